@@ -33,6 +33,10 @@ func (n *nopLog) Gauge(context.Context, *pb.GaugeReq) (*pb.GaugeRes, error) {
 	return nil, nil
 }
 
+func (n *nopLog) Event(context.Context, *pb.EventReq) (*pb.EventRes, error) {
+	return nil, nil
+}
+
 func newNopMetricLog() *nopLog {
 	return &nopLog{}
 }
@@ -8,4 +8,5 @@ const (
 	DEFAULT_PORT         = 8080
 	DEFAULT_CLUSTER_PORT = 6890
 	DEFAULT_DB_NAME      = "gobench.sqlite3"
+	DEFAULT_SMTP_PORT    = 587
 )
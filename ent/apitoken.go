@@ -0,0 +1,201 @@
+// Code generated by entc, DO NOT EDIT.
+
+package ent
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/facebook/ent/dialect/sql"
+	"github.com/gobench-io/gobench/ent/apitoken"
+	"github.com/gobench-io/gobench/ent/user"
+)
+
+// APIToken is the model entity for the APIToken schema.
+type APIToken struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID int `json:"id,omitempty"`
+	// Name holds the value of the "name" field.
+	Name string `json:"name,omitempty"`
+	// TokenHash holds the value of the "token_hash" field.
+	TokenHash string `json:"-"`
+	// Prefix holds the value of the "prefix" field.
+	Prefix string `json:"prefix,omitempty"`
+	// Scope holds the value of the "scope" field.
+	Scope apitoken.Scope `json:"scope,omitempty"`
+	// LastUsedAt holds the value of the "last_used_at" field.
+	LastUsedAt *time.Time `json:"last_used_at,omitempty"`
+	// ExpiresAt holds the value of the "expires_at" field.
+	ExpiresAt *time.Time `json:"expires_at,omitempty"`
+	// CreatedAt holds the value of the "created_at" field.
+	CreatedAt time.Time `json:"created_at,omitempty"`
+	// Edges holds the relations/edges for other nodes in the graph.
+	// The values are being populated by the APITokenQuery when eager-loading is set.
+	Edges       APITokenEdges `json:"edges"`
+	user_tokens *int
+}
+
+// APITokenEdges holds the relations/edges for other nodes in the graph.
+type APITokenEdges struct {
+	// User holds the value of the user edge.
+	User *User
+	// loadedTypes holds the information for reporting if a
+	// type was loaded (or requested) in eager-loading or not.
+	loadedTypes [1]bool
+}
+
+// UserOrErr returns the User value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e APITokenEdges) UserOrErr() (*User, error) {
+	if e.loadedTypes[0] {
+		if e.User == nil {
+			// The edge user was loaded in eager-loading,
+			// but was not found.
+			return nil, &NotFoundError{label: user.Label}
+		}
+		return e.User, nil
+	}
+	return nil, &NotLoadedError{edge: "user"}
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*APIToken) scanValues() []interface{} {
+	return []interface{}{
+		&sql.NullInt64{},  // id
+		&sql.NullString{}, // name
+		&sql.NullString{}, // token_hash
+		&sql.NullString{}, // prefix
+		&sql.NullString{}, // scope
+		&sql.NullTime{},   // last_used_at
+		&sql.NullTime{},   // expires_at
+		&sql.NullTime{},   // created_at
+	}
+}
+
+// fkValues returns the types for scanning foreign-keys values from sql.Rows.
+func (*APIToken) fkValues() []interface{} {
+	return []interface{}{
+		&sql.NullInt64{}, // user_tokens
+	}
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the APIToken fields.
+func (at *APIToken) assignValues(values ...interface{}) error {
+	if m, n := len(values), len(apitoken.Columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	value, ok := values[0].(*sql.NullInt64)
+	if !ok {
+		return fmt.Errorf("unexpected type %T for field id", value)
+	}
+	at.ID = int(value.Int64)
+	values = values[1:]
+	if value, ok := values[0].(*sql.NullString); !ok {
+		return fmt.Errorf("unexpected type %T for field name", values[0])
+	} else if value.Valid {
+		at.Name = value.String
+	}
+	if value, ok := values[1].(*sql.NullString); !ok {
+		return fmt.Errorf("unexpected type %T for field token_hash", values[1])
+	} else if value.Valid {
+		at.TokenHash = value.String
+	}
+	if value, ok := values[2].(*sql.NullString); !ok {
+		return fmt.Errorf("unexpected type %T for field prefix", values[2])
+	} else if value.Valid {
+		at.Prefix = value.String
+	}
+	if value, ok := values[3].(*sql.NullString); !ok {
+		return fmt.Errorf("unexpected type %T for field scope", values[3])
+	} else if value.Valid {
+		at.Scope = apitoken.Scope(value.String)
+	}
+	if value, ok := values[4].(*sql.NullTime); !ok {
+		return fmt.Errorf("unexpected type %T for field last_used_at", values[4])
+	} else if value.Valid {
+		at.LastUsedAt = new(time.Time)
+		*at.LastUsedAt = value.Time
+	}
+	if value, ok := values[5].(*sql.NullTime); !ok {
+		return fmt.Errorf("unexpected type %T for field expires_at", values[5])
+	} else if value.Valid {
+		at.ExpiresAt = new(time.Time)
+		*at.ExpiresAt = value.Time
+	}
+	if value, ok := values[6].(*sql.NullTime); !ok {
+		return fmt.Errorf("unexpected type %T for field created_at", values[6])
+	} else if value.Valid {
+		at.CreatedAt = value.Time
+	}
+	values = values[7:]
+	if len(values) == len(apitoken.ForeignKeys) {
+		if value, ok := values[0].(*sql.NullInt64); !ok {
+			return fmt.Errorf("unexpected type %T for edge-field user_tokens", value)
+		} else if value.Valid {
+			at.user_tokens = new(int)
+			*at.user_tokens = int(value.Int64)
+		}
+	}
+	return nil
+}
+
+// QueryUser queries the user edge of the APIToken.
+func (at *APIToken) QueryUser() *UserQuery {
+	return (&APITokenClient{config: at.config}).QueryUser(at)
+}
+
+// Update returns a builder for updating this APIToken.
+// Note that, you need to call APIToken.Unwrap() before calling this method, if this APIToken
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (at *APIToken) Update() *APITokenUpdateOne {
+	return (&APITokenClient{config: at.config}).UpdateOne(at)
+}
+
+// Unwrap unwraps the entity that was returned from a transaction after it was closed,
+// so that all next queries will be executed through the driver which created the transaction.
+func (at *APIToken) Unwrap() *APIToken {
+	tx, ok := at.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: APIToken is not a transactional entity")
+	}
+	at.config.driver = tx.drv
+	return at
+}
+
+// String implements the fmt.Stringer.
+func (at *APIToken) String() string {
+	var builder strings.Builder
+	builder.WriteString("APIToken(")
+	builder.WriteString(fmt.Sprintf("id=%v", at.ID))
+	builder.WriteString(", name=")
+	builder.WriteString(at.Name)
+	builder.WriteString(", token_hash=<sensitive>")
+	builder.WriteString(", prefix=")
+	builder.WriteString(at.Prefix)
+	builder.WriteString(", scope=")
+	builder.WriteString(fmt.Sprintf("%v", at.Scope))
+	if v := at.LastUsedAt; v != nil {
+		builder.WriteString(", last_used_at=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	if v := at.ExpiresAt; v != nil {
+		builder.WriteString(", expires_at=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", created_at=")
+	builder.WriteString(at.CreatedAt.Format(time.ANSIC))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// APITokens is a parsable slice of APIToken.
+type APITokens []*APIToken
+
+func (at APITokens) config(cfg config) {
+	for _i := range at {
+		at[_i].config = cfg
+	}
+}
@@ -0,0 +1,108 @@
+// Code generated by entc, DO NOT EDIT.
+
+package apitoken
+
+import (
+	"fmt"
+	"time"
+)
+
+const (
+	// Label holds the string label denoting the apitoken type in the database.
+	Label = "api_token"
+	// FieldID holds the string denoting the id field in the database.
+	FieldID = "id"
+	// FieldName holds the string denoting the name field in the database.
+	FieldName = "name"
+	// FieldTokenHash holds the string denoting the token_hash field in the database.
+	FieldTokenHash = "token_hash"
+	// FieldPrefix holds the string denoting the prefix field in the database.
+	FieldPrefix = "prefix"
+	// FieldScope holds the string denoting the scope field in the database.
+	FieldScope = "scope"
+	// FieldLastUsedAt holds the string denoting the last_used_at field in the database.
+	FieldLastUsedAt = "last_used_at"
+	// FieldExpiresAt holds the string denoting the expires_at field in the database.
+	FieldExpiresAt = "expires_at"
+	// FieldCreatedAt holds the string denoting the created_at field in the database.
+	FieldCreatedAt = "created_at"
+
+	// EdgeUser holds the string denoting the user edge name in mutations.
+	EdgeUser = "user"
+
+	// Table holds the table name of the apitoken in the database.
+	Table = "api_tokens"
+	// UserTable is the table the holds the user relation/edge.
+	UserTable = "api_tokens"
+	// UserInverseTable is the table name for the User entity.
+	// It exists in this package in order to avoid circular dependency with the "user" package.
+	UserInverseTable = "users"
+	// UserColumn is the table column denoting the user relation/edge.
+	UserColumn = "user_tokens"
+)
+
+// Columns holds all SQL columns for apitoken fields.
+var Columns = []string{
+	FieldID,
+	FieldName,
+	FieldTokenHash,
+	FieldPrefix,
+	FieldScope,
+	FieldLastUsedAt,
+	FieldExpiresAt,
+	FieldCreatedAt,
+}
+
+// ForeignKeys holds the SQL foreign-keys that are owned by the APIToken type.
+var ForeignKeys = []string{
+	"user_tokens",
+}
+
+// ValidColumn reports if the column name is valid (part of the table columns).
+func ValidColumn(column string) bool {
+	for i := range Columns {
+		if column == Columns[i] {
+			return true
+		}
+	}
+	for i := range ForeignKeys {
+		if column == ForeignKeys[i] {
+			return true
+		}
+	}
+	return false
+}
+
+var (
+	// NameValidator is a validator for the "name" field. It is called by the builders before save.
+	NameValidator func(string) error
+	// DefaultCreatedAt holds the default value on creation for the created_at field.
+	DefaultCreatedAt func() time.Time
+)
+
+// Scope defines the type for the scope enum field.
+type Scope string
+
+// ScopeRead is the default Scope.
+const DefaultScope = ScopeRead
+
+// Scope values.
+const (
+	ScopeRead  Scope = "read"
+	ScopeWrite Scope = "write"
+	ScopeAdmin Scope = "admin"
+)
+
+func (s Scope) String() string {
+	return string(s)
+}
+
+// ScopeValidator is a validator for the "scope" field enum values. It is called by the builders before save.
+func ScopeValidator(s Scope) error {
+	switch s {
+	case ScopeRead, ScopeWrite, ScopeAdmin:
+		return nil
+	default:
+		return fmt.Errorf("apitoken: invalid enum value for scope field: %q", s)
+	}
+}
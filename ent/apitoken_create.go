@@ -0,0 +1,371 @@
+// Code generated by entc, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/facebook/ent/dialect/sql/sqlgraph"
+	"github.com/facebook/ent/schema/field"
+	"github.com/gobench-io/gobench/ent/apitoken"
+	"github.com/gobench-io/gobench/ent/user"
+)
+
+// APITokenCreate is the builder for creating a APIToken entity.
+type APITokenCreate struct {
+	config
+	mutation *APITokenMutation
+	hooks    []Hook
+}
+
+// SetName sets the name field.
+func (atc *APITokenCreate) SetName(s string) *APITokenCreate {
+	atc.mutation.SetName(s)
+	return atc
+}
+
+// SetTokenHash sets the token_hash field.
+func (atc *APITokenCreate) SetTokenHash(s string) *APITokenCreate {
+	atc.mutation.SetTokenHash(s)
+	return atc
+}
+
+// SetPrefix sets the prefix field.
+func (atc *APITokenCreate) SetPrefix(s string) *APITokenCreate {
+	atc.mutation.SetPrefix(s)
+	return atc
+}
+
+// SetScope sets the scope field.
+func (atc *APITokenCreate) SetScope(a apitoken.Scope) *APITokenCreate {
+	atc.mutation.SetScope(a)
+	return atc
+}
+
+// SetNillableScope sets the scope field if the given value is not nil.
+func (atc *APITokenCreate) SetNillableScope(a *apitoken.Scope) *APITokenCreate {
+	if a != nil {
+		atc.SetScope(*a)
+	}
+	return atc
+}
+
+// SetLastUsedAt sets the last_used_at field.
+func (atc *APITokenCreate) SetLastUsedAt(t time.Time) *APITokenCreate {
+	atc.mutation.SetLastUsedAt(t)
+	return atc
+}
+
+// SetNillableLastUsedAt sets the last_used_at field if the given value is not nil.
+func (atc *APITokenCreate) SetNillableLastUsedAt(t *time.Time) *APITokenCreate {
+	if t != nil {
+		atc.SetLastUsedAt(*t)
+	}
+	return atc
+}
+
+// SetExpiresAt sets the expires_at field.
+func (atc *APITokenCreate) SetExpiresAt(t time.Time) *APITokenCreate {
+	atc.mutation.SetExpiresAt(t)
+	return atc
+}
+
+// SetNillableExpiresAt sets the expires_at field if the given value is not nil.
+func (atc *APITokenCreate) SetNillableExpiresAt(t *time.Time) *APITokenCreate {
+	if t != nil {
+		atc.SetExpiresAt(*t)
+	}
+	return atc
+}
+
+// SetCreatedAt sets the created_at field.
+func (atc *APITokenCreate) SetCreatedAt(t time.Time) *APITokenCreate {
+	atc.mutation.SetCreatedAt(t)
+	return atc
+}
+
+// SetNillableCreatedAt sets the created_at field if the given value is not nil.
+func (atc *APITokenCreate) SetNillableCreatedAt(t *time.Time) *APITokenCreate {
+	if t != nil {
+		atc.SetCreatedAt(*t)
+	}
+	return atc
+}
+
+// SetUserID sets the user edge to User by id.
+func (atc *APITokenCreate) SetUserID(id int) *APITokenCreate {
+	atc.mutation.SetUserID(id)
+	return atc
+}
+
+// SetUser sets the user edge to User.
+func (atc *APITokenCreate) SetUser(u *User) *APITokenCreate {
+	return atc.SetUserID(u.ID)
+}
+
+// Mutation returns the APITokenMutation object of the builder.
+func (atc *APITokenCreate) Mutation() *APITokenMutation {
+	return atc.mutation
+}
+
+// Save creates the APIToken in the database.
+func (atc *APITokenCreate) Save(ctx context.Context) (*APIToken, error) {
+	var (
+		err  error
+		node *APIToken
+	)
+	atc.defaults()
+	if len(atc.hooks) == 0 {
+		if err = atc.check(); err != nil {
+			return nil, err
+		}
+		node, err = atc.sqlSave(ctx)
+	} else {
+		var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+			mutation, ok := m.(*APITokenMutation)
+			if !ok {
+				return nil, fmt.Errorf("unexpected mutation type %T", m)
+			}
+			if err = atc.check(); err != nil {
+				return nil, err
+			}
+			atc.mutation = mutation
+			node, err = atc.sqlSave(ctx)
+			mutation.done = true
+			return node, err
+		})
+		for i := len(atc.hooks) - 1; i >= 0; i-- {
+			mut = atc.hooks[i](mut)
+		}
+		if _, err := mut.Mutate(ctx, atc.mutation); err != nil {
+			return nil, err
+		}
+	}
+	return node, err
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (atc *APITokenCreate) SaveX(ctx context.Context) *APIToken {
+	v, err := atc.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// defaults sets the default values of the builder before save.
+func (atc *APITokenCreate) defaults() {
+	if _, ok := atc.mutation.Scope(); !ok {
+		v := apitoken.DefaultScope
+		atc.mutation.SetScope(v)
+	}
+	if _, ok := atc.mutation.CreatedAt(); !ok {
+		v := apitoken.DefaultCreatedAt()
+		atc.mutation.SetCreatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (atc *APITokenCreate) check() error {
+	if _, ok := atc.mutation.Name(); !ok {
+		return &ValidationError{Name: "name", err: errors.New("ent: missing required field \"name\"")}
+	}
+	if v, ok := atc.mutation.Name(); ok {
+		if err := apitoken.NameValidator(v); err != nil {
+			return &ValidationError{Name: "name", err: fmt.Errorf("ent: validator failed for field \"name\": %w", err)}
+		}
+	}
+	if _, ok := atc.mutation.TokenHash(); !ok {
+		return &ValidationError{Name: "token_hash", err: errors.New("ent: missing required field \"token_hash\"")}
+	}
+	if _, ok := atc.mutation.Prefix(); !ok {
+		return &ValidationError{Name: "prefix", err: errors.New("ent: missing required field \"prefix\"")}
+	}
+	if _, ok := atc.mutation.Scope(); !ok {
+		return &ValidationError{Name: "scope", err: errors.New("ent: missing required field \"scope\"")}
+	}
+	if v, ok := atc.mutation.Scope(); ok {
+		if err := apitoken.ScopeValidator(v); err != nil {
+			return &ValidationError{Name: "scope", err: fmt.Errorf("ent: validator failed for field \"scope\": %w", err)}
+		}
+	}
+	if _, ok := atc.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New("ent: missing required field \"created_at\"")}
+	}
+	if _, ok := atc.mutation.UserID(); !ok {
+		return &ValidationError{Name: "user", err: errors.New("ent: missing required edge \"user\"")}
+	}
+	return nil
+}
+
+func (atc *APITokenCreate) sqlSave(ctx context.Context) (*APIToken, error) {
+	_node, _spec := atc.createSpec()
+	if err := sqlgraph.CreateNode(ctx, atc.driver, _spec); err != nil {
+		if cerr, ok := isSQLConstraintError(err); ok {
+			err = cerr
+		}
+		return nil, err
+	}
+	id := _spec.ID.Value.(int64)
+	_node.ID = int(id)
+	return _node, nil
+}
+
+func (atc *APITokenCreate) createSpec() (*APIToken, *sqlgraph.CreateSpec) {
+	var (
+		_node = &APIToken{config: atc.config}
+		_spec = &sqlgraph.CreateSpec{
+			Table: apitoken.Table,
+			ID: &sqlgraph.FieldSpec{
+				Type:   field.TypeInt,
+				Column: apitoken.FieldID,
+			},
+		}
+	)
+	if value, ok := atc.mutation.Name(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: apitoken.FieldName,
+		})
+		_node.Name = value
+	}
+	if value, ok := atc.mutation.TokenHash(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: apitoken.FieldTokenHash,
+		})
+		_node.TokenHash = value
+	}
+	if value, ok := atc.mutation.Prefix(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: apitoken.FieldPrefix,
+		})
+		_node.Prefix = value
+	}
+	if value, ok := atc.mutation.Scope(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeEnum,
+			Value:  value,
+			Column: apitoken.FieldScope,
+		})
+		_node.Scope = value
+	}
+	if value, ok := atc.mutation.LastUsedAt(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeTime,
+			Value:  value,
+			Column: apitoken.FieldLastUsedAt,
+		})
+		_node.LastUsedAt = &value
+	}
+	if value, ok := atc.mutation.ExpiresAt(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeTime,
+			Value:  value,
+			Column: apitoken.FieldExpiresAt,
+		})
+		_node.ExpiresAt = &value
+	}
+	if value, ok := atc.mutation.CreatedAt(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeTime,
+			Value:  value,
+			Column: apitoken.FieldCreatedAt,
+		})
+		_node.CreatedAt = value
+	}
+	if nodes := atc.mutation.UserIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   apitoken.UserTable,
+			Columns: []string{apitoken.UserColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt,
+					Column: user.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	return _node, _spec
+}
+
+// APITokenCreateBulk is the builder for creating a bulk of APIToken entities.
+type APITokenCreateBulk struct {
+	config
+	builders []*APITokenCreate
+}
+
+// Save creates the APIToken entities in the database.
+func (atcb *APITokenCreateBulk) Save(ctx context.Context) ([]*APIToken, error) {
+	specs := make([]*sqlgraph.CreateSpec, len(atcb.builders))
+	nodes := make([]*APIToken, len(atcb.builders))
+	mutators := make([]Mutator, len(atcb.builders))
+	for i := range atcb.builders {
+		func(i int, root context.Context) {
+			builder := atcb.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*APITokenMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				nodes[i], specs[i] = builder.createSpec()
+				var err error
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, atcb.builders[i+1].mutation)
+				} else {
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, atcb.driver, &sqlgraph.BatchCreateSpec{Nodes: specs}); err != nil {
+						if cerr, ok := isSQLConstraintError(err); ok {
+							err = cerr
+						}
+					}
+				}
+				mutation.done = true
+				if err != nil {
+					return nil, err
+				}
+				id := specs[i].ID.Value.(int64)
+				nodes[i].ID = int(id)
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, atcb.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (atcb *APITokenCreateBulk) SaveX(ctx context.Context) []*APIToken {
+	v, err := atcb.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
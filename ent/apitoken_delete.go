@@ -0,0 +1,109 @@
+// Code generated by entc, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/facebook/ent/dialect/sql"
+	"github.com/facebook/ent/dialect/sql/sqlgraph"
+	"github.com/facebook/ent/schema/field"
+	"github.com/gobench-io/gobench/ent/apitoken"
+	"github.com/gobench-io/gobench/ent/predicate"
+)
+
+// APITokenDelete is the builder for deleting a APIToken entity.
+type APITokenDelete struct {
+	config
+	hooks      []Hook
+	mutation   *APITokenMutation
+	predicates []predicate.APIToken
+}
+
+// Where adds a new predicate to the delete builder.
+func (atd *APITokenDelete) Where(ps ...predicate.APIToken) *APITokenDelete {
+	atd.predicates = append(atd.predicates, ps...)
+	return atd
+}
+
+// Exec executes the deletion query and returns how many vertices were deleted.
+func (atd *APITokenDelete) Exec(ctx context.Context) (int, error) {
+	var (
+		err      error
+		affected int
+	)
+	if len(atd.hooks) == 0 {
+		affected, err = atd.sqlExec(ctx)
+	} else {
+		var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+			mutation, ok := m.(*APITokenMutation)
+			if !ok {
+				return nil, fmt.Errorf("unexpected mutation type %T", m)
+			}
+			atd.mutation = mutation
+			affected, err = atd.sqlExec(ctx)
+			mutation.done = true
+			return affected, err
+		})
+		for i := len(atd.hooks) - 1; i >= 0; i-- {
+			mut = atd.hooks[i](mut)
+		}
+		if _, err := mut.Mutate(ctx, atd.mutation); err != nil {
+			return 0, err
+		}
+	}
+	return affected, err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (atd *APITokenDelete) ExecX(ctx context.Context) int {
+	n, err := atd.Exec(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return n
+}
+
+func (atd *APITokenDelete) sqlExec(ctx context.Context) (int, error) {
+	_spec := &sqlgraph.DeleteSpec{
+		Node: &sqlgraph.NodeSpec{
+			Table: apitoken.Table,
+			ID: &sqlgraph.FieldSpec{
+				Type:   field.TypeInt,
+				Column: apitoken.FieldID,
+			},
+		},
+	}
+	if ps := atd.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	return sqlgraph.DeleteNodes(ctx, atd.driver, _spec)
+}
+
+// APITokenDeleteOne is the builder for deleting a single APIToken entity.
+type APITokenDeleteOne struct {
+	atd *APITokenDelete
+}
+
+// Exec executes the deletion query.
+func (atdo *APITokenDeleteOne) Exec(ctx context.Context) error {
+	n, err := atdo.atd.Exec(ctx)
+	switch {
+	case err != nil:
+		return err
+	case n == 0:
+		return &NotFoundError{apitoken.Label}
+	default:
+		return nil
+	}
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (atdo *APITokenDeleteOne) ExecX(ctx context.Context) {
+	atdo.atd.ExecX(ctx)
+}
@@ -0,0 +1,956 @@
+// Code generated by entc, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math"
+
+	"github.com/facebook/ent/dialect/sql"
+	"github.com/facebook/ent/dialect/sql/sqlgraph"
+	"github.com/facebook/ent/schema/field"
+	"github.com/gobench-io/gobench/ent/apitoken"
+	"github.com/gobench-io/gobench/ent/predicate"
+	"github.com/gobench-io/gobench/ent/user"
+)
+
+// APITokenQuery is the builder for querying APIToken entities.
+type APITokenQuery struct {
+	config
+	limit      *int
+	offset     *int
+	order      []OrderFunc
+	unique     []string
+	predicates []predicate.APIToken
+	// eager-loading edges.
+	withUser *UserQuery
+	withFKs  bool
+	// intermediate query (i.e. traversal path).
+	sql  *sql.Selector
+	path func(context.Context) (*sql.Selector, error)
+}
+
+// Where adds a new predicate for the builder.
+func (atq *APITokenQuery) Where(ps ...predicate.APIToken) *APITokenQuery {
+	atq.predicates = append(atq.predicates, ps...)
+	return atq
+}
+
+// Limit adds a limit step to the query.
+func (atq *APITokenQuery) Limit(limit int) *APITokenQuery {
+	atq.limit = &limit
+	return atq
+}
+
+// Offset adds an offset step to the query.
+func (atq *APITokenQuery) Offset(offset int) *APITokenQuery {
+	atq.offset = &offset
+	return atq
+}
+
+// Order adds an order step to the query.
+func (atq *APITokenQuery) Order(o ...OrderFunc) *APITokenQuery {
+	atq.order = append(atq.order, o...)
+	return atq
+}
+
+// QueryUser chains the current query on the user edge.
+func (atq *APITokenQuery) QueryUser() *UserQuery {
+	query := &UserQuery{config: atq.config}
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := atq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := atq.sqlQuery()
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(apitoken.Table, apitoken.FieldID, selector),
+			sqlgraph.To(user.Table, user.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, apitoken.UserTable, apitoken.UserColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(atq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// First returns the first APIToken entity in the query. Returns *NotFoundError when no apitoken was found.
+func (atq *APITokenQuery) First(ctx context.Context) (*APIToken, error) {
+	nodes, err := atq.Limit(1).All(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nil, &NotFoundError{apitoken.Label}
+	}
+	return nodes[0], nil
+}
+
+// FirstX is like First, but panics if an error occurs.
+func (atq *APITokenQuery) FirstX(ctx context.Context) *APIToken {
+	node, err := atq.First(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return node
+}
+
+// FirstID returns the first APIToken id in the query. Returns *NotFoundError when no id was found.
+func (atq *APITokenQuery) FirstID(ctx context.Context) (id int, err error) {
+	var ids []int
+	if ids, err = atq.Limit(1).IDs(ctx); err != nil {
+		return
+	}
+	if len(ids) == 0 {
+		err = &NotFoundError{apitoken.Label}
+		return
+	}
+	return ids[0], nil
+}
+
+// FirstXID is like FirstID, but panics if an error occurs.
+func (atq *APITokenQuery) FirstXID(ctx context.Context) int {
+	id, err := atq.FirstID(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return id
+}
+
+// Only returns the only APIToken entity in the query, returns an error if not exactly one entity was returned.
+func (atq *APITokenQuery) Only(ctx context.Context) (*APIToken, error) {
+	nodes, err := atq.Limit(2).All(ctx)
+	if err != nil {
+		return nil, err
+	}
+	switch len(nodes) {
+	case 1:
+		return nodes[0], nil
+	case 0:
+		return nil, &NotFoundError{apitoken.Label}
+	default:
+		return nil, &NotSingularError{apitoken.Label}
+	}
+}
+
+// OnlyX is like Only, but panics if an error occurs.
+func (atq *APITokenQuery) OnlyX(ctx context.Context) *APIToken {
+	node, err := atq.Only(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// OnlyID returns the only APIToken id in the query, returns an error if not exactly one id was returned.
+func (atq *APITokenQuery) OnlyID(ctx context.Context) (id int, err error) {
+	var ids []int
+	if ids, err = atq.Limit(2).IDs(ctx); err != nil {
+		return
+	}
+	switch len(ids) {
+	case 1:
+		id = ids[0]
+	case 0:
+		err = &NotFoundError{apitoken.Label}
+	default:
+		err = &NotSingularError{apitoken.Label}
+	}
+	return
+}
+
+// OnlyIDX is like OnlyID, but panics if an error occurs.
+func (atq *APITokenQuery) OnlyIDX(ctx context.Context) int {
+	id, err := atq.OnlyID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// All executes the query and returns a list of APITokens.
+func (atq *APITokenQuery) All(ctx context.Context) ([]*APIToken, error) {
+	if err := atq.prepareQuery(ctx); err != nil {
+		return nil, err
+	}
+	return atq.sqlAll(ctx)
+}
+
+// AllX is like All, but panics if an error occurs.
+func (atq *APITokenQuery) AllX(ctx context.Context) []*APIToken {
+	nodes, err := atq.All(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return nodes
+}
+
+// IDs executes the query and returns a list of APIToken ids.
+func (atq *APITokenQuery) IDs(ctx context.Context) ([]int, error) {
+	var ids []int
+	if err := atq.Select(apitoken.FieldID).Scan(ctx, &ids); err != nil {
+		return nil, err
+	}
+	return ids, nil
+}
+
+// IDsX is like IDs, but panics if an error occurs.
+func (atq *APITokenQuery) IDsX(ctx context.Context) []int {
+	ids, err := atq.IDs(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return ids
+}
+
+// Count returns the count of the given query.
+func (atq *APITokenQuery) Count(ctx context.Context) (int, error) {
+	if err := atq.prepareQuery(ctx); err != nil {
+		return 0, err
+	}
+	return atq.sqlCount(ctx)
+}
+
+// CountX is like Count, but panics if an error occurs.
+func (atq *APITokenQuery) CountX(ctx context.Context) int {
+	count, err := atq.Count(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return count
+}
+
+// Exist returns true if the query has elements in the graph.
+func (atq *APITokenQuery) Exist(ctx context.Context) (bool, error) {
+	if err := atq.prepareQuery(ctx); err != nil {
+		return false, err
+	}
+	return atq.sqlExist(ctx)
+}
+
+// ExistX is like Exist, but panics if an error occurs.
+func (atq *APITokenQuery) ExistX(ctx context.Context) bool {
+	exist, err := atq.Exist(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return exist
+}
+
+// Clone returns a duplicate of the query builder, including all associated steps. It can be
+// used to prepare common query builders and use them differently after the clone is made.
+func (atq *APITokenQuery) Clone() *APITokenQuery {
+	return &APITokenQuery{
+		config:     atq.config,
+		limit:      atq.limit,
+		offset:     atq.offset,
+		order:      append([]OrderFunc{}, atq.order...),
+		unique:     append([]string{}, atq.unique...),
+		predicates: append([]predicate.APIToken{}, atq.predicates...),
+		// clone intermediate query.
+		sql:  atq.sql.Clone(),
+		path: atq.path,
+	}
+}
+
+//	WithUser tells the query-builder to eager-loads the nodes that are connected to
+//
+// the "user" edge. The optional arguments used to configure the query builder of the edge.
+func (atq *APITokenQuery) WithUser(opts ...func(*UserQuery)) *APITokenQuery {
+	query := &UserQuery{config: atq.config}
+	for _, opt := range opts {
+		opt(query)
+	}
+	atq.withUser = query
+	return atq
+}
+
+// GroupBy used to group vertices by one or more fields/columns.
+// It is often used with aggregate functions, like: count, max, mean, min, sum.
+//
+// Example:
+//
+//	var v []struct {
+//		Name string `json:"name,omitempty"`
+//		Count int `json:"count,omitempty"`
+//	}
+//
+//	client.APIToken.Query().
+//		GroupBy(apitoken.FieldName).
+//		Aggregate(ent.Count()).
+//		Scan(ctx, &v)
+func (atq *APITokenQuery) GroupBy(field string, fields ...string) *APITokenGroupBy {
+	group := &APITokenGroupBy{config: atq.config}
+	group.fields = append([]string{field}, fields...)
+	group.path = func(ctx context.Context) (prev *sql.Selector, err error) {
+		if err := atq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		return atq.sqlQuery(), nil
+	}
+	return group
+}
+
+// Select one or more fields from the given query.
+//
+// Example:
+//
+//	var v []struct {
+//		Name string `json:"name,omitempty"`
+//	}
+//
+//	client.APIToken.Query().
+//		Select(apitoken.FieldName).
+//		Scan(ctx, &v)
+func (atq *APITokenQuery) Select(field string, fields ...string) *APITokenSelect {
+	selector := &APITokenSelect{config: atq.config}
+	selector.fields = append([]string{field}, fields...)
+	selector.path = func(ctx context.Context) (prev *sql.Selector, err error) {
+		if err := atq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		return atq.sqlQuery(), nil
+	}
+	return selector
+}
+
+func (atq *APITokenQuery) prepareQuery(ctx context.Context) error {
+	if atq.path != nil {
+		prev, err := atq.path(ctx)
+		if err != nil {
+			return err
+		}
+		atq.sql = prev
+	}
+	return nil
+}
+
+func (atq *APITokenQuery) sqlAll(ctx context.Context) ([]*APIToken, error) {
+	var (
+		nodes       = []*APIToken{}
+		withFKs     = atq.withFKs
+		_spec       = atq.querySpec()
+		loadedTypes = [1]bool{
+			atq.withUser != nil,
+		}
+	)
+	if atq.withUser != nil {
+		withFKs = true
+	}
+	if withFKs {
+		_spec.Node.Columns = append(_spec.Node.Columns, apitoken.ForeignKeys...)
+	}
+	_spec.ScanValues = func() []interface{} {
+		node := &APIToken{config: atq.config}
+		nodes = append(nodes, node)
+		values := node.scanValues()
+		if withFKs {
+			values = append(values, node.fkValues()...)
+		}
+		return values
+	}
+	_spec.Assign = func(values ...interface{}) error {
+		if len(nodes) == 0 {
+			return fmt.Errorf("ent: Assign called without calling ScanValues")
+		}
+		node := nodes[len(nodes)-1]
+		node.Edges.loadedTypes = loadedTypes
+		return node.assignValues(values...)
+	}
+	if err := sqlgraph.QueryNodes(ctx, atq.driver, _spec); err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nodes, nil
+	}
+
+	if query := atq.withUser; query != nil {
+		ids := make([]int, 0, len(nodes))
+		nodeids := make(map[int][]*APIToken)
+		for i := range nodes {
+			if fk := nodes[i].user_tokens; fk != nil {
+				ids = append(ids, *fk)
+				nodeids[*fk] = append(nodeids[*fk], nodes[i])
+			}
+		}
+		query.Where(user.IDIn(ids...))
+		neighbors, err := query.All(ctx)
+		if err != nil {
+			return nil, err
+		}
+		for _, n := range neighbors {
+			nodes, ok := nodeids[n.ID]
+			if !ok {
+				return nil, fmt.Errorf(`unexpected foreign-key "user_tokens" returned %v`, n.ID)
+			}
+			for i := range nodes {
+				nodes[i].Edges.User = n
+			}
+		}
+	}
+
+	return nodes, nil
+}
+
+func (atq *APITokenQuery) sqlCount(ctx context.Context) (int, error) {
+	_spec := atq.querySpec()
+	return sqlgraph.CountNodes(ctx, atq.driver, _spec)
+}
+
+func (atq *APITokenQuery) sqlExist(ctx context.Context) (bool, error) {
+	n, err := atq.sqlCount(ctx)
+	if err != nil {
+		return false, fmt.Errorf("ent: check existence: %v", err)
+	}
+	return n > 0, nil
+}
+
+func (atq *APITokenQuery) querySpec() *sqlgraph.QuerySpec {
+	_spec := &sqlgraph.QuerySpec{
+		Node: &sqlgraph.NodeSpec{
+			Table:   apitoken.Table,
+			Columns: apitoken.Columns,
+			ID: &sqlgraph.FieldSpec{
+				Type:   field.TypeInt,
+				Column: apitoken.FieldID,
+			},
+		},
+		From:   atq.sql,
+		Unique: true,
+	}
+	if ps := atq.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if limit := atq.limit; limit != nil {
+		_spec.Limit = *limit
+	}
+	if offset := atq.offset; offset != nil {
+		_spec.Offset = *offset
+	}
+	if ps := atq.order; len(ps) > 0 {
+		_spec.Order = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector, apitoken.ValidColumn)
+			}
+		}
+	}
+	return _spec
+}
+
+func (atq *APITokenQuery) sqlQuery() *sql.Selector {
+	builder := sql.Dialect(atq.driver.Dialect())
+	t1 := builder.Table(apitoken.Table)
+	selector := builder.Select(t1.Columns(apitoken.Columns...)...).From(t1)
+	if atq.sql != nil {
+		selector = atq.sql
+		selector.Select(selector.Columns(apitoken.Columns...)...)
+	}
+	for _, p := range atq.predicates {
+		p(selector)
+	}
+	for _, p := range atq.order {
+		p(selector, apitoken.ValidColumn)
+	}
+	if offset := atq.offset; offset != nil {
+		// limit is mandatory for offset clause. We start
+		// with default value, and override it below if needed.
+		selector.Offset(*offset).Limit(math.MaxInt32)
+	}
+	if limit := atq.limit; limit != nil {
+		selector.Limit(*limit)
+	}
+	return selector
+}
+
+// APITokenGroupBy is the builder for group-by APIToken entities.
+type APITokenGroupBy struct {
+	config
+	fields []string
+	fns    []AggregateFunc
+	// intermediate query (i.e. traversal path).
+	sql  *sql.Selector
+	path func(context.Context) (*sql.Selector, error)
+}
+
+// Aggregate adds the given aggregation functions to the group-by query.
+func (atgb *APITokenGroupBy) Aggregate(fns ...AggregateFunc) *APITokenGroupBy {
+	atgb.fns = append(atgb.fns, fns...)
+	return atgb
+}
+
+// Scan applies the group-by query and scan the result into the given value.
+func (atgb *APITokenGroupBy) Scan(ctx context.Context, v interface{}) error {
+	query, err := atgb.path(ctx)
+	if err != nil {
+		return err
+	}
+	atgb.sql = query
+	return atgb.sqlScan(ctx, v)
+}
+
+// ScanX is like Scan, but panics if an error occurs.
+func (atgb *APITokenGroupBy) ScanX(ctx context.Context, v interface{}) {
+	if err := atgb.Scan(ctx, v); err != nil {
+		panic(err)
+	}
+}
+
+// Strings returns list of strings from group-by. It is only allowed when querying group-by with one field.
+func (atgb *APITokenGroupBy) Strings(ctx context.Context) ([]string, error) {
+	if len(atgb.fields) > 1 {
+		return nil, errors.New("ent: APITokenGroupBy.Strings is not achievable when grouping more than 1 field")
+	}
+	var v []string
+	if err := atgb.Scan(ctx, &v); err != nil {
+		return nil, err
+	}
+	return v, nil
+}
+
+// StringsX is like Strings, but panics if an error occurs.
+func (atgb *APITokenGroupBy) StringsX(ctx context.Context) []string {
+	v, err := atgb.Strings(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// String returns a single string from group-by. It is only allowed when querying group-by with one field.
+func (atgb *APITokenGroupBy) String(ctx context.Context) (_ string, err error) {
+	var v []string
+	if v, err = atgb.Strings(ctx); err != nil {
+		return
+	}
+	switch len(v) {
+	case 1:
+		return v[0], nil
+	case 0:
+		err = &NotFoundError{apitoken.Label}
+	default:
+		err = fmt.Errorf("ent: APITokenGroupBy.Strings returned %d results when one was expected", len(v))
+	}
+	return
+}
+
+// StringX is like String, but panics if an error occurs.
+func (atgb *APITokenGroupBy) StringX(ctx context.Context) string {
+	v, err := atgb.String(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Ints returns list of ints from group-by. It is only allowed when querying group-by with one field.
+func (atgb *APITokenGroupBy) Ints(ctx context.Context) ([]int, error) {
+	if len(atgb.fields) > 1 {
+		return nil, errors.New("ent: APITokenGroupBy.Ints is not achievable when grouping more than 1 field")
+	}
+	var v []int
+	if err := atgb.Scan(ctx, &v); err != nil {
+		return nil, err
+	}
+	return v, nil
+}
+
+// IntsX is like Ints, but panics if an error occurs.
+func (atgb *APITokenGroupBy) IntsX(ctx context.Context) []int {
+	v, err := atgb.Ints(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Int returns a single int from group-by. It is only allowed when querying group-by with one field.
+func (atgb *APITokenGroupBy) Int(ctx context.Context) (_ int, err error) {
+	var v []int
+	if v, err = atgb.Ints(ctx); err != nil {
+		return
+	}
+	switch len(v) {
+	case 1:
+		return v[0], nil
+	case 0:
+		err = &NotFoundError{apitoken.Label}
+	default:
+		err = fmt.Errorf("ent: APITokenGroupBy.Ints returned %d results when one was expected", len(v))
+	}
+	return
+}
+
+// IntX is like Int, but panics if an error occurs.
+func (atgb *APITokenGroupBy) IntX(ctx context.Context) int {
+	v, err := atgb.Int(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Float64s returns list of float64s from group-by. It is only allowed when querying group-by with one field.
+func (atgb *APITokenGroupBy) Float64s(ctx context.Context) ([]float64, error) {
+	if len(atgb.fields) > 1 {
+		return nil, errors.New("ent: APITokenGroupBy.Float64s is not achievable when grouping more than 1 field")
+	}
+	var v []float64
+	if err := atgb.Scan(ctx, &v); err != nil {
+		return nil, err
+	}
+	return v, nil
+}
+
+// Float64sX is like Float64s, but panics if an error occurs.
+func (atgb *APITokenGroupBy) Float64sX(ctx context.Context) []float64 {
+	v, err := atgb.Float64s(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Float64 returns a single float64 from group-by. It is only allowed when querying group-by with one field.
+func (atgb *APITokenGroupBy) Float64(ctx context.Context) (_ float64, err error) {
+	var v []float64
+	if v, err = atgb.Float64s(ctx); err != nil {
+		return
+	}
+	switch len(v) {
+	case 1:
+		return v[0], nil
+	case 0:
+		err = &NotFoundError{apitoken.Label}
+	default:
+		err = fmt.Errorf("ent: APITokenGroupBy.Float64s returned %d results when one was expected", len(v))
+	}
+	return
+}
+
+// Float64X is like Float64, but panics if an error occurs.
+func (atgb *APITokenGroupBy) Float64X(ctx context.Context) float64 {
+	v, err := atgb.Float64(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Bools returns list of bools from group-by. It is only allowed when querying group-by with one field.
+func (atgb *APITokenGroupBy) Bools(ctx context.Context) ([]bool, error) {
+	if len(atgb.fields) > 1 {
+		return nil, errors.New("ent: APITokenGroupBy.Bools is not achievable when grouping more than 1 field")
+	}
+	var v []bool
+	if err := atgb.Scan(ctx, &v); err != nil {
+		return nil, err
+	}
+	return v, nil
+}
+
+// BoolsX is like Bools, but panics if an error occurs.
+func (atgb *APITokenGroupBy) BoolsX(ctx context.Context) []bool {
+	v, err := atgb.Bools(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Bool returns a single bool from group-by. It is only allowed when querying group-by with one field.
+func (atgb *APITokenGroupBy) Bool(ctx context.Context) (_ bool, err error) {
+	var v []bool
+	if v, err = atgb.Bools(ctx); err != nil {
+		return
+	}
+	switch len(v) {
+	case 1:
+		return v[0], nil
+	case 0:
+		err = &NotFoundError{apitoken.Label}
+	default:
+		err = fmt.Errorf("ent: APITokenGroupBy.Bools returned %d results when one was expected", len(v))
+	}
+	return
+}
+
+// BoolX is like Bool, but panics if an error occurs.
+func (atgb *APITokenGroupBy) BoolX(ctx context.Context) bool {
+	v, err := atgb.Bool(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+func (atgb *APITokenGroupBy) sqlScan(ctx context.Context, v interface{}) error {
+	for _, f := range atgb.fields {
+		if !apitoken.ValidColumn(f) {
+			return &ValidationError{Name: f, err: fmt.Errorf("invalid field %q for group-by", f)}
+		}
+	}
+	selector := atgb.sqlQuery()
+	if err := selector.Err(); err != nil {
+		return err
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := atgb.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
+
+func (atgb *APITokenGroupBy) sqlQuery() *sql.Selector {
+	selector := atgb.sql
+	columns := make([]string, 0, len(atgb.fields)+len(atgb.fns))
+	columns = append(columns, atgb.fields...)
+	for _, fn := range atgb.fns {
+		columns = append(columns, fn(selector, apitoken.ValidColumn))
+	}
+	return selector.Select(columns...).GroupBy(atgb.fields...)
+}
+
+// APITokenSelect is the builder for select fields of APIToken entities.
+type APITokenSelect struct {
+	config
+	fields []string
+	// intermediate query (i.e. traversal path).
+	sql  *sql.Selector
+	path func(context.Context) (*sql.Selector, error)
+}
+
+// Scan applies the selector query and scan the result into the given value.
+func (ats *APITokenSelect) Scan(ctx context.Context, v interface{}) error {
+	query, err := ats.path(ctx)
+	if err != nil {
+		return err
+	}
+	ats.sql = query
+	return ats.sqlScan(ctx, v)
+}
+
+// ScanX is like Scan, but panics if an error occurs.
+func (ats *APITokenSelect) ScanX(ctx context.Context, v interface{}) {
+	if err := ats.Scan(ctx, v); err != nil {
+		panic(err)
+	}
+}
+
+// Strings returns list of strings from selector. It is only allowed when selecting one field.
+func (ats *APITokenSelect) Strings(ctx context.Context) ([]string, error) {
+	if len(ats.fields) > 1 {
+		return nil, errors.New("ent: APITokenSelect.Strings is not achievable when selecting more than 1 field")
+	}
+	var v []string
+	if err := ats.Scan(ctx, &v); err != nil {
+		return nil, err
+	}
+	return v, nil
+}
+
+// StringsX is like Strings, but panics if an error occurs.
+func (ats *APITokenSelect) StringsX(ctx context.Context) []string {
+	v, err := ats.Strings(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// String returns a single string from selector. It is only allowed when selecting one field.
+func (ats *APITokenSelect) String(ctx context.Context) (_ string, err error) {
+	var v []string
+	if v, err = ats.Strings(ctx); err != nil {
+		return
+	}
+	switch len(v) {
+	case 1:
+		return v[0], nil
+	case 0:
+		err = &NotFoundError{apitoken.Label}
+	default:
+		err = fmt.Errorf("ent: APITokenSelect.Strings returned %d results when one was expected", len(v))
+	}
+	return
+}
+
+// StringX is like String, but panics if an error occurs.
+func (ats *APITokenSelect) StringX(ctx context.Context) string {
+	v, err := ats.String(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Ints returns list of ints from selector. It is only allowed when selecting one field.
+func (ats *APITokenSelect) Ints(ctx context.Context) ([]int, error) {
+	if len(ats.fields) > 1 {
+		return nil, errors.New("ent: APITokenSelect.Ints is not achievable when selecting more than 1 field")
+	}
+	var v []int
+	if err := ats.Scan(ctx, &v); err != nil {
+		return nil, err
+	}
+	return v, nil
+}
+
+// IntsX is like Ints, but panics if an error occurs.
+func (ats *APITokenSelect) IntsX(ctx context.Context) []int {
+	v, err := ats.Ints(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Int returns a single int from selector. It is only allowed when selecting one field.
+func (ats *APITokenSelect) Int(ctx context.Context) (_ int, err error) {
+	var v []int
+	if v, err = ats.Ints(ctx); err != nil {
+		return
+	}
+	switch len(v) {
+	case 1:
+		return v[0], nil
+	case 0:
+		err = &NotFoundError{apitoken.Label}
+	default:
+		err = fmt.Errorf("ent: APITokenSelect.Ints returned %d results when one was expected", len(v))
+	}
+	return
+}
+
+// IntX is like Int, but panics if an error occurs.
+func (ats *APITokenSelect) IntX(ctx context.Context) int {
+	v, err := ats.Int(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Float64s returns list of float64s from selector. It is only allowed when selecting one field.
+func (ats *APITokenSelect) Float64s(ctx context.Context) ([]float64, error) {
+	if len(ats.fields) > 1 {
+		return nil, errors.New("ent: APITokenSelect.Float64s is not achievable when selecting more than 1 field")
+	}
+	var v []float64
+	if err := ats.Scan(ctx, &v); err != nil {
+		return nil, err
+	}
+	return v, nil
+}
+
+// Float64sX is like Float64s, but panics if an error occurs.
+func (ats *APITokenSelect) Float64sX(ctx context.Context) []float64 {
+	v, err := ats.Float64s(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Float64 returns a single float64 from selector. It is only allowed when selecting one field.
+func (ats *APITokenSelect) Float64(ctx context.Context) (_ float64, err error) {
+	var v []float64
+	if v, err = ats.Float64s(ctx); err != nil {
+		return
+	}
+	switch len(v) {
+	case 1:
+		return v[0], nil
+	case 0:
+		err = &NotFoundError{apitoken.Label}
+	default:
+		err = fmt.Errorf("ent: APITokenSelect.Float64s returned %d results when one was expected", len(v))
+	}
+	return
+}
+
+// Float64X is like Float64, but panics if an error occurs.
+func (ats *APITokenSelect) Float64X(ctx context.Context) float64 {
+	v, err := ats.Float64(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Bools returns list of bools from selector. It is only allowed when selecting one field.
+func (ats *APITokenSelect) Bools(ctx context.Context) ([]bool, error) {
+	if len(ats.fields) > 1 {
+		return nil, errors.New("ent: APITokenSelect.Bools is not achievable when selecting more than 1 field")
+	}
+	var v []bool
+	if err := ats.Scan(ctx, &v); err != nil {
+		return nil, err
+	}
+	return v, nil
+}
+
+// BoolsX is like Bools, but panics if an error occurs.
+func (ats *APITokenSelect) BoolsX(ctx context.Context) []bool {
+	v, err := ats.Bools(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Bool returns a single bool from selector. It is only allowed when selecting one field.
+func (ats *APITokenSelect) Bool(ctx context.Context) (_ bool, err error) {
+	var v []bool
+	if v, err = ats.Bools(ctx); err != nil {
+		return
+	}
+	switch len(v) {
+	case 1:
+		return v[0], nil
+	case 0:
+		err = &NotFoundError{apitoken.Label}
+	default:
+		err = fmt.Errorf("ent: APITokenSelect.Bools returned %d results when one was expected", len(v))
+	}
+	return
+}
+
+// BoolX is like Bool, but panics if an error occurs.
+func (ats *APITokenSelect) BoolX(ctx context.Context) bool {
+	v, err := ats.Bool(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+func (ats *APITokenSelect) sqlScan(ctx context.Context, v interface{}) error {
+	for _, f := range ats.fields {
+		if !apitoken.ValidColumn(f) {
+			return &ValidationError{Name: f, err: fmt.Errorf("invalid field %q for selection", f)}
+		}
+	}
+	rows := &sql.Rows{}
+	query, args := ats.sqlQuery().Query()
+	if err := ats.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
+
+func (ats *APITokenSelect) sqlQuery() sql.Querier {
+	selector := ats.sql
+	selector.Select(selector.Columns(ats.fields...)...)
+	return selector
+}
@@ -0,0 +1,655 @@
+// Code generated by entc, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/facebook/ent/dialect/sql"
+	"github.com/facebook/ent/dialect/sql/sqlgraph"
+	"github.com/facebook/ent/schema/field"
+	"github.com/gobench-io/gobench/ent/apitoken"
+	"github.com/gobench-io/gobench/ent/predicate"
+	"github.com/gobench-io/gobench/ent/user"
+)
+
+// APITokenUpdate is the builder for updating APIToken entities.
+type APITokenUpdate struct {
+	config
+	hooks      []Hook
+	mutation   *APITokenMutation
+	predicates []predicate.APIToken
+}
+
+// Where adds a new predicate for the builder.
+func (atu *APITokenUpdate) Where(ps ...predicate.APIToken) *APITokenUpdate {
+	atu.predicates = append(atu.predicates, ps...)
+	return atu
+}
+
+// SetName sets the name field.
+func (atu *APITokenUpdate) SetName(s string) *APITokenUpdate {
+	atu.mutation.SetName(s)
+	return atu
+}
+
+// SetTokenHash sets the token_hash field.
+func (atu *APITokenUpdate) SetTokenHash(s string) *APITokenUpdate {
+	atu.mutation.SetTokenHash(s)
+	return atu
+}
+
+// SetPrefix sets the prefix field.
+func (atu *APITokenUpdate) SetPrefix(s string) *APITokenUpdate {
+	atu.mutation.SetPrefix(s)
+	return atu
+}
+
+// SetScope sets the scope field.
+func (atu *APITokenUpdate) SetScope(a apitoken.Scope) *APITokenUpdate {
+	atu.mutation.SetScope(a)
+	return atu
+}
+
+// SetNillableScope sets the scope field if the given value is not nil.
+func (atu *APITokenUpdate) SetNillableScope(a *apitoken.Scope) *APITokenUpdate {
+	if a != nil {
+		atu.SetScope(*a)
+	}
+	return atu
+}
+
+// SetLastUsedAt sets the last_used_at field.
+func (atu *APITokenUpdate) SetLastUsedAt(t time.Time) *APITokenUpdate {
+	atu.mutation.SetLastUsedAt(t)
+	return atu
+}
+
+// SetNillableLastUsedAt sets the last_used_at field if the given value is not nil.
+func (atu *APITokenUpdate) SetNillableLastUsedAt(t *time.Time) *APITokenUpdate {
+	if t != nil {
+		atu.SetLastUsedAt(*t)
+	}
+	return atu
+}
+
+// ClearLastUsedAt clears the value of last_used_at.
+func (atu *APITokenUpdate) ClearLastUsedAt() *APITokenUpdate {
+	atu.mutation.ClearLastUsedAt()
+	return atu
+}
+
+// SetExpiresAt sets the expires_at field.
+func (atu *APITokenUpdate) SetExpiresAt(t time.Time) *APITokenUpdate {
+	atu.mutation.SetExpiresAt(t)
+	return atu
+}
+
+// SetNillableExpiresAt sets the expires_at field if the given value is not nil.
+func (atu *APITokenUpdate) SetNillableExpiresAt(t *time.Time) *APITokenUpdate {
+	if t != nil {
+		atu.SetExpiresAt(*t)
+	}
+	return atu
+}
+
+// ClearExpiresAt clears the value of expires_at.
+func (atu *APITokenUpdate) ClearExpiresAt() *APITokenUpdate {
+	atu.mutation.ClearExpiresAt()
+	return atu
+}
+
+// SetCreatedAt sets the created_at field.
+func (atu *APITokenUpdate) SetCreatedAt(t time.Time) *APITokenUpdate {
+	atu.mutation.SetCreatedAt(t)
+	return atu
+}
+
+// SetNillableCreatedAt sets the created_at field if the given value is not nil.
+func (atu *APITokenUpdate) SetNillableCreatedAt(t *time.Time) *APITokenUpdate {
+	if t != nil {
+		atu.SetCreatedAt(*t)
+	}
+	return atu
+}
+
+// SetUserID sets the user edge to User by id.
+func (atu *APITokenUpdate) SetUserID(id int) *APITokenUpdate {
+	atu.mutation.SetUserID(id)
+	return atu
+}
+
+// SetUser sets the user edge to User.
+func (atu *APITokenUpdate) SetUser(u *User) *APITokenUpdate {
+	return atu.SetUserID(u.ID)
+}
+
+// Mutation returns the APITokenMutation object of the builder.
+func (atu *APITokenUpdate) Mutation() *APITokenMutation {
+	return atu.mutation
+}
+
+// ClearUser clears the "user" edge to type User.
+func (atu *APITokenUpdate) ClearUser() *APITokenUpdate {
+	atu.mutation.ClearUser()
+	return atu
+}
+
+// Save executes the query and returns the number of rows/vertices matched by this operation.
+func (atu *APITokenUpdate) Save(ctx context.Context) (int, error) {
+	var (
+		err      error
+		affected int
+	)
+	if len(atu.hooks) == 0 {
+		if err = atu.check(); err != nil {
+			return 0, err
+		}
+		affected, err = atu.sqlSave(ctx)
+	} else {
+		var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+			mutation, ok := m.(*APITokenMutation)
+			if !ok {
+				return nil, fmt.Errorf("unexpected mutation type %T", m)
+			}
+			if err = atu.check(); err != nil {
+				return 0, err
+			}
+			atu.mutation = mutation
+			affected, err = atu.sqlSave(ctx)
+			mutation.done = true
+			return affected, err
+		})
+		for i := len(atu.hooks) - 1; i >= 0; i-- {
+			mut = atu.hooks[i](mut)
+		}
+		if _, err := mut.Mutate(ctx, atu.mutation); err != nil {
+			return 0, err
+		}
+	}
+	return affected, err
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (atu *APITokenUpdate) SaveX(ctx context.Context) int {
+	affected, err := atu.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (atu *APITokenUpdate) Exec(ctx context.Context) error {
+	_, err := atu.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (atu *APITokenUpdate) ExecX(ctx context.Context) {
+	if err := atu.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (atu *APITokenUpdate) check() error {
+	if v, ok := atu.mutation.Name(); ok {
+		if err := apitoken.NameValidator(v); err != nil {
+			return &ValidationError{Name: "name", err: fmt.Errorf("ent: validator failed for field \"name\": %w", err)}
+		}
+	}
+	if v, ok := atu.mutation.Scope(); ok {
+		if err := apitoken.ScopeValidator(v); err != nil {
+			return &ValidationError{Name: "scope", err: fmt.Errorf("ent: validator failed for field \"scope\": %w", err)}
+		}
+	}
+	if _, ok := atu.mutation.UserID(); atu.mutation.UserCleared() && !ok {
+		return errors.New("ent: clearing a required unique edge \"user\"")
+	}
+	return nil
+}
+
+func (atu *APITokenUpdate) sqlSave(ctx context.Context) (n int, err error) {
+	_spec := &sqlgraph.UpdateSpec{
+		Node: &sqlgraph.NodeSpec{
+			Table:   apitoken.Table,
+			Columns: apitoken.Columns,
+			ID: &sqlgraph.FieldSpec{
+				Type:   field.TypeInt,
+				Column: apitoken.FieldID,
+			},
+		},
+	}
+	if ps := atu.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := atu.mutation.Name(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: apitoken.FieldName,
+		})
+	}
+	if value, ok := atu.mutation.TokenHash(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: apitoken.FieldTokenHash,
+		})
+	}
+	if value, ok := atu.mutation.Prefix(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: apitoken.FieldPrefix,
+		})
+	}
+	if value, ok := atu.mutation.Scope(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeEnum,
+			Value:  value,
+			Column: apitoken.FieldScope,
+		})
+	}
+	if value, ok := atu.mutation.LastUsedAt(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeTime,
+			Value:  value,
+			Column: apitoken.FieldLastUsedAt,
+		})
+	}
+	if atu.mutation.LastUsedAtCleared() {
+		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
+			Type:   field.TypeTime,
+			Column: apitoken.FieldLastUsedAt,
+		})
+	}
+	if value, ok := atu.mutation.ExpiresAt(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeTime,
+			Value:  value,
+			Column: apitoken.FieldExpiresAt,
+		})
+	}
+	if atu.mutation.ExpiresAtCleared() {
+		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
+			Type:   field.TypeTime,
+			Column: apitoken.FieldExpiresAt,
+		})
+	}
+	if value, ok := atu.mutation.CreatedAt(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeTime,
+			Value:  value,
+			Column: apitoken.FieldCreatedAt,
+		})
+	}
+	if atu.mutation.UserCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   apitoken.UserTable,
+			Columns: []string{apitoken.UserColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt,
+					Column: user.FieldID,
+				},
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := atu.mutation.UserIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   apitoken.UserTable,
+			Columns: []string{apitoken.UserColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt,
+					Column: user.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if n, err = sqlgraph.UpdateNodes(ctx, atu.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{apitoken.Label}
+		} else if cerr, ok := isSQLConstraintError(err); ok {
+			err = cerr
+		}
+		return 0, err
+	}
+	return n, nil
+}
+
+// APITokenUpdateOne is the builder for updating a single APIToken entity.
+type APITokenUpdateOne struct {
+	config
+	hooks    []Hook
+	mutation *APITokenMutation
+}
+
+// SetName sets the name field.
+func (atuo *APITokenUpdateOne) SetName(s string) *APITokenUpdateOne {
+	atuo.mutation.SetName(s)
+	return atuo
+}
+
+// SetTokenHash sets the token_hash field.
+func (atuo *APITokenUpdateOne) SetTokenHash(s string) *APITokenUpdateOne {
+	atuo.mutation.SetTokenHash(s)
+	return atuo
+}
+
+// SetPrefix sets the prefix field.
+func (atuo *APITokenUpdateOne) SetPrefix(s string) *APITokenUpdateOne {
+	atuo.mutation.SetPrefix(s)
+	return atuo
+}
+
+// SetScope sets the scope field.
+func (atuo *APITokenUpdateOne) SetScope(a apitoken.Scope) *APITokenUpdateOne {
+	atuo.mutation.SetScope(a)
+	return atuo
+}
+
+// SetNillableScope sets the scope field if the given value is not nil.
+func (atuo *APITokenUpdateOne) SetNillableScope(a *apitoken.Scope) *APITokenUpdateOne {
+	if a != nil {
+		atuo.SetScope(*a)
+	}
+	return atuo
+}
+
+// SetLastUsedAt sets the last_used_at field.
+func (atuo *APITokenUpdateOne) SetLastUsedAt(t time.Time) *APITokenUpdateOne {
+	atuo.mutation.SetLastUsedAt(t)
+	return atuo
+}
+
+// SetNillableLastUsedAt sets the last_used_at field if the given value is not nil.
+func (atuo *APITokenUpdateOne) SetNillableLastUsedAt(t *time.Time) *APITokenUpdateOne {
+	if t != nil {
+		atuo.SetLastUsedAt(*t)
+	}
+	return atuo
+}
+
+// ClearLastUsedAt clears the value of last_used_at.
+func (atuo *APITokenUpdateOne) ClearLastUsedAt() *APITokenUpdateOne {
+	atuo.mutation.ClearLastUsedAt()
+	return atuo
+}
+
+// SetExpiresAt sets the expires_at field.
+func (atuo *APITokenUpdateOne) SetExpiresAt(t time.Time) *APITokenUpdateOne {
+	atuo.mutation.SetExpiresAt(t)
+	return atuo
+}
+
+// SetNillableExpiresAt sets the expires_at field if the given value is not nil.
+func (atuo *APITokenUpdateOne) SetNillableExpiresAt(t *time.Time) *APITokenUpdateOne {
+	if t != nil {
+		atuo.SetExpiresAt(*t)
+	}
+	return atuo
+}
+
+// ClearExpiresAt clears the value of expires_at.
+func (atuo *APITokenUpdateOne) ClearExpiresAt() *APITokenUpdateOne {
+	atuo.mutation.ClearExpiresAt()
+	return atuo
+}
+
+// SetCreatedAt sets the created_at field.
+func (atuo *APITokenUpdateOne) SetCreatedAt(t time.Time) *APITokenUpdateOne {
+	atuo.mutation.SetCreatedAt(t)
+	return atuo
+}
+
+// SetNillableCreatedAt sets the created_at field if the given value is not nil.
+func (atuo *APITokenUpdateOne) SetNillableCreatedAt(t *time.Time) *APITokenUpdateOne {
+	if t != nil {
+		atuo.SetCreatedAt(*t)
+	}
+	return atuo
+}
+
+// SetUserID sets the user edge to User by id.
+func (atuo *APITokenUpdateOne) SetUserID(id int) *APITokenUpdateOne {
+	atuo.mutation.SetUserID(id)
+	return atuo
+}
+
+// SetUser sets the user edge to User.
+func (atuo *APITokenUpdateOne) SetUser(u *User) *APITokenUpdateOne {
+	return atuo.SetUserID(u.ID)
+}
+
+// Mutation returns the APITokenMutation object of the builder.
+func (atuo *APITokenUpdateOne) Mutation() *APITokenMutation {
+	return atuo.mutation
+}
+
+// ClearUser clears the "user" edge to type User.
+func (atuo *APITokenUpdateOne) ClearUser() *APITokenUpdateOne {
+	atuo.mutation.ClearUser()
+	return atuo
+}
+
+// Save executes the query and returns the updated entity.
+func (atuo *APITokenUpdateOne) Save(ctx context.Context) (*APIToken, error) {
+	var (
+		err  error
+		node *APIToken
+	)
+	if len(atuo.hooks) == 0 {
+		if err = atuo.check(); err != nil {
+			return nil, err
+		}
+		node, err = atuo.sqlSave(ctx)
+	} else {
+		var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+			mutation, ok := m.(*APITokenMutation)
+			if !ok {
+				return nil, fmt.Errorf("unexpected mutation type %T", m)
+			}
+			if err = atuo.check(); err != nil {
+				return nil, err
+			}
+			atuo.mutation = mutation
+			node, err = atuo.sqlSave(ctx)
+			mutation.done = true
+			return node, err
+		})
+		for i := len(atuo.hooks) - 1; i >= 0; i-- {
+			mut = atuo.hooks[i](mut)
+		}
+		if _, err := mut.Mutate(ctx, atuo.mutation); err != nil {
+			return nil, err
+		}
+	}
+	return node, err
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (atuo *APITokenUpdateOne) SaveX(ctx context.Context) *APIToken {
+	node, err := atuo.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (atuo *APITokenUpdateOne) Exec(ctx context.Context) error {
+	_, err := atuo.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (atuo *APITokenUpdateOne) ExecX(ctx context.Context) {
+	if err := atuo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (atuo *APITokenUpdateOne) check() error {
+	if v, ok := atuo.mutation.Name(); ok {
+		if err := apitoken.NameValidator(v); err != nil {
+			return &ValidationError{Name: "name", err: fmt.Errorf("ent: validator failed for field \"name\": %w", err)}
+		}
+	}
+	if v, ok := atuo.mutation.Scope(); ok {
+		if err := apitoken.ScopeValidator(v); err != nil {
+			return &ValidationError{Name: "scope", err: fmt.Errorf("ent: validator failed for field \"scope\": %w", err)}
+		}
+	}
+	if _, ok := atuo.mutation.UserID(); atuo.mutation.UserCleared() && !ok {
+		return errors.New("ent: clearing a required unique edge \"user\"")
+	}
+	return nil
+}
+
+func (atuo *APITokenUpdateOne) sqlSave(ctx context.Context) (_node *APIToken, err error) {
+	_spec := &sqlgraph.UpdateSpec{
+		Node: &sqlgraph.NodeSpec{
+			Table:   apitoken.Table,
+			Columns: apitoken.Columns,
+			ID: &sqlgraph.FieldSpec{
+				Type:   field.TypeInt,
+				Column: apitoken.FieldID,
+			},
+		},
+	}
+	id, ok := atuo.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "ID", err: fmt.Errorf("missing APIToken.ID for update")}
+	}
+	_spec.Node.ID.Value = id
+	if value, ok := atuo.mutation.Name(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: apitoken.FieldName,
+		})
+	}
+	if value, ok := atuo.mutation.TokenHash(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: apitoken.FieldTokenHash,
+		})
+	}
+	if value, ok := atuo.mutation.Prefix(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: apitoken.FieldPrefix,
+		})
+	}
+	if value, ok := atuo.mutation.Scope(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeEnum,
+			Value:  value,
+			Column: apitoken.FieldScope,
+		})
+	}
+	if value, ok := atuo.mutation.LastUsedAt(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeTime,
+			Value:  value,
+			Column: apitoken.FieldLastUsedAt,
+		})
+	}
+	if atuo.mutation.LastUsedAtCleared() {
+		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
+			Type:   field.TypeTime,
+			Column: apitoken.FieldLastUsedAt,
+		})
+	}
+	if value, ok := atuo.mutation.ExpiresAt(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeTime,
+			Value:  value,
+			Column: apitoken.FieldExpiresAt,
+		})
+	}
+	if atuo.mutation.ExpiresAtCleared() {
+		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
+			Type:   field.TypeTime,
+			Column: apitoken.FieldExpiresAt,
+		})
+	}
+	if value, ok := atuo.mutation.CreatedAt(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeTime,
+			Value:  value,
+			Column: apitoken.FieldCreatedAt,
+		})
+	}
+	if atuo.mutation.UserCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   apitoken.UserTable,
+			Columns: []string{apitoken.UserColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt,
+					Column: user.FieldID,
+				},
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := atuo.mutation.UserIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   apitoken.UserTable,
+			Columns: []string{apitoken.UserColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt,
+					Column: user.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_node = &APIToken{config: atuo.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues()
+	if err = sqlgraph.UpdateNode(ctx, atuo.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{apitoken.Label}
+		} else if cerr, ok := isSQLConstraintError(err); ok {
+			err = cerr
+		}
+		return nil, err
+	}
+	return _node, nil
+}
@@ -3,12 +3,16 @@
 package ent
 
 import (
+	"encoding/json"
 	"fmt"
 	"strings"
 	"time"
 
 	"github.com/facebook/ent/dialect/sql"
 	"github.com/gobench-io/gobench/ent/application"
+	"github.com/gobench-io/gobench/ent/project"
+	"github.com/gobench-io/gobench/sink"
+	"github.com/gobench-io/gobench/verdict"
 )
 
 // Application is the model entity for the Application schema.
@@ -26,15 +30,30 @@ type Application struct {
 	StartedAt time.Time `json:"started_at,omitempty"`
 	// UpdatedAt holds the value of the "updated_at" field.
 	UpdatedAt time.Time `json:"updated_at,omitempty"`
+	// FinishedAt holds the value of the "finished_at" field.
+	FinishedAt time.Time `json:"finished_at,omitempty"`
 	// Scenario holds the value of the "scenario" field.
 	Scenario string `json:"scenario,omitempty"`
 	// Gomod holds the value of the "gomod" field.
 	Gomod string `json:"gomod,omitempty"`
 	// Gosum holds the value of the "gosum" field.
 	Gosum string `json:"gosum,omitempty"`
+	// Sinks holds the value of the "sinks" field.
+	Sinks []sink.Config `json:"sinks,omitempty"`
+	// SinkTokens holds the value of the "sink_tokens" field.
+	SinkTokens string `json:"-"`
+	// Imported holds the value of the "imported" field.
+	Imported bool `json:"imported,omitempty"`
+	// Verdict holds the value of the "verdict" field.
+	Verdict string `json:"verdict,omitempty"`
+	// Checks holds the value of the "checks" field.
+	Checks []verdict.Check `json:"checks,omitempty"`
+	// BaselineAppID holds the value of the "baseline_app_id" field.
+	BaselineAppID int `json:"baseline_app_id,omitempty"`
 	// Edges holds the relations/edges for other nodes in the graph.
 	// The values are being populated by the ApplicationQuery when eager-loading is set.
-	Edges ApplicationEdges `json:"edges"`
+	Edges                ApplicationEdges `json:"edges"`
+	project_applications *int
 }
 
 // ApplicationEdges holds the relations/edges for other nodes in the graph.
@@ -43,9 +62,17 @@ type ApplicationEdges struct {
 	Groups []*Group
 	// Tags holds the value of the tags edge.
 	Tags []*Tag
+	// Baselines holds the value of the baselines edge.
+	Baselines []*Baseline
+	// Events holds the value of the events edge.
+	Events []*Event
+	// Executors holds the value of the executors edge.
+	Executors []*Executor
+	// Project holds the value of the project edge.
+	Project *Project
 	// loadedTypes holds the information for reporting if a
 	// type was loaded (or requested) in eager-loading or not.
-	loadedTypes [2]bool
+	loadedTypes [6]bool
 }
 
 // GroupsOrErr returns the Groups value or an error if the edge
@@ -66,6 +93,47 @@ func (e ApplicationEdges) TagsOrErr() ([]*Tag, error) {
 	return nil, &NotLoadedError{edge: "tags"}
 }
 
+// BaselinesOrErr returns the Baselines value or an error if the edge
+// was not loaded in eager-loading.
+func (e ApplicationEdges) BaselinesOrErr() ([]*Baseline, error) {
+	if e.loadedTypes[2] {
+		return e.Baselines, nil
+	}
+	return nil, &NotLoadedError{edge: "baselines"}
+}
+
+// EventsOrErr returns the Events value or an error if the edge
+// was not loaded in eager-loading.
+func (e ApplicationEdges) EventsOrErr() ([]*Event, error) {
+	if e.loadedTypes[3] {
+		return e.Events, nil
+	}
+	return nil, &NotLoadedError{edge: "events"}
+}
+
+// ExecutorsOrErr returns the Executors value or an error if the edge
+// was not loaded in eager-loading.
+func (e ApplicationEdges) ExecutorsOrErr() ([]*Executor, error) {
+	if e.loadedTypes[4] {
+		return e.Executors, nil
+	}
+	return nil, &NotLoadedError{edge: "executors"}
+}
+
+// ProjectOrErr returns the Project value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e ApplicationEdges) ProjectOrErr() (*Project, error) {
+	if e.loadedTypes[5] {
+		if e.Project == nil {
+			// The edge project was loaded in eager-loading,
+			// but was not found.
+			return nil, &NotFoundError{label: project.Label}
+		}
+		return e.Project, nil
+	}
+	return nil, &NotLoadedError{edge: "project"}
+}
+
 // scanValues returns the types for scanning values from sql.Rows.
 func (*Application) scanValues() []interface{} {
 	return []interface{}{
@@ -75,9 +143,23 @@ func (*Application) scanValues() []interface{} {
 		&sql.NullTime{},   // created_at
 		&sql.NullTime{},   // started_at
 		&sql.NullTime{},   // updated_at
+		&sql.NullTime{},   // finished_at
 		&sql.NullString{}, // scenario
 		&sql.NullString{}, // gomod
 		&sql.NullString{}, // gosum
+		&[]byte{},         // sinks
+		&sql.NullString{}, // sink_tokens
+		&sql.NullBool{},   // imported
+		&sql.NullString{}, // verdict
+		&[]byte{},         // checks
+		&sql.NullInt64{},  // baseline_app_id
+	}
+}
+
+// fkValues returns the types for scanning foreign-keys values from sql.Rows.
+func (*Application) fkValues() []interface{} {
+	return []interface{}{
+		&sql.NullInt64{}, // project_applications
 	}
 }
 
@@ -118,21 +200,71 @@ func (a *Application) assignValues(values ...interface{}) error {
 	} else if value.Valid {
 		a.UpdatedAt = value.Time
 	}
-	if value, ok := values[5].(*sql.NullString); !ok {
-		return fmt.Errorf("unexpected type %T for field scenario", values[5])
+	if value, ok := values[5].(*sql.NullTime); !ok {
+		return fmt.Errorf("unexpected type %T for field finished_at", values[5])
 	} else if value.Valid {
-		a.Scenario = value.String
+		a.FinishedAt = value.Time
 	}
 	if value, ok := values[6].(*sql.NullString); !ok {
-		return fmt.Errorf("unexpected type %T for field gomod", values[6])
+		return fmt.Errorf("unexpected type %T for field scenario", values[6])
 	} else if value.Valid {
-		a.Gomod = value.String
+		a.Scenario = value.String
 	}
 	if value, ok := values[7].(*sql.NullString); !ok {
-		return fmt.Errorf("unexpected type %T for field gosum", values[7])
+		return fmt.Errorf("unexpected type %T for field gomod", values[7])
+	} else if value.Valid {
+		a.Gomod = value.String
+	}
+	if value, ok := values[8].(*sql.NullString); !ok {
+		return fmt.Errorf("unexpected type %T for field gosum", values[8])
 	} else if value.Valid {
 		a.Gosum = value.String
 	}
+
+	if value, ok := values[9].(*[]byte); !ok {
+		return fmt.Errorf("unexpected type %T for field sinks", values[9])
+	} else if value != nil && len(*value) > 0 {
+		if err := json.Unmarshal(*value, &a.Sinks); err != nil {
+			return fmt.Errorf("unmarshal field sinks: %v", err)
+		}
+	}
+	if value, ok := values[10].(*sql.NullString); !ok {
+		return fmt.Errorf("unexpected type %T for field sink_tokens", values[10])
+	} else if value.Valid {
+		a.SinkTokens = value.String
+	}
+	if value, ok := values[11].(*sql.NullBool); !ok {
+		return fmt.Errorf("unexpected type %T for field imported", values[11])
+	} else if value.Valid {
+		a.Imported = value.Bool
+	}
+	if value, ok := values[12].(*sql.NullString); !ok {
+		return fmt.Errorf("unexpected type %T for field verdict", values[12])
+	} else if value.Valid {
+		a.Verdict = value.String
+	}
+
+	if value, ok := values[13].(*[]byte); !ok {
+		return fmt.Errorf("unexpected type %T for field checks", values[13])
+	} else if value != nil && len(*value) > 0 {
+		if err := json.Unmarshal(*value, &a.Checks); err != nil {
+			return fmt.Errorf("unmarshal field checks: %v", err)
+		}
+	}
+	if value, ok := values[14].(*sql.NullInt64); !ok {
+		return fmt.Errorf("unexpected type %T for field baseline_app_id", values[14])
+	} else if value.Valid {
+		a.BaselineAppID = int(value.Int64)
+	}
+	values = values[15:]
+	if len(values) == len(application.ForeignKeys) {
+		if value, ok := values[0].(*sql.NullInt64); !ok {
+			return fmt.Errorf("unexpected type %T for edge-field project_applications", value)
+		} else if value.Valid {
+			a.project_applications = new(int)
+			*a.project_applications = int(value.Int64)
+		}
+	}
 	return nil
 }
 
@@ -146,6 +278,26 @@ func (a *Application) QueryTags() *TagQuery {
 	return (&ApplicationClient{config: a.config}).QueryTags(a)
 }
 
+// QueryBaselines queries the baselines edge of the Application.
+func (a *Application) QueryBaselines() *BaselineQuery {
+	return (&ApplicationClient{config: a.config}).QueryBaselines(a)
+}
+
+// QueryEvents queries the events edge of the Application.
+func (a *Application) QueryEvents() *EventQuery {
+	return (&ApplicationClient{config: a.config}).QueryEvents(a)
+}
+
+// QueryExecutors queries the executors edge of the Application.
+func (a *Application) QueryExecutors() *ExecutorQuery {
+	return (&ApplicationClient{config: a.config}).QueryExecutors(a)
+}
+
+// QueryProject queries the project edge of the Application.
+func (a *Application) QueryProject() *ProjectQuery {
+	return (&ApplicationClient{config: a.config}).QueryProject(a)
+}
+
 // Update returns a builder for updating this Application.
 // Note that, you need to call Application.Unwrap() before calling this method, if this Application
 // was returned from a transaction, and the transaction was committed or rolled back.
@@ -179,12 +331,25 @@ func (a *Application) String() string {
 	builder.WriteString(a.StartedAt.Format(time.ANSIC))
 	builder.WriteString(", updated_at=")
 	builder.WriteString(a.UpdatedAt.Format(time.ANSIC))
+	builder.WriteString(", finished_at=")
+	builder.WriteString(a.FinishedAt.Format(time.ANSIC))
 	builder.WriteString(", scenario=")
 	builder.WriteString(a.Scenario)
 	builder.WriteString(", gomod=")
 	builder.WriteString(a.Gomod)
 	builder.WriteString(", gosum=")
 	builder.WriteString(a.Gosum)
+	builder.WriteString(", sinks=")
+	builder.WriteString(fmt.Sprintf("%v", a.Sinks))
+	builder.WriteString(", sink_tokens=<sensitive>")
+	builder.WriteString(", imported=")
+	builder.WriteString(fmt.Sprintf("%v", a.Imported))
+	builder.WriteString(", verdict=")
+	builder.WriteString(a.Verdict)
+	builder.WriteString(", checks=")
+	builder.WriteString(fmt.Sprintf("%v", a.Checks))
+	builder.WriteString(", baseline_app_id=")
+	builder.WriteString(fmt.Sprintf("%v", a.BaselineAppID))
 	builder.WriteByte(')')
 	return builder.String()
 }
@@ -21,17 +21,39 @@ const (
 	FieldStartedAt = "started_at"
 	// FieldUpdatedAt holds the string denoting the updated_at field in the database.
 	FieldUpdatedAt = "updated_at"
+	// FieldFinishedAt holds the string denoting the finished_at field in the database.
+	FieldFinishedAt = "finished_at"
 	// FieldScenario holds the string denoting the scenario field in the database.
 	FieldScenario = "scenario"
 	// FieldGomod holds the string denoting the gomod field in the database.
 	FieldGomod = "gomod"
 	// FieldGosum holds the string denoting the gosum field in the database.
 	FieldGosum = "gosum"
+	// FieldSinks holds the string denoting the sinks field in the database.
+	FieldSinks = "sinks"
+	// FieldSinkTokens holds the string denoting the sink_tokens field in the database.
+	FieldSinkTokens = "sink_tokens"
+	// FieldImported holds the string denoting the imported field in the database.
+	FieldImported = "imported"
+	// FieldVerdict holds the string denoting the verdict field in the database.
+	FieldVerdict = "verdict"
+	// FieldChecks holds the string denoting the checks field in the database.
+	FieldChecks = "checks"
+	// FieldBaselineAppID holds the string denoting the baseline_app_id field in the database.
+	FieldBaselineAppID = "baseline_app_id"
 
 	// EdgeGroups holds the string denoting the groups edge name in mutations.
 	EdgeGroups = "groups"
 	// EdgeTags holds the string denoting the tags edge name in mutations.
 	EdgeTags = "tags"
+	// EdgeBaselines holds the string denoting the baselines edge name in mutations.
+	EdgeBaselines = "baselines"
+	// EdgeEvents holds the string denoting the events edge name in mutations.
+	EdgeEvents = "events"
+	// EdgeExecutors holds the string denoting the executors edge name in mutations.
+	EdgeExecutors = "executors"
+	// EdgeProject holds the string denoting the project edge name in mutations.
+	EdgeProject = "project"
 
 	// Table holds the table name of the application in the database.
 	Table = "applications"
@@ -49,6 +71,34 @@ const (
 	TagsInverseTable = "tags"
 	// TagsColumn is the table column denoting the tags relation/edge.
 	TagsColumn = "application_tags"
+	// BaselinesTable is the table the holds the baselines relation/edge.
+	BaselinesTable = "baselines"
+	// BaselinesInverseTable is the table name for the Baseline entity.
+	// It exists in this package in order to avoid circular dependency with the "baseline" package.
+	BaselinesInverseTable = "baselines"
+	// BaselinesColumn is the table column denoting the baselines relation/edge.
+	BaselinesColumn = "application_baselines"
+	// EventsTable is the table the holds the events relation/edge.
+	EventsTable = "events"
+	// EventsInverseTable is the table name for the Event entity.
+	// It exists in this package in order to avoid circular dependency with the "event" package.
+	EventsInverseTable = "events"
+	// EventsColumn is the table column denoting the events relation/edge.
+	EventsColumn = "application_events"
+	// ExecutorsTable is the table the holds the executors relation/edge.
+	ExecutorsTable = "executors"
+	// ExecutorsInverseTable is the table name for the Executor entity.
+	// It exists in this package in order to avoid circular dependency with the "executor" package.
+	ExecutorsInverseTable = "executors"
+	// ExecutorsColumn is the table column denoting the executors relation/edge.
+	ExecutorsColumn = "application_executors"
+	// ProjectTable is the table the holds the project relation/edge.
+	ProjectTable = "applications"
+	// ProjectInverseTable is the table name for the Project entity.
+	// It exists in this package in order to avoid circular dependency with the "project" package.
+	ProjectInverseTable = "projects"
+	// ProjectColumn is the table column denoting the project relation/edge.
+	ProjectColumn = "project_applications"
 )
 
 // Columns holds all SQL columns for application fields.
@@ -59,9 +109,21 @@ var Columns = []string{
 	FieldCreatedAt,
 	FieldStartedAt,
 	FieldUpdatedAt,
+	FieldFinishedAt,
 	FieldScenario,
 	FieldGomod,
 	FieldGosum,
+	FieldSinks,
+	FieldSinkTokens,
+	FieldImported,
+	FieldVerdict,
+	FieldChecks,
+	FieldBaselineAppID,
+}
+
+// ForeignKeys holds the SQL foreign-keys that are owned by the Application type.
+var ForeignKeys = []string{
+	"project_applications",
 }
 
 // ValidColumn reports if the column name is valid (part of the table columns).
@@ -71,6 +133,11 @@ func ValidColumn(column string) bool {
 			return true
 		}
 	}
+	for i := range ForeignKeys {
+		if column == ForeignKeys[i] {
+			return true
+		}
+	}
 	return false
 }
 
@@ -85,4 +152,6 @@ var (
 	DefaultGomod string
 	// DefaultGosum holds the default value on creation for the gosum field.
 	DefaultGosum string
+	// DefaultImported holds the default value on creation for the imported field.
+	DefaultImported bool
 )
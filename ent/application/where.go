@@ -128,6 +128,13 @@ func UpdatedAt(v time.Time) predicate.Application {
 	})
 }
 
+// FinishedAt applies equality check predicate on the "finished_at" field. It's identical to FinishedAtEQ.
+func FinishedAt(v time.Time) predicate.Application {
+	return predicate.Application(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldFinishedAt), v))
+	})
+}
+
 // Scenario applies equality check predicate on the "scenario" field. It's identical to ScenarioEQ.
 func Scenario(v string) predicate.Application {
 	return predicate.Application(func(s *sql.Selector) {
@@ -149,6 +156,34 @@ func Gosum(v string) predicate.Application {
 	})
 }
 
+// SinkTokens applies equality check predicate on the "sink_tokens" field. It's identical to SinkTokensEQ.
+func SinkTokens(v string) predicate.Application {
+	return predicate.Application(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldSinkTokens), v))
+	})
+}
+
+// Imported applies equality check predicate on the "imported" field. It's identical to ImportedEQ.
+func Imported(v bool) predicate.Application {
+	return predicate.Application(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldImported), v))
+	})
+}
+
+// Verdict applies equality check predicate on the "verdict" field. It's identical to VerdictEQ.
+func Verdict(v string) predicate.Application {
+	return predicate.Application(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldVerdict), v))
+	})
+}
+
+// BaselineAppID applies equality check predicate on the "baseline_app_id" field. It's identical to BaselineAppIDEQ.
+func BaselineAppID(v int) predicate.Application {
+	return predicate.Application(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldBaselineAppID), v))
+	})
+}
+
 // NameEQ applies the EQ predicate on the "name" field.
 func NameEQ(v string) predicate.Application {
 	return predicate.Application(func(s *sql.Selector) {
@@ -613,6 +648,96 @@ func UpdatedAtLTE(v time.Time) predicate.Application {
 	})
 }
 
+// FinishedAtEQ applies the EQ predicate on the "finished_at" field.
+func FinishedAtEQ(v time.Time) predicate.Application {
+	return predicate.Application(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldFinishedAt), v))
+	})
+}
+
+// FinishedAtNEQ applies the NEQ predicate on the "finished_at" field.
+func FinishedAtNEQ(v time.Time) predicate.Application {
+	return predicate.Application(func(s *sql.Selector) {
+		s.Where(sql.NEQ(s.C(FieldFinishedAt), v))
+	})
+}
+
+// FinishedAtIn applies the In predicate on the "finished_at" field.
+func FinishedAtIn(vs ...time.Time) predicate.Application {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.Application(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.In(s.C(FieldFinishedAt), v...))
+	})
+}
+
+// FinishedAtNotIn applies the NotIn predicate on the "finished_at" field.
+func FinishedAtNotIn(vs ...time.Time) predicate.Application {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.Application(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.NotIn(s.C(FieldFinishedAt), v...))
+	})
+}
+
+// FinishedAtGT applies the GT predicate on the "finished_at" field.
+func FinishedAtGT(v time.Time) predicate.Application {
+	return predicate.Application(func(s *sql.Selector) {
+		s.Where(sql.GT(s.C(FieldFinishedAt), v))
+	})
+}
+
+// FinishedAtGTE applies the GTE predicate on the "finished_at" field.
+func FinishedAtGTE(v time.Time) predicate.Application {
+	return predicate.Application(func(s *sql.Selector) {
+		s.Where(sql.GTE(s.C(FieldFinishedAt), v))
+	})
+}
+
+// FinishedAtLT applies the LT predicate on the "finished_at" field.
+func FinishedAtLT(v time.Time) predicate.Application {
+	return predicate.Application(func(s *sql.Selector) {
+		s.Where(sql.LT(s.C(FieldFinishedAt), v))
+	})
+}
+
+// FinishedAtLTE applies the LTE predicate on the "finished_at" field.
+func FinishedAtLTE(v time.Time) predicate.Application {
+	return predicate.Application(func(s *sql.Selector) {
+		s.Where(sql.LTE(s.C(FieldFinishedAt), v))
+	})
+}
+
+// FinishedAtIsNil applies the IsNil predicate on the "finished_at" field.
+func FinishedAtIsNil() predicate.Application {
+	return predicate.Application(func(s *sql.Selector) {
+		s.Where(sql.IsNull(s.C(FieldFinishedAt)))
+	})
+}
+
+// FinishedAtNotNil applies the NotNil predicate on the "finished_at" field.
+func FinishedAtNotNil() predicate.Application {
+	return predicate.Application(func(s *sql.Selector) {
+		s.Where(sql.NotNull(s.C(FieldFinishedAt)))
+	})
+}
+
 // ScenarioEQ applies the EQ predicate on the "scenario" field.
 func ScenarioEQ(v string) predicate.Application {
 	return predicate.Application(func(s *sql.Selector) {
@@ -946,6 +1071,402 @@ func GosumContainsFold(v string) predicate.Application {
 	})
 }
 
+// SinksIsNil applies the IsNil predicate on the "sinks" field.
+func SinksIsNil() predicate.Application {
+	return predicate.Application(func(s *sql.Selector) {
+		s.Where(sql.IsNull(s.C(FieldSinks)))
+	})
+}
+
+// SinksNotNil applies the NotNil predicate on the "sinks" field.
+func SinksNotNil() predicate.Application {
+	return predicate.Application(func(s *sql.Selector) {
+		s.Where(sql.NotNull(s.C(FieldSinks)))
+	})
+}
+
+// SinkTokensEQ applies the EQ predicate on the "sink_tokens" field.
+func SinkTokensEQ(v string) predicate.Application {
+	return predicate.Application(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldSinkTokens), v))
+	})
+}
+
+// SinkTokensNEQ applies the NEQ predicate on the "sink_tokens" field.
+func SinkTokensNEQ(v string) predicate.Application {
+	return predicate.Application(func(s *sql.Selector) {
+		s.Where(sql.NEQ(s.C(FieldSinkTokens), v))
+	})
+}
+
+// SinkTokensIn applies the In predicate on the "sink_tokens" field.
+func SinkTokensIn(vs ...string) predicate.Application {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.Application(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.In(s.C(FieldSinkTokens), v...))
+	})
+}
+
+// SinkTokensNotIn applies the NotIn predicate on the "sink_tokens" field.
+func SinkTokensNotIn(vs ...string) predicate.Application {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.Application(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.NotIn(s.C(FieldSinkTokens), v...))
+	})
+}
+
+// SinkTokensGT applies the GT predicate on the "sink_tokens" field.
+func SinkTokensGT(v string) predicate.Application {
+	return predicate.Application(func(s *sql.Selector) {
+		s.Where(sql.GT(s.C(FieldSinkTokens), v))
+	})
+}
+
+// SinkTokensGTE applies the GTE predicate on the "sink_tokens" field.
+func SinkTokensGTE(v string) predicate.Application {
+	return predicate.Application(func(s *sql.Selector) {
+		s.Where(sql.GTE(s.C(FieldSinkTokens), v))
+	})
+}
+
+// SinkTokensLT applies the LT predicate on the "sink_tokens" field.
+func SinkTokensLT(v string) predicate.Application {
+	return predicate.Application(func(s *sql.Selector) {
+		s.Where(sql.LT(s.C(FieldSinkTokens), v))
+	})
+}
+
+// SinkTokensLTE applies the LTE predicate on the "sink_tokens" field.
+func SinkTokensLTE(v string) predicate.Application {
+	return predicate.Application(func(s *sql.Selector) {
+		s.Where(sql.LTE(s.C(FieldSinkTokens), v))
+	})
+}
+
+// SinkTokensContains applies the Contains predicate on the "sink_tokens" field.
+func SinkTokensContains(v string) predicate.Application {
+	return predicate.Application(func(s *sql.Selector) {
+		s.Where(sql.Contains(s.C(FieldSinkTokens), v))
+	})
+}
+
+// SinkTokensHasPrefix applies the HasPrefix predicate on the "sink_tokens" field.
+func SinkTokensHasPrefix(v string) predicate.Application {
+	return predicate.Application(func(s *sql.Selector) {
+		s.Where(sql.HasPrefix(s.C(FieldSinkTokens), v))
+	})
+}
+
+// SinkTokensHasSuffix applies the HasSuffix predicate on the "sink_tokens" field.
+func SinkTokensHasSuffix(v string) predicate.Application {
+	return predicate.Application(func(s *sql.Selector) {
+		s.Where(sql.HasSuffix(s.C(FieldSinkTokens), v))
+	})
+}
+
+// SinkTokensIsNil applies the IsNil predicate on the "sink_tokens" field.
+func SinkTokensIsNil() predicate.Application {
+	return predicate.Application(func(s *sql.Selector) {
+		s.Where(sql.IsNull(s.C(FieldSinkTokens)))
+	})
+}
+
+// SinkTokensNotNil applies the NotNil predicate on the "sink_tokens" field.
+func SinkTokensNotNil() predicate.Application {
+	return predicate.Application(func(s *sql.Selector) {
+		s.Where(sql.NotNull(s.C(FieldSinkTokens)))
+	})
+}
+
+// SinkTokensEqualFold applies the EqualFold predicate on the "sink_tokens" field.
+func SinkTokensEqualFold(v string) predicate.Application {
+	return predicate.Application(func(s *sql.Selector) {
+		s.Where(sql.EqualFold(s.C(FieldSinkTokens), v))
+	})
+}
+
+// SinkTokensContainsFold applies the ContainsFold predicate on the "sink_tokens" field.
+func SinkTokensContainsFold(v string) predicate.Application {
+	return predicate.Application(func(s *sql.Selector) {
+		s.Where(sql.ContainsFold(s.C(FieldSinkTokens), v))
+	})
+}
+
+// ImportedEQ applies the EQ predicate on the "imported" field.
+func ImportedEQ(v bool) predicate.Application {
+	return predicate.Application(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldImported), v))
+	})
+}
+
+// ImportedNEQ applies the NEQ predicate on the "imported" field.
+func ImportedNEQ(v bool) predicate.Application {
+	return predicate.Application(func(s *sql.Selector) {
+		s.Where(sql.NEQ(s.C(FieldImported), v))
+	})
+}
+
+// ImportedIsNil applies the IsNil predicate on the "imported" field.
+func ImportedIsNil() predicate.Application {
+	return predicate.Application(func(s *sql.Selector) {
+		s.Where(sql.IsNull(s.C(FieldImported)))
+	})
+}
+
+// ImportedNotNil applies the NotNil predicate on the "imported" field.
+func ImportedNotNil() predicate.Application {
+	return predicate.Application(func(s *sql.Selector) {
+		s.Where(sql.NotNull(s.C(FieldImported)))
+	})
+}
+
+// VerdictEQ applies the EQ predicate on the "verdict" field.
+func VerdictEQ(v string) predicate.Application {
+	return predicate.Application(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldVerdict), v))
+	})
+}
+
+// VerdictNEQ applies the NEQ predicate on the "verdict" field.
+func VerdictNEQ(v string) predicate.Application {
+	return predicate.Application(func(s *sql.Selector) {
+		s.Where(sql.NEQ(s.C(FieldVerdict), v))
+	})
+}
+
+// VerdictIn applies the In predicate on the "verdict" field.
+func VerdictIn(vs ...string) predicate.Application {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.Application(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.In(s.C(FieldVerdict), v...))
+	})
+}
+
+// VerdictNotIn applies the NotIn predicate on the "verdict" field.
+func VerdictNotIn(vs ...string) predicate.Application {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.Application(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.NotIn(s.C(FieldVerdict), v...))
+	})
+}
+
+// VerdictGT applies the GT predicate on the "verdict" field.
+func VerdictGT(v string) predicate.Application {
+	return predicate.Application(func(s *sql.Selector) {
+		s.Where(sql.GT(s.C(FieldVerdict), v))
+	})
+}
+
+// VerdictGTE applies the GTE predicate on the "verdict" field.
+func VerdictGTE(v string) predicate.Application {
+	return predicate.Application(func(s *sql.Selector) {
+		s.Where(sql.GTE(s.C(FieldVerdict), v))
+	})
+}
+
+// VerdictLT applies the LT predicate on the "verdict" field.
+func VerdictLT(v string) predicate.Application {
+	return predicate.Application(func(s *sql.Selector) {
+		s.Where(sql.LT(s.C(FieldVerdict), v))
+	})
+}
+
+// VerdictLTE applies the LTE predicate on the "verdict" field.
+func VerdictLTE(v string) predicate.Application {
+	return predicate.Application(func(s *sql.Selector) {
+		s.Where(sql.LTE(s.C(FieldVerdict), v))
+	})
+}
+
+// VerdictContains applies the Contains predicate on the "verdict" field.
+func VerdictContains(v string) predicate.Application {
+	return predicate.Application(func(s *sql.Selector) {
+		s.Where(sql.Contains(s.C(FieldVerdict), v))
+	})
+}
+
+// VerdictHasPrefix applies the HasPrefix predicate on the "verdict" field.
+func VerdictHasPrefix(v string) predicate.Application {
+	return predicate.Application(func(s *sql.Selector) {
+		s.Where(sql.HasPrefix(s.C(FieldVerdict), v))
+	})
+}
+
+// VerdictHasSuffix applies the HasSuffix predicate on the "verdict" field.
+func VerdictHasSuffix(v string) predicate.Application {
+	return predicate.Application(func(s *sql.Selector) {
+		s.Where(sql.HasSuffix(s.C(FieldVerdict), v))
+	})
+}
+
+// VerdictIsNil applies the IsNil predicate on the "verdict" field.
+func VerdictIsNil() predicate.Application {
+	return predicate.Application(func(s *sql.Selector) {
+		s.Where(sql.IsNull(s.C(FieldVerdict)))
+	})
+}
+
+// VerdictNotNil applies the NotNil predicate on the "verdict" field.
+func VerdictNotNil() predicate.Application {
+	return predicate.Application(func(s *sql.Selector) {
+		s.Where(sql.NotNull(s.C(FieldVerdict)))
+	})
+}
+
+// VerdictEqualFold applies the EqualFold predicate on the "verdict" field.
+func VerdictEqualFold(v string) predicate.Application {
+	return predicate.Application(func(s *sql.Selector) {
+		s.Where(sql.EqualFold(s.C(FieldVerdict), v))
+	})
+}
+
+// VerdictContainsFold applies the ContainsFold predicate on the "verdict" field.
+func VerdictContainsFold(v string) predicate.Application {
+	return predicate.Application(func(s *sql.Selector) {
+		s.Where(sql.ContainsFold(s.C(FieldVerdict), v))
+	})
+}
+
+// ChecksIsNil applies the IsNil predicate on the "checks" field.
+func ChecksIsNil() predicate.Application {
+	return predicate.Application(func(s *sql.Selector) {
+		s.Where(sql.IsNull(s.C(FieldChecks)))
+	})
+}
+
+// ChecksNotNil applies the NotNil predicate on the "checks" field.
+func ChecksNotNil() predicate.Application {
+	return predicate.Application(func(s *sql.Selector) {
+		s.Where(sql.NotNull(s.C(FieldChecks)))
+	})
+}
+
+// BaselineAppIDEQ applies the EQ predicate on the "baseline_app_id" field.
+func BaselineAppIDEQ(v int) predicate.Application {
+	return predicate.Application(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldBaselineAppID), v))
+	})
+}
+
+// BaselineAppIDNEQ applies the NEQ predicate on the "baseline_app_id" field.
+func BaselineAppIDNEQ(v int) predicate.Application {
+	return predicate.Application(func(s *sql.Selector) {
+		s.Where(sql.NEQ(s.C(FieldBaselineAppID), v))
+	})
+}
+
+// BaselineAppIDIn applies the In predicate on the "baseline_app_id" field.
+func BaselineAppIDIn(vs ...int) predicate.Application {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.Application(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.In(s.C(FieldBaselineAppID), v...))
+	})
+}
+
+// BaselineAppIDNotIn applies the NotIn predicate on the "baseline_app_id" field.
+func BaselineAppIDNotIn(vs ...int) predicate.Application {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.Application(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.NotIn(s.C(FieldBaselineAppID), v...))
+	})
+}
+
+// BaselineAppIDGT applies the GT predicate on the "baseline_app_id" field.
+func BaselineAppIDGT(v int) predicate.Application {
+	return predicate.Application(func(s *sql.Selector) {
+		s.Where(sql.GT(s.C(FieldBaselineAppID), v))
+	})
+}
+
+// BaselineAppIDGTE applies the GTE predicate on the "baseline_app_id" field.
+func BaselineAppIDGTE(v int) predicate.Application {
+	return predicate.Application(func(s *sql.Selector) {
+		s.Where(sql.GTE(s.C(FieldBaselineAppID), v))
+	})
+}
+
+// BaselineAppIDLT applies the LT predicate on the "baseline_app_id" field.
+func BaselineAppIDLT(v int) predicate.Application {
+	return predicate.Application(func(s *sql.Selector) {
+		s.Where(sql.LT(s.C(FieldBaselineAppID), v))
+	})
+}
+
+// BaselineAppIDLTE applies the LTE predicate on the "baseline_app_id" field.
+func BaselineAppIDLTE(v int) predicate.Application {
+	return predicate.Application(func(s *sql.Selector) {
+		s.Where(sql.LTE(s.C(FieldBaselineAppID), v))
+	})
+}
+
+// BaselineAppIDIsNil applies the IsNil predicate on the "baseline_app_id" field.
+func BaselineAppIDIsNil() predicate.Application {
+	return predicate.Application(func(s *sql.Selector) {
+		s.Where(sql.IsNull(s.C(FieldBaselineAppID)))
+	})
+}
+
+// BaselineAppIDNotNil applies the NotNil predicate on the "baseline_app_id" field.
+func BaselineAppIDNotNil() predicate.Application {
+	return predicate.Application(func(s *sql.Selector) {
+		s.Where(sql.NotNull(s.C(FieldBaselineAppID)))
+	})
+}
+
 // HasGroups applies the HasEdge predicate on the "groups" edge.
 func HasGroups() predicate.Application {
 	return predicate.Application(func(s *sql.Selector) {
@@ -1002,6 +1523,118 @@ func HasTagsWith(preds ...predicate.Tag) predicate.Application {
 	})
 }
 
+// HasBaselines applies the HasEdge predicate on the "baselines" edge.
+func HasBaselines() predicate.Application {
+	return predicate.Application(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.To(BaselinesTable, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, BaselinesTable, BaselinesColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasBaselinesWith applies the HasEdge predicate on the "baselines" edge with a given conditions (other predicates).
+func HasBaselinesWith(preds ...predicate.Baseline) predicate.Application {
+	return predicate.Application(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.To(BaselinesInverseTable, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, BaselinesTable, BaselinesColumn),
+		)
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasEvents applies the HasEdge predicate on the "events" edge.
+func HasEvents() predicate.Application {
+	return predicate.Application(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.To(EventsTable, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, EventsTable, EventsColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasEventsWith applies the HasEdge predicate on the "events" edge with a given conditions (other predicates).
+func HasEventsWith(preds ...predicate.Event) predicate.Application {
+	return predicate.Application(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.To(EventsInverseTable, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, EventsTable, EventsColumn),
+		)
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasExecutors applies the HasEdge predicate on the "executors" edge.
+func HasExecutors() predicate.Application {
+	return predicate.Application(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.To(ExecutorsTable, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, ExecutorsTable, ExecutorsColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasExecutorsWith applies the HasEdge predicate on the "executors" edge with a given conditions (other predicates).
+func HasExecutorsWith(preds ...predicate.Executor) predicate.Application {
+	return predicate.Application(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.To(ExecutorsInverseTable, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, ExecutorsTable, ExecutorsColumn),
+		)
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasProject applies the HasEdge predicate on the "project" edge.
+func HasProject() predicate.Application {
+	return predicate.Application(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.To(ProjectTable, FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, ProjectTable, ProjectColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasProjectWith applies the HasEdge predicate on the "project" edge with a given conditions (other predicates).
+func HasProjectWith(preds ...predicate.Project) predicate.Application {
+	return predicate.Application(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.To(ProjectInverseTable, FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, ProjectTable, ProjectColumn),
+		)
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
 // And groups list of predicates with the AND operator between them.
 func And(predicates ...predicate.Application) predicate.Application {
 	return predicate.Application(func(s *sql.Selector) {
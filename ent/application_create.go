@@ -11,8 +11,14 @@ import (
 	"github.com/facebook/ent/dialect/sql/sqlgraph"
 	"github.com/facebook/ent/schema/field"
 	"github.com/gobench-io/gobench/ent/application"
+	"github.com/gobench-io/gobench/ent/baseline"
+	"github.com/gobench-io/gobench/ent/event"
+	"github.com/gobench-io/gobench/ent/executor"
 	"github.com/gobench-io/gobench/ent/group"
+	"github.com/gobench-io/gobench/ent/project"
 	"github.com/gobench-io/gobench/ent/tag"
+	"github.com/gobench-io/gobench/sink"
+	"github.com/gobench-io/gobench/verdict"
 )
 
 // ApplicationCreate is the builder for creating a Application entity.
@@ -76,6 +82,20 @@ func (ac *ApplicationCreate) SetNillableUpdatedAt(t *time.Time) *ApplicationCrea
 	return ac
 }
 
+// SetFinishedAt sets the finished_at field.
+func (ac *ApplicationCreate) SetFinishedAt(t time.Time) *ApplicationCreate {
+	ac.mutation.SetFinishedAt(t)
+	return ac
+}
+
+// SetNillableFinishedAt sets the finished_at field if the given value is not nil.
+func (ac *ApplicationCreate) SetNillableFinishedAt(t *time.Time) *ApplicationCreate {
+	if t != nil {
+		ac.SetFinishedAt(*t)
+	}
+	return ac
+}
+
 // SetScenario sets the scenario field.
 func (ac *ApplicationCreate) SetScenario(s string) *ApplicationCreate {
 	ac.mutation.SetScenario(s)
@@ -110,6 +130,74 @@ func (ac *ApplicationCreate) SetNillableGosum(s *string) *ApplicationCreate {
 	return ac
 }
 
+// SetSinks sets the sinks field.
+func (ac *ApplicationCreate) SetSinks(s []sink.Config) *ApplicationCreate {
+	ac.mutation.SetSinks(s)
+	return ac
+}
+
+// SetSinkTokens sets the sink_tokens field.
+func (ac *ApplicationCreate) SetSinkTokens(s string) *ApplicationCreate {
+	ac.mutation.SetSinkTokens(s)
+	return ac
+}
+
+// SetNillableSinkTokens sets the sink_tokens field if the given value is not nil.
+func (ac *ApplicationCreate) SetNillableSinkTokens(s *string) *ApplicationCreate {
+	if s != nil {
+		ac.SetSinkTokens(*s)
+	}
+	return ac
+}
+
+// SetImported sets the imported field.
+func (ac *ApplicationCreate) SetImported(b bool) *ApplicationCreate {
+	ac.mutation.SetImported(b)
+	return ac
+}
+
+// SetNillableImported sets the imported field if the given value is not nil.
+func (ac *ApplicationCreate) SetNillableImported(b *bool) *ApplicationCreate {
+	if b != nil {
+		ac.SetImported(*b)
+	}
+	return ac
+}
+
+// SetVerdict sets the verdict field.
+func (ac *ApplicationCreate) SetVerdict(s string) *ApplicationCreate {
+	ac.mutation.SetVerdict(s)
+	return ac
+}
+
+// SetNillableVerdict sets the verdict field if the given value is not nil.
+func (ac *ApplicationCreate) SetNillableVerdict(s *string) *ApplicationCreate {
+	if s != nil {
+		ac.SetVerdict(*s)
+	}
+	return ac
+}
+
+// SetChecks sets the checks field.
+func (ac *ApplicationCreate) SetChecks(v []verdict.Check) *ApplicationCreate {
+	ac.mutation.SetChecks(v)
+	return ac
+}
+
+// SetBaselineAppID sets the baseline_app_id field.
+func (ac *ApplicationCreate) SetBaselineAppID(i int) *ApplicationCreate {
+	ac.mutation.SetBaselineAppID(i)
+	return ac
+}
+
+// SetNillableBaselineAppID sets the baseline_app_id field if the given value is not nil.
+func (ac *ApplicationCreate) SetNillableBaselineAppID(i *int) *ApplicationCreate {
+	if i != nil {
+		ac.SetBaselineAppID(*i)
+	}
+	return ac
+}
+
 // AddGroupIDs adds the groups edge to Group by ids.
 func (ac *ApplicationCreate) AddGroupIDs(ids ...int) *ApplicationCreate {
 	ac.mutation.AddGroupIDs(ids...)
@@ -140,6 +228,70 @@ func (ac *ApplicationCreate) AddTags(t ...*Tag) *ApplicationCreate {
 	return ac.AddTagIDs(ids...)
 }
 
+// AddBaselineIDs adds the baselines edge to Baseline by ids.
+func (ac *ApplicationCreate) AddBaselineIDs(ids ...int) *ApplicationCreate {
+	ac.mutation.AddBaselineIDs(ids...)
+	return ac
+}
+
+// AddBaselines adds the baselines edges to Baseline.
+func (ac *ApplicationCreate) AddBaselines(b ...*Baseline) *ApplicationCreate {
+	ids := make([]int, len(b))
+	for i := range b {
+		ids[i] = b[i].ID
+	}
+	return ac.AddBaselineIDs(ids...)
+}
+
+// AddEventIDs adds the events edge to Event by ids.
+func (ac *ApplicationCreate) AddEventIDs(ids ...int) *ApplicationCreate {
+	ac.mutation.AddEventIDs(ids...)
+	return ac
+}
+
+// AddEvents adds the events edges to Event.
+func (ac *ApplicationCreate) AddEvents(e ...*Event) *ApplicationCreate {
+	ids := make([]int, len(e))
+	for i := range e {
+		ids[i] = e[i].ID
+	}
+	return ac.AddEventIDs(ids...)
+}
+
+// AddExecutorIDs adds the executors edge to Executor by ids.
+func (ac *ApplicationCreate) AddExecutorIDs(ids ...int) *ApplicationCreate {
+	ac.mutation.AddExecutorIDs(ids...)
+	return ac
+}
+
+// AddExecutors adds the executors edges to Executor.
+func (ac *ApplicationCreate) AddExecutors(e ...*Executor) *ApplicationCreate {
+	ids := make([]int, len(e))
+	for i := range e {
+		ids[i] = e[i].ID
+	}
+	return ac.AddExecutorIDs(ids...)
+}
+
+// SetProjectID sets the project edge to Project by id.
+func (ac *ApplicationCreate) SetProjectID(id int) *ApplicationCreate {
+	ac.mutation.SetProjectID(id)
+	return ac
+}
+
+// SetNillableProjectID sets the project edge to Project by id if the given value is not nil.
+func (ac *ApplicationCreate) SetNillableProjectID(id *int) *ApplicationCreate {
+	if id != nil {
+		ac = ac.SetProjectID(*id)
+	}
+	return ac
+}
+
+// SetProject sets the project edge to Project.
+func (ac *ApplicationCreate) SetProject(p *Project) *ApplicationCreate {
+	return ac.SetProjectID(p.ID)
+}
+
 // Mutation returns the ApplicationMutation object of the builder.
 func (ac *ApplicationCreate) Mutation() *ApplicationMutation {
 	return ac.mutation
@@ -208,6 +360,10 @@ func (ac *ApplicationCreate) defaults() {
 		v := application.DefaultGosum
 		ac.mutation.SetGosum(v)
 	}
+	if _, ok := ac.mutation.Imported(); !ok {
+		v := application.DefaultImported
+		ac.mutation.SetImported(v)
+	}
 }
 
 // check runs all checks and user-defined validators on the builder.
@@ -300,6 +456,14 @@ func (ac *ApplicationCreate) createSpec() (*Application, *sqlgraph.CreateSpec) {
 		})
 		_node.UpdatedAt = value
 	}
+	if value, ok := ac.mutation.FinishedAt(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeTime,
+			Value:  value,
+			Column: application.FieldFinishedAt,
+		})
+		_node.FinishedAt = value
+	}
 	if value, ok := ac.mutation.Scenario(); ok {
 		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
 			Type:   field.TypeString,
@@ -324,6 +488,54 @@ func (ac *ApplicationCreate) createSpec() (*Application, *sqlgraph.CreateSpec) {
 		})
 		_node.Gosum = value
 	}
+	if value, ok := ac.mutation.Sinks(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeJSON,
+			Value:  value,
+			Column: application.FieldSinks,
+		})
+		_node.Sinks = value
+	}
+	if value, ok := ac.mutation.SinkTokens(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: application.FieldSinkTokens,
+		})
+		_node.SinkTokens = value
+	}
+	if value, ok := ac.mutation.Imported(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeBool,
+			Value:  value,
+			Column: application.FieldImported,
+		})
+		_node.Imported = value
+	}
+	if value, ok := ac.mutation.Verdict(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: application.FieldVerdict,
+		})
+		_node.Verdict = value
+	}
+	if value, ok := ac.mutation.Checks(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeJSON,
+			Value:  value,
+			Column: application.FieldChecks,
+		})
+		_node.Checks = value
+	}
+	if value, ok := ac.mutation.BaselineAppID(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt,
+			Value:  value,
+			Column: application.FieldBaselineAppID,
+		})
+		_node.BaselineAppID = value
+	}
 	if nodes := ac.mutation.GroupsIDs(); len(nodes) > 0 {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
@@ -362,6 +574,82 @@ func (ac *ApplicationCreate) createSpec() (*Application, *sqlgraph.CreateSpec) {
 		}
 		_spec.Edges = append(_spec.Edges, edge)
 	}
+	if nodes := ac.mutation.BaselinesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   application.BaselinesTable,
+			Columns: []string{application.BaselinesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt,
+					Column: baseline.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := ac.mutation.EventsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   application.EventsTable,
+			Columns: []string{application.EventsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt,
+					Column: event.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := ac.mutation.ExecutorsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   application.ExecutorsTable,
+			Columns: []string{application.ExecutorsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt,
+					Column: executor.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := ac.mutation.ProjectIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   application.ProjectTable,
+			Columns: []string{application.ProjectColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt,
+					Column: project.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
 	return _node, _spec
 }
 
@@ -13,8 +13,12 @@ import (
 	"github.com/facebook/ent/dialect/sql/sqlgraph"
 	"github.com/facebook/ent/schema/field"
 	"github.com/gobench-io/gobench/ent/application"
+	"github.com/gobench-io/gobench/ent/baseline"
+	"github.com/gobench-io/gobench/ent/event"
+	"github.com/gobench-io/gobench/ent/executor"
 	"github.com/gobench-io/gobench/ent/group"
 	"github.com/gobench-io/gobench/ent/predicate"
+	"github.com/gobench-io/gobench/ent/project"
 	"github.com/gobench-io/gobench/ent/tag"
 )
 
@@ -27,8 +31,13 @@ type ApplicationQuery struct {
 	unique     []string
 	predicates []predicate.Application
 	// eager-loading edges.
-	withGroups *GroupQuery
-	withTags   *TagQuery
+	withGroups    *GroupQuery
+	withTags      *TagQuery
+	withBaselines *BaselineQuery
+	withEvents    *EventQuery
+	withExecutors *ExecutorQuery
+	withProject   *ProjectQuery
+	withFKs       bool
 	// intermediate query (i.e. traversal path).
 	sql  *sql.Selector
 	path func(context.Context) (*sql.Selector, error)
@@ -102,6 +111,94 @@ func (aq *ApplicationQuery) QueryTags() *TagQuery {
 	return query
 }
 
+// QueryBaselines chains the current query on the baselines edge.
+func (aq *ApplicationQuery) QueryBaselines() *BaselineQuery {
+	query := &BaselineQuery{config: aq.config}
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := aq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := aq.sqlQuery()
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(application.Table, application.FieldID, selector),
+			sqlgraph.To(baseline.Table, baseline.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, application.BaselinesTable, application.BaselinesColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(aq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// QueryEvents chains the current query on the events edge.
+func (aq *ApplicationQuery) QueryEvents() *EventQuery {
+	query := &EventQuery{config: aq.config}
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := aq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := aq.sqlQuery()
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(application.Table, application.FieldID, selector),
+			sqlgraph.To(event.Table, event.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, application.EventsTable, application.EventsColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(aq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// QueryExecutors chains the current query on the executors edge.
+func (aq *ApplicationQuery) QueryExecutors() *ExecutorQuery {
+	query := &ExecutorQuery{config: aq.config}
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := aq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := aq.sqlQuery()
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(application.Table, application.FieldID, selector),
+			sqlgraph.To(executor.Table, executor.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, application.ExecutorsTable, application.ExecutorsColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(aq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// QueryProject chains the current query on the project edge.
+func (aq *ApplicationQuery) QueryProject() *ProjectQuery {
+	query := &ProjectQuery{config: aq.config}
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := aq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := aq.sqlQuery()
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(application.Table, application.FieldID, selector),
+			sqlgraph.To(project.Table, project.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, application.ProjectTable, application.ProjectColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(aq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
 // First returns the first Application entity in the query. Returns *NotFoundError when no application was found.
 func (aq *ApplicationQuery) First(ctx context.Context) (*Application, error) {
 	nodes, err := aq.Limit(1).All(ctx)
@@ -281,7 +378,8 @@ func (aq *ApplicationQuery) Clone() *ApplicationQuery {
 	}
 }
 
-//  WithGroups tells the query-builder to eager-loads the nodes that are connected to
+//	WithGroups tells the query-builder to eager-loads the nodes that are connected to
+//
 // the "groups" edge. The optional arguments used to configure the query builder of the edge.
 func (aq *ApplicationQuery) WithGroups(opts ...func(*GroupQuery)) *ApplicationQuery {
 	query := &GroupQuery{config: aq.config}
@@ -292,7 +390,8 @@ func (aq *ApplicationQuery) WithGroups(opts ...func(*GroupQuery)) *ApplicationQu
 	return aq
 }
 
-//  WithTags tells the query-builder to eager-loads the nodes that are connected to
+//	WithTags tells the query-builder to eager-loads the nodes that are connected to
+//
 // the "tags" edge. The optional arguments used to configure the query builder of the edge.
 func (aq *ApplicationQuery) WithTags(opts ...func(*TagQuery)) *ApplicationQuery {
 	query := &TagQuery{config: aq.config}
@@ -303,6 +402,54 @@ func (aq *ApplicationQuery) WithTags(opts ...func(*TagQuery)) *ApplicationQuery
 	return aq
 }
 
+//	WithBaselines tells the query-builder to eager-loads the nodes that are connected to
+//
+// the "baselines" edge. The optional arguments used to configure the query builder of the edge.
+func (aq *ApplicationQuery) WithBaselines(opts ...func(*BaselineQuery)) *ApplicationQuery {
+	query := &BaselineQuery{config: aq.config}
+	for _, opt := range opts {
+		opt(query)
+	}
+	aq.withBaselines = query
+	return aq
+}
+
+//	WithEvents tells the query-builder to eager-loads the nodes that are connected to
+//
+// the "events" edge. The optional arguments used to configure the query builder of the edge.
+func (aq *ApplicationQuery) WithEvents(opts ...func(*EventQuery)) *ApplicationQuery {
+	query := &EventQuery{config: aq.config}
+	for _, opt := range opts {
+		opt(query)
+	}
+	aq.withEvents = query
+	return aq
+}
+
+//	WithExecutors tells the query-builder to eager-loads the nodes that are connected to
+//
+// the "executors" edge. The optional arguments used to configure the query builder of the edge.
+func (aq *ApplicationQuery) WithExecutors(opts ...func(*ExecutorQuery)) *ApplicationQuery {
+	query := &ExecutorQuery{config: aq.config}
+	for _, opt := range opts {
+		opt(query)
+	}
+	aq.withExecutors = query
+	return aq
+}
+
+//	WithProject tells the query-builder to eager-loads the nodes that are connected to
+//
+// the "project" edge. The optional arguments used to configure the query builder of the edge.
+func (aq *ApplicationQuery) WithProject(opts ...func(*ProjectQuery)) *ApplicationQuery {
+	query := &ProjectQuery{config: aq.config}
+	for _, opt := range opts {
+		opt(query)
+	}
+	aq.withProject = query
+	return aq
+}
+
 // GroupBy used to group vertices by one or more fields/columns.
 // It is often used with aggregate functions, like: count, max, mean, min, sum.
 //
@@ -317,7 +464,6 @@ func (aq *ApplicationQuery) WithTags(opts ...func(*TagQuery)) *ApplicationQuery
 //		GroupBy(application.FieldName).
 //		Aggregate(ent.Count()).
 //		Scan(ctx, &v)
-//
 func (aq *ApplicationQuery) GroupBy(field string, fields ...string) *ApplicationGroupBy {
 	group := &ApplicationGroupBy{config: aq.config}
 	group.fields = append([]string{field}, fields...)
@@ -341,7 +487,6 @@ func (aq *ApplicationQuery) GroupBy(field string, fields ...string) *Application
 //	client.Application.Query().
 //		Select(application.FieldName).
 //		Scan(ctx, &v)
-//
 func (aq *ApplicationQuery) Select(field string, fields ...string) *ApplicationSelect {
 	selector := &ApplicationSelect{config: aq.config}
 	selector.fields = append([]string{field}, fields...)
@@ -368,16 +513,30 @@ func (aq *ApplicationQuery) prepareQuery(ctx context.Context) error {
 func (aq *ApplicationQuery) sqlAll(ctx context.Context) ([]*Application, error) {
 	var (
 		nodes       = []*Application{}
+		withFKs     = aq.withFKs
 		_spec       = aq.querySpec()
-		loadedTypes = [2]bool{
+		loadedTypes = [6]bool{
 			aq.withGroups != nil,
 			aq.withTags != nil,
+			aq.withBaselines != nil,
+			aq.withEvents != nil,
+			aq.withExecutors != nil,
+			aq.withProject != nil,
 		}
 	)
+	if aq.withProject != nil {
+		withFKs = true
+	}
+	if withFKs {
+		_spec.Node.Columns = append(_spec.Node.Columns, application.ForeignKeys...)
+	}
 	_spec.ScanValues = func() []interface{} {
 		node := &Application{config: aq.config}
 		nodes = append(nodes, node)
 		values := node.scanValues()
+		if withFKs {
+			values = append(values, node.fkValues()...)
+		}
 		return values
 	}
 	_spec.Assign = func(values ...interface{}) error {
@@ -451,6 +610,115 @@ func (aq *ApplicationQuery) sqlAll(ctx context.Context) ([]*Application, error)
 		}
 	}
 
+	if query := aq.withBaselines; query != nil {
+		fks := make([]driver.Value, 0, len(nodes))
+		nodeids := make(map[int]*Application)
+		for i := range nodes {
+			fks = append(fks, nodes[i].ID)
+			nodeids[nodes[i].ID] = nodes[i]
+		}
+		query.withFKs = true
+		query.Where(predicate.Baseline(func(s *sql.Selector) {
+			s.Where(sql.InValues(application.BaselinesColumn, fks...))
+		}))
+		neighbors, err := query.All(ctx)
+		if err != nil {
+			return nil, err
+		}
+		for _, n := range neighbors {
+			fk := n.application_baselines
+			if fk == nil {
+				return nil, fmt.Errorf(`foreign-key "application_baselines" is nil for node %v`, n.ID)
+			}
+			node, ok := nodeids[*fk]
+			if !ok {
+				return nil, fmt.Errorf(`unexpected foreign-key "application_baselines" returned %v for node %v`, *fk, n.ID)
+			}
+			node.Edges.Baselines = append(node.Edges.Baselines, n)
+		}
+	}
+
+	if query := aq.withEvents; query != nil {
+		fks := make([]driver.Value, 0, len(nodes))
+		nodeids := make(map[int]*Application)
+		for i := range nodes {
+			fks = append(fks, nodes[i].ID)
+			nodeids[nodes[i].ID] = nodes[i]
+		}
+		query.withFKs = true
+		query.Where(predicate.Event(func(s *sql.Selector) {
+			s.Where(sql.InValues(application.EventsColumn, fks...))
+		}))
+		neighbors, err := query.All(ctx)
+		if err != nil {
+			return nil, err
+		}
+		for _, n := range neighbors {
+			fk := n.application_events
+			if fk == nil {
+				return nil, fmt.Errorf(`foreign-key "application_events" is nil for node %v`, n.ID)
+			}
+			node, ok := nodeids[*fk]
+			if !ok {
+				return nil, fmt.Errorf(`unexpected foreign-key "application_events" returned %v for node %v`, *fk, n.ID)
+			}
+			node.Edges.Events = append(node.Edges.Events, n)
+		}
+	}
+
+	if query := aq.withExecutors; query != nil {
+		fks := make([]driver.Value, 0, len(nodes))
+		nodeids := make(map[int]*Application)
+		for i := range nodes {
+			fks = append(fks, nodes[i].ID)
+			nodeids[nodes[i].ID] = nodes[i]
+		}
+		query.withFKs = true
+		query.Where(predicate.Executor(func(s *sql.Selector) {
+			s.Where(sql.InValues(application.ExecutorsColumn, fks...))
+		}))
+		neighbors, err := query.All(ctx)
+		if err != nil {
+			return nil, err
+		}
+		for _, n := range neighbors {
+			fk := n.application_executors
+			if fk == nil {
+				return nil, fmt.Errorf(`foreign-key "application_executors" is nil for node %v`, n.ID)
+			}
+			node, ok := nodeids[*fk]
+			if !ok {
+				return nil, fmt.Errorf(`unexpected foreign-key "application_executors" returned %v for node %v`, *fk, n.ID)
+			}
+			node.Edges.Executors = append(node.Edges.Executors, n)
+		}
+	}
+
+	if query := aq.withProject; query != nil {
+		ids := make([]int, 0, len(nodes))
+		nodeids := make(map[int][]*Application)
+		for i := range nodes {
+			if fk := nodes[i].project_applications; fk != nil {
+				ids = append(ids, *fk)
+				nodeids[*fk] = append(nodeids[*fk], nodes[i])
+			}
+		}
+		query.Where(project.IDIn(ids...))
+		neighbors, err := query.All(ctx)
+		if err != nil {
+			return nil, err
+		}
+		for _, n := range neighbors {
+			nodes, ok := nodeids[n.ID]
+			if !ok {
+				return nil, fmt.Errorf(`unexpected foreign-key "project_applications" returned %v`, n.ID)
+			}
+			for i := range nodes {
+				nodes[i].Edges.Project = n
+			}
+		}
+	}
+
 	return nodes, nil
 }
 
@@ -11,9 +11,15 @@ import (
 	"github.com/facebook/ent/dialect/sql/sqlgraph"
 	"github.com/facebook/ent/schema/field"
 	"github.com/gobench-io/gobench/ent/application"
+	"github.com/gobench-io/gobench/ent/baseline"
+	"github.com/gobench-io/gobench/ent/event"
+	"github.com/gobench-io/gobench/ent/executor"
 	"github.com/gobench-io/gobench/ent/group"
 	"github.com/gobench-io/gobench/ent/predicate"
+	"github.com/gobench-io/gobench/ent/project"
 	"github.com/gobench-io/gobench/ent/tag"
+	"github.com/gobench-io/gobench/sink"
+	"github.com/gobench-io/gobench/verdict"
 )
 
 // ApplicationUpdate is the builder for updating Application entities.
@@ -82,6 +88,26 @@ func (au *ApplicationUpdate) SetUpdatedAt(t time.Time) *ApplicationUpdate {
 	return au
 }
 
+// SetFinishedAt sets the finished_at field.
+func (au *ApplicationUpdate) SetFinishedAt(t time.Time) *ApplicationUpdate {
+	au.mutation.SetFinishedAt(t)
+	return au
+}
+
+// SetNillableFinishedAt sets the finished_at field if the given value is not nil.
+func (au *ApplicationUpdate) SetNillableFinishedAt(t *time.Time) *ApplicationUpdate {
+	if t != nil {
+		au.SetFinishedAt(*t)
+	}
+	return au
+}
+
+// ClearFinishedAt clears the value of finished_at.
+func (au *ApplicationUpdate) ClearFinishedAt() *ApplicationUpdate {
+	au.mutation.ClearFinishedAt()
+	return au
+}
+
 // SetScenario sets the scenario field.
 func (au *ApplicationUpdate) SetScenario(s string) *ApplicationUpdate {
 	au.mutation.SetScenario(s)
@@ -116,6 +142,117 @@ func (au *ApplicationUpdate) SetNillableGosum(s *string) *ApplicationUpdate {
 	return au
 }
 
+// SetSinks sets the sinks field.
+func (au *ApplicationUpdate) SetSinks(s []sink.Config) *ApplicationUpdate {
+	au.mutation.SetSinks(s)
+	return au
+}
+
+// ClearSinks clears the value of sinks.
+func (au *ApplicationUpdate) ClearSinks() *ApplicationUpdate {
+	au.mutation.ClearSinks()
+	return au
+}
+
+// SetSinkTokens sets the sink_tokens field.
+func (au *ApplicationUpdate) SetSinkTokens(s string) *ApplicationUpdate {
+	au.mutation.SetSinkTokens(s)
+	return au
+}
+
+// SetNillableSinkTokens sets the sink_tokens field if the given value is not nil.
+func (au *ApplicationUpdate) SetNillableSinkTokens(s *string) *ApplicationUpdate {
+	if s != nil {
+		au.SetSinkTokens(*s)
+	}
+	return au
+}
+
+// ClearSinkTokens clears the value of sink_tokens.
+func (au *ApplicationUpdate) ClearSinkTokens() *ApplicationUpdate {
+	au.mutation.ClearSinkTokens()
+	return au
+}
+
+// SetImported sets the imported field.
+func (au *ApplicationUpdate) SetImported(b bool) *ApplicationUpdate {
+	au.mutation.SetImported(b)
+	return au
+}
+
+// SetNillableImported sets the imported field if the given value is not nil.
+func (au *ApplicationUpdate) SetNillableImported(b *bool) *ApplicationUpdate {
+	if b != nil {
+		au.SetImported(*b)
+	}
+	return au
+}
+
+// ClearImported clears the value of imported.
+func (au *ApplicationUpdate) ClearImported() *ApplicationUpdate {
+	au.mutation.ClearImported()
+	return au
+}
+
+// SetVerdict sets the verdict field.
+func (au *ApplicationUpdate) SetVerdict(s string) *ApplicationUpdate {
+	au.mutation.SetVerdict(s)
+	return au
+}
+
+// SetNillableVerdict sets the verdict field if the given value is not nil.
+func (au *ApplicationUpdate) SetNillableVerdict(s *string) *ApplicationUpdate {
+	if s != nil {
+		au.SetVerdict(*s)
+	}
+	return au
+}
+
+// ClearVerdict clears the value of verdict.
+func (au *ApplicationUpdate) ClearVerdict() *ApplicationUpdate {
+	au.mutation.ClearVerdict()
+	return au
+}
+
+// SetChecks sets the checks field.
+func (au *ApplicationUpdate) SetChecks(v []verdict.Check) *ApplicationUpdate {
+	au.mutation.SetChecks(v)
+	return au
+}
+
+// ClearChecks clears the value of checks.
+func (au *ApplicationUpdate) ClearChecks() *ApplicationUpdate {
+	au.mutation.ClearChecks()
+	return au
+}
+
+// SetBaselineAppID sets the baseline_app_id field.
+func (au *ApplicationUpdate) SetBaselineAppID(i int) *ApplicationUpdate {
+	au.mutation.ResetBaselineAppID()
+	au.mutation.SetBaselineAppID(i)
+	return au
+}
+
+// SetNillableBaselineAppID sets the baseline_app_id field if the given value is not nil.
+func (au *ApplicationUpdate) SetNillableBaselineAppID(i *int) *ApplicationUpdate {
+	if i != nil {
+		au.SetBaselineAppID(*i)
+	}
+	return au
+}
+
+// AddBaselineAppID adds i to baseline_app_id.
+func (au *ApplicationUpdate) AddBaselineAppID(i int) *ApplicationUpdate {
+	au.mutation.AddBaselineAppID(i)
+	return au
+}
+
+// ClearBaselineAppID clears the value of baseline_app_id.
+func (au *ApplicationUpdate) ClearBaselineAppID() *ApplicationUpdate {
+	au.mutation.ClearBaselineAppID()
+	return au
+}
+
 // AddGroupIDs adds the groups edge to Group by ids.
 func (au *ApplicationUpdate) AddGroupIDs(ids ...int) *ApplicationUpdate {
 	au.mutation.AddGroupIDs(ids...)
@@ -146,6 +283,70 @@ func (au *ApplicationUpdate) AddTags(t ...*Tag) *ApplicationUpdate {
 	return au.AddTagIDs(ids...)
 }
 
+// AddBaselineIDs adds the baselines edge to Baseline by ids.
+func (au *ApplicationUpdate) AddBaselineIDs(ids ...int) *ApplicationUpdate {
+	au.mutation.AddBaselineIDs(ids...)
+	return au
+}
+
+// AddBaselines adds the baselines edges to Baseline.
+func (au *ApplicationUpdate) AddBaselines(b ...*Baseline) *ApplicationUpdate {
+	ids := make([]int, len(b))
+	for i := range b {
+		ids[i] = b[i].ID
+	}
+	return au.AddBaselineIDs(ids...)
+}
+
+// AddEventIDs adds the events edge to Event by ids.
+func (au *ApplicationUpdate) AddEventIDs(ids ...int) *ApplicationUpdate {
+	au.mutation.AddEventIDs(ids...)
+	return au
+}
+
+// AddEvents adds the events edges to Event.
+func (au *ApplicationUpdate) AddEvents(e ...*Event) *ApplicationUpdate {
+	ids := make([]int, len(e))
+	for i := range e {
+		ids[i] = e[i].ID
+	}
+	return au.AddEventIDs(ids...)
+}
+
+// AddExecutorIDs adds the executors edge to Executor by ids.
+func (au *ApplicationUpdate) AddExecutorIDs(ids ...int) *ApplicationUpdate {
+	au.mutation.AddExecutorIDs(ids...)
+	return au
+}
+
+// AddExecutors adds the executors edges to Executor.
+func (au *ApplicationUpdate) AddExecutors(e ...*Executor) *ApplicationUpdate {
+	ids := make([]int, len(e))
+	for i := range e {
+		ids[i] = e[i].ID
+	}
+	return au.AddExecutorIDs(ids...)
+}
+
+// SetProjectID sets the project edge to Project by id.
+func (au *ApplicationUpdate) SetProjectID(id int) *ApplicationUpdate {
+	au.mutation.SetProjectID(id)
+	return au
+}
+
+// SetNillableProjectID sets the project edge to Project by id if the given value is not nil.
+func (au *ApplicationUpdate) SetNillableProjectID(id *int) *ApplicationUpdate {
+	if id != nil {
+		au = au.SetProjectID(*id)
+	}
+	return au
+}
+
+// SetProject sets the project edge to Project.
+func (au *ApplicationUpdate) SetProject(p *Project) *ApplicationUpdate {
+	return au.SetProjectID(p.ID)
+}
+
 // Mutation returns the ApplicationMutation object of the builder.
 func (au *ApplicationUpdate) Mutation() *ApplicationMutation {
 	return au.mutation
@@ -193,6 +394,75 @@ func (au *ApplicationUpdate) RemoveTags(t ...*Tag) *ApplicationUpdate {
 	return au.RemoveTagIDs(ids...)
 }
 
+// ClearBaselines clears all "baselines" edges to type Baseline.
+func (au *ApplicationUpdate) ClearBaselines() *ApplicationUpdate {
+	au.mutation.ClearBaselines()
+	return au
+}
+
+// RemoveBaselineIDs removes the baselines edge to Baseline by ids.
+func (au *ApplicationUpdate) RemoveBaselineIDs(ids ...int) *ApplicationUpdate {
+	au.mutation.RemoveBaselineIDs(ids...)
+	return au
+}
+
+// RemoveBaselines removes baselines edges to Baseline.
+func (au *ApplicationUpdate) RemoveBaselines(b ...*Baseline) *ApplicationUpdate {
+	ids := make([]int, len(b))
+	for i := range b {
+		ids[i] = b[i].ID
+	}
+	return au.RemoveBaselineIDs(ids...)
+}
+
+// ClearEvents clears all "events" edges to type Event.
+func (au *ApplicationUpdate) ClearEvents() *ApplicationUpdate {
+	au.mutation.ClearEvents()
+	return au
+}
+
+// RemoveEventIDs removes the events edge to Event by ids.
+func (au *ApplicationUpdate) RemoveEventIDs(ids ...int) *ApplicationUpdate {
+	au.mutation.RemoveEventIDs(ids...)
+	return au
+}
+
+// RemoveEvents removes events edges to Event.
+func (au *ApplicationUpdate) RemoveEvents(e ...*Event) *ApplicationUpdate {
+	ids := make([]int, len(e))
+	for i := range e {
+		ids[i] = e[i].ID
+	}
+	return au.RemoveEventIDs(ids...)
+}
+
+// ClearExecutors clears all "executors" edges to type Executor.
+func (au *ApplicationUpdate) ClearExecutors() *ApplicationUpdate {
+	au.mutation.ClearExecutors()
+	return au
+}
+
+// RemoveExecutorIDs removes the executors edge to Executor by ids.
+func (au *ApplicationUpdate) RemoveExecutorIDs(ids ...int) *ApplicationUpdate {
+	au.mutation.RemoveExecutorIDs(ids...)
+	return au
+}
+
+// RemoveExecutors removes executors edges to Executor.
+func (au *ApplicationUpdate) RemoveExecutors(e ...*Executor) *ApplicationUpdate {
+	ids := make([]int, len(e))
+	for i := range e {
+		ids[i] = e[i].ID
+	}
+	return au.RemoveExecutorIDs(ids...)
+}
+
+// ClearProject clears the "project" edge to type Project.
+func (au *ApplicationUpdate) ClearProject() *ApplicationUpdate {
+	au.mutation.ClearProject()
+	return au
+}
+
 // Save executes the query and returns the number of rows/vertices matched by this operation.
 func (au *ApplicationUpdate) Save(ctx context.Context) (int, error) {
 	var (
@@ -312,6 +582,19 @@ func (au *ApplicationUpdate) sqlSave(ctx context.Context) (n int, err error) {
 			Column: application.FieldUpdatedAt,
 		})
 	}
+	if value, ok := au.mutation.FinishedAt(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeTime,
+			Value:  value,
+			Column: application.FieldFinishedAt,
+		})
+	}
+	if au.mutation.FinishedAtCleared() {
+		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
+			Type:   field.TypeTime,
+			Column: application.FieldFinishedAt,
+		})
+	}
 	if value, ok := au.mutation.Scenario(); ok {
 		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
 			Type:   field.TypeString,
@@ -333,6 +616,91 @@ func (au *ApplicationUpdate) sqlSave(ctx context.Context) (n int, err error) {
 			Column: application.FieldGosum,
 		})
 	}
+	if value, ok := au.mutation.Sinks(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeJSON,
+			Value:  value,
+			Column: application.FieldSinks,
+		})
+	}
+	if au.mutation.SinksCleared() {
+		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
+			Type:   field.TypeJSON,
+			Column: application.FieldSinks,
+		})
+	}
+	if value, ok := au.mutation.SinkTokens(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: application.FieldSinkTokens,
+		})
+	}
+	if au.mutation.SinkTokensCleared() {
+		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Column: application.FieldSinkTokens,
+		})
+	}
+	if value, ok := au.mutation.Imported(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeBool,
+			Value:  value,
+			Column: application.FieldImported,
+		})
+	}
+	if au.mutation.ImportedCleared() {
+		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
+			Type:   field.TypeBool,
+			Column: application.FieldImported,
+		})
+	}
+	if value, ok := au.mutation.Verdict(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: application.FieldVerdict,
+		})
+	}
+	if au.mutation.VerdictCleared() {
+		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Column: application.FieldVerdict,
+		})
+	}
+	if value, ok := au.mutation.Checks(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeJSON,
+			Value:  value,
+			Column: application.FieldChecks,
+		})
+	}
+	if au.mutation.ChecksCleared() {
+		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
+			Type:   field.TypeJSON,
+			Column: application.FieldChecks,
+		})
+	}
+	if value, ok := au.mutation.BaselineAppID(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt,
+			Value:  value,
+			Column: application.FieldBaselineAppID,
+		})
+	}
+	if value, ok := au.mutation.AddedBaselineAppID(); ok {
+		_spec.Fields.Add = append(_spec.Fields.Add, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt,
+			Value:  value,
+			Column: application.FieldBaselineAppID,
+		})
+	}
+	if au.mutation.BaselineAppIDCleared() {
+		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt,
+			Column: application.FieldBaselineAppID,
+		})
+	}
 	if au.mutation.GroupsCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
@@ -441,58 +809,255 @@ func (au *ApplicationUpdate) sqlSave(ctx context.Context) (n int, err error) {
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
-	if n, err = sqlgraph.UpdateNodes(ctx, au.driver, _spec); err != nil {
-		if _, ok := err.(*sqlgraph.NotFoundError); ok {
-			err = &NotFoundError{application.Label}
-		} else if cerr, ok := isSQLConstraintError(err); ok {
-			err = cerr
+	if au.mutation.BaselinesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   application.BaselinesTable,
+			Columns: []string{application.BaselinesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt,
+					Column: baseline.FieldID,
+				},
+			},
 		}
-		return 0, err
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	return n, nil
-}
-
-// ApplicationUpdateOne is the builder for updating a single Application entity.
-type ApplicationUpdateOne struct {
-	config
-	hooks    []Hook
-	mutation *ApplicationMutation
-}
-
-// SetName sets the name field.
-func (auo *ApplicationUpdateOne) SetName(s string) *ApplicationUpdateOne {
-	auo.mutation.SetName(s)
-	return auo
-}
-
-// SetStatus sets the status field.
-func (auo *ApplicationUpdateOne) SetStatus(s string) *ApplicationUpdateOne {
-	auo.mutation.SetStatus(s)
-	return auo
-}
-
-// SetCreatedAt sets the created_at field.
-func (auo *ApplicationUpdateOne) SetCreatedAt(t time.Time) *ApplicationUpdateOne {
-	auo.mutation.SetCreatedAt(t)
-	return auo
-}
-
-// SetNillableCreatedAt sets the created_at field if the given value is not nil.
-func (auo *ApplicationUpdateOne) SetNillableCreatedAt(t *time.Time) *ApplicationUpdateOne {
-	if t != nil {
-		auo.SetCreatedAt(*t)
+	if nodes := au.mutation.RemovedBaselinesIDs(); len(nodes) > 0 && !au.mutation.BaselinesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   application.BaselinesTable,
+			Columns: []string{application.BaselinesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt,
+					Column: baseline.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	return auo
-}
-
-// SetStartedAt sets the started_at field.
-func (auo *ApplicationUpdateOne) SetStartedAt(t time.Time) *ApplicationUpdateOne {
-	auo.mutation.SetStartedAt(t)
-	return auo
-}
-
-// SetNillableStartedAt sets the started_at field if the given value is not nil.
-func (auo *ApplicationUpdateOne) SetNillableStartedAt(t *time.Time) *ApplicationUpdateOne {
+	if nodes := au.mutation.BaselinesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   application.BaselinesTable,
+			Columns: []string{application.BaselinesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt,
+					Column: baseline.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if au.mutation.EventsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   application.EventsTable,
+			Columns: []string{application.EventsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt,
+					Column: event.FieldID,
+				},
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := au.mutation.RemovedEventsIDs(); len(nodes) > 0 && !au.mutation.EventsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   application.EventsTable,
+			Columns: []string{application.EventsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt,
+					Column: event.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := au.mutation.EventsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   application.EventsTable,
+			Columns: []string{application.EventsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt,
+					Column: event.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if au.mutation.ExecutorsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   application.ExecutorsTable,
+			Columns: []string{application.ExecutorsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt,
+					Column: executor.FieldID,
+				},
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := au.mutation.RemovedExecutorsIDs(); len(nodes) > 0 && !au.mutation.ExecutorsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   application.ExecutorsTable,
+			Columns: []string{application.ExecutorsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt,
+					Column: executor.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := au.mutation.ExecutorsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   application.ExecutorsTable,
+			Columns: []string{application.ExecutorsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt,
+					Column: executor.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if au.mutation.ProjectCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   application.ProjectTable,
+			Columns: []string{application.ProjectColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt,
+					Column: project.FieldID,
+				},
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := au.mutation.ProjectIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   application.ProjectTable,
+			Columns: []string{application.ProjectColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt,
+					Column: project.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if n, err = sqlgraph.UpdateNodes(ctx, au.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{application.Label}
+		} else if cerr, ok := isSQLConstraintError(err); ok {
+			err = cerr
+		}
+		return 0, err
+	}
+	return n, nil
+}
+
+// ApplicationUpdateOne is the builder for updating a single Application entity.
+type ApplicationUpdateOne struct {
+	config
+	hooks    []Hook
+	mutation *ApplicationMutation
+}
+
+// SetName sets the name field.
+func (auo *ApplicationUpdateOne) SetName(s string) *ApplicationUpdateOne {
+	auo.mutation.SetName(s)
+	return auo
+}
+
+// SetStatus sets the status field.
+func (auo *ApplicationUpdateOne) SetStatus(s string) *ApplicationUpdateOne {
+	auo.mutation.SetStatus(s)
+	return auo
+}
+
+// SetCreatedAt sets the created_at field.
+func (auo *ApplicationUpdateOne) SetCreatedAt(t time.Time) *ApplicationUpdateOne {
+	auo.mutation.SetCreatedAt(t)
+	return auo
+}
+
+// SetNillableCreatedAt sets the created_at field if the given value is not nil.
+func (auo *ApplicationUpdateOne) SetNillableCreatedAt(t *time.Time) *ApplicationUpdateOne {
+	if t != nil {
+		auo.SetCreatedAt(*t)
+	}
+	return auo
+}
+
+// SetStartedAt sets the started_at field.
+func (auo *ApplicationUpdateOne) SetStartedAt(t time.Time) *ApplicationUpdateOne {
+	auo.mutation.SetStartedAt(t)
+	return auo
+}
+
+// SetNillableStartedAt sets the started_at field if the given value is not nil.
+func (auo *ApplicationUpdateOne) SetNillableStartedAt(t *time.Time) *ApplicationUpdateOne {
 	if t != nil {
 		auo.SetStartedAt(*t)
 	}
@@ -511,6 +1076,26 @@ func (auo *ApplicationUpdateOne) SetUpdatedAt(t time.Time) *ApplicationUpdateOne
 	return auo
 }
 
+// SetFinishedAt sets the finished_at field.
+func (auo *ApplicationUpdateOne) SetFinishedAt(t time.Time) *ApplicationUpdateOne {
+	auo.mutation.SetFinishedAt(t)
+	return auo
+}
+
+// SetNillableFinishedAt sets the finished_at field if the given value is not nil.
+func (auo *ApplicationUpdateOne) SetNillableFinishedAt(t *time.Time) *ApplicationUpdateOne {
+	if t != nil {
+		auo.SetFinishedAt(*t)
+	}
+	return auo
+}
+
+// ClearFinishedAt clears the value of finished_at.
+func (auo *ApplicationUpdateOne) ClearFinishedAt() *ApplicationUpdateOne {
+	auo.mutation.ClearFinishedAt()
+	return auo
+}
+
 // SetScenario sets the scenario field.
 func (auo *ApplicationUpdateOne) SetScenario(s string) *ApplicationUpdateOne {
 	auo.mutation.SetScenario(s)
@@ -545,6 +1130,117 @@ func (auo *ApplicationUpdateOne) SetNillableGosum(s *string) *ApplicationUpdateO
 	return auo
 }
 
+// SetSinks sets the sinks field.
+func (auo *ApplicationUpdateOne) SetSinks(s []sink.Config) *ApplicationUpdateOne {
+	auo.mutation.SetSinks(s)
+	return auo
+}
+
+// ClearSinks clears the value of sinks.
+func (auo *ApplicationUpdateOne) ClearSinks() *ApplicationUpdateOne {
+	auo.mutation.ClearSinks()
+	return auo
+}
+
+// SetSinkTokens sets the sink_tokens field.
+func (auo *ApplicationUpdateOne) SetSinkTokens(s string) *ApplicationUpdateOne {
+	auo.mutation.SetSinkTokens(s)
+	return auo
+}
+
+// SetNillableSinkTokens sets the sink_tokens field if the given value is not nil.
+func (auo *ApplicationUpdateOne) SetNillableSinkTokens(s *string) *ApplicationUpdateOne {
+	if s != nil {
+		auo.SetSinkTokens(*s)
+	}
+	return auo
+}
+
+// ClearSinkTokens clears the value of sink_tokens.
+func (auo *ApplicationUpdateOne) ClearSinkTokens() *ApplicationUpdateOne {
+	auo.mutation.ClearSinkTokens()
+	return auo
+}
+
+// SetImported sets the imported field.
+func (auo *ApplicationUpdateOne) SetImported(b bool) *ApplicationUpdateOne {
+	auo.mutation.SetImported(b)
+	return auo
+}
+
+// SetNillableImported sets the imported field if the given value is not nil.
+func (auo *ApplicationUpdateOne) SetNillableImported(b *bool) *ApplicationUpdateOne {
+	if b != nil {
+		auo.SetImported(*b)
+	}
+	return auo
+}
+
+// ClearImported clears the value of imported.
+func (auo *ApplicationUpdateOne) ClearImported() *ApplicationUpdateOne {
+	auo.mutation.ClearImported()
+	return auo
+}
+
+// SetVerdict sets the verdict field.
+func (auo *ApplicationUpdateOne) SetVerdict(s string) *ApplicationUpdateOne {
+	auo.mutation.SetVerdict(s)
+	return auo
+}
+
+// SetNillableVerdict sets the verdict field if the given value is not nil.
+func (auo *ApplicationUpdateOne) SetNillableVerdict(s *string) *ApplicationUpdateOne {
+	if s != nil {
+		auo.SetVerdict(*s)
+	}
+	return auo
+}
+
+// ClearVerdict clears the value of verdict.
+func (auo *ApplicationUpdateOne) ClearVerdict() *ApplicationUpdateOne {
+	auo.mutation.ClearVerdict()
+	return auo
+}
+
+// SetChecks sets the checks field.
+func (auo *ApplicationUpdateOne) SetChecks(v []verdict.Check) *ApplicationUpdateOne {
+	auo.mutation.SetChecks(v)
+	return auo
+}
+
+// ClearChecks clears the value of checks.
+func (auo *ApplicationUpdateOne) ClearChecks() *ApplicationUpdateOne {
+	auo.mutation.ClearChecks()
+	return auo
+}
+
+// SetBaselineAppID sets the baseline_app_id field.
+func (auo *ApplicationUpdateOne) SetBaselineAppID(i int) *ApplicationUpdateOne {
+	auo.mutation.ResetBaselineAppID()
+	auo.mutation.SetBaselineAppID(i)
+	return auo
+}
+
+// SetNillableBaselineAppID sets the baseline_app_id field if the given value is not nil.
+func (auo *ApplicationUpdateOne) SetNillableBaselineAppID(i *int) *ApplicationUpdateOne {
+	if i != nil {
+		auo.SetBaselineAppID(*i)
+	}
+	return auo
+}
+
+// AddBaselineAppID adds i to baseline_app_id.
+func (auo *ApplicationUpdateOne) AddBaselineAppID(i int) *ApplicationUpdateOne {
+	auo.mutation.AddBaselineAppID(i)
+	return auo
+}
+
+// ClearBaselineAppID clears the value of baseline_app_id.
+func (auo *ApplicationUpdateOne) ClearBaselineAppID() *ApplicationUpdateOne {
+	auo.mutation.ClearBaselineAppID()
+	return auo
+}
+
 // AddGroupIDs adds the groups edge to Group by ids.
 func (auo *ApplicationUpdateOne) AddGroupIDs(ids ...int) *ApplicationUpdateOne {
 	auo.mutation.AddGroupIDs(ids...)
@@ -575,6 +1271,70 @@ func (auo *ApplicationUpdateOne) AddTags(t ...*Tag) *ApplicationUpdateOne {
 	return auo.AddTagIDs(ids...)
 }
 
+// AddBaselineIDs adds the baselines edge to Baseline by ids.
+func (auo *ApplicationUpdateOne) AddBaselineIDs(ids ...int) *ApplicationUpdateOne {
+	auo.mutation.AddBaselineIDs(ids...)
+	return auo
+}
+
+// AddBaselines adds the baselines edges to Baseline.
+func (auo *ApplicationUpdateOne) AddBaselines(b ...*Baseline) *ApplicationUpdateOne {
+	ids := make([]int, len(b))
+	for i := range b {
+		ids[i] = b[i].ID
+	}
+	return auo.AddBaselineIDs(ids...)
+}
+
+// AddEventIDs adds the events edge to Event by ids.
+func (auo *ApplicationUpdateOne) AddEventIDs(ids ...int) *ApplicationUpdateOne {
+	auo.mutation.AddEventIDs(ids...)
+	return auo
+}
+
+// AddEvents adds the events edges to Event.
+func (auo *ApplicationUpdateOne) AddEvents(e ...*Event) *ApplicationUpdateOne {
+	ids := make([]int, len(e))
+	for i := range e {
+		ids[i] = e[i].ID
+	}
+	return auo.AddEventIDs(ids...)
+}
+
+// AddExecutorIDs adds the executors edge to Executor by ids.
+func (auo *ApplicationUpdateOne) AddExecutorIDs(ids ...int) *ApplicationUpdateOne {
+	auo.mutation.AddExecutorIDs(ids...)
+	return auo
+}
+
+// AddExecutors adds the executors edges to Executor.
+func (auo *ApplicationUpdateOne) AddExecutors(e ...*Executor) *ApplicationUpdateOne {
+	ids := make([]int, len(e))
+	for i := range e {
+		ids[i] = e[i].ID
+	}
+	return auo.AddExecutorIDs(ids...)
+}
+
+// SetProjectID sets the project edge to Project by id.
+func (auo *ApplicationUpdateOne) SetProjectID(id int) *ApplicationUpdateOne {
+	auo.mutation.SetProjectID(id)
+	return auo
+}
+
+// SetNillableProjectID sets the project edge to Project by id if the given value is not nil.
+func (auo *ApplicationUpdateOne) SetNillableProjectID(id *int) *ApplicationUpdateOne {
+	if id != nil {
+		auo = auo.SetProjectID(*id)
+	}
+	return auo
+}
+
+// SetProject sets the project edge to Project.
+func (auo *ApplicationUpdateOne) SetProject(p *Project) *ApplicationUpdateOne {
+	return auo.SetProjectID(p.ID)
+}
+
 // Mutation returns the ApplicationMutation object of the builder.
 func (auo *ApplicationUpdateOne) Mutation() *ApplicationMutation {
 	return auo.mutation
@@ -622,6 +1382,75 @@ func (auo *ApplicationUpdateOne) RemoveTags(t ...*Tag) *ApplicationUpdateOne {
 	return auo.RemoveTagIDs(ids...)
 }
 
+// ClearBaselines clears all "baselines" edges to type Baseline.
+func (auo *ApplicationUpdateOne) ClearBaselines() *ApplicationUpdateOne {
+	auo.mutation.ClearBaselines()
+	return auo
+}
+
+// RemoveBaselineIDs removes the baselines edge to Baseline by ids.
+func (auo *ApplicationUpdateOne) RemoveBaselineIDs(ids ...int) *ApplicationUpdateOne {
+	auo.mutation.RemoveBaselineIDs(ids...)
+	return auo
+}
+
+// RemoveBaselines removes baselines edges to Baseline.
+func (auo *ApplicationUpdateOne) RemoveBaselines(b ...*Baseline) *ApplicationUpdateOne {
+	ids := make([]int, len(b))
+	for i := range b {
+		ids[i] = b[i].ID
+	}
+	return auo.RemoveBaselineIDs(ids...)
+}
+
+// ClearEvents clears all "events" edges to type Event.
+func (auo *ApplicationUpdateOne) ClearEvents() *ApplicationUpdateOne {
+	auo.mutation.ClearEvents()
+	return auo
+}
+
+// RemoveEventIDs removes the events edge to Event by ids.
+func (auo *ApplicationUpdateOne) RemoveEventIDs(ids ...int) *ApplicationUpdateOne {
+	auo.mutation.RemoveEventIDs(ids...)
+	return auo
+}
+
+// RemoveEvents removes events edges to Event.
+func (auo *ApplicationUpdateOne) RemoveEvents(e ...*Event) *ApplicationUpdateOne {
+	ids := make([]int, len(e))
+	for i := range e {
+		ids[i] = e[i].ID
+	}
+	return auo.RemoveEventIDs(ids...)
+}
+
+// ClearExecutors clears all "executors" edges to type Executor.
+func (auo *ApplicationUpdateOne) ClearExecutors() *ApplicationUpdateOne {
+	auo.mutation.ClearExecutors()
+	return auo
+}
+
+// RemoveExecutorIDs removes the executors edge to Executor by ids.
+func (auo *ApplicationUpdateOne) RemoveExecutorIDs(ids ...int) *ApplicationUpdateOne {
+	auo.mutation.RemoveExecutorIDs(ids...)
+	return auo
+}
+
+// RemoveExecutors removes executors edges to Executor.
+func (auo *ApplicationUpdateOne) RemoveExecutors(e ...*Executor) *ApplicationUpdateOne {
+	ids := make([]int, len(e))
+	for i := range e {
+		ids[i] = e[i].ID
+	}
+	return auo.RemoveExecutorIDs(ids...)
+}
+
+// ClearProject clears the "project" edge to type Project.
+func (auo *ApplicationUpdateOne) ClearProject() *ApplicationUpdateOne {
+	auo.mutation.ClearProject()
+	return auo
+}
+
 // Save executes the query and returns the updated entity.
 func (auo *ApplicationUpdateOne) Save(ctx context.Context) (*Application, error) {
 	var (
@@ -739,6 +1568,19 @@ func (auo *ApplicationUpdateOne) sqlSave(ctx context.Context) (_node *Applicatio
 			Column: application.FieldUpdatedAt,
 		})
 	}
+	if value, ok := auo.mutation.FinishedAt(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeTime,
+			Value:  value,
+			Column: application.FieldFinishedAt,
+		})
+	}
+	if auo.mutation.FinishedAtCleared() {
+		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
+			Type:   field.TypeTime,
+			Column: application.FieldFinishedAt,
+		})
+	}
 	if value, ok := auo.mutation.Scenario(); ok {
 		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
 			Type:   field.TypeString,
@@ -760,6 +1602,91 @@ func (auo *ApplicationUpdateOne) sqlSave(ctx context.Context) (_node *Applicatio
 			Column: application.FieldGosum,
 		})
 	}
+	if value, ok := auo.mutation.Sinks(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeJSON,
+			Value:  value,
+			Column: application.FieldSinks,
+		})
+	}
+	if auo.mutation.SinksCleared() {
+		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
+			Type:   field.TypeJSON,
+			Column: application.FieldSinks,
+		})
+	}
+	if value, ok := auo.mutation.SinkTokens(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: application.FieldSinkTokens,
+		})
+	}
+	if auo.mutation.SinkTokensCleared() {
+		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Column: application.FieldSinkTokens,
+		})
+	}
+	if value, ok := auo.mutation.Imported(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeBool,
+			Value:  value,
+			Column: application.FieldImported,
+		})
+	}
+	if auo.mutation.ImportedCleared() {
+		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
+			Type:   field.TypeBool,
+			Column: application.FieldImported,
+		})
+	}
+	if value, ok := auo.mutation.Verdict(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: application.FieldVerdict,
+		})
+	}
+	if auo.mutation.VerdictCleared() {
+		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Column: application.FieldVerdict,
+		})
+	}
+	if value, ok := auo.mutation.Checks(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeJSON,
+			Value:  value,
+			Column: application.FieldChecks,
+		})
+	}
+	if auo.mutation.ChecksCleared() {
+		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
+			Type:   field.TypeJSON,
+			Column: application.FieldChecks,
+		})
+	}
+	if value, ok := auo.mutation.BaselineAppID(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt,
+			Value:  value,
+			Column: application.FieldBaselineAppID,
+		})
+	}
+	if value, ok := auo.mutation.AddedBaselineAppID(); ok {
+		_spec.Fields.Add = append(_spec.Fields.Add, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt,
+			Value:  value,
+			Column: application.FieldBaselineAppID,
+		})
+	}
+	if auo.mutation.BaselineAppIDCleared() {
+		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt,
+			Column: application.FieldBaselineAppID,
+		})
+	}
 	if auo.mutation.GroupsCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
@@ -868,6 +1795,203 @@ func (auo *ApplicationUpdateOne) sqlSave(ctx context.Context) (_node *Applicatio
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
+	if auo.mutation.BaselinesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   application.BaselinesTable,
+			Columns: []string{application.BaselinesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt,
+					Column: baseline.FieldID,
+				},
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := auo.mutation.RemovedBaselinesIDs(); len(nodes) > 0 && !auo.mutation.BaselinesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   application.BaselinesTable,
+			Columns: []string{application.BaselinesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt,
+					Column: baseline.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := auo.mutation.BaselinesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   application.BaselinesTable,
+			Columns: []string{application.BaselinesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt,
+					Column: baseline.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if auo.mutation.EventsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   application.EventsTable,
+			Columns: []string{application.EventsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt,
+					Column: event.FieldID,
+				},
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := auo.mutation.RemovedEventsIDs(); len(nodes) > 0 && !auo.mutation.EventsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   application.EventsTable,
+			Columns: []string{application.EventsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt,
+					Column: event.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := auo.mutation.EventsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   application.EventsTable,
+			Columns: []string{application.EventsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt,
+					Column: event.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if auo.mutation.ExecutorsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   application.ExecutorsTable,
+			Columns: []string{application.ExecutorsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt,
+					Column: executor.FieldID,
+				},
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := auo.mutation.RemovedExecutorsIDs(); len(nodes) > 0 && !auo.mutation.ExecutorsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   application.ExecutorsTable,
+			Columns: []string{application.ExecutorsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt,
+					Column: executor.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := auo.mutation.ExecutorsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   application.ExecutorsTable,
+			Columns: []string{application.ExecutorsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt,
+					Column: executor.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if auo.mutation.ProjectCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   application.ProjectTable,
+			Columns: []string{application.ProjectColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt,
+					Column: project.FieldID,
+				},
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := auo.mutation.ProjectIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   application.ProjectTable,
+			Columns: []string{application.ProjectColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt,
+					Column: project.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
 	_node = &Application{config: auo.config}
 	_spec.Assign = _node.assignValues
 	_spec.ScanValues = _node.scanValues()
@@ -0,0 +1,137 @@
+// Code generated by entc, DO NOT EDIT.
+
+package ent
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/facebook/ent/dialect/sql"
+	"github.com/gobench-io/gobench/ent/audit"
+)
+
+// Audit is the model entity for the Audit schema.
+type Audit struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID int `json:"id,omitempty"`
+	// Username holds the value of the "username" field.
+	Username string `json:"username,omitempty"`
+	// Action holds the value of the "action" field.
+	Action audit.Action `json:"action,omitempty"`
+	// ApplicationID holds the value of the "application_id" field.
+	ApplicationID int `json:"application_id,omitempty"`
+	// Tag holds the value of the "tag" field.
+	Tag string `json:"tag,omitempty"`
+	// IP holds the value of the "ip" field.
+	IP string `json:"ip,omitempty"`
+	// CreatedAt holds the value of the "created_at" field.
+	CreatedAt time.Time `json:"created_at,omitempty"`
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*Audit) scanValues() []interface{} {
+	return []interface{}{
+		&sql.NullInt64{},  // id
+		&sql.NullString{}, // username
+		&sql.NullString{}, // action
+		&sql.NullInt64{},  // application_id
+		&sql.NullString{}, // tag
+		&sql.NullString{}, // ip
+		&sql.NullTime{},   // created_at
+	}
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the Audit fields.
+func (a *Audit) assignValues(values ...interface{}) error {
+	if m, n := len(values), len(audit.Columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	value, ok := values[0].(*sql.NullInt64)
+	if !ok {
+		return fmt.Errorf("unexpected type %T for field id", value)
+	}
+	a.ID = int(value.Int64)
+	values = values[1:]
+	if value, ok := values[0].(*sql.NullString); !ok {
+		return fmt.Errorf("unexpected type %T for field username", values[0])
+	} else if value.Valid {
+		a.Username = value.String
+	}
+	if value, ok := values[1].(*sql.NullString); !ok {
+		return fmt.Errorf("unexpected type %T for field action", values[1])
+	} else if value.Valid {
+		a.Action = audit.Action(value.String)
+	}
+	if value, ok := values[2].(*sql.NullInt64); !ok {
+		return fmt.Errorf("unexpected type %T for field application_id", values[2])
+	} else if value.Valid {
+		a.ApplicationID = int(value.Int64)
+	}
+	if value, ok := values[3].(*sql.NullString); !ok {
+		return fmt.Errorf("unexpected type %T for field tag", values[3])
+	} else if value.Valid {
+		a.Tag = value.String
+	}
+	if value, ok := values[4].(*sql.NullString); !ok {
+		return fmt.Errorf("unexpected type %T for field ip", values[4])
+	} else if value.Valid {
+		a.IP = value.String
+	}
+	if value, ok := values[5].(*sql.NullTime); !ok {
+		return fmt.Errorf("unexpected type %T for field created_at", values[5])
+	} else if value.Valid {
+		a.CreatedAt = value.Time
+	}
+	return nil
+}
+
+// Update returns a builder for updating this Audit.
+// Note that, you need to call Audit.Unwrap() before calling this method, if this Audit
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (a *Audit) Update() *AuditUpdateOne {
+	return (&AuditClient{config: a.config}).UpdateOne(a)
+}
+
+// Unwrap unwraps the entity that was returned from a transaction after it was closed,
+// so that all next queries will be executed through the driver which created the transaction.
+func (a *Audit) Unwrap() *Audit {
+	tx, ok := a.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: Audit is not a transactional entity")
+	}
+	a.config.driver = tx.drv
+	return a
+}
+
+// String implements the fmt.Stringer.
+func (a *Audit) String() string {
+	var builder strings.Builder
+	builder.WriteString("Audit(")
+	builder.WriteString(fmt.Sprintf("id=%v", a.ID))
+	builder.WriteString(", username=")
+	builder.WriteString(a.Username)
+	builder.WriteString(", action=")
+	builder.WriteString(fmt.Sprintf("%v", a.Action))
+	builder.WriteString(", application_id=")
+	builder.WriteString(fmt.Sprintf("%v", a.ApplicationID))
+	builder.WriteString(", tag=")
+	builder.WriteString(a.Tag)
+	builder.WriteString(", ip=")
+	builder.WriteString(a.IP)
+	builder.WriteString(", created_at=")
+	builder.WriteString(a.CreatedAt.Format(time.ANSIC))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// Audits is a parsable slice of Audit.
+type Audits []*Audit
+
+func (a Audits) config(cfg config) {
+	for _i := range a {
+		a[_i].config = cfg
+	}
+}
@@ -0,0 +1,85 @@
+// Code generated by entc, DO NOT EDIT.
+
+package audit
+
+import (
+	"fmt"
+	"time"
+)
+
+const (
+	// Label holds the string label denoting the audit type in the database.
+	Label = "audit"
+	// FieldID holds the string denoting the id field in the database.
+	FieldID = "id"
+	// FieldUsername holds the string denoting the username field in the database.
+	FieldUsername = "username"
+	// FieldAction holds the string denoting the action field in the database.
+	FieldAction = "action"
+	// FieldApplicationID holds the string denoting the application_id field in the database.
+	FieldApplicationID = "application_id"
+	// FieldTag holds the string denoting the tag field in the database.
+	FieldTag = "tag"
+	// FieldIP holds the string denoting the ip field in the database.
+	FieldIP = "ip"
+	// FieldCreatedAt holds the string denoting the created_at field in the database.
+	FieldCreatedAt = "created_at"
+
+	// Table holds the table name of the audit in the database.
+	Table = "audits"
+)
+
+// Columns holds all SQL columns for audit fields.
+var Columns = []string{
+	FieldID,
+	FieldUsername,
+	FieldAction,
+	FieldApplicationID,
+	FieldTag,
+	FieldIP,
+	FieldCreatedAt,
+}
+
+// ValidColumn reports if the column name is valid (part of the table columns).
+func ValidColumn(column string) bool {
+	for i := range Columns {
+		if column == Columns[i] {
+			return true
+		}
+	}
+	return false
+}
+
+var (
+	// DefaultCreatedAt holds the default value on creation for the created_at field.
+	DefaultCreatedAt func() time.Time
+)
+
+// Action defines the type for the action enum field.
+type Action string
+
+// Action values.
+const (
+	ActionLogin       Action = "login"
+	ActionLoginFailed Action = "login_failed"
+	ActionCreate      Action = "create"
+	ActionImport      Action = "import"
+	ActionCancel      Action = "cancel"
+	ActionDelete      Action = "delete"
+	ActionTag         Action = "tag"
+	ActionUntag       Action = "untag"
+)
+
+func (a Action) String() string {
+	return string(a)
+}
+
+// ActionValidator is a validator for the "action" field enum values. It is called by the builders before save.
+func ActionValidator(a Action) error {
+	switch a {
+	case ActionLogin, ActionLoginFailed, ActionCreate, ActionImport, ActionCancel, ActionDelete, ActionTag, ActionUntag:
+		return nil
+	default:
+		return fmt.Errorf("audit: invalid enum value for action field: %q", a)
+	}
+}
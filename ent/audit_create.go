@@ -0,0 +1,303 @@
+// Code generated by entc, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/facebook/ent/dialect/sql/sqlgraph"
+	"github.com/facebook/ent/schema/field"
+	"github.com/gobench-io/gobench/ent/audit"
+)
+
+// AuditCreate is the builder for creating a Audit entity.
+type AuditCreate struct {
+	config
+	mutation *AuditMutation
+	hooks    []Hook
+}
+
+// SetUsername sets the username field.
+func (ac *AuditCreate) SetUsername(s string) *AuditCreate {
+	ac.mutation.SetUsername(s)
+	return ac
+}
+
+// SetAction sets the action field.
+func (ac *AuditCreate) SetAction(a audit.Action) *AuditCreate {
+	ac.mutation.SetAction(a)
+	return ac
+}
+
+// SetApplicationID sets the application_id field.
+func (ac *AuditCreate) SetApplicationID(i int) *AuditCreate {
+	ac.mutation.SetApplicationID(i)
+	return ac
+}
+
+// SetNillableApplicationID sets the application_id field if the given value is not nil.
+func (ac *AuditCreate) SetNillableApplicationID(i *int) *AuditCreate {
+	if i != nil {
+		ac.SetApplicationID(*i)
+	}
+	return ac
+}
+
+// SetTag sets the tag field.
+func (ac *AuditCreate) SetTag(s string) *AuditCreate {
+	ac.mutation.SetTag(s)
+	return ac
+}
+
+// SetNillableTag sets the tag field if the given value is not nil.
+func (ac *AuditCreate) SetNillableTag(s *string) *AuditCreate {
+	if s != nil {
+		ac.SetTag(*s)
+	}
+	return ac
+}
+
+// SetIP sets the ip field.
+func (ac *AuditCreate) SetIP(s string) *AuditCreate {
+	ac.mutation.SetIP(s)
+	return ac
+}
+
+// SetCreatedAt sets the created_at field.
+func (ac *AuditCreate) SetCreatedAt(t time.Time) *AuditCreate {
+	ac.mutation.SetCreatedAt(t)
+	return ac
+}
+
+// SetNillableCreatedAt sets the created_at field if the given value is not nil.
+func (ac *AuditCreate) SetNillableCreatedAt(t *time.Time) *AuditCreate {
+	if t != nil {
+		ac.SetCreatedAt(*t)
+	}
+	return ac
+}
+
+// Mutation returns the AuditMutation object of the builder.
+func (ac *AuditCreate) Mutation() *AuditMutation {
+	return ac.mutation
+}
+
+// Save creates the Audit in the database.
+func (ac *AuditCreate) Save(ctx context.Context) (*Audit, error) {
+	var (
+		err  error
+		node *Audit
+	)
+	ac.defaults()
+	if len(ac.hooks) == 0 {
+		if err = ac.check(); err != nil {
+			return nil, err
+		}
+		node, err = ac.sqlSave(ctx)
+	} else {
+		var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+			mutation, ok := m.(*AuditMutation)
+			if !ok {
+				return nil, fmt.Errorf("unexpected mutation type %T", m)
+			}
+			if err = ac.check(); err != nil {
+				return nil, err
+			}
+			ac.mutation = mutation
+			node, err = ac.sqlSave(ctx)
+			mutation.done = true
+			return node, err
+		})
+		for i := len(ac.hooks) - 1; i >= 0; i-- {
+			mut = ac.hooks[i](mut)
+		}
+		if _, err := mut.Mutate(ctx, ac.mutation); err != nil {
+			return nil, err
+		}
+	}
+	return node, err
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (ac *AuditCreate) SaveX(ctx context.Context) *Audit {
+	v, err := ac.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// defaults sets the default values of the builder before save.
+func (ac *AuditCreate) defaults() {
+	if _, ok := ac.mutation.CreatedAt(); !ok {
+		v := audit.DefaultCreatedAt()
+		ac.mutation.SetCreatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (ac *AuditCreate) check() error {
+	if _, ok := ac.mutation.Username(); !ok {
+		return &ValidationError{Name: "username", err: errors.New("ent: missing required field \"username\"")}
+	}
+	if _, ok := ac.mutation.Action(); !ok {
+		return &ValidationError{Name: "action", err: errors.New("ent: missing required field \"action\"")}
+	}
+	if v, ok := ac.mutation.Action(); ok {
+		if err := audit.ActionValidator(v); err != nil {
+			return &ValidationError{Name: "action", err: fmt.Errorf("ent: validator failed for field \"action\": %w", err)}
+		}
+	}
+	if _, ok := ac.mutation.IP(); !ok {
+		return &ValidationError{Name: "ip", err: errors.New("ent: missing required field \"ip\"")}
+	}
+	if _, ok := ac.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New("ent: missing required field \"created_at\"")}
+	}
+	return nil
+}
+
+func (ac *AuditCreate) sqlSave(ctx context.Context) (*Audit, error) {
+	_node, _spec := ac.createSpec()
+	if err := sqlgraph.CreateNode(ctx, ac.driver, _spec); err != nil {
+		if cerr, ok := isSQLConstraintError(err); ok {
+			err = cerr
+		}
+		return nil, err
+	}
+	id := _spec.ID.Value.(int64)
+	_node.ID = int(id)
+	return _node, nil
+}
+
+func (ac *AuditCreate) createSpec() (*Audit, *sqlgraph.CreateSpec) {
+	var (
+		_node = &Audit{config: ac.config}
+		_spec = &sqlgraph.CreateSpec{
+			Table: audit.Table,
+			ID: &sqlgraph.FieldSpec{
+				Type:   field.TypeInt,
+				Column: audit.FieldID,
+			},
+		}
+	)
+	if value, ok := ac.mutation.Username(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: audit.FieldUsername,
+		})
+		_node.Username = value
+	}
+	if value, ok := ac.mutation.Action(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeEnum,
+			Value:  value,
+			Column: audit.FieldAction,
+		})
+		_node.Action = value
+	}
+	if value, ok := ac.mutation.ApplicationID(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt,
+			Value:  value,
+			Column: audit.FieldApplicationID,
+		})
+		_node.ApplicationID = value
+	}
+	if value, ok := ac.mutation.Tag(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: audit.FieldTag,
+		})
+		_node.Tag = value
+	}
+	if value, ok := ac.mutation.IP(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: audit.FieldIP,
+		})
+		_node.IP = value
+	}
+	if value, ok := ac.mutation.CreatedAt(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeTime,
+			Value:  value,
+			Column: audit.FieldCreatedAt,
+		})
+		_node.CreatedAt = value
+	}
+	return _node, _spec
+}
+
+// AuditCreateBulk is the builder for creating a bulk of Audit entities.
+type AuditCreateBulk struct {
+	config
+	builders []*AuditCreate
+}
+
+// Save creates the Audit entities in the database.
+func (acb *AuditCreateBulk) Save(ctx context.Context) ([]*Audit, error) {
+	specs := make([]*sqlgraph.CreateSpec, len(acb.builders))
+	nodes := make([]*Audit, len(acb.builders))
+	mutators := make([]Mutator, len(acb.builders))
+	for i := range acb.builders {
+		func(i int, root context.Context) {
+			builder := acb.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*AuditMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				nodes[i], specs[i] = builder.createSpec()
+				var err error
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, acb.builders[i+1].mutation)
+				} else {
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, acb.driver, &sqlgraph.BatchCreateSpec{Nodes: specs}); err != nil {
+						if cerr, ok := isSQLConstraintError(err); ok {
+							err = cerr
+						}
+					}
+				}
+				mutation.done = true
+				if err != nil {
+					return nil, err
+				}
+				id := specs[i].ID.Value.(int64)
+				nodes[i].ID = int(id)
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, acb.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (acb *AuditCreateBulk) SaveX(ctx context.Context) []*Audit {
+	v, err := acb.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
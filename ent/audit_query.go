@@ -0,0 +1,878 @@
+// Code generated by entc, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math"
+
+	"github.com/facebook/ent/dialect/sql"
+	"github.com/facebook/ent/dialect/sql/sqlgraph"
+	"github.com/facebook/ent/schema/field"
+	"github.com/gobench-io/gobench/ent/audit"
+	"github.com/gobench-io/gobench/ent/predicate"
+)
+
+// AuditQuery is the builder for querying Audit entities.
+type AuditQuery struct {
+	config
+	limit      *int
+	offset     *int
+	order      []OrderFunc
+	unique     []string
+	predicates []predicate.Audit
+	// intermediate query (i.e. traversal path).
+	sql  *sql.Selector
+	path func(context.Context) (*sql.Selector, error)
+}
+
+// Where adds a new predicate for the builder.
+func (aq *AuditQuery) Where(ps ...predicate.Audit) *AuditQuery {
+	aq.predicates = append(aq.predicates, ps...)
+	return aq
+}
+
+// Limit adds a limit step to the query.
+func (aq *AuditQuery) Limit(limit int) *AuditQuery {
+	aq.limit = &limit
+	return aq
+}
+
+// Offset adds an offset step to the query.
+func (aq *AuditQuery) Offset(offset int) *AuditQuery {
+	aq.offset = &offset
+	return aq
+}
+
+// Order adds an order step to the query.
+func (aq *AuditQuery) Order(o ...OrderFunc) *AuditQuery {
+	aq.order = append(aq.order, o...)
+	return aq
+}
+
+// First returns the first Audit entity in the query. Returns *NotFoundError when no audit was found.
+func (aq *AuditQuery) First(ctx context.Context) (*Audit, error) {
+	nodes, err := aq.Limit(1).All(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nil, &NotFoundError{audit.Label}
+	}
+	return nodes[0], nil
+}
+
+// FirstX is like First, but panics if an error occurs.
+func (aq *AuditQuery) FirstX(ctx context.Context) *Audit {
+	node, err := aq.First(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return node
+}
+
+// FirstID returns the first Audit id in the query. Returns *NotFoundError when no id was found.
+func (aq *AuditQuery) FirstID(ctx context.Context) (id int, err error) {
+	var ids []int
+	if ids, err = aq.Limit(1).IDs(ctx); err != nil {
+		return
+	}
+	if len(ids) == 0 {
+		err = &NotFoundError{audit.Label}
+		return
+	}
+	return ids[0], nil
+}
+
+// FirstXID is like FirstID, but panics if an error occurs.
+func (aq *AuditQuery) FirstXID(ctx context.Context) int {
+	id, err := aq.FirstID(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return id
+}
+
+// Only returns the only Audit entity in the query, returns an error if not exactly one entity was returned.
+func (aq *AuditQuery) Only(ctx context.Context) (*Audit, error) {
+	nodes, err := aq.Limit(2).All(ctx)
+	if err != nil {
+		return nil, err
+	}
+	switch len(nodes) {
+	case 1:
+		return nodes[0], nil
+	case 0:
+		return nil, &NotFoundError{audit.Label}
+	default:
+		return nil, &NotSingularError{audit.Label}
+	}
+}
+
+// OnlyX is like Only, but panics if an error occurs.
+func (aq *AuditQuery) OnlyX(ctx context.Context) *Audit {
+	node, err := aq.Only(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// OnlyID returns the only Audit id in the query, returns an error if not exactly one id was returned.
+func (aq *AuditQuery) OnlyID(ctx context.Context) (id int, err error) {
+	var ids []int
+	if ids, err = aq.Limit(2).IDs(ctx); err != nil {
+		return
+	}
+	switch len(ids) {
+	case 1:
+		id = ids[0]
+	case 0:
+		err = &NotFoundError{audit.Label}
+	default:
+		err = &NotSingularError{audit.Label}
+	}
+	return
+}
+
+// OnlyIDX is like OnlyID, but panics if an error occurs.
+func (aq *AuditQuery) OnlyIDX(ctx context.Context) int {
+	id, err := aq.OnlyID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// All executes the query and returns a list of Audits.
+func (aq *AuditQuery) All(ctx context.Context) ([]*Audit, error) {
+	if err := aq.prepareQuery(ctx); err != nil {
+		return nil, err
+	}
+	return aq.sqlAll(ctx)
+}
+
+// AllX is like All, but panics if an error occurs.
+func (aq *AuditQuery) AllX(ctx context.Context) []*Audit {
+	nodes, err := aq.All(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return nodes
+}
+
+// IDs executes the query and returns a list of Audit ids.
+func (aq *AuditQuery) IDs(ctx context.Context) ([]int, error) {
+	var ids []int
+	if err := aq.Select(audit.FieldID).Scan(ctx, &ids); err != nil {
+		return nil, err
+	}
+	return ids, nil
+}
+
+// IDsX is like IDs, but panics if an error occurs.
+func (aq *AuditQuery) IDsX(ctx context.Context) []int {
+	ids, err := aq.IDs(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return ids
+}
+
+// Count returns the count of the given query.
+func (aq *AuditQuery) Count(ctx context.Context) (int, error) {
+	if err := aq.prepareQuery(ctx); err != nil {
+		return 0, err
+	}
+	return aq.sqlCount(ctx)
+}
+
+// CountX is like Count, but panics if an error occurs.
+func (aq *AuditQuery) CountX(ctx context.Context) int {
+	count, err := aq.Count(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return count
+}
+
+// Exist returns true if the query has elements in the graph.
+func (aq *AuditQuery) Exist(ctx context.Context) (bool, error) {
+	if err := aq.prepareQuery(ctx); err != nil {
+		return false, err
+	}
+	return aq.sqlExist(ctx)
+}
+
+// ExistX is like Exist, but panics if an error occurs.
+func (aq *AuditQuery) ExistX(ctx context.Context) bool {
+	exist, err := aq.Exist(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return exist
+}
+
+// Clone returns a duplicate of the query builder, including all associated steps. It can be
+// used to prepare common query builders and use them differently after the clone is made.
+func (aq *AuditQuery) Clone() *AuditQuery {
+	return &AuditQuery{
+		config:     aq.config,
+		limit:      aq.limit,
+		offset:     aq.offset,
+		order:      append([]OrderFunc{}, aq.order...),
+		unique:     append([]string{}, aq.unique...),
+		predicates: append([]predicate.Audit{}, aq.predicates...),
+		// clone intermediate query.
+		sql:  aq.sql.Clone(),
+		path: aq.path,
+	}
+}
+
+// GroupBy used to group vertices by one or more fields/columns.
+// It is often used with aggregate functions, like: count, max, mean, min, sum.
+//
+// Example:
+//
+//	var v []struct {
+//		Username string `json:"username,omitempty"`
+//		Count int `json:"count,omitempty"`
+//	}
+//
+//	client.Audit.Query().
+//		GroupBy(audit.FieldUsername).
+//		Aggregate(ent.Count()).
+//		Scan(ctx, &v)
+func (aq *AuditQuery) GroupBy(field string, fields ...string) *AuditGroupBy {
+	group := &AuditGroupBy{config: aq.config}
+	group.fields = append([]string{field}, fields...)
+	group.path = func(ctx context.Context) (prev *sql.Selector, err error) {
+		if err := aq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		return aq.sqlQuery(), nil
+	}
+	return group
+}
+
+// Select one or more fields from the given query.
+//
+// Example:
+//
+//	var v []struct {
+//		Username string `json:"username,omitempty"`
+//	}
+//
+//	client.Audit.Query().
+//		Select(audit.FieldUsername).
+//		Scan(ctx, &v)
+func (aq *AuditQuery) Select(field string, fields ...string) *AuditSelect {
+	selector := &AuditSelect{config: aq.config}
+	selector.fields = append([]string{field}, fields...)
+	selector.path = func(ctx context.Context) (prev *sql.Selector, err error) {
+		if err := aq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		return aq.sqlQuery(), nil
+	}
+	return selector
+}
+
+func (aq *AuditQuery) prepareQuery(ctx context.Context) error {
+	if aq.path != nil {
+		prev, err := aq.path(ctx)
+		if err != nil {
+			return err
+		}
+		aq.sql = prev
+	}
+	return nil
+}
+
+func (aq *AuditQuery) sqlAll(ctx context.Context) ([]*Audit, error) {
+	var (
+		nodes = []*Audit{}
+		_spec = aq.querySpec()
+	)
+	_spec.ScanValues = func() []interface{} {
+		node := &Audit{config: aq.config}
+		nodes = append(nodes, node)
+		values := node.scanValues()
+		return values
+	}
+	_spec.Assign = func(values ...interface{}) error {
+		if len(nodes) == 0 {
+			return fmt.Errorf("ent: Assign called without calling ScanValues")
+		}
+		node := nodes[len(nodes)-1]
+		return node.assignValues(values...)
+	}
+	if err := sqlgraph.QueryNodes(ctx, aq.driver, _spec); err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nodes, nil
+	}
+	return nodes, nil
+}
+
+func (aq *AuditQuery) sqlCount(ctx context.Context) (int, error) {
+	_spec := aq.querySpec()
+	return sqlgraph.CountNodes(ctx, aq.driver, _spec)
+}
+
+func (aq *AuditQuery) sqlExist(ctx context.Context) (bool, error) {
+	n, err := aq.sqlCount(ctx)
+	if err != nil {
+		return false, fmt.Errorf("ent: check existence: %v", err)
+	}
+	return n > 0, nil
+}
+
+func (aq *AuditQuery) querySpec() *sqlgraph.QuerySpec {
+	_spec := &sqlgraph.QuerySpec{
+		Node: &sqlgraph.NodeSpec{
+			Table:   audit.Table,
+			Columns: audit.Columns,
+			ID: &sqlgraph.FieldSpec{
+				Type:   field.TypeInt,
+				Column: audit.FieldID,
+			},
+		},
+		From:   aq.sql,
+		Unique: true,
+	}
+	if ps := aq.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if limit := aq.limit; limit != nil {
+		_spec.Limit = *limit
+	}
+	if offset := aq.offset; offset != nil {
+		_spec.Offset = *offset
+	}
+	if ps := aq.order; len(ps) > 0 {
+		_spec.Order = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector, audit.ValidColumn)
+			}
+		}
+	}
+	return _spec
+}
+
+func (aq *AuditQuery) sqlQuery() *sql.Selector {
+	builder := sql.Dialect(aq.driver.Dialect())
+	t1 := builder.Table(audit.Table)
+	selector := builder.Select(t1.Columns(audit.Columns...)...).From(t1)
+	if aq.sql != nil {
+		selector = aq.sql
+		selector.Select(selector.Columns(audit.Columns...)...)
+	}
+	for _, p := range aq.predicates {
+		p(selector)
+	}
+	for _, p := range aq.order {
+		p(selector, audit.ValidColumn)
+	}
+	if offset := aq.offset; offset != nil {
+		// limit is mandatory for offset clause. We start
+		// with default value, and override it below if needed.
+		selector.Offset(*offset).Limit(math.MaxInt32)
+	}
+	if limit := aq.limit; limit != nil {
+		selector.Limit(*limit)
+	}
+	return selector
+}
+
+// AuditGroupBy is the builder for group-by Audit entities.
+type AuditGroupBy struct {
+	config
+	fields []string
+	fns    []AggregateFunc
+	// intermediate query (i.e. traversal path).
+	sql  *sql.Selector
+	path func(context.Context) (*sql.Selector, error)
+}
+
+// Aggregate adds the given aggregation functions to the group-by query.
+func (agb *AuditGroupBy) Aggregate(fns ...AggregateFunc) *AuditGroupBy {
+	agb.fns = append(agb.fns, fns...)
+	return agb
+}
+
+// Scan applies the group-by query and scan the result into the given value.
+func (agb *AuditGroupBy) Scan(ctx context.Context, v interface{}) error {
+	query, err := agb.path(ctx)
+	if err != nil {
+		return err
+	}
+	agb.sql = query
+	return agb.sqlScan(ctx, v)
+}
+
+// ScanX is like Scan, but panics if an error occurs.
+func (agb *AuditGroupBy) ScanX(ctx context.Context, v interface{}) {
+	if err := agb.Scan(ctx, v); err != nil {
+		panic(err)
+	}
+}
+
+// Strings returns list of strings from group-by. It is only allowed when querying group-by with one field.
+func (agb *AuditGroupBy) Strings(ctx context.Context) ([]string, error) {
+	if len(agb.fields) > 1 {
+		return nil, errors.New("ent: AuditGroupBy.Strings is not achievable when grouping more than 1 field")
+	}
+	var v []string
+	if err := agb.Scan(ctx, &v); err != nil {
+		return nil, err
+	}
+	return v, nil
+}
+
+// StringsX is like Strings, but panics if an error occurs.
+func (agb *AuditGroupBy) StringsX(ctx context.Context) []string {
+	v, err := agb.Strings(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// String returns a single string from group-by. It is only allowed when querying group-by with one field.
+func (agb *AuditGroupBy) String(ctx context.Context) (_ string, err error) {
+	var v []string
+	if v, err = agb.Strings(ctx); err != nil {
+		return
+	}
+	switch len(v) {
+	case 1:
+		return v[0], nil
+	case 0:
+		err = &NotFoundError{audit.Label}
+	default:
+		err = fmt.Errorf("ent: AuditGroupBy.Strings returned %d results when one was expected", len(v))
+	}
+	return
+}
+
+// StringX is like String, but panics if an error occurs.
+func (agb *AuditGroupBy) StringX(ctx context.Context) string {
+	v, err := agb.String(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Ints returns list of ints from group-by. It is only allowed when querying group-by with one field.
+func (agb *AuditGroupBy) Ints(ctx context.Context) ([]int, error) {
+	if len(agb.fields) > 1 {
+		return nil, errors.New("ent: AuditGroupBy.Ints is not achievable when grouping more than 1 field")
+	}
+	var v []int
+	if err := agb.Scan(ctx, &v); err != nil {
+		return nil, err
+	}
+	return v, nil
+}
+
+// IntsX is like Ints, but panics if an error occurs.
+func (agb *AuditGroupBy) IntsX(ctx context.Context) []int {
+	v, err := agb.Ints(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Int returns a single int from group-by. It is only allowed when querying group-by with one field.
+func (agb *AuditGroupBy) Int(ctx context.Context) (_ int, err error) {
+	var v []int
+	if v, err = agb.Ints(ctx); err != nil {
+		return
+	}
+	switch len(v) {
+	case 1:
+		return v[0], nil
+	case 0:
+		err = &NotFoundError{audit.Label}
+	default:
+		err = fmt.Errorf("ent: AuditGroupBy.Ints returned %d results when one was expected", len(v))
+	}
+	return
+}
+
+// IntX is like Int, but panics if an error occurs.
+func (agb *AuditGroupBy) IntX(ctx context.Context) int {
+	v, err := agb.Int(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Float64s returns list of float64s from group-by. It is only allowed when querying group-by with one field.
+func (agb *AuditGroupBy) Float64s(ctx context.Context) ([]float64, error) {
+	if len(agb.fields) > 1 {
+		return nil, errors.New("ent: AuditGroupBy.Float64s is not achievable when grouping more than 1 field")
+	}
+	var v []float64
+	if err := agb.Scan(ctx, &v); err != nil {
+		return nil, err
+	}
+	return v, nil
+}
+
+// Float64sX is like Float64s, but panics if an error occurs.
+func (agb *AuditGroupBy) Float64sX(ctx context.Context) []float64 {
+	v, err := agb.Float64s(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Float64 returns a single float64 from group-by. It is only allowed when querying group-by with one field.
+func (agb *AuditGroupBy) Float64(ctx context.Context) (_ float64, err error) {
+	var v []float64
+	if v, err = agb.Float64s(ctx); err != nil {
+		return
+	}
+	switch len(v) {
+	case 1:
+		return v[0], nil
+	case 0:
+		err = &NotFoundError{audit.Label}
+	default:
+		err = fmt.Errorf("ent: AuditGroupBy.Float64s returned %d results when one was expected", len(v))
+	}
+	return
+}
+
+// Float64X is like Float64, but panics if an error occurs.
+func (agb *AuditGroupBy) Float64X(ctx context.Context) float64 {
+	v, err := agb.Float64(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Bools returns list of bools from group-by. It is only allowed when querying group-by with one field.
+func (agb *AuditGroupBy) Bools(ctx context.Context) ([]bool, error) {
+	if len(agb.fields) > 1 {
+		return nil, errors.New("ent: AuditGroupBy.Bools is not achievable when grouping more than 1 field")
+	}
+	var v []bool
+	if err := agb.Scan(ctx, &v); err != nil {
+		return nil, err
+	}
+	return v, nil
+}
+
+// BoolsX is like Bools, but panics if an error occurs.
+func (agb *AuditGroupBy) BoolsX(ctx context.Context) []bool {
+	v, err := agb.Bools(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Bool returns a single bool from group-by. It is only allowed when querying group-by with one field.
+func (agb *AuditGroupBy) Bool(ctx context.Context) (_ bool, err error) {
+	var v []bool
+	if v, err = agb.Bools(ctx); err != nil {
+		return
+	}
+	switch len(v) {
+	case 1:
+		return v[0], nil
+	case 0:
+		err = &NotFoundError{audit.Label}
+	default:
+		err = fmt.Errorf("ent: AuditGroupBy.Bools returned %d results when one was expected", len(v))
+	}
+	return
+}
+
+// BoolX is like Bool, but panics if an error occurs.
+func (agb *AuditGroupBy) BoolX(ctx context.Context) bool {
+	v, err := agb.Bool(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+func (agb *AuditGroupBy) sqlScan(ctx context.Context, v interface{}) error {
+	for _, f := range agb.fields {
+		if !audit.ValidColumn(f) {
+			return &ValidationError{Name: f, err: fmt.Errorf("invalid field %q for group-by", f)}
+		}
+	}
+	selector := agb.sqlQuery()
+	if err := selector.Err(); err != nil {
+		return err
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := agb.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
+
+func (agb *AuditGroupBy) sqlQuery() *sql.Selector {
+	selector := agb.sql
+	columns := make([]string, 0, len(agb.fields)+len(agb.fns))
+	columns = append(columns, agb.fields...)
+	for _, fn := range agb.fns {
+		columns = append(columns, fn(selector, audit.ValidColumn))
+	}
+	return selector.Select(columns...).GroupBy(agb.fields...)
+}
+
+// AuditSelect is the builder for select fields of Audit entities.
+type AuditSelect struct {
+	config
+	fields []string
+	// intermediate query (i.e. traversal path).
+	sql  *sql.Selector
+	path func(context.Context) (*sql.Selector, error)
+}
+
+// Scan applies the selector query and scan the result into the given value.
+func (as *AuditSelect) Scan(ctx context.Context, v interface{}) error {
+	query, err := as.path(ctx)
+	if err != nil {
+		return err
+	}
+	as.sql = query
+	return as.sqlScan(ctx, v)
+}
+
+// ScanX is like Scan, but panics if an error occurs.
+func (as *AuditSelect) ScanX(ctx context.Context, v interface{}) {
+	if err := as.Scan(ctx, v); err != nil {
+		panic(err)
+	}
+}
+
+// Strings returns list of strings from selector. It is only allowed when selecting one field.
+func (as *AuditSelect) Strings(ctx context.Context) ([]string, error) {
+	if len(as.fields) > 1 {
+		return nil, errors.New("ent: AuditSelect.Strings is not achievable when selecting more than 1 field")
+	}
+	var v []string
+	if err := as.Scan(ctx, &v); err != nil {
+		return nil, err
+	}
+	return v, nil
+}
+
+// StringsX is like Strings, but panics if an error occurs.
+func (as *AuditSelect) StringsX(ctx context.Context) []string {
+	v, err := as.Strings(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// String returns a single string from selector. It is only allowed when selecting one field.
+func (as *AuditSelect) String(ctx context.Context) (_ string, err error) {
+	var v []string
+	if v, err = as.Strings(ctx); err != nil {
+		return
+	}
+	switch len(v) {
+	case 1:
+		return v[0], nil
+	case 0:
+		err = &NotFoundError{audit.Label}
+	default:
+		err = fmt.Errorf("ent: AuditSelect.Strings returned %d results when one was expected", len(v))
+	}
+	return
+}
+
+// StringX is like String, but panics if an error occurs.
+func (as *AuditSelect) StringX(ctx context.Context) string {
+	v, err := as.String(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Ints returns list of ints from selector. It is only allowed when selecting one field.
+func (as *AuditSelect) Ints(ctx context.Context) ([]int, error) {
+	if len(as.fields) > 1 {
+		return nil, errors.New("ent: AuditSelect.Ints is not achievable when selecting more than 1 field")
+	}
+	var v []int
+	if err := as.Scan(ctx, &v); err != nil {
+		return nil, err
+	}
+	return v, nil
+}
+
+// IntsX is like Ints, but panics if an error occurs.
+func (as *AuditSelect) IntsX(ctx context.Context) []int {
+	v, err := as.Ints(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Int returns a single int from selector. It is only allowed when selecting one field.
+func (as *AuditSelect) Int(ctx context.Context) (_ int, err error) {
+	var v []int
+	if v, err = as.Ints(ctx); err != nil {
+		return
+	}
+	switch len(v) {
+	case 1:
+		return v[0], nil
+	case 0:
+		err = &NotFoundError{audit.Label}
+	default:
+		err = fmt.Errorf("ent: AuditSelect.Ints returned %d results when one was expected", len(v))
+	}
+	return
+}
+
+// IntX is like Int, but panics if an error occurs.
+func (as *AuditSelect) IntX(ctx context.Context) int {
+	v, err := as.Int(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Float64s returns list of float64s from selector. It is only allowed when selecting one field.
+func (as *AuditSelect) Float64s(ctx context.Context) ([]float64, error) {
+	if len(as.fields) > 1 {
+		return nil, errors.New("ent: AuditSelect.Float64s is not achievable when selecting more than 1 field")
+	}
+	var v []float64
+	if err := as.Scan(ctx, &v); err != nil {
+		return nil, err
+	}
+	return v, nil
+}
+
+// Float64sX is like Float64s, but panics if an error occurs.
+func (as *AuditSelect) Float64sX(ctx context.Context) []float64 {
+	v, err := as.Float64s(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Float64 returns a single float64 from selector. It is only allowed when selecting one field.
+func (as *AuditSelect) Float64(ctx context.Context) (_ float64, err error) {
+	var v []float64
+	if v, err = as.Float64s(ctx); err != nil {
+		return
+	}
+	switch len(v) {
+	case 1:
+		return v[0], nil
+	case 0:
+		err = &NotFoundError{audit.Label}
+	default:
+		err = fmt.Errorf("ent: AuditSelect.Float64s returned %d results when one was expected", len(v))
+	}
+	return
+}
+
+// Float64X is like Float64, but panics if an error occurs.
+func (as *AuditSelect) Float64X(ctx context.Context) float64 {
+	v, err := as.Float64(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Bools returns list of bools from selector. It is only allowed when selecting one field.
+func (as *AuditSelect) Bools(ctx context.Context) ([]bool, error) {
+	if len(as.fields) > 1 {
+		return nil, errors.New("ent: AuditSelect.Bools is not achievable when selecting more than 1 field")
+	}
+	var v []bool
+	if err := as.Scan(ctx, &v); err != nil {
+		return nil, err
+	}
+	return v, nil
+}
+
+// BoolsX is like Bools, but panics if an error occurs.
+func (as *AuditSelect) BoolsX(ctx context.Context) []bool {
+	v, err := as.Bools(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Bool returns a single bool from selector. It is only allowed when selecting one field.
+func (as *AuditSelect) Bool(ctx context.Context) (_ bool, err error) {
+	var v []bool
+	if v, err = as.Bools(ctx); err != nil {
+		return
+	}
+	switch len(v) {
+	case 1:
+		return v[0], nil
+	case 0:
+		err = &NotFoundError{audit.Label}
+	default:
+		err = fmt.Errorf("ent: AuditSelect.Bools returned %d results when one was expected", len(v))
+	}
+	return
+}
+
+// BoolX is like Bool, but panics if an error occurs.
+func (as *AuditSelect) BoolX(ctx context.Context) bool {
+	v, err := as.Bool(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+func (as *AuditSelect) sqlScan(ctx context.Context, v interface{}) error {
+	for _, f := range as.fields {
+		if !audit.ValidColumn(f) {
+			return &ValidationError{Name: f, err: fmt.Errorf("invalid field %q for selection", f)}
+		}
+	}
+	rows := &sql.Rows{}
+	query, args := as.sqlQuery().Query()
+	if err := as.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
+
+func (as *AuditSelect) sqlQuery() sql.Querier {
+	selector := as.sql
+	selector.Select(selector.Columns(as.fields...)...)
+	return selector
+}
@@ -0,0 +1,519 @@
+// Code generated by entc, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/facebook/ent/dialect/sql"
+	"github.com/facebook/ent/dialect/sql/sqlgraph"
+	"github.com/facebook/ent/schema/field"
+	"github.com/gobench-io/gobench/ent/audit"
+	"github.com/gobench-io/gobench/ent/predicate"
+)
+
+// AuditUpdate is the builder for updating Audit entities.
+type AuditUpdate struct {
+	config
+	hooks      []Hook
+	mutation   *AuditMutation
+	predicates []predicate.Audit
+}
+
+// Where adds a new predicate for the builder.
+func (au *AuditUpdate) Where(ps ...predicate.Audit) *AuditUpdate {
+	au.predicates = append(au.predicates, ps...)
+	return au
+}
+
+// SetUsername sets the username field.
+func (au *AuditUpdate) SetUsername(s string) *AuditUpdate {
+	au.mutation.SetUsername(s)
+	return au
+}
+
+// SetAction sets the action field.
+func (au *AuditUpdate) SetAction(a audit.Action) *AuditUpdate {
+	au.mutation.SetAction(a)
+	return au
+}
+
+// SetApplicationID sets the application_id field.
+func (au *AuditUpdate) SetApplicationID(i int) *AuditUpdate {
+	au.mutation.ResetApplicationID()
+	au.mutation.SetApplicationID(i)
+	return au
+}
+
+// SetNillableApplicationID sets the application_id field if the given value is not nil.
+func (au *AuditUpdate) SetNillableApplicationID(i *int) *AuditUpdate {
+	if i != nil {
+		au.SetApplicationID(*i)
+	}
+	return au
+}
+
+// AddApplicationID adds i to application_id.
+func (au *AuditUpdate) AddApplicationID(i int) *AuditUpdate {
+	au.mutation.AddApplicationID(i)
+	return au
+}
+
+// ClearApplicationID clears the value of application_id.
+func (au *AuditUpdate) ClearApplicationID() *AuditUpdate {
+	au.mutation.ClearApplicationID()
+	return au
+}
+
+// SetTag sets the tag field.
+func (au *AuditUpdate) SetTag(s string) *AuditUpdate {
+	au.mutation.SetTag(s)
+	return au
+}
+
+// SetNillableTag sets the tag field if the given value is not nil.
+func (au *AuditUpdate) SetNillableTag(s *string) *AuditUpdate {
+	if s != nil {
+		au.SetTag(*s)
+	}
+	return au
+}
+
+// ClearTag clears the value of tag.
+func (au *AuditUpdate) ClearTag() *AuditUpdate {
+	au.mutation.ClearTag()
+	return au
+}
+
+// SetIP sets the ip field.
+func (au *AuditUpdate) SetIP(s string) *AuditUpdate {
+	au.mutation.SetIP(s)
+	return au
+}
+
+// SetCreatedAt sets the created_at field.
+func (au *AuditUpdate) SetCreatedAt(t time.Time) *AuditUpdate {
+	au.mutation.SetCreatedAt(t)
+	return au
+}
+
+// SetNillableCreatedAt sets the created_at field if the given value is not nil.
+func (au *AuditUpdate) SetNillableCreatedAt(t *time.Time) *AuditUpdate {
+	if t != nil {
+		au.SetCreatedAt(*t)
+	}
+	return au
+}
+
+// Mutation returns the AuditMutation object of the builder.
+func (au *AuditUpdate) Mutation() *AuditMutation {
+	return au.mutation
+}
+
+// Save executes the query and returns the number of rows/vertices matched by this operation.
+func (au *AuditUpdate) Save(ctx context.Context) (int, error) {
+	var (
+		err      error
+		affected int
+	)
+	if len(au.hooks) == 0 {
+		if err = au.check(); err != nil {
+			return 0, err
+		}
+		affected, err = au.sqlSave(ctx)
+	} else {
+		var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+			mutation, ok := m.(*AuditMutation)
+			if !ok {
+				return nil, fmt.Errorf("unexpected mutation type %T", m)
+			}
+			if err = au.check(); err != nil {
+				return 0, err
+			}
+			au.mutation = mutation
+			affected, err = au.sqlSave(ctx)
+			mutation.done = true
+			return affected, err
+		})
+		for i := len(au.hooks) - 1; i >= 0; i-- {
+			mut = au.hooks[i](mut)
+		}
+		if _, err := mut.Mutate(ctx, au.mutation); err != nil {
+			return 0, err
+		}
+	}
+	return affected, err
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (au *AuditUpdate) SaveX(ctx context.Context) int {
+	affected, err := au.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (au *AuditUpdate) Exec(ctx context.Context) error {
+	_, err := au.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (au *AuditUpdate) ExecX(ctx context.Context) {
+	if err := au.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (au *AuditUpdate) check() error {
+	if v, ok := au.mutation.Action(); ok {
+		if err := audit.ActionValidator(v); err != nil {
+			return &ValidationError{Name: "action", err: fmt.Errorf("ent: validator failed for field \"action\": %w", err)}
+		}
+	}
+	return nil
+}
+
+func (au *AuditUpdate) sqlSave(ctx context.Context) (n int, err error) {
+	_spec := &sqlgraph.UpdateSpec{
+		Node: &sqlgraph.NodeSpec{
+			Table:   audit.Table,
+			Columns: audit.Columns,
+			ID: &sqlgraph.FieldSpec{
+				Type:   field.TypeInt,
+				Column: audit.FieldID,
+			},
+		},
+	}
+	if ps := au.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := au.mutation.Username(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: audit.FieldUsername,
+		})
+	}
+	if value, ok := au.mutation.Action(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeEnum,
+			Value:  value,
+			Column: audit.FieldAction,
+		})
+	}
+	if value, ok := au.mutation.ApplicationID(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt,
+			Value:  value,
+			Column: audit.FieldApplicationID,
+		})
+	}
+	if value, ok := au.mutation.AddedApplicationID(); ok {
+		_spec.Fields.Add = append(_spec.Fields.Add, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt,
+			Value:  value,
+			Column: audit.FieldApplicationID,
+		})
+	}
+	if au.mutation.ApplicationIDCleared() {
+		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt,
+			Column: audit.FieldApplicationID,
+		})
+	}
+	if value, ok := au.mutation.Tag(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: audit.FieldTag,
+		})
+	}
+	if au.mutation.TagCleared() {
+		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Column: audit.FieldTag,
+		})
+	}
+	if value, ok := au.mutation.IP(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: audit.FieldIP,
+		})
+	}
+	if value, ok := au.mutation.CreatedAt(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeTime,
+			Value:  value,
+			Column: audit.FieldCreatedAt,
+		})
+	}
+	if n, err = sqlgraph.UpdateNodes(ctx, au.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{audit.Label}
+		} else if cerr, ok := isSQLConstraintError(err); ok {
+			err = cerr
+		}
+		return 0, err
+	}
+	return n, nil
+}
+
+// AuditUpdateOne is the builder for updating a single Audit entity.
+type AuditUpdateOne struct {
+	config
+	hooks    []Hook
+	mutation *AuditMutation
+}
+
+// SetUsername sets the username field.
+func (auo *AuditUpdateOne) SetUsername(s string) *AuditUpdateOne {
+	auo.mutation.SetUsername(s)
+	return auo
+}
+
+// SetAction sets the action field.
+func (auo *AuditUpdateOne) SetAction(a audit.Action) *AuditUpdateOne {
+	auo.mutation.SetAction(a)
+	return auo
+}
+
+// SetApplicationID sets the application_id field.
+func (auo *AuditUpdateOne) SetApplicationID(i int) *AuditUpdateOne {
+	auo.mutation.ResetApplicationID()
+	auo.mutation.SetApplicationID(i)
+	return auo
+}
+
+// SetNillableApplicationID sets the application_id field if the given value is not nil.
+func (auo *AuditUpdateOne) SetNillableApplicationID(i *int) *AuditUpdateOne {
+	if i != nil {
+		auo.SetApplicationID(*i)
+	}
+	return auo
+}
+
+// AddApplicationID adds i to application_id.
+func (auo *AuditUpdateOne) AddApplicationID(i int) *AuditUpdateOne {
+	auo.mutation.AddApplicationID(i)
+	return auo
+}
+
+// ClearApplicationID clears the value of application_id.
+func (auo *AuditUpdateOne) ClearApplicationID() *AuditUpdateOne {
+	auo.mutation.ClearApplicationID()
+	return auo
+}
+
+// SetTag sets the tag field.
+func (auo *AuditUpdateOne) SetTag(s string) *AuditUpdateOne {
+	auo.mutation.SetTag(s)
+	return auo
+}
+
+// SetNillableTag sets the tag field if the given value is not nil.
+func (auo *AuditUpdateOne) SetNillableTag(s *string) *AuditUpdateOne {
+	if s != nil {
+		auo.SetTag(*s)
+	}
+	return auo
+}
+
+// ClearTag clears the value of tag.
+func (auo *AuditUpdateOne) ClearTag() *AuditUpdateOne {
+	auo.mutation.ClearTag()
+	return auo
+}
+
+// SetIP sets the ip field.
+func (auo *AuditUpdateOne) SetIP(s string) *AuditUpdateOne {
+	auo.mutation.SetIP(s)
+	return auo
+}
+
+// SetCreatedAt sets the created_at field.
+func (auo *AuditUpdateOne) SetCreatedAt(t time.Time) *AuditUpdateOne {
+	auo.mutation.SetCreatedAt(t)
+	return auo
+}
+
+// SetNillableCreatedAt sets the created_at field if the given value is not nil.
+func (auo *AuditUpdateOne) SetNillableCreatedAt(t *time.Time) *AuditUpdateOne {
+	if t != nil {
+		auo.SetCreatedAt(*t)
+	}
+	return auo
+}
+
+// Mutation returns the AuditMutation object of the builder.
+func (auo *AuditUpdateOne) Mutation() *AuditMutation {
+	return auo.mutation
+}
+
+// Save executes the query and returns the updated entity.
+func (auo *AuditUpdateOne) Save(ctx context.Context) (*Audit, error) {
+	var (
+		err  error
+		node *Audit
+	)
+	if len(auo.hooks) == 0 {
+		if err = auo.check(); err != nil {
+			return nil, err
+		}
+		node, err = auo.sqlSave(ctx)
+	} else {
+		var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+			mutation, ok := m.(*AuditMutation)
+			if !ok {
+				return nil, fmt.Errorf("unexpected mutation type %T", m)
+			}
+			if err = auo.check(); err != nil {
+				return nil, err
+			}
+			auo.mutation = mutation
+			node, err = auo.sqlSave(ctx)
+			mutation.done = true
+			return node, err
+		})
+		for i := len(auo.hooks) - 1; i >= 0; i-- {
+			mut = auo.hooks[i](mut)
+		}
+		if _, err := mut.Mutate(ctx, auo.mutation); err != nil {
+			return nil, err
+		}
+	}
+	return node, err
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (auo *AuditUpdateOne) SaveX(ctx context.Context) *Audit {
+	node, err := auo.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (auo *AuditUpdateOne) Exec(ctx context.Context) error {
+	_, err := auo.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (auo *AuditUpdateOne) ExecX(ctx context.Context) {
+	if err := auo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (auo *AuditUpdateOne) check() error {
+	if v, ok := auo.mutation.Action(); ok {
+		if err := audit.ActionValidator(v); err != nil {
+			return &ValidationError{Name: "action", err: fmt.Errorf("ent: validator failed for field \"action\": %w", err)}
+		}
+	}
+	return nil
+}
+
+func (auo *AuditUpdateOne) sqlSave(ctx context.Context) (_node *Audit, err error) {
+	_spec := &sqlgraph.UpdateSpec{
+		Node: &sqlgraph.NodeSpec{
+			Table:   audit.Table,
+			Columns: audit.Columns,
+			ID: &sqlgraph.FieldSpec{
+				Type:   field.TypeInt,
+				Column: audit.FieldID,
+			},
+		},
+	}
+	id, ok := auo.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "ID", err: fmt.Errorf("missing Audit.ID for update")}
+	}
+	_spec.Node.ID.Value = id
+	if value, ok := auo.mutation.Username(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: audit.FieldUsername,
+		})
+	}
+	if value, ok := auo.mutation.Action(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeEnum,
+			Value:  value,
+			Column: audit.FieldAction,
+		})
+	}
+	if value, ok := auo.mutation.ApplicationID(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt,
+			Value:  value,
+			Column: audit.FieldApplicationID,
+		})
+	}
+	if value, ok := auo.mutation.AddedApplicationID(); ok {
+		_spec.Fields.Add = append(_spec.Fields.Add, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt,
+			Value:  value,
+			Column: audit.FieldApplicationID,
+		})
+	}
+	if auo.mutation.ApplicationIDCleared() {
+		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt,
+			Column: audit.FieldApplicationID,
+		})
+	}
+	if value, ok := auo.mutation.Tag(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: audit.FieldTag,
+		})
+	}
+	if auo.mutation.TagCleared() {
+		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Column: audit.FieldTag,
+		})
+	}
+	if value, ok := auo.mutation.IP(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: audit.FieldIP,
+		})
+	}
+	if value, ok := auo.mutation.CreatedAt(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeTime,
+			Value:  value,
+			Column: audit.FieldCreatedAt,
+		})
+	}
+	_node = &Audit{config: auo.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues()
+	if err = sqlgraph.UpdateNode(ctx, auo.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{audit.Label}
+		} else if cerr, ok := isSQLConstraintError(err); ok {
+			err = cerr
+		}
+		return nil, err
+	}
+	return _node, nil
+}
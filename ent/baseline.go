@@ -0,0 +1,180 @@
+// Code generated by entc, DO NOT EDIT.
+
+package ent
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/facebook/ent/dialect/sql"
+	"github.com/gobench-io/gobench/ent/application"
+	"github.com/gobench-io/gobench/ent/baseline"
+)
+
+// Baseline is the model entity for the Baseline schema.
+type Baseline struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID int `json:"id,omitempty"`
+	// Tag holds the value of the "tag" field.
+	Tag string `json:"tag,omitempty"`
+	// Tolerance holds the value of the "tolerance" field.
+	Tolerance float64 `json:"tolerance,omitempty"`
+	// Tolerances holds the value of the "tolerances" field.
+	Tolerances map[string]float64 `json:"tolerances,omitempty"`
+	// CreatedAt holds the value of the "created_at" field.
+	CreatedAt time.Time `json:"created_at,omitempty"`
+	// UpdatedAt holds the value of the "updated_at" field.
+	UpdatedAt time.Time `json:"updated_at,omitempty"`
+	// Edges holds the relations/edges for other nodes in the graph.
+	// The values are being populated by the BaselineQuery when eager-loading is set.
+	Edges                 BaselineEdges `json:"edges"`
+	application_baselines *int
+}
+
+// BaselineEdges holds the relations/edges for other nodes in the graph.
+type BaselineEdges struct {
+	// Application holds the value of the application edge.
+	Application *Application
+	// loadedTypes holds the information for reporting if a
+	// type was loaded (or requested) in eager-loading or not.
+	loadedTypes [1]bool
+}
+
+// ApplicationOrErr returns the Application value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e BaselineEdges) ApplicationOrErr() (*Application, error) {
+	if e.loadedTypes[0] {
+		if e.Application == nil {
+			// The edge application was loaded in eager-loading,
+			// but was not found.
+			return nil, &NotFoundError{label: application.Label}
+		}
+		return e.Application, nil
+	}
+	return nil, &NotLoadedError{edge: "application"}
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*Baseline) scanValues() []interface{} {
+	return []interface{}{
+		&sql.NullInt64{},   // id
+		&sql.NullString{},  // tag
+		&sql.NullFloat64{}, // tolerance
+		&[]byte{},          // tolerances
+		&sql.NullTime{},    // created_at
+		&sql.NullTime{},    // updated_at
+	}
+}
+
+// fkValues returns the types for scanning foreign-keys values from sql.Rows.
+func (*Baseline) fkValues() []interface{} {
+	return []interface{}{
+		&sql.NullInt64{}, // application_baselines
+	}
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the Baseline fields.
+func (b *Baseline) assignValues(values ...interface{}) error {
+	if m, n := len(values), len(baseline.Columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	value, ok := values[0].(*sql.NullInt64)
+	if !ok {
+		return fmt.Errorf("unexpected type %T for field id", value)
+	}
+	b.ID = int(value.Int64)
+	values = values[1:]
+	if value, ok := values[0].(*sql.NullString); !ok {
+		return fmt.Errorf("unexpected type %T for field tag", values[0])
+	} else if value.Valid {
+		b.Tag = value.String
+	}
+	if value, ok := values[1].(*sql.NullFloat64); !ok {
+		return fmt.Errorf("unexpected type %T for field tolerance", values[1])
+	} else if value.Valid {
+		b.Tolerance = value.Float64
+	}
+
+	if value, ok := values[2].(*[]byte); !ok {
+		return fmt.Errorf("unexpected type %T for field tolerances", values[2])
+	} else if value != nil && len(*value) > 0 {
+		if err := json.Unmarshal(*value, &b.Tolerances); err != nil {
+			return fmt.Errorf("unmarshal field tolerances: %v", err)
+		}
+	}
+	if value, ok := values[3].(*sql.NullTime); !ok {
+		return fmt.Errorf("unexpected type %T for field created_at", values[3])
+	} else if value.Valid {
+		b.CreatedAt = value.Time
+	}
+	if value, ok := values[4].(*sql.NullTime); !ok {
+		return fmt.Errorf("unexpected type %T for field updated_at", values[4])
+	} else if value.Valid {
+		b.UpdatedAt = value.Time
+	}
+	values = values[5:]
+	if len(values) == len(baseline.ForeignKeys) {
+		if value, ok := values[0].(*sql.NullInt64); !ok {
+			return fmt.Errorf("unexpected type %T for edge-field application_baselines", value)
+		} else if value.Valid {
+			b.application_baselines = new(int)
+			*b.application_baselines = int(value.Int64)
+		}
+	}
+	return nil
+}
+
+// QueryApplication queries the application edge of the Baseline.
+func (b *Baseline) QueryApplication() *ApplicationQuery {
+	return (&BaselineClient{config: b.config}).QueryApplication(b)
+}
+
+// Update returns a builder for updating this Baseline.
+// Note that, you need to call Baseline.Unwrap() before calling this method, if this Baseline
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (b *Baseline) Update() *BaselineUpdateOne {
+	return (&BaselineClient{config: b.config}).UpdateOne(b)
+}
+
+// Unwrap unwraps the entity that was returned from a transaction after it was closed,
+// so that all next queries will be executed through the driver which created the transaction.
+func (b *Baseline) Unwrap() *Baseline {
+	tx, ok := b.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: Baseline is not a transactional entity")
+	}
+	b.config.driver = tx.drv
+	return b
+}
+
+// String implements the fmt.Stringer.
+func (b *Baseline) String() string {
+	var builder strings.Builder
+	builder.WriteString("Baseline(")
+	builder.WriteString(fmt.Sprintf("id=%v", b.ID))
+	builder.WriteString(", tag=")
+	builder.WriteString(b.Tag)
+	builder.WriteString(", tolerance=")
+	builder.WriteString(fmt.Sprintf("%v", b.Tolerance))
+	builder.WriteString(", tolerances=")
+	builder.WriteString(fmt.Sprintf("%v", b.Tolerances))
+	builder.WriteString(", created_at=")
+	builder.WriteString(b.CreatedAt.Format(time.ANSIC))
+	builder.WriteString(", updated_at=")
+	builder.WriteString(b.UpdatedAt.Format(time.ANSIC))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// Baselines is a parsable slice of Baseline.
+type Baselines []*Baseline
+
+func (b Baselines) config(cfg config) {
+	for _i := range b {
+		b[_i].config = cfg
+	}
+}
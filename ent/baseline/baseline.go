@@ -0,0 +1,80 @@
+// Code generated by entc, DO NOT EDIT.
+
+package baseline
+
+import (
+	"time"
+)
+
+const (
+	// Label holds the string label denoting the baseline type in the database.
+	Label = "baseline"
+	// FieldID holds the string denoting the id field in the database.
+	FieldID = "id"
+	// FieldTag holds the string denoting the tag field in the database.
+	FieldTag = "tag"
+	// FieldTolerance holds the string denoting the tolerance field in the database.
+	FieldTolerance = "tolerance"
+	// FieldTolerances holds the string denoting the tolerances field in the database.
+	FieldTolerances = "tolerances"
+	// FieldCreatedAt holds the string denoting the created_at field in the database.
+	FieldCreatedAt = "created_at"
+	// FieldUpdatedAt holds the string denoting the updated_at field in the database.
+	FieldUpdatedAt = "updated_at"
+
+	// EdgeApplication holds the string denoting the application edge name in mutations.
+	EdgeApplication = "application"
+
+	// Table holds the table name of the baseline in the database.
+	Table = "baselines"
+	// ApplicationTable is the table the holds the application relation/edge.
+	ApplicationTable = "baselines"
+	// ApplicationInverseTable is the table name for the Application entity.
+	// It exists in this package in order to avoid circular dependency with the "application" package.
+	ApplicationInverseTable = "applications"
+	// ApplicationColumn is the table column denoting the application relation/edge.
+	ApplicationColumn = "application_baselines"
+)
+
+// Columns holds all SQL columns for baseline fields.
+var Columns = []string{
+	FieldID,
+	FieldTag,
+	FieldTolerance,
+	FieldTolerances,
+	FieldCreatedAt,
+	FieldUpdatedAt,
+}
+
+// ForeignKeys holds the SQL foreign-keys that are owned by the Baseline type.
+var ForeignKeys = []string{
+	"application_baselines",
+}
+
+// ValidColumn reports if the column name is valid (part of the table columns).
+func ValidColumn(column string) bool {
+	for i := range Columns {
+		if column == Columns[i] {
+			return true
+		}
+	}
+	for i := range ForeignKeys {
+		if column == ForeignKeys[i] {
+			return true
+		}
+	}
+	return false
+}
+
+var (
+	// TagValidator is a validator for the "tag" field. It is called by the builders before save.
+	TagValidator func(string) error
+	// DefaultTolerance holds the default value on creation for the tolerance field.
+	DefaultTolerance float64
+	// DefaultCreatedAt holds the default value on creation for the created_at field.
+	DefaultCreatedAt func() time.Time
+	// DefaultUpdatedAt holds the default value on creation for the updated_at field.
+	DefaultUpdatedAt func() time.Time
+	// UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
+	UpdateDefaultUpdatedAt func() time.Time
+)
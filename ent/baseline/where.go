@@ -0,0 +1,535 @@
+// Code generated by entc, DO NOT EDIT.
+
+package baseline
+
+import (
+	"time"
+
+	"github.com/facebook/ent/dialect/sql"
+	"github.com/facebook/ent/dialect/sql/sqlgraph"
+	"github.com/gobench-io/gobench/ent/predicate"
+)
+
+// ID filters vertices based on their identifier.
+func ID(id int) predicate.Baseline {
+	return predicate.Baseline(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldID), id))
+	})
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id int) predicate.Baseline {
+	return predicate.Baseline(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldID), id))
+	})
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id int) predicate.Baseline {
+	return predicate.Baseline(func(s *sql.Selector) {
+		s.Where(sql.NEQ(s.C(FieldID), id))
+	})
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...int) predicate.Baseline {
+	return predicate.Baseline(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(ids) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		v := make([]interface{}, len(ids))
+		for i := range v {
+			v[i] = ids[i]
+		}
+		s.Where(sql.In(s.C(FieldID), v...))
+	})
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...int) predicate.Baseline {
+	return predicate.Baseline(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(ids) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		v := make([]interface{}, len(ids))
+		for i := range v {
+			v[i] = ids[i]
+		}
+		s.Where(sql.NotIn(s.C(FieldID), v...))
+	})
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id int) predicate.Baseline {
+	return predicate.Baseline(func(s *sql.Selector) {
+		s.Where(sql.GT(s.C(FieldID), id))
+	})
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id int) predicate.Baseline {
+	return predicate.Baseline(func(s *sql.Selector) {
+		s.Where(sql.GTE(s.C(FieldID), id))
+	})
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id int) predicate.Baseline {
+	return predicate.Baseline(func(s *sql.Selector) {
+		s.Where(sql.LT(s.C(FieldID), id))
+	})
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id int) predicate.Baseline {
+	return predicate.Baseline(func(s *sql.Selector) {
+		s.Where(sql.LTE(s.C(FieldID), id))
+	})
+}
+
+// Tag applies equality check predicate on the "tag" field. It's identical to TagEQ.
+func Tag(v string) predicate.Baseline {
+	return predicate.Baseline(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldTag), v))
+	})
+}
+
+// Tolerance applies equality check predicate on the "tolerance" field. It's identical to ToleranceEQ.
+func Tolerance(v float64) predicate.Baseline {
+	return predicate.Baseline(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldTolerance), v))
+	})
+}
+
+// CreatedAt applies equality check predicate on the "created_at" field. It's identical to CreatedAtEQ.
+func CreatedAt(v time.Time) predicate.Baseline {
+	return predicate.Baseline(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldCreatedAt), v))
+	})
+}
+
+// UpdatedAt applies equality check predicate on the "updated_at" field. It's identical to UpdatedAtEQ.
+func UpdatedAt(v time.Time) predicate.Baseline {
+	return predicate.Baseline(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldUpdatedAt), v))
+	})
+}
+
+// TagEQ applies the EQ predicate on the "tag" field.
+func TagEQ(v string) predicate.Baseline {
+	return predicate.Baseline(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldTag), v))
+	})
+}
+
+// TagNEQ applies the NEQ predicate on the "tag" field.
+func TagNEQ(v string) predicate.Baseline {
+	return predicate.Baseline(func(s *sql.Selector) {
+		s.Where(sql.NEQ(s.C(FieldTag), v))
+	})
+}
+
+// TagIn applies the In predicate on the "tag" field.
+func TagIn(vs ...string) predicate.Baseline {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.Baseline(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.In(s.C(FieldTag), v...))
+	})
+}
+
+// TagNotIn applies the NotIn predicate on the "tag" field.
+func TagNotIn(vs ...string) predicate.Baseline {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.Baseline(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.NotIn(s.C(FieldTag), v...))
+	})
+}
+
+// TagGT applies the GT predicate on the "tag" field.
+func TagGT(v string) predicate.Baseline {
+	return predicate.Baseline(func(s *sql.Selector) {
+		s.Where(sql.GT(s.C(FieldTag), v))
+	})
+}
+
+// TagGTE applies the GTE predicate on the "tag" field.
+func TagGTE(v string) predicate.Baseline {
+	return predicate.Baseline(func(s *sql.Selector) {
+		s.Where(sql.GTE(s.C(FieldTag), v))
+	})
+}
+
+// TagLT applies the LT predicate on the "tag" field.
+func TagLT(v string) predicate.Baseline {
+	return predicate.Baseline(func(s *sql.Selector) {
+		s.Where(sql.LT(s.C(FieldTag), v))
+	})
+}
+
+// TagLTE applies the LTE predicate on the "tag" field.
+func TagLTE(v string) predicate.Baseline {
+	return predicate.Baseline(func(s *sql.Selector) {
+		s.Where(sql.LTE(s.C(FieldTag), v))
+	})
+}
+
+// TagContains applies the Contains predicate on the "tag" field.
+func TagContains(v string) predicate.Baseline {
+	return predicate.Baseline(func(s *sql.Selector) {
+		s.Where(sql.Contains(s.C(FieldTag), v))
+	})
+}
+
+// TagHasPrefix applies the HasPrefix predicate on the "tag" field.
+func TagHasPrefix(v string) predicate.Baseline {
+	return predicate.Baseline(func(s *sql.Selector) {
+		s.Where(sql.HasPrefix(s.C(FieldTag), v))
+	})
+}
+
+// TagHasSuffix applies the HasSuffix predicate on the "tag" field.
+func TagHasSuffix(v string) predicate.Baseline {
+	return predicate.Baseline(func(s *sql.Selector) {
+		s.Where(sql.HasSuffix(s.C(FieldTag), v))
+	})
+}
+
+// TagEqualFold applies the EqualFold predicate on the "tag" field.
+func TagEqualFold(v string) predicate.Baseline {
+	return predicate.Baseline(func(s *sql.Selector) {
+		s.Where(sql.EqualFold(s.C(FieldTag), v))
+	})
+}
+
+// TagContainsFold applies the ContainsFold predicate on the "tag" field.
+func TagContainsFold(v string) predicate.Baseline {
+	return predicate.Baseline(func(s *sql.Selector) {
+		s.Where(sql.ContainsFold(s.C(FieldTag), v))
+	})
+}
+
+// ToleranceEQ applies the EQ predicate on the "tolerance" field.
+func ToleranceEQ(v float64) predicate.Baseline {
+	return predicate.Baseline(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldTolerance), v))
+	})
+}
+
+// ToleranceNEQ applies the NEQ predicate on the "tolerance" field.
+func ToleranceNEQ(v float64) predicate.Baseline {
+	return predicate.Baseline(func(s *sql.Selector) {
+		s.Where(sql.NEQ(s.C(FieldTolerance), v))
+	})
+}
+
+// ToleranceIn applies the In predicate on the "tolerance" field.
+func ToleranceIn(vs ...float64) predicate.Baseline {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.Baseline(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.In(s.C(FieldTolerance), v...))
+	})
+}
+
+// ToleranceNotIn applies the NotIn predicate on the "tolerance" field.
+func ToleranceNotIn(vs ...float64) predicate.Baseline {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.Baseline(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.NotIn(s.C(FieldTolerance), v...))
+	})
+}
+
+// ToleranceGT applies the GT predicate on the "tolerance" field.
+func ToleranceGT(v float64) predicate.Baseline {
+	return predicate.Baseline(func(s *sql.Selector) {
+		s.Where(sql.GT(s.C(FieldTolerance), v))
+	})
+}
+
+// ToleranceGTE applies the GTE predicate on the "tolerance" field.
+func ToleranceGTE(v float64) predicate.Baseline {
+	return predicate.Baseline(func(s *sql.Selector) {
+		s.Where(sql.GTE(s.C(FieldTolerance), v))
+	})
+}
+
+// ToleranceLT applies the LT predicate on the "tolerance" field.
+func ToleranceLT(v float64) predicate.Baseline {
+	return predicate.Baseline(func(s *sql.Selector) {
+		s.Where(sql.LT(s.C(FieldTolerance), v))
+	})
+}
+
+// ToleranceLTE applies the LTE predicate on the "tolerance" field.
+func ToleranceLTE(v float64) predicate.Baseline {
+	return predicate.Baseline(func(s *sql.Selector) {
+		s.Where(sql.LTE(s.C(FieldTolerance), v))
+	})
+}
+
+// TolerancesIsNil applies the IsNil predicate on the "tolerances" field.
+func TolerancesIsNil() predicate.Baseline {
+	return predicate.Baseline(func(s *sql.Selector) {
+		s.Where(sql.IsNull(s.C(FieldTolerances)))
+	})
+}
+
+// TolerancesNotNil applies the NotNil predicate on the "tolerances" field.
+func TolerancesNotNil() predicate.Baseline {
+	return predicate.Baseline(func(s *sql.Selector) {
+		s.Where(sql.NotNull(s.C(FieldTolerances)))
+	})
+}
+
+// CreatedAtEQ applies the EQ predicate on the "created_at" field.
+func CreatedAtEQ(v time.Time) predicate.Baseline {
+	return predicate.Baseline(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldCreatedAt), v))
+	})
+}
+
+// CreatedAtNEQ applies the NEQ predicate on the "created_at" field.
+func CreatedAtNEQ(v time.Time) predicate.Baseline {
+	return predicate.Baseline(func(s *sql.Selector) {
+		s.Where(sql.NEQ(s.C(FieldCreatedAt), v))
+	})
+}
+
+// CreatedAtIn applies the In predicate on the "created_at" field.
+func CreatedAtIn(vs ...time.Time) predicate.Baseline {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.Baseline(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.In(s.C(FieldCreatedAt), v...))
+	})
+}
+
+// CreatedAtNotIn applies the NotIn predicate on the "created_at" field.
+func CreatedAtNotIn(vs ...time.Time) predicate.Baseline {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.Baseline(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.NotIn(s.C(FieldCreatedAt), v...))
+	})
+}
+
+// CreatedAtGT applies the GT predicate on the "created_at" field.
+func CreatedAtGT(v time.Time) predicate.Baseline {
+	return predicate.Baseline(func(s *sql.Selector) {
+		s.Where(sql.GT(s.C(FieldCreatedAt), v))
+	})
+}
+
+// CreatedAtGTE applies the GTE predicate on the "created_at" field.
+func CreatedAtGTE(v time.Time) predicate.Baseline {
+	return predicate.Baseline(func(s *sql.Selector) {
+		s.Where(sql.GTE(s.C(FieldCreatedAt), v))
+	})
+}
+
+// CreatedAtLT applies the LT predicate on the "created_at" field.
+func CreatedAtLT(v time.Time) predicate.Baseline {
+	return predicate.Baseline(func(s *sql.Selector) {
+		s.Where(sql.LT(s.C(FieldCreatedAt), v))
+	})
+}
+
+// CreatedAtLTE applies the LTE predicate on the "created_at" field.
+func CreatedAtLTE(v time.Time) predicate.Baseline {
+	return predicate.Baseline(func(s *sql.Selector) {
+		s.Where(sql.LTE(s.C(FieldCreatedAt), v))
+	})
+}
+
+// UpdatedAtEQ applies the EQ predicate on the "updated_at" field.
+func UpdatedAtEQ(v time.Time) predicate.Baseline {
+	return predicate.Baseline(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldUpdatedAt), v))
+	})
+}
+
+// UpdatedAtNEQ applies the NEQ predicate on the "updated_at" field.
+func UpdatedAtNEQ(v time.Time) predicate.Baseline {
+	return predicate.Baseline(func(s *sql.Selector) {
+		s.Where(sql.NEQ(s.C(FieldUpdatedAt), v))
+	})
+}
+
+// UpdatedAtIn applies the In predicate on the "updated_at" field.
+func UpdatedAtIn(vs ...time.Time) predicate.Baseline {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.Baseline(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.In(s.C(FieldUpdatedAt), v...))
+	})
+}
+
+// UpdatedAtNotIn applies the NotIn predicate on the "updated_at" field.
+func UpdatedAtNotIn(vs ...time.Time) predicate.Baseline {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.Baseline(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.NotIn(s.C(FieldUpdatedAt), v...))
+	})
+}
+
+// UpdatedAtGT applies the GT predicate on the "updated_at" field.
+func UpdatedAtGT(v time.Time) predicate.Baseline {
+	return predicate.Baseline(func(s *sql.Selector) {
+		s.Where(sql.GT(s.C(FieldUpdatedAt), v))
+	})
+}
+
+// UpdatedAtGTE applies the GTE predicate on the "updated_at" field.
+func UpdatedAtGTE(v time.Time) predicate.Baseline {
+	return predicate.Baseline(func(s *sql.Selector) {
+		s.Where(sql.GTE(s.C(FieldUpdatedAt), v))
+	})
+}
+
+// UpdatedAtLT applies the LT predicate on the "updated_at" field.
+func UpdatedAtLT(v time.Time) predicate.Baseline {
+	return predicate.Baseline(func(s *sql.Selector) {
+		s.Where(sql.LT(s.C(FieldUpdatedAt), v))
+	})
+}
+
+// UpdatedAtLTE applies the LTE predicate on the "updated_at" field.
+func UpdatedAtLTE(v time.Time) predicate.Baseline {
+	return predicate.Baseline(func(s *sql.Selector) {
+		s.Where(sql.LTE(s.C(FieldUpdatedAt), v))
+	})
+}
+
+// HasApplication applies the HasEdge predicate on the "application" edge.
+func HasApplication() predicate.Baseline {
+	return predicate.Baseline(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.To(ApplicationTable, FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, ApplicationTable, ApplicationColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasApplicationWith applies the HasEdge predicate on the "application" edge with a given conditions (other predicates).
+func HasApplicationWith(preds ...predicate.Application) predicate.Baseline {
+	return predicate.Baseline(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.To(ApplicationInverseTable, FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, ApplicationTable, ApplicationColumn),
+		)
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// And groups list of predicates with the AND operator between them.
+func And(predicates ...predicate.Baseline) predicate.Baseline {
+	return predicate.Baseline(func(s *sql.Selector) {
+		s1 := s.Clone().SetP(nil)
+		for _, p := range predicates {
+			p(s1)
+		}
+		s.Where(s1.P())
+	})
+}
+
+// Or groups list of predicates with the OR operator between them.
+func Or(predicates ...predicate.Baseline) predicate.Baseline {
+	return predicate.Baseline(func(s *sql.Selector) {
+		s1 := s.Clone().SetP(nil)
+		for i, p := range predicates {
+			if i > 0 {
+				s1.Or()
+			}
+			p(s1)
+		}
+		s.Where(s1.P())
+	})
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.Baseline) predicate.Baseline {
+	return predicate.Baseline(func(s *sql.Selector) {
+		p(s.Not())
+	})
+}
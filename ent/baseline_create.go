@@ -0,0 +1,331 @@
+// Code generated by entc, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/facebook/ent/dialect/sql/sqlgraph"
+	"github.com/facebook/ent/schema/field"
+	"github.com/gobench-io/gobench/ent/application"
+	"github.com/gobench-io/gobench/ent/baseline"
+)
+
+// BaselineCreate is the builder for creating a Baseline entity.
+type BaselineCreate struct {
+	config
+	mutation *BaselineMutation
+	hooks    []Hook
+}
+
+// SetTag sets the tag field.
+func (bc *BaselineCreate) SetTag(s string) *BaselineCreate {
+	bc.mutation.SetTag(s)
+	return bc
+}
+
+// SetTolerance sets the tolerance field.
+func (bc *BaselineCreate) SetTolerance(f float64) *BaselineCreate {
+	bc.mutation.SetTolerance(f)
+	return bc
+}
+
+// SetNillableTolerance sets the tolerance field if the given value is not nil.
+func (bc *BaselineCreate) SetNillableTolerance(f *float64) *BaselineCreate {
+	if f != nil {
+		bc.SetTolerance(*f)
+	}
+	return bc
+}
+
+// SetTolerances sets the tolerances field.
+func (bc *BaselineCreate) SetTolerances(m map[string]float64) *BaselineCreate {
+	bc.mutation.SetTolerances(m)
+	return bc
+}
+
+// SetCreatedAt sets the created_at field.
+func (bc *BaselineCreate) SetCreatedAt(t time.Time) *BaselineCreate {
+	bc.mutation.SetCreatedAt(t)
+	return bc
+}
+
+// SetNillableCreatedAt sets the created_at field if the given value is not nil.
+func (bc *BaselineCreate) SetNillableCreatedAt(t *time.Time) *BaselineCreate {
+	if t != nil {
+		bc.SetCreatedAt(*t)
+	}
+	return bc
+}
+
+// SetUpdatedAt sets the updated_at field.
+func (bc *BaselineCreate) SetUpdatedAt(t time.Time) *BaselineCreate {
+	bc.mutation.SetUpdatedAt(t)
+	return bc
+}
+
+// SetNillableUpdatedAt sets the updated_at field if the given value is not nil.
+func (bc *BaselineCreate) SetNillableUpdatedAt(t *time.Time) *BaselineCreate {
+	if t != nil {
+		bc.SetUpdatedAt(*t)
+	}
+	return bc
+}
+
+// SetApplicationID sets the application edge to Application by id.
+func (bc *BaselineCreate) SetApplicationID(id int) *BaselineCreate {
+	bc.mutation.SetApplicationID(id)
+	return bc
+}
+
+// SetApplication sets the application edge to Application.
+func (bc *BaselineCreate) SetApplication(a *Application) *BaselineCreate {
+	return bc.SetApplicationID(a.ID)
+}
+
+// Mutation returns the BaselineMutation object of the builder.
+func (bc *BaselineCreate) Mutation() *BaselineMutation {
+	return bc.mutation
+}
+
+// Save creates the Baseline in the database.
+func (bc *BaselineCreate) Save(ctx context.Context) (*Baseline, error) {
+	var (
+		err  error
+		node *Baseline
+	)
+	bc.defaults()
+	if len(bc.hooks) == 0 {
+		if err = bc.check(); err != nil {
+			return nil, err
+		}
+		node, err = bc.sqlSave(ctx)
+	} else {
+		var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+			mutation, ok := m.(*BaselineMutation)
+			if !ok {
+				return nil, fmt.Errorf("unexpected mutation type %T", m)
+			}
+			if err = bc.check(); err != nil {
+				return nil, err
+			}
+			bc.mutation = mutation
+			node, err = bc.sqlSave(ctx)
+			mutation.done = true
+			return node, err
+		})
+		for i := len(bc.hooks) - 1; i >= 0; i-- {
+			mut = bc.hooks[i](mut)
+		}
+		if _, err := mut.Mutate(ctx, bc.mutation); err != nil {
+			return nil, err
+		}
+	}
+	return node, err
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (bc *BaselineCreate) SaveX(ctx context.Context) *Baseline {
+	v, err := bc.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// defaults sets the default values of the builder before save.
+func (bc *BaselineCreate) defaults() {
+	if _, ok := bc.mutation.Tolerance(); !ok {
+		v := baseline.DefaultTolerance
+		bc.mutation.SetTolerance(v)
+	}
+	if _, ok := bc.mutation.CreatedAt(); !ok {
+		v := baseline.DefaultCreatedAt()
+		bc.mutation.SetCreatedAt(v)
+	}
+	if _, ok := bc.mutation.UpdatedAt(); !ok {
+		v := baseline.DefaultUpdatedAt()
+		bc.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (bc *BaselineCreate) check() error {
+	if _, ok := bc.mutation.Tag(); !ok {
+		return &ValidationError{Name: "tag", err: errors.New("ent: missing required field \"tag\"")}
+	}
+	if v, ok := bc.mutation.Tag(); ok {
+		if err := baseline.TagValidator(v); err != nil {
+			return &ValidationError{Name: "tag", err: fmt.Errorf("ent: validator failed for field \"tag\": %w", err)}
+		}
+	}
+	if _, ok := bc.mutation.Tolerance(); !ok {
+		return &ValidationError{Name: "tolerance", err: errors.New("ent: missing required field \"tolerance\"")}
+	}
+	if _, ok := bc.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New("ent: missing required field \"created_at\"")}
+	}
+	if _, ok := bc.mutation.UpdatedAt(); !ok {
+		return &ValidationError{Name: "updated_at", err: errors.New("ent: missing required field \"updated_at\"")}
+	}
+	if _, ok := bc.mutation.ApplicationID(); !ok {
+		return &ValidationError{Name: "application", err: errors.New("ent: missing required edge \"application\"")}
+	}
+	return nil
+}
+
+func (bc *BaselineCreate) sqlSave(ctx context.Context) (*Baseline, error) {
+	_node, _spec := bc.createSpec()
+	if err := sqlgraph.CreateNode(ctx, bc.driver, _spec); err != nil {
+		if cerr, ok := isSQLConstraintError(err); ok {
+			err = cerr
+		}
+		return nil, err
+	}
+	id := _spec.ID.Value.(int64)
+	_node.ID = int(id)
+	return _node, nil
+}
+
+func (bc *BaselineCreate) createSpec() (*Baseline, *sqlgraph.CreateSpec) {
+	var (
+		_node = &Baseline{config: bc.config}
+		_spec = &sqlgraph.CreateSpec{
+			Table: baseline.Table,
+			ID: &sqlgraph.FieldSpec{
+				Type:   field.TypeInt,
+				Column: baseline.FieldID,
+			},
+		}
+	)
+	if value, ok := bc.mutation.Tag(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: baseline.FieldTag,
+		})
+		_node.Tag = value
+	}
+	if value, ok := bc.mutation.Tolerance(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeFloat64,
+			Value:  value,
+			Column: baseline.FieldTolerance,
+		})
+		_node.Tolerance = value
+	}
+	if value, ok := bc.mutation.Tolerances(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeJSON,
+			Value:  value,
+			Column: baseline.FieldTolerances,
+		})
+		_node.Tolerances = value
+	}
+	if value, ok := bc.mutation.CreatedAt(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeTime,
+			Value:  value,
+			Column: baseline.FieldCreatedAt,
+		})
+		_node.CreatedAt = value
+	}
+	if value, ok := bc.mutation.UpdatedAt(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeTime,
+			Value:  value,
+			Column: baseline.FieldUpdatedAt,
+		})
+		_node.UpdatedAt = value
+	}
+	if nodes := bc.mutation.ApplicationIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   baseline.ApplicationTable,
+			Columns: []string{baseline.ApplicationColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt,
+					Column: application.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	return _node, _spec
+}
+
+// BaselineCreateBulk is the builder for creating a bulk of Baseline entities.
+type BaselineCreateBulk struct {
+	config
+	builders []*BaselineCreate
+}
+
+// Save creates the Baseline entities in the database.
+func (bcb *BaselineCreateBulk) Save(ctx context.Context) ([]*Baseline, error) {
+	specs := make([]*sqlgraph.CreateSpec, len(bcb.builders))
+	nodes := make([]*Baseline, len(bcb.builders))
+	mutators := make([]Mutator, len(bcb.builders))
+	for i := range bcb.builders {
+		func(i int, root context.Context) {
+			builder := bcb.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*BaselineMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				nodes[i], specs[i] = builder.createSpec()
+				var err error
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, bcb.builders[i+1].mutation)
+				} else {
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, bcb.driver, &sqlgraph.BatchCreateSpec{Nodes: specs}); err != nil {
+						if cerr, ok := isSQLConstraintError(err); ok {
+							err = cerr
+						}
+					}
+				}
+				mutation.done = true
+				if err != nil {
+					return nil, err
+				}
+				id := specs[i].ID.Value.(int64)
+				nodes[i].ID = int(id)
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, bcb.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (bcb *BaselineCreateBulk) SaveX(ctx context.Context) []*Baseline {
+	v, err := bcb.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
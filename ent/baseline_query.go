@@ -0,0 +1,956 @@
+// Code generated by entc, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math"
+
+	"github.com/facebook/ent/dialect/sql"
+	"github.com/facebook/ent/dialect/sql/sqlgraph"
+	"github.com/facebook/ent/schema/field"
+	"github.com/gobench-io/gobench/ent/application"
+	"github.com/gobench-io/gobench/ent/baseline"
+	"github.com/gobench-io/gobench/ent/predicate"
+)
+
+// BaselineQuery is the builder for querying Baseline entities.
+type BaselineQuery struct {
+	config
+	limit      *int
+	offset     *int
+	order      []OrderFunc
+	unique     []string
+	predicates []predicate.Baseline
+	// eager-loading edges.
+	withApplication *ApplicationQuery
+	withFKs         bool
+	// intermediate query (i.e. traversal path).
+	sql  *sql.Selector
+	path func(context.Context) (*sql.Selector, error)
+}
+
+// Where adds a new predicate for the builder.
+func (bq *BaselineQuery) Where(ps ...predicate.Baseline) *BaselineQuery {
+	bq.predicates = append(bq.predicates, ps...)
+	return bq
+}
+
+// Limit adds a limit step to the query.
+func (bq *BaselineQuery) Limit(limit int) *BaselineQuery {
+	bq.limit = &limit
+	return bq
+}
+
+// Offset adds an offset step to the query.
+func (bq *BaselineQuery) Offset(offset int) *BaselineQuery {
+	bq.offset = &offset
+	return bq
+}
+
+// Order adds an order step to the query.
+func (bq *BaselineQuery) Order(o ...OrderFunc) *BaselineQuery {
+	bq.order = append(bq.order, o...)
+	return bq
+}
+
+// QueryApplication chains the current query on the application edge.
+func (bq *BaselineQuery) QueryApplication() *ApplicationQuery {
+	query := &ApplicationQuery{config: bq.config}
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := bq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := bq.sqlQuery()
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(baseline.Table, baseline.FieldID, selector),
+			sqlgraph.To(application.Table, application.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, baseline.ApplicationTable, baseline.ApplicationColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(bq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// First returns the first Baseline entity in the query. Returns *NotFoundError when no baseline was found.
+func (bq *BaselineQuery) First(ctx context.Context) (*Baseline, error) {
+	nodes, err := bq.Limit(1).All(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nil, &NotFoundError{baseline.Label}
+	}
+	return nodes[0], nil
+}
+
+// FirstX is like First, but panics if an error occurs.
+func (bq *BaselineQuery) FirstX(ctx context.Context) *Baseline {
+	node, err := bq.First(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return node
+}
+
+// FirstID returns the first Baseline id in the query. Returns *NotFoundError when no id was found.
+func (bq *BaselineQuery) FirstID(ctx context.Context) (id int, err error) {
+	var ids []int
+	if ids, err = bq.Limit(1).IDs(ctx); err != nil {
+		return
+	}
+	if len(ids) == 0 {
+		err = &NotFoundError{baseline.Label}
+		return
+	}
+	return ids[0], nil
+}
+
+// FirstXID is like FirstID, but panics if an error occurs.
+func (bq *BaselineQuery) FirstXID(ctx context.Context) int {
+	id, err := bq.FirstID(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return id
+}
+
+// Only returns the only Baseline entity in the query, returns an error if not exactly one entity was returned.
+func (bq *BaselineQuery) Only(ctx context.Context) (*Baseline, error) {
+	nodes, err := bq.Limit(2).All(ctx)
+	if err != nil {
+		return nil, err
+	}
+	switch len(nodes) {
+	case 1:
+		return nodes[0], nil
+	case 0:
+		return nil, &NotFoundError{baseline.Label}
+	default:
+		return nil, &NotSingularError{baseline.Label}
+	}
+}
+
+// OnlyX is like Only, but panics if an error occurs.
+func (bq *BaselineQuery) OnlyX(ctx context.Context) *Baseline {
+	node, err := bq.Only(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// OnlyID returns the only Baseline id in the query, returns an error if not exactly one id was returned.
+func (bq *BaselineQuery) OnlyID(ctx context.Context) (id int, err error) {
+	var ids []int
+	if ids, err = bq.Limit(2).IDs(ctx); err != nil {
+		return
+	}
+	switch len(ids) {
+	case 1:
+		id = ids[0]
+	case 0:
+		err = &NotFoundError{baseline.Label}
+	default:
+		err = &NotSingularError{baseline.Label}
+	}
+	return
+}
+
+// OnlyIDX is like OnlyID, but panics if an error occurs.
+func (bq *BaselineQuery) OnlyIDX(ctx context.Context) int {
+	id, err := bq.OnlyID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// All executes the query and returns a list of Baselines.
+func (bq *BaselineQuery) All(ctx context.Context) ([]*Baseline, error) {
+	if err := bq.prepareQuery(ctx); err != nil {
+		return nil, err
+	}
+	return bq.sqlAll(ctx)
+}
+
+// AllX is like All, but panics if an error occurs.
+func (bq *BaselineQuery) AllX(ctx context.Context) []*Baseline {
+	nodes, err := bq.All(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return nodes
+}
+
+// IDs executes the query and returns a list of Baseline ids.
+func (bq *BaselineQuery) IDs(ctx context.Context) ([]int, error) {
+	var ids []int
+	if err := bq.Select(baseline.FieldID).Scan(ctx, &ids); err != nil {
+		return nil, err
+	}
+	return ids, nil
+}
+
+// IDsX is like IDs, but panics if an error occurs.
+func (bq *BaselineQuery) IDsX(ctx context.Context) []int {
+	ids, err := bq.IDs(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return ids
+}
+
+// Count returns the count of the given query.
+func (bq *BaselineQuery) Count(ctx context.Context) (int, error) {
+	if err := bq.prepareQuery(ctx); err != nil {
+		return 0, err
+	}
+	return bq.sqlCount(ctx)
+}
+
+// CountX is like Count, but panics if an error occurs.
+func (bq *BaselineQuery) CountX(ctx context.Context) int {
+	count, err := bq.Count(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return count
+}
+
+// Exist returns true if the query has elements in the graph.
+func (bq *BaselineQuery) Exist(ctx context.Context) (bool, error) {
+	if err := bq.prepareQuery(ctx); err != nil {
+		return false, err
+	}
+	return bq.sqlExist(ctx)
+}
+
+// ExistX is like Exist, but panics if an error occurs.
+func (bq *BaselineQuery) ExistX(ctx context.Context) bool {
+	exist, err := bq.Exist(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return exist
+}
+
+// Clone returns a duplicate of the query builder, including all associated steps. It can be
+// used to prepare common query builders and use them differently after the clone is made.
+func (bq *BaselineQuery) Clone() *BaselineQuery {
+	return &BaselineQuery{
+		config:     bq.config,
+		limit:      bq.limit,
+		offset:     bq.offset,
+		order:      append([]OrderFunc{}, bq.order...),
+		unique:     append([]string{}, bq.unique...),
+		predicates: append([]predicate.Baseline{}, bq.predicates...),
+		// clone intermediate query.
+		sql:  bq.sql.Clone(),
+		path: bq.path,
+	}
+}
+
+//	WithApplication tells the query-builder to eager-loads the nodes that are connected to
+//
+// the "application" edge. The optional arguments used to configure the query builder of the edge.
+func (bq *BaselineQuery) WithApplication(opts ...func(*ApplicationQuery)) *BaselineQuery {
+	query := &ApplicationQuery{config: bq.config}
+	for _, opt := range opts {
+		opt(query)
+	}
+	bq.withApplication = query
+	return bq
+}
+
+// GroupBy used to group vertices by one or more fields/columns.
+// It is often used with aggregate functions, like: count, max, mean, min, sum.
+//
+// Example:
+//
+//	var v []struct {
+//		Tag string `json:"tag,omitempty"`
+//		Count int `json:"count,omitempty"`
+//	}
+//
+//	client.Baseline.Query().
+//		GroupBy(baseline.FieldTag).
+//		Aggregate(ent.Count()).
+//		Scan(ctx, &v)
+func (bq *BaselineQuery) GroupBy(field string, fields ...string) *BaselineGroupBy {
+	group := &BaselineGroupBy{config: bq.config}
+	group.fields = append([]string{field}, fields...)
+	group.path = func(ctx context.Context) (prev *sql.Selector, err error) {
+		if err := bq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		return bq.sqlQuery(), nil
+	}
+	return group
+}
+
+// Select one or more fields from the given query.
+//
+// Example:
+//
+//	var v []struct {
+//		Tag string `json:"tag,omitempty"`
+//	}
+//
+//	client.Baseline.Query().
+//		Select(baseline.FieldTag).
+//		Scan(ctx, &v)
+func (bq *BaselineQuery) Select(field string, fields ...string) *BaselineSelect {
+	selector := &BaselineSelect{config: bq.config}
+	selector.fields = append([]string{field}, fields...)
+	selector.path = func(ctx context.Context) (prev *sql.Selector, err error) {
+		if err := bq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		return bq.sqlQuery(), nil
+	}
+	return selector
+}
+
+func (bq *BaselineQuery) prepareQuery(ctx context.Context) error {
+	if bq.path != nil {
+		prev, err := bq.path(ctx)
+		if err != nil {
+			return err
+		}
+		bq.sql = prev
+	}
+	return nil
+}
+
+func (bq *BaselineQuery) sqlAll(ctx context.Context) ([]*Baseline, error) {
+	var (
+		nodes       = []*Baseline{}
+		withFKs     = bq.withFKs
+		_spec       = bq.querySpec()
+		loadedTypes = [1]bool{
+			bq.withApplication != nil,
+		}
+	)
+	if bq.withApplication != nil {
+		withFKs = true
+	}
+	if withFKs {
+		_spec.Node.Columns = append(_spec.Node.Columns, baseline.ForeignKeys...)
+	}
+	_spec.ScanValues = func() []interface{} {
+		node := &Baseline{config: bq.config}
+		nodes = append(nodes, node)
+		values := node.scanValues()
+		if withFKs {
+			values = append(values, node.fkValues()...)
+		}
+		return values
+	}
+	_spec.Assign = func(values ...interface{}) error {
+		if len(nodes) == 0 {
+			return fmt.Errorf("ent: Assign called without calling ScanValues")
+		}
+		node := nodes[len(nodes)-1]
+		node.Edges.loadedTypes = loadedTypes
+		return node.assignValues(values...)
+	}
+	if err := sqlgraph.QueryNodes(ctx, bq.driver, _spec); err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nodes, nil
+	}
+
+	if query := bq.withApplication; query != nil {
+		ids := make([]int, 0, len(nodes))
+		nodeids := make(map[int][]*Baseline)
+		for i := range nodes {
+			if fk := nodes[i].application_baselines; fk != nil {
+				ids = append(ids, *fk)
+				nodeids[*fk] = append(nodeids[*fk], nodes[i])
+			}
+		}
+		query.Where(application.IDIn(ids...))
+		neighbors, err := query.All(ctx)
+		if err != nil {
+			return nil, err
+		}
+		for _, n := range neighbors {
+			nodes, ok := nodeids[n.ID]
+			if !ok {
+				return nil, fmt.Errorf(`unexpected foreign-key "application_baselines" returned %v`, n.ID)
+			}
+			for i := range nodes {
+				nodes[i].Edges.Application = n
+			}
+		}
+	}
+
+	return nodes, nil
+}
+
+func (bq *BaselineQuery) sqlCount(ctx context.Context) (int, error) {
+	_spec := bq.querySpec()
+	return sqlgraph.CountNodes(ctx, bq.driver, _spec)
+}
+
+func (bq *BaselineQuery) sqlExist(ctx context.Context) (bool, error) {
+	n, err := bq.sqlCount(ctx)
+	if err != nil {
+		return false, fmt.Errorf("ent: check existence: %v", err)
+	}
+	return n > 0, nil
+}
+
+func (bq *BaselineQuery) querySpec() *sqlgraph.QuerySpec {
+	_spec := &sqlgraph.QuerySpec{
+		Node: &sqlgraph.NodeSpec{
+			Table:   baseline.Table,
+			Columns: baseline.Columns,
+			ID: &sqlgraph.FieldSpec{
+				Type:   field.TypeInt,
+				Column: baseline.FieldID,
+			},
+		},
+		From:   bq.sql,
+		Unique: true,
+	}
+	if ps := bq.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if limit := bq.limit; limit != nil {
+		_spec.Limit = *limit
+	}
+	if offset := bq.offset; offset != nil {
+		_spec.Offset = *offset
+	}
+	if ps := bq.order; len(ps) > 0 {
+		_spec.Order = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector, baseline.ValidColumn)
+			}
+		}
+	}
+	return _spec
+}
+
+func (bq *BaselineQuery) sqlQuery() *sql.Selector {
+	builder := sql.Dialect(bq.driver.Dialect())
+	t1 := builder.Table(baseline.Table)
+	selector := builder.Select(t1.Columns(baseline.Columns...)...).From(t1)
+	if bq.sql != nil {
+		selector = bq.sql
+		selector.Select(selector.Columns(baseline.Columns...)...)
+	}
+	for _, p := range bq.predicates {
+		p(selector)
+	}
+	for _, p := range bq.order {
+		p(selector, baseline.ValidColumn)
+	}
+	if offset := bq.offset; offset != nil {
+		// limit is mandatory for offset clause. We start
+		// with default value, and override it below if needed.
+		selector.Offset(*offset).Limit(math.MaxInt32)
+	}
+	if limit := bq.limit; limit != nil {
+		selector.Limit(*limit)
+	}
+	return selector
+}
+
+// BaselineGroupBy is the builder for group-by Baseline entities.
+type BaselineGroupBy struct {
+	config
+	fields []string
+	fns    []AggregateFunc
+	// intermediate query (i.e. traversal path).
+	sql  *sql.Selector
+	path func(context.Context) (*sql.Selector, error)
+}
+
+// Aggregate adds the given aggregation functions to the group-by query.
+func (bgb *BaselineGroupBy) Aggregate(fns ...AggregateFunc) *BaselineGroupBy {
+	bgb.fns = append(bgb.fns, fns...)
+	return bgb
+}
+
+// Scan applies the group-by query and scan the result into the given value.
+func (bgb *BaselineGroupBy) Scan(ctx context.Context, v interface{}) error {
+	query, err := bgb.path(ctx)
+	if err != nil {
+		return err
+	}
+	bgb.sql = query
+	return bgb.sqlScan(ctx, v)
+}
+
+// ScanX is like Scan, but panics if an error occurs.
+func (bgb *BaselineGroupBy) ScanX(ctx context.Context, v interface{}) {
+	if err := bgb.Scan(ctx, v); err != nil {
+		panic(err)
+	}
+}
+
+// Strings returns list of strings from group-by. It is only allowed when querying group-by with one field.
+func (bgb *BaselineGroupBy) Strings(ctx context.Context) ([]string, error) {
+	if len(bgb.fields) > 1 {
+		return nil, errors.New("ent: BaselineGroupBy.Strings is not achievable when grouping more than 1 field")
+	}
+	var v []string
+	if err := bgb.Scan(ctx, &v); err != nil {
+		return nil, err
+	}
+	return v, nil
+}
+
+// StringsX is like Strings, but panics if an error occurs.
+func (bgb *BaselineGroupBy) StringsX(ctx context.Context) []string {
+	v, err := bgb.Strings(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// String returns a single string from group-by. It is only allowed when querying group-by with one field.
+func (bgb *BaselineGroupBy) String(ctx context.Context) (_ string, err error) {
+	var v []string
+	if v, err = bgb.Strings(ctx); err != nil {
+		return
+	}
+	switch len(v) {
+	case 1:
+		return v[0], nil
+	case 0:
+		err = &NotFoundError{baseline.Label}
+	default:
+		err = fmt.Errorf("ent: BaselineGroupBy.Strings returned %d results when one was expected", len(v))
+	}
+	return
+}
+
+// StringX is like String, but panics if an error occurs.
+func (bgb *BaselineGroupBy) StringX(ctx context.Context) string {
+	v, err := bgb.String(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Ints returns list of ints from group-by. It is only allowed when querying group-by with one field.
+func (bgb *BaselineGroupBy) Ints(ctx context.Context) ([]int, error) {
+	if len(bgb.fields) > 1 {
+		return nil, errors.New("ent: BaselineGroupBy.Ints is not achievable when grouping more than 1 field")
+	}
+	var v []int
+	if err := bgb.Scan(ctx, &v); err != nil {
+		return nil, err
+	}
+	return v, nil
+}
+
+// IntsX is like Ints, but panics if an error occurs.
+func (bgb *BaselineGroupBy) IntsX(ctx context.Context) []int {
+	v, err := bgb.Ints(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Int returns a single int from group-by. It is only allowed when querying group-by with one field.
+func (bgb *BaselineGroupBy) Int(ctx context.Context) (_ int, err error) {
+	var v []int
+	if v, err = bgb.Ints(ctx); err != nil {
+		return
+	}
+	switch len(v) {
+	case 1:
+		return v[0], nil
+	case 0:
+		err = &NotFoundError{baseline.Label}
+	default:
+		err = fmt.Errorf("ent: BaselineGroupBy.Ints returned %d results when one was expected", len(v))
+	}
+	return
+}
+
+// IntX is like Int, but panics if an error occurs.
+func (bgb *BaselineGroupBy) IntX(ctx context.Context) int {
+	v, err := bgb.Int(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Float64s returns list of float64s from group-by. It is only allowed when querying group-by with one field.
+func (bgb *BaselineGroupBy) Float64s(ctx context.Context) ([]float64, error) {
+	if len(bgb.fields) > 1 {
+		return nil, errors.New("ent: BaselineGroupBy.Float64s is not achievable when grouping more than 1 field")
+	}
+	var v []float64
+	if err := bgb.Scan(ctx, &v); err != nil {
+		return nil, err
+	}
+	return v, nil
+}
+
+// Float64sX is like Float64s, but panics if an error occurs.
+func (bgb *BaselineGroupBy) Float64sX(ctx context.Context) []float64 {
+	v, err := bgb.Float64s(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Float64 returns a single float64 from group-by. It is only allowed when querying group-by with one field.
+func (bgb *BaselineGroupBy) Float64(ctx context.Context) (_ float64, err error) {
+	var v []float64
+	if v, err = bgb.Float64s(ctx); err != nil {
+		return
+	}
+	switch len(v) {
+	case 1:
+		return v[0], nil
+	case 0:
+		err = &NotFoundError{baseline.Label}
+	default:
+		err = fmt.Errorf("ent: BaselineGroupBy.Float64s returned %d results when one was expected", len(v))
+	}
+	return
+}
+
+// Float64X is like Float64, but panics if an error occurs.
+func (bgb *BaselineGroupBy) Float64X(ctx context.Context) float64 {
+	v, err := bgb.Float64(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Bools returns list of bools from group-by. It is only allowed when querying group-by with one field.
+func (bgb *BaselineGroupBy) Bools(ctx context.Context) ([]bool, error) {
+	if len(bgb.fields) > 1 {
+		return nil, errors.New("ent: BaselineGroupBy.Bools is not achievable when grouping more than 1 field")
+	}
+	var v []bool
+	if err := bgb.Scan(ctx, &v); err != nil {
+		return nil, err
+	}
+	return v, nil
+}
+
+// BoolsX is like Bools, but panics if an error occurs.
+func (bgb *BaselineGroupBy) BoolsX(ctx context.Context) []bool {
+	v, err := bgb.Bools(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Bool returns a single bool from group-by. It is only allowed when querying group-by with one field.
+func (bgb *BaselineGroupBy) Bool(ctx context.Context) (_ bool, err error) {
+	var v []bool
+	if v, err = bgb.Bools(ctx); err != nil {
+		return
+	}
+	switch len(v) {
+	case 1:
+		return v[0], nil
+	case 0:
+		err = &NotFoundError{baseline.Label}
+	default:
+		err = fmt.Errorf("ent: BaselineGroupBy.Bools returned %d results when one was expected", len(v))
+	}
+	return
+}
+
+// BoolX is like Bool, but panics if an error occurs.
+func (bgb *BaselineGroupBy) BoolX(ctx context.Context) bool {
+	v, err := bgb.Bool(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+func (bgb *BaselineGroupBy) sqlScan(ctx context.Context, v interface{}) error {
+	for _, f := range bgb.fields {
+		if !baseline.ValidColumn(f) {
+			return &ValidationError{Name: f, err: fmt.Errorf("invalid field %q for group-by", f)}
+		}
+	}
+	selector := bgb.sqlQuery()
+	if err := selector.Err(); err != nil {
+		return err
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := bgb.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
+
+func (bgb *BaselineGroupBy) sqlQuery() *sql.Selector {
+	selector := bgb.sql
+	columns := make([]string, 0, len(bgb.fields)+len(bgb.fns))
+	columns = append(columns, bgb.fields...)
+	for _, fn := range bgb.fns {
+		columns = append(columns, fn(selector, baseline.ValidColumn))
+	}
+	return selector.Select(columns...).GroupBy(bgb.fields...)
+}
+
+// BaselineSelect is the builder for select fields of Baseline entities.
+type BaselineSelect struct {
+	config
+	fields []string
+	// intermediate query (i.e. traversal path).
+	sql  *sql.Selector
+	path func(context.Context) (*sql.Selector, error)
+}
+
+// Scan applies the selector query and scan the result into the given value.
+func (bs *BaselineSelect) Scan(ctx context.Context, v interface{}) error {
+	query, err := bs.path(ctx)
+	if err != nil {
+		return err
+	}
+	bs.sql = query
+	return bs.sqlScan(ctx, v)
+}
+
+// ScanX is like Scan, but panics if an error occurs.
+func (bs *BaselineSelect) ScanX(ctx context.Context, v interface{}) {
+	if err := bs.Scan(ctx, v); err != nil {
+		panic(err)
+	}
+}
+
+// Strings returns list of strings from selector. It is only allowed when selecting one field.
+func (bs *BaselineSelect) Strings(ctx context.Context) ([]string, error) {
+	if len(bs.fields) > 1 {
+		return nil, errors.New("ent: BaselineSelect.Strings is not achievable when selecting more than 1 field")
+	}
+	var v []string
+	if err := bs.Scan(ctx, &v); err != nil {
+		return nil, err
+	}
+	return v, nil
+}
+
+// StringsX is like Strings, but panics if an error occurs.
+func (bs *BaselineSelect) StringsX(ctx context.Context) []string {
+	v, err := bs.Strings(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// String returns a single string from selector. It is only allowed when selecting one field.
+func (bs *BaselineSelect) String(ctx context.Context) (_ string, err error) {
+	var v []string
+	if v, err = bs.Strings(ctx); err != nil {
+		return
+	}
+	switch len(v) {
+	case 1:
+		return v[0], nil
+	case 0:
+		err = &NotFoundError{baseline.Label}
+	default:
+		err = fmt.Errorf("ent: BaselineSelect.Strings returned %d results when one was expected", len(v))
+	}
+	return
+}
+
+// StringX is like String, but panics if an error occurs.
+func (bs *BaselineSelect) StringX(ctx context.Context) string {
+	v, err := bs.String(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Ints returns list of ints from selector. It is only allowed when selecting one field.
+func (bs *BaselineSelect) Ints(ctx context.Context) ([]int, error) {
+	if len(bs.fields) > 1 {
+		return nil, errors.New("ent: BaselineSelect.Ints is not achievable when selecting more than 1 field")
+	}
+	var v []int
+	if err := bs.Scan(ctx, &v); err != nil {
+		return nil, err
+	}
+	return v, nil
+}
+
+// IntsX is like Ints, but panics if an error occurs.
+func (bs *BaselineSelect) IntsX(ctx context.Context) []int {
+	v, err := bs.Ints(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Int returns a single int from selector. It is only allowed when selecting one field.
+func (bs *BaselineSelect) Int(ctx context.Context) (_ int, err error) {
+	var v []int
+	if v, err = bs.Ints(ctx); err != nil {
+		return
+	}
+	switch len(v) {
+	case 1:
+		return v[0], nil
+	case 0:
+		err = &NotFoundError{baseline.Label}
+	default:
+		err = fmt.Errorf("ent: BaselineSelect.Ints returned %d results when one was expected", len(v))
+	}
+	return
+}
+
+// IntX is like Int, but panics if an error occurs.
+func (bs *BaselineSelect) IntX(ctx context.Context) int {
+	v, err := bs.Int(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Float64s returns list of float64s from selector. It is only allowed when selecting one field.
+func (bs *BaselineSelect) Float64s(ctx context.Context) ([]float64, error) {
+	if len(bs.fields) > 1 {
+		return nil, errors.New("ent: BaselineSelect.Float64s is not achievable when selecting more than 1 field")
+	}
+	var v []float64
+	if err := bs.Scan(ctx, &v); err != nil {
+		return nil, err
+	}
+	return v, nil
+}
+
+// Float64sX is like Float64s, but panics if an error occurs.
+func (bs *BaselineSelect) Float64sX(ctx context.Context) []float64 {
+	v, err := bs.Float64s(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Float64 returns a single float64 from selector. It is only allowed when selecting one field.
+func (bs *BaselineSelect) Float64(ctx context.Context) (_ float64, err error) {
+	var v []float64
+	if v, err = bs.Float64s(ctx); err != nil {
+		return
+	}
+	switch len(v) {
+	case 1:
+		return v[0], nil
+	case 0:
+		err = &NotFoundError{baseline.Label}
+	default:
+		err = fmt.Errorf("ent: BaselineSelect.Float64s returned %d results when one was expected", len(v))
+	}
+	return
+}
+
+// Float64X is like Float64, but panics if an error occurs.
+func (bs *BaselineSelect) Float64X(ctx context.Context) float64 {
+	v, err := bs.Float64(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Bools returns list of bools from selector. It is only allowed when selecting one field.
+func (bs *BaselineSelect) Bools(ctx context.Context) ([]bool, error) {
+	if len(bs.fields) > 1 {
+		return nil, errors.New("ent: BaselineSelect.Bools is not achievable when selecting more than 1 field")
+	}
+	var v []bool
+	if err := bs.Scan(ctx, &v); err != nil {
+		return nil, err
+	}
+	return v, nil
+}
+
+// BoolsX is like Bools, but panics if an error occurs.
+func (bs *BaselineSelect) BoolsX(ctx context.Context) []bool {
+	v, err := bs.Bools(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Bool returns a single bool from selector. It is only allowed when selecting one field.
+func (bs *BaselineSelect) Bool(ctx context.Context) (_ bool, err error) {
+	var v []bool
+	if v, err = bs.Bools(ctx); err != nil {
+		return
+	}
+	switch len(v) {
+	case 1:
+		return v[0], nil
+	case 0:
+		err = &NotFoundError{baseline.Label}
+	default:
+		err = fmt.Errorf("ent: BaselineSelect.Bools returned %d results when one was expected", len(v))
+	}
+	return
+}
+
+// BoolX is like Bool, but panics if an error occurs.
+func (bs *BaselineSelect) BoolX(ctx context.Context) bool {
+	v, err := bs.Bool(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+func (bs *BaselineSelect) sqlScan(ctx context.Context, v interface{}) error {
+	for _, f := range bs.fields {
+		if !baseline.ValidColumn(f) {
+			return &ValidationError{Name: f, err: fmt.Errorf("invalid field %q for selection", f)}
+		}
+	}
+	rows := &sql.Rows{}
+	query, args := bs.sqlQuery().Query()
+	if err := bs.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
+
+func (bs *BaselineSelect) sqlQuery() sql.Querier {
+	selector := bs.sql
+	selector.Select(selector.Columns(bs.fields...)...)
+	return selector
+}
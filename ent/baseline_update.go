@@ -0,0 +1,583 @@
+// Code generated by entc, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/facebook/ent/dialect/sql"
+	"github.com/facebook/ent/dialect/sql/sqlgraph"
+	"github.com/facebook/ent/schema/field"
+	"github.com/gobench-io/gobench/ent/application"
+	"github.com/gobench-io/gobench/ent/baseline"
+	"github.com/gobench-io/gobench/ent/predicate"
+)
+
+// BaselineUpdate is the builder for updating Baseline entities.
+type BaselineUpdate struct {
+	config
+	hooks      []Hook
+	mutation   *BaselineMutation
+	predicates []predicate.Baseline
+}
+
+// Where adds a new predicate for the builder.
+func (bu *BaselineUpdate) Where(ps ...predicate.Baseline) *BaselineUpdate {
+	bu.predicates = append(bu.predicates, ps...)
+	return bu
+}
+
+// SetTag sets the tag field.
+func (bu *BaselineUpdate) SetTag(s string) *BaselineUpdate {
+	bu.mutation.SetTag(s)
+	return bu
+}
+
+// SetTolerance sets the tolerance field.
+func (bu *BaselineUpdate) SetTolerance(f float64) *BaselineUpdate {
+	bu.mutation.ResetTolerance()
+	bu.mutation.SetTolerance(f)
+	return bu
+}
+
+// SetNillableTolerance sets the tolerance field if the given value is not nil.
+func (bu *BaselineUpdate) SetNillableTolerance(f *float64) *BaselineUpdate {
+	if f != nil {
+		bu.SetTolerance(*f)
+	}
+	return bu
+}
+
+// AddTolerance adds f to tolerance.
+func (bu *BaselineUpdate) AddTolerance(f float64) *BaselineUpdate {
+	bu.mutation.AddTolerance(f)
+	return bu
+}
+
+// SetTolerances sets the tolerances field.
+func (bu *BaselineUpdate) SetTolerances(m map[string]float64) *BaselineUpdate {
+	bu.mutation.SetTolerances(m)
+	return bu
+}
+
+// ClearTolerances clears the value of tolerances.
+func (bu *BaselineUpdate) ClearTolerances() *BaselineUpdate {
+	bu.mutation.ClearTolerances()
+	return bu
+}
+
+// SetCreatedAt sets the created_at field.
+func (bu *BaselineUpdate) SetCreatedAt(t time.Time) *BaselineUpdate {
+	bu.mutation.SetCreatedAt(t)
+	return bu
+}
+
+// SetNillableCreatedAt sets the created_at field if the given value is not nil.
+func (bu *BaselineUpdate) SetNillableCreatedAt(t *time.Time) *BaselineUpdate {
+	if t != nil {
+		bu.SetCreatedAt(*t)
+	}
+	return bu
+}
+
+// SetUpdatedAt sets the updated_at field.
+func (bu *BaselineUpdate) SetUpdatedAt(t time.Time) *BaselineUpdate {
+	bu.mutation.SetUpdatedAt(t)
+	return bu
+}
+
+// SetApplicationID sets the application edge to Application by id.
+func (bu *BaselineUpdate) SetApplicationID(id int) *BaselineUpdate {
+	bu.mutation.SetApplicationID(id)
+	return bu
+}
+
+// SetApplication sets the application edge to Application.
+func (bu *BaselineUpdate) SetApplication(a *Application) *BaselineUpdate {
+	return bu.SetApplicationID(a.ID)
+}
+
+// Mutation returns the BaselineMutation object of the builder.
+func (bu *BaselineUpdate) Mutation() *BaselineMutation {
+	return bu.mutation
+}
+
+// ClearApplication clears the "application" edge to type Application.
+func (bu *BaselineUpdate) ClearApplication() *BaselineUpdate {
+	bu.mutation.ClearApplication()
+	return bu
+}
+
+// Save executes the query and returns the number of rows/vertices matched by this operation.
+func (bu *BaselineUpdate) Save(ctx context.Context) (int, error) {
+	var (
+		err      error
+		affected int
+	)
+	bu.defaults()
+	if len(bu.hooks) == 0 {
+		if err = bu.check(); err != nil {
+			return 0, err
+		}
+		affected, err = bu.sqlSave(ctx)
+	} else {
+		var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+			mutation, ok := m.(*BaselineMutation)
+			if !ok {
+				return nil, fmt.Errorf("unexpected mutation type %T", m)
+			}
+			if err = bu.check(); err != nil {
+				return 0, err
+			}
+			bu.mutation = mutation
+			affected, err = bu.sqlSave(ctx)
+			mutation.done = true
+			return affected, err
+		})
+		for i := len(bu.hooks) - 1; i >= 0; i-- {
+			mut = bu.hooks[i](mut)
+		}
+		if _, err := mut.Mutate(ctx, bu.mutation); err != nil {
+			return 0, err
+		}
+	}
+	return affected, err
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (bu *BaselineUpdate) SaveX(ctx context.Context) int {
+	affected, err := bu.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (bu *BaselineUpdate) Exec(ctx context.Context) error {
+	_, err := bu.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (bu *BaselineUpdate) ExecX(ctx context.Context) {
+	if err := bu.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (bu *BaselineUpdate) defaults() {
+	if _, ok := bu.mutation.UpdatedAt(); !ok {
+		v := baseline.UpdateDefaultUpdatedAt()
+		bu.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (bu *BaselineUpdate) check() error {
+	if v, ok := bu.mutation.Tag(); ok {
+		if err := baseline.TagValidator(v); err != nil {
+			return &ValidationError{Name: "tag", err: fmt.Errorf("ent: validator failed for field \"tag\": %w", err)}
+		}
+	}
+	if _, ok := bu.mutation.ApplicationID(); bu.mutation.ApplicationCleared() && !ok {
+		return errors.New("ent: clearing a required unique edge \"application\"")
+	}
+	return nil
+}
+
+func (bu *BaselineUpdate) sqlSave(ctx context.Context) (n int, err error) {
+	_spec := &sqlgraph.UpdateSpec{
+		Node: &sqlgraph.NodeSpec{
+			Table:   baseline.Table,
+			Columns: baseline.Columns,
+			ID: &sqlgraph.FieldSpec{
+				Type:   field.TypeInt,
+				Column: baseline.FieldID,
+			},
+		},
+	}
+	if ps := bu.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := bu.mutation.Tag(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: baseline.FieldTag,
+		})
+	}
+	if value, ok := bu.mutation.Tolerance(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeFloat64,
+			Value:  value,
+			Column: baseline.FieldTolerance,
+		})
+	}
+	if value, ok := bu.mutation.AddedTolerance(); ok {
+		_spec.Fields.Add = append(_spec.Fields.Add, &sqlgraph.FieldSpec{
+			Type:   field.TypeFloat64,
+			Value:  value,
+			Column: baseline.FieldTolerance,
+		})
+	}
+	if value, ok := bu.mutation.Tolerances(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeJSON,
+			Value:  value,
+			Column: baseline.FieldTolerances,
+		})
+	}
+	if bu.mutation.TolerancesCleared() {
+		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
+			Type:   field.TypeJSON,
+			Column: baseline.FieldTolerances,
+		})
+	}
+	if value, ok := bu.mutation.CreatedAt(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeTime,
+			Value:  value,
+			Column: baseline.FieldCreatedAt,
+		})
+	}
+	if value, ok := bu.mutation.UpdatedAt(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeTime,
+			Value:  value,
+			Column: baseline.FieldUpdatedAt,
+		})
+	}
+	if bu.mutation.ApplicationCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   baseline.ApplicationTable,
+			Columns: []string{baseline.ApplicationColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt,
+					Column: application.FieldID,
+				},
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := bu.mutation.ApplicationIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   baseline.ApplicationTable,
+			Columns: []string{baseline.ApplicationColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt,
+					Column: application.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if n, err = sqlgraph.UpdateNodes(ctx, bu.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{baseline.Label}
+		} else if cerr, ok := isSQLConstraintError(err); ok {
+			err = cerr
+		}
+		return 0, err
+	}
+	return n, nil
+}
+
+// BaselineUpdateOne is the builder for updating a single Baseline entity.
+type BaselineUpdateOne struct {
+	config
+	hooks    []Hook
+	mutation *BaselineMutation
+}
+
+// SetTag sets the tag field.
+func (buo *BaselineUpdateOne) SetTag(s string) *BaselineUpdateOne {
+	buo.mutation.SetTag(s)
+	return buo
+}
+
+// SetTolerance sets the tolerance field.
+func (buo *BaselineUpdateOne) SetTolerance(f float64) *BaselineUpdateOne {
+	buo.mutation.ResetTolerance()
+	buo.mutation.SetTolerance(f)
+	return buo
+}
+
+// SetNillableTolerance sets the tolerance field if the given value is not nil.
+func (buo *BaselineUpdateOne) SetNillableTolerance(f *float64) *BaselineUpdateOne {
+	if f != nil {
+		buo.SetTolerance(*f)
+	}
+	return buo
+}
+
+// AddTolerance adds f to tolerance.
+func (buo *BaselineUpdateOne) AddTolerance(f float64) *BaselineUpdateOne {
+	buo.mutation.AddTolerance(f)
+	return buo
+}
+
+// SetTolerances sets the tolerances field.
+func (buo *BaselineUpdateOne) SetTolerances(m map[string]float64) *BaselineUpdateOne {
+	buo.mutation.SetTolerances(m)
+	return buo
+}
+
+// ClearTolerances clears the value of tolerances.
+func (buo *BaselineUpdateOne) ClearTolerances() *BaselineUpdateOne {
+	buo.mutation.ClearTolerances()
+	return buo
+}
+
+// SetCreatedAt sets the created_at field.
+func (buo *BaselineUpdateOne) SetCreatedAt(t time.Time) *BaselineUpdateOne {
+	buo.mutation.SetCreatedAt(t)
+	return buo
+}
+
+// SetNillableCreatedAt sets the created_at field if the given value is not nil.
+func (buo *BaselineUpdateOne) SetNillableCreatedAt(t *time.Time) *BaselineUpdateOne {
+	if t != nil {
+		buo.SetCreatedAt(*t)
+	}
+	return buo
+}
+
+// SetUpdatedAt sets the updated_at field.
+func (buo *BaselineUpdateOne) SetUpdatedAt(t time.Time) *BaselineUpdateOne {
+	buo.mutation.SetUpdatedAt(t)
+	return buo
+}
+
+// SetApplicationID sets the application edge to Application by id.
+func (buo *BaselineUpdateOne) SetApplicationID(id int) *BaselineUpdateOne {
+	buo.mutation.SetApplicationID(id)
+	return buo
+}
+
+// SetApplication sets the application edge to Application.
+func (buo *BaselineUpdateOne) SetApplication(a *Application) *BaselineUpdateOne {
+	return buo.SetApplicationID(a.ID)
+}
+
+// Mutation returns the BaselineMutation object of the builder.
+func (buo *BaselineUpdateOne) Mutation() *BaselineMutation {
+	return buo.mutation
+}
+
+// ClearApplication clears the "application" edge to type Application.
+func (buo *BaselineUpdateOne) ClearApplication() *BaselineUpdateOne {
+	buo.mutation.ClearApplication()
+	return buo
+}
+
+// Save executes the query and returns the updated entity.
+func (buo *BaselineUpdateOne) Save(ctx context.Context) (*Baseline, error) {
+	var (
+		err  error
+		node *Baseline
+	)
+	buo.defaults()
+	if len(buo.hooks) == 0 {
+		if err = buo.check(); err != nil {
+			return nil, err
+		}
+		node, err = buo.sqlSave(ctx)
+	} else {
+		var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+			mutation, ok := m.(*BaselineMutation)
+			if !ok {
+				return nil, fmt.Errorf("unexpected mutation type %T", m)
+			}
+			if err = buo.check(); err != nil {
+				return nil, err
+			}
+			buo.mutation = mutation
+			node, err = buo.sqlSave(ctx)
+			mutation.done = true
+			return node, err
+		})
+		for i := len(buo.hooks) - 1; i >= 0; i-- {
+			mut = buo.hooks[i](mut)
+		}
+		if _, err := mut.Mutate(ctx, buo.mutation); err != nil {
+			return nil, err
+		}
+	}
+	return node, err
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (buo *BaselineUpdateOne) SaveX(ctx context.Context) *Baseline {
+	node, err := buo.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (buo *BaselineUpdateOne) Exec(ctx context.Context) error {
+	_, err := buo.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (buo *BaselineUpdateOne) ExecX(ctx context.Context) {
+	if err := buo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (buo *BaselineUpdateOne) defaults() {
+	if _, ok := buo.mutation.UpdatedAt(); !ok {
+		v := baseline.UpdateDefaultUpdatedAt()
+		buo.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (buo *BaselineUpdateOne) check() error {
+	if v, ok := buo.mutation.Tag(); ok {
+		if err := baseline.TagValidator(v); err != nil {
+			return &ValidationError{Name: "tag", err: fmt.Errorf("ent: validator failed for field \"tag\": %w", err)}
+		}
+	}
+	if _, ok := buo.mutation.ApplicationID(); buo.mutation.ApplicationCleared() && !ok {
+		return errors.New("ent: clearing a required unique edge \"application\"")
+	}
+	return nil
+}
+
+func (buo *BaselineUpdateOne) sqlSave(ctx context.Context) (_node *Baseline, err error) {
+	_spec := &sqlgraph.UpdateSpec{
+		Node: &sqlgraph.NodeSpec{
+			Table:   baseline.Table,
+			Columns: baseline.Columns,
+			ID: &sqlgraph.FieldSpec{
+				Type:   field.TypeInt,
+				Column: baseline.FieldID,
+			},
+		},
+	}
+	id, ok := buo.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "ID", err: fmt.Errorf("missing Baseline.ID for update")}
+	}
+	_spec.Node.ID.Value = id
+	if value, ok := buo.mutation.Tag(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: baseline.FieldTag,
+		})
+	}
+	if value, ok := buo.mutation.Tolerance(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeFloat64,
+			Value:  value,
+			Column: baseline.FieldTolerance,
+		})
+	}
+	if value, ok := buo.mutation.AddedTolerance(); ok {
+		_spec.Fields.Add = append(_spec.Fields.Add, &sqlgraph.FieldSpec{
+			Type:   field.TypeFloat64,
+			Value:  value,
+			Column: baseline.FieldTolerance,
+		})
+	}
+	if value, ok := buo.mutation.Tolerances(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeJSON,
+			Value:  value,
+			Column: baseline.FieldTolerances,
+		})
+	}
+	if buo.mutation.TolerancesCleared() {
+		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
+			Type:   field.TypeJSON,
+			Column: baseline.FieldTolerances,
+		})
+	}
+	if value, ok := buo.mutation.CreatedAt(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeTime,
+			Value:  value,
+			Column: baseline.FieldCreatedAt,
+		})
+	}
+	if value, ok := buo.mutation.UpdatedAt(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeTime,
+			Value:  value,
+			Column: baseline.FieldUpdatedAt,
+		})
+	}
+	if buo.mutation.ApplicationCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   baseline.ApplicationTable,
+			Columns: []string{baseline.ApplicationColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt,
+					Column: application.FieldID,
+				},
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := buo.mutation.ApplicationIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   baseline.ApplicationTable,
+			Columns: []string{baseline.ApplicationColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt,
+					Column: application.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_node = &Baseline{config: buo.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues()
+	if err = sqlgraph.UpdateNode(ctx, buo.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{baseline.Label}
+		} else if cerr, ok := isSQLConstraintError(err); ok {
+			err = cerr
+		}
+		return nil, err
+	}
+	return _node, nil
+}
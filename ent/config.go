@@ -24,14 +24,24 @@ type config struct {
 
 // hooks per client, for fast access.
 type hooks struct {
-	Application []ent.Hook
-	Counter     []ent.Hook
-	Gauge       []ent.Hook
-	Graph       []ent.Hook
-	Group       []ent.Hook
-	Histogram   []ent.Hook
-	Metric      []ent.Hook
-	Tag         []ent.Hook
+	APIToken        []ent.Hook
+	Application     []ent.Hook
+	Audit           []ent.Hook
+	Baseline        []ent.Hook
+	Counter         []ent.Hook
+	Event           []ent.Hook
+	Executor        []ent.Hook
+	Gauge           []ent.Hook
+	Graph           []ent.Hook
+	Group           []ent.Hook
+	Histogram       []ent.Hook
+	Metric          []ent.Hook
+	Project         []ent.Hook
+	Subscription    []ent.Hook
+	Tag             []ent.Hook
+	User            []ent.Hook
+	Webhook         []ent.Hook
+	WebhookDelivery []ent.Hook
 }
 
 // Options applies the options on the config object.
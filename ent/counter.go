@@ -8,6 +8,7 @@ import (
 
 	"github.com/facebook/ent/dialect/sql"
 	"github.com/gobench-io/gobench/ent/counter"
+	"github.com/gobench-io/gobench/ent/executor"
 	"github.com/gobench-io/gobench/ent/metric"
 )
 
@@ -21,20 +22,23 @@ type Counter struct {
 	// Count holds the value of the "count" field.
 	Count int64 `json:"count"`
 	// WID holds the value of the "wID" field.
-	WID string `json:"wId"`
+	WID string `json:"-"`
 	// Edges holds the relations/edges for other nodes in the graph.
 	// The values are being populated by the CounterQuery when eager-loading is set.
-	Edges           CounterEdges `json:"edges"`
-	metric_counters *int
+	Edges             CounterEdges `json:"edges"`
+	executor_counters *int
+	metric_counters   *int
 }
 
 // CounterEdges holds the relations/edges for other nodes in the graph.
 type CounterEdges struct {
 	// Metric holds the value of the metric edge.
 	Metric *Metric
+	// Executor holds the value of the executor edge.
+	Executor *Executor
 	// loadedTypes holds the information for reporting if a
 	// type was loaded (or requested) in eager-loading or not.
-	loadedTypes [1]bool
+	loadedTypes [2]bool
 }
 
 // MetricOrErr returns the Metric value or an error if the edge
@@ -51,6 +55,20 @@ func (e CounterEdges) MetricOrErr() (*Metric, error) {
 	return nil, &NotLoadedError{edge: "metric"}
 }
 
+// ExecutorOrErr returns the Executor value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e CounterEdges) ExecutorOrErr() (*Executor, error) {
+	if e.loadedTypes[1] {
+		if e.Executor == nil {
+			// The edge executor was loaded in eager-loading,
+			// but was not found.
+			return nil, &NotFoundError{label: executor.Label}
+		}
+		return e.Executor, nil
+	}
+	return nil, &NotLoadedError{edge: "executor"}
+}
+
 // scanValues returns the types for scanning values from sql.Rows.
 func (*Counter) scanValues() []interface{} {
 	return []interface{}{
@@ -64,6 +82,7 @@ func (*Counter) scanValues() []interface{} {
 // fkValues returns the types for scanning foreign-keys values from sql.Rows.
 func (*Counter) fkValues() []interface{} {
 	return []interface{}{
+		&sql.NullInt64{}, // executor_counters
 		&sql.NullInt64{}, // metric_counters
 	}
 }
@@ -98,6 +117,12 @@ func (c *Counter) assignValues(values ...interface{}) error {
 	values = values[3:]
 	if len(values) == len(counter.ForeignKeys) {
 		if value, ok := values[0].(*sql.NullInt64); !ok {
+			return fmt.Errorf("unexpected type %T for edge-field executor_counters", value)
+		} else if value.Valid {
+			c.executor_counters = new(int)
+			*c.executor_counters = int(value.Int64)
+		}
+		if value, ok := values[1].(*sql.NullInt64); !ok {
 			return fmt.Errorf("unexpected type %T for edge-field metric_counters", value)
 		} else if value.Valid {
 			c.metric_counters = new(int)
@@ -112,6 +137,11 @@ func (c *Counter) QueryMetric() *MetricQuery {
 	return (&CounterClient{config: c.config}).QueryMetric(c)
 }
 
+// QueryExecutor queries the executor edge of the Counter.
+func (c *Counter) QueryExecutor() *ExecutorQuery {
+	return (&CounterClient{config: c.config}).QueryExecutor(c)
+}
+
 // Update returns a builder for updating this Counter.
 // Note that, you need to call Counter.Unwrap() before calling this method, if this Counter
 // was returned from a transaction, and the transaction was committed or rolled back.
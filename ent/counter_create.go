@@ -10,6 +10,7 @@ import (
 	"github.com/facebook/ent/dialect/sql/sqlgraph"
 	"github.com/facebook/ent/schema/field"
 	"github.com/gobench-io/gobench/ent/counter"
+	"github.com/gobench-io/gobench/ent/executor"
 	"github.com/gobench-io/gobench/ent/metric"
 )
 
@@ -38,6 +39,14 @@ func (cc *CounterCreate) SetWID(s string) *CounterCreate {
 	return cc
 }
 
+// SetNillableWID sets the wID field if the given value is not nil.
+func (cc *CounterCreate) SetNillableWID(s *string) *CounterCreate {
+	if s != nil {
+		cc.SetWID(*s)
+	}
+	return cc
+}
+
 // SetMetricID sets the metric edge to Metric by id.
 func (cc *CounterCreate) SetMetricID(id int) *CounterCreate {
 	cc.mutation.SetMetricID(id)
@@ -57,6 +66,25 @@ func (cc *CounterCreate) SetMetric(m *Metric) *CounterCreate {
 	return cc.SetMetricID(m.ID)
 }
 
+// SetExecutorID sets the executor edge to Executor by id.
+func (cc *CounterCreate) SetExecutorID(id int) *CounterCreate {
+	cc.mutation.SetExecutorID(id)
+	return cc
+}
+
+// SetNillableExecutorID sets the executor edge to Executor by id if the given value is not nil.
+func (cc *CounterCreate) SetNillableExecutorID(id *int) *CounterCreate {
+	if id != nil {
+		cc = cc.SetExecutorID(*id)
+	}
+	return cc
+}
+
+// SetExecutor sets the executor edge to Executor.
+func (cc *CounterCreate) SetExecutor(e *Executor) *CounterCreate {
+	return cc.SetExecutorID(e.ID)
+}
+
 // Mutation returns the CounterMutation object of the builder.
 func (cc *CounterCreate) Mutation() *CounterMutation {
 	return cc.mutation
@@ -68,6 +96,7 @@ func (cc *CounterCreate) Save(ctx context.Context) (*Counter, error) {
 		err  error
 		node *Counter
 	)
+	cc.defaults()
 	if len(cc.hooks) == 0 {
 		if err = cc.check(); err != nil {
 			return nil, err
@@ -106,6 +135,14 @@ func (cc *CounterCreate) SaveX(ctx context.Context) *Counter {
 	return v
 }
 
+// defaults sets the default values of the builder before save.
+func (cc *CounterCreate) defaults() {
+	if _, ok := cc.mutation.WID(); !ok {
+		v := counter.DefaultWID
+		cc.mutation.SetWID(v)
+	}
+}
+
 // check runs all checks and user-defined validators on the builder.
 func (cc *CounterCreate) check() error {
 	if _, ok := cc.mutation.Time(); !ok {
@@ -187,6 +224,25 @@ func (cc *CounterCreate) createSpec() (*Counter, *sqlgraph.CreateSpec) {
 		}
 		_spec.Edges = append(_spec.Edges, edge)
 	}
+	if nodes := cc.mutation.ExecutorIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   counter.ExecutorTable,
+			Columns: []string{counter.ExecutorColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt,
+					Column: executor.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
 	return _node, _spec
 }
 
@@ -204,6 +260,7 @@ func (ccb *CounterCreateBulk) Save(ctx context.Context) ([]*Counter, error) {
 	for i := range ccb.builders {
 		func(i int, root context.Context) {
 			builder := ccb.builders[i]
+			builder.defaults()
 			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
 				mutation, ok := m.(*CounterMutation)
 				if !ok {
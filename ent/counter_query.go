@@ -12,6 +12,7 @@ import (
 	"github.com/facebook/ent/dialect/sql/sqlgraph"
 	"github.com/facebook/ent/schema/field"
 	"github.com/gobench-io/gobench/ent/counter"
+	"github.com/gobench-io/gobench/ent/executor"
 	"github.com/gobench-io/gobench/ent/metric"
 	"github.com/gobench-io/gobench/ent/predicate"
 )
@@ -25,8 +26,9 @@ type CounterQuery struct {
 	unique     []string
 	predicates []predicate.Counter
 	// eager-loading edges.
-	withMetric *MetricQuery
-	withFKs    bool
+	withMetric   *MetricQuery
+	withExecutor *ExecutorQuery
+	withFKs      bool
 	// intermediate query (i.e. traversal path).
 	sql  *sql.Selector
 	path func(context.Context) (*sql.Selector, error)
@@ -78,6 +80,28 @@ func (cq *CounterQuery) QueryMetric() *MetricQuery {
 	return query
 }
 
+// QueryExecutor chains the current query on the executor edge.
+func (cq *CounterQuery) QueryExecutor() *ExecutorQuery {
+	query := &ExecutorQuery{config: cq.config}
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := cq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := cq.sqlQuery()
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(counter.Table, counter.FieldID, selector),
+			sqlgraph.To(executor.Table, executor.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, counter.ExecutorTable, counter.ExecutorColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(cq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
 // First returns the first Counter entity in the query. Returns *NotFoundError when no counter was found.
 func (cq *CounterQuery) First(ctx context.Context) (*Counter, error) {
 	nodes, err := cq.Limit(1).All(ctx)
@@ -257,7 +281,8 @@ func (cq *CounterQuery) Clone() *CounterQuery {
 	}
 }
 
-//  WithMetric tells the query-builder to eager-loads the nodes that are connected to
+//	WithMetric tells the query-builder to eager-loads the nodes that are connected to
+//
 // the "metric" edge. The optional arguments used to configure the query builder of the edge.
 func (cq *CounterQuery) WithMetric(opts ...func(*MetricQuery)) *CounterQuery {
 	query := &MetricQuery{config: cq.config}
@@ -268,6 +293,18 @@ func (cq *CounterQuery) WithMetric(opts ...func(*MetricQuery)) *CounterQuery {
 	return cq
 }
 
+//	WithExecutor tells the query-builder to eager-loads the nodes that are connected to
+//
+// the "executor" edge. The optional arguments used to configure the query builder of the edge.
+func (cq *CounterQuery) WithExecutor(opts ...func(*ExecutorQuery)) *CounterQuery {
+	query := &ExecutorQuery{config: cq.config}
+	for _, opt := range opts {
+		opt(query)
+	}
+	cq.withExecutor = query
+	return cq
+}
+
 // GroupBy used to group vertices by one or more fields/columns.
 // It is often used with aggregate functions, like: count, max, mean, min, sum.
 //
@@ -282,7 +319,6 @@ func (cq *CounterQuery) WithMetric(opts ...func(*MetricQuery)) *CounterQuery {
 //		GroupBy(counter.FieldTime).
 //		Aggregate(ent.Count()).
 //		Scan(ctx, &v)
-//
 func (cq *CounterQuery) GroupBy(field string, fields ...string) *CounterGroupBy {
 	group := &CounterGroupBy{config: cq.config}
 	group.fields = append([]string{field}, fields...)
@@ -306,7 +342,6 @@ func (cq *CounterQuery) GroupBy(field string, fields ...string) *CounterGroupBy
 //	client.Counter.Query().
 //		Select(counter.FieldTime).
 //		Scan(ctx, &v)
-//
 func (cq *CounterQuery) Select(field string, fields ...string) *CounterSelect {
 	selector := &CounterSelect{config: cq.config}
 	selector.fields = append([]string{field}, fields...)
@@ -335,11 +370,12 @@ func (cq *CounterQuery) sqlAll(ctx context.Context) ([]*Counter, error) {
 		nodes       = []*Counter{}
 		withFKs     = cq.withFKs
 		_spec       = cq.querySpec()
-		loadedTypes = [1]bool{
+		loadedTypes = [2]bool{
 			cq.withMetric != nil,
+			cq.withExecutor != nil,
 		}
 	)
-	if cq.withMetric != nil {
+	if cq.withMetric != nil || cq.withExecutor != nil {
 		withFKs = true
 	}
 	if withFKs {
@@ -394,6 +430,31 @@ func (cq *CounterQuery) sqlAll(ctx context.Context) ([]*Counter, error) {
 		}
 	}
 
+	if query := cq.withExecutor; query != nil {
+		ids := make([]int, 0, len(nodes))
+		nodeids := make(map[int][]*Counter)
+		for i := range nodes {
+			if fk := nodes[i].executor_counters; fk != nil {
+				ids = append(ids, *fk)
+				nodeids[*fk] = append(nodeids[*fk], nodes[i])
+			}
+		}
+		query.Where(executor.IDIn(ids...))
+		neighbors, err := query.All(ctx)
+		if err != nil {
+			return nil, err
+		}
+		for _, n := range neighbors {
+			nodes, ok := nodeids[n.ID]
+			if !ok {
+				return nil, fmt.Errorf(`unexpected foreign-key "executor_counters" returned %v`, n.ID)
+			}
+			for i := range nodes {
+				nodes[i].Edges.Executor = n
+			}
+		}
+	}
+
 	return nodes, nil
 }
 
@@ -10,6 +10,7 @@ import (
 	"github.com/facebook/ent/dialect/sql/sqlgraph"
 	"github.com/facebook/ent/schema/field"
 	"github.com/gobench-io/gobench/ent/counter"
+	"github.com/gobench-io/gobench/ent/executor"
 	"github.com/gobench-io/gobench/ent/metric"
 	"github.com/gobench-io/gobench/ent/predicate"
 )
@@ -60,6 +61,14 @@ func (cu *CounterUpdate) SetWID(s string) *CounterUpdate {
 	return cu
 }
 
+// SetNillableWID sets the wID field if the given value is not nil.
+func (cu *CounterUpdate) SetNillableWID(s *string) *CounterUpdate {
+	if s != nil {
+		cu.SetWID(*s)
+	}
+	return cu
+}
+
 // SetMetricID sets the metric edge to Metric by id.
 func (cu *CounterUpdate) SetMetricID(id int) *CounterUpdate {
 	cu.mutation.SetMetricID(id)
@@ -79,6 +88,25 @@ func (cu *CounterUpdate) SetMetric(m *Metric) *CounterUpdate {
 	return cu.SetMetricID(m.ID)
 }
 
+// SetExecutorID sets the executor edge to Executor by id.
+func (cu *CounterUpdate) SetExecutorID(id int) *CounterUpdate {
+	cu.mutation.SetExecutorID(id)
+	return cu
+}
+
+// SetNillableExecutorID sets the executor edge to Executor by id if the given value is not nil.
+func (cu *CounterUpdate) SetNillableExecutorID(id *int) *CounterUpdate {
+	if id != nil {
+		cu = cu.SetExecutorID(*id)
+	}
+	return cu
+}
+
+// SetExecutor sets the executor edge to Executor.
+func (cu *CounterUpdate) SetExecutor(e *Executor) *CounterUpdate {
+	return cu.SetExecutorID(e.ID)
+}
+
 // Mutation returns the CounterMutation object of the builder.
 func (cu *CounterUpdate) Mutation() *CounterMutation {
 	return cu.mutation
@@ -90,6 +118,12 @@ func (cu *CounterUpdate) ClearMetric() *CounterUpdate {
 	return cu
 }
 
+// ClearExecutor clears the "executor" edge to type Executor.
+func (cu *CounterUpdate) ClearExecutor() *CounterUpdate {
+	cu.mutation.ClearExecutor()
+	return cu
+}
+
 // Save executes the query and returns the number of rows/vertices matched by this operation.
 func (cu *CounterUpdate) Save(ctx context.Context) (int, error) {
 	var (
@@ -229,6 +263,41 @@ func (cu *CounterUpdate) sqlSave(ctx context.Context) (n int, err error) {
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
+	if cu.mutation.ExecutorCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   counter.ExecutorTable,
+			Columns: []string{counter.ExecutorColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt,
+					Column: executor.FieldID,
+				},
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := cu.mutation.ExecutorIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   counter.ExecutorTable,
+			Columns: []string{counter.ExecutorColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt,
+					Column: executor.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
 	if n, err = sqlgraph.UpdateNodes(ctx, cu.driver, _spec); err != nil {
 		if _, ok := err.(*sqlgraph.NotFoundError); ok {
 			err = &NotFoundError{counter.Label}
@@ -279,6 +348,14 @@ func (cuo *CounterUpdateOne) SetWID(s string) *CounterUpdateOne {
 	return cuo
 }
 
+// SetNillableWID sets the wID field if the given value is not nil.
+func (cuo *CounterUpdateOne) SetNillableWID(s *string) *CounterUpdateOne {
+	if s != nil {
+		cuo.SetWID(*s)
+	}
+	return cuo
+}
+
 // SetMetricID sets the metric edge to Metric by id.
 func (cuo *CounterUpdateOne) SetMetricID(id int) *CounterUpdateOne {
 	cuo.mutation.SetMetricID(id)
@@ -298,6 +375,25 @@ func (cuo *CounterUpdateOne) SetMetric(m *Metric) *CounterUpdateOne {
 	return cuo.SetMetricID(m.ID)
 }
 
+// SetExecutorID sets the executor edge to Executor by id.
+func (cuo *CounterUpdateOne) SetExecutorID(id int) *CounterUpdateOne {
+	cuo.mutation.SetExecutorID(id)
+	return cuo
+}
+
+// SetNillableExecutorID sets the executor edge to Executor by id if the given value is not nil.
+func (cuo *CounterUpdateOne) SetNillableExecutorID(id *int) *CounterUpdateOne {
+	if id != nil {
+		cuo = cuo.SetExecutorID(*id)
+	}
+	return cuo
+}
+
+// SetExecutor sets the executor edge to Executor.
+func (cuo *CounterUpdateOne) SetExecutor(e *Executor) *CounterUpdateOne {
+	return cuo.SetExecutorID(e.ID)
+}
+
 // Mutation returns the CounterMutation object of the builder.
 func (cuo *CounterUpdateOne) Mutation() *CounterMutation {
 	return cuo.mutation
@@ -309,6 +405,12 @@ func (cuo *CounterUpdateOne) ClearMetric() *CounterUpdateOne {
 	return cuo
 }
 
+// ClearExecutor clears the "executor" edge to type Executor.
+func (cuo *CounterUpdateOne) ClearExecutor() *CounterUpdateOne {
+	cuo.mutation.ClearExecutor()
+	return cuo
+}
+
 // Save executes the query and returns the updated entity.
 func (cuo *CounterUpdateOne) Save(ctx context.Context) (*Counter, error) {
 	var (
@@ -446,6 +548,41 @@ func (cuo *CounterUpdateOne) sqlSave(ctx context.Context) (_node *Counter, err e
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
+	if cuo.mutation.ExecutorCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   counter.ExecutorTable,
+			Columns: []string{counter.ExecutorColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt,
+					Column: executor.FieldID,
+				},
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := cuo.mutation.ExecutorIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   counter.ExecutorTable,
+			Columns: []string{counter.ExecutorColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt,
+					Column: executor.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
 	_node = &Counter{config: cuo.config}
 	_spec.Assign = _node.assignValues
 	_spec.ScanValues = _node.scanValues()
@@ -62,7 +62,6 @@ type AggregateFunc func(*sql.Selector, func(string) bool) string
 //	GroupBy(field1, field2).
 //	Aggregate(ent.As(ent.Sum(field1), "sum_field1"), (ent.As(ent.Sum(field2), "sum_field2")).
 //	Scan(ctx, &v)
-//
 func As(fn AggregateFunc, end string) AggregateFunc {
 	return func(s *sql.Selector, check func(string) bool) string {
 		return sql.As(fn(s, check), end)
@@ -0,0 +1,179 @@
+// Code generated by entc, DO NOT EDIT.
+
+package ent
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"github.com/facebook/ent/dialect/sql"
+	"github.com/gobench-io/gobench/ent/application"
+	"github.com/gobench-io/gobench/ent/event"
+)
+
+// Event is the model entity for the Event schema.
+type Event struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID int `json:"id,omitempty"`
+	// Name holds the value of the "name" field.
+	Name string `json:"name"`
+	// Time holds the value of the "time" field.
+	Time int64 `json:"time"`
+	// Source holds the value of the "source" field.
+	Source string `json:"source"`
+	// WID holds the value of the "wID" field.
+	WID string `json:"wId"`
+	// Attrs holds the value of the "attrs" field.
+	Attrs map[string]string `json:"attrs"`
+	// Edges holds the relations/edges for other nodes in the graph.
+	// The values are being populated by the EventQuery when eager-loading is set.
+	Edges              EventEdges `json:"edges"`
+	application_events *int
+}
+
+// EventEdges holds the relations/edges for other nodes in the graph.
+type EventEdges struct {
+	// Application holds the value of the application edge.
+	Application *Application
+	// loadedTypes holds the information for reporting if a
+	// type was loaded (or requested) in eager-loading or not.
+	loadedTypes [1]bool
+}
+
+// ApplicationOrErr returns the Application value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e EventEdges) ApplicationOrErr() (*Application, error) {
+	if e.loadedTypes[0] {
+		if e.Application == nil {
+			// The edge application was loaded in eager-loading,
+			// but was not found.
+			return nil, &NotFoundError{label: application.Label}
+		}
+		return e.Application, nil
+	}
+	return nil, &NotLoadedError{edge: "application"}
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*Event) scanValues() []interface{} {
+	return []interface{}{
+		&sql.NullInt64{},  // id
+		&sql.NullString{}, // name
+		&sql.NullInt64{},  // time
+		&sql.NullString{}, // source
+		&sql.NullString{}, // wID
+		&[]byte{},         // attrs
+	}
+}
+
+// fkValues returns the types for scanning foreign-keys values from sql.Rows.
+func (*Event) fkValues() []interface{} {
+	return []interface{}{
+		&sql.NullInt64{}, // application_events
+	}
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the Event fields.
+func (e *Event) assignValues(values ...interface{}) error {
+	if m, n := len(values), len(event.Columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	value, ok := values[0].(*sql.NullInt64)
+	if !ok {
+		return fmt.Errorf("unexpected type %T for field id", value)
+	}
+	e.ID = int(value.Int64)
+	values = values[1:]
+	if value, ok := values[0].(*sql.NullString); !ok {
+		return fmt.Errorf("unexpected type %T for field name", values[0])
+	} else if value.Valid {
+		e.Name = value.String
+	}
+	if value, ok := values[1].(*sql.NullInt64); !ok {
+		return fmt.Errorf("unexpected type %T for field time", values[1])
+	} else if value.Valid {
+		e.Time = value.Int64
+	}
+	if value, ok := values[2].(*sql.NullString); !ok {
+		return fmt.Errorf("unexpected type %T for field source", values[2])
+	} else if value.Valid {
+		e.Source = value.String
+	}
+	if value, ok := values[3].(*sql.NullString); !ok {
+		return fmt.Errorf("unexpected type %T for field wID", values[3])
+	} else if value.Valid {
+		e.WID = value.String
+	}
+
+	if value, ok := values[4].(*[]byte); !ok {
+		return fmt.Errorf("unexpected type %T for field attrs", values[4])
+	} else if value != nil && len(*value) > 0 {
+		if err := json.Unmarshal(*value, &e.Attrs); err != nil {
+			return fmt.Errorf("unmarshal field attrs: %v", err)
+		}
+	}
+	values = values[5:]
+	if len(values) == len(event.ForeignKeys) {
+		if value, ok := values[0].(*sql.NullInt64); !ok {
+			return fmt.Errorf("unexpected type %T for edge-field application_events", value)
+		} else if value.Valid {
+			e.application_events = new(int)
+			*e.application_events = int(value.Int64)
+		}
+	}
+	return nil
+}
+
+// QueryApplication queries the application edge of the Event.
+func (e *Event) QueryApplication() *ApplicationQuery {
+	return (&EventClient{config: e.config}).QueryApplication(e)
+}
+
+// Update returns a builder for updating this Event.
+// Note that, you need to call Event.Unwrap() before calling this method, if this Event
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (e *Event) Update() *EventUpdateOne {
+	return (&EventClient{config: e.config}).UpdateOne(e)
+}
+
+// Unwrap unwraps the entity that was returned from a transaction after it was closed,
+// so that all next queries will be executed through the driver which created the transaction.
+func (e *Event) Unwrap() *Event {
+	tx, ok := e.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: Event is not a transactional entity")
+	}
+	e.config.driver = tx.drv
+	return e
+}
+
+// String implements the fmt.Stringer.
+func (e *Event) String() string {
+	var builder strings.Builder
+	builder.WriteString("Event(")
+	builder.WriteString(fmt.Sprintf("id=%v", e.ID))
+	builder.WriteString(", name=")
+	builder.WriteString(e.Name)
+	builder.WriteString(", time=")
+	builder.WriteString(fmt.Sprintf("%v", e.Time))
+	builder.WriteString(", source=")
+	builder.WriteString(e.Source)
+	builder.WriteString(", wID=")
+	builder.WriteString(e.WID)
+	builder.WriteString(", attrs=")
+	builder.WriteString(fmt.Sprintf("%v", e.Attrs))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// Events is a parsable slice of Event.
+type Events []*Event
+
+func (e Events) config(cfg config) {
+	for _i := range e {
+		e[_i].config = cfg
+	}
+}
@@ -0,0 +1,68 @@
+// Code generated by entc, DO NOT EDIT.
+
+package event
+
+const (
+	// Label holds the string label denoting the event type in the database.
+	Label = "event"
+	// FieldID holds the string denoting the id field in the database.
+	FieldID = "id"
+	// FieldName holds the string denoting the name field in the database.
+	FieldName = "name"
+	// FieldTime holds the string denoting the time field in the database.
+	FieldTime = "time"
+	// FieldSource holds the string denoting the source field in the database.
+	FieldSource = "source"
+	// FieldWID holds the string denoting the wid field in the database.
+	FieldWID = "w_id"
+	// FieldAttrs holds the string denoting the attrs field in the database.
+	FieldAttrs = "attrs"
+
+	// EdgeApplication holds the string denoting the application edge name in mutations.
+	EdgeApplication = "application"
+
+	// Table holds the table name of the event in the database.
+	Table = "events"
+	// ApplicationTable is the table the holds the application relation/edge.
+	ApplicationTable = "events"
+	// ApplicationInverseTable is the table name for the Application entity.
+	// It exists in this package in order to avoid circular dependency with the "application" package.
+	ApplicationInverseTable = "applications"
+	// ApplicationColumn is the table column denoting the application relation/edge.
+	ApplicationColumn = "application_events"
+)
+
+// Columns holds all SQL columns for event fields.
+var Columns = []string{
+	FieldID,
+	FieldName,
+	FieldTime,
+	FieldSource,
+	FieldWID,
+	FieldAttrs,
+}
+
+// ForeignKeys holds the SQL foreign-keys that are owned by the Event type.
+var ForeignKeys = []string{
+	"application_events",
+}
+
+// ValidColumn reports if the column name is valid (part of the table columns).
+func ValidColumn(column string) bool {
+	for i := range Columns {
+		if column == Columns[i] {
+			return true
+		}
+	}
+	for i := range ForeignKeys {
+		if column == ForeignKeys[i] {
+			return true
+		}
+	}
+	return false
+}
+
+var (
+	// NameValidator is a validator for the "name" field. It is called by the builders before save.
+	NameValidator func(string) error
+)
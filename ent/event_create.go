@@ -0,0 +1,293 @@
+// Code generated by entc, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/facebook/ent/dialect/sql/sqlgraph"
+	"github.com/facebook/ent/schema/field"
+	"github.com/gobench-io/gobench/ent/application"
+	"github.com/gobench-io/gobench/ent/event"
+)
+
+// EventCreate is the builder for creating a Event entity.
+type EventCreate struct {
+	config
+	mutation *EventMutation
+	hooks    []Hook
+}
+
+// SetName sets the name field.
+func (ec *EventCreate) SetName(s string) *EventCreate {
+	ec.mutation.SetName(s)
+	return ec
+}
+
+// SetTime sets the time field.
+func (ec *EventCreate) SetTime(i int64) *EventCreate {
+	ec.mutation.SetTime(i)
+	return ec
+}
+
+// SetSource sets the source field.
+func (ec *EventCreate) SetSource(s string) *EventCreate {
+	ec.mutation.SetSource(s)
+	return ec
+}
+
+// SetWID sets the wID field.
+func (ec *EventCreate) SetWID(s string) *EventCreate {
+	ec.mutation.SetWID(s)
+	return ec
+}
+
+// SetNillableWID sets the wID field if the given value is not nil.
+func (ec *EventCreate) SetNillableWID(s *string) *EventCreate {
+	if s != nil {
+		ec.SetWID(*s)
+	}
+	return ec
+}
+
+// SetAttrs sets the attrs field.
+func (ec *EventCreate) SetAttrs(m map[string]string) *EventCreate {
+	ec.mutation.SetAttrs(m)
+	return ec
+}
+
+// SetApplicationID sets the application edge to Application by id.
+func (ec *EventCreate) SetApplicationID(id int) *EventCreate {
+	ec.mutation.SetApplicationID(id)
+	return ec
+}
+
+// SetApplication sets the application edge to Application.
+func (ec *EventCreate) SetApplication(a *Application) *EventCreate {
+	return ec.SetApplicationID(a.ID)
+}
+
+// Mutation returns the EventMutation object of the builder.
+func (ec *EventCreate) Mutation() *EventMutation {
+	return ec.mutation
+}
+
+// Save creates the Event in the database.
+func (ec *EventCreate) Save(ctx context.Context) (*Event, error) {
+	var (
+		err  error
+		node *Event
+	)
+	if len(ec.hooks) == 0 {
+		if err = ec.check(); err != nil {
+			return nil, err
+		}
+		node, err = ec.sqlSave(ctx)
+	} else {
+		var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+			mutation, ok := m.(*EventMutation)
+			if !ok {
+				return nil, fmt.Errorf("unexpected mutation type %T", m)
+			}
+			if err = ec.check(); err != nil {
+				return nil, err
+			}
+			ec.mutation = mutation
+			node, err = ec.sqlSave(ctx)
+			mutation.done = true
+			return node, err
+		})
+		for i := len(ec.hooks) - 1; i >= 0; i-- {
+			mut = ec.hooks[i](mut)
+		}
+		if _, err := mut.Mutate(ctx, ec.mutation); err != nil {
+			return nil, err
+		}
+	}
+	return node, err
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (ec *EventCreate) SaveX(ctx context.Context) *Event {
+	v, err := ec.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (ec *EventCreate) check() error {
+	if _, ok := ec.mutation.Name(); !ok {
+		return &ValidationError{Name: "name", err: errors.New("ent: missing required field \"name\"")}
+	}
+	if v, ok := ec.mutation.Name(); ok {
+		if err := event.NameValidator(v); err != nil {
+			return &ValidationError{Name: "name", err: fmt.Errorf("ent: validator failed for field \"name\": %w", err)}
+		}
+	}
+	if _, ok := ec.mutation.Time(); !ok {
+		return &ValidationError{Name: "time", err: errors.New("ent: missing required field \"time\"")}
+	}
+	if _, ok := ec.mutation.Source(); !ok {
+		return &ValidationError{Name: "source", err: errors.New("ent: missing required field \"source\"")}
+	}
+	if _, ok := ec.mutation.ApplicationID(); !ok {
+		return &ValidationError{Name: "application", err: errors.New("ent: missing required edge \"application\"")}
+	}
+	return nil
+}
+
+func (ec *EventCreate) sqlSave(ctx context.Context) (*Event, error) {
+	_node, _spec := ec.createSpec()
+	if err := sqlgraph.CreateNode(ctx, ec.driver, _spec); err != nil {
+		if cerr, ok := isSQLConstraintError(err); ok {
+			err = cerr
+		}
+		return nil, err
+	}
+	id := _spec.ID.Value.(int64)
+	_node.ID = int(id)
+	return _node, nil
+}
+
+func (ec *EventCreate) createSpec() (*Event, *sqlgraph.CreateSpec) {
+	var (
+		_node = &Event{config: ec.config}
+		_spec = &sqlgraph.CreateSpec{
+			Table: event.Table,
+			ID: &sqlgraph.FieldSpec{
+				Type:   field.TypeInt,
+				Column: event.FieldID,
+			},
+		}
+	)
+	if value, ok := ec.mutation.Name(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: event.FieldName,
+		})
+		_node.Name = value
+	}
+	if value, ok := ec.mutation.Time(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt64,
+			Value:  value,
+			Column: event.FieldTime,
+		})
+		_node.Time = value
+	}
+	if value, ok := ec.mutation.Source(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: event.FieldSource,
+		})
+		_node.Source = value
+	}
+	if value, ok := ec.mutation.WID(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: event.FieldWID,
+		})
+		_node.WID = value
+	}
+	if value, ok := ec.mutation.Attrs(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeJSON,
+			Value:  value,
+			Column: event.FieldAttrs,
+		})
+		_node.Attrs = value
+	}
+	if nodes := ec.mutation.ApplicationIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   event.ApplicationTable,
+			Columns: []string{event.ApplicationColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt,
+					Column: application.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	return _node, _spec
+}
+
+// EventCreateBulk is the builder for creating a bulk of Event entities.
+type EventCreateBulk struct {
+	config
+	builders []*EventCreate
+}
+
+// Save creates the Event entities in the database.
+func (ecb *EventCreateBulk) Save(ctx context.Context) ([]*Event, error) {
+	specs := make([]*sqlgraph.CreateSpec, len(ecb.builders))
+	nodes := make([]*Event, len(ecb.builders))
+	mutators := make([]Mutator, len(ecb.builders))
+	for i := range ecb.builders {
+		func(i int, root context.Context) {
+			builder := ecb.builders[i]
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*EventMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				nodes[i], specs[i] = builder.createSpec()
+				var err error
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, ecb.builders[i+1].mutation)
+				} else {
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, ecb.driver, &sqlgraph.BatchCreateSpec{Nodes: specs}); err != nil {
+						if cerr, ok := isSQLConstraintError(err); ok {
+							err = cerr
+						}
+					}
+				}
+				mutation.done = true
+				if err != nil {
+					return nil, err
+				}
+				id := specs[i].ID.Value.(int64)
+				nodes[i].ID = int(id)
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, ecb.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (ecb *EventCreateBulk) SaveX(ctx context.Context) []*Event {
+	v, err := ecb.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
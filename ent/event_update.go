@@ -0,0 +1,572 @@
+// Code generated by entc, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/facebook/ent/dialect/sql"
+	"github.com/facebook/ent/dialect/sql/sqlgraph"
+	"github.com/facebook/ent/schema/field"
+	"github.com/gobench-io/gobench/ent/application"
+	"github.com/gobench-io/gobench/ent/event"
+	"github.com/gobench-io/gobench/ent/predicate"
+)
+
+// EventUpdate is the builder for updating Event entities.
+type EventUpdate struct {
+	config
+	hooks      []Hook
+	mutation   *EventMutation
+	predicates []predicate.Event
+}
+
+// Where adds a new predicate for the builder.
+func (eu *EventUpdate) Where(ps ...predicate.Event) *EventUpdate {
+	eu.predicates = append(eu.predicates, ps...)
+	return eu
+}
+
+// SetName sets the name field.
+func (eu *EventUpdate) SetName(s string) *EventUpdate {
+	eu.mutation.SetName(s)
+	return eu
+}
+
+// SetTime sets the time field.
+func (eu *EventUpdate) SetTime(i int64) *EventUpdate {
+	eu.mutation.ResetTime()
+	eu.mutation.SetTime(i)
+	return eu
+}
+
+// AddTime adds i to time.
+func (eu *EventUpdate) AddTime(i int64) *EventUpdate {
+	eu.mutation.AddTime(i)
+	return eu
+}
+
+// SetSource sets the source field.
+func (eu *EventUpdate) SetSource(s string) *EventUpdate {
+	eu.mutation.SetSource(s)
+	return eu
+}
+
+// SetWID sets the wID field.
+func (eu *EventUpdate) SetWID(s string) *EventUpdate {
+	eu.mutation.SetWID(s)
+	return eu
+}
+
+// SetNillableWID sets the wID field if the given value is not nil.
+func (eu *EventUpdate) SetNillableWID(s *string) *EventUpdate {
+	if s != nil {
+		eu.SetWID(*s)
+	}
+	return eu
+}
+
+// ClearWID clears the value of wID.
+func (eu *EventUpdate) ClearWID() *EventUpdate {
+	eu.mutation.ClearWID()
+	return eu
+}
+
+// SetAttrs sets the attrs field.
+func (eu *EventUpdate) SetAttrs(m map[string]string) *EventUpdate {
+	eu.mutation.SetAttrs(m)
+	return eu
+}
+
+// ClearAttrs clears the value of attrs.
+func (eu *EventUpdate) ClearAttrs() *EventUpdate {
+	eu.mutation.ClearAttrs()
+	return eu
+}
+
+// SetApplicationID sets the application edge to Application by id.
+func (eu *EventUpdate) SetApplicationID(id int) *EventUpdate {
+	eu.mutation.SetApplicationID(id)
+	return eu
+}
+
+// SetApplication sets the application edge to Application.
+func (eu *EventUpdate) SetApplication(a *Application) *EventUpdate {
+	return eu.SetApplicationID(a.ID)
+}
+
+// Mutation returns the EventMutation object of the builder.
+func (eu *EventUpdate) Mutation() *EventMutation {
+	return eu.mutation
+}
+
+// ClearApplication clears the "application" edge to type Application.
+func (eu *EventUpdate) ClearApplication() *EventUpdate {
+	eu.mutation.ClearApplication()
+	return eu
+}
+
+// Save executes the query and returns the number of rows/vertices matched by this operation.
+func (eu *EventUpdate) Save(ctx context.Context) (int, error) {
+	var (
+		err      error
+		affected int
+	)
+	if len(eu.hooks) == 0 {
+		if err = eu.check(); err != nil {
+			return 0, err
+		}
+		affected, err = eu.sqlSave(ctx)
+	} else {
+		var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+			mutation, ok := m.(*EventMutation)
+			if !ok {
+				return nil, fmt.Errorf("unexpected mutation type %T", m)
+			}
+			if err = eu.check(); err != nil {
+				return 0, err
+			}
+			eu.mutation = mutation
+			affected, err = eu.sqlSave(ctx)
+			mutation.done = true
+			return affected, err
+		})
+		for i := len(eu.hooks) - 1; i >= 0; i-- {
+			mut = eu.hooks[i](mut)
+		}
+		if _, err := mut.Mutate(ctx, eu.mutation); err != nil {
+			return 0, err
+		}
+	}
+	return affected, err
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (eu *EventUpdate) SaveX(ctx context.Context) int {
+	affected, err := eu.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (eu *EventUpdate) Exec(ctx context.Context) error {
+	_, err := eu.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (eu *EventUpdate) ExecX(ctx context.Context) {
+	if err := eu.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (eu *EventUpdate) check() error {
+	if v, ok := eu.mutation.Name(); ok {
+		if err := event.NameValidator(v); err != nil {
+			return &ValidationError{Name: "name", err: fmt.Errorf("ent: validator failed for field \"name\": %w", err)}
+		}
+	}
+	if _, ok := eu.mutation.ApplicationID(); eu.mutation.ApplicationCleared() && !ok {
+		return errors.New("ent: clearing a required unique edge \"application\"")
+	}
+	return nil
+}
+
+func (eu *EventUpdate) sqlSave(ctx context.Context) (n int, err error) {
+	_spec := &sqlgraph.UpdateSpec{
+		Node: &sqlgraph.NodeSpec{
+			Table:   event.Table,
+			Columns: event.Columns,
+			ID: &sqlgraph.FieldSpec{
+				Type:   field.TypeInt,
+				Column: event.FieldID,
+			},
+		},
+	}
+	if ps := eu.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := eu.mutation.Name(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: event.FieldName,
+		})
+	}
+	if value, ok := eu.mutation.Time(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt64,
+			Value:  value,
+			Column: event.FieldTime,
+		})
+	}
+	if value, ok := eu.mutation.AddedTime(); ok {
+		_spec.Fields.Add = append(_spec.Fields.Add, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt64,
+			Value:  value,
+			Column: event.FieldTime,
+		})
+	}
+	if value, ok := eu.mutation.Source(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: event.FieldSource,
+		})
+	}
+	if value, ok := eu.mutation.WID(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: event.FieldWID,
+		})
+	}
+	if eu.mutation.WIDCleared() {
+		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Column: event.FieldWID,
+		})
+	}
+	if value, ok := eu.mutation.Attrs(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeJSON,
+			Value:  value,
+			Column: event.FieldAttrs,
+		})
+	}
+	if eu.mutation.AttrsCleared() {
+		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
+			Type:   field.TypeJSON,
+			Column: event.FieldAttrs,
+		})
+	}
+	if eu.mutation.ApplicationCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   event.ApplicationTable,
+			Columns: []string{event.ApplicationColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt,
+					Column: application.FieldID,
+				},
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := eu.mutation.ApplicationIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   event.ApplicationTable,
+			Columns: []string{event.ApplicationColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt,
+					Column: application.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if n, err = sqlgraph.UpdateNodes(ctx, eu.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{event.Label}
+		} else if cerr, ok := isSQLConstraintError(err); ok {
+			err = cerr
+		}
+		return 0, err
+	}
+	return n, nil
+}
+
+// EventUpdateOne is the builder for updating a single Event entity.
+type EventUpdateOne struct {
+	config
+	hooks    []Hook
+	mutation *EventMutation
+}
+
+// SetName sets the name field.
+func (euo *EventUpdateOne) SetName(s string) *EventUpdateOne {
+	euo.mutation.SetName(s)
+	return euo
+}
+
+// SetTime sets the time field.
+func (euo *EventUpdateOne) SetTime(i int64) *EventUpdateOne {
+	euo.mutation.ResetTime()
+	euo.mutation.SetTime(i)
+	return euo
+}
+
+// AddTime adds i to time.
+func (euo *EventUpdateOne) AddTime(i int64) *EventUpdateOne {
+	euo.mutation.AddTime(i)
+	return euo
+}
+
+// SetSource sets the source field.
+func (euo *EventUpdateOne) SetSource(s string) *EventUpdateOne {
+	euo.mutation.SetSource(s)
+	return euo
+}
+
+// SetWID sets the wID field.
+func (euo *EventUpdateOne) SetWID(s string) *EventUpdateOne {
+	euo.mutation.SetWID(s)
+	return euo
+}
+
+// SetNillableWID sets the wID field if the given value is not nil.
+func (euo *EventUpdateOne) SetNillableWID(s *string) *EventUpdateOne {
+	if s != nil {
+		euo.SetWID(*s)
+	}
+	return euo
+}
+
+// ClearWID clears the value of wID.
+func (euo *EventUpdateOne) ClearWID() *EventUpdateOne {
+	euo.mutation.ClearWID()
+	return euo
+}
+
+// SetAttrs sets the attrs field.
+func (euo *EventUpdateOne) SetAttrs(m map[string]string) *EventUpdateOne {
+	euo.mutation.SetAttrs(m)
+	return euo
+}
+
+// ClearAttrs clears the value of attrs.
+func (euo *EventUpdateOne) ClearAttrs() *EventUpdateOne {
+	euo.mutation.ClearAttrs()
+	return euo
+}
+
+// SetApplicationID sets the application edge to Application by id.
+func (euo *EventUpdateOne) SetApplicationID(id int) *EventUpdateOne {
+	euo.mutation.SetApplicationID(id)
+	return euo
+}
+
+// SetApplication sets the application edge to Application.
+func (euo *EventUpdateOne) SetApplication(a *Application) *EventUpdateOne {
+	return euo.SetApplicationID(a.ID)
+}
+
+// Mutation returns the EventMutation object of the builder.
+func (euo *EventUpdateOne) Mutation() *EventMutation {
+	return euo.mutation
+}
+
+// ClearApplication clears the "application" edge to type Application.
+func (euo *EventUpdateOne) ClearApplication() *EventUpdateOne {
+	euo.mutation.ClearApplication()
+	return euo
+}
+
+// Save executes the query and returns the updated entity.
+func (euo *EventUpdateOne) Save(ctx context.Context) (*Event, error) {
+	var (
+		err  error
+		node *Event
+	)
+	if len(euo.hooks) == 0 {
+		if err = euo.check(); err != nil {
+			return nil, err
+		}
+		node, err = euo.sqlSave(ctx)
+	} else {
+		var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+			mutation, ok := m.(*EventMutation)
+			if !ok {
+				return nil, fmt.Errorf("unexpected mutation type %T", m)
+			}
+			if err = euo.check(); err != nil {
+				return nil, err
+			}
+			euo.mutation = mutation
+			node, err = euo.sqlSave(ctx)
+			mutation.done = true
+			return node, err
+		})
+		for i := len(euo.hooks) - 1; i >= 0; i-- {
+			mut = euo.hooks[i](mut)
+		}
+		if _, err := mut.Mutate(ctx, euo.mutation); err != nil {
+			return nil, err
+		}
+	}
+	return node, err
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (euo *EventUpdateOne) SaveX(ctx context.Context) *Event {
+	node, err := euo.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (euo *EventUpdateOne) Exec(ctx context.Context) error {
+	_, err := euo.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (euo *EventUpdateOne) ExecX(ctx context.Context) {
+	if err := euo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (euo *EventUpdateOne) check() error {
+	if v, ok := euo.mutation.Name(); ok {
+		if err := event.NameValidator(v); err != nil {
+			return &ValidationError{Name: "name", err: fmt.Errorf("ent: validator failed for field \"name\": %w", err)}
+		}
+	}
+	if _, ok := euo.mutation.ApplicationID(); euo.mutation.ApplicationCleared() && !ok {
+		return errors.New("ent: clearing a required unique edge \"application\"")
+	}
+	return nil
+}
+
+func (euo *EventUpdateOne) sqlSave(ctx context.Context) (_node *Event, err error) {
+	_spec := &sqlgraph.UpdateSpec{
+		Node: &sqlgraph.NodeSpec{
+			Table:   event.Table,
+			Columns: event.Columns,
+			ID: &sqlgraph.FieldSpec{
+				Type:   field.TypeInt,
+				Column: event.FieldID,
+			},
+		},
+	}
+	id, ok := euo.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "ID", err: fmt.Errorf("missing Event.ID for update")}
+	}
+	_spec.Node.ID.Value = id
+	if value, ok := euo.mutation.Name(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: event.FieldName,
+		})
+	}
+	if value, ok := euo.mutation.Time(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt64,
+			Value:  value,
+			Column: event.FieldTime,
+		})
+	}
+	if value, ok := euo.mutation.AddedTime(); ok {
+		_spec.Fields.Add = append(_spec.Fields.Add, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt64,
+			Value:  value,
+			Column: event.FieldTime,
+		})
+	}
+	if value, ok := euo.mutation.Source(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: event.FieldSource,
+		})
+	}
+	if value, ok := euo.mutation.WID(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: event.FieldWID,
+		})
+	}
+	if euo.mutation.WIDCleared() {
+		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Column: event.FieldWID,
+		})
+	}
+	if value, ok := euo.mutation.Attrs(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeJSON,
+			Value:  value,
+			Column: event.FieldAttrs,
+		})
+	}
+	if euo.mutation.AttrsCleared() {
+		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
+			Type:   field.TypeJSON,
+			Column: event.FieldAttrs,
+		})
+	}
+	if euo.mutation.ApplicationCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   event.ApplicationTable,
+			Columns: []string{event.ApplicationColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt,
+					Column: application.FieldID,
+				},
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := euo.mutation.ApplicationIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   event.ApplicationTable,
+			Columns: []string{event.ApplicationColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt,
+					Column: application.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_node = &Event{config: euo.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues()
+	if err = sqlgraph.UpdateNode(ctx, euo.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{event.Label}
+		} else if cerr, ok := isSQLConstraintError(err); ok {
+			err = cerr
+		}
+		return nil, err
+	}
+	return _node, nil
+}
@@ -0,0 +1,183 @@
+// Code generated by entc, DO NOT EDIT.
+
+package ent
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/facebook/ent/dialect/sql"
+	"github.com/gobench-io/gobench/ent/application"
+	"github.com/gobench-io/gobench/ent/executor"
+)
+
+// Executor is the model entity for the Executor schema.
+type Executor struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID int `json:"id,omitempty"`
+	// EID holds the value of the "eID" field.
+	EID string `json:"eId"`
+	// Edges holds the relations/edges for other nodes in the graph.
+	// The values are being populated by the ExecutorQuery when eager-loading is set.
+	Edges                 ExecutorEdges `json:"edges"`
+	application_executors *int
+}
+
+// ExecutorEdges holds the relations/edges for other nodes in the graph.
+type ExecutorEdges struct {
+	// Application holds the value of the application edge.
+	Application *Application
+	// Counters holds the value of the counters edge.
+	Counters []*Counter
+	// Histograms holds the value of the histograms edge.
+	Histograms []*Histogram
+	// Gauges holds the value of the gauges edge.
+	Gauges []*Gauge
+	// loadedTypes holds the information for reporting if a
+	// type was loaded (or requested) in eager-loading or not.
+	loadedTypes [4]bool
+}
+
+// ApplicationOrErr returns the Application value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e ExecutorEdges) ApplicationOrErr() (*Application, error) {
+	if e.loadedTypes[0] {
+		if e.Application == nil {
+			// The edge application was loaded in eager-loading,
+			// but was not found.
+			return nil, &NotFoundError{label: application.Label}
+		}
+		return e.Application, nil
+	}
+	return nil, &NotLoadedError{edge: "application"}
+}
+
+// CountersOrErr returns the Counters value or an error if the edge
+// was not loaded in eager-loading.
+func (e ExecutorEdges) CountersOrErr() ([]*Counter, error) {
+	if e.loadedTypes[1] {
+		return e.Counters, nil
+	}
+	return nil, &NotLoadedError{edge: "counters"}
+}
+
+// HistogramsOrErr returns the Histograms value or an error if the edge
+// was not loaded in eager-loading.
+func (e ExecutorEdges) HistogramsOrErr() ([]*Histogram, error) {
+	if e.loadedTypes[2] {
+		return e.Histograms, nil
+	}
+	return nil, &NotLoadedError{edge: "histograms"}
+}
+
+// GaugesOrErr returns the Gauges value or an error if the edge
+// was not loaded in eager-loading.
+func (e ExecutorEdges) GaugesOrErr() ([]*Gauge, error) {
+	if e.loadedTypes[3] {
+		return e.Gauges, nil
+	}
+	return nil, &NotLoadedError{edge: "gauges"}
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*Executor) scanValues() []interface{} {
+	return []interface{}{
+		&sql.NullInt64{},  // id
+		&sql.NullString{}, // eID
+	}
+}
+
+// fkValues returns the types for scanning foreign-keys values from sql.Rows.
+func (*Executor) fkValues() []interface{} {
+	return []interface{}{
+		&sql.NullInt64{}, // application_executors
+	}
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the Executor fields.
+func (e *Executor) assignValues(values ...interface{}) error {
+	if m, n := len(values), len(executor.Columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	value, ok := values[0].(*sql.NullInt64)
+	if !ok {
+		return fmt.Errorf("unexpected type %T for field id", value)
+	}
+	e.ID = int(value.Int64)
+	values = values[1:]
+	if value, ok := values[0].(*sql.NullString); !ok {
+		return fmt.Errorf("unexpected type %T for field eID", values[0])
+	} else if value.Valid {
+		e.EID = value.String
+	}
+	values = values[1:]
+	if len(values) == len(executor.ForeignKeys) {
+		if value, ok := values[0].(*sql.NullInt64); !ok {
+			return fmt.Errorf("unexpected type %T for edge-field application_executors", value)
+		} else if value.Valid {
+			e.application_executors = new(int)
+			*e.application_executors = int(value.Int64)
+		}
+	}
+	return nil
+}
+
+// QueryApplication queries the application edge of the Executor.
+func (e *Executor) QueryApplication() *ApplicationQuery {
+	return (&ExecutorClient{config: e.config}).QueryApplication(e)
+}
+
+// QueryCounters queries the counters edge of the Executor.
+func (e *Executor) QueryCounters() *CounterQuery {
+	return (&ExecutorClient{config: e.config}).QueryCounters(e)
+}
+
+// QueryHistograms queries the histograms edge of the Executor.
+func (e *Executor) QueryHistograms() *HistogramQuery {
+	return (&ExecutorClient{config: e.config}).QueryHistograms(e)
+}
+
+// QueryGauges queries the gauges edge of the Executor.
+func (e *Executor) QueryGauges() *GaugeQuery {
+	return (&ExecutorClient{config: e.config}).QueryGauges(e)
+}
+
+// Update returns a builder for updating this Executor.
+// Note that, you need to call Executor.Unwrap() before calling this method, if this Executor
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (e *Executor) Update() *ExecutorUpdateOne {
+	return (&ExecutorClient{config: e.config}).UpdateOne(e)
+}
+
+// Unwrap unwraps the entity that was returned from a transaction after it was closed,
+// so that all next queries will be executed through the driver which created the transaction.
+func (e *Executor) Unwrap() *Executor {
+	tx, ok := e.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: Executor is not a transactional entity")
+	}
+	e.config.driver = tx.drv
+	return e
+}
+
+// String implements the fmt.Stringer.
+func (e *Executor) String() string {
+	var builder strings.Builder
+	builder.WriteString("Executor(")
+	builder.WriteString(fmt.Sprintf("id=%v", e.ID))
+	builder.WriteString(", eID=")
+	builder.WriteString(e.EID)
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// Executors is a parsable slice of Executor.
+type Executors []*Executor
+
+func (e Executors) config(cfg config) {
+	for _i := range e {
+		e[_i].config = cfg
+	}
+}
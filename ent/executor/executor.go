@@ -0,0 +1,83 @@
+// Code generated by entc, DO NOT EDIT.
+
+package executor
+
+const (
+	// Label holds the string label denoting the executor type in the database.
+	Label = "executor"
+	// FieldID holds the string denoting the id field in the database.
+	FieldID = "id"
+	// FieldEID holds the string denoting the eid field in the database.
+	FieldEID = "e_id"
+
+	// EdgeApplication holds the string denoting the application edge name in mutations.
+	EdgeApplication = "application"
+	// EdgeCounters holds the string denoting the counters edge name in mutations.
+	EdgeCounters = "counters"
+	// EdgeHistograms holds the string denoting the histograms edge name in mutations.
+	EdgeHistograms = "histograms"
+	// EdgeGauges holds the string denoting the gauges edge name in mutations.
+	EdgeGauges = "gauges"
+
+	// Table holds the table name of the executor in the database.
+	Table = "executors"
+	// ApplicationTable is the table the holds the application relation/edge.
+	ApplicationTable = "executors"
+	// ApplicationInverseTable is the table name for the Application entity.
+	// It exists in this package in order to avoid circular dependency with the "application" package.
+	ApplicationInverseTable = "applications"
+	// ApplicationColumn is the table column denoting the application relation/edge.
+	ApplicationColumn = "application_executors"
+	// CountersTable is the table the holds the counters relation/edge.
+	CountersTable = "counters"
+	// CountersInverseTable is the table name for the Counter entity.
+	// It exists in this package in order to avoid circular dependency with the "counter" package.
+	CountersInverseTable = "counters"
+	// CountersColumn is the table column denoting the counters relation/edge.
+	CountersColumn = "executor_counters"
+	// HistogramsTable is the table the holds the histograms relation/edge.
+	HistogramsTable = "histograms"
+	// HistogramsInverseTable is the table name for the Histogram entity.
+	// It exists in this package in order to avoid circular dependency with the "histogram" package.
+	HistogramsInverseTable = "histograms"
+	// HistogramsColumn is the table column denoting the histograms relation/edge.
+	HistogramsColumn = "executor_histograms"
+	// GaugesTable is the table the holds the gauges relation/edge.
+	GaugesTable = "gauges"
+	// GaugesInverseTable is the table name for the Gauge entity.
+	// It exists in this package in order to avoid circular dependency with the "gauge" package.
+	GaugesInverseTable = "gauges"
+	// GaugesColumn is the table column denoting the gauges relation/edge.
+	GaugesColumn = "executor_gauges"
+)
+
+// Columns holds all SQL columns for executor fields.
+var Columns = []string{
+	FieldID,
+	FieldEID,
+}
+
+// ForeignKeys holds the SQL foreign-keys that are owned by the Executor type.
+var ForeignKeys = []string{
+	"application_executors",
+}
+
+// ValidColumn reports if the column name is valid (part of the table columns).
+func ValidColumn(column string) bool {
+	for i := range Columns {
+		if column == Columns[i] {
+			return true
+		}
+	}
+	for i := range ForeignKeys {
+		if column == ForeignKeys[i] {
+			return true
+		}
+	}
+	return false
+}
+
+var (
+	// EIDValidator is a validator for the "eID" field. It is called by the builders before save.
+	EIDValidator func(string) error
+)
@@ -0,0 +1,354 @@
+// Code generated by entc, DO NOT EDIT.
+
+package executor
+
+import (
+	"github.com/facebook/ent/dialect/sql"
+	"github.com/facebook/ent/dialect/sql/sqlgraph"
+	"github.com/gobench-io/gobench/ent/predicate"
+)
+
+// ID filters vertices based on their identifier.
+func ID(id int) predicate.Executor {
+	return predicate.Executor(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldID), id))
+	})
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id int) predicate.Executor {
+	return predicate.Executor(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldID), id))
+	})
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id int) predicate.Executor {
+	return predicate.Executor(func(s *sql.Selector) {
+		s.Where(sql.NEQ(s.C(FieldID), id))
+	})
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...int) predicate.Executor {
+	return predicate.Executor(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(ids) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		v := make([]interface{}, len(ids))
+		for i := range v {
+			v[i] = ids[i]
+		}
+		s.Where(sql.In(s.C(FieldID), v...))
+	})
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...int) predicate.Executor {
+	return predicate.Executor(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(ids) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		v := make([]interface{}, len(ids))
+		for i := range v {
+			v[i] = ids[i]
+		}
+		s.Where(sql.NotIn(s.C(FieldID), v...))
+	})
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id int) predicate.Executor {
+	return predicate.Executor(func(s *sql.Selector) {
+		s.Where(sql.GT(s.C(FieldID), id))
+	})
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id int) predicate.Executor {
+	return predicate.Executor(func(s *sql.Selector) {
+		s.Where(sql.GTE(s.C(FieldID), id))
+	})
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id int) predicate.Executor {
+	return predicate.Executor(func(s *sql.Selector) {
+		s.Where(sql.LT(s.C(FieldID), id))
+	})
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id int) predicate.Executor {
+	return predicate.Executor(func(s *sql.Selector) {
+		s.Where(sql.LTE(s.C(FieldID), id))
+	})
+}
+
+// EID applies equality check predicate on the "eID" field. It's identical to EIDEQ.
+func EID(v string) predicate.Executor {
+	return predicate.Executor(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldEID), v))
+	})
+}
+
+// EIDEQ applies the EQ predicate on the "eID" field.
+func EIDEQ(v string) predicate.Executor {
+	return predicate.Executor(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldEID), v))
+	})
+}
+
+// EIDNEQ applies the NEQ predicate on the "eID" field.
+func EIDNEQ(v string) predicate.Executor {
+	return predicate.Executor(func(s *sql.Selector) {
+		s.Where(sql.NEQ(s.C(FieldEID), v))
+	})
+}
+
+// EIDIn applies the In predicate on the "eID" field.
+func EIDIn(vs ...string) predicate.Executor {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.Executor(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.In(s.C(FieldEID), v...))
+	})
+}
+
+// EIDNotIn applies the NotIn predicate on the "eID" field.
+func EIDNotIn(vs ...string) predicate.Executor {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.Executor(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.NotIn(s.C(FieldEID), v...))
+	})
+}
+
+// EIDGT applies the GT predicate on the "eID" field.
+func EIDGT(v string) predicate.Executor {
+	return predicate.Executor(func(s *sql.Selector) {
+		s.Where(sql.GT(s.C(FieldEID), v))
+	})
+}
+
+// EIDGTE applies the GTE predicate on the "eID" field.
+func EIDGTE(v string) predicate.Executor {
+	return predicate.Executor(func(s *sql.Selector) {
+		s.Where(sql.GTE(s.C(FieldEID), v))
+	})
+}
+
+// EIDLT applies the LT predicate on the "eID" field.
+func EIDLT(v string) predicate.Executor {
+	return predicate.Executor(func(s *sql.Selector) {
+		s.Where(sql.LT(s.C(FieldEID), v))
+	})
+}
+
+// EIDLTE applies the LTE predicate on the "eID" field.
+func EIDLTE(v string) predicate.Executor {
+	return predicate.Executor(func(s *sql.Selector) {
+		s.Where(sql.LTE(s.C(FieldEID), v))
+	})
+}
+
+// EIDContains applies the Contains predicate on the "eID" field.
+func EIDContains(v string) predicate.Executor {
+	return predicate.Executor(func(s *sql.Selector) {
+		s.Where(sql.Contains(s.C(FieldEID), v))
+	})
+}
+
+// EIDHasPrefix applies the HasPrefix predicate on the "eID" field.
+func EIDHasPrefix(v string) predicate.Executor {
+	return predicate.Executor(func(s *sql.Selector) {
+		s.Where(sql.HasPrefix(s.C(FieldEID), v))
+	})
+}
+
+// EIDHasSuffix applies the HasSuffix predicate on the "eID" field.
+func EIDHasSuffix(v string) predicate.Executor {
+	return predicate.Executor(func(s *sql.Selector) {
+		s.Where(sql.HasSuffix(s.C(FieldEID), v))
+	})
+}
+
+// EIDEqualFold applies the EqualFold predicate on the "eID" field.
+func EIDEqualFold(v string) predicate.Executor {
+	return predicate.Executor(func(s *sql.Selector) {
+		s.Where(sql.EqualFold(s.C(FieldEID), v))
+	})
+}
+
+// EIDContainsFold applies the ContainsFold predicate on the "eID" field.
+func EIDContainsFold(v string) predicate.Executor {
+	return predicate.Executor(func(s *sql.Selector) {
+		s.Where(sql.ContainsFold(s.C(FieldEID), v))
+	})
+}
+
+// HasApplication applies the HasEdge predicate on the "application" edge.
+func HasApplication() predicate.Executor {
+	return predicate.Executor(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.To(ApplicationTable, FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, ApplicationTable, ApplicationColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasApplicationWith applies the HasEdge predicate on the "application" edge with a given conditions (other predicates).
+func HasApplicationWith(preds ...predicate.Application) predicate.Executor {
+	return predicate.Executor(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.To(ApplicationInverseTable, FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, ApplicationTable, ApplicationColumn),
+		)
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasCounters applies the HasEdge predicate on the "counters" edge.
+func HasCounters() predicate.Executor {
+	return predicate.Executor(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.To(CountersTable, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, CountersTable, CountersColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasCountersWith applies the HasEdge predicate on the "counters" edge with a given conditions (other predicates).
+func HasCountersWith(preds ...predicate.Counter) predicate.Executor {
+	return predicate.Executor(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.To(CountersInverseTable, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, CountersTable, CountersColumn),
+		)
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasHistograms applies the HasEdge predicate on the "histograms" edge.
+func HasHistograms() predicate.Executor {
+	return predicate.Executor(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.To(HistogramsTable, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, HistogramsTable, HistogramsColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasHistogramsWith applies the HasEdge predicate on the "histograms" edge with a given conditions (other predicates).
+func HasHistogramsWith(preds ...predicate.Histogram) predicate.Executor {
+	return predicate.Executor(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.To(HistogramsInverseTable, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, HistogramsTable, HistogramsColumn),
+		)
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasGauges applies the HasEdge predicate on the "gauges" edge.
+func HasGauges() predicate.Executor {
+	return predicate.Executor(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.To(GaugesTable, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, GaugesTable, GaugesColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasGaugesWith applies the HasEdge predicate on the "gauges" edge with a given conditions (other predicates).
+func HasGaugesWith(preds ...predicate.Gauge) predicate.Executor {
+	return predicate.Executor(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.To(GaugesInverseTable, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, GaugesTable, GaugesColumn),
+		)
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// And groups list of predicates with the AND operator between them.
+func And(predicates ...predicate.Executor) predicate.Executor {
+	return predicate.Executor(func(s *sql.Selector) {
+		s1 := s.Clone().SetP(nil)
+		for _, p := range predicates {
+			p(s1)
+		}
+		s.Where(s1.P())
+	})
+}
+
+// Or groups list of predicates with the OR operator between them.
+func Or(predicates ...predicate.Executor) predicate.Executor {
+	return predicate.Executor(func(s *sql.Selector) {
+		s1 := s.Clone().SetP(nil)
+		for i, p := range predicates {
+			if i > 0 {
+				s1.Or()
+			}
+			p(s1)
+		}
+		s.Where(s1.P())
+	})
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.Executor) predicate.Executor {
+	return predicate.Executor(func(s *sql.Selector) {
+		p(s.Not())
+	})
+}
@@ -0,0 +1,328 @@
+// Code generated by entc, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/facebook/ent/dialect/sql/sqlgraph"
+	"github.com/facebook/ent/schema/field"
+	"github.com/gobench-io/gobench/ent/application"
+	"github.com/gobench-io/gobench/ent/counter"
+	"github.com/gobench-io/gobench/ent/executor"
+	"github.com/gobench-io/gobench/ent/gauge"
+	"github.com/gobench-io/gobench/ent/histogram"
+)
+
+// ExecutorCreate is the builder for creating a Executor entity.
+type ExecutorCreate struct {
+	config
+	mutation *ExecutorMutation
+	hooks    []Hook
+}
+
+// SetEID sets the eID field.
+func (ec *ExecutorCreate) SetEID(s string) *ExecutorCreate {
+	ec.mutation.SetEID(s)
+	return ec
+}
+
+// SetApplicationID sets the application edge to Application by id.
+func (ec *ExecutorCreate) SetApplicationID(id int) *ExecutorCreate {
+	ec.mutation.SetApplicationID(id)
+	return ec
+}
+
+// SetApplication sets the application edge to Application.
+func (ec *ExecutorCreate) SetApplication(a *Application) *ExecutorCreate {
+	return ec.SetApplicationID(a.ID)
+}
+
+// AddCounterIDs adds the counters edge to Counter by ids.
+func (ec *ExecutorCreate) AddCounterIDs(ids ...int) *ExecutorCreate {
+	ec.mutation.AddCounterIDs(ids...)
+	return ec
+}
+
+// AddCounters adds the counters edges to Counter.
+func (ec *ExecutorCreate) AddCounters(c ...*Counter) *ExecutorCreate {
+	ids := make([]int, len(c))
+	for i := range c {
+		ids[i] = c[i].ID
+	}
+	return ec.AddCounterIDs(ids...)
+}
+
+// AddHistogramIDs adds the histograms edge to Histogram by ids.
+func (ec *ExecutorCreate) AddHistogramIDs(ids ...int) *ExecutorCreate {
+	ec.mutation.AddHistogramIDs(ids...)
+	return ec
+}
+
+// AddHistograms adds the histograms edges to Histogram.
+func (ec *ExecutorCreate) AddHistograms(h ...*Histogram) *ExecutorCreate {
+	ids := make([]int, len(h))
+	for i := range h {
+		ids[i] = h[i].ID
+	}
+	return ec.AddHistogramIDs(ids...)
+}
+
+// AddGaugeIDs adds the gauges edge to Gauge by ids.
+func (ec *ExecutorCreate) AddGaugeIDs(ids ...int) *ExecutorCreate {
+	ec.mutation.AddGaugeIDs(ids...)
+	return ec
+}
+
+// AddGauges adds the gauges edges to Gauge.
+func (ec *ExecutorCreate) AddGauges(g ...*Gauge) *ExecutorCreate {
+	ids := make([]int, len(g))
+	for i := range g {
+		ids[i] = g[i].ID
+	}
+	return ec.AddGaugeIDs(ids...)
+}
+
+// Mutation returns the ExecutorMutation object of the builder.
+func (ec *ExecutorCreate) Mutation() *ExecutorMutation {
+	return ec.mutation
+}
+
+// Save creates the Executor in the database.
+func (ec *ExecutorCreate) Save(ctx context.Context) (*Executor, error) {
+	var (
+		err  error
+		node *Executor
+	)
+	if len(ec.hooks) == 0 {
+		if err = ec.check(); err != nil {
+			return nil, err
+		}
+		node, err = ec.sqlSave(ctx)
+	} else {
+		var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+			mutation, ok := m.(*ExecutorMutation)
+			if !ok {
+				return nil, fmt.Errorf("unexpected mutation type %T", m)
+			}
+			if err = ec.check(); err != nil {
+				return nil, err
+			}
+			ec.mutation = mutation
+			node, err = ec.sqlSave(ctx)
+			mutation.done = true
+			return node, err
+		})
+		for i := len(ec.hooks) - 1; i >= 0; i-- {
+			mut = ec.hooks[i](mut)
+		}
+		if _, err := mut.Mutate(ctx, ec.mutation); err != nil {
+			return nil, err
+		}
+	}
+	return node, err
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (ec *ExecutorCreate) SaveX(ctx context.Context) *Executor {
+	v, err := ec.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (ec *ExecutorCreate) check() error {
+	if _, ok := ec.mutation.EID(); !ok {
+		return &ValidationError{Name: "eID", err: errors.New("ent: missing required field \"eID\"")}
+	}
+	if v, ok := ec.mutation.EID(); ok {
+		if err := executor.EIDValidator(v); err != nil {
+			return &ValidationError{Name: "eID", err: fmt.Errorf("ent: validator failed for field \"eID\": %w", err)}
+		}
+	}
+	if _, ok := ec.mutation.ApplicationID(); !ok {
+		return &ValidationError{Name: "application", err: errors.New("ent: missing required edge \"application\"")}
+	}
+	return nil
+}
+
+func (ec *ExecutorCreate) sqlSave(ctx context.Context) (*Executor, error) {
+	_node, _spec := ec.createSpec()
+	if err := sqlgraph.CreateNode(ctx, ec.driver, _spec); err != nil {
+		if cerr, ok := isSQLConstraintError(err); ok {
+			err = cerr
+		}
+		return nil, err
+	}
+	id := _spec.ID.Value.(int64)
+	_node.ID = int(id)
+	return _node, nil
+}
+
+func (ec *ExecutorCreate) createSpec() (*Executor, *sqlgraph.CreateSpec) {
+	var (
+		_node = &Executor{config: ec.config}
+		_spec = &sqlgraph.CreateSpec{
+			Table: executor.Table,
+			ID: &sqlgraph.FieldSpec{
+				Type:   field.TypeInt,
+				Column: executor.FieldID,
+			},
+		}
+	)
+	if value, ok := ec.mutation.EID(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: executor.FieldEID,
+		})
+		_node.EID = value
+	}
+	if nodes := ec.mutation.ApplicationIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   executor.ApplicationTable,
+			Columns: []string{executor.ApplicationColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt,
+					Column: application.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := ec.mutation.CountersIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   executor.CountersTable,
+			Columns: []string{executor.CountersColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt,
+					Column: counter.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := ec.mutation.HistogramsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   executor.HistogramsTable,
+			Columns: []string{executor.HistogramsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt,
+					Column: histogram.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := ec.mutation.GaugesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   executor.GaugesTable,
+			Columns: []string{executor.GaugesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt,
+					Column: gauge.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	return _node, _spec
+}
+
+// ExecutorCreateBulk is the builder for creating a bulk of Executor entities.
+type ExecutorCreateBulk struct {
+	config
+	builders []*ExecutorCreate
+}
+
+// Save creates the Executor entities in the database.
+func (ecb *ExecutorCreateBulk) Save(ctx context.Context) ([]*Executor, error) {
+	specs := make([]*sqlgraph.CreateSpec, len(ecb.builders))
+	nodes := make([]*Executor, len(ecb.builders))
+	mutators := make([]Mutator, len(ecb.builders))
+	for i := range ecb.builders {
+		func(i int, root context.Context) {
+			builder := ecb.builders[i]
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*ExecutorMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				nodes[i], specs[i] = builder.createSpec()
+				var err error
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, ecb.builders[i+1].mutation)
+				} else {
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, ecb.driver, &sqlgraph.BatchCreateSpec{Nodes: specs}); err != nil {
+						if cerr, ok := isSQLConstraintError(err); ok {
+							err = cerr
+						}
+					}
+				}
+				mutation.done = true
+				if err != nil {
+					return nil, err
+				}
+				id := specs[i].ID.Value.(int64)
+				nodes[i].ID = int(id)
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, ecb.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (ecb *ExecutorCreateBulk) SaveX(ctx context.Context) []*Executor {
+	v, err := ecb.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
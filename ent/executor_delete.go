@@ -0,0 +1,109 @@
+// Code generated by entc, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/facebook/ent/dialect/sql"
+	"github.com/facebook/ent/dialect/sql/sqlgraph"
+	"github.com/facebook/ent/schema/field"
+	"github.com/gobench-io/gobench/ent/executor"
+	"github.com/gobench-io/gobench/ent/predicate"
+)
+
+// ExecutorDelete is the builder for deleting a Executor entity.
+type ExecutorDelete struct {
+	config
+	hooks      []Hook
+	mutation   *ExecutorMutation
+	predicates []predicate.Executor
+}
+
+// Where adds a new predicate to the delete builder.
+func (ed *ExecutorDelete) Where(ps ...predicate.Executor) *ExecutorDelete {
+	ed.predicates = append(ed.predicates, ps...)
+	return ed
+}
+
+// Exec executes the deletion query and returns how many vertices were deleted.
+func (ed *ExecutorDelete) Exec(ctx context.Context) (int, error) {
+	var (
+		err      error
+		affected int
+	)
+	if len(ed.hooks) == 0 {
+		affected, err = ed.sqlExec(ctx)
+	} else {
+		var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+			mutation, ok := m.(*ExecutorMutation)
+			if !ok {
+				return nil, fmt.Errorf("unexpected mutation type %T", m)
+			}
+			ed.mutation = mutation
+			affected, err = ed.sqlExec(ctx)
+			mutation.done = true
+			return affected, err
+		})
+		for i := len(ed.hooks) - 1; i >= 0; i-- {
+			mut = ed.hooks[i](mut)
+		}
+		if _, err := mut.Mutate(ctx, ed.mutation); err != nil {
+			return 0, err
+		}
+	}
+	return affected, err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (ed *ExecutorDelete) ExecX(ctx context.Context) int {
+	n, err := ed.Exec(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return n
+}
+
+func (ed *ExecutorDelete) sqlExec(ctx context.Context) (int, error) {
+	_spec := &sqlgraph.DeleteSpec{
+		Node: &sqlgraph.NodeSpec{
+			Table: executor.Table,
+			ID: &sqlgraph.FieldSpec{
+				Type:   field.TypeInt,
+				Column: executor.FieldID,
+			},
+		},
+	}
+	if ps := ed.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	return sqlgraph.DeleteNodes(ctx, ed.driver, _spec)
+}
+
+// ExecutorDeleteOne is the builder for deleting a single Executor entity.
+type ExecutorDeleteOne struct {
+	ed *ExecutorDelete
+}
+
+// Exec executes the deletion query.
+func (edo *ExecutorDeleteOne) Exec(ctx context.Context) error {
+	n, err := edo.ed.Exec(ctx)
+	switch {
+	case err != nil:
+		return err
+	case n == 0:
+		return &NotFoundError{executor.Label}
+	default:
+		return nil
+	}
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (edo *ExecutorDeleteOne) ExecX(ctx context.Context) {
+	edo.ed.ExecX(ctx)
+}
@@ -0,0 +1,1152 @@
+// Code generated by entc, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"database/sql/driver"
+	"errors"
+	"fmt"
+	"math"
+
+	"github.com/facebook/ent/dialect/sql"
+	"github.com/facebook/ent/dialect/sql/sqlgraph"
+	"github.com/facebook/ent/schema/field"
+	"github.com/gobench-io/gobench/ent/application"
+	"github.com/gobench-io/gobench/ent/counter"
+	"github.com/gobench-io/gobench/ent/executor"
+	"github.com/gobench-io/gobench/ent/gauge"
+	"github.com/gobench-io/gobench/ent/histogram"
+	"github.com/gobench-io/gobench/ent/predicate"
+)
+
+// ExecutorQuery is the builder for querying Executor entities.
+type ExecutorQuery struct {
+	config
+	limit      *int
+	offset     *int
+	order      []OrderFunc
+	unique     []string
+	predicates []predicate.Executor
+	// eager-loading edges.
+	withApplication *ApplicationQuery
+	withCounters    *CounterQuery
+	withHistograms  *HistogramQuery
+	withGauges      *GaugeQuery
+	withFKs         bool
+	// intermediate query (i.e. traversal path).
+	sql  *sql.Selector
+	path func(context.Context) (*sql.Selector, error)
+}
+
+// Where adds a new predicate for the builder.
+func (eq *ExecutorQuery) Where(ps ...predicate.Executor) *ExecutorQuery {
+	eq.predicates = append(eq.predicates, ps...)
+	return eq
+}
+
+// Limit adds a limit step to the query.
+func (eq *ExecutorQuery) Limit(limit int) *ExecutorQuery {
+	eq.limit = &limit
+	return eq
+}
+
+// Offset adds an offset step to the query.
+func (eq *ExecutorQuery) Offset(offset int) *ExecutorQuery {
+	eq.offset = &offset
+	return eq
+}
+
+// Order adds an order step to the query.
+func (eq *ExecutorQuery) Order(o ...OrderFunc) *ExecutorQuery {
+	eq.order = append(eq.order, o...)
+	return eq
+}
+
+// QueryApplication chains the current query on the application edge.
+func (eq *ExecutorQuery) QueryApplication() *ApplicationQuery {
+	query := &ApplicationQuery{config: eq.config}
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := eq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := eq.sqlQuery()
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(executor.Table, executor.FieldID, selector),
+			sqlgraph.To(application.Table, application.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, executor.ApplicationTable, executor.ApplicationColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(eq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// QueryCounters chains the current query on the counters edge.
+func (eq *ExecutorQuery) QueryCounters() *CounterQuery {
+	query := &CounterQuery{config: eq.config}
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := eq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := eq.sqlQuery()
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(executor.Table, executor.FieldID, selector),
+			sqlgraph.To(counter.Table, counter.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, executor.CountersTable, executor.CountersColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(eq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// QueryHistograms chains the current query on the histograms edge.
+func (eq *ExecutorQuery) QueryHistograms() *HistogramQuery {
+	query := &HistogramQuery{config: eq.config}
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := eq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := eq.sqlQuery()
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(executor.Table, executor.FieldID, selector),
+			sqlgraph.To(histogram.Table, histogram.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, executor.HistogramsTable, executor.HistogramsColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(eq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// QueryGauges chains the current query on the gauges edge.
+func (eq *ExecutorQuery) QueryGauges() *GaugeQuery {
+	query := &GaugeQuery{config: eq.config}
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := eq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := eq.sqlQuery()
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(executor.Table, executor.FieldID, selector),
+			sqlgraph.To(gauge.Table, gauge.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, executor.GaugesTable, executor.GaugesColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(eq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// First returns the first Executor entity in the query. Returns *NotFoundError when no executor was found.
+func (eq *ExecutorQuery) First(ctx context.Context) (*Executor, error) {
+	nodes, err := eq.Limit(1).All(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nil, &NotFoundError{executor.Label}
+	}
+	return nodes[0], nil
+}
+
+// FirstX is like First, but panics if an error occurs.
+func (eq *ExecutorQuery) FirstX(ctx context.Context) *Executor {
+	node, err := eq.First(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return node
+}
+
+// FirstID returns the first Executor id in the query. Returns *NotFoundError when no id was found.
+func (eq *ExecutorQuery) FirstID(ctx context.Context) (id int, err error) {
+	var ids []int
+	if ids, err = eq.Limit(1).IDs(ctx); err != nil {
+		return
+	}
+	if len(ids) == 0 {
+		err = &NotFoundError{executor.Label}
+		return
+	}
+	return ids[0], nil
+}
+
+// FirstXID is like FirstID, but panics if an error occurs.
+func (eq *ExecutorQuery) FirstXID(ctx context.Context) int {
+	id, err := eq.FirstID(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return id
+}
+
+// Only returns the only Executor entity in the query, returns an error if not exactly one entity was returned.
+func (eq *ExecutorQuery) Only(ctx context.Context) (*Executor, error) {
+	nodes, err := eq.Limit(2).All(ctx)
+	if err != nil {
+		return nil, err
+	}
+	switch len(nodes) {
+	case 1:
+		return nodes[0], nil
+	case 0:
+		return nil, &NotFoundError{executor.Label}
+	default:
+		return nil, &NotSingularError{executor.Label}
+	}
+}
+
+// OnlyX is like Only, but panics if an error occurs.
+func (eq *ExecutorQuery) OnlyX(ctx context.Context) *Executor {
+	node, err := eq.Only(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// OnlyID returns the only Executor id in the query, returns an error if not exactly one id was returned.
+func (eq *ExecutorQuery) OnlyID(ctx context.Context) (id int, err error) {
+	var ids []int
+	if ids, err = eq.Limit(2).IDs(ctx); err != nil {
+		return
+	}
+	switch len(ids) {
+	case 1:
+		id = ids[0]
+	case 0:
+		err = &NotFoundError{executor.Label}
+	default:
+		err = &NotSingularError{executor.Label}
+	}
+	return
+}
+
+// OnlyIDX is like OnlyID, but panics if an error occurs.
+func (eq *ExecutorQuery) OnlyIDX(ctx context.Context) int {
+	id, err := eq.OnlyID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// All executes the query and returns a list of Executors.
+func (eq *ExecutorQuery) All(ctx context.Context) ([]*Executor, error) {
+	if err := eq.prepareQuery(ctx); err != nil {
+		return nil, err
+	}
+	return eq.sqlAll(ctx)
+}
+
+// AllX is like All, but panics if an error occurs.
+func (eq *ExecutorQuery) AllX(ctx context.Context) []*Executor {
+	nodes, err := eq.All(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return nodes
+}
+
+// IDs executes the query and returns a list of Executor ids.
+func (eq *ExecutorQuery) IDs(ctx context.Context) ([]int, error) {
+	var ids []int
+	if err := eq.Select(executor.FieldID).Scan(ctx, &ids); err != nil {
+		return nil, err
+	}
+	return ids, nil
+}
+
+// IDsX is like IDs, but panics if an error occurs.
+func (eq *ExecutorQuery) IDsX(ctx context.Context) []int {
+	ids, err := eq.IDs(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return ids
+}
+
+// Count returns the count of the given query.
+func (eq *ExecutorQuery) Count(ctx context.Context) (int, error) {
+	if err := eq.prepareQuery(ctx); err != nil {
+		return 0, err
+	}
+	return eq.sqlCount(ctx)
+}
+
+// CountX is like Count, but panics if an error occurs.
+func (eq *ExecutorQuery) CountX(ctx context.Context) int {
+	count, err := eq.Count(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return count
+}
+
+// Exist returns true if the query has elements in the graph.
+func (eq *ExecutorQuery) Exist(ctx context.Context) (bool, error) {
+	if err := eq.prepareQuery(ctx); err != nil {
+		return false, err
+	}
+	return eq.sqlExist(ctx)
+}
+
+// ExistX is like Exist, but panics if an error occurs.
+func (eq *ExecutorQuery) ExistX(ctx context.Context) bool {
+	exist, err := eq.Exist(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return exist
+}
+
+// Clone returns a duplicate of the query builder, including all associated steps. It can be
+// used to prepare common query builders and use them differently after the clone is made.
+func (eq *ExecutorQuery) Clone() *ExecutorQuery {
+	return &ExecutorQuery{
+		config:     eq.config,
+		limit:      eq.limit,
+		offset:     eq.offset,
+		order:      append([]OrderFunc{}, eq.order...),
+		unique:     append([]string{}, eq.unique...),
+		predicates: append([]predicate.Executor{}, eq.predicates...),
+		// clone intermediate query.
+		sql:  eq.sql.Clone(),
+		path: eq.path,
+	}
+}
+
+//	WithApplication tells the query-builder to eager-loads the nodes that are connected to
+//
+// the "application" edge. The optional arguments used to configure the query builder of the edge.
+func (eq *ExecutorQuery) WithApplication(opts ...func(*ApplicationQuery)) *ExecutorQuery {
+	query := &ApplicationQuery{config: eq.config}
+	for _, opt := range opts {
+		opt(query)
+	}
+	eq.withApplication = query
+	return eq
+}
+
+//	WithCounters tells the query-builder to eager-loads the nodes that are connected to
+//
+// the "counters" edge. The optional arguments used to configure the query builder of the edge.
+func (eq *ExecutorQuery) WithCounters(opts ...func(*CounterQuery)) *ExecutorQuery {
+	query := &CounterQuery{config: eq.config}
+	for _, opt := range opts {
+		opt(query)
+	}
+	eq.withCounters = query
+	return eq
+}
+
+//	WithHistograms tells the query-builder to eager-loads the nodes that are connected to
+//
+// the "histograms" edge. The optional arguments used to configure the query builder of the edge.
+func (eq *ExecutorQuery) WithHistograms(opts ...func(*HistogramQuery)) *ExecutorQuery {
+	query := &HistogramQuery{config: eq.config}
+	for _, opt := range opts {
+		opt(query)
+	}
+	eq.withHistograms = query
+	return eq
+}
+
+//	WithGauges tells the query-builder to eager-loads the nodes that are connected to
+//
+// the "gauges" edge. The optional arguments used to configure the query builder of the edge.
+func (eq *ExecutorQuery) WithGauges(opts ...func(*GaugeQuery)) *ExecutorQuery {
+	query := &GaugeQuery{config: eq.config}
+	for _, opt := range opts {
+		opt(query)
+	}
+	eq.withGauges = query
+	return eq
+}
+
+// GroupBy used to group vertices by one or more fields/columns.
+// It is often used with aggregate functions, like: count, max, mean, min, sum.
+//
+// Example:
+//
+//	var v []struct {
+//		EID string `json:"eId"`
+//		Count int `json:"count,omitempty"`
+//	}
+//
+//	client.Executor.Query().
+//		GroupBy(executor.FieldEID).
+//		Aggregate(ent.Count()).
+//		Scan(ctx, &v)
+func (eq *ExecutorQuery) GroupBy(field string, fields ...string) *ExecutorGroupBy {
+	group := &ExecutorGroupBy{config: eq.config}
+	group.fields = append([]string{field}, fields...)
+	group.path = func(ctx context.Context) (prev *sql.Selector, err error) {
+		if err := eq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		return eq.sqlQuery(), nil
+	}
+	return group
+}
+
+// Select one or more fields from the given query.
+//
+// Example:
+//
+//	var v []struct {
+//		EID string `json:"eId"`
+//	}
+//
+//	client.Executor.Query().
+//		Select(executor.FieldEID).
+//		Scan(ctx, &v)
+func (eq *ExecutorQuery) Select(field string, fields ...string) *ExecutorSelect {
+	selector := &ExecutorSelect{config: eq.config}
+	selector.fields = append([]string{field}, fields...)
+	selector.path = func(ctx context.Context) (prev *sql.Selector, err error) {
+		if err := eq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		return eq.sqlQuery(), nil
+	}
+	return selector
+}
+
+func (eq *ExecutorQuery) prepareQuery(ctx context.Context) error {
+	if eq.path != nil {
+		prev, err := eq.path(ctx)
+		if err != nil {
+			return err
+		}
+		eq.sql = prev
+	}
+	return nil
+}
+
+func (eq *ExecutorQuery) sqlAll(ctx context.Context) ([]*Executor, error) {
+	var (
+		nodes       = []*Executor{}
+		withFKs     = eq.withFKs
+		_spec       = eq.querySpec()
+		loadedTypes = [4]bool{
+			eq.withApplication != nil,
+			eq.withCounters != nil,
+			eq.withHistograms != nil,
+			eq.withGauges != nil,
+		}
+	)
+	if eq.withApplication != nil {
+		withFKs = true
+	}
+	if withFKs {
+		_spec.Node.Columns = append(_spec.Node.Columns, executor.ForeignKeys...)
+	}
+	_spec.ScanValues = func() []interface{} {
+		node := &Executor{config: eq.config}
+		nodes = append(nodes, node)
+		values := node.scanValues()
+		if withFKs {
+			values = append(values, node.fkValues()...)
+		}
+		return values
+	}
+	_spec.Assign = func(values ...interface{}) error {
+		if len(nodes) == 0 {
+			return fmt.Errorf("ent: Assign called without calling ScanValues")
+		}
+		node := nodes[len(nodes)-1]
+		node.Edges.loadedTypes = loadedTypes
+		return node.assignValues(values...)
+	}
+	if err := sqlgraph.QueryNodes(ctx, eq.driver, _spec); err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nodes, nil
+	}
+
+	if query := eq.withApplication; query != nil {
+		ids := make([]int, 0, len(nodes))
+		nodeids := make(map[int][]*Executor)
+		for i := range nodes {
+			if fk := nodes[i].application_executors; fk != nil {
+				ids = append(ids, *fk)
+				nodeids[*fk] = append(nodeids[*fk], nodes[i])
+			}
+		}
+		query.Where(application.IDIn(ids...))
+		neighbors, err := query.All(ctx)
+		if err != nil {
+			return nil, err
+		}
+		for _, n := range neighbors {
+			nodes, ok := nodeids[n.ID]
+			if !ok {
+				return nil, fmt.Errorf(`unexpected foreign-key "application_executors" returned %v`, n.ID)
+			}
+			for i := range nodes {
+				nodes[i].Edges.Application = n
+			}
+		}
+	}
+
+	if query := eq.withCounters; query != nil {
+		fks := make([]driver.Value, 0, len(nodes))
+		nodeids := make(map[int]*Executor)
+		for i := range nodes {
+			fks = append(fks, nodes[i].ID)
+			nodeids[nodes[i].ID] = nodes[i]
+		}
+		query.withFKs = true
+		query.Where(predicate.Counter(func(s *sql.Selector) {
+			s.Where(sql.InValues(executor.CountersColumn, fks...))
+		}))
+		neighbors, err := query.All(ctx)
+		if err != nil {
+			return nil, err
+		}
+		for _, n := range neighbors {
+			fk := n.executor_counters
+			if fk == nil {
+				return nil, fmt.Errorf(`foreign-key "executor_counters" is nil for node %v`, n.ID)
+			}
+			node, ok := nodeids[*fk]
+			if !ok {
+				return nil, fmt.Errorf(`unexpected foreign-key "executor_counters" returned %v for node %v`, *fk, n.ID)
+			}
+			node.Edges.Counters = append(node.Edges.Counters, n)
+		}
+	}
+
+	if query := eq.withHistograms; query != nil {
+		fks := make([]driver.Value, 0, len(nodes))
+		nodeids := make(map[int]*Executor)
+		for i := range nodes {
+			fks = append(fks, nodes[i].ID)
+			nodeids[nodes[i].ID] = nodes[i]
+		}
+		query.withFKs = true
+		query.Where(predicate.Histogram(func(s *sql.Selector) {
+			s.Where(sql.InValues(executor.HistogramsColumn, fks...))
+		}))
+		neighbors, err := query.All(ctx)
+		if err != nil {
+			return nil, err
+		}
+		for _, n := range neighbors {
+			fk := n.executor_histograms
+			if fk == nil {
+				return nil, fmt.Errorf(`foreign-key "executor_histograms" is nil for node %v`, n.ID)
+			}
+			node, ok := nodeids[*fk]
+			if !ok {
+				return nil, fmt.Errorf(`unexpected foreign-key "executor_histograms" returned %v for node %v`, *fk, n.ID)
+			}
+			node.Edges.Histograms = append(node.Edges.Histograms, n)
+		}
+	}
+
+	if query := eq.withGauges; query != nil {
+		fks := make([]driver.Value, 0, len(nodes))
+		nodeids := make(map[int]*Executor)
+		for i := range nodes {
+			fks = append(fks, nodes[i].ID)
+			nodeids[nodes[i].ID] = nodes[i]
+		}
+		query.withFKs = true
+		query.Where(predicate.Gauge(func(s *sql.Selector) {
+			s.Where(sql.InValues(executor.GaugesColumn, fks...))
+		}))
+		neighbors, err := query.All(ctx)
+		if err != nil {
+			return nil, err
+		}
+		for _, n := range neighbors {
+			fk := n.executor_gauges
+			if fk == nil {
+				return nil, fmt.Errorf(`foreign-key "executor_gauges" is nil for node %v`, n.ID)
+			}
+			node, ok := nodeids[*fk]
+			if !ok {
+				return nil, fmt.Errorf(`unexpected foreign-key "executor_gauges" returned %v for node %v`, *fk, n.ID)
+			}
+			node.Edges.Gauges = append(node.Edges.Gauges, n)
+		}
+	}
+
+	return nodes, nil
+}
+
+func (eq *ExecutorQuery) sqlCount(ctx context.Context) (int, error) {
+	_spec := eq.querySpec()
+	return sqlgraph.CountNodes(ctx, eq.driver, _spec)
+}
+
+func (eq *ExecutorQuery) sqlExist(ctx context.Context) (bool, error) {
+	n, err := eq.sqlCount(ctx)
+	if err != nil {
+		return false, fmt.Errorf("ent: check existence: %v", err)
+	}
+	return n > 0, nil
+}
+
+func (eq *ExecutorQuery) querySpec() *sqlgraph.QuerySpec {
+	_spec := &sqlgraph.QuerySpec{
+		Node: &sqlgraph.NodeSpec{
+			Table:   executor.Table,
+			Columns: executor.Columns,
+			ID: &sqlgraph.FieldSpec{
+				Type:   field.TypeInt,
+				Column: executor.FieldID,
+			},
+		},
+		From:   eq.sql,
+		Unique: true,
+	}
+	if ps := eq.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if limit := eq.limit; limit != nil {
+		_spec.Limit = *limit
+	}
+	if offset := eq.offset; offset != nil {
+		_spec.Offset = *offset
+	}
+	if ps := eq.order; len(ps) > 0 {
+		_spec.Order = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector, executor.ValidColumn)
+			}
+		}
+	}
+	return _spec
+}
+
+func (eq *ExecutorQuery) sqlQuery() *sql.Selector {
+	builder := sql.Dialect(eq.driver.Dialect())
+	t1 := builder.Table(executor.Table)
+	selector := builder.Select(t1.Columns(executor.Columns...)...).From(t1)
+	if eq.sql != nil {
+		selector = eq.sql
+		selector.Select(selector.Columns(executor.Columns...)...)
+	}
+	for _, p := range eq.predicates {
+		p(selector)
+	}
+	for _, p := range eq.order {
+		p(selector, executor.ValidColumn)
+	}
+	if offset := eq.offset; offset != nil {
+		// limit is mandatory for offset clause. We start
+		// with default value, and override it below if needed.
+		selector.Offset(*offset).Limit(math.MaxInt32)
+	}
+	if limit := eq.limit; limit != nil {
+		selector.Limit(*limit)
+	}
+	return selector
+}
+
+// ExecutorGroupBy is the builder for group-by Executor entities.
+type ExecutorGroupBy struct {
+	config
+	fields []string
+	fns    []AggregateFunc
+	// intermediate query (i.e. traversal path).
+	sql  *sql.Selector
+	path func(context.Context) (*sql.Selector, error)
+}
+
+// Aggregate adds the given aggregation functions to the group-by query.
+func (egb *ExecutorGroupBy) Aggregate(fns ...AggregateFunc) *ExecutorGroupBy {
+	egb.fns = append(egb.fns, fns...)
+	return egb
+}
+
+// Scan applies the group-by query and scan the result into the given value.
+func (egb *ExecutorGroupBy) Scan(ctx context.Context, v interface{}) error {
+	query, err := egb.path(ctx)
+	if err != nil {
+		return err
+	}
+	egb.sql = query
+	return egb.sqlScan(ctx, v)
+}
+
+// ScanX is like Scan, but panics if an error occurs.
+func (egb *ExecutorGroupBy) ScanX(ctx context.Context, v interface{}) {
+	if err := egb.Scan(ctx, v); err != nil {
+		panic(err)
+	}
+}
+
+// Strings returns list of strings from group-by. It is only allowed when querying group-by with one field.
+func (egb *ExecutorGroupBy) Strings(ctx context.Context) ([]string, error) {
+	if len(egb.fields) > 1 {
+		return nil, errors.New("ent: ExecutorGroupBy.Strings is not achievable when grouping more than 1 field")
+	}
+	var v []string
+	if err := egb.Scan(ctx, &v); err != nil {
+		return nil, err
+	}
+	return v, nil
+}
+
+// StringsX is like Strings, but panics if an error occurs.
+func (egb *ExecutorGroupBy) StringsX(ctx context.Context) []string {
+	v, err := egb.Strings(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// String returns a single string from group-by. It is only allowed when querying group-by with one field.
+func (egb *ExecutorGroupBy) String(ctx context.Context) (_ string, err error) {
+	var v []string
+	if v, err = egb.Strings(ctx); err != nil {
+		return
+	}
+	switch len(v) {
+	case 1:
+		return v[0], nil
+	case 0:
+		err = &NotFoundError{executor.Label}
+	default:
+		err = fmt.Errorf("ent: ExecutorGroupBy.Strings returned %d results when one was expected", len(v))
+	}
+	return
+}
+
+// StringX is like String, but panics if an error occurs.
+func (egb *ExecutorGroupBy) StringX(ctx context.Context) string {
+	v, err := egb.String(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Ints returns list of ints from group-by. It is only allowed when querying group-by with one field.
+func (egb *ExecutorGroupBy) Ints(ctx context.Context) ([]int, error) {
+	if len(egb.fields) > 1 {
+		return nil, errors.New("ent: ExecutorGroupBy.Ints is not achievable when grouping more than 1 field")
+	}
+	var v []int
+	if err := egb.Scan(ctx, &v); err != nil {
+		return nil, err
+	}
+	return v, nil
+}
+
+// IntsX is like Ints, but panics if an error occurs.
+func (egb *ExecutorGroupBy) IntsX(ctx context.Context) []int {
+	v, err := egb.Ints(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Int returns a single int from group-by. It is only allowed when querying group-by with one field.
+func (egb *ExecutorGroupBy) Int(ctx context.Context) (_ int, err error) {
+	var v []int
+	if v, err = egb.Ints(ctx); err != nil {
+		return
+	}
+	switch len(v) {
+	case 1:
+		return v[0], nil
+	case 0:
+		err = &NotFoundError{executor.Label}
+	default:
+		err = fmt.Errorf("ent: ExecutorGroupBy.Ints returned %d results when one was expected", len(v))
+	}
+	return
+}
+
+// IntX is like Int, but panics if an error occurs.
+func (egb *ExecutorGroupBy) IntX(ctx context.Context) int {
+	v, err := egb.Int(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Float64s returns list of float64s from group-by. It is only allowed when querying group-by with one field.
+func (egb *ExecutorGroupBy) Float64s(ctx context.Context) ([]float64, error) {
+	if len(egb.fields) > 1 {
+		return nil, errors.New("ent: ExecutorGroupBy.Float64s is not achievable when grouping more than 1 field")
+	}
+	var v []float64
+	if err := egb.Scan(ctx, &v); err != nil {
+		return nil, err
+	}
+	return v, nil
+}
+
+// Float64sX is like Float64s, but panics if an error occurs.
+func (egb *ExecutorGroupBy) Float64sX(ctx context.Context) []float64 {
+	v, err := egb.Float64s(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Float64 returns a single float64 from group-by. It is only allowed when querying group-by with one field.
+func (egb *ExecutorGroupBy) Float64(ctx context.Context) (_ float64, err error) {
+	var v []float64
+	if v, err = egb.Float64s(ctx); err != nil {
+		return
+	}
+	switch len(v) {
+	case 1:
+		return v[0], nil
+	case 0:
+		err = &NotFoundError{executor.Label}
+	default:
+		err = fmt.Errorf("ent: ExecutorGroupBy.Float64s returned %d results when one was expected", len(v))
+	}
+	return
+}
+
+// Float64X is like Float64, but panics if an error occurs.
+func (egb *ExecutorGroupBy) Float64X(ctx context.Context) float64 {
+	v, err := egb.Float64(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Bools returns list of bools from group-by. It is only allowed when querying group-by with one field.
+func (egb *ExecutorGroupBy) Bools(ctx context.Context) ([]bool, error) {
+	if len(egb.fields) > 1 {
+		return nil, errors.New("ent: ExecutorGroupBy.Bools is not achievable when grouping more than 1 field")
+	}
+	var v []bool
+	if err := egb.Scan(ctx, &v); err != nil {
+		return nil, err
+	}
+	return v, nil
+}
+
+// BoolsX is like Bools, but panics if an error occurs.
+func (egb *ExecutorGroupBy) BoolsX(ctx context.Context) []bool {
+	v, err := egb.Bools(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Bool returns a single bool from group-by. It is only allowed when querying group-by with one field.
+func (egb *ExecutorGroupBy) Bool(ctx context.Context) (_ bool, err error) {
+	var v []bool
+	if v, err = egb.Bools(ctx); err != nil {
+		return
+	}
+	switch len(v) {
+	case 1:
+		return v[0], nil
+	case 0:
+		err = &NotFoundError{executor.Label}
+	default:
+		err = fmt.Errorf("ent: ExecutorGroupBy.Bools returned %d results when one was expected", len(v))
+	}
+	return
+}
+
+// BoolX is like Bool, but panics if an error occurs.
+func (egb *ExecutorGroupBy) BoolX(ctx context.Context) bool {
+	v, err := egb.Bool(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+func (egb *ExecutorGroupBy) sqlScan(ctx context.Context, v interface{}) error {
+	for _, f := range egb.fields {
+		if !executor.ValidColumn(f) {
+			return &ValidationError{Name: f, err: fmt.Errorf("invalid field %q for group-by", f)}
+		}
+	}
+	selector := egb.sqlQuery()
+	if err := selector.Err(); err != nil {
+		return err
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := egb.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
+
+func (egb *ExecutorGroupBy) sqlQuery() *sql.Selector {
+	selector := egb.sql
+	columns := make([]string, 0, len(egb.fields)+len(egb.fns))
+	columns = append(columns, egb.fields...)
+	for _, fn := range egb.fns {
+		columns = append(columns, fn(selector, executor.ValidColumn))
+	}
+	return selector.Select(columns...).GroupBy(egb.fields...)
+}
+
+// ExecutorSelect is the builder for select fields of Executor entities.
+type ExecutorSelect struct {
+	config
+	fields []string
+	// intermediate query (i.e. traversal path).
+	sql  *sql.Selector
+	path func(context.Context) (*sql.Selector, error)
+}
+
+// Scan applies the selector query and scan the result into the given value.
+func (es *ExecutorSelect) Scan(ctx context.Context, v interface{}) error {
+	query, err := es.path(ctx)
+	if err != nil {
+		return err
+	}
+	es.sql = query
+	return es.sqlScan(ctx, v)
+}
+
+// ScanX is like Scan, but panics if an error occurs.
+func (es *ExecutorSelect) ScanX(ctx context.Context, v interface{}) {
+	if err := es.Scan(ctx, v); err != nil {
+		panic(err)
+	}
+}
+
+// Strings returns list of strings from selector. It is only allowed when selecting one field.
+func (es *ExecutorSelect) Strings(ctx context.Context) ([]string, error) {
+	if len(es.fields) > 1 {
+		return nil, errors.New("ent: ExecutorSelect.Strings is not achievable when selecting more than 1 field")
+	}
+	var v []string
+	if err := es.Scan(ctx, &v); err != nil {
+		return nil, err
+	}
+	return v, nil
+}
+
+// StringsX is like Strings, but panics if an error occurs.
+func (es *ExecutorSelect) StringsX(ctx context.Context) []string {
+	v, err := es.Strings(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// String returns a single string from selector. It is only allowed when selecting one field.
+func (es *ExecutorSelect) String(ctx context.Context) (_ string, err error) {
+	var v []string
+	if v, err = es.Strings(ctx); err != nil {
+		return
+	}
+	switch len(v) {
+	case 1:
+		return v[0], nil
+	case 0:
+		err = &NotFoundError{executor.Label}
+	default:
+		err = fmt.Errorf("ent: ExecutorSelect.Strings returned %d results when one was expected", len(v))
+	}
+	return
+}
+
+// StringX is like String, but panics if an error occurs.
+func (es *ExecutorSelect) StringX(ctx context.Context) string {
+	v, err := es.String(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Ints returns list of ints from selector. It is only allowed when selecting one field.
+func (es *ExecutorSelect) Ints(ctx context.Context) ([]int, error) {
+	if len(es.fields) > 1 {
+		return nil, errors.New("ent: ExecutorSelect.Ints is not achievable when selecting more than 1 field")
+	}
+	var v []int
+	if err := es.Scan(ctx, &v); err != nil {
+		return nil, err
+	}
+	return v, nil
+}
+
+// IntsX is like Ints, but panics if an error occurs.
+func (es *ExecutorSelect) IntsX(ctx context.Context) []int {
+	v, err := es.Ints(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Int returns a single int from selector. It is only allowed when selecting one field.
+func (es *ExecutorSelect) Int(ctx context.Context) (_ int, err error) {
+	var v []int
+	if v, err = es.Ints(ctx); err != nil {
+		return
+	}
+	switch len(v) {
+	case 1:
+		return v[0], nil
+	case 0:
+		err = &NotFoundError{executor.Label}
+	default:
+		err = fmt.Errorf("ent: ExecutorSelect.Ints returned %d results when one was expected", len(v))
+	}
+	return
+}
+
+// IntX is like Int, but panics if an error occurs.
+func (es *ExecutorSelect) IntX(ctx context.Context) int {
+	v, err := es.Int(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Float64s returns list of float64s from selector. It is only allowed when selecting one field.
+func (es *ExecutorSelect) Float64s(ctx context.Context) ([]float64, error) {
+	if len(es.fields) > 1 {
+		return nil, errors.New("ent: ExecutorSelect.Float64s is not achievable when selecting more than 1 field")
+	}
+	var v []float64
+	if err := es.Scan(ctx, &v); err != nil {
+		return nil, err
+	}
+	return v, nil
+}
+
+// Float64sX is like Float64s, but panics if an error occurs.
+func (es *ExecutorSelect) Float64sX(ctx context.Context) []float64 {
+	v, err := es.Float64s(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Float64 returns a single float64 from selector. It is only allowed when selecting one field.
+func (es *ExecutorSelect) Float64(ctx context.Context) (_ float64, err error) {
+	var v []float64
+	if v, err = es.Float64s(ctx); err != nil {
+		return
+	}
+	switch len(v) {
+	case 1:
+		return v[0], nil
+	case 0:
+		err = &NotFoundError{executor.Label}
+	default:
+		err = fmt.Errorf("ent: ExecutorSelect.Float64s returned %d results when one was expected", len(v))
+	}
+	return
+}
+
+// Float64X is like Float64, but panics if an error occurs.
+func (es *ExecutorSelect) Float64X(ctx context.Context) float64 {
+	v, err := es.Float64(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Bools returns list of bools from selector. It is only allowed when selecting one field.
+func (es *ExecutorSelect) Bools(ctx context.Context) ([]bool, error) {
+	if len(es.fields) > 1 {
+		return nil, errors.New("ent: ExecutorSelect.Bools is not achievable when selecting more than 1 field")
+	}
+	var v []bool
+	if err := es.Scan(ctx, &v); err != nil {
+		return nil, err
+	}
+	return v, nil
+}
+
+// BoolsX is like Bools, but panics if an error occurs.
+func (es *ExecutorSelect) BoolsX(ctx context.Context) []bool {
+	v, err := es.Bools(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Bool returns a single bool from selector. It is only allowed when selecting one field.
+func (es *ExecutorSelect) Bool(ctx context.Context) (_ bool, err error) {
+	var v []bool
+	if v, err = es.Bools(ctx); err != nil {
+		return
+	}
+	switch len(v) {
+	case 1:
+		return v[0], nil
+	case 0:
+		err = &NotFoundError{executor.Label}
+	default:
+		err = fmt.Errorf("ent: ExecutorSelect.Bools returned %d results when one was expected", len(v))
+	}
+	return
+}
+
+// BoolX is like Bool, but panics if an error occurs.
+func (es *ExecutorSelect) BoolX(ctx context.Context) bool {
+	v, err := es.Bool(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+func (es *ExecutorSelect) sqlScan(ctx context.Context, v interface{}) error {
+	for _, f := range es.fields {
+		if !executor.ValidColumn(f) {
+			return &ValidationError{Name: f, err: fmt.Errorf("invalid field %q for selection", f)}
+		}
+	}
+	rows := &sql.Rows{}
+	query, args := es.sqlQuery().Query()
+	if err := es.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
+
+func (es *ExecutorSelect) sqlQuery() sql.Querier {
+	selector := es.sql
+	selector.Select(selector.Columns(es.fields...)...)
+	return selector
+}
@@ -0,0 +1,919 @@
+// Code generated by entc, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/facebook/ent/dialect/sql"
+	"github.com/facebook/ent/dialect/sql/sqlgraph"
+	"github.com/facebook/ent/schema/field"
+	"github.com/gobench-io/gobench/ent/application"
+	"github.com/gobench-io/gobench/ent/counter"
+	"github.com/gobench-io/gobench/ent/executor"
+	"github.com/gobench-io/gobench/ent/gauge"
+	"github.com/gobench-io/gobench/ent/histogram"
+	"github.com/gobench-io/gobench/ent/predicate"
+)
+
+// ExecutorUpdate is the builder for updating Executor entities.
+type ExecutorUpdate struct {
+	config
+	hooks      []Hook
+	mutation   *ExecutorMutation
+	predicates []predicate.Executor
+}
+
+// Where adds a new predicate for the builder.
+func (eu *ExecutorUpdate) Where(ps ...predicate.Executor) *ExecutorUpdate {
+	eu.predicates = append(eu.predicates, ps...)
+	return eu
+}
+
+// SetEID sets the eID field.
+func (eu *ExecutorUpdate) SetEID(s string) *ExecutorUpdate {
+	eu.mutation.SetEID(s)
+	return eu
+}
+
+// SetApplicationID sets the application edge to Application by id.
+func (eu *ExecutorUpdate) SetApplicationID(id int) *ExecutorUpdate {
+	eu.mutation.SetApplicationID(id)
+	return eu
+}
+
+// SetApplication sets the application edge to Application.
+func (eu *ExecutorUpdate) SetApplication(a *Application) *ExecutorUpdate {
+	return eu.SetApplicationID(a.ID)
+}
+
+// AddCounterIDs adds the counters edge to Counter by ids.
+func (eu *ExecutorUpdate) AddCounterIDs(ids ...int) *ExecutorUpdate {
+	eu.mutation.AddCounterIDs(ids...)
+	return eu
+}
+
+// AddCounters adds the counters edges to Counter.
+func (eu *ExecutorUpdate) AddCounters(c ...*Counter) *ExecutorUpdate {
+	ids := make([]int, len(c))
+	for i := range c {
+		ids[i] = c[i].ID
+	}
+	return eu.AddCounterIDs(ids...)
+}
+
+// AddHistogramIDs adds the histograms edge to Histogram by ids.
+func (eu *ExecutorUpdate) AddHistogramIDs(ids ...int) *ExecutorUpdate {
+	eu.mutation.AddHistogramIDs(ids...)
+	return eu
+}
+
+// AddHistograms adds the histograms edges to Histogram.
+func (eu *ExecutorUpdate) AddHistograms(h ...*Histogram) *ExecutorUpdate {
+	ids := make([]int, len(h))
+	for i := range h {
+		ids[i] = h[i].ID
+	}
+	return eu.AddHistogramIDs(ids...)
+}
+
+// AddGaugeIDs adds the gauges edge to Gauge by ids.
+func (eu *ExecutorUpdate) AddGaugeIDs(ids ...int) *ExecutorUpdate {
+	eu.mutation.AddGaugeIDs(ids...)
+	return eu
+}
+
+// AddGauges adds the gauges edges to Gauge.
+func (eu *ExecutorUpdate) AddGauges(g ...*Gauge) *ExecutorUpdate {
+	ids := make([]int, len(g))
+	for i := range g {
+		ids[i] = g[i].ID
+	}
+	return eu.AddGaugeIDs(ids...)
+}
+
+// Mutation returns the ExecutorMutation object of the builder.
+func (eu *ExecutorUpdate) Mutation() *ExecutorMutation {
+	return eu.mutation
+}
+
+// ClearApplication clears the "application" edge to type Application.
+func (eu *ExecutorUpdate) ClearApplication() *ExecutorUpdate {
+	eu.mutation.ClearApplication()
+	return eu
+}
+
+// ClearCounters clears all "counters" edges to type Counter.
+func (eu *ExecutorUpdate) ClearCounters() *ExecutorUpdate {
+	eu.mutation.ClearCounters()
+	return eu
+}
+
+// RemoveCounterIDs removes the counters edge to Counter by ids.
+func (eu *ExecutorUpdate) RemoveCounterIDs(ids ...int) *ExecutorUpdate {
+	eu.mutation.RemoveCounterIDs(ids...)
+	return eu
+}
+
+// RemoveCounters removes counters edges to Counter.
+func (eu *ExecutorUpdate) RemoveCounters(c ...*Counter) *ExecutorUpdate {
+	ids := make([]int, len(c))
+	for i := range c {
+		ids[i] = c[i].ID
+	}
+	return eu.RemoveCounterIDs(ids...)
+}
+
+// ClearHistograms clears all "histograms" edges to type Histogram.
+func (eu *ExecutorUpdate) ClearHistograms() *ExecutorUpdate {
+	eu.mutation.ClearHistograms()
+	return eu
+}
+
+// RemoveHistogramIDs removes the histograms edge to Histogram by ids.
+func (eu *ExecutorUpdate) RemoveHistogramIDs(ids ...int) *ExecutorUpdate {
+	eu.mutation.RemoveHistogramIDs(ids...)
+	return eu
+}
+
+// RemoveHistograms removes histograms edges to Histogram.
+func (eu *ExecutorUpdate) RemoveHistograms(h ...*Histogram) *ExecutorUpdate {
+	ids := make([]int, len(h))
+	for i := range h {
+		ids[i] = h[i].ID
+	}
+	return eu.RemoveHistogramIDs(ids...)
+}
+
+// ClearGauges clears all "gauges" edges to type Gauge.
+func (eu *ExecutorUpdate) ClearGauges() *ExecutorUpdate {
+	eu.mutation.ClearGauges()
+	return eu
+}
+
+// RemoveGaugeIDs removes the gauges edge to Gauge by ids.
+func (eu *ExecutorUpdate) RemoveGaugeIDs(ids ...int) *ExecutorUpdate {
+	eu.mutation.RemoveGaugeIDs(ids...)
+	return eu
+}
+
+// RemoveGauges removes gauges edges to Gauge.
+func (eu *ExecutorUpdate) RemoveGauges(g ...*Gauge) *ExecutorUpdate {
+	ids := make([]int, len(g))
+	for i := range g {
+		ids[i] = g[i].ID
+	}
+	return eu.RemoveGaugeIDs(ids...)
+}
+
+// Save executes the query and returns the number of rows/vertices matched by this operation.
+func (eu *ExecutorUpdate) Save(ctx context.Context) (int, error) {
+	var (
+		err      error
+		affected int
+	)
+	if len(eu.hooks) == 0 {
+		if err = eu.check(); err != nil {
+			return 0, err
+		}
+		affected, err = eu.sqlSave(ctx)
+	} else {
+		var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+			mutation, ok := m.(*ExecutorMutation)
+			if !ok {
+				return nil, fmt.Errorf("unexpected mutation type %T", m)
+			}
+			if err = eu.check(); err != nil {
+				return 0, err
+			}
+			eu.mutation = mutation
+			affected, err = eu.sqlSave(ctx)
+			mutation.done = true
+			return affected, err
+		})
+		for i := len(eu.hooks) - 1; i >= 0; i-- {
+			mut = eu.hooks[i](mut)
+		}
+		if _, err := mut.Mutate(ctx, eu.mutation); err != nil {
+			return 0, err
+		}
+	}
+	return affected, err
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (eu *ExecutorUpdate) SaveX(ctx context.Context) int {
+	affected, err := eu.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (eu *ExecutorUpdate) Exec(ctx context.Context) error {
+	_, err := eu.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (eu *ExecutorUpdate) ExecX(ctx context.Context) {
+	if err := eu.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (eu *ExecutorUpdate) check() error {
+	if v, ok := eu.mutation.EID(); ok {
+		if err := executor.EIDValidator(v); err != nil {
+			return &ValidationError{Name: "eID", err: fmt.Errorf("ent: validator failed for field \"eID\": %w", err)}
+		}
+	}
+	if _, ok := eu.mutation.ApplicationID(); eu.mutation.ApplicationCleared() && !ok {
+		return errors.New("ent: clearing a required unique edge \"application\"")
+	}
+	return nil
+}
+
+func (eu *ExecutorUpdate) sqlSave(ctx context.Context) (n int, err error) {
+	_spec := &sqlgraph.UpdateSpec{
+		Node: &sqlgraph.NodeSpec{
+			Table:   executor.Table,
+			Columns: executor.Columns,
+			ID: &sqlgraph.FieldSpec{
+				Type:   field.TypeInt,
+				Column: executor.FieldID,
+			},
+		},
+	}
+	if ps := eu.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := eu.mutation.EID(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: executor.FieldEID,
+		})
+	}
+	if eu.mutation.ApplicationCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   executor.ApplicationTable,
+			Columns: []string{executor.ApplicationColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt,
+					Column: application.FieldID,
+				},
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := eu.mutation.ApplicationIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   executor.ApplicationTable,
+			Columns: []string{executor.ApplicationColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt,
+					Column: application.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if eu.mutation.CountersCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   executor.CountersTable,
+			Columns: []string{executor.CountersColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt,
+					Column: counter.FieldID,
+				},
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := eu.mutation.RemovedCountersIDs(); len(nodes) > 0 && !eu.mutation.CountersCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   executor.CountersTable,
+			Columns: []string{executor.CountersColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt,
+					Column: counter.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := eu.mutation.CountersIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   executor.CountersTable,
+			Columns: []string{executor.CountersColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt,
+					Column: counter.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if eu.mutation.HistogramsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   executor.HistogramsTable,
+			Columns: []string{executor.HistogramsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt,
+					Column: histogram.FieldID,
+				},
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := eu.mutation.RemovedHistogramsIDs(); len(nodes) > 0 && !eu.mutation.HistogramsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   executor.HistogramsTable,
+			Columns: []string{executor.HistogramsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt,
+					Column: histogram.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := eu.mutation.HistogramsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   executor.HistogramsTable,
+			Columns: []string{executor.HistogramsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt,
+					Column: histogram.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if eu.mutation.GaugesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   executor.GaugesTable,
+			Columns: []string{executor.GaugesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt,
+					Column: gauge.FieldID,
+				},
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := eu.mutation.RemovedGaugesIDs(); len(nodes) > 0 && !eu.mutation.GaugesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   executor.GaugesTable,
+			Columns: []string{executor.GaugesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt,
+					Column: gauge.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := eu.mutation.GaugesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   executor.GaugesTable,
+			Columns: []string{executor.GaugesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt,
+					Column: gauge.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if n, err = sqlgraph.UpdateNodes(ctx, eu.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{executor.Label}
+		} else if cerr, ok := isSQLConstraintError(err); ok {
+			err = cerr
+		}
+		return 0, err
+	}
+	return n, nil
+}
+
+// ExecutorUpdateOne is the builder for updating a single Executor entity.
+type ExecutorUpdateOne struct {
+	config
+	hooks    []Hook
+	mutation *ExecutorMutation
+}
+
+// SetEID sets the eID field.
+func (euo *ExecutorUpdateOne) SetEID(s string) *ExecutorUpdateOne {
+	euo.mutation.SetEID(s)
+	return euo
+}
+
+// SetApplicationID sets the application edge to Application by id.
+func (euo *ExecutorUpdateOne) SetApplicationID(id int) *ExecutorUpdateOne {
+	euo.mutation.SetApplicationID(id)
+	return euo
+}
+
+// SetApplication sets the application edge to Application.
+func (euo *ExecutorUpdateOne) SetApplication(a *Application) *ExecutorUpdateOne {
+	return euo.SetApplicationID(a.ID)
+}
+
+// AddCounterIDs adds the counters edge to Counter by ids.
+func (euo *ExecutorUpdateOne) AddCounterIDs(ids ...int) *ExecutorUpdateOne {
+	euo.mutation.AddCounterIDs(ids...)
+	return euo
+}
+
+// AddCounters adds the counters edges to Counter.
+func (euo *ExecutorUpdateOne) AddCounters(c ...*Counter) *ExecutorUpdateOne {
+	ids := make([]int, len(c))
+	for i := range c {
+		ids[i] = c[i].ID
+	}
+	return euo.AddCounterIDs(ids...)
+}
+
+// AddHistogramIDs adds the histograms edge to Histogram by ids.
+func (euo *ExecutorUpdateOne) AddHistogramIDs(ids ...int) *ExecutorUpdateOne {
+	euo.mutation.AddHistogramIDs(ids...)
+	return euo
+}
+
+// AddHistograms adds the histograms edges to Histogram.
+func (euo *ExecutorUpdateOne) AddHistograms(h ...*Histogram) *ExecutorUpdateOne {
+	ids := make([]int, len(h))
+	for i := range h {
+		ids[i] = h[i].ID
+	}
+	return euo.AddHistogramIDs(ids...)
+}
+
+// AddGaugeIDs adds the gauges edge to Gauge by ids.
+func (euo *ExecutorUpdateOne) AddGaugeIDs(ids ...int) *ExecutorUpdateOne {
+	euo.mutation.AddGaugeIDs(ids...)
+	return euo
+}
+
+// AddGauges adds the gauges edges to Gauge.
+func (euo *ExecutorUpdateOne) AddGauges(g ...*Gauge) *ExecutorUpdateOne {
+	ids := make([]int, len(g))
+	for i := range g {
+		ids[i] = g[i].ID
+	}
+	return euo.AddGaugeIDs(ids...)
+}
+
+// Mutation returns the ExecutorMutation object of the builder.
+func (euo *ExecutorUpdateOne) Mutation() *ExecutorMutation {
+	return euo.mutation
+}
+
+// ClearApplication clears the "application" edge to type Application.
+func (euo *ExecutorUpdateOne) ClearApplication() *ExecutorUpdateOne {
+	euo.mutation.ClearApplication()
+	return euo
+}
+
+// ClearCounters clears all "counters" edges to type Counter.
+func (euo *ExecutorUpdateOne) ClearCounters() *ExecutorUpdateOne {
+	euo.mutation.ClearCounters()
+	return euo
+}
+
+// RemoveCounterIDs removes the counters edge to Counter by ids.
+func (euo *ExecutorUpdateOne) RemoveCounterIDs(ids ...int) *ExecutorUpdateOne {
+	euo.mutation.RemoveCounterIDs(ids...)
+	return euo
+}
+
+// RemoveCounters removes counters edges to Counter.
+func (euo *ExecutorUpdateOne) RemoveCounters(c ...*Counter) *ExecutorUpdateOne {
+	ids := make([]int, len(c))
+	for i := range c {
+		ids[i] = c[i].ID
+	}
+	return euo.RemoveCounterIDs(ids...)
+}
+
+// ClearHistograms clears all "histograms" edges to type Histogram.
+func (euo *ExecutorUpdateOne) ClearHistograms() *ExecutorUpdateOne {
+	euo.mutation.ClearHistograms()
+	return euo
+}
+
+// RemoveHistogramIDs removes the histograms edge to Histogram by ids.
+func (euo *ExecutorUpdateOne) RemoveHistogramIDs(ids ...int) *ExecutorUpdateOne {
+	euo.mutation.RemoveHistogramIDs(ids...)
+	return euo
+}
+
+// RemoveHistograms removes histograms edges to Histogram.
+func (euo *ExecutorUpdateOne) RemoveHistograms(h ...*Histogram) *ExecutorUpdateOne {
+	ids := make([]int, len(h))
+	for i := range h {
+		ids[i] = h[i].ID
+	}
+	return euo.RemoveHistogramIDs(ids...)
+}
+
+// ClearGauges clears all "gauges" edges to type Gauge.
+func (euo *ExecutorUpdateOne) ClearGauges() *ExecutorUpdateOne {
+	euo.mutation.ClearGauges()
+	return euo
+}
+
+// RemoveGaugeIDs removes the gauges edge to Gauge by ids.
+func (euo *ExecutorUpdateOne) RemoveGaugeIDs(ids ...int) *ExecutorUpdateOne {
+	euo.mutation.RemoveGaugeIDs(ids...)
+	return euo
+}
+
+// RemoveGauges removes gauges edges to Gauge.
+func (euo *ExecutorUpdateOne) RemoveGauges(g ...*Gauge) *ExecutorUpdateOne {
+	ids := make([]int, len(g))
+	for i := range g {
+		ids[i] = g[i].ID
+	}
+	return euo.RemoveGaugeIDs(ids...)
+}
+
+// Save executes the query and returns the updated entity.
+func (euo *ExecutorUpdateOne) Save(ctx context.Context) (*Executor, error) {
+	var (
+		err  error
+		node *Executor
+	)
+	if len(euo.hooks) == 0 {
+		if err = euo.check(); err != nil {
+			return nil, err
+		}
+		node, err = euo.sqlSave(ctx)
+	} else {
+		var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+			mutation, ok := m.(*ExecutorMutation)
+			if !ok {
+				return nil, fmt.Errorf("unexpected mutation type %T", m)
+			}
+			if err = euo.check(); err != nil {
+				return nil, err
+			}
+			euo.mutation = mutation
+			node, err = euo.sqlSave(ctx)
+			mutation.done = true
+			return node, err
+		})
+		for i := len(euo.hooks) - 1; i >= 0; i-- {
+			mut = euo.hooks[i](mut)
+		}
+		if _, err := mut.Mutate(ctx, euo.mutation); err != nil {
+			return nil, err
+		}
+	}
+	return node, err
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (euo *ExecutorUpdateOne) SaveX(ctx context.Context) *Executor {
+	node, err := euo.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (euo *ExecutorUpdateOne) Exec(ctx context.Context) error {
+	_, err := euo.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (euo *ExecutorUpdateOne) ExecX(ctx context.Context) {
+	if err := euo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (euo *ExecutorUpdateOne) check() error {
+	if v, ok := euo.mutation.EID(); ok {
+		if err := executor.EIDValidator(v); err != nil {
+			return &ValidationError{Name: "eID", err: fmt.Errorf("ent: validator failed for field \"eID\": %w", err)}
+		}
+	}
+	if _, ok := euo.mutation.ApplicationID(); euo.mutation.ApplicationCleared() && !ok {
+		return errors.New("ent: clearing a required unique edge \"application\"")
+	}
+	return nil
+}
+
+func (euo *ExecutorUpdateOne) sqlSave(ctx context.Context) (_node *Executor, err error) {
+	_spec := &sqlgraph.UpdateSpec{
+		Node: &sqlgraph.NodeSpec{
+			Table:   executor.Table,
+			Columns: executor.Columns,
+			ID: &sqlgraph.FieldSpec{
+				Type:   field.TypeInt,
+				Column: executor.FieldID,
+			},
+		},
+	}
+	id, ok := euo.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "ID", err: fmt.Errorf("missing Executor.ID for update")}
+	}
+	_spec.Node.ID.Value = id
+	if value, ok := euo.mutation.EID(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: executor.FieldEID,
+		})
+	}
+	if euo.mutation.ApplicationCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   executor.ApplicationTable,
+			Columns: []string{executor.ApplicationColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt,
+					Column: application.FieldID,
+				},
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := euo.mutation.ApplicationIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   executor.ApplicationTable,
+			Columns: []string{executor.ApplicationColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt,
+					Column: application.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if euo.mutation.CountersCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   executor.CountersTable,
+			Columns: []string{executor.CountersColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt,
+					Column: counter.FieldID,
+				},
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := euo.mutation.RemovedCountersIDs(); len(nodes) > 0 && !euo.mutation.CountersCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   executor.CountersTable,
+			Columns: []string{executor.CountersColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt,
+					Column: counter.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := euo.mutation.CountersIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   executor.CountersTable,
+			Columns: []string{executor.CountersColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt,
+					Column: counter.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if euo.mutation.HistogramsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   executor.HistogramsTable,
+			Columns: []string{executor.HistogramsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt,
+					Column: histogram.FieldID,
+				},
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := euo.mutation.RemovedHistogramsIDs(); len(nodes) > 0 && !euo.mutation.HistogramsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   executor.HistogramsTable,
+			Columns: []string{executor.HistogramsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt,
+					Column: histogram.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := euo.mutation.HistogramsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   executor.HistogramsTable,
+			Columns: []string{executor.HistogramsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt,
+					Column: histogram.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if euo.mutation.GaugesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   executor.GaugesTable,
+			Columns: []string{executor.GaugesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt,
+					Column: gauge.FieldID,
+				},
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := euo.mutation.RemovedGaugesIDs(); len(nodes) > 0 && !euo.mutation.GaugesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   executor.GaugesTable,
+			Columns: []string{executor.GaugesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt,
+					Column: gauge.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := euo.mutation.GaugesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   executor.GaugesTable,
+			Columns: []string{executor.GaugesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt,
+					Column: gauge.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_node = &Executor{config: euo.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues()
+	if err = sqlgraph.UpdateNode(ctx, euo.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{executor.Label}
+		} else if cerr, ok := isSQLConstraintError(err); ok {
+			err = cerr
+		}
+		return nil, err
+	}
+	return _node, nil
+}
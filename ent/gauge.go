@@ -7,6 +7,7 @@ import (
 	"strings"
 
 	"github.com/facebook/ent/dialect/sql"
+	"github.com/gobench-io/gobench/ent/executor"
 	"github.com/gobench-io/gobench/ent/gauge"
 	"github.com/gobench-io/gobench/ent/metric"
 )
@@ -21,20 +22,23 @@ type Gauge struct {
 	// Value holds the value of the "value" field.
 	Value int64 `json:"value"`
 	// WID holds the value of the "wID" field.
-	WID string `json:"wId"`
+	WID string `json:"-"`
 	// Edges holds the relations/edges for other nodes in the graph.
 	// The values are being populated by the GaugeQuery when eager-loading is set.
-	Edges         GaugeEdges `json:"edges"`
-	metric_gauges *int
+	Edges           GaugeEdges `json:"edges"`
+	executor_gauges *int
+	metric_gauges   *int
 }
 
 // GaugeEdges holds the relations/edges for other nodes in the graph.
 type GaugeEdges struct {
 	// Metric holds the value of the metric edge.
 	Metric *Metric
+	// Executor holds the value of the executor edge.
+	Executor *Executor
 	// loadedTypes holds the information for reporting if a
 	// type was loaded (or requested) in eager-loading or not.
-	loadedTypes [1]bool
+	loadedTypes [2]bool
 }
 
 // MetricOrErr returns the Metric value or an error if the edge
@@ -51,6 +55,20 @@ func (e GaugeEdges) MetricOrErr() (*Metric, error) {
 	return nil, &NotLoadedError{edge: "metric"}
 }
 
+// ExecutorOrErr returns the Executor value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e GaugeEdges) ExecutorOrErr() (*Executor, error) {
+	if e.loadedTypes[1] {
+		if e.Executor == nil {
+			// The edge executor was loaded in eager-loading,
+			// but was not found.
+			return nil, &NotFoundError{label: executor.Label}
+		}
+		return e.Executor, nil
+	}
+	return nil, &NotLoadedError{edge: "executor"}
+}
+
 // scanValues returns the types for scanning values from sql.Rows.
 func (*Gauge) scanValues() []interface{} {
 	return []interface{}{
@@ -64,6 +82,7 @@ func (*Gauge) scanValues() []interface{} {
 // fkValues returns the types for scanning foreign-keys values from sql.Rows.
 func (*Gauge) fkValues() []interface{} {
 	return []interface{}{
+		&sql.NullInt64{}, // executor_gauges
 		&sql.NullInt64{}, // metric_gauges
 	}
 }
@@ -98,6 +117,12 @@ func (ga *Gauge) assignValues(values ...interface{}) error {
 	values = values[3:]
 	if len(values) == len(gauge.ForeignKeys) {
 		if value, ok := values[0].(*sql.NullInt64); !ok {
+			return fmt.Errorf("unexpected type %T for edge-field executor_gauges", value)
+		} else if value.Valid {
+			ga.executor_gauges = new(int)
+			*ga.executor_gauges = int(value.Int64)
+		}
+		if value, ok := values[1].(*sql.NullInt64); !ok {
 			return fmt.Errorf("unexpected type %T for edge-field metric_gauges", value)
 		} else if value.Valid {
 			ga.metric_gauges = new(int)
@@ -112,6 +137,11 @@ func (ga *Gauge) QueryMetric() *MetricQuery {
 	return (&GaugeClient{config: ga.config}).QueryMetric(ga)
 }
 
+// QueryExecutor queries the executor edge of the Gauge.
+func (ga *Gauge) QueryExecutor() *ExecutorQuery {
+	return (&GaugeClient{config: ga.config}).QueryExecutor(ga)
+}
+
 // Update returns a builder for updating this Gauge.
 // Note that, you need to call Gauge.Unwrap() before calling this method, if this Gauge
 // was returned from a transaction, and the transaction was committed or rolled back.
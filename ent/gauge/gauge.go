@@ -16,6 +16,8 @@ const (
 
 	// EdgeMetric holds the string denoting the metric edge name in mutations.
 	EdgeMetric = "metric"
+	// EdgeExecutor holds the string denoting the executor edge name in mutations.
+	EdgeExecutor = "executor"
 
 	// Table holds the table name of the gauge in the database.
 	Table = "gauges"
@@ -26,6 +28,13 @@ const (
 	MetricInverseTable = "metrics"
 	// MetricColumn is the table column denoting the metric relation/edge.
 	MetricColumn = "metric_gauges"
+	// ExecutorTable is the table the holds the executor relation/edge.
+	ExecutorTable = "gauges"
+	// ExecutorInverseTable is the table name for the Executor entity.
+	// It exists in this package in order to avoid circular dependency with the "executor" package.
+	ExecutorInverseTable = "executors"
+	// ExecutorColumn is the table column denoting the executor relation/edge.
+	ExecutorColumn = "executor_gauges"
 )
 
 // Columns holds all SQL columns for gauge fields.
@@ -38,6 +47,7 @@ var Columns = []string{
 
 // ForeignKeys holds the SQL foreign-keys that are owned by the Gauge type.
 var ForeignKeys = []string{
+	"executor_gauges",
 	"metric_gauges",
 }
 
@@ -55,3 +65,8 @@ func ValidColumn(column string) bool {
 	}
 	return false
 }
+
+var (
+	// DefaultWID holds the default value on creation for the wID field.
+	DefaultWID string
+)
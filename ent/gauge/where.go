@@ -403,6 +403,34 @@ func HasMetricWith(preds ...predicate.Metric) predicate.Gauge {
 	})
 }
 
+// HasExecutor applies the HasEdge predicate on the "executor" edge.
+func HasExecutor() predicate.Gauge {
+	return predicate.Gauge(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.To(ExecutorTable, FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, ExecutorTable, ExecutorColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasExecutorWith applies the HasEdge predicate on the "executor" edge with a given conditions (other predicates).
+func HasExecutorWith(preds ...predicate.Executor) predicate.Gauge {
+	return predicate.Gauge(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.To(ExecutorInverseTable, FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, ExecutorTable, ExecutorColumn),
+		)
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
 // And groups list of predicates with the AND operator between them.
 func And(predicates ...predicate.Gauge) predicate.Gauge {
 	return predicate.Gauge(func(s *sql.Selector) {
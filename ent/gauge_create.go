@@ -9,6 +9,7 @@ import (
 
 	"github.com/facebook/ent/dialect/sql/sqlgraph"
 	"github.com/facebook/ent/schema/field"
+	"github.com/gobench-io/gobench/ent/executor"
 	"github.com/gobench-io/gobench/ent/gauge"
 	"github.com/gobench-io/gobench/ent/metric"
 )
@@ -38,6 +39,14 @@ func (gc *GaugeCreate) SetWID(s string) *GaugeCreate {
 	return gc
 }
 
+// SetNillableWID sets the wID field if the given value is not nil.
+func (gc *GaugeCreate) SetNillableWID(s *string) *GaugeCreate {
+	if s != nil {
+		gc.SetWID(*s)
+	}
+	return gc
+}
+
 // SetMetricID sets the metric edge to Metric by id.
 func (gc *GaugeCreate) SetMetricID(id int) *GaugeCreate {
 	gc.mutation.SetMetricID(id)
@@ -57,6 +66,25 @@ func (gc *GaugeCreate) SetMetric(m *Metric) *GaugeCreate {
 	return gc.SetMetricID(m.ID)
 }
 
+// SetExecutorID sets the executor edge to Executor by id.
+func (gc *GaugeCreate) SetExecutorID(id int) *GaugeCreate {
+	gc.mutation.SetExecutorID(id)
+	return gc
+}
+
+// SetNillableExecutorID sets the executor edge to Executor by id if the given value is not nil.
+func (gc *GaugeCreate) SetNillableExecutorID(id *int) *GaugeCreate {
+	if id != nil {
+		gc = gc.SetExecutorID(*id)
+	}
+	return gc
+}
+
+// SetExecutor sets the executor edge to Executor.
+func (gc *GaugeCreate) SetExecutor(e *Executor) *GaugeCreate {
+	return gc.SetExecutorID(e.ID)
+}
+
 // Mutation returns the GaugeMutation object of the builder.
 func (gc *GaugeCreate) Mutation() *GaugeMutation {
 	return gc.mutation
@@ -68,6 +96,7 @@ func (gc *GaugeCreate) Save(ctx context.Context) (*Gauge, error) {
 		err  error
 		node *Gauge
 	)
+	gc.defaults()
 	if len(gc.hooks) == 0 {
 		if err = gc.check(); err != nil {
 			return nil, err
@@ -106,6 +135,14 @@ func (gc *GaugeCreate) SaveX(ctx context.Context) *Gauge {
 	return v
 }
 
+// defaults sets the default values of the builder before save.
+func (gc *GaugeCreate) defaults() {
+	if _, ok := gc.mutation.WID(); !ok {
+		v := gauge.DefaultWID
+		gc.mutation.SetWID(v)
+	}
+}
+
 // check runs all checks and user-defined validators on the builder.
 func (gc *GaugeCreate) check() error {
 	if _, ok := gc.mutation.Time(); !ok {
@@ -187,6 +224,25 @@ func (gc *GaugeCreate) createSpec() (*Gauge, *sqlgraph.CreateSpec) {
 		}
 		_spec.Edges = append(_spec.Edges, edge)
 	}
+	if nodes := gc.mutation.ExecutorIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   gauge.ExecutorTable,
+			Columns: []string{gauge.ExecutorColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt,
+					Column: executor.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
 	return _node, _spec
 }
 
@@ -204,6 +260,7 @@ func (gcb *GaugeCreateBulk) Save(ctx context.Context) ([]*Gauge, error) {
 	for i := range gcb.builders {
 		func(i int, root context.Context) {
 			builder := gcb.builders[i]
+			builder.defaults()
 			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
 				mutation, ok := m.(*GaugeMutation)
 				if !ok {
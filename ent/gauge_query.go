@@ -11,6 +11,7 @@ import (
 	"github.com/facebook/ent/dialect/sql"
 	"github.com/facebook/ent/dialect/sql/sqlgraph"
 	"github.com/facebook/ent/schema/field"
+	"github.com/gobench-io/gobench/ent/executor"
 	"github.com/gobench-io/gobench/ent/gauge"
 	"github.com/gobench-io/gobench/ent/metric"
 	"github.com/gobench-io/gobench/ent/predicate"
@@ -25,8 +26,9 @@ type GaugeQuery struct {
 	unique     []string
 	predicates []predicate.Gauge
 	// eager-loading edges.
-	withMetric *MetricQuery
-	withFKs    bool
+	withMetric   *MetricQuery
+	withExecutor *ExecutorQuery
+	withFKs      bool
 	// intermediate query (i.e. traversal path).
 	sql  *sql.Selector
 	path func(context.Context) (*sql.Selector, error)
@@ -78,6 +80,28 @@ func (gq *GaugeQuery) QueryMetric() *MetricQuery {
 	return query
 }
 
+// QueryExecutor chains the current query on the executor edge.
+func (gq *GaugeQuery) QueryExecutor() *ExecutorQuery {
+	query := &ExecutorQuery{config: gq.config}
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := gq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := gq.sqlQuery()
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(gauge.Table, gauge.FieldID, selector),
+			sqlgraph.To(executor.Table, executor.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, gauge.ExecutorTable, gauge.ExecutorColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(gq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
 // First returns the first Gauge entity in the query. Returns *NotFoundError when no gauge was found.
 func (gq *GaugeQuery) First(ctx context.Context) (*Gauge, error) {
 	nodes, err := gq.Limit(1).All(ctx)
@@ -257,7 +281,8 @@ func (gq *GaugeQuery) Clone() *GaugeQuery {
 	}
 }
 
-//  WithMetric tells the query-builder to eager-loads the nodes that are connected to
+//	WithMetric tells the query-builder to eager-loads the nodes that are connected to
+//
 // the "metric" edge. The optional arguments used to configure the query builder of the edge.
 func (gq *GaugeQuery) WithMetric(opts ...func(*MetricQuery)) *GaugeQuery {
 	query := &MetricQuery{config: gq.config}
@@ -268,6 +293,18 @@ func (gq *GaugeQuery) WithMetric(opts ...func(*MetricQuery)) *GaugeQuery {
 	return gq
 }
 
+//	WithExecutor tells the query-builder to eager-loads the nodes that are connected to
+//
+// the "executor" edge. The optional arguments used to configure the query builder of the edge.
+func (gq *GaugeQuery) WithExecutor(opts ...func(*ExecutorQuery)) *GaugeQuery {
+	query := &ExecutorQuery{config: gq.config}
+	for _, opt := range opts {
+		opt(query)
+	}
+	gq.withExecutor = query
+	return gq
+}
+
 // GroupBy used to group vertices by one or more fields/columns.
 // It is often used with aggregate functions, like: count, max, mean, min, sum.
 //
@@ -282,7 +319,6 @@ func (gq *GaugeQuery) WithMetric(opts ...func(*MetricQuery)) *GaugeQuery {
 //		GroupBy(gauge.FieldTime).
 //		Aggregate(ent.Count()).
 //		Scan(ctx, &v)
-//
 func (gq *GaugeQuery) GroupBy(field string, fields ...string) *GaugeGroupBy {
 	group := &GaugeGroupBy{config: gq.config}
 	group.fields = append([]string{field}, fields...)
@@ -306,7 +342,6 @@ func (gq *GaugeQuery) GroupBy(field string, fields ...string) *GaugeGroupBy {
 //	client.Gauge.Query().
 //		Select(gauge.FieldTime).
 //		Scan(ctx, &v)
-//
 func (gq *GaugeQuery) Select(field string, fields ...string) *GaugeSelect {
 	selector := &GaugeSelect{config: gq.config}
 	selector.fields = append([]string{field}, fields...)
@@ -335,11 +370,12 @@ func (gq *GaugeQuery) sqlAll(ctx context.Context) ([]*Gauge, error) {
 		nodes       = []*Gauge{}
 		withFKs     = gq.withFKs
 		_spec       = gq.querySpec()
-		loadedTypes = [1]bool{
+		loadedTypes = [2]bool{
 			gq.withMetric != nil,
+			gq.withExecutor != nil,
 		}
 	)
-	if gq.withMetric != nil {
+	if gq.withMetric != nil || gq.withExecutor != nil {
 		withFKs = true
 	}
 	if withFKs {
@@ -394,6 +430,31 @@ func (gq *GaugeQuery) sqlAll(ctx context.Context) ([]*Gauge, error) {
 		}
 	}
 
+	if query := gq.withExecutor; query != nil {
+		ids := make([]int, 0, len(nodes))
+		nodeids := make(map[int][]*Gauge)
+		for i := range nodes {
+			if fk := nodes[i].executor_gauges; fk != nil {
+				ids = append(ids, *fk)
+				nodeids[*fk] = append(nodeids[*fk], nodes[i])
+			}
+		}
+		query.Where(executor.IDIn(ids...))
+		neighbors, err := query.All(ctx)
+		if err != nil {
+			return nil, err
+		}
+		for _, n := range neighbors {
+			nodes, ok := nodeids[n.ID]
+			if !ok {
+				return nil, fmt.Errorf(`unexpected foreign-key "executor_gauges" returned %v`, n.ID)
+			}
+			for i := range nodes {
+				nodes[i].Edges.Executor = n
+			}
+		}
+	}
+
 	return nodes, nil
 }
 
@@ -9,6 +9,7 @@ import (
 	"github.com/facebook/ent/dialect/sql"
 	"github.com/facebook/ent/dialect/sql/sqlgraph"
 	"github.com/facebook/ent/schema/field"
+	"github.com/gobench-io/gobench/ent/executor"
 	"github.com/gobench-io/gobench/ent/gauge"
 	"github.com/gobench-io/gobench/ent/metric"
 	"github.com/gobench-io/gobench/ent/predicate"
@@ -60,6 +61,14 @@ func (gu *GaugeUpdate) SetWID(s string) *GaugeUpdate {
 	return gu
 }
 
+// SetNillableWID sets the wID field if the given value is not nil.
+func (gu *GaugeUpdate) SetNillableWID(s *string) *GaugeUpdate {
+	if s != nil {
+		gu.SetWID(*s)
+	}
+	return gu
+}
+
 // SetMetricID sets the metric edge to Metric by id.
 func (gu *GaugeUpdate) SetMetricID(id int) *GaugeUpdate {
 	gu.mutation.SetMetricID(id)
@@ -79,6 +88,25 @@ func (gu *GaugeUpdate) SetMetric(m *Metric) *GaugeUpdate {
 	return gu.SetMetricID(m.ID)
 }
 
+// SetExecutorID sets the executor edge to Executor by id.
+func (gu *GaugeUpdate) SetExecutorID(id int) *GaugeUpdate {
+	gu.mutation.SetExecutorID(id)
+	return gu
+}
+
+// SetNillableExecutorID sets the executor edge to Executor by id if the given value is not nil.
+func (gu *GaugeUpdate) SetNillableExecutorID(id *int) *GaugeUpdate {
+	if id != nil {
+		gu = gu.SetExecutorID(*id)
+	}
+	return gu
+}
+
+// SetExecutor sets the executor edge to Executor.
+func (gu *GaugeUpdate) SetExecutor(e *Executor) *GaugeUpdate {
+	return gu.SetExecutorID(e.ID)
+}
+
 // Mutation returns the GaugeMutation object of the builder.
 func (gu *GaugeUpdate) Mutation() *GaugeMutation {
 	return gu.mutation
@@ -90,6 +118,12 @@ func (gu *GaugeUpdate) ClearMetric() *GaugeUpdate {
 	return gu
 }
 
+// ClearExecutor clears the "executor" edge to type Executor.
+func (gu *GaugeUpdate) ClearExecutor() *GaugeUpdate {
+	gu.mutation.ClearExecutor()
+	return gu
+}
+
 // Save executes the query and returns the number of rows/vertices matched by this operation.
 func (gu *GaugeUpdate) Save(ctx context.Context) (int, error) {
 	var (
@@ -229,6 +263,41 @@ func (gu *GaugeUpdate) sqlSave(ctx context.Context) (n int, err error) {
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
+	if gu.mutation.ExecutorCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   gauge.ExecutorTable,
+			Columns: []string{gauge.ExecutorColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt,
+					Column: executor.FieldID,
+				},
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := gu.mutation.ExecutorIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   gauge.ExecutorTable,
+			Columns: []string{gauge.ExecutorColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt,
+					Column: executor.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
 	if n, err = sqlgraph.UpdateNodes(ctx, gu.driver, _spec); err != nil {
 		if _, ok := err.(*sqlgraph.NotFoundError); ok {
 			err = &NotFoundError{gauge.Label}
@@ -279,6 +348,14 @@ func (guo *GaugeUpdateOne) SetWID(s string) *GaugeUpdateOne {
 	return guo
 }
 
+// SetNillableWID sets the wID field if the given value is not nil.
+func (guo *GaugeUpdateOne) SetNillableWID(s *string) *GaugeUpdateOne {
+	if s != nil {
+		guo.SetWID(*s)
+	}
+	return guo
+}
+
 // SetMetricID sets the metric edge to Metric by id.
 func (guo *GaugeUpdateOne) SetMetricID(id int) *GaugeUpdateOne {
 	guo.mutation.SetMetricID(id)
@@ -298,6 +375,25 @@ func (guo *GaugeUpdateOne) SetMetric(m *Metric) *GaugeUpdateOne {
 	return guo.SetMetricID(m.ID)
 }
 
+// SetExecutorID sets the executor edge to Executor by id.
+func (guo *GaugeUpdateOne) SetExecutorID(id int) *GaugeUpdateOne {
+	guo.mutation.SetExecutorID(id)
+	return guo
+}
+
+// SetNillableExecutorID sets the executor edge to Executor by id if the given value is not nil.
+func (guo *GaugeUpdateOne) SetNillableExecutorID(id *int) *GaugeUpdateOne {
+	if id != nil {
+		guo = guo.SetExecutorID(*id)
+	}
+	return guo
+}
+
+// SetExecutor sets the executor edge to Executor.
+func (guo *GaugeUpdateOne) SetExecutor(e *Executor) *GaugeUpdateOne {
+	return guo.SetExecutorID(e.ID)
+}
+
 // Mutation returns the GaugeMutation object of the builder.
 func (guo *GaugeUpdateOne) Mutation() *GaugeMutation {
 	return guo.mutation
@@ -309,6 +405,12 @@ func (guo *GaugeUpdateOne) ClearMetric() *GaugeUpdateOne {
 	return guo
 }
 
+// ClearExecutor clears the "executor" edge to type Executor.
+func (guo *GaugeUpdateOne) ClearExecutor() *GaugeUpdateOne {
+	guo.mutation.ClearExecutor()
+	return guo
+}
+
 // Save executes the query and returns the updated entity.
 func (guo *GaugeUpdateOne) Save(ctx context.Context) (*Gauge, error) {
 	var (
@@ -446,6 +548,41 @@ func (guo *GaugeUpdateOne) sqlSave(ctx context.Context) (_node *Gauge, err error
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
+	if guo.mutation.ExecutorCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   gauge.ExecutorTable,
+			Columns: []string{gauge.ExecutorColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt,
+					Column: executor.FieldID,
+				},
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := guo.mutation.ExecutorIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   gauge.ExecutorTable,
+			Columns: []string{gauge.ExecutorColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt,
+					Column: executor.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
 	_node = &Gauge{config: guo.config}
 	_spec.Assign = _node.assignValues
 	_spec.ScanValues = _node.scanValues()
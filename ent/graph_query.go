@@ -282,7 +282,8 @@ func (gq *GraphQuery) Clone() *GraphQuery {
 	}
 }
 
-//  WithGroup tells the query-builder to eager-loads the nodes that are connected to
+//	WithGroup tells the query-builder to eager-loads the nodes that are connected to
+//
 // the "group" edge. The optional arguments used to configure the query builder of the edge.
 func (gq *GraphQuery) WithGroup(opts ...func(*GroupQuery)) *GraphQuery {
 	query := &GroupQuery{config: gq.config}
@@ -293,7 +294,8 @@ func (gq *GraphQuery) WithGroup(opts ...func(*GroupQuery)) *GraphQuery {
 	return gq
 }
 
-//  WithMetrics tells the query-builder to eager-loads the nodes that are connected to
+//	WithMetrics tells the query-builder to eager-loads the nodes that are connected to
+//
 // the "metrics" edge. The optional arguments used to configure the query builder of the edge.
 func (gq *GraphQuery) WithMetrics(opts ...func(*MetricQuery)) *GraphQuery {
 	query := &MetricQuery{config: gq.config}
@@ -318,7 +320,6 @@ func (gq *GraphQuery) WithMetrics(opts ...func(*MetricQuery)) *GraphQuery {
 //		GroupBy(graph.FieldTitle).
 //		Aggregate(ent.Count()).
 //		Scan(ctx, &v)
-//
 func (gq *GraphQuery) GroupBy(field string, fields ...string) *GraphGroupBy {
 	group := &GraphGroupBy{config: gq.config}
 	group.fields = append([]string{field}, fields...)
@@ -342,7 +343,6 @@ func (gq *GraphQuery) GroupBy(field string, fields ...string) *GraphGroupBy {
 //	client.Graph.Query().
 //		Select(graph.FieldTitle).
 //		Scan(ctx, &v)
-//
 func (gq *GraphQuery) Select(field string, fields ...string) *GraphSelect {
 	selector := &GraphSelect{config: gq.config}
 	selector.fields = append([]string{field}, fields...)
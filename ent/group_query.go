@@ -282,7 +282,8 @@ func (gq *GroupQuery) Clone() *GroupQuery {
 	}
 }
 
-//  WithApplication tells the query-builder to eager-loads the nodes that are connected to
+//	WithApplication tells the query-builder to eager-loads the nodes that are connected to
+//
 // the "application" edge. The optional arguments used to configure the query builder of the edge.
 func (gq *GroupQuery) WithApplication(opts ...func(*ApplicationQuery)) *GroupQuery {
 	query := &ApplicationQuery{config: gq.config}
@@ -293,7 +294,8 @@ func (gq *GroupQuery) WithApplication(opts ...func(*ApplicationQuery)) *GroupQue
 	return gq
 }
 
-//  WithGraphs tells the query-builder to eager-loads the nodes that are connected to
+//	WithGraphs tells the query-builder to eager-loads the nodes that are connected to
+//
 // the "graphs" edge. The optional arguments used to configure the query builder of the edge.
 func (gq *GroupQuery) WithGraphs(opts ...func(*GraphQuery)) *GroupQuery {
 	query := &GraphQuery{config: gq.config}
@@ -318,7 +320,6 @@ func (gq *GroupQuery) WithGraphs(opts ...func(*GraphQuery)) *GroupQuery {
 //		GroupBy(group.FieldName).
 //		Aggregate(ent.Count()).
 //		Scan(ctx, &v)
-//
 func (gq *GroupQuery) GroupBy(field string, fields ...string) *GroupGroupBy {
 	group := &GroupGroupBy{config: gq.config}
 	group.fields = append([]string{field}, fields...)
@@ -342,7 +343,6 @@ func (gq *GroupQuery) GroupBy(field string, fields ...string) *GroupGroupBy {
 //	client.Group.Query().
 //		Select(group.FieldName).
 //		Scan(ctx, &v)
-//
 func (gq *GroupQuery) Select(field string, fields ...string) *GroupSelect {
 	selector := &GroupSelect{config: gq.config}
 	selector.fields = append([]string{field}, fields...)
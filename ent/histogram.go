@@ -7,6 +7,7 @@ import (
 	"strings"
 
 	"github.com/facebook/ent/dialect/sql"
+	"github.com/gobench-io/gobench/ent/executor"
 	"github.com/gobench-io/gobench/ent/histogram"
 	"github.com/gobench-io/gobench/ent/metric"
 )
@@ -39,20 +40,23 @@ type Histogram struct {
 	// P999 holds the value of the "p999" field.
 	P999 float64 `json:"p999"`
 	// WID holds the value of the "wID" field.
-	WID string `json:"wId"`
+	WID string `json:"-"`
 	// Edges holds the relations/edges for other nodes in the graph.
 	// The values are being populated by the HistogramQuery when eager-loading is set.
-	Edges             HistogramEdges `json:"edges"`
-	metric_histograms *int
+	Edges               HistogramEdges `json:"edges"`
+	executor_histograms *int
+	metric_histograms   *int
 }
 
 // HistogramEdges holds the relations/edges for other nodes in the graph.
 type HistogramEdges struct {
 	// Metric holds the value of the metric edge.
 	Metric *Metric
+	// Executor holds the value of the executor edge.
+	Executor *Executor
 	// loadedTypes holds the information for reporting if a
 	// type was loaded (or requested) in eager-loading or not.
-	loadedTypes [1]bool
+	loadedTypes [2]bool
 }
 
 // MetricOrErr returns the Metric value or an error if the edge
@@ -69,6 +73,20 @@ func (e HistogramEdges) MetricOrErr() (*Metric, error) {
 	return nil, &NotLoadedError{edge: "metric"}
 }
 
+// ExecutorOrErr returns the Executor value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e HistogramEdges) ExecutorOrErr() (*Executor, error) {
+	if e.loadedTypes[1] {
+		if e.Executor == nil {
+			// The edge executor was loaded in eager-loading,
+			// but was not found.
+			return nil, &NotFoundError{label: executor.Label}
+		}
+		return e.Executor, nil
+	}
+	return nil, &NotLoadedError{edge: "executor"}
+}
+
 // scanValues returns the types for scanning values from sql.Rows.
 func (*Histogram) scanValues() []interface{} {
 	return []interface{}{
@@ -91,6 +109,7 @@ func (*Histogram) scanValues() []interface{} {
 // fkValues returns the types for scanning foreign-keys values from sql.Rows.
 func (*Histogram) fkValues() []interface{} {
 	return []interface{}{
+		&sql.NullInt64{}, // executor_histograms
 		&sql.NullInt64{}, // metric_histograms
 	}
 }
@@ -170,6 +189,12 @@ func (h *Histogram) assignValues(values ...interface{}) error {
 	values = values[12:]
 	if len(values) == len(histogram.ForeignKeys) {
 		if value, ok := values[0].(*sql.NullInt64); !ok {
+			return fmt.Errorf("unexpected type %T for edge-field executor_histograms", value)
+		} else if value.Valid {
+			h.executor_histograms = new(int)
+			*h.executor_histograms = int(value.Int64)
+		}
+		if value, ok := values[1].(*sql.NullInt64); !ok {
 			return fmt.Errorf("unexpected type %T for edge-field metric_histograms", value)
 		} else if value.Valid {
 			h.metric_histograms = new(int)
@@ -184,6 +209,11 @@ func (h *Histogram) QueryMetric() *MetricQuery {
 	return (&HistogramClient{config: h.config}).QueryMetric(h)
 }
 
+// QueryExecutor queries the executor edge of the Histogram.
+func (h *Histogram) QueryExecutor() *ExecutorQuery {
+	return (&HistogramClient{config: h.config}).QueryExecutor(h)
+}
+
 // Update returns a builder for updating this Histogram.
 // Note that, you need to call Histogram.Unwrap() before calling this method, if this Histogram
 // was returned from a transaction, and the transaction was committed or rolled back.
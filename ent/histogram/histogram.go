@@ -34,6 +34,8 @@ const (
 
 	// EdgeMetric holds the string denoting the metric edge name in mutations.
 	EdgeMetric = "metric"
+	// EdgeExecutor holds the string denoting the executor edge name in mutations.
+	EdgeExecutor = "executor"
 
 	// Table holds the table name of the histogram in the database.
 	Table = "histograms"
@@ -44,6 +46,13 @@ const (
 	MetricInverseTable = "metrics"
 	// MetricColumn is the table column denoting the metric relation/edge.
 	MetricColumn = "metric_histograms"
+	// ExecutorTable is the table the holds the executor relation/edge.
+	ExecutorTable = "histograms"
+	// ExecutorInverseTable is the table name for the Executor entity.
+	// It exists in this package in order to avoid circular dependency with the "executor" package.
+	ExecutorInverseTable = "executors"
+	// ExecutorColumn is the table column denoting the executor relation/edge.
+	ExecutorColumn = "executor_histograms"
 )
 
 // Columns holds all SQL columns for histogram fields.
@@ -65,6 +74,7 @@ var Columns = []string{
 
 // ForeignKeys holds the SQL foreign-keys that are owned by the Histogram type.
 var ForeignKeys = []string{
+	"executor_histograms",
 	"metric_histograms",
 }
 
@@ -82,3 +92,8 @@ func ValidColumn(column string) bool {
 	}
 	return false
 }
+
+var (
+	// DefaultWID holds the default value on creation for the wID field.
+	DefaultWID string
+)
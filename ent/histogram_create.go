@@ -9,6 +9,7 @@ import (
 
 	"github.com/facebook/ent/dialect/sql/sqlgraph"
 	"github.com/facebook/ent/schema/field"
+	"github.com/gobench-io/gobench/ent/executor"
 	"github.com/gobench-io/gobench/ent/histogram"
 	"github.com/gobench-io/gobench/ent/metric"
 )
@@ -92,6 +93,14 @@ func (hc *HistogramCreate) SetWID(s string) *HistogramCreate {
 	return hc
 }
 
+// SetNillableWID sets the wID field if the given value is not nil.
+func (hc *HistogramCreate) SetNillableWID(s *string) *HistogramCreate {
+	if s != nil {
+		hc.SetWID(*s)
+	}
+	return hc
+}
+
 // SetMetricID sets the metric edge to Metric by id.
 func (hc *HistogramCreate) SetMetricID(id int) *HistogramCreate {
 	hc.mutation.SetMetricID(id)
@@ -111,6 +120,25 @@ func (hc *HistogramCreate) SetMetric(m *Metric) *HistogramCreate {
 	return hc.SetMetricID(m.ID)
 }
 
+// SetExecutorID sets the executor edge to Executor by id.
+func (hc *HistogramCreate) SetExecutorID(id int) *HistogramCreate {
+	hc.mutation.SetExecutorID(id)
+	return hc
+}
+
+// SetNillableExecutorID sets the executor edge to Executor by id if the given value is not nil.
+func (hc *HistogramCreate) SetNillableExecutorID(id *int) *HistogramCreate {
+	if id != nil {
+		hc = hc.SetExecutorID(*id)
+	}
+	return hc
+}
+
+// SetExecutor sets the executor edge to Executor.
+func (hc *HistogramCreate) SetExecutor(e *Executor) *HistogramCreate {
+	return hc.SetExecutorID(e.ID)
+}
+
 // Mutation returns the HistogramMutation object of the builder.
 func (hc *HistogramCreate) Mutation() *HistogramMutation {
 	return hc.mutation
@@ -122,6 +150,7 @@ func (hc *HistogramCreate) Save(ctx context.Context) (*Histogram, error) {
 		err  error
 		node *Histogram
 	)
+	hc.defaults()
 	if len(hc.hooks) == 0 {
 		if err = hc.check(); err != nil {
 			return nil, err
@@ -160,6 +189,14 @@ func (hc *HistogramCreate) SaveX(ctx context.Context) *Histogram {
 	return v
 }
 
+// defaults sets the default values of the builder before save.
+func (hc *HistogramCreate) defaults() {
+	if _, ok := hc.mutation.WID(); !ok {
+		v := histogram.DefaultWID
+		hc.mutation.SetWID(v)
+	}
+}
+
 // check runs all checks and user-defined validators on the builder.
 func (hc *HistogramCreate) check() error {
 	if _, ok := hc.mutation.Time(); !ok {
@@ -340,6 +377,25 @@ func (hc *HistogramCreate) createSpec() (*Histogram, *sqlgraph.CreateSpec) {
 		}
 		_spec.Edges = append(_spec.Edges, edge)
 	}
+	if nodes := hc.mutation.ExecutorIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   histogram.ExecutorTable,
+			Columns: []string{histogram.ExecutorColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt,
+					Column: executor.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
 	return _node, _spec
 }
 
@@ -357,6 +413,7 @@ func (hcb *HistogramCreateBulk) Save(ctx context.Context) ([]*Histogram, error)
 	for i := range hcb.builders {
 		func(i int, root context.Context) {
 			builder := hcb.builders[i]
+			builder.defaults()
 			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
 				mutation, ok := m.(*HistogramMutation)
 				if !ok {
@@ -11,6 +11,7 @@ import (
 	"github.com/facebook/ent/dialect/sql"
 	"github.com/facebook/ent/dialect/sql/sqlgraph"
 	"github.com/facebook/ent/schema/field"
+	"github.com/gobench-io/gobench/ent/executor"
 	"github.com/gobench-io/gobench/ent/histogram"
 	"github.com/gobench-io/gobench/ent/metric"
 	"github.com/gobench-io/gobench/ent/predicate"
@@ -25,8 +26,9 @@ type HistogramQuery struct {
 	unique     []string
 	predicates []predicate.Histogram
 	// eager-loading edges.
-	withMetric *MetricQuery
-	withFKs    bool
+	withMetric   *MetricQuery
+	withExecutor *ExecutorQuery
+	withFKs      bool
 	// intermediate query (i.e. traversal path).
 	sql  *sql.Selector
 	path func(context.Context) (*sql.Selector, error)
@@ -78,6 +80,28 @@ func (hq *HistogramQuery) QueryMetric() *MetricQuery {
 	return query
 }
 
+// QueryExecutor chains the current query on the executor edge.
+func (hq *HistogramQuery) QueryExecutor() *ExecutorQuery {
+	query := &ExecutorQuery{config: hq.config}
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := hq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := hq.sqlQuery()
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(histogram.Table, histogram.FieldID, selector),
+			sqlgraph.To(executor.Table, executor.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, histogram.ExecutorTable, histogram.ExecutorColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(hq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
 // First returns the first Histogram entity in the query. Returns *NotFoundError when no histogram was found.
 func (hq *HistogramQuery) First(ctx context.Context) (*Histogram, error) {
 	nodes, err := hq.Limit(1).All(ctx)
@@ -257,7 +281,8 @@ func (hq *HistogramQuery) Clone() *HistogramQuery {
 	}
 }
 
-//  WithMetric tells the query-builder to eager-loads the nodes that are connected to
+//	WithMetric tells the query-builder to eager-loads the nodes that are connected to
+//
 // the "metric" edge. The optional arguments used to configure the query builder of the edge.
 func (hq *HistogramQuery) WithMetric(opts ...func(*MetricQuery)) *HistogramQuery {
 	query := &MetricQuery{config: hq.config}
@@ -268,6 +293,18 @@ func (hq *HistogramQuery) WithMetric(opts ...func(*MetricQuery)) *HistogramQuery
 	return hq
 }
 
+//	WithExecutor tells the query-builder to eager-loads the nodes that are connected to
+//
+// the "executor" edge. The optional arguments used to configure the query builder of the edge.
+func (hq *HistogramQuery) WithExecutor(opts ...func(*ExecutorQuery)) *HistogramQuery {
+	query := &ExecutorQuery{config: hq.config}
+	for _, opt := range opts {
+		opt(query)
+	}
+	hq.withExecutor = query
+	return hq
+}
+
 // GroupBy used to group vertices by one or more fields/columns.
 // It is often used with aggregate functions, like: count, max, mean, min, sum.
 //
@@ -282,7 +319,6 @@ func (hq *HistogramQuery) WithMetric(opts ...func(*MetricQuery)) *HistogramQuery
 //		GroupBy(histogram.FieldTime).
 //		Aggregate(ent.Count()).
 //		Scan(ctx, &v)
-//
 func (hq *HistogramQuery) GroupBy(field string, fields ...string) *HistogramGroupBy {
 	group := &HistogramGroupBy{config: hq.config}
 	group.fields = append([]string{field}, fields...)
@@ -306,7 +342,6 @@ func (hq *HistogramQuery) GroupBy(field string, fields ...string) *HistogramGrou
 //	client.Histogram.Query().
 //		Select(histogram.FieldTime).
 //		Scan(ctx, &v)
-//
 func (hq *HistogramQuery) Select(field string, fields ...string) *HistogramSelect {
 	selector := &HistogramSelect{config: hq.config}
 	selector.fields = append([]string{field}, fields...)
@@ -335,11 +370,12 @@ func (hq *HistogramQuery) sqlAll(ctx context.Context) ([]*Histogram, error) {
 		nodes       = []*Histogram{}
 		withFKs     = hq.withFKs
 		_spec       = hq.querySpec()
-		loadedTypes = [1]bool{
+		loadedTypes = [2]bool{
 			hq.withMetric != nil,
+			hq.withExecutor != nil,
 		}
 	)
-	if hq.withMetric != nil {
+	if hq.withMetric != nil || hq.withExecutor != nil {
 		withFKs = true
 	}
 	if withFKs {
@@ -394,6 +430,31 @@ func (hq *HistogramQuery) sqlAll(ctx context.Context) ([]*Histogram, error) {
 		}
 	}
 
+	if query := hq.withExecutor; query != nil {
+		ids := make([]int, 0, len(nodes))
+		nodeids := make(map[int][]*Histogram)
+		for i := range nodes {
+			if fk := nodes[i].executor_histograms; fk != nil {
+				ids = append(ids, *fk)
+				nodeids[*fk] = append(nodeids[*fk], nodes[i])
+			}
+		}
+		query.Where(executor.IDIn(ids...))
+		neighbors, err := query.All(ctx)
+		if err != nil {
+			return nil, err
+		}
+		for _, n := range neighbors {
+			nodes, ok := nodeids[n.ID]
+			if !ok {
+				return nil, fmt.Errorf(`unexpected foreign-key "executor_histograms" returned %v`, n.ID)
+			}
+			for i := range nodes {
+				nodes[i].Edges.Executor = n
+			}
+		}
+	}
+
 	return nodes, nil
 }
 
@@ -9,6 +9,7 @@ import (
 	"github.com/facebook/ent/dialect/sql"
 	"github.com/facebook/ent/dialect/sql/sqlgraph"
 	"github.com/facebook/ent/schema/field"
+	"github.com/gobench-io/gobench/ent/executor"
 	"github.com/gobench-io/gobench/ent/histogram"
 	"github.com/gobench-io/gobench/ent/metric"
 	"github.com/gobench-io/gobench/ent/predicate"
@@ -177,6 +178,14 @@ func (hu *HistogramUpdate) SetWID(s string) *HistogramUpdate {
 	return hu
 }
 
+// SetNillableWID sets the wID field if the given value is not nil.
+func (hu *HistogramUpdate) SetNillableWID(s *string) *HistogramUpdate {
+	if s != nil {
+		hu.SetWID(*s)
+	}
+	return hu
+}
+
 // SetMetricID sets the metric edge to Metric by id.
 func (hu *HistogramUpdate) SetMetricID(id int) *HistogramUpdate {
 	hu.mutation.SetMetricID(id)
@@ -196,6 +205,25 @@ func (hu *HistogramUpdate) SetMetric(m *Metric) *HistogramUpdate {
 	return hu.SetMetricID(m.ID)
 }
 
+// SetExecutorID sets the executor edge to Executor by id.
+func (hu *HistogramUpdate) SetExecutorID(id int) *HistogramUpdate {
+	hu.mutation.SetExecutorID(id)
+	return hu
+}
+
+// SetNillableExecutorID sets the executor edge to Executor by id if the given value is not nil.
+func (hu *HistogramUpdate) SetNillableExecutorID(id *int) *HistogramUpdate {
+	if id != nil {
+		hu = hu.SetExecutorID(*id)
+	}
+	return hu
+}
+
+// SetExecutor sets the executor edge to Executor.
+func (hu *HistogramUpdate) SetExecutor(e *Executor) *HistogramUpdate {
+	return hu.SetExecutorID(e.ID)
+}
+
 // Mutation returns the HistogramMutation object of the builder.
 func (hu *HistogramUpdate) Mutation() *HistogramMutation {
 	return hu.mutation
@@ -207,6 +235,12 @@ func (hu *HistogramUpdate) ClearMetric() *HistogramUpdate {
 	return hu
 }
 
+// ClearExecutor clears the "executor" edge to type Executor.
+func (hu *HistogramUpdate) ClearExecutor() *HistogramUpdate {
+	hu.mutation.ClearExecutor()
+	return hu
+}
+
 // Save executes the query and returns the number of rows/vertices matched by this operation.
 func (hu *HistogramUpdate) Save(ctx context.Context) (int, error) {
 	var (
@@ -472,6 +506,41 @@ func (hu *HistogramUpdate) sqlSave(ctx context.Context) (n int, err error) {
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
+	if hu.mutation.ExecutorCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   histogram.ExecutorTable,
+			Columns: []string{histogram.ExecutorColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt,
+					Column: executor.FieldID,
+				},
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := hu.mutation.ExecutorIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   histogram.ExecutorTable,
+			Columns: []string{histogram.ExecutorColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt,
+					Column: executor.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
 	if n, err = sqlgraph.UpdateNodes(ctx, hu.driver, _spec); err != nil {
 		if _, ok := err.(*sqlgraph.NotFoundError); ok {
 			err = &NotFoundError{histogram.Label}
@@ -639,6 +708,14 @@ func (huo *HistogramUpdateOne) SetWID(s string) *HistogramUpdateOne {
 	return huo
 }
 
+// SetNillableWID sets the wID field if the given value is not nil.
+func (huo *HistogramUpdateOne) SetNillableWID(s *string) *HistogramUpdateOne {
+	if s != nil {
+		huo.SetWID(*s)
+	}
+	return huo
+}
+
 // SetMetricID sets the metric edge to Metric by id.
 func (huo *HistogramUpdateOne) SetMetricID(id int) *HistogramUpdateOne {
 	huo.mutation.SetMetricID(id)
@@ -658,6 +735,25 @@ func (huo *HistogramUpdateOne) SetMetric(m *Metric) *HistogramUpdateOne {
 	return huo.SetMetricID(m.ID)
 }
 
+// SetExecutorID sets the executor edge to Executor by id.
+func (huo *HistogramUpdateOne) SetExecutorID(id int) *HistogramUpdateOne {
+	huo.mutation.SetExecutorID(id)
+	return huo
+}
+
+// SetNillableExecutorID sets the executor edge to Executor by id if the given value is not nil.
+func (huo *HistogramUpdateOne) SetNillableExecutorID(id *int) *HistogramUpdateOne {
+	if id != nil {
+		huo = huo.SetExecutorID(*id)
+	}
+	return huo
+}
+
+// SetExecutor sets the executor edge to Executor.
+func (huo *HistogramUpdateOne) SetExecutor(e *Executor) *HistogramUpdateOne {
+	return huo.SetExecutorID(e.ID)
+}
+
 // Mutation returns the HistogramMutation object of the builder.
 func (huo *HistogramUpdateOne) Mutation() *HistogramMutation {
 	return huo.mutation
@@ -669,6 +765,12 @@ func (huo *HistogramUpdateOne) ClearMetric() *HistogramUpdateOne {
 	return huo
 }
 
+// ClearExecutor clears the "executor" edge to type Executor.
+func (huo *HistogramUpdateOne) ClearExecutor() *HistogramUpdateOne {
+	huo.mutation.ClearExecutor()
+	return huo
+}
+
 // Save executes the query and returns the updated entity.
 func (huo *HistogramUpdateOne) Save(ctx context.Context) (*Histogram, error) {
 	var (
@@ -932,6 +1034,41 @@ func (huo *HistogramUpdateOne) sqlSave(ctx context.Context) (_node *Histogram, e
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
+	if huo.mutation.ExecutorCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   histogram.ExecutorTable,
+			Columns: []string{histogram.ExecutorColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt,
+					Column: executor.FieldID,
+				},
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := huo.mutation.ExecutorIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   histogram.ExecutorTable,
+			Columns: []string{histogram.ExecutorColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt,
+					Column: executor.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
 	_node = &Histogram{config: huo.config}
 	_spec.Assign = _node.assignValues
 	_spec.ScanValues = _node.scanValues()
@@ -20,6 +20,8 @@ type Metric struct {
 	Title string `json:"title"`
 	// Type holds the value of the "type" field.
 	Type string `json:"type"`
+	// DownsampledUntil holds the value of the "downsampled_until" field.
+	DownsampledUntil int64 `json:"-"`
 	// Edges holds the relations/edges for other nodes in the graph.
 	// The values are being populated by the MetricQuery when eager-loading is set.
 	Edges         MetricEdges `json:"edges"`
@@ -88,6 +90,7 @@ func (*Metric) scanValues() []interface{} {
 		&sql.NullInt64{},  // id
 		&sql.NullString{}, // title
 		&sql.NullString{}, // type
+		&sql.NullInt64{},  // downsampled_until
 	}
 }
 
@@ -120,7 +123,12 @@ func (m *Metric) assignValues(values ...interface{}) error {
 	} else if value.Valid {
 		m.Type = value.String
 	}
-	values = values[2:]
+	if value, ok := values[2].(*sql.NullInt64); !ok {
+		return fmt.Errorf("unexpected type %T for field downsampled_until", values[2])
+	} else if value.Valid {
+		m.DownsampledUntil = value.Int64
+	}
+	values = values[3:]
 	if len(values) == len(metric.ForeignKeys) {
 		if value, ok := values[0].(*sql.NullInt64); !ok {
 			return fmt.Errorf("unexpected type %T for edge-field graph_metrics", value)
@@ -179,6 +187,8 @@ func (m *Metric) String() string {
 	builder.WriteString(m.Title)
 	builder.WriteString(", type=")
 	builder.WriteString(m.Type)
+	builder.WriteString(", downsampled_until=")
+	builder.WriteString(fmt.Sprintf("%v", m.DownsampledUntil))
 	builder.WriteByte(')')
 	return builder.String()
 }
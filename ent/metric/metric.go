@@ -11,6 +11,8 @@ const (
 	FieldTitle = "title"
 	// FieldType holds the string denoting the type field in the database.
 	FieldType = "type"
+	// FieldDownsampledUntil holds the string denoting the downsampled_until field in the database.
+	FieldDownsampledUntil = "downsampled_until"
 
 	// EdgeGraph holds the string denoting the graph edge name in mutations.
 	EdgeGraph = "graph"
@@ -58,6 +60,7 @@ var Columns = []string{
 	FieldID,
 	FieldTitle,
 	FieldType,
+	FieldDownsampledUntil,
 }
 
 // ForeignKeys holds the SQL foreign-keys that are owned by the Metric type.
@@ -105,6 +105,13 @@ func Type(v string) predicate.Metric {
 	})
 }
 
+// DownsampledUntil applies equality check predicate on the "downsampled_until" field. It's identical to DownsampledUntilEQ.
+func DownsampledUntil(v int64) predicate.Metric {
+	return predicate.Metric(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldDownsampledUntil), v))
+	})
+}
+
 // TitleEQ applies the EQ predicate on the "title" field.
 func TitleEQ(v string) predicate.Metric {
 	return predicate.Metric(func(s *sql.Selector) {
@@ -327,6 +334,96 @@ func TypeContainsFold(v string) predicate.Metric {
 	})
 }
 
+// DownsampledUntilEQ applies the EQ predicate on the "downsampled_until" field.
+func DownsampledUntilEQ(v int64) predicate.Metric {
+	return predicate.Metric(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldDownsampledUntil), v))
+	})
+}
+
+// DownsampledUntilNEQ applies the NEQ predicate on the "downsampled_until" field.
+func DownsampledUntilNEQ(v int64) predicate.Metric {
+	return predicate.Metric(func(s *sql.Selector) {
+		s.Where(sql.NEQ(s.C(FieldDownsampledUntil), v))
+	})
+}
+
+// DownsampledUntilIn applies the In predicate on the "downsampled_until" field.
+func DownsampledUntilIn(vs ...int64) predicate.Metric {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.Metric(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.In(s.C(FieldDownsampledUntil), v...))
+	})
+}
+
+// DownsampledUntilNotIn applies the NotIn predicate on the "downsampled_until" field.
+func DownsampledUntilNotIn(vs ...int64) predicate.Metric {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.Metric(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.NotIn(s.C(FieldDownsampledUntil), v...))
+	})
+}
+
+// DownsampledUntilGT applies the GT predicate on the "downsampled_until" field.
+func DownsampledUntilGT(v int64) predicate.Metric {
+	return predicate.Metric(func(s *sql.Selector) {
+		s.Where(sql.GT(s.C(FieldDownsampledUntil), v))
+	})
+}
+
+// DownsampledUntilGTE applies the GTE predicate on the "downsampled_until" field.
+func DownsampledUntilGTE(v int64) predicate.Metric {
+	return predicate.Metric(func(s *sql.Selector) {
+		s.Where(sql.GTE(s.C(FieldDownsampledUntil), v))
+	})
+}
+
+// DownsampledUntilLT applies the LT predicate on the "downsampled_until" field.
+func DownsampledUntilLT(v int64) predicate.Metric {
+	return predicate.Metric(func(s *sql.Selector) {
+		s.Where(sql.LT(s.C(FieldDownsampledUntil), v))
+	})
+}
+
+// DownsampledUntilLTE applies the LTE predicate on the "downsampled_until" field.
+func DownsampledUntilLTE(v int64) predicate.Metric {
+	return predicate.Metric(func(s *sql.Selector) {
+		s.Where(sql.LTE(s.C(FieldDownsampledUntil), v))
+	})
+}
+
+// DownsampledUntilIsNil applies the IsNil predicate on the "downsampled_until" field.
+func DownsampledUntilIsNil() predicate.Metric {
+	return predicate.Metric(func(s *sql.Selector) {
+		s.Where(sql.IsNull(s.C(FieldDownsampledUntil)))
+	})
+}
+
+// DownsampledUntilNotNil applies the NotNil predicate on the "downsampled_until" field.
+func DownsampledUntilNotNil() predicate.Metric {
+	return predicate.Metric(func(s *sql.Selector) {
+		s.Where(sql.NotNull(s.C(FieldDownsampledUntil)))
+	})
+}
+
 // HasGraph applies the HasEdge predicate on the "graph" edge.
 func HasGraph() predicate.Metric {
 	return predicate.Metric(func(s *sql.Selector) {
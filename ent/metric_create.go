@@ -35,6 +35,20 @@ func (mc *MetricCreate) SetType(s string) *MetricCreate {
 	return mc
 }
 
+// SetDownsampledUntil sets the downsampled_until field.
+func (mc *MetricCreate) SetDownsampledUntil(i int64) *MetricCreate {
+	mc.mutation.SetDownsampledUntil(i)
+	return mc
+}
+
+// SetNillableDownsampledUntil sets the downsampled_until field if the given value is not nil.
+func (mc *MetricCreate) SetNillableDownsampledUntil(i *int64) *MetricCreate {
+	if i != nil {
+		mc.SetDownsampledUntil(*i)
+	}
+	return mc
+}
+
 // SetGraphID sets the graph edge to Graph by id.
 func (mc *MetricCreate) SetGraphID(id int) *MetricCreate {
 	mc.mutation.SetGraphID(id)
@@ -199,6 +213,14 @@ func (mc *MetricCreate) createSpec() (*Metric, *sqlgraph.CreateSpec) {
 		})
 		_node.Type = value
 	}
+	if value, ok := mc.mutation.DownsampledUntil(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt64,
+			Value:  value,
+			Column: metric.FieldDownsampledUntil,
+		})
+		_node.DownsampledUntil = value
+	}
 	if nodes := mc.mutation.GraphIDs(); len(nodes) > 0 {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.M2O,
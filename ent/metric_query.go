@@ -330,7 +330,8 @@ func (mq *MetricQuery) Clone() *MetricQuery {
 	}
 }
 
-//  WithGraph tells the query-builder to eager-loads the nodes that are connected to
+//	WithGraph tells the query-builder to eager-loads the nodes that are connected to
+//
 // the "graph" edge. The optional arguments used to configure the query builder of the edge.
 func (mq *MetricQuery) WithGraph(opts ...func(*GraphQuery)) *MetricQuery {
 	query := &GraphQuery{config: mq.config}
@@ -341,7 +342,8 @@ func (mq *MetricQuery) WithGraph(opts ...func(*GraphQuery)) *MetricQuery {
 	return mq
 }
 
-//  WithHistograms tells the query-builder to eager-loads the nodes that are connected to
+//	WithHistograms tells the query-builder to eager-loads the nodes that are connected to
+//
 // the "histograms" edge. The optional arguments used to configure the query builder of the edge.
 func (mq *MetricQuery) WithHistograms(opts ...func(*HistogramQuery)) *MetricQuery {
 	query := &HistogramQuery{config: mq.config}
@@ -352,7 +354,8 @@ func (mq *MetricQuery) WithHistograms(opts ...func(*HistogramQuery)) *MetricQuer
 	return mq
 }
 
-//  WithCounters tells the query-builder to eager-loads the nodes that are connected to
+//	WithCounters tells the query-builder to eager-loads the nodes that are connected to
+//
 // the "counters" edge. The optional arguments used to configure the query builder of the edge.
 func (mq *MetricQuery) WithCounters(opts ...func(*CounterQuery)) *MetricQuery {
 	query := &CounterQuery{config: mq.config}
@@ -363,7 +366,8 @@ func (mq *MetricQuery) WithCounters(opts ...func(*CounterQuery)) *MetricQuery {
 	return mq
 }
 
-//  WithGauges tells the query-builder to eager-loads the nodes that are connected to
+//	WithGauges tells the query-builder to eager-loads the nodes that are connected to
+//
 // the "gauges" edge. The optional arguments used to configure the query builder of the edge.
 func (mq *MetricQuery) WithGauges(opts ...func(*GaugeQuery)) *MetricQuery {
 	query := &GaugeQuery{config: mq.config}
@@ -388,7 +392,6 @@ func (mq *MetricQuery) WithGauges(opts ...func(*GaugeQuery)) *MetricQuery {
 //		GroupBy(metric.FieldTitle).
 //		Aggregate(ent.Count()).
 //		Scan(ctx, &v)
-//
 func (mq *MetricQuery) GroupBy(field string, fields ...string) *MetricGroupBy {
 	group := &MetricGroupBy{config: mq.config}
 	group.fields = append([]string{field}, fields...)
@@ -412,7 +415,6 @@ func (mq *MetricQuery) GroupBy(field string, fields ...string) *MetricGroupBy {
 //	client.Metric.Query().
 //		Select(metric.FieldTitle).
 //		Scan(ctx, &v)
-//
 func (mq *MetricQuery) Select(field string, fields ...string) *MetricSelect {
 	selector := &MetricSelect{config: mq.config}
 	selector.fields = append([]string{field}, fields...)
@@ -37,6 +37,33 @@ func (mu *MetricUpdate) SetType(s string) *MetricUpdate {
 	return mu
 }
 
+// SetDownsampledUntil sets the downsampled_until field.
+func (mu *MetricUpdate) SetDownsampledUntil(i int64) *MetricUpdate {
+	mu.mutation.ResetDownsampledUntil()
+	mu.mutation.SetDownsampledUntil(i)
+	return mu
+}
+
+// SetNillableDownsampledUntil sets the downsampled_until field if the given value is not nil.
+func (mu *MetricUpdate) SetNillableDownsampledUntil(i *int64) *MetricUpdate {
+	if i != nil {
+		mu.SetDownsampledUntil(*i)
+	}
+	return mu
+}
+
+// AddDownsampledUntil adds i to downsampled_until.
+func (mu *MetricUpdate) AddDownsampledUntil(i int64) *MetricUpdate {
+	mu.mutation.AddDownsampledUntil(i)
+	return mu
+}
+
+// ClearDownsampledUntil clears the value of downsampled_until.
+func (mu *MetricUpdate) ClearDownsampledUntil() *MetricUpdate {
+	mu.mutation.ClearDownsampledUntil()
+	return mu
+}
+
 // SetGraphID sets the graph edge to Graph by id.
 func (mu *MetricUpdate) SetGraphID(id int) *MetricUpdate {
 	mu.mutation.SetGraphID(id)
@@ -251,6 +278,26 @@ func (mu *MetricUpdate) sqlSave(ctx context.Context) (n int, err error) {
 			Column: metric.FieldType,
 		})
 	}
+	if value, ok := mu.mutation.DownsampledUntil(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt64,
+			Value:  value,
+			Column: metric.FieldDownsampledUntil,
+		})
+	}
+	if value, ok := mu.mutation.AddedDownsampledUntil(); ok {
+		_spec.Fields.Add = append(_spec.Fields.Add, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt64,
+			Value:  value,
+			Column: metric.FieldDownsampledUntil,
+		})
+	}
+	if mu.mutation.DownsampledUntilCleared() {
+		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt64,
+			Column: metric.FieldDownsampledUntil,
+		})
+	}
 	if mu.mutation.GraphCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.M2O,
@@ -472,6 +519,33 @@ func (muo *MetricUpdateOne) SetType(s string) *MetricUpdateOne {
 	return muo
 }
 
+// SetDownsampledUntil sets the downsampled_until field.
+func (muo *MetricUpdateOne) SetDownsampledUntil(i int64) *MetricUpdateOne {
+	muo.mutation.ResetDownsampledUntil()
+	muo.mutation.SetDownsampledUntil(i)
+	return muo
+}
+
+// SetNillableDownsampledUntil sets the downsampled_until field if the given value is not nil.
+func (muo *MetricUpdateOne) SetNillableDownsampledUntil(i *int64) *MetricUpdateOne {
+	if i != nil {
+		muo.SetDownsampledUntil(*i)
+	}
+	return muo
+}
+
+// AddDownsampledUntil adds i to downsampled_until.
+func (muo *MetricUpdateOne) AddDownsampledUntil(i int64) *MetricUpdateOne {
+	muo.mutation.AddDownsampledUntil(i)
+	return muo
+}
+
+// ClearDownsampledUntil clears the value of downsampled_until.
+func (muo *MetricUpdateOne) ClearDownsampledUntil() *MetricUpdateOne {
+	muo.mutation.ClearDownsampledUntil()
+	return muo
+}
+
 // SetGraphID sets the graph edge to Graph by id.
 func (muo *MetricUpdateOne) SetGraphID(id int) *MetricUpdateOne {
 	muo.mutation.SetGraphID(id)
@@ -684,6 +758,26 @@ func (muo *MetricUpdateOne) sqlSave(ctx context.Context) (_node *Metric, err err
 			Column: metric.FieldType,
 		})
 	}
+	if value, ok := muo.mutation.DownsampledUntil(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt64,
+			Value:  value,
+			Column: metric.FieldDownsampledUntil,
+		})
+	}
+	if value, ok := muo.mutation.AddedDownsampledUntil(); ok {
+		_spec.Fields.Add = append(_spec.Fields.Add, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt64,
+			Value:  value,
+			Column: metric.FieldDownsampledUntil,
+		})
+	}
+	if muo.mutation.DownsampledUntilCleared() {
+		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt64,
+			Column: metric.FieldDownsampledUntil,
+		})
+	}
 	if muo.mutation.GraphCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.M2O,
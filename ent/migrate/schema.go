@@ -8,6 +8,33 @@ import (
 )
 
 var (
+	// APITokensColumns holds the columns for the "api_tokens" table.
+	APITokensColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt, Increment: true},
+		{Name: "name", Type: field.TypeString, Size: 255},
+		{Name: "token_hash", Type: field.TypeString, Unique: true},
+		{Name: "prefix", Type: field.TypeString},
+		{Name: "scope", Type: field.TypeEnum, Enums: []string{"read", "write", "admin"}, Default: "read"},
+		{Name: "last_used_at", Type: field.TypeTime, Nullable: true},
+		{Name: "expires_at", Type: field.TypeTime, Nullable: true},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "user_tokens", Type: field.TypeInt, Nullable: true},
+	}
+	// APITokensTable holds the schema information for the "api_tokens" table.
+	APITokensTable = &schema.Table{
+		Name:       "api_tokens",
+		Columns:    APITokensColumns,
+		PrimaryKey: []*schema.Column{APITokensColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:  "api_tokens_users_tokens",
+				Columns: []*schema.Column{APITokensColumns[8]},
+
+				RefColumns: []*schema.Column{UsersColumns[0]},
+				OnDelete:   schema.SetNull,
+			},
+		},
+	}
 	// ApplicationsColumns holds the columns for the "applications" table.
 	ApplicationsColumns = []*schema.Column{
 		{Name: "id", Type: field.TypeInt, Increment: true},
@@ -16,23 +43,99 @@ var (
 		{Name: "created_at", Type: field.TypeTime},
 		{Name: "started_at", Type: field.TypeTime, Nullable: true},
 		{Name: "updated_at", Type: field.TypeTime},
+		{Name: "finished_at", Type: field.TypeTime, Nullable: true},
 		{Name: "scenario", Type: field.TypeString, Size: 2147483647},
 		{Name: "gomod", Type: field.TypeString, Size: 2147483647, Default: ""},
 		{Name: "gosum", Type: field.TypeString, Size: 2147483647, Default: ""},
+		{Name: "sinks", Type: field.TypeJSON, Nullable: true},
+		{Name: "sink_tokens", Type: field.TypeString, Nullable: true, Size: 2147483647},
+		{Name: "imported", Type: field.TypeBool, Nullable: true},
+		{Name: "verdict", Type: field.TypeString, Nullable: true},
+		{Name: "checks", Type: field.TypeJSON, Nullable: true},
+		{Name: "baseline_app_id", Type: field.TypeInt, Nullable: true},
+		{Name: "project_applications", Type: field.TypeInt, Nullable: true},
 	}
 	// ApplicationsTable holds the schema information for the "applications" table.
 	ApplicationsTable = &schema.Table{
-		Name:        "applications",
-		Columns:     ApplicationsColumns,
-		PrimaryKey:  []*schema.Column{ApplicationsColumns[0]},
+		Name:       "applications",
+		Columns:    ApplicationsColumns,
+		PrimaryKey: []*schema.Column{ApplicationsColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:  "applications_projects_applications",
+				Columns: []*schema.Column{ApplicationsColumns[16]},
+
+				RefColumns: []*schema.Column{ProjectsColumns[0]},
+				OnDelete:   schema.SetNull,
+			},
+		},
+	}
+	// AuditsColumns holds the columns for the "audits" table.
+	AuditsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt, Increment: true},
+		{Name: "username", Type: field.TypeString},
+		{Name: "action", Type: field.TypeEnum, Enums: []string{"login", "login_failed", "create", "import", "cancel", "delete", "tag", "untag"}},
+		{Name: "application_id", Type: field.TypeInt, Nullable: true},
+		{Name: "tag", Type: field.TypeString, Nullable: true},
+		{Name: "ip", Type: field.TypeString},
+		{Name: "created_at", Type: field.TypeTime},
+	}
+	// AuditsTable holds the schema information for the "audits" table.
+	AuditsTable = &schema.Table{
+		Name:        "audits",
+		Columns:     AuditsColumns,
+		PrimaryKey:  []*schema.Column{AuditsColumns[0]},
 		ForeignKeys: []*schema.ForeignKey{},
+		Indexes: []*schema.Index{
+			{
+				Name:    "audit_created_at",
+				Unique:  false,
+				Columns: []*schema.Column{AuditsColumns[6]},
+			},
+			{
+				Name:    "audit_username",
+				Unique:  false,
+				Columns: []*schema.Column{AuditsColumns[1]},
+			},
+			{
+				Name:    "audit_application_id",
+				Unique:  false,
+				Columns: []*schema.Column{AuditsColumns[3]},
+			},
+		},
+	}
+	// BaselinesColumns holds the columns for the "baselines" table.
+	BaselinesColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt, Increment: true},
+		{Name: "tag", Type: field.TypeString, Unique: true, Size: 255},
+		{Name: "tolerance", Type: field.TypeFloat64, Default: 10},
+		{Name: "tolerances", Type: field.TypeJSON, Nullable: true},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "updated_at", Type: field.TypeTime},
+		{Name: "application_baselines", Type: field.TypeInt, Nullable: true},
+	}
+	// BaselinesTable holds the schema information for the "baselines" table.
+	BaselinesTable = &schema.Table{
+		Name:       "baselines",
+		Columns:    BaselinesColumns,
+		PrimaryKey: []*schema.Column{BaselinesColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:  "baselines_applications_baselines",
+				Columns: []*schema.Column{BaselinesColumns[6]},
+
+				RefColumns: []*schema.Column{ApplicationsColumns[0]},
+				OnDelete:   schema.SetNull,
+			},
+		},
 	}
 	// CountersColumns holds the columns for the "counters" table.
 	CountersColumns = []*schema.Column{
 		{Name: "id", Type: field.TypeInt, Increment: true},
 		{Name: "time", Type: field.TypeInt64},
 		{Name: "count", Type: field.TypeInt64},
-		{Name: "w_id", Type: field.TypeString},
+		{Name: "w_id", Type: field.TypeString, Default: ""},
+		{Name: "executor_counters", Type: field.TypeInt, Nullable: true},
 		{Name: "metric_counters", Type: field.TypeInt, Nullable: true},
 	}
 	// CountersTable holds the schema information for the "counters" table.
@@ -42,20 +145,88 @@ var (
 		PrimaryKey: []*schema.Column{CountersColumns[0]},
 		ForeignKeys: []*schema.ForeignKey{
 			{
-				Symbol:  "counters_metrics_counters",
+				Symbol:  "counters_executors_counters",
 				Columns: []*schema.Column{CountersColumns[4]},
 
+				RefColumns: []*schema.Column{ExecutorsColumns[0]},
+				OnDelete:   schema.SetNull,
+			},
+			{
+				Symbol:  "counters_metrics_counters",
+				Columns: []*schema.Column{CountersColumns[5]},
+
 				RefColumns: []*schema.Column{MetricsColumns[0]},
 				OnDelete:   schema.SetNull,
 			},
 		},
 	}
+	// EventsColumns holds the columns for the "events" table.
+	EventsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt, Increment: true},
+		{Name: "name", Type: field.TypeString},
+		{Name: "time", Type: field.TypeInt64},
+		{Name: "source", Type: field.TypeString},
+		{Name: "w_id", Type: field.TypeString, Nullable: true},
+		{Name: "attrs", Type: field.TypeJSON, Nullable: true},
+		{Name: "application_events", Type: field.TypeInt, Nullable: true},
+	}
+	// EventsTable holds the schema information for the "events" table.
+	EventsTable = &schema.Table{
+		Name:       "events",
+		Columns:    EventsColumns,
+		PrimaryKey: []*schema.Column{EventsColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:  "events_applications_events",
+				Columns: []*schema.Column{EventsColumns[6]},
+
+				RefColumns: []*schema.Column{ApplicationsColumns[0]},
+				OnDelete:   schema.SetNull,
+			},
+		},
+		Indexes: []*schema.Index{
+			{
+				Name:    "event_time_application_events",
+				Unique:  false,
+				Columns: []*schema.Column{EventsColumns[2], EventsColumns[6]},
+			},
+		},
+	}
+	// ExecutorsColumns holds the columns for the "executors" table.
+	ExecutorsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt, Increment: true},
+		{Name: "e_id", Type: field.TypeString},
+		{Name: "application_executors", Type: field.TypeInt, Nullable: true},
+	}
+	// ExecutorsTable holds the schema information for the "executors" table.
+	ExecutorsTable = &schema.Table{
+		Name:       "executors",
+		Columns:    ExecutorsColumns,
+		PrimaryKey: []*schema.Column{ExecutorsColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:  "executors_applications_executors",
+				Columns: []*schema.Column{ExecutorsColumns[2]},
+
+				RefColumns: []*schema.Column{ApplicationsColumns[0]},
+				OnDelete:   schema.SetNull,
+			},
+		},
+		Indexes: []*schema.Index{
+			{
+				Name:    "executor_e_id_application_executors",
+				Unique:  true,
+				Columns: []*schema.Column{ExecutorsColumns[1], ExecutorsColumns[2]},
+			},
+		},
+	}
 	// GaugesColumns holds the columns for the "gauges" table.
 	GaugesColumns = []*schema.Column{
 		{Name: "id", Type: field.TypeInt, Increment: true},
 		{Name: "time", Type: field.TypeInt64},
 		{Name: "value", Type: field.TypeInt64},
-		{Name: "w_id", Type: field.TypeString},
+		{Name: "w_id", Type: field.TypeString, Default: ""},
+		{Name: "executor_gauges", Type: field.TypeInt, Nullable: true},
 		{Name: "metric_gauges", Type: field.TypeInt, Nullable: true},
 	}
 	// GaugesTable holds the schema information for the "gauges" table.
@@ -65,9 +236,16 @@ var (
 		PrimaryKey: []*schema.Column{GaugesColumns[0]},
 		ForeignKeys: []*schema.ForeignKey{
 			{
-				Symbol:  "gauges_metrics_gauges",
+				Symbol:  "gauges_executors_gauges",
 				Columns: []*schema.Column{GaugesColumns[4]},
 
+				RefColumns: []*schema.Column{ExecutorsColumns[0]},
+				OnDelete:   schema.SetNull,
+			},
+			{
+				Symbol:  "gauges_metrics_gauges",
+				Columns: []*schema.Column{GaugesColumns[5]},
+
 				RefColumns: []*schema.Column{MetricsColumns[0]},
 				OnDelete:   schema.SetNull,
 			},
@@ -130,7 +308,8 @@ var (
 		{Name: "p95", Type: field.TypeFloat64},
 		{Name: "p99", Type: field.TypeFloat64},
 		{Name: "p999", Type: field.TypeFloat64},
-		{Name: "w_id", Type: field.TypeString},
+		{Name: "w_id", Type: field.TypeString, Default: ""},
+		{Name: "executor_histograms", Type: field.TypeInt, Nullable: true},
 		{Name: "metric_histograms", Type: field.TypeInt, Nullable: true},
 	}
 	// HistogramsTable holds the schema information for the "histograms" table.
@@ -140,9 +319,16 @@ var (
 		PrimaryKey: []*schema.Column{HistogramsColumns[0]},
 		ForeignKeys: []*schema.ForeignKey{
 			{
-				Symbol:  "histograms_metrics_histograms",
+				Symbol:  "histograms_executors_histograms",
 				Columns: []*schema.Column{HistogramsColumns[13]},
 
+				RefColumns: []*schema.Column{ExecutorsColumns[0]},
+				OnDelete:   schema.SetNull,
+			},
+			{
+				Symbol:  "histograms_metrics_histograms",
+				Columns: []*schema.Column{HistogramsColumns[14]},
+
 				RefColumns: []*schema.Column{MetricsColumns[0]},
 				OnDelete:   schema.SetNull,
 			},
@@ -153,6 +339,7 @@ var (
 		{Name: "id", Type: field.TypeInt, Increment: true},
 		{Name: "title", Type: field.TypeString},
 		{Name: "type", Type: field.TypeString},
+		{Name: "downsampled_until", Type: field.TypeInt64, Nullable: true},
 		{Name: "graph_metrics", Type: field.TypeInt, Nullable: true},
 	}
 	// MetricsTable holds the schema information for the "metrics" table.
@@ -163,13 +350,53 @@ var (
 		ForeignKeys: []*schema.ForeignKey{
 			{
 				Symbol:  "metrics_graphs_metrics",
-				Columns: []*schema.Column{MetricsColumns[3]},
+				Columns: []*schema.Column{MetricsColumns[4]},
 
 				RefColumns: []*schema.Column{GraphsColumns[0]},
 				OnDelete:   schema.SetNull,
 			},
 		},
 	}
+	// ProjectsColumns holds the columns for the "projects" table.
+	ProjectsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt, Increment: true},
+		{Name: "name", Type: field.TypeString, Unique: true, Size: 255},
+		{Name: "max_queued", Type: field.TypeInt},
+		{Name: "raw_retention", Type: field.TypeInt64},
+		{Name: "rollup_retention", Type: field.TypeInt64},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "updated_at", Type: field.TypeTime},
+	}
+	// ProjectsTable holds the schema information for the "projects" table.
+	ProjectsTable = &schema.Table{
+		Name:        "projects",
+		Columns:     ProjectsColumns,
+		PrimaryKey:  []*schema.Column{ProjectsColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{},
+	}
+	// SubscriptionsColumns holds the columns for the "subscriptions" table.
+	SubscriptionsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt, Increment: true},
+		{Name: "application_id", Type: field.TypeInt, Nullable: true},
+		{Name: "tag", Type: field.TypeString, Nullable: true},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "user_subscriptions", Type: field.TypeInt, Nullable: true},
+	}
+	// SubscriptionsTable holds the schema information for the "subscriptions" table.
+	SubscriptionsTable = &schema.Table{
+		Name:       "subscriptions",
+		Columns:    SubscriptionsColumns,
+		PrimaryKey: []*schema.Column{SubscriptionsColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:  "subscriptions_users_subscriptions",
+				Columns: []*schema.Column{SubscriptionsColumns[4]},
+
+				RefColumns: []*schema.Column{UsersColumns[0]},
+				OnDelete:   schema.SetNull,
+			},
+		},
+	}
 	// TagsColumns holds the columns for the "tags" table.
 	TagsColumns = []*schema.Column{
 		{Name: "id", Type: field.TypeInt, Increment: true},
@@ -198,25 +425,147 @@ var (
 			},
 		},
 	}
+	// UsersColumns holds the columns for the "users" table.
+	UsersColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt, Increment: true},
+		{Name: "username", Type: field.TypeString, Unique: true, Size: 255},
+		{Name: "password_hash", Type: field.TypeString},
+		{Name: "role", Type: field.TypeEnum, Enums: []string{"viewer", "runner", "admin"}, Default: "viewer"},
+		{Name: "email", Type: field.TypeString, Nullable: true, Size: 255},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "updated_at", Type: field.TypeTime},
+	}
+	// UsersTable holds the schema information for the "users" table.
+	UsersTable = &schema.Table{
+		Name:        "users",
+		Columns:     UsersColumns,
+		PrimaryKey:  []*schema.Column{UsersColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{},
+	}
+	// WebhooksColumns holds the columns for the "webhooks" table.
+	WebhooksColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt, Increment: true},
+		{Name: "name", Type: field.TypeString, Size: 255},
+		{Name: "url", Type: field.TypeString},
+		{Name: "tag", Type: field.TypeString, Nullable: true},
+		{Name: "events", Type: field.TypeJSON, Nullable: true},
+		{Name: "template", Type: field.TypeString, Nullable: true, Size: 2147483647},
+		{Name: "secret", Type: field.TypeString, Nullable: true},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "updated_at", Type: field.TypeTime},
+	}
+	// WebhooksTable holds the schema information for the "webhooks" table.
+	WebhooksTable = &schema.Table{
+		Name:        "webhooks",
+		Columns:     WebhooksColumns,
+		PrimaryKey:  []*schema.Column{WebhooksColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{},
+	}
+	// WebhookDeliveriesColumns holds the columns for the "webhook_deliveries" table.
+	WebhookDeliveriesColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt, Increment: true},
+		{Name: "event", Type: field.TypeString},
+		{Name: "application_id", Type: field.TypeInt},
+		{Name: "payload", Type: field.TypeString, Size: 2147483647},
+		{Name: "attempts", Type: field.TypeInt},
+		{Name: "status_code", Type: field.TypeInt},
+		{Name: "error", Type: field.TypeString, Nullable: true},
+		{Name: "success", Type: field.TypeBool},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "delivered_at", Type: field.TypeTime, Nullable: true},
+		{Name: "webhook_deliveries", Type: field.TypeInt, Nullable: true},
+	}
+	// WebhookDeliveriesTable holds the schema information for the "webhook_deliveries" table.
+	WebhookDeliveriesTable = &schema.Table{
+		Name:       "webhook_deliveries",
+		Columns:    WebhookDeliveriesColumns,
+		PrimaryKey: []*schema.Column{WebhookDeliveriesColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:  "webhook_deliveries_webhooks_deliveries",
+				Columns: []*schema.Column{WebhookDeliveriesColumns[10]},
+
+				RefColumns: []*schema.Column{WebhooksColumns[0]},
+				OnDelete:   schema.SetNull,
+			},
+		},
+		Indexes: []*schema.Index{
+			{
+				Name:    "webhookdelivery_created_at_webhook_deliveries",
+				Unique:  false,
+				Columns: []*schema.Column{WebhookDeliveriesColumns[8], WebhookDeliveriesColumns[10]},
+			},
+		},
+	}
+	// ProjectMembersColumns holds the columns for the "project_members" table.
+	ProjectMembersColumns = []*schema.Column{
+		{Name: "project_id", Type: field.TypeInt},
+		{Name: "user_id", Type: field.TypeInt},
+	}
+	// ProjectMembersTable holds the schema information for the "project_members" table.
+	ProjectMembersTable = &schema.Table{
+		Name:       "project_members",
+		Columns:    ProjectMembersColumns,
+		PrimaryKey: []*schema.Column{ProjectMembersColumns[0], ProjectMembersColumns[1]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:  "project_members_project_id",
+				Columns: []*schema.Column{ProjectMembersColumns[0]},
+
+				RefColumns: []*schema.Column{ProjectsColumns[0]},
+				OnDelete:   schema.Cascade,
+			},
+			{
+				Symbol:  "project_members_user_id",
+				Columns: []*schema.Column{ProjectMembersColumns[1]},
+
+				RefColumns: []*schema.Column{UsersColumns[0]},
+				OnDelete:   schema.Cascade,
+			},
+		},
+	}
 	// Tables holds all the tables in the schema.
 	Tables = []*schema.Table{
+		APITokensTable,
 		ApplicationsTable,
+		AuditsTable,
+		BaselinesTable,
 		CountersTable,
+		EventsTable,
+		ExecutorsTable,
 		GaugesTable,
 		GraphsTable,
 		GroupsTable,
 		HistogramsTable,
 		MetricsTable,
+		ProjectsTable,
+		SubscriptionsTable,
 		TagsTable,
+		UsersTable,
+		WebhooksTable,
+		WebhookDeliveriesTable,
+		ProjectMembersTable,
 	}
 )
 
 func init() {
-	CountersTable.ForeignKeys[0].RefTable = MetricsTable
-	GaugesTable.ForeignKeys[0].RefTable = MetricsTable
+	APITokensTable.ForeignKeys[0].RefTable = UsersTable
+	ApplicationsTable.ForeignKeys[0].RefTable = ProjectsTable
+	BaselinesTable.ForeignKeys[0].RefTable = ApplicationsTable
+	CountersTable.ForeignKeys[0].RefTable = ExecutorsTable
+	CountersTable.ForeignKeys[1].RefTable = MetricsTable
+	EventsTable.ForeignKeys[0].RefTable = ApplicationsTable
+	ExecutorsTable.ForeignKeys[0].RefTable = ApplicationsTable
+	GaugesTable.ForeignKeys[0].RefTable = ExecutorsTable
+	GaugesTable.ForeignKeys[1].RefTable = MetricsTable
 	GraphsTable.ForeignKeys[0].RefTable = GroupsTable
 	GroupsTable.ForeignKeys[0].RefTable = ApplicationsTable
-	HistogramsTable.ForeignKeys[0].RefTable = MetricsTable
+	HistogramsTable.ForeignKeys[0].RefTable = ExecutorsTable
+	HistogramsTable.ForeignKeys[1].RefTable = MetricsTable
 	MetricsTable.ForeignKeys[0].RefTable = GraphsTable
+	SubscriptionsTable.ForeignKeys[0].RefTable = UsersTable
 	TagsTable.ForeignKeys[0].RefTable = ApplicationsTable
+	WebhookDeliveriesTable.ForeignKeys[0].RefTable = WebhooksTable
+	ProjectMembersTable.ForeignKeys[0].RefTable = ProjectsTable
+	ProjectMembersTable.ForeignKeys[1].RefTable = UsersTable
 }
@@ -8,14 +8,26 @@ import (
 	"sync"
 	"time"
 
+	"github.com/gobench-io/gobench/ent/apitoken"
 	"github.com/gobench-io/gobench/ent/application"
+	"github.com/gobench-io/gobench/ent/audit"
+	"github.com/gobench-io/gobench/ent/baseline"
 	"github.com/gobench-io/gobench/ent/counter"
+	"github.com/gobench-io/gobench/ent/event"
+	"github.com/gobench-io/gobench/ent/executor"
 	"github.com/gobench-io/gobench/ent/gauge"
 	"github.com/gobench-io/gobench/ent/graph"
 	"github.com/gobench-io/gobench/ent/group"
 	"github.com/gobench-io/gobench/ent/histogram"
 	"github.com/gobench-io/gobench/ent/metric"
+	"github.com/gobench-io/gobench/ent/project"
+	"github.com/gobench-io/gobench/ent/subscription"
 	"github.com/gobench-io/gobench/ent/tag"
+	"github.com/gobench-io/gobench/ent/user"
+	"github.com/gobench-io/gobench/ent/webhook"
+	"github.com/gobench-io/gobench/ent/webhookdelivery"
+	"github.com/gobench-io/gobench/sink"
+	"github.com/gobench-io/gobench/verdict"
 
 	"github.com/facebook/ent"
 )
@@ -29,53 +41,58 @@ const (
 	OpUpdateOne = ent.OpUpdateOne
 
 	// Node types.
-	TypeApplication = "Application"
-	TypeCounter     = "Counter"
-	TypeGauge       = "Gauge"
-	TypeGraph       = "Graph"
-	TypeGroup       = "Group"
-	TypeHistogram   = "Histogram"
-	TypeMetric      = "Metric"
-	TypeTag         = "Tag"
+	TypeAPIToken        = "APIToken"
+	TypeApplication     = "Application"
+	TypeAudit           = "Audit"
+	TypeBaseline        = "Baseline"
+	TypeCounter         = "Counter"
+	TypeEvent           = "Event"
+	TypeExecutor        = "Executor"
+	TypeGauge           = "Gauge"
+	TypeGraph           = "Graph"
+	TypeGroup           = "Group"
+	TypeHistogram       = "Histogram"
+	TypeMetric          = "Metric"
+	TypeProject         = "Project"
+	TypeSubscription    = "Subscription"
+	TypeTag             = "Tag"
+	TypeUser            = "User"
+	TypeWebhook         = "Webhook"
+	TypeWebhookDelivery = "WebhookDelivery"
 )
 
-// ApplicationMutation represents an operation that mutate the Applications
+// APITokenMutation represents an operation that mutate the APITokens
 // nodes in the graph.
-type ApplicationMutation struct {
+type APITokenMutation struct {
 	config
 	op            Op
 	typ           string
 	id            *int
 	name          *string
-	status        *string
+	token_hash    *string
+	prefix        *string
+	scope         *apitoken.Scope
+	last_used_at  *time.Time
+	expires_at    *time.Time
 	created_at    *time.Time
-	started_at    *time.Time
-	updated_at    *time.Time
-	scenario      *string
-	gomod         *string
-	gosum         *string
 	clearedFields map[string]struct{}
-	groups        map[int]struct{}
-	removedgroups map[int]struct{}
-	clearedgroups bool
-	tags          map[int]struct{}
-	removedtags   map[int]struct{}
-	clearedtags   bool
+	user          *int
+	cleareduser   bool
 	done          bool
-	oldValue      func(context.Context) (*Application, error)
+	oldValue      func(context.Context) (*APIToken, error)
 }
 
-var _ ent.Mutation = (*ApplicationMutation)(nil)
+var _ ent.Mutation = (*APITokenMutation)(nil)
 
-// applicationOption allows to manage the mutation configuration using functional options.
-type applicationOption func(*ApplicationMutation)
+// apitokenOption allows to manage the mutation configuration using functional options.
+type apitokenOption func(*APITokenMutation)
 
-// newApplicationMutation creates new mutation for $n.Name.
-func newApplicationMutation(c config, op Op, opts ...applicationOption) *ApplicationMutation {
-	m := &ApplicationMutation{
+// newAPITokenMutation creates new mutation for $n.Name.
+func newAPITokenMutation(c config, op Op, opts ...apitokenOption) *APITokenMutation {
+	m := &APITokenMutation{
 		config:        c,
 		op:            op,
-		typ:           TypeApplication,
+		typ:           TypeAPIToken,
 		clearedFields: make(map[string]struct{}),
 	}
 	for _, opt := range opts {
@@ -84,20 +101,20 @@ func newApplicationMutation(c config, op Op, opts ...applicationOption) *Applica
 	return m
 }
 
-// withApplicationID sets the id field of the mutation.
-func withApplicationID(id int) applicationOption {
-	return func(m *ApplicationMutation) {
+// withAPITokenID sets the id field of the mutation.
+func withAPITokenID(id int) apitokenOption {
+	return func(m *APITokenMutation) {
 		var (
 			err   error
 			once  sync.Once
-			value *Application
+			value *APIToken
 		)
-		m.oldValue = func(ctx context.Context) (*Application, error) {
+		m.oldValue = func(ctx context.Context) (*APIToken, error) {
 			once.Do(func() {
 				if m.done {
 					err = fmt.Errorf("querying old values post mutation is not allowed")
 				} else {
-					value, err = m.Client().Application.Get(ctx, id)
+					value, err = m.Client().APIToken.Get(ctx, id)
 				}
 			})
 			return value, err
@@ -106,10 +123,10 @@ func withApplicationID(id int) applicationOption {
 	}
 }
 
-// withApplication sets the old Application of the mutation.
-func withApplication(node *Application) applicationOption {
-	return func(m *ApplicationMutation) {
-		m.oldValue = func(context.Context) (*Application, error) {
+// withAPIToken sets the old APIToken of the mutation.
+func withAPIToken(node *APIToken) apitokenOption {
+	return func(m *APITokenMutation) {
+		m.oldValue = func(context.Context) (*APIToken, error) {
 			return node, nil
 		}
 		m.id = &node.ID
@@ -118,7 +135,7 @@ func withApplication(node *Application) applicationOption {
 
 // Client returns a new `ent.Client` from the mutation. If the mutation was
 // executed in a transaction (ent.Tx), a transactional client is returned.
-func (m ApplicationMutation) Client() *Client {
+func (m APITokenMutation) Client() *Client {
 	client := &Client{config: m.config}
 	client.init()
 	return client
@@ -126,7 +143,7 @@ func (m ApplicationMutation) Client() *Client {
 
 // Tx returns an `ent.Tx` for mutations that were executed in transactions;
 // it returns an error otherwise.
-func (m ApplicationMutation) Tx() (*Tx, error) {
+func (m APITokenMutation) Tx() (*Tx, error) {
 	if _, ok := m.driver.(*txDriver); !ok {
 		return nil, fmt.Errorf("ent: mutation is not running in a transaction")
 	}
@@ -137,7 +154,7 @@ func (m ApplicationMutation) Tx() (*Tx, error) {
 
 // ID returns the id value in the mutation. Note that, the id
 // is available only if it was provided to the builder.
-func (m *ApplicationMutation) ID() (id int, exists bool) {
+func (m *APITokenMutation) ID() (id int, exists bool) {
 	if m.id == nil {
 		return
 	}
@@ -145,12 +162,12 @@ func (m *ApplicationMutation) ID() (id int, exists bool) {
 }
 
 // SetName sets the name field.
-func (m *ApplicationMutation) SetName(s string) {
+func (m *APITokenMutation) SetName(s string) {
 	m.name = &s
 }
 
 // Name returns the name value in the mutation.
-func (m *ApplicationMutation) Name() (r string, exists bool) {
+func (m *APITokenMutation) Name() (r string, exists bool) {
 	v := m.name
 	if v == nil {
 		return
@@ -158,11 +175,11 @@ func (m *ApplicationMutation) Name() (r string, exists bool) {
 	return *v, true
 }
 
-// OldName returns the old name value of the Application.
-// If the Application object wasn't provided to the builder, the object is fetched
+// OldName returns the old name value of the APIToken.
+// If the APIToken object wasn't provided to the builder, the object is fetched
 // from the database.
 // An error is returned if the mutation operation is not UpdateOne, or database query fails.
-func (m *ApplicationMutation) OldName(ctx context.Context) (v string, err error) {
+func (m *APITokenMutation) OldName(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
 		return v, fmt.Errorf("OldName is allowed only on UpdateOne operations")
 	}
@@ -177,426 +194,332 @@ func (m *ApplicationMutation) OldName(ctx context.Context) (v string, err error)
 }
 
 // ResetName reset all changes of the "name" field.
-func (m *ApplicationMutation) ResetName() {
+func (m *APITokenMutation) ResetName() {
 	m.name = nil
 }
 
-// SetStatus sets the status field.
-func (m *ApplicationMutation) SetStatus(s string) {
-	m.status = &s
+// SetTokenHash sets the token_hash field.
+func (m *APITokenMutation) SetTokenHash(s string) {
+	m.token_hash = &s
 }
 
-// Status returns the status value in the mutation.
-func (m *ApplicationMutation) Status() (r string, exists bool) {
-	v := m.status
+// TokenHash returns the token_hash value in the mutation.
+func (m *APITokenMutation) TokenHash() (r string, exists bool) {
+	v := m.token_hash
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldStatus returns the old status value of the Application.
-// If the Application object wasn't provided to the builder, the object is fetched
+// OldTokenHash returns the old token_hash value of the APIToken.
+// If the APIToken object wasn't provided to the builder, the object is fetched
 // from the database.
 // An error is returned if the mutation operation is not UpdateOne, or database query fails.
-func (m *ApplicationMutation) OldStatus(ctx context.Context) (v string, err error) {
+func (m *APITokenMutation) OldTokenHash(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, fmt.Errorf("OldStatus is allowed only on UpdateOne operations")
+		return v, fmt.Errorf("OldTokenHash is allowed only on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, fmt.Errorf("OldStatus requires an ID field in the mutation")
+		return v, fmt.Errorf("OldTokenHash requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldStatus: %w", err)
+		return v, fmt.Errorf("querying old value for OldTokenHash: %w", err)
 	}
-	return oldValue.Status, nil
+	return oldValue.TokenHash, nil
 }
 
-// ResetStatus reset all changes of the "status" field.
-func (m *ApplicationMutation) ResetStatus() {
-	m.status = nil
+// ResetTokenHash reset all changes of the "token_hash" field.
+func (m *APITokenMutation) ResetTokenHash() {
+	m.token_hash = nil
 }
 
-// SetCreatedAt sets the created_at field.
-func (m *ApplicationMutation) SetCreatedAt(t time.Time) {
-	m.created_at = &t
+// SetPrefix sets the prefix field.
+func (m *APITokenMutation) SetPrefix(s string) {
+	m.prefix = &s
 }
 
-// CreatedAt returns the created_at value in the mutation.
-func (m *ApplicationMutation) CreatedAt() (r time.Time, exists bool) {
-	v := m.created_at
+// Prefix returns the prefix value in the mutation.
+func (m *APITokenMutation) Prefix() (r string, exists bool) {
+	v := m.prefix
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldCreatedAt returns the old created_at value of the Application.
-// If the Application object wasn't provided to the builder, the object is fetched
+// OldPrefix returns the old prefix value of the APIToken.
+// If the APIToken object wasn't provided to the builder, the object is fetched
 // from the database.
 // An error is returned if the mutation operation is not UpdateOne, or database query fails.
-func (m *ApplicationMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
+func (m *APITokenMutation) OldPrefix(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, fmt.Errorf("OldCreatedAt is allowed only on UpdateOne operations")
+		return v, fmt.Errorf("OldPrefix is allowed only on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, fmt.Errorf("OldCreatedAt requires an ID field in the mutation")
+		return v, fmt.Errorf("OldPrefix requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
+		return v, fmt.Errorf("querying old value for OldPrefix: %w", err)
 	}
-	return oldValue.CreatedAt, nil
+	return oldValue.Prefix, nil
 }
 
-// ResetCreatedAt reset all changes of the "created_at" field.
-func (m *ApplicationMutation) ResetCreatedAt() {
-	m.created_at = nil
+// ResetPrefix reset all changes of the "prefix" field.
+func (m *APITokenMutation) ResetPrefix() {
+	m.prefix = nil
 }
 
-// SetStartedAt sets the started_at field.
-func (m *ApplicationMutation) SetStartedAt(t time.Time) {
-	m.started_at = &t
+// SetScope sets the scope field.
+func (m *APITokenMutation) SetScope(a apitoken.Scope) {
+	m.scope = &a
 }
 
-// StartedAt returns the started_at value in the mutation.
-func (m *ApplicationMutation) StartedAt() (r time.Time, exists bool) {
-	v := m.started_at
+// Scope returns the scope value in the mutation.
+func (m *APITokenMutation) Scope() (r apitoken.Scope, exists bool) {
+	v := m.scope
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldStartedAt returns the old started_at value of the Application.
-// If the Application object wasn't provided to the builder, the object is fetched
+// OldScope returns the old scope value of the APIToken.
+// If the APIToken object wasn't provided to the builder, the object is fetched
 // from the database.
 // An error is returned if the mutation operation is not UpdateOne, or database query fails.
-func (m *ApplicationMutation) OldStartedAt(ctx context.Context) (v time.Time, err error) {
+func (m *APITokenMutation) OldScope(ctx context.Context) (v apitoken.Scope, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, fmt.Errorf("OldStartedAt is allowed only on UpdateOne operations")
+		return v, fmt.Errorf("OldScope is allowed only on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, fmt.Errorf("OldStartedAt requires an ID field in the mutation")
+		return v, fmt.Errorf("OldScope requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldStartedAt: %w", err)
+		return v, fmt.Errorf("querying old value for OldScope: %w", err)
 	}
-	return oldValue.StartedAt, nil
-}
-
-// ClearStartedAt clears the value of started_at.
-func (m *ApplicationMutation) ClearStartedAt() {
-	m.started_at = nil
-	m.clearedFields[application.FieldStartedAt] = struct{}{}
-}
-
-// StartedAtCleared returns if the field started_at was cleared in this mutation.
-func (m *ApplicationMutation) StartedAtCleared() bool {
-	_, ok := m.clearedFields[application.FieldStartedAt]
-	return ok
+	return oldValue.Scope, nil
 }
 
-// ResetStartedAt reset all changes of the "started_at" field.
-func (m *ApplicationMutation) ResetStartedAt() {
-	m.started_at = nil
-	delete(m.clearedFields, application.FieldStartedAt)
+// ResetScope reset all changes of the "scope" field.
+func (m *APITokenMutation) ResetScope() {
+	m.scope = nil
 }
 
-// SetUpdatedAt sets the updated_at field.
-func (m *ApplicationMutation) SetUpdatedAt(t time.Time) {
-	m.updated_at = &t
+// SetLastUsedAt sets the last_used_at field.
+func (m *APITokenMutation) SetLastUsedAt(t time.Time) {
+	m.last_used_at = &t
 }
 
-// UpdatedAt returns the updated_at value in the mutation.
-func (m *ApplicationMutation) UpdatedAt() (r time.Time, exists bool) {
-	v := m.updated_at
+// LastUsedAt returns the last_used_at value in the mutation.
+func (m *APITokenMutation) LastUsedAt() (r time.Time, exists bool) {
+	v := m.last_used_at
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldUpdatedAt returns the old updated_at value of the Application.
-// If the Application object wasn't provided to the builder, the object is fetched
+// OldLastUsedAt returns the old last_used_at value of the APIToken.
+// If the APIToken object wasn't provided to the builder, the object is fetched
 // from the database.
 // An error is returned if the mutation operation is not UpdateOne, or database query fails.
-func (m *ApplicationMutation) OldUpdatedAt(ctx context.Context) (v time.Time, err error) {
+func (m *APITokenMutation) OldLastUsedAt(ctx context.Context) (v *time.Time, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, fmt.Errorf("OldUpdatedAt is allowed only on UpdateOne operations")
+		return v, fmt.Errorf("OldLastUsedAt is allowed only on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, fmt.Errorf("OldUpdatedAt requires an ID field in the mutation")
+		return v, fmt.Errorf("OldLastUsedAt requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldUpdatedAt: %w", err)
+		return v, fmt.Errorf("querying old value for OldLastUsedAt: %w", err)
 	}
-	return oldValue.UpdatedAt, nil
-}
-
-// ResetUpdatedAt reset all changes of the "updated_at" field.
-func (m *ApplicationMutation) ResetUpdatedAt() {
-	m.updated_at = nil
-}
-
-// SetScenario sets the scenario field.
-func (m *ApplicationMutation) SetScenario(s string) {
-	m.scenario = &s
+	return oldValue.LastUsedAt, nil
 }
 
-// Scenario returns the scenario value in the mutation.
-func (m *ApplicationMutation) Scenario() (r string, exists bool) {
-	v := m.scenario
-	if v == nil {
-		return
-	}
-	return *v, true
+// ClearLastUsedAt clears the value of last_used_at.
+func (m *APITokenMutation) ClearLastUsedAt() {
+	m.last_used_at = nil
+	m.clearedFields[apitoken.FieldLastUsedAt] = struct{}{}
 }
 
-// OldScenario returns the old scenario value of the Application.
-// If the Application object wasn't provided to the builder, the object is fetched
-// from the database.
-// An error is returned if the mutation operation is not UpdateOne, or database query fails.
-func (m *ApplicationMutation) OldScenario(ctx context.Context) (v string, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, fmt.Errorf("OldScenario is allowed only on UpdateOne operations")
-	}
-	if m.id == nil || m.oldValue == nil {
-		return v, fmt.Errorf("OldScenario requires an ID field in the mutation")
-	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldScenario: %w", err)
-	}
-	return oldValue.Scenario, nil
+// LastUsedAtCleared returns if the field last_used_at was cleared in this mutation.
+func (m *APITokenMutation) LastUsedAtCleared() bool {
+	_, ok := m.clearedFields[apitoken.FieldLastUsedAt]
+	return ok
 }
 
-// ResetScenario reset all changes of the "scenario" field.
-func (m *ApplicationMutation) ResetScenario() {
-	m.scenario = nil
+// ResetLastUsedAt reset all changes of the "last_used_at" field.
+func (m *APITokenMutation) ResetLastUsedAt() {
+	m.last_used_at = nil
+	delete(m.clearedFields, apitoken.FieldLastUsedAt)
 }
 
-// SetGomod sets the gomod field.
-func (m *ApplicationMutation) SetGomod(s string) {
-	m.gomod = &s
+// SetExpiresAt sets the expires_at field.
+func (m *APITokenMutation) SetExpiresAt(t time.Time) {
+	m.expires_at = &t
 }
 
-// Gomod returns the gomod value in the mutation.
-func (m *ApplicationMutation) Gomod() (r string, exists bool) {
-	v := m.gomod
+// ExpiresAt returns the expires_at value in the mutation.
+func (m *APITokenMutation) ExpiresAt() (r time.Time, exists bool) {
+	v := m.expires_at
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldGomod returns the old gomod value of the Application.
-// If the Application object wasn't provided to the builder, the object is fetched
+// OldExpiresAt returns the old expires_at value of the APIToken.
+// If the APIToken object wasn't provided to the builder, the object is fetched
 // from the database.
 // An error is returned if the mutation operation is not UpdateOne, or database query fails.
-func (m *ApplicationMutation) OldGomod(ctx context.Context) (v string, err error) {
+func (m *APITokenMutation) OldExpiresAt(ctx context.Context) (v *time.Time, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, fmt.Errorf("OldGomod is allowed only on UpdateOne operations")
+		return v, fmt.Errorf("OldExpiresAt is allowed only on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, fmt.Errorf("OldGomod requires an ID field in the mutation")
+		return v, fmt.Errorf("OldExpiresAt requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldGomod: %w", err)
+		return v, fmt.Errorf("querying old value for OldExpiresAt: %w", err)
 	}
-	return oldValue.Gomod, nil
+	return oldValue.ExpiresAt, nil
 }
 
-// ResetGomod reset all changes of the "gomod" field.
-func (m *ApplicationMutation) ResetGomod() {
-	m.gomod = nil
+// ClearExpiresAt clears the value of expires_at.
+func (m *APITokenMutation) ClearExpiresAt() {
+	m.expires_at = nil
+	m.clearedFields[apitoken.FieldExpiresAt] = struct{}{}
 }
 
-// SetGosum sets the gosum field.
-func (m *ApplicationMutation) SetGosum(s string) {
-	m.gosum = &s
+// ExpiresAtCleared returns if the field expires_at was cleared in this mutation.
+func (m *APITokenMutation) ExpiresAtCleared() bool {
+	_, ok := m.clearedFields[apitoken.FieldExpiresAt]
+	return ok
 }
 
-// Gosum returns the gosum value in the mutation.
-func (m *ApplicationMutation) Gosum() (r string, exists bool) {
-	v := m.gosum
+// ResetExpiresAt reset all changes of the "expires_at" field.
+func (m *APITokenMutation) ResetExpiresAt() {
+	m.expires_at = nil
+	delete(m.clearedFields, apitoken.FieldExpiresAt)
+}
+
+// SetCreatedAt sets the created_at field.
+func (m *APITokenMutation) SetCreatedAt(t time.Time) {
+	m.created_at = &t
+}
+
+// CreatedAt returns the created_at value in the mutation.
+func (m *APITokenMutation) CreatedAt() (r time.Time, exists bool) {
+	v := m.created_at
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldGosum returns the old gosum value of the Application.
-// If the Application object wasn't provided to the builder, the object is fetched
+// OldCreatedAt returns the old created_at value of the APIToken.
+// If the APIToken object wasn't provided to the builder, the object is fetched
 // from the database.
 // An error is returned if the mutation operation is not UpdateOne, or database query fails.
-func (m *ApplicationMutation) OldGosum(ctx context.Context) (v string, err error) {
+func (m *APITokenMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, fmt.Errorf("OldGosum is allowed only on UpdateOne operations")
+		return v, fmt.Errorf("OldCreatedAt is allowed only on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, fmt.Errorf("OldGosum requires an ID field in the mutation")
+		return v, fmt.Errorf("OldCreatedAt requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldGosum: %w", err)
+		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
 	}
-	return oldValue.Gosum, nil
-}
-
-// ResetGosum reset all changes of the "gosum" field.
-func (m *ApplicationMutation) ResetGosum() {
-	m.gosum = nil
+	return oldValue.CreatedAt, nil
 }
 
-// AddGroupIDs adds the groups edge to Group by ids.
-func (m *ApplicationMutation) AddGroupIDs(ids ...int) {
-	if m.groups == nil {
-		m.groups = make(map[int]struct{})
-	}
-	for i := range ids {
-		m.groups[ids[i]] = struct{}{}
-	}
+// ResetCreatedAt reset all changes of the "created_at" field.
+func (m *APITokenMutation) ResetCreatedAt() {
+	m.created_at = nil
 }
 
-// ClearGroups clears the groups edge to Group.
-func (m *ApplicationMutation) ClearGroups() {
-	m.clearedgroups = true
+// SetUserID sets the user edge to User by id.
+func (m *APITokenMutation) SetUserID(id int) {
+	m.user = &id
 }
 
-// GroupsCleared returns if the edge groups was cleared.
-func (m *ApplicationMutation) GroupsCleared() bool {
-	return m.clearedgroups
+// ClearUser clears the user edge to User.
+func (m *APITokenMutation) ClearUser() {
+	m.cleareduser = true
 }
 
-// RemoveGroupIDs removes the groups edge to Group by ids.
-func (m *ApplicationMutation) RemoveGroupIDs(ids ...int) {
-	if m.removedgroups == nil {
-		m.removedgroups = make(map[int]struct{})
-	}
-	for i := range ids {
-		m.removedgroups[ids[i]] = struct{}{}
-	}
+// UserCleared returns if the edge user was cleared.
+func (m *APITokenMutation) UserCleared() bool {
+	return m.cleareduser
 }
 
-// RemovedGroups returns the removed ids of groups.
-func (m *ApplicationMutation) RemovedGroupsIDs() (ids []int) {
-	for id := range m.removedgroups {
-		ids = append(ids, id)
+// UserID returns the user id in the mutation.
+func (m *APITokenMutation) UserID() (id int, exists bool) {
+	if m.user != nil {
+		return *m.user, true
 	}
 	return
 }
 
-// GroupsIDs returns the groups ids in the mutation.
-func (m *ApplicationMutation) GroupsIDs() (ids []int) {
-	for id := range m.groups {
-		ids = append(ids, id)
+// UserIDs returns the user ids in the mutation.
+// Note that ids always returns len(ids) <= 1 for unique edges, and you should use
+// UserID instead. It exists only for internal usage by the builders.
+func (m *APITokenMutation) UserIDs() (ids []int) {
+	if id := m.user; id != nil {
+		ids = append(ids, *id)
 	}
 	return
 }
 
-// ResetGroups reset all changes of the "groups" edge.
-func (m *ApplicationMutation) ResetGroups() {
-	m.groups = nil
-	m.clearedgroups = false
-	m.removedgroups = nil
-}
-
-// AddTagIDs adds the tags edge to Tag by ids.
-func (m *ApplicationMutation) AddTagIDs(ids ...int) {
-	if m.tags == nil {
-		m.tags = make(map[int]struct{})
-	}
-	for i := range ids {
-		m.tags[ids[i]] = struct{}{}
-	}
+// ResetUser reset all changes of the "user" edge.
+func (m *APITokenMutation) ResetUser() {
+	m.user = nil
+	m.cleareduser = false
 }
 
-// ClearTags clears the tags edge to Tag.
-func (m *ApplicationMutation) ClearTags() {
-	m.clearedtags = true
+// Op returns the operation name.
+func (m *APITokenMutation) Op() Op {
+	return m.op
 }
 
-// TagsCleared returns if the edge tags was cleared.
-func (m *ApplicationMutation) TagsCleared() bool {
-	return m.clearedtags
+// Type returns the node type of this mutation (APIToken).
+func (m *APITokenMutation) Type() string {
+	return m.typ
 }
 
-// RemoveTagIDs removes the tags edge to Tag by ids.
-func (m *ApplicationMutation) RemoveTagIDs(ids ...int) {
-	if m.removedtags == nil {
-		m.removedtags = make(map[int]struct{})
+// Fields returns all fields that were changed during
+// this mutation. Note that, in order to get all numeric
+// fields that were in/decremented, call AddedFields().
+func (m *APITokenMutation) Fields() []string {
+	fields := make([]string, 0, 7)
+	if m.name != nil {
+		fields = append(fields, apitoken.FieldName)
 	}
-	for i := range ids {
-		m.removedtags[ids[i]] = struct{}{}
+	if m.token_hash != nil {
+		fields = append(fields, apitoken.FieldTokenHash)
 	}
-}
-
-// RemovedTags returns the removed ids of tags.
-func (m *ApplicationMutation) RemovedTagsIDs() (ids []int) {
-	for id := range m.removedtags {
-		ids = append(ids, id)
+	if m.prefix != nil {
+		fields = append(fields, apitoken.FieldPrefix)
 	}
-	return
-}
-
-// TagsIDs returns the tags ids in the mutation.
-func (m *ApplicationMutation) TagsIDs() (ids []int) {
-	for id := range m.tags {
-		ids = append(ids, id)
+	if m.scope != nil {
+		fields = append(fields, apitoken.FieldScope)
 	}
-	return
-}
-
-// ResetTags reset all changes of the "tags" edge.
-func (m *ApplicationMutation) ResetTags() {
-	m.tags = nil
-	m.clearedtags = false
-	m.removedtags = nil
-}
-
-// Op returns the operation name.
-func (m *ApplicationMutation) Op() Op {
-	return m.op
-}
-
-// Type returns the node type of this mutation (Application).
-func (m *ApplicationMutation) Type() string {
-	return m.typ
-}
-
-// Fields returns all fields that were changed during
-// this mutation. Note that, in order to get all numeric
-// fields that were in/decremented, call AddedFields().
-func (m *ApplicationMutation) Fields() []string {
-	fields := make([]string, 0, 8)
-	if m.name != nil {
-		fields = append(fields, application.FieldName)
+	if m.last_used_at != nil {
+		fields = append(fields, apitoken.FieldLastUsedAt)
 	}
-	if m.status != nil {
-		fields = append(fields, application.FieldStatus)
+	if m.expires_at != nil {
+		fields = append(fields, apitoken.FieldExpiresAt)
 	}
 	if m.created_at != nil {
-		fields = append(fields, application.FieldCreatedAt)
-	}
-	if m.started_at != nil {
-		fields = append(fields, application.FieldStartedAt)
-	}
-	if m.updated_at != nil {
-		fields = append(fields, application.FieldUpdatedAt)
-	}
-	if m.scenario != nil {
-		fields = append(fields, application.FieldScenario)
-	}
-	if m.gomod != nil {
-		fields = append(fields, application.FieldGomod)
-	}
-	if m.gosum != nil {
-		fields = append(fields, application.FieldGosum)
+		fields = append(fields, apitoken.FieldCreatedAt)
 	}
 	return fields
 }
@@ -604,24 +527,22 @@ func (m *ApplicationMutation) Fields() []string {
 // Field returns the value of a field with the given name.
 // The second boolean value indicates that this field was
 // not set, or was not define in the schema.
-func (m *ApplicationMutation) Field(name string) (ent.Value, bool) {
+func (m *APITokenMutation) Field(name string) (ent.Value, bool) {
 	switch name {
-	case application.FieldName:
+	case apitoken.FieldName:
 		return m.Name()
-	case application.FieldStatus:
-		return m.Status()
-	case application.FieldCreatedAt:
+	case apitoken.FieldTokenHash:
+		return m.TokenHash()
+	case apitoken.FieldPrefix:
+		return m.Prefix()
+	case apitoken.FieldScope:
+		return m.Scope()
+	case apitoken.FieldLastUsedAt:
+		return m.LastUsedAt()
+	case apitoken.FieldExpiresAt:
+		return m.ExpiresAt()
+	case apitoken.FieldCreatedAt:
 		return m.CreatedAt()
-	case application.FieldStartedAt:
-		return m.StartedAt()
-	case application.FieldUpdatedAt:
-		return m.UpdatedAt()
-	case application.FieldScenario:
-		return m.Scenario()
-	case application.FieldGomod:
-		return m.Gomod()
-	case application.FieldGosum:
-		return m.Gosum()
 	}
 	return nil, false
 }
@@ -629,320 +550,306 @@ func (m *ApplicationMutation) Field(name string) (ent.Value, bool) {
 // OldField returns the old value of the field from the database.
 // An error is returned if the mutation operation is not UpdateOne,
 // or the query to the database was failed.
-func (m *ApplicationMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+func (m *APITokenMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
 	switch name {
-	case application.FieldName:
+	case apitoken.FieldName:
 		return m.OldName(ctx)
-	case application.FieldStatus:
-		return m.OldStatus(ctx)
-	case application.FieldCreatedAt:
+	case apitoken.FieldTokenHash:
+		return m.OldTokenHash(ctx)
+	case apitoken.FieldPrefix:
+		return m.OldPrefix(ctx)
+	case apitoken.FieldScope:
+		return m.OldScope(ctx)
+	case apitoken.FieldLastUsedAt:
+		return m.OldLastUsedAt(ctx)
+	case apitoken.FieldExpiresAt:
+		return m.OldExpiresAt(ctx)
+	case apitoken.FieldCreatedAt:
 		return m.OldCreatedAt(ctx)
-	case application.FieldStartedAt:
-		return m.OldStartedAt(ctx)
-	case application.FieldUpdatedAt:
-		return m.OldUpdatedAt(ctx)
-	case application.FieldScenario:
-		return m.OldScenario(ctx)
-	case application.FieldGomod:
-		return m.OldGomod(ctx)
-	case application.FieldGosum:
-		return m.OldGosum(ctx)
 	}
-	return nil, fmt.Errorf("unknown Application field %s", name)
+	return nil, fmt.Errorf("unknown APIToken field %s", name)
 }
 
 // SetField sets the value for the given name. It returns an
 // error if the field is not defined in the schema, or if the
 // type mismatch the field type.
-func (m *ApplicationMutation) SetField(name string, value ent.Value) error {
+func (m *APITokenMutation) SetField(name string, value ent.Value) error {
 	switch name {
-	case application.FieldName:
+	case apitoken.FieldName:
 		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
 		m.SetName(v)
 		return nil
-	case application.FieldStatus:
+	case apitoken.FieldTokenHash:
 		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetStatus(v)
+		m.SetTokenHash(v)
 		return nil
-	case application.FieldCreatedAt:
-		v, ok := value.(time.Time)
+	case apitoken.FieldPrefix:
+		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetCreatedAt(v)
+		m.SetPrefix(v)
 		return nil
-	case application.FieldStartedAt:
-		v, ok := value.(time.Time)
+	case apitoken.FieldScope:
+		v, ok := value.(apitoken.Scope)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetStartedAt(v)
+		m.SetScope(v)
 		return nil
-	case application.FieldUpdatedAt:
+	case apitoken.FieldLastUsedAt:
 		v, ok := value.(time.Time)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetUpdatedAt(v)
-		return nil
-	case application.FieldScenario:
-		v, ok := value.(string)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetScenario(v)
+		m.SetLastUsedAt(v)
 		return nil
-	case application.FieldGomod:
-		v, ok := value.(string)
+	case apitoken.FieldExpiresAt:
+		v, ok := value.(time.Time)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetGomod(v)
+		m.SetExpiresAt(v)
 		return nil
-	case application.FieldGosum:
-		v, ok := value.(string)
+	case apitoken.FieldCreatedAt:
+		v, ok := value.(time.Time)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetGosum(v)
+		m.SetCreatedAt(v)
 		return nil
 	}
-	return fmt.Errorf("unknown Application field %s", name)
+	return fmt.Errorf("unknown APIToken field %s", name)
 }
 
 // AddedFields returns all numeric fields that were incremented
 // or decremented during this mutation.
-func (m *ApplicationMutation) AddedFields() []string {
+func (m *APITokenMutation) AddedFields() []string {
 	return nil
 }
 
 // AddedField returns the numeric value that was in/decremented
 // from a field with the given name. The second value indicates
 // that this field was not set, or was not define in the schema.
-func (m *ApplicationMutation) AddedField(name string) (ent.Value, bool) {
+func (m *APITokenMutation) AddedField(name string) (ent.Value, bool) {
 	return nil, false
 }
 
 // AddField adds the value for the given name. It returns an
 // error if the field is not defined in the schema, or if the
 // type mismatch the field type.
-func (m *ApplicationMutation) AddField(name string, value ent.Value) error {
+func (m *APITokenMutation) AddField(name string, value ent.Value) error {
 	switch name {
 	}
-	return fmt.Errorf("unknown Application numeric field %s", name)
+	return fmt.Errorf("unknown APIToken numeric field %s", name)
 }
 
 // ClearedFields returns all nullable fields that were cleared
 // during this mutation.
-func (m *ApplicationMutation) ClearedFields() []string {
+func (m *APITokenMutation) ClearedFields() []string {
 	var fields []string
-	if m.FieldCleared(application.FieldStartedAt) {
-		fields = append(fields, application.FieldStartedAt)
+	if m.FieldCleared(apitoken.FieldLastUsedAt) {
+		fields = append(fields, apitoken.FieldLastUsedAt)
+	}
+	if m.FieldCleared(apitoken.FieldExpiresAt) {
+		fields = append(fields, apitoken.FieldExpiresAt)
 	}
 	return fields
 }
 
 // FieldCleared returns a boolean indicates if this field was
 // cleared in this mutation.
-func (m *ApplicationMutation) FieldCleared(name string) bool {
+func (m *APITokenMutation) FieldCleared(name string) bool {
 	_, ok := m.clearedFields[name]
 	return ok
 }
 
 // ClearField clears the value for the given name. It returns an
 // error if the field is not defined in the schema.
-func (m *ApplicationMutation) ClearField(name string) error {
+func (m *APITokenMutation) ClearField(name string) error {
 	switch name {
-	case application.FieldStartedAt:
-		m.ClearStartedAt()
+	case apitoken.FieldLastUsedAt:
+		m.ClearLastUsedAt()
+		return nil
+	case apitoken.FieldExpiresAt:
+		m.ClearExpiresAt()
 		return nil
 	}
-	return fmt.Errorf("unknown Application nullable field %s", name)
+	return fmt.Errorf("unknown APIToken nullable field %s", name)
 }
 
 // ResetField resets all changes in the mutation regarding the
 // given field name. It returns an error if the field is not
 // defined in the schema.
-func (m *ApplicationMutation) ResetField(name string) error {
+func (m *APITokenMutation) ResetField(name string) error {
 	switch name {
-	case application.FieldName:
+	case apitoken.FieldName:
 		m.ResetName()
 		return nil
-	case application.FieldStatus:
-		m.ResetStatus()
-		return nil
-	case application.FieldCreatedAt:
-		m.ResetCreatedAt()
+	case apitoken.FieldTokenHash:
+		m.ResetTokenHash()
 		return nil
-	case application.FieldStartedAt:
-		m.ResetStartedAt()
+	case apitoken.FieldPrefix:
+		m.ResetPrefix()
 		return nil
-	case application.FieldUpdatedAt:
-		m.ResetUpdatedAt()
+	case apitoken.FieldScope:
+		m.ResetScope()
 		return nil
-	case application.FieldScenario:
-		m.ResetScenario()
+	case apitoken.FieldLastUsedAt:
+		m.ResetLastUsedAt()
 		return nil
-	case application.FieldGomod:
-		m.ResetGomod()
+	case apitoken.FieldExpiresAt:
+		m.ResetExpiresAt()
 		return nil
-	case application.FieldGosum:
-		m.ResetGosum()
+	case apitoken.FieldCreatedAt:
+		m.ResetCreatedAt()
 		return nil
 	}
-	return fmt.Errorf("unknown Application field %s", name)
+	return fmt.Errorf("unknown APIToken field %s", name)
 }
 
 // AddedEdges returns all edge names that were set/added in this
 // mutation.
-func (m *ApplicationMutation) AddedEdges() []string {
-	edges := make([]string, 0, 2)
-	if m.groups != nil {
-		edges = append(edges, application.EdgeGroups)
-	}
-	if m.tags != nil {
-		edges = append(edges, application.EdgeTags)
+func (m *APITokenMutation) AddedEdges() []string {
+	edges := make([]string, 0, 1)
+	if m.user != nil {
+		edges = append(edges, apitoken.EdgeUser)
 	}
 	return edges
 }
 
 // AddedIDs returns all ids (to other nodes) that were added for
 // the given edge name.
-func (m *ApplicationMutation) AddedIDs(name string) []ent.Value {
+func (m *APITokenMutation) AddedIDs(name string) []ent.Value {
 	switch name {
-	case application.EdgeGroups:
-		ids := make([]ent.Value, 0, len(m.groups))
-		for id := range m.groups {
-			ids = append(ids, id)
-		}
-		return ids
-	case application.EdgeTags:
-		ids := make([]ent.Value, 0, len(m.tags))
-		for id := range m.tags {
-			ids = append(ids, id)
+	case apitoken.EdgeUser:
+		if id := m.user; id != nil {
+			return []ent.Value{*id}
 		}
-		return ids
 	}
 	return nil
 }
 
 // RemovedEdges returns all edge names that were removed in this
 // mutation.
-func (m *ApplicationMutation) RemovedEdges() []string {
-	edges := make([]string, 0, 2)
-	if m.removedgroups != nil {
-		edges = append(edges, application.EdgeGroups)
-	}
-	if m.removedtags != nil {
-		edges = append(edges, application.EdgeTags)
-	}
+func (m *APITokenMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 1)
 	return edges
 }
 
 // RemovedIDs returns all ids (to other nodes) that were removed for
 // the given edge name.
-func (m *ApplicationMutation) RemovedIDs(name string) []ent.Value {
+func (m *APITokenMutation) RemovedIDs(name string) []ent.Value {
 	switch name {
-	case application.EdgeGroups:
-		ids := make([]ent.Value, 0, len(m.removedgroups))
-		for id := range m.removedgroups {
-			ids = append(ids, id)
-		}
-		return ids
-	case application.EdgeTags:
-		ids := make([]ent.Value, 0, len(m.removedtags))
-		for id := range m.removedtags {
-			ids = append(ids, id)
-		}
-		return ids
 	}
 	return nil
 }
 
 // ClearedEdges returns all edge names that were cleared in this
 // mutation.
-func (m *ApplicationMutation) ClearedEdges() []string {
-	edges := make([]string, 0, 2)
-	if m.clearedgroups {
-		edges = append(edges, application.EdgeGroups)
-	}
-	if m.clearedtags {
-		edges = append(edges, application.EdgeTags)
+func (m *APITokenMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 1)
+	if m.cleareduser {
+		edges = append(edges, apitoken.EdgeUser)
 	}
 	return edges
 }
 
 // EdgeCleared returns a boolean indicates if this edge was
 // cleared in this mutation.
-func (m *ApplicationMutation) EdgeCleared(name string) bool {
+func (m *APITokenMutation) EdgeCleared(name string) bool {
 	switch name {
-	case application.EdgeGroups:
-		return m.clearedgroups
-	case application.EdgeTags:
-		return m.clearedtags
+	case apitoken.EdgeUser:
+		return m.cleareduser
 	}
 	return false
 }
 
 // ClearEdge clears the value for the given name. It returns an
 // error if the edge name is not defined in the schema.
-func (m *ApplicationMutation) ClearEdge(name string) error {
+func (m *APITokenMutation) ClearEdge(name string) error {
 	switch name {
+	case apitoken.EdgeUser:
+		m.ClearUser()
+		return nil
 	}
-	return fmt.Errorf("unknown Application unique edge %s", name)
+	return fmt.Errorf("unknown APIToken unique edge %s", name)
 }
 
 // ResetEdge resets all changes in the mutation regarding the
 // given edge name. It returns an error if the edge is not
 // defined in the schema.
-func (m *ApplicationMutation) ResetEdge(name string) error {
+func (m *APITokenMutation) ResetEdge(name string) error {
 	switch name {
-	case application.EdgeGroups:
-		m.ResetGroups()
-		return nil
-	case application.EdgeTags:
-		m.ResetTags()
+	case apitoken.EdgeUser:
+		m.ResetUser()
 		return nil
 	}
-	return fmt.Errorf("unknown Application edge %s", name)
+	return fmt.Errorf("unknown APIToken edge %s", name)
 }
 
-// CounterMutation represents an operation that mutate the Counters
+// ApplicationMutation represents an operation that mutate the Applications
 // nodes in the graph.
-type CounterMutation struct {
+type ApplicationMutation struct {
 	config
-	op            Op
-	typ           string
-	id            *int
-	time          *int64
-	addtime       *int64
-	count         *int64
-	addcount      *int64
-	wID           *string
-	clearedFields map[string]struct{}
-	metric        *int
-	clearedmetric bool
-	done          bool
-	oldValue      func(context.Context) (*Counter, error)
+	op                 Op
+	typ                string
+	id                 *int
+	name               *string
+	status             *string
+	created_at         *time.Time
+	started_at         *time.Time
+	updated_at         *time.Time
+	finished_at        *time.Time
+	scenario           *string
+	gomod              *string
+	gosum              *string
+	sinks              *[]sink.Config
+	sink_tokens        *string
+	imported           *bool
+	verdict            *string
+	checks             *[]verdict.Check
+	baseline_app_id    *int
+	addbaseline_app_id *int
+	clearedFields      map[string]struct{}
+	groups             map[int]struct{}
+	removedgroups      map[int]struct{}
+	clearedgroups      bool
+	tags               map[int]struct{}
+	removedtags        map[int]struct{}
+	clearedtags        bool
+	baselines          map[int]struct{}
+	removedbaselines   map[int]struct{}
+	clearedbaselines   bool
+	events             map[int]struct{}
+	removedevents      map[int]struct{}
+	clearedevents      bool
+	executors          map[int]struct{}
+	removedexecutors   map[int]struct{}
+	clearedexecutors   bool
+	project            *int
+	clearedproject     bool
+	done               bool
+	oldValue           func(context.Context) (*Application, error)
 }
 
-var _ ent.Mutation = (*CounterMutation)(nil)
+var _ ent.Mutation = (*ApplicationMutation)(nil)
 
-// counterOption allows to manage the mutation configuration using functional options.
-type counterOption func(*CounterMutation)
+// applicationOption allows to manage the mutation configuration using functional options.
+type applicationOption func(*ApplicationMutation)
 
-// newCounterMutation creates new mutation for $n.Name.
-func newCounterMutation(c config, op Op, opts ...counterOption) *CounterMutation {
-	m := &CounterMutation{
+// newApplicationMutation creates new mutation for $n.Name.
+func newApplicationMutation(c config, op Op, opts ...applicationOption) *ApplicationMutation {
+	m := &ApplicationMutation{
 		config:        c,
 		op:            op,
-		typ:           TypeCounter,
+		typ:           TypeApplication,
 		clearedFields: make(map[string]struct{}),
 	}
 	for _, opt := range opts {
@@ -951,20 +858,20 @@ func newCounterMutation(c config, op Op, opts ...counterOption) *CounterMutation
 	return m
 }
 
-// withCounterID sets the id field of the mutation.
-func withCounterID(id int) counterOption {
-	return func(m *CounterMutation) {
+// withApplicationID sets the id field of the mutation.
+func withApplicationID(id int) applicationOption {
+	return func(m *ApplicationMutation) {
 		var (
 			err   error
 			once  sync.Once
-			value *Counter
+			value *Application
 		)
-		m.oldValue = func(ctx context.Context) (*Counter, error) {
+		m.oldValue = func(ctx context.Context) (*Application, error) {
 			once.Do(func() {
 				if m.done {
 					err = fmt.Errorf("querying old values post mutation is not allowed")
 				} else {
-					value, err = m.Client().Counter.Get(ctx, id)
+					value, err = m.Client().Application.Get(ctx, id)
 				}
 			})
 			return value, err
@@ -973,10 +880,10 @@ func withCounterID(id int) counterOption {
 	}
 }
 
-// withCounter sets the old Counter of the mutation.
-func withCounter(node *Counter) counterOption {
-	return func(m *CounterMutation) {
-		m.oldValue = func(context.Context) (*Counter, error) {
+// withApplication sets the old Application of the mutation.
+func withApplication(node *Application) applicationOption {
+	return func(m *ApplicationMutation) {
+		m.oldValue = func(context.Context) (*Application, error) {
 			return node, nil
 		}
 		m.id = &node.ID
@@ -985,7 +892,7 @@ func withCounter(node *Counter) counterOption {
 
 // Client returns a new `ent.Client` from the mutation. If the mutation was
 // executed in a transaction (ent.Tx), a transactional client is returned.
-func (m CounterMutation) Client() *Client {
+func (m ApplicationMutation) Client() *Client {
 	client := &Client{config: m.config}
 	client.init()
 	return client
@@ -993,7 +900,7 @@ func (m CounterMutation) Client() *Client {
 
 // Tx returns an `ent.Tx` for mutations that were executed in transactions;
 // it returns an error otherwise.
-func (m CounterMutation) Tx() (*Tx, error) {
+func (m ApplicationMutation) Tx() (*Tx, error) {
 	if _, ok := m.driver.(*txDriver); !ok {
 		return nil, fmt.Errorf("ent: mutation is not running in a transaction")
 	}
@@ -1004,1281 +911,1056 @@ func (m CounterMutation) Tx() (*Tx, error) {
 
 // ID returns the id value in the mutation. Note that, the id
 // is available only if it was provided to the builder.
-func (m *CounterMutation) ID() (id int, exists bool) {
+func (m *ApplicationMutation) ID() (id int, exists bool) {
 	if m.id == nil {
 		return
 	}
 	return *m.id, true
 }
 
-// SetTime sets the time field.
-func (m *CounterMutation) SetTime(i int64) {
-	m.time = &i
-	m.addtime = nil
+// SetName sets the name field.
+func (m *ApplicationMutation) SetName(s string) {
+	m.name = &s
 }
 
-// Time returns the time value in the mutation.
-func (m *CounterMutation) Time() (r int64, exists bool) {
-	v := m.time
+// Name returns the name value in the mutation.
+func (m *ApplicationMutation) Name() (r string, exists bool) {
+	v := m.name
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldTime returns the old time value of the Counter.
-// If the Counter object wasn't provided to the builder, the object is fetched
+// OldName returns the old name value of the Application.
+// If the Application object wasn't provided to the builder, the object is fetched
 // from the database.
 // An error is returned if the mutation operation is not UpdateOne, or database query fails.
-func (m *CounterMutation) OldTime(ctx context.Context) (v int64, err error) {
+func (m *ApplicationMutation) OldName(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, fmt.Errorf("OldTime is allowed only on UpdateOne operations")
+		return v, fmt.Errorf("OldName is allowed only on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, fmt.Errorf("OldTime requires an ID field in the mutation")
+		return v, fmt.Errorf("OldName requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldTime: %w", err)
+		return v, fmt.Errorf("querying old value for OldName: %w", err)
 	}
-	return oldValue.Time, nil
+	return oldValue.Name, nil
 }
 
-// AddTime adds i to time.
-func (m *CounterMutation) AddTime(i int64) {
-	if m.addtime != nil {
-		*m.addtime += i
-	} else {
-		m.addtime = &i
-	}
+// ResetName reset all changes of the "name" field.
+func (m *ApplicationMutation) ResetName() {
+	m.name = nil
 }
 
-// AddedTime returns the value that was added to the time field in this mutation.
-func (m *CounterMutation) AddedTime() (r int64, exists bool) {
-	v := m.addtime
+// SetStatus sets the status field.
+func (m *ApplicationMutation) SetStatus(s string) {
+	m.status = &s
+}
+
+// Status returns the status value in the mutation.
+func (m *ApplicationMutation) Status() (r string, exists bool) {
+	v := m.status
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// ResetTime reset all changes of the "time" field.
-func (m *CounterMutation) ResetTime() {
-	m.time = nil
-	m.addtime = nil
+// OldStatus returns the old status value of the Application.
+// If the Application object wasn't provided to the builder, the object is fetched
+// from the database.
+// An error is returned if the mutation operation is not UpdateOne, or database query fails.
+func (m *ApplicationMutation) OldStatus(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, fmt.Errorf("OldStatus is allowed only on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, fmt.Errorf("OldStatus requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldStatus: %w", err)
+	}
+	return oldValue.Status, nil
 }
 
-// SetCount sets the count field.
-func (m *CounterMutation) SetCount(i int64) {
-	m.count = &i
-	m.addcount = nil
+// ResetStatus reset all changes of the "status" field.
+func (m *ApplicationMutation) ResetStatus() {
+	m.status = nil
 }
 
-// Count returns the count value in the mutation.
-func (m *CounterMutation) Count() (r int64, exists bool) {
-	v := m.count
+// SetCreatedAt sets the created_at field.
+func (m *ApplicationMutation) SetCreatedAt(t time.Time) {
+	m.created_at = &t
+}
+
+// CreatedAt returns the created_at value in the mutation.
+func (m *ApplicationMutation) CreatedAt() (r time.Time, exists bool) {
+	v := m.created_at
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldCount returns the old count value of the Counter.
-// If the Counter object wasn't provided to the builder, the object is fetched
+// OldCreatedAt returns the old created_at value of the Application.
+// If the Application object wasn't provided to the builder, the object is fetched
 // from the database.
 // An error is returned if the mutation operation is not UpdateOne, or database query fails.
-func (m *CounterMutation) OldCount(ctx context.Context) (v int64, err error) {
+func (m *ApplicationMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, fmt.Errorf("OldCount is allowed only on UpdateOne operations")
+		return v, fmt.Errorf("OldCreatedAt is allowed only on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, fmt.Errorf("OldCount requires an ID field in the mutation")
+		return v, fmt.Errorf("OldCreatedAt requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldCount: %w", err)
+		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
 	}
-	return oldValue.Count, nil
+	return oldValue.CreatedAt, nil
 }
 
-// AddCount adds i to count.
-func (m *CounterMutation) AddCount(i int64) {
-	if m.addcount != nil {
-		*m.addcount += i
-	} else {
-		m.addcount = &i
-	}
+// ResetCreatedAt reset all changes of the "created_at" field.
+func (m *ApplicationMutation) ResetCreatedAt() {
+	m.created_at = nil
 }
 
-// AddedCount returns the value that was added to the count field in this mutation.
-func (m *CounterMutation) AddedCount() (r int64, exists bool) {
-	v := m.addcount
+// SetStartedAt sets the started_at field.
+func (m *ApplicationMutation) SetStartedAt(t time.Time) {
+	m.started_at = &t
+}
+
+// StartedAt returns the started_at value in the mutation.
+func (m *ApplicationMutation) StartedAt() (r time.Time, exists bool) {
+	v := m.started_at
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// ResetCount reset all changes of the "count" field.
-func (m *CounterMutation) ResetCount() {
-	m.count = nil
-	m.addcount = nil
+// OldStartedAt returns the old started_at value of the Application.
+// If the Application object wasn't provided to the builder, the object is fetched
+// from the database.
+// An error is returned if the mutation operation is not UpdateOne, or database query fails.
+func (m *ApplicationMutation) OldStartedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, fmt.Errorf("OldStartedAt is allowed only on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, fmt.Errorf("OldStartedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldStartedAt: %w", err)
+	}
+	return oldValue.StartedAt, nil
 }
 
-// SetWID sets the wID field.
-func (m *CounterMutation) SetWID(s string) {
-	m.wID = &s
+// ClearStartedAt clears the value of started_at.
+func (m *ApplicationMutation) ClearStartedAt() {
+	m.started_at = nil
+	m.clearedFields[application.FieldStartedAt] = struct{}{}
 }
 
-// WID returns the wID value in the mutation.
-func (m *CounterMutation) WID() (r string, exists bool) {
-	v := m.wID
+// StartedAtCleared returns if the field started_at was cleared in this mutation.
+func (m *ApplicationMutation) StartedAtCleared() bool {
+	_, ok := m.clearedFields[application.FieldStartedAt]
+	return ok
+}
+
+// ResetStartedAt reset all changes of the "started_at" field.
+func (m *ApplicationMutation) ResetStartedAt() {
+	m.started_at = nil
+	delete(m.clearedFields, application.FieldStartedAt)
+}
+
+// SetUpdatedAt sets the updated_at field.
+func (m *ApplicationMutation) SetUpdatedAt(t time.Time) {
+	m.updated_at = &t
+}
+
+// UpdatedAt returns the updated_at value in the mutation.
+func (m *ApplicationMutation) UpdatedAt() (r time.Time, exists bool) {
+	v := m.updated_at
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldWID returns the old wID value of the Counter.
-// If the Counter object wasn't provided to the builder, the object is fetched
+// OldUpdatedAt returns the old updated_at value of the Application.
+// If the Application object wasn't provided to the builder, the object is fetched
 // from the database.
 // An error is returned if the mutation operation is not UpdateOne, or database query fails.
-func (m *CounterMutation) OldWID(ctx context.Context) (v string, err error) {
+func (m *ApplicationMutation) OldUpdatedAt(ctx context.Context) (v time.Time, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, fmt.Errorf("OldWID is allowed only on UpdateOne operations")
+		return v, fmt.Errorf("OldUpdatedAt is allowed only on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, fmt.Errorf("OldWID requires an ID field in the mutation")
+		return v, fmt.Errorf("OldUpdatedAt requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldWID: %w", err)
+		return v, fmt.Errorf("querying old value for OldUpdatedAt: %w", err)
 	}
-	return oldValue.WID, nil
-}
-
-// ResetWID reset all changes of the "wID" field.
-func (m *CounterMutation) ResetWID() {
-	m.wID = nil
-}
-
-// SetMetricID sets the metric edge to Metric by id.
-func (m *CounterMutation) SetMetricID(id int) {
-	m.metric = &id
+	return oldValue.UpdatedAt, nil
 }
 
-// ClearMetric clears the metric edge to Metric.
-func (m *CounterMutation) ClearMetric() {
-	m.clearedmetric = true
+// ResetUpdatedAt reset all changes of the "updated_at" field.
+func (m *ApplicationMutation) ResetUpdatedAt() {
+	m.updated_at = nil
 }
 
-// MetricCleared returns if the edge metric was cleared.
-func (m *CounterMutation) MetricCleared() bool {
-	return m.clearedmetric
+// SetFinishedAt sets the finished_at field.
+func (m *ApplicationMutation) SetFinishedAt(t time.Time) {
+	m.finished_at = &t
 }
 
-// MetricID returns the metric id in the mutation.
-func (m *CounterMutation) MetricID() (id int, exists bool) {
-	if m.metric != nil {
-		return *m.metric, true
+// FinishedAt returns the finished_at value in the mutation.
+func (m *ApplicationMutation) FinishedAt() (r time.Time, exists bool) {
+	v := m.finished_at
+	if v == nil {
+		return
 	}
-	return
+	return *v, true
 }
 
-// MetricIDs returns the metric ids in the mutation.
-// Note that ids always returns len(ids) <= 1 for unique edges, and you should use
-// MetricID instead. It exists only for internal usage by the builders.
-func (m *CounterMutation) MetricIDs() (ids []int) {
-	if id := m.metric; id != nil {
-		ids = append(ids, *id)
+// OldFinishedAt returns the old finished_at value of the Application.
+// If the Application object wasn't provided to the builder, the object is fetched
+// from the database.
+// An error is returned if the mutation operation is not UpdateOne, or database query fails.
+func (m *ApplicationMutation) OldFinishedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, fmt.Errorf("OldFinishedAt is allowed only on UpdateOne operations")
 	}
-	return
+	if m.id == nil || m.oldValue == nil {
+		return v, fmt.Errorf("OldFinishedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldFinishedAt: %w", err)
+	}
+	return oldValue.FinishedAt, nil
 }
 
-// ResetMetric reset all changes of the "metric" edge.
-func (m *CounterMutation) ResetMetric() {
-	m.metric = nil
-	m.clearedmetric = false
+// ClearFinishedAt clears the value of finished_at.
+func (m *ApplicationMutation) ClearFinishedAt() {
+	m.finished_at = nil
+	m.clearedFields[application.FieldFinishedAt] = struct{}{}
 }
 
-// Op returns the operation name.
-func (m *CounterMutation) Op() Op {
-	return m.op
+// FinishedAtCleared returns if the field finished_at was cleared in this mutation.
+func (m *ApplicationMutation) FinishedAtCleared() bool {
+	_, ok := m.clearedFields[application.FieldFinishedAt]
+	return ok
 }
 
-// Type returns the node type of this mutation (Counter).
-func (m *CounterMutation) Type() string {
-	return m.typ
+// ResetFinishedAt reset all changes of the "finished_at" field.
+func (m *ApplicationMutation) ResetFinishedAt() {
+	m.finished_at = nil
+	delete(m.clearedFields, application.FieldFinishedAt)
 }
 
-// Fields returns all fields that were changed during
-// this mutation. Note that, in order to get all numeric
-// fields that were in/decremented, call AddedFields().
-func (m *CounterMutation) Fields() []string {
-	fields := make([]string, 0, 3)
-	if m.time != nil {
-		fields = append(fields, counter.FieldTime)
+// SetScenario sets the scenario field.
+func (m *ApplicationMutation) SetScenario(s string) {
+	m.scenario = &s
+}
+
+// Scenario returns the scenario value in the mutation.
+func (m *ApplicationMutation) Scenario() (r string, exists bool) {
+	v := m.scenario
+	if v == nil {
+		return
 	}
-	if m.count != nil {
-		fields = append(fields, counter.FieldCount)
+	return *v, true
+}
+
+// OldScenario returns the old scenario value of the Application.
+// If the Application object wasn't provided to the builder, the object is fetched
+// from the database.
+// An error is returned if the mutation operation is not UpdateOne, or database query fails.
+func (m *ApplicationMutation) OldScenario(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, fmt.Errorf("OldScenario is allowed only on UpdateOne operations")
 	}
-	if m.wID != nil {
-		fields = append(fields, counter.FieldWID)
+	if m.id == nil || m.oldValue == nil {
+		return v, fmt.Errorf("OldScenario requires an ID field in the mutation")
 	}
-	return fields
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldScenario: %w", err)
+	}
+	return oldValue.Scenario, nil
 }
 
-// Field returns the value of a field with the given name.
-// The second boolean value indicates that this field was
-// not set, or was not define in the schema.
-func (m *CounterMutation) Field(name string) (ent.Value, bool) {
-	switch name {
-	case counter.FieldTime:
-		return m.Time()
-	case counter.FieldCount:
-		return m.Count()
-	case counter.FieldWID:
-		return m.WID()
-	}
-	return nil, false
+// ResetScenario reset all changes of the "scenario" field.
+func (m *ApplicationMutation) ResetScenario() {
+	m.scenario = nil
 }
 
-// OldField returns the old value of the field from the database.
-// An error is returned if the mutation operation is not UpdateOne,
-// or the query to the database was failed.
-func (m *CounterMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
-	switch name {
-	case counter.FieldTime:
-		return m.OldTime(ctx)
-	case counter.FieldCount:
-		return m.OldCount(ctx)
-	case counter.FieldWID:
-		return m.OldWID(ctx)
-	}
-	return nil, fmt.Errorf("unknown Counter field %s", name)
+// SetGomod sets the gomod field.
+func (m *ApplicationMutation) SetGomod(s string) {
+	m.gomod = &s
 }
 
-// SetField sets the value for the given name. It returns an
-// error if the field is not defined in the schema, or if the
-// type mismatch the field type.
-func (m *CounterMutation) SetField(name string, value ent.Value) error {
-	switch name {
-	case counter.FieldTime:
-		v, ok := value.(int64)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetTime(v)
-		return nil
-	case counter.FieldCount:
-		v, ok := value.(int64)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetCount(v)
-		return nil
-	case counter.FieldWID:
-		v, ok := value.(string)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetWID(v)
-		return nil
+// Gomod returns the gomod value in the mutation.
+func (m *ApplicationMutation) Gomod() (r string, exists bool) {
+	v := m.gomod
+	if v == nil {
+		return
 	}
-	return fmt.Errorf("unknown Counter field %s", name)
+	return *v, true
 }
 
-// AddedFields returns all numeric fields that were incremented
-// or decremented during this mutation.
-func (m *CounterMutation) AddedFields() []string {
-	var fields []string
-	if m.addtime != nil {
-		fields = append(fields, counter.FieldTime)
+// OldGomod returns the old gomod value of the Application.
+// If the Application object wasn't provided to the builder, the object is fetched
+// from the database.
+// An error is returned if the mutation operation is not UpdateOne, or database query fails.
+func (m *ApplicationMutation) OldGomod(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, fmt.Errorf("OldGomod is allowed only on UpdateOne operations")
 	}
-	if m.addcount != nil {
-		fields = append(fields, counter.FieldCount)
+	if m.id == nil || m.oldValue == nil {
+		return v, fmt.Errorf("OldGomod requires an ID field in the mutation")
 	}
-	return fields
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldGomod: %w", err)
+	}
+	return oldValue.Gomod, nil
 }
 
-// AddedField returns the numeric value that was in/decremented
-// from a field with the given name. The second value indicates
-// that this field was not set, or was not define in the schema.
-func (m *CounterMutation) AddedField(name string) (ent.Value, bool) {
-	switch name {
-	case counter.FieldTime:
-		return m.AddedTime()
-	case counter.FieldCount:
-		return m.AddedCount()
-	}
-	return nil, false
+// ResetGomod reset all changes of the "gomod" field.
+func (m *ApplicationMutation) ResetGomod() {
+	m.gomod = nil
 }
 
-// AddField adds the value for the given name. It returns an
-// error if the field is not defined in the schema, or if the
-// type mismatch the field type.
-func (m *CounterMutation) AddField(name string, value ent.Value) error {
-	switch name {
-	case counter.FieldTime:
-		v, ok := value.(int64)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.AddTime(v)
-		return nil
-	case counter.FieldCount:
-		v, ok := value.(int64)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.AddCount(v)
-		return nil
+// SetGosum sets the gosum field.
+func (m *ApplicationMutation) SetGosum(s string) {
+	m.gosum = &s
+}
+
+// Gosum returns the gosum value in the mutation.
+func (m *ApplicationMutation) Gosum() (r string, exists bool) {
+	v := m.gosum
+	if v == nil {
+		return
 	}
-	return fmt.Errorf("unknown Counter numeric field %s", name)
+	return *v, true
 }
 
-// ClearedFields returns all nullable fields that were cleared
-// during this mutation.
-func (m *CounterMutation) ClearedFields() []string {
-	return nil
+// OldGosum returns the old gosum value of the Application.
+// If the Application object wasn't provided to the builder, the object is fetched
+// from the database.
+// An error is returned if the mutation operation is not UpdateOne, or database query fails.
+func (m *ApplicationMutation) OldGosum(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, fmt.Errorf("OldGosum is allowed only on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, fmt.Errorf("OldGosum requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldGosum: %w", err)
+	}
+	return oldValue.Gosum, nil
 }
 
-// FieldCleared returns a boolean indicates if this field was
-// cleared in this mutation.
-func (m *CounterMutation) FieldCleared(name string) bool {
-	_, ok := m.clearedFields[name]
-	return ok
+// ResetGosum reset all changes of the "gosum" field.
+func (m *ApplicationMutation) ResetGosum() {
+	m.gosum = nil
 }
 
-// ClearField clears the value for the given name. It returns an
-// error if the field is not defined in the schema.
-func (m *CounterMutation) ClearField(name string) error {
-	return fmt.Errorf("unknown Counter nullable field %s", name)
+// SetSinks sets the sinks field.
+func (m *ApplicationMutation) SetSinks(s []sink.Config) {
+	m.sinks = &s
 }
 
-// ResetField resets all changes in the mutation regarding the
-// given field name. It returns an error if the field is not
-// defined in the schema.
-func (m *CounterMutation) ResetField(name string) error {
-	switch name {
-	case counter.FieldTime:
-		m.ResetTime()
-		return nil
-	case counter.FieldCount:
-		m.ResetCount()
-		return nil
-	case counter.FieldWID:
-		m.ResetWID()
-		return nil
+// Sinks returns the sinks value in the mutation.
+func (m *ApplicationMutation) Sinks() (r []sink.Config, exists bool) {
+	v := m.sinks
+	if v == nil {
+		return
 	}
-	return fmt.Errorf("unknown Counter field %s", name)
+	return *v, true
 }
 
-// AddedEdges returns all edge names that were set/added in this
-// mutation.
-func (m *CounterMutation) AddedEdges() []string {
-	edges := make([]string, 0, 1)
-	if m.metric != nil {
-		edges = append(edges, counter.EdgeMetric)
+// OldSinks returns the old sinks value of the Application.
+// If the Application object wasn't provided to the builder, the object is fetched
+// from the database.
+// An error is returned if the mutation operation is not UpdateOne, or database query fails.
+func (m *ApplicationMutation) OldSinks(ctx context.Context) (v []sink.Config, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, fmt.Errorf("OldSinks is allowed only on UpdateOne operations")
 	}
-	return edges
+	if m.id == nil || m.oldValue == nil {
+		return v, fmt.Errorf("OldSinks requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldSinks: %w", err)
+	}
+	return oldValue.Sinks, nil
 }
 
-// AddedIDs returns all ids (to other nodes) that were added for
-// the given edge name.
-func (m *CounterMutation) AddedIDs(name string) []ent.Value {
-	switch name {
-	case counter.EdgeMetric:
-		if id := m.metric; id != nil {
-			return []ent.Value{*id}
-		}
-	}
-	return nil
+// ClearSinks clears the value of sinks.
+func (m *ApplicationMutation) ClearSinks() {
+	m.sinks = nil
+	m.clearedFields[application.FieldSinks] = struct{}{}
 }
 
-// RemovedEdges returns all edge names that were removed in this
-// mutation.
-func (m *CounterMutation) RemovedEdges() []string {
-	edges := make([]string, 0, 1)
-	return edges
+// SinksCleared returns if the field sinks was cleared in this mutation.
+func (m *ApplicationMutation) SinksCleared() bool {
+	_, ok := m.clearedFields[application.FieldSinks]
+	return ok
 }
 
-// RemovedIDs returns all ids (to other nodes) that were removed for
-// the given edge name.
-func (m *CounterMutation) RemovedIDs(name string) []ent.Value {
-	switch name {
-	}
-	return nil
+// ResetSinks reset all changes of the "sinks" field.
+func (m *ApplicationMutation) ResetSinks() {
+	m.sinks = nil
+	delete(m.clearedFields, application.FieldSinks)
 }
 
-// ClearedEdges returns all edge names that were cleared in this
-// mutation.
-func (m *CounterMutation) ClearedEdges() []string {
-	edges := make([]string, 0, 1)
-	if m.clearedmetric {
-		edges = append(edges, counter.EdgeMetric)
-	}
-	return edges
+// SetSinkTokens sets the sink_tokens field.
+func (m *ApplicationMutation) SetSinkTokens(s string) {
+	m.sink_tokens = &s
 }
 
-// EdgeCleared returns a boolean indicates if this edge was
-// cleared in this mutation.
-func (m *CounterMutation) EdgeCleared(name string) bool {
-	switch name {
-	case counter.EdgeMetric:
-		return m.clearedmetric
+// SinkTokens returns the sink_tokens value in the mutation.
+func (m *ApplicationMutation) SinkTokens() (r string, exists bool) {
+	v := m.sink_tokens
+	if v == nil {
+		return
 	}
-	return false
+	return *v, true
 }
 
-// ClearEdge clears the value for the given name. It returns an
-// error if the edge name is not defined in the schema.
-func (m *CounterMutation) ClearEdge(name string) error {
-	switch name {
-	case counter.EdgeMetric:
-		m.ClearMetric()
-		return nil
+// OldSinkTokens returns the old sink_tokens value of the Application.
+// If the Application object wasn't provided to the builder, the object is fetched
+// from the database.
+// An error is returned if the mutation operation is not UpdateOne, or database query fails.
+func (m *ApplicationMutation) OldSinkTokens(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, fmt.Errorf("OldSinkTokens is allowed only on UpdateOne operations")
 	}
-	return fmt.Errorf("unknown Counter unique edge %s", name)
-}
-
-// ResetEdge resets all changes in the mutation regarding the
-// given edge name. It returns an error if the edge is not
-// defined in the schema.
-func (m *CounterMutation) ResetEdge(name string) error {
-	switch name {
-	case counter.EdgeMetric:
-		m.ResetMetric()
-		return nil
+	if m.id == nil || m.oldValue == nil {
+		return v, fmt.Errorf("OldSinkTokens requires an ID field in the mutation")
 	}
-	return fmt.Errorf("unknown Counter edge %s", name)
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldSinkTokens: %w", err)
+	}
+	return oldValue.SinkTokens, nil
 }
 
-// GaugeMutation represents an operation that mutate the Gauges
-// nodes in the graph.
-type GaugeMutation struct {
-	config
-	op            Op
-	typ           string
-	id            *int
-	time          *int64
-	addtime       *int64
-	value         *int64
-	addvalue      *int64
-	wID           *string
-	clearedFields map[string]struct{}
-	metric        *int
-	clearedmetric bool
-	done          bool
-	oldValue      func(context.Context) (*Gauge, error)
+// ClearSinkTokens clears the value of sink_tokens.
+func (m *ApplicationMutation) ClearSinkTokens() {
+	m.sink_tokens = nil
+	m.clearedFields[application.FieldSinkTokens] = struct{}{}
 }
 
-var _ ent.Mutation = (*GaugeMutation)(nil)
+// SinkTokensCleared returns if the field sink_tokens was cleared in this mutation.
+func (m *ApplicationMutation) SinkTokensCleared() bool {
+	_, ok := m.clearedFields[application.FieldSinkTokens]
+	return ok
+}
 
-// gaugeOption allows to manage the mutation configuration using functional options.
-type gaugeOption func(*GaugeMutation)
+// ResetSinkTokens reset all changes of the "sink_tokens" field.
+func (m *ApplicationMutation) ResetSinkTokens() {
+	m.sink_tokens = nil
+	delete(m.clearedFields, application.FieldSinkTokens)
+}
 
-// newGaugeMutation creates new mutation for $n.Name.
-func newGaugeMutation(c config, op Op, opts ...gaugeOption) *GaugeMutation {
-	m := &GaugeMutation{
-		config:        c,
-		op:            op,
-		typ:           TypeGauge,
-		clearedFields: make(map[string]struct{}),
-	}
-	for _, opt := range opts {
-		opt(m)
-	}
-	return m
+// SetImported sets the imported field.
+func (m *ApplicationMutation) SetImported(b bool) {
+	m.imported = &b
 }
 
-// withGaugeID sets the id field of the mutation.
-func withGaugeID(id int) gaugeOption {
-	return func(m *GaugeMutation) {
-		var (
-			err   error
-			once  sync.Once
-			value *Gauge
-		)
-		m.oldValue = func(ctx context.Context) (*Gauge, error) {
-			once.Do(func() {
-				if m.done {
-					err = fmt.Errorf("querying old values post mutation is not allowed")
-				} else {
-					value, err = m.Client().Gauge.Get(ctx, id)
-				}
-			})
-			return value, err
-		}
-		m.id = &id
+// Imported returns the imported value in the mutation.
+func (m *ApplicationMutation) Imported() (r bool, exists bool) {
+	v := m.imported
+	if v == nil {
+		return
 	}
+	return *v, true
 }
 
-// withGauge sets the old Gauge of the mutation.
-func withGauge(node *Gauge) gaugeOption {
-	return func(m *GaugeMutation) {
-		m.oldValue = func(context.Context) (*Gauge, error) {
-			return node, nil
-		}
-		m.id = &node.ID
+// OldImported returns the old imported value of the Application.
+// If the Application object wasn't provided to the builder, the object is fetched
+// from the database.
+// An error is returned if the mutation operation is not UpdateOne, or database query fails.
+func (m *ApplicationMutation) OldImported(ctx context.Context) (v bool, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, fmt.Errorf("OldImported is allowed only on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, fmt.Errorf("OldImported requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldImported: %w", err)
 	}
+	return oldValue.Imported, nil
 }
 
-// Client returns a new `ent.Client` from the mutation. If the mutation was
-// executed in a transaction (ent.Tx), a transactional client is returned.
-func (m GaugeMutation) Client() *Client {
-	client := &Client{config: m.config}
-	client.init()
-	return client
+// ClearImported clears the value of imported.
+func (m *ApplicationMutation) ClearImported() {
+	m.imported = nil
+	m.clearedFields[application.FieldImported] = struct{}{}
 }
 
-// Tx returns an `ent.Tx` for mutations that were executed in transactions;
-// it returns an error otherwise.
-func (m GaugeMutation) Tx() (*Tx, error) {
-	if _, ok := m.driver.(*txDriver); !ok {
-		return nil, fmt.Errorf("ent: mutation is not running in a transaction")
-	}
-	tx := &Tx{config: m.config}
-	tx.init()
-	return tx, nil
+// ImportedCleared returns if the field imported was cleared in this mutation.
+func (m *ApplicationMutation) ImportedCleared() bool {
+	_, ok := m.clearedFields[application.FieldImported]
+	return ok
 }
 
-// ID returns the id value in the mutation. Note that, the id
-// is available only if it was provided to the builder.
-func (m *GaugeMutation) ID() (id int, exists bool) {
-	if m.id == nil {
-		return
-	}
-	return *m.id, true
+// ResetImported reset all changes of the "imported" field.
+func (m *ApplicationMutation) ResetImported() {
+	m.imported = nil
+	delete(m.clearedFields, application.FieldImported)
 }
 
-// SetTime sets the time field.
-func (m *GaugeMutation) SetTime(i int64) {
-	m.time = &i
-	m.addtime = nil
+// SetVerdict sets the verdict field.
+func (m *ApplicationMutation) SetVerdict(s string) {
+	m.verdict = &s
 }
 
-// Time returns the time value in the mutation.
-func (m *GaugeMutation) Time() (r int64, exists bool) {
-	v := m.time
+// Verdict returns the verdict value in the mutation.
+func (m *ApplicationMutation) Verdict() (r string, exists bool) {
+	v := m.verdict
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldTime returns the old time value of the Gauge.
-// If the Gauge object wasn't provided to the builder, the object is fetched
+// OldVerdict returns the old verdict value of the Application.
+// If the Application object wasn't provided to the builder, the object is fetched
 // from the database.
 // An error is returned if the mutation operation is not UpdateOne, or database query fails.
-func (m *GaugeMutation) OldTime(ctx context.Context) (v int64, err error) {
+func (m *ApplicationMutation) OldVerdict(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, fmt.Errorf("OldTime is allowed only on UpdateOne operations")
+		return v, fmt.Errorf("OldVerdict is allowed only on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, fmt.Errorf("OldTime requires an ID field in the mutation")
+		return v, fmt.Errorf("OldVerdict requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldTime: %w", err)
+		return v, fmt.Errorf("querying old value for OldVerdict: %w", err)
 	}
-	return oldValue.Time, nil
+	return oldValue.Verdict, nil
 }
 
-// AddTime adds i to time.
-func (m *GaugeMutation) AddTime(i int64) {
-	if m.addtime != nil {
-		*m.addtime += i
-	} else {
-		m.addtime = &i
-	}
+// ClearVerdict clears the value of verdict.
+func (m *ApplicationMutation) ClearVerdict() {
+	m.verdict = nil
+	m.clearedFields[application.FieldVerdict] = struct{}{}
 }
 
-// AddedTime returns the value that was added to the time field in this mutation.
-func (m *GaugeMutation) AddedTime() (r int64, exists bool) {
-	v := m.addtime
-	if v == nil {
-		return
-	}
-	return *v, true
+// VerdictCleared returns if the field verdict was cleared in this mutation.
+func (m *ApplicationMutation) VerdictCleared() bool {
+	_, ok := m.clearedFields[application.FieldVerdict]
+	return ok
 }
 
-// ResetTime reset all changes of the "time" field.
-func (m *GaugeMutation) ResetTime() {
-	m.time = nil
-	m.addtime = nil
+// ResetVerdict reset all changes of the "verdict" field.
+func (m *ApplicationMutation) ResetVerdict() {
+	m.verdict = nil
+	delete(m.clearedFields, application.FieldVerdict)
 }
 
-// SetValue sets the value field.
-func (m *GaugeMutation) SetValue(i int64) {
-	m.value = &i
-	m.addvalue = nil
+// SetChecks sets the checks field.
+func (m *ApplicationMutation) SetChecks(v []verdict.Check) {
+	m.checks = &v
 }
 
-// Value returns the value value in the mutation.
-func (m *GaugeMutation) Value() (r int64, exists bool) {
-	v := m.value
+// Checks returns the checks value in the mutation.
+func (m *ApplicationMutation) Checks() (r []verdict.Check, exists bool) {
+	v := m.checks
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldValue returns the old value value of the Gauge.
-// If the Gauge object wasn't provided to the builder, the object is fetched
+// OldChecks returns the old checks value of the Application.
+// If the Application object wasn't provided to the builder, the object is fetched
 // from the database.
 // An error is returned if the mutation operation is not UpdateOne, or database query fails.
-func (m *GaugeMutation) OldValue(ctx context.Context) (v int64, err error) {
+func (m *ApplicationMutation) OldChecks(ctx context.Context) (v []verdict.Check, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, fmt.Errorf("OldValue is allowed only on UpdateOne operations")
+		return v, fmt.Errorf("OldChecks is allowed only on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, fmt.Errorf("OldValue requires an ID field in the mutation")
+		return v, fmt.Errorf("OldChecks requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldValue: %w", err)
+		return v, fmt.Errorf("querying old value for OldChecks: %w", err)
 	}
-	return oldValue.Value, nil
+	return oldValue.Checks, nil
 }
 
-// AddValue adds i to value.
-func (m *GaugeMutation) AddValue(i int64) {
-	if m.addvalue != nil {
-		*m.addvalue += i
-	} else {
-		m.addvalue = &i
-	}
+// ClearChecks clears the value of checks.
+func (m *ApplicationMutation) ClearChecks() {
+	m.checks = nil
+	m.clearedFields[application.FieldChecks] = struct{}{}
 }
 
-// AddedValue returns the value that was added to the value field in this mutation.
-func (m *GaugeMutation) AddedValue() (r int64, exists bool) {
-	v := m.addvalue
-	if v == nil {
-		return
-	}
-	return *v, true
+// ChecksCleared returns if the field checks was cleared in this mutation.
+func (m *ApplicationMutation) ChecksCleared() bool {
+	_, ok := m.clearedFields[application.FieldChecks]
+	return ok
 }
 
-// ResetValue reset all changes of the "value" field.
-func (m *GaugeMutation) ResetValue() {
-	m.value = nil
-	m.addvalue = nil
+// ResetChecks reset all changes of the "checks" field.
+func (m *ApplicationMutation) ResetChecks() {
+	m.checks = nil
+	delete(m.clearedFields, application.FieldChecks)
 }
 
-// SetWID sets the wID field.
-func (m *GaugeMutation) SetWID(s string) {
-	m.wID = &s
+// SetBaselineAppID sets the baseline_app_id field.
+func (m *ApplicationMutation) SetBaselineAppID(i int) {
+	m.baseline_app_id = &i
+	m.addbaseline_app_id = nil
 }
 
-// WID returns the wID value in the mutation.
-func (m *GaugeMutation) WID() (r string, exists bool) {
-	v := m.wID
+// BaselineAppID returns the baseline_app_id value in the mutation.
+func (m *ApplicationMutation) BaselineAppID() (r int, exists bool) {
+	v := m.baseline_app_id
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldWID returns the old wID value of the Gauge.
-// If the Gauge object wasn't provided to the builder, the object is fetched
+// OldBaselineAppID returns the old baseline_app_id value of the Application.
+// If the Application object wasn't provided to the builder, the object is fetched
 // from the database.
 // An error is returned if the mutation operation is not UpdateOne, or database query fails.
-func (m *GaugeMutation) OldWID(ctx context.Context) (v string, err error) {
+func (m *ApplicationMutation) OldBaselineAppID(ctx context.Context) (v int, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, fmt.Errorf("OldWID is allowed only on UpdateOne operations")
+		return v, fmt.Errorf("OldBaselineAppID is allowed only on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, fmt.Errorf("OldWID requires an ID field in the mutation")
+		return v, fmt.Errorf("OldBaselineAppID requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldWID: %w", err)
+		return v, fmt.Errorf("querying old value for OldBaselineAppID: %w", err)
 	}
-	return oldValue.WID, nil
+	return oldValue.BaselineAppID, nil
 }
 
-// ResetWID reset all changes of the "wID" field.
-func (m *GaugeMutation) ResetWID() {
-	m.wID = nil
+// AddBaselineAppID adds i to baseline_app_id.
+func (m *ApplicationMutation) AddBaselineAppID(i int) {
+	if m.addbaseline_app_id != nil {
+		*m.addbaseline_app_id += i
+	} else {
+		m.addbaseline_app_id = &i
+	}
 }
 
-// SetMetricID sets the metric edge to Metric by id.
-func (m *GaugeMutation) SetMetricID(id int) {
-	m.metric = &id
+// AddedBaselineAppID returns the value that was added to the baseline_app_id field in this mutation.
+func (m *ApplicationMutation) AddedBaselineAppID() (r int, exists bool) {
+	v := m.addbaseline_app_id
+	if v == nil {
+		return
+	}
+	return *v, true
 }
 
-// ClearMetric clears the metric edge to Metric.
-func (m *GaugeMutation) ClearMetric() {
-	m.clearedmetric = true
+// ClearBaselineAppID clears the value of baseline_app_id.
+func (m *ApplicationMutation) ClearBaselineAppID() {
+	m.baseline_app_id = nil
+	m.addbaseline_app_id = nil
+	m.clearedFields[application.FieldBaselineAppID] = struct{}{}
 }
 
-// MetricCleared returns if the edge metric was cleared.
-func (m *GaugeMutation) MetricCleared() bool {
-	return m.clearedmetric
+// BaselineAppIDCleared returns if the field baseline_app_id was cleared in this mutation.
+func (m *ApplicationMutation) BaselineAppIDCleared() bool {
+	_, ok := m.clearedFields[application.FieldBaselineAppID]
+	return ok
 }
 
-// MetricID returns the metric id in the mutation.
-func (m *GaugeMutation) MetricID() (id int, exists bool) {
-	if m.metric != nil {
-		return *m.metric, true
-	}
-	return
+// ResetBaselineAppID reset all changes of the "baseline_app_id" field.
+func (m *ApplicationMutation) ResetBaselineAppID() {
+	m.baseline_app_id = nil
+	m.addbaseline_app_id = nil
+	delete(m.clearedFields, application.FieldBaselineAppID)
 }
 
-// MetricIDs returns the metric ids in the mutation.
-// Note that ids always returns len(ids) <= 1 for unique edges, and you should use
-// MetricID instead. It exists only for internal usage by the builders.
-func (m *GaugeMutation) MetricIDs() (ids []int) {
-	if id := m.metric; id != nil {
-		ids = append(ids, *id)
+// AddGroupIDs adds the groups edge to Group by ids.
+func (m *ApplicationMutation) AddGroupIDs(ids ...int) {
+	if m.groups == nil {
+		m.groups = make(map[int]struct{})
+	}
+	for i := range ids {
+		m.groups[ids[i]] = struct{}{}
 	}
-	return
 }
 
-// ResetMetric reset all changes of the "metric" edge.
-func (m *GaugeMutation) ResetMetric() {
-	m.metric = nil
-	m.clearedmetric = false
-}
-
-// Op returns the operation name.
-func (m *GaugeMutation) Op() Op {
-	return m.op
+// ClearGroups clears the groups edge to Group.
+func (m *ApplicationMutation) ClearGroups() {
+	m.clearedgroups = true
 }
 
-// Type returns the node type of this mutation (Gauge).
-func (m *GaugeMutation) Type() string {
-	return m.typ
+// GroupsCleared returns if the edge groups was cleared.
+func (m *ApplicationMutation) GroupsCleared() bool {
+	return m.clearedgroups
 }
 
-// Fields returns all fields that were changed during
-// this mutation. Note that, in order to get all numeric
-// fields that were in/decremented, call AddedFields().
-func (m *GaugeMutation) Fields() []string {
-	fields := make([]string, 0, 3)
-	if m.time != nil {
-		fields = append(fields, gauge.FieldTime)
-	}
-	if m.value != nil {
-		fields = append(fields, gauge.FieldValue)
+// RemoveGroupIDs removes the groups edge to Group by ids.
+func (m *ApplicationMutation) RemoveGroupIDs(ids ...int) {
+	if m.removedgroups == nil {
+		m.removedgroups = make(map[int]struct{})
 	}
-	if m.wID != nil {
-		fields = append(fields, gauge.FieldWID)
+	for i := range ids {
+		m.removedgroups[ids[i]] = struct{}{}
 	}
-	return fields
 }
 
-// Field returns the value of a field with the given name.
-// The second boolean value indicates that this field was
-// not set, or was not define in the schema.
-func (m *GaugeMutation) Field(name string) (ent.Value, bool) {
-	switch name {
-	case gauge.FieldTime:
-		return m.Time()
-	case gauge.FieldValue:
-		return m.Value()
-	case gauge.FieldWID:
-		return m.WID()
+// RemovedGroups returns the removed ids of groups.
+func (m *ApplicationMutation) RemovedGroupsIDs() (ids []int) {
+	for id := range m.removedgroups {
+		ids = append(ids, id)
 	}
-	return nil, false
+	return
 }
 
-// OldField returns the old value of the field from the database.
-// An error is returned if the mutation operation is not UpdateOne,
-// or the query to the database was failed.
-func (m *GaugeMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
-	switch name {
-	case gauge.FieldTime:
-		return m.OldTime(ctx)
-	case gauge.FieldValue:
-		return m.OldValue(ctx)
-	case gauge.FieldWID:
-		return m.OldWID(ctx)
+// GroupsIDs returns the groups ids in the mutation.
+func (m *ApplicationMutation) GroupsIDs() (ids []int) {
+	for id := range m.groups {
+		ids = append(ids, id)
 	}
-	return nil, fmt.Errorf("unknown Gauge field %s", name)
+	return
 }
 
-// SetField sets the value for the given name. It returns an
-// error if the field is not defined in the schema, or if the
-// type mismatch the field type.
-func (m *GaugeMutation) SetField(name string, value ent.Value) error {
-	switch name {
-	case gauge.FieldTime:
-		v, ok := value.(int64)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetTime(v)
-		return nil
-	case gauge.FieldValue:
-		v, ok := value.(int64)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetValue(v)
-		return nil
-	case gauge.FieldWID:
-		v, ok := value.(string)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetWID(v)
-		return nil
+// ResetGroups reset all changes of the "groups" edge.
+func (m *ApplicationMutation) ResetGroups() {
+	m.groups = nil
+	m.clearedgroups = false
+	m.removedgroups = nil
+}
+
+// AddTagIDs adds the tags edge to Tag by ids.
+func (m *ApplicationMutation) AddTagIDs(ids ...int) {
+	if m.tags == nil {
+		m.tags = make(map[int]struct{})
+	}
+	for i := range ids {
+		m.tags[ids[i]] = struct{}{}
 	}
-	return fmt.Errorf("unknown Gauge field %s", name)
 }
 
-// AddedFields returns all numeric fields that were incremented
-// or decremented during this mutation.
-func (m *GaugeMutation) AddedFields() []string {
-	var fields []string
-	if m.addtime != nil {
-		fields = append(fields, gauge.FieldTime)
+// ClearTags clears the tags edge to Tag.
+func (m *ApplicationMutation) ClearTags() {
+	m.clearedtags = true
+}
+
+// TagsCleared returns if the edge tags was cleared.
+func (m *ApplicationMutation) TagsCleared() bool {
+	return m.clearedtags
+}
+
+// RemoveTagIDs removes the tags edge to Tag by ids.
+func (m *ApplicationMutation) RemoveTagIDs(ids ...int) {
+	if m.removedtags == nil {
+		m.removedtags = make(map[int]struct{})
 	}
-	if m.addvalue != nil {
-		fields = append(fields, gauge.FieldValue)
+	for i := range ids {
+		m.removedtags[ids[i]] = struct{}{}
 	}
-	return fields
 }
 
-// AddedField returns the numeric value that was in/decremented
-// from a field with the given name. The second value indicates
-// that this field was not set, or was not define in the schema.
-func (m *GaugeMutation) AddedField(name string) (ent.Value, bool) {
-	switch name {
-	case gauge.FieldTime:
-		return m.AddedTime()
-	case gauge.FieldValue:
-		return m.AddedValue()
+// RemovedTags returns the removed ids of tags.
+func (m *ApplicationMutation) RemovedTagsIDs() (ids []int) {
+	for id := range m.removedtags {
+		ids = append(ids, id)
 	}
-	return nil, false
+	return
 }
 
-// AddField adds the value for the given name. It returns an
-// error if the field is not defined in the schema, or if the
-// type mismatch the field type.
-func (m *GaugeMutation) AddField(name string, value ent.Value) error {
-	switch name {
-	case gauge.FieldTime:
-		v, ok := value.(int64)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.AddTime(v)
-		return nil
-	case gauge.FieldValue:
-		v, ok := value.(int64)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.AddValue(v)
-		return nil
+// TagsIDs returns the tags ids in the mutation.
+func (m *ApplicationMutation) TagsIDs() (ids []int) {
+	for id := range m.tags {
+		ids = append(ids, id)
 	}
-	return fmt.Errorf("unknown Gauge numeric field %s", name)
+	return
 }
 
-// ClearedFields returns all nullable fields that were cleared
-// during this mutation.
-func (m *GaugeMutation) ClearedFields() []string {
-	return nil
+// ResetTags reset all changes of the "tags" edge.
+func (m *ApplicationMutation) ResetTags() {
+	m.tags = nil
+	m.clearedtags = false
+	m.removedtags = nil
 }
 
-// FieldCleared returns a boolean indicates if this field was
-// cleared in this mutation.
-func (m *GaugeMutation) FieldCleared(name string) bool {
-	_, ok := m.clearedFields[name]
-	return ok
+// AddBaselineIDs adds the baselines edge to Baseline by ids.
+func (m *ApplicationMutation) AddBaselineIDs(ids ...int) {
+	if m.baselines == nil {
+		m.baselines = make(map[int]struct{})
+	}
+	for i := range ids {
+		m.baselines[ids[i]] = struct{}{}
+	}
 }
 
-// ClearField clears the value for the given name. It returns an
-// error if the field is not defined in the schema.
-func (m *GaugeMutation) ClearField(name string) error {
-	return fmt.Errorf("unknown Gauge nullable field %s", name)
+// ClearBaselines clears the baselines edge to Baseline.
+func (m *ApplicationMutation) ClearBaselines() {
+	m.clearedbaselines = true
 }
 
-// ResetField resets all changes in the mutation regarding the
-// given field name. It returns an error if the field is not
-// defined in the schema.
-func (m *GaugeMutation) ResetField(name string) error {
-	switch name {
-	case gauge.FieldTime:
-		m.ResetTime()
-		return nil
-	case gauge.FieldValue:
-		m.ResetValue()
-		return nil
-	case gauge.FieldWID:
-		m.ResetWID()
-		return nil
+// BaselinesCleared returns if the edge baselines was cleared.
+func (m *ApplicationMutation) BaselinesCleared() bool {
+	return m.clearedbaselines
+}
+
+// RemoveBaselineIDs removes the baselines edge to Baseline by ids.
+func (m *ApplicationMutation) RemoveBaselineIDs(ids ...int) {
+	if m.removedbaselines == nil {
+		m.removedbaselines = make(map[int]struct{})
+	}
+	for i := range ids {
+		m.removedbaselines[ids[i]] = struct{}{}
 	}
-	return fmt.Errorf("unknown Gauge field %s", name)
 }
 
-// AddedEdges returns all edge names that were set/added in this
-// mutation.
-func (m *GaugeMutation) AddedEdges() []string {
-	edges := make([]string, 0, 1)
-	if m.metric != nil {
-		edges = append(edges, gauge.EdgeMetric)
+// RemovedBaselines returns the removed ids of baselines.
+func (m *ApplicationMutation) RemovedBaselinesIDs() (ids []int) {
+	for id := range m.removedbaselines {
+		ids = append(ids, id)
 	}
-	return edges
+	return
 }
 
-// AddedIDs returns all ids (to other nodes) that were added for
-// the given edge name.
-func (m *GaugeMutation) AddedIDs(name string) []ent.Value {
-	switch name {
-	case gauge.EdgeMetric:
-		if id := m.metric; id != nil {
-			return []ent.Value{*id}
-		}
+// BaselinesIDs returns the baselines ids in the mutation.
+func (m *ApplicationMutation) BaselinesIDs() (ids []int) {
+	for id := range m.baselines {
+		ids = append(ids, id)
 	}
-	return nil
+	return
 }
 
-// RemovedEdges returns all edge names that were removed in this
-// mutation.
-func (m *GaugeMutation) RemovedEdges() []string {
-	edges := make([]string, 0, 1)
-	return edges
+// ResetBaselines reset all changes of the "baselines" edge.
+func (m *ApplicationMutation) ResetBaselines() {
+	m.baselines = nil
+	m.clearedbaselines = false
+	m.removedbaselines = nil
 }
 
-// RemovedIDs returns all ids (to other nodes) that were removed for
-// the given edge name.
-func (m *GaugeMutation) RemovedIDs(name string) []ent.Value {
-	switch name {
+// AddEventIDs adds the events edge to Event by ids.
+func (m *ApplicationMutation) AddEventIDs(ids ...int) {
+	if m.events == nil {
+		m.events = make(map[int]struct{})
+	}
+	for i := range ids {
+		m.events[ids[i]] = struct{}{}
 	}
-	return nil
 }
 
-// ClearedEdges returns all edge names that were cleared in this
-// mutation.
-func (m *GaugeMutation) ClearedEdges() []string {
-	edges := make([]string, 0, 1)
-	if m.clearedmetric {
-		edges = append(edges, gauge.EdgeMetric)
-	}
-	return edges
-}
-
-// EdgeCleared returns a boolean indicates if this edge was
-// cleared in this mutation.
-func (m *GaugeMutation) EdgeCleared(name string) bool {
-	switch name {
-	case gauge.EdgeMetric:
-		return m.clearedmetric
-	}
-	return false
-}
-
-// ClearEdge clears the value for the given name. It returns an
-// error if the edge name is not defined in the schema.
-func (m *GaugeMutation) ClearEdge(name string) error {
-	switch name {
-	case gauge.EdgeMetric:
-		m.ClearMetric()
-		return nil
-	}
-	return fmt.Errorf("unknown Gauge unique edge %s", name)
-}
-
-// ResetEdge resets all changes in the mutation regarding the
-// given edge name. It returns an error if the edge is not
-// defined in the schema.
-func (m *GaugeMutation) ResetEdge(name string) error {
-	switch name {
-	case gauge.EdgeMetric:
-		m.ResetMetric()
-		return nil
-	}
-	return fmt.Errorf("unknown Gauge edge %s", name)
+// ClearEvents clears the events edge to Event.
+func (m *ApplicationMutation) ClearEvents() {
+	m.clearedevents = true
 }
 
-// GraphMutation represents an operation that mutate the Graphs
-// nodes in the graph.
-type GraphMutation struct {
-	config
-	op             Op
-	typ            string
-	id             *int
-	title          *string
-	unit           *string
-	clearedFields  map[string]struct{}
-	group          *int
-	clearedgroup   bool
-	metrics        map[int]struct{}
-	removedmetrics map[int]struct{}
-	clearedmetrics bool
-	done           bool
-	oldValue       func(context.Context) (*Graph, error)
+// EventsCleared returns if the edge events was cleared.
+func (m *ApplicationMutation) EventsCleared() bool {
+	return m.clearedevents
 }
 
-var _ ent.Mutation = (*GraphMutation)(nil)
-
-// graphOption allows to manage the mutation configuration using functional options.
-type graphOption func(*GraphMutation)
-
-// newGraphMutation creates new mutation for $n.Name.
-func newGraphMutation(c config, op Op, opts ...graphOption) *GraphMutation {
-	m := &GraphMutation{
-		config:        c,
-		op:            op,
-		typ:           TypeGraph,
-		clearedFields: make(map[string]struct{}),
+// RemoveEventIDs removes the events edge to Event by ids.
+func (m *ApplicationMutation) RemoveEventIDs(ids ...int) {
+	if m.removedevents == nil {
+		m.removedevents = make(map[int]struct{})
 	}
-	for _, opt := range opts {
-		opt(m)
+	for i := range ids {
+		m.removedevents[ids[i]] = struct{}{}
 	}
-	return m
 }
 
-// withGraphID sets the id field of the mutation.
-func withGraphID(id int) graphOption {
-	return func(m *GraphMutation) {
-		var (
-			err   error
-			once  sync.Once
-			value *Graph
-		)
-		m.oldValue = func(ctx context.Context) (*Graph, error) {
-			once.Do(func() {
-				if m.done {
-					err = fmt.Errorf("querying old values post mutation is not allowed")
-				} else {
-					value, err = m.Client().Graph.Get(ctx, id)
-				}
-			})
-			return value, err
-		}
-		m.id = &id
+// RemovedEvents returns the removed ids of events.
+func (m *ApplicationMutation) RemovedEventsIDs() (ids []int) {
+	for id := range m.removedevents {
+		ids = append(ids, id)
 	}
+	return
 }
 
-// withGraph sets the old Graph of the mutation.
-func withGraph(node *Graph) graphOption {
-	return func(m *GraphMutation) {
-		m.oldValue = func(context.Context) (*Graph, error) {
-			return node, nil
-		}
-		m.id = &node.ID
+// EventsIDs returns the events ids in the mutation.
+func (m *ApplicationMutation) EventsIDs() (ids []int) {
+	for id := range m.events {
+		ids = append(ids, id)
 	}
+	return
 }
 
-// Client returns a new `ent.Client` from the mutation. If the mutation was
-// executed in a transaction (ent.Tx), a transactional client is returned.
-func (m GraphMutation) Client() *Client {
-	client := &Client{config: m.config}
-	client.init()
-	return client
+// ResetEvents reset all changes of the "events" edge.
+func (m *ApplicationMutation) ResetEvents() {
+	m.events = nil
+	m.clearedevents = false
+	m.removedevents = nil
 }
 
-// Tx returns an `ent.Tx` for mutations that were executed in transactions;
-// it returns an error otherwise.
-func (m GraphMutation) Tx() (*Tx, error) {
-	if _, ok := m.driver.(*txDriver); !ok {
-		return nil, fmt.Errorf("ent: mutation is not running in a transaction")
+// AddExecutorIDs adds the executors edge to Executor by ids.
+func (m *ApplicationMutation) AddExecutorIDs(ids ...int) {
+	if m.executors == nil {
+		m.executors = make(map[int]struct{})
 	}
-	tx := &Tx{config: m.config}
-	tx.init()
-	return tx, nil
-}
-
-// ID returns the id value in the mutation. Note that, the id
-// is available only if it was provided to the builder.
-func (m *GraphMutation) ID() (id int, exists bool) {
-	if m.id == nil {
-		return
+	for i := range ids {
+		m.executors[ids[i]] = struct{}{}
 	}
-	return *m.id, true
 }
 
-// SetTitle sets the title field.
-func (m *GraphMutation) SetTitle(s string) {
-	m.title = &s
+// ClearExecutors clears the executors edge to Executor.
+func (m *ApplicationMutation) ClearExecutors() {
+	m.clearedexecutors = true
 }
 
-// Title returns the title value in the mutation.
-func (m *GraphMutation) Title() (r string, exists bool) {
-	v := m.title
-	if v == nil {
-		return
-	}
-	return *v, true
+// ExecutorsCleared returns if the edge executors was cleared.
+func (m *ApplicationMutation) ExecutorsCleared() bool {
+	return m.clearedexecutors
 }
 
-// OldTitle returns the old title value of the Graph.
-// If the Graph object wasn't provided to the builder, the object is fetched
-// from the database.
-// An error is returned if the mutation operation is not UpdateOne, or database query fails.
-func (m *GraphMutation) OldTitle(ctx context.Context) (v string, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, fmt.Errorf("OldTitle is allowed only on UpdateOne operations")
-	}
-	if m.id == nil || m.oldValue == nil {
-		return v, fmt.Errorf("OldTitle requires an ID field in the mutation")
+// RemoveExecutorIDs removes the executors edge to Executor by ids.
+func (m *ApplicationMutation) RemoveExecutorIDs(ids ...int) {
+	if m.removedexecutors == nil {
+		m.removedexecutors = make(map[int]struct{})
 	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldTitle: %w", err)
+	for i := range ids {
+		m.removedexecutors[ids[i]] = struct{}{}
 	}
-	return oldValue.Title, nil
-}
-
-// ResetTitle reset all changes of the "title" field.
-func (m *GraphMutation) ResetTitle() {
-	m.title = nil
-}
-
-// SetUnit sets the unit field.
-func (m *GraphMutation) SetUnit(s string) {
-	m.unit = &s
 }
 
-// Unit returns the unit value in the mutation.
-func (m *GraphMutation) Unit() (r string, exists bool) {
-	v := m.unit
-	if v == nil {
-		return
+// RemovedExecutors returns the removed ids of executors.
+func (m *ApplicationMutation) RemovedExecutorsIDs() (ids []int) {
+	for id := range m.removedexecutors {
+		ids = append(ids, id)
 	}
-	return *v, true
+	return
 }
 
-// OldUnit returns the old unit value of the Graph.
-// If the Graph object wasn't provided to the builder, the object is fetched
-// from the database.
-// An error is returned if the mutation operation is not UpdateOne, or database query fails.
-func (m *GraphMutation) OldUnit(ctx context.Context) (v string, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, fmt.Errorf("OldUnit is allowed only on UpdateOne operations")
-	}
-	if m.id == nil || m.oldValue == nil {
-		return v, fmt.Errorf("OldUnit requires an ID field in the mutation")
-	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldUnit: %w", err)
+// ExecutorsIDs returns the executors ids in the mutation.
+func (m *ApplicationMutation) ExecutorsIDs() (ids []int) {
+	for id := range m.executors {
+		ids = append(ids, id)
 	}
-	return oldValue.Unit, nil
+	return
 }
 
-// ResetUnit reset all changes of the "unit" field.
-func (m *GraphMutation) ResetUnit() {
-	m.unit = nil
+// ResetExecutors reset all changes of the "executors" edge.
+func (m *ApplicationMutation) ResetExecutors() {
+	m.executors = nil
+	m.clearedexecutors = false
+	m.removedexecutors = nil
 }
 
-// SetGroupID sets the group edge to Group by id.
-func (m *GraphMutation) SetGroupID(id int) {
-	m.group = &id
+// SetProjectID sets the project edge to Project by id.
+func (m *ApplicationMutation) SetProjectID(id int) {
+	m.project = &id
 }
 
-// ClearGroup clears the group edge to Group.
-func (m *GraphMutation) ClearGroup() {
-	m.clearedgroup = true
+// ClearProject clears the project edge to Project.
+func (m *ApplicationMutation) ClearProject() {
+	m.clearedproject = true
 }
 
-// GroupCleared returns if the edge group was cleared.
-func (m *GraphMutation) GroupCleared() bool {
-	return m.clearedgroup
+// ProjectCleared returns if the edge project was cleared.
+func (m *ApplicationMutation) ProjectCleared() bool {
+	return m.clearedproject
 }
 
-// GroupID returns the group id in the mutation.
-func (m *GraphMutation) GroupID() (id int, exists bool) {
-	if m.group != nil {
-		return *m.group, true
+// ProjectID returns the project id in the mutation.
+func (m *ApplicationMutation) ProjectID() (id int, exists bool) {
+	if m.project != nil {
+		return *m.project, true
 	}
 	return
 }
 
-// GroupIDs returns the group ids in the mutation.
+// ProjectIDs returns the project ids in the mutation.
 // Note that ids always returns len(ids) <= 1 for unique edges, and you should use
-// GroupID instead. It exists only for internal usage by the builders.
-func (m *GraphMutation) GroupIDs() (ids []int) {
-	if id := m.group; id != nil {
+// ProjectID instead. It exists only for internal usage by the builders.
+func (m *ApplicationMutation) ProjectIDs() (ids []int) {
+	if id := m.project; id != nil {
 		ids = append(ids, *id)
 	}
 	return
 }
 
-// ResetGroup reset all changes of the "group" edge.
-func (m *GraphMutation) ResetGroup() {
-	m.group = nil
-	m.clearedgroup = false
-}
-
-// AddMetricIDs adds the metrics edge to Metric by ids.
-func (m *GraphMutation) AddMetricIDs(ids ...int) {
-	if m.metrics == nil {
-		m.metrics = make(map[int]struct{})
-	}
-	for i := range ids {
-		m.metrics[ids[i]] = struct{}{}
-	}
+// ResetProject reset all changes of the "project" edge.
+func (m *ApplicationMutation) ResetProject() {
+	m.project = nil
+	m.clearedproject = false
 }
 
-// ClearMetrics clears the metrics edge to Metric.
-func (m *GraphMutation) ClearMetrics() {
-	m.clearedmetrics = true
+// Op returns the operation name.
+func (m *ApplicationMutation) Op() Op {
+	return m.op
 }
 
-// MetricsCleared returns if the edge metrics was cleared.
-func (m *GraphMutation) MetricsCleared() bool {
-	return m.clearedmetrics
+// Type returns the node type of this mutation (Application).
+func (m *ApplicationMutation) Type() string {
+	return m.typ
 }
 
-// RemoveMetricIDs removes the metrics edge to Metric by ids.
-func (m *GraphMutation) RemoveMetricIDs(ids ...int) {
-	if m.removedmetrics == nil {
-		m.removedmetrics = make(map[int]struct{})
-	}
-	for i := range ids {
-		m.removedmetrics[ids[i]] = struct{}{}
+// Fields returns all fields that were changed during
+// this mutation. Note that, in order to get all numeric
+// fields that were in/decremented, call AddedFields().
+func (m *ApplicationMutation) Fields() []string {
+	fields := make([]string, 0, 15)
+	if m.name != nil {
+		fields = append(fields, application.FieldName)
 	}
-}
-
-// RemovedMetrics returns the removed ids of metrics.
-func (m *GraphMutation) RemovedMetricsIDs() (ids []int) {
-	for id := range m.removedmetrics {
-		ids = append(ids, id)
+	if m.status != nil {
+		fields = append(fields, application.FieldStatus)
 	}
-	return
-}
-
-// MetricsIDs returns the metrics ids in the mutation.
-func (m *GraphMutation) MetricsIDs() (ids []int) {
-	for id := range m.metrics {
-		ids = append(ids, id)
+	if m.created_at != nil {
+		fields = append(fields, application.FieldCreatedAt)
 	}
-	return
-}
-
-// ResetMetrics reset all changes of the "metrics" edge.
-func (m *GraphMutation) ResetMetrics() {
-	m.metrics = nil
-	m.clearedmetrics = false
-	m.removedmetrics = nil
-}
-
-// Op returns the operation name.
-func (m *GraphMutation) Op() Op {
-	return m.op
-}
-
-// Type returns the node type of this mutation (Graph).
-func (m *GraphMutation) Type() string {
-	return m.typ
-}
-
-// Fields returns all fields that were changed during
-// this mutation. Note that, in order to get all numeric
-// fields that were in/decremented, call AddedFields().
-func (m *GraphMutation) Fields() []string {
-	fields := make([]string, 0, 2)
-	if m.title != nil {
-		fields = append(fields, graph.FieldTitle)
+	if m.started_at != nil {
+		fields = append(fields, application.FieldStartedAt)
 	}
-	if m.unit != nil {
-		fields = append(fields, graph.FieldUnit)
+	if m.updated_at != nil {
+		fields = append(fields, application.FieldUpdatedAt)
+	}
+	if m.finished_at != nil {
+		fields = append(fields, application.FieldFinishedAt)
+	}
+	if m.scenario != nil {
+		fields = append(fields, application.FieldScenario)
+	}
+	if m.gomod != nil {
+		fields = append(fields, application.FieldGomod)
+	}
+	if m.gosum != nil {
+		fields = append(fields, application.FieldGosum)
+	}
+	if m.sinks != nil {
+		fields = append(fields, application.FieldSinks)
+	}
+	if m.sink_tokens != nil {
+		fields = append(fields, application.FieldSinkTokens)
+	}
+	if m.imported != nil {
+		fields = append(fields, application.FieldImported)
+	}
+	if m.verdict != nil {
+		fields = append(fields, application.FieldVerdict)
+	}
+	if m.checks != nil {
+		fields = append(fields, application.FieldChecks)
+	}
+	if m.baseline_app_id != nil {
+		fields = append(fields, application.FieldBaselineAppID)
 	}
 	return fields
 }
@@ -2286,12 +1968,38 @@ func (m *GraphMutation) Fields() []string {
 // Field returns the value of a field with the given name.
 // The second boolean value indicates that this field was
 // not set, or was not define in the schema.
-func (m *GraphMutation) Field(name string) (ent.Value, bool) {
+func (m *ApplicationMutation) Field(name string) (ent.Value, bool) {
 	switch name {
-	case graph.FieldTitle:
-		return m.Title()
-	case graph.FieldUnit:
-		return m.Unit()
+	case application.FieldName:
+		return m.Name()
+	case application.FieldStatus:
+		return m.Status()
+	case application.FieldCreatedAt:
+		return m.CreatedAt()
+	case application.FieldStartedAt:
+		return m.StartedAt()
+	case application.FieldUpdatedAt:
+		return m.UpdatedAt()
+	case application.FieldFinishedAt:
+		return m.FinishedAt()
+	case application.FieldScenario:
+		return m.Scenario()
+	case application.FieldGomod:
+		return m.Gomod()
+	case application.FieldGosum:
+		return m.Gosum()
+	case application.FieldSinks:
+		return m.Sinks()
+	case application.FieldSinkTokens:
+		return m.SinkTokens()
+	case application.FieldImported:
+		return m.Imported()
+	case application.FieldVerdict:
+		return m.Verdict()
+	case application.FieldChecks:
+		return m.Checks()
+	case application.FieldBaselineAppID:
+		return m.BaselineAppID()
 	}
 	return nil, false
 }
@@ -2299,143 +2007,437 @@ func (m *GraphMutation) Field(name string) (ent.Value, bool) {
 // OldField returns the old value of the field from the database.
 // An error is returned if the mutation operation is not UpdateOne,
 // or the query to the database was failed.
-func (m *GraphMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+func (m *ApplicationMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
 	switch name {
-	case graph.FieldTitle:
-		return m.OldTitle(ctx)
-	case graph.FieldUnit:
-		return m.OldUnit(ctx)
+	case application.FieldName:
+		return m.OldName(ctx)
+	case application.FieldStatus:
+		return m.OldStatus(ctx)
+	case application.FieldCreatedAt:
+		return m.OldCreatedAt(ctx)
+	case application.FieldStartedAt:
+		return m.OldStartedAt(ctx)
+	case application.FieldUpdatedAt:
+		return m.OldUpdatedAt(ctx)
+	case application.FieldFinishedAt:
+		return m.OldFinishedAt(ctx)
+	case application.FieldScenario:
+		return m.OldScenario(ctx)
+	case application.FieldGomod:
+		return m.OldGomod(ctx)
+	case application.FieldGosum:
+		return m.OldGosum(ctx)
+	case application.FieldSinks:
+		return m.OldSinks(ctx)
+	case application.FieldSinkTokens:
+		return m.OldSinkTokens(ctx)
+	case application.FieldImported:
+		return m.OldImported(ctx)
+	case application.FieldVerdict:
+		return m.OldVerdict(ctx)
+	case application.FieldChecks:
+		return m.OldChecks(ctx)
+	case application.FieldBaselineAppID:
+		return m.OldBaselineAppID(ctx)
 	}
-	return nil, fmt.Errorf("unknown Graph field %s", name)
+	return nil, fmt.Errorf("unknown Application field %s", name)
 }
 
 // SetField sets the value for the given name. It returns an
 // error if the field is not defined in the schema, or if the
 // type mismatch the field type.
-func (m *GraphMutation) SetField(name string, value ent.Value) error {
+func (m *ApplicationMutation) SetField(name string, value ent.Value) error {
 	switch name {
-	case graph.FieldTitle:
+	case application.FieldName:
 		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetTitle(v)
+		m.SetName(v)
 		return nil
-	case graph.FieldUnit:
+	case application.FieldStatus:
 		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetUnit(v)
+		m.SetStatus(v)
+		return nil
+	case application.FieldCreatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCreatedAt(v)
+		return nil
+	case application.FieldStartedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetStartedAt(v)
+		return nil
+	case application.FieldUpdatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetUpdatedAt(v)
+		return nil
+	case application.FieldFinishedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetFinishedAt(v)
+		return nil
+	case application.FieldScenario:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetScenario(v)
+		return nil
+	case application.FieldGomod:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetGomod(v)
+		return nil
+	case application.FieldGosum:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetGosum(v)
+		return nil
+	case application.FieldSinks:
+		v, ok := value.([]sink.Config)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetSinks(v)
+		return nil
+	case application.FieldSinkTokens:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetSinkTokens(v)
+		return nil
+	case application.FieldImported:
+		v, ok := value.(bool)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetImported(v)
+		return nil
+	case application.FieldVerdict:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetVerdict(v)
+		return nil
+	case application.FieldChecks:
+		v, ok := value.([]verdict.Check)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetChecks(v)
+		return nil
+	case application.FieldBaselineAppID:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetBaselineAppID(v)
 		return nil
 	}
-	return fmt.Errorf("unknown Graph field %s", name)
+	return fmt.Errorf("unknown Application field %s", name)
 }
 
 // AddedFields returns all numeric fields that were incremented
 // or decremented during this mutation.
-func (m *GraphMutation) AddedFields() []string {
-	return nil
+func (m *ApplicationMutation) AddedFields() []string {
+	var fields []string
+	if m.addbaseline_app_id != nil {
+		fields = append(fields, application.FieldBaselineAppID)
+	}
+	return fields
 }
 
 // AddedField returns the numeric value that was in/decremented
 // from a field with the given name. The second value indicates
 // that this field was not set, or was not define in the schema.
-func (m *GraphMutation) AddedField(name string) (ent.Value, bool) {
+func (m *ApplicationMutation) AddedField(name string) (ent.Value, bool) {
+	switch name {
+	case application.FieldBaselineAppID:
+		return m.AddedBaselineAppID()
+	}
 	return nil, false
 }
 
 // AddField adds the value for the given name. It returns an
 // error if the field is not defined in the schema, or if the
 // type mismatch the field type.
-func (m *GraphMutation) AddField(name string, value ent.Value) error {
+func (m *ApplicationMutation) AddField(name string, value ent.Value) error {
 	switch name {
+	case application.FieldBaselineAppID:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddBaselineAppID(v)
+		return nil
 	}
-	return fmt.Errorf("unknown Graph numeric field %s", name)
+	return fmt.Errorf("unknown Application numeric field %s", name)
 }
 
 // ClearedFields returns all nullable fields that were cleared
 // during this mutation.
-func (m *GraphMutation) ClearedFields() []string {
-	return nil
+func (m *ApplicationMutation) ClearedFields() []string {
+	var fields []string
+	if m.FieldCleared(application.FieldStartedAt) {
+		fields = append(fields, application.FieldStartedAt)
+	}
+	if m.FieldCleared(application.FieldFinishedAt) {
+		fields = append(fields, application.FieldFinishedAt)
+	}
+	if m.FieldCleared(application.FieldSinks) {
+		fields = append(fields, application.FieldSinks)
+	}
+	if m.FieldCleared(application.FieldSinkTokens) {
+		fields = append(fields, application.FieldSinkTokens)
+	}
+	if m.FieldCleared(application.FieldImported) {
+		fields = append(fields, application.FieldImported)
+	}
+	if m.FieldCleared(application.FieldVerdict) {
+		fields = append(fields, application.FieldVerdict)
+	}
+	if m.FieldCleared(application.FieldChecks) {
+		fields = append(fields, application.FieldChecks)
+	}
+	if m.FieldCleared(application.FieldBaselineAppID) {
+		fields = append(fields, application.FieldBaselineAppID)
+	}
+	return fields
 }
 
 // FieldCleared returns a boolean indicates if this field was
 // cleared in this mutation.
-func (m *GraphMutation) FieldCleared(name string) bool {
+func (m *ApplicationMutation) FieldCleared(name string) bool {
 	_, ok := m.clearedFields[name]
 	return ok
 }
 
 // ClearField clears the value for the given name. It returns an
 // error if the field is not defined in the schema.
-func (m *GraphMutation) ClearField(name string) error {
-	return fmt.Errorf("unknown Graph nullable field %s", name)
+func (m *ApplicationMutation) ClearField(name string) error {
+	switch name {
+	case application.FieldStartedAt:
+		m.ClearStartedAt()
+		return nil
+	case application.FieldFinishedAt:
+		m.ClearFinishedAt()
+		return nil
+	case application.FieldSinks:
+		m.ClearSinks()
+		return nil
+	case application.FieldSinkTokens:
+		m.ClearSinkTokens()
+		return nil
+	case application.FieldImported:
+		m.ClearImported()
+		return nil
+	case application.FieldVerdict:
+		m.ClearVerdict()
+		return nil
+	case application.FieldChecks:
+		m.ClearChecks()
+		return nil
+	case application.FieldBaselineAppID:
+		m.ClearBaselineAppID()
+		return nil
+	}
+	return fmt.Errorf("unknown Application nullable field %s", name)
 }
 
 // ResetField resets all changes in the mutation regarding the
 // given field name. It returns an error if the field is not
 // defined in the schema.
-func (m *GraphMutation) ResetField(name string) error {
+func (m *ApplicationMutation) ResetField(name string) error {
 	switch name {
-	case graph.FieldTitle:
-		m.ResetTitle()
+	case application.FieldName:
+		m.ResetName()
 		return nil
-	case graph.FieldUnit:
-		m.ResetUnit()
+	case application.FieldStatus:
+		m.ResetStatus()
 		return nil
-	}
-	return fmt.Errorf("unknown Graph field %s", name)
-}
-
-// AddedEdges returns all edge names that were set/added in this
-// mutation.
-func (m *GraphMutation) AddedEdges() []string {
-	edges := make([]string, 0, 2)
-	if m.group != nil {
-		edges = append(edges, graph.EdgeGroup)
-	}
-	if m.metrics != nil {
-		edges = append(edges, graph.EdgeMetrics)
-	}
-	return edges
+	case application.FieldCreatedAt:
+		m.ResetCreatedAt()
+		return nil
+	case application.FieldStartedAt:
+		m.ResetStartedAt()
+		return nil
+	case application.FieldUpdatedAt:
+		m.ResetUpdatedAt()
+		return nil
+	case application.FieldFinishedAt:
+		m.ResetFinishedAt()
+		return nil
+	case application.FieldScenario:
+		m.ResetScenario()
+		return nil
+	case application.FieldGomod:
+		m.ResetGomod()
+		return nil
+	case application.FieldGosum:
+		m.ResetGosum()
+		return nil
+	case application.FieldSinks:
+		m.ResetSinks()
+		return nil
+	case application.FieldSinkTokens:
+		m.ResetSinkTokens()
+		return nil
+	case application.FieldImported:
+		m.ResetImported()
+		return nil
+	case application.FieldVerdict:
+		m.ResetVerdict()
+		return nil
+	case application.FieldChecks:
+		m.ResetChecks()
+		return nil
+	case application.FieldBaselineAppID:
+		m.ResetBaselineAppID()
+		return nil
+	}
+	return fmt.Errorf("unknown Application field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this
+// mutation.
+func (m *ApplicationMutation) AddedEdges() []string {
+	edges := make([]string, 0, 6)
+	if m.groups != nil {
+		edges = append(edges, application.EdgeGroups)
+	}
+	if m.tags != nil {
+		edges = append(edges, application.EdgeTags)
+	}
+	if m.baselines != nil {
+		edges = append(edges, application.EdgeBaselines)
+	}
+	if m.events != nil {
+		edges = append(edges, application.EdgeEvents)
+	}
+	if m.executors != nil {
+		edges = append(edges, application.EdgeExecutors)
+	}
+	if m.project != nil {
+		edges = append(edges, application.EdgeProject)
+	}
+	return edges
 }
 
 // AddedIDs returns all ids (to other nodes) that were added for
 // the given edge name.
-func (m *GraphMutation) AddedIDs(name string) []ent.Value {
+func (m *ApplicationMutation) AddedIDs(name string) []ent.Value {
 	switch name {
-	case graph.EdgeGroup:
-		if id := m.group; id != nil {
-			return []ent.Value{*id}
+	case application.EdgeGroups:
+		ids := make([]ent.Value, 0, len(m.groups))
+		for id := range m.groups {
+			ids = append(ids, id)
 		}
-	case graph.EdgeMetrics:
-		ids := make([]ent.Value, 0, len(m.metrics))
-		for id := range m.metrics {
+		return ids
+	case application.EdgeTags:
+		ids := make([]ent.Value, 0, len(m.tags))
+		for id := range m.tags {
+			ids = append(ids, id)
+		}
+		return ids
+	case application.EdgeBaselines:
+		ids := make([]ent.Value, 0, len(m.baselines))
+		for id := range m.baselines {
+			ids = append(ids, id)
+		}
+		return ids
+	case application.EdgeEvents:
+		ids := make([]ent.Value, 0, len(m.events))
+		for id := range m.events {
+			ids = append(ids, id)
+		}
+		return ids
+	case application.EdgeExecutors:
+		ids := make([]ent.Value, 0, len(m.executors))
+		for id := range m.executors {
 			ids = append(ids, id)
 		}
 		return ids
+	case application.EdgeProject:
+		if id := m.project; id != nil {
+			return []ent.Value{*id}
+		}
 	}
 	return nil
 }
 
 // RemovedEdges returns all edge names that were removed in this
 // mutation.
-func (m *GraphMutation) RemovedEdges() []string {
-	edges := make([]string, 0, 2)
-	if m.removedmetrics != nil {
-		edges = append(edges, graph.EdgeMetrics)
+func (m *ApplicationMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 6)
+	if m.removedgroups != nil {
+		edges = append(edges, application.EdgeGroups)
+	}
+	if m.removedtags != nil {
+		edges = append(edges, application.EdgeTags)
+	}
+	if m.removedbaselines != nil {
+		edges = append(edges, application.EdgeBaselines)
+	}
+	if m.removedevents != nil {
+		edges = append(edges, application.EdgeEvents)
+	}
+	if m.removedexecutors != nil {
+		edges = append(edges, application.EdgeExecutors)
 	}
 	return edges
 }
 
 // RemovedIDs returns all ids (to other nodes) that were removed for
 // the given edge name.
-func (m *GraphMutation) RemovedIDs(name string) []ent.Value {
+func (m *ApplicationMutation) RemovedIDs(name string) []ent.Value {
 	switch name {
-	case graph.EdgeMetrics:
-		ids := make([]ent.Value, 0, len(m.removedmetrics))
-		for id := range m.removedmetrics {
+	case application.EdgeGroups:
+		ids := make([]ent.Value, 0, len(m.removedgroups))
+		for id := range m.removedgroups {
+			ids = append(ids, id)
+		}
+		return ids
+	case application.EdgeTags:
+		ids := make([]ent.Value, 0, len(m.removedtags))
+		for id := range m.removedtags {
+			ids = append(ids, id)
+		}
+		return ids
+	case application.EdgeBaselines:
+		ids := make([]ent.Value, 0, len(m.removedbaselines))
+		for id := range m.removedbaselines {
+			ids = append(ids, id)
+		}
+		return ids
+	case application.EdgeEvents:
+		ids := make([]ent.Value, 0, len(m.removedevents))
+		for id := range m.removedevents {
+			ids = append(ids, id)
+		}
+		return ids
+	case application.EdgeExecutors:
+		ids := make([]ent.Value, 0, len(m.removedexecutors))
+		for id := range m.removedexecutors {
 			ids = append(ids, id)
 		}
 		return ids
@@ -2445,84 +2447,117 @@ func (m *GraphMutation) RemovedIDs(name string) []ent.Value {
 
 // ClearedEdges returns all edge names that were cleared in this
 // mutation.
-func (m *GraphMutation) ClearedEdges() []string {
-	edges := make([]string, 0, 2)
-	if m.clearedgroup {
-		edges = append(edges, graph.EdgeGroup)
+func (m *ApplicationMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 6)
+	if m.clearedgroups {
+		edges = append(edges, application.EdgeGroups)
 	}
-	if m.clearedmetrics {
-		edges = append(edges, graph.EdgeMetrics)
+	if m.clearedtags {
+		edges = append(edges, application.EdgeTags)
+	}
+	if m.clearedbaselines {
+		edges = append(edges, application.EdgeBaselines)
+	}
+	if m.clearedevents {
+		edges = append(edges, application.EdgeEvents)
+	}
+	if m.clearedexecutors {
+		edges = append(edges, application.EdgeExecutors)
+	}
+	if m.clearedproject {
+		edges = append(edges, application.EdgeProject)
 	}
 	return edges
 }
 
 // EdgeCleared returns a boolean indicates if this edge was
 // cleared in this mutation.
-func (m *GraphMutation) EdgeCleared(name string) bool {
+func (m *ApplicationMutation) EdgeCleared(name string) bool {
 	switch name {
-	case graph.EdgeGroup:
-		return m.clearedgroup
-	case graph.EdgeMetrics:
-		return m.clearedmetrics
+	case application.EdgeGroups:
+		return m.clearedgroups
+	case application.EdgeTags:
+		return m.clearedtags
+	case application.EdgeBaselines:
+		return m.clearedbaselines
+	case application.EdgeEvents:
+		return m.clearedevents
+	case application.EdgeExecutors:
+		return m.clearedexecutors
+	case application.EdgeProject:
+		return m.clearedproject
 	}
 	return false
 }
 
 // ClearEdge clears the value for the given name. It returns an
 // error if the edge name is not defined in the schema.
-func (m *GraphMutation) ClearEdge(name string) error {
+func (m *ApplicationMutation) ClearEdge(name string) error {
 	switch name {
-	case graph.EdgeGroup:
-		m.ClearGroup()
+	case application.EdgeProject:
+		m.ClearProject()
 		return nil
 	}
-	return fmt.Errorf("unknown Graph unique edge %s", name)
+	return fmt.Errorf("unknown Application unique edge %s", name)
 }
 
 // ResetEdge resets all changes in the mutation regarding the
 // given edge name. It returns an error if the edge is not
 // defined in the schema.
-func (m *GraphMutation) ResetEdge(name string) error {
+func (m *ApplicationMutation) ResetEdge(name string) error {
 	switch name {
-	case graph.EdgeGroup:
-		m.ResetGroup()
+	case application.EdgeGroups:
+		m.ResetGroups()
 		return nil
-	case graph.EdgeMetrics:
-		m.ResetMetrics()
+	case application.EdgeTags:
+		m.ResetTags()
+		return nil
+	case application.EdgeBaselines:
+		m.ResetBaselines()
+		return nil
+	case application.EdgeEvents:
+		m.ResetEvents()
+		return nil
+	case application.EdgeExecutors:
+		m.ResetExecutors()
+		return nil
+	case application.EdgeProject:
+		m.ResetProject()
 		return nil
 	}
-	return fmt.Errorf("unknown Graph edge %s", name)
+	return fmt.Errorf("unknown Application edge %s", name)
 }
 
-// GroupMutation represents an operation that mutate the Groups
+// AuditMutation represents an operation that mutate the Audits
 // nodes in the graph.
-type GroupMutation struct {
+type AuditMutation struct {
 	config
-	op                 Op
-	typ                string
-	id                 *int
-	name               *string
-	clearedFields      map[string]struct{}
-	application        *int
-	clearedapplication bool
-	graphs             map[int]struct{}
-	removedgraphs      map[int]struct{}
-	clearedgraphs      bool
-	done               bool
-	oldValue           func(context.Context) (*Group, error)
+	op                Op
+	typ               string
+	id                *int
+	username          *string
+	action            *audit.Action
+	application_id    *int
+	addapplication_id *int
+	tag               *string
+	ip                *string
+	created_at        *time.Time
+	clearedFields     map[string]struct{}
+	done              bool
+	oldValue          func(context.Context) (*Audit, error)
 }
 
-var _ ent.Mutation = (*GroupMutation)(nil)
+var _ ent.Mutation = (*AuditMutation)(nil)
 
-// groupOption allows to manage the mutation configuration using functional options.
-type groupOption func(*GroupMutation)
+// auditOption allows to manage the mutation configuration using functional options.
+type auditOption func(*AuditMutation)
 
-// newGroupMutation creates new mutation for $n.Name.
-func newGroupMutation(c config, op Op, opts ...groupOption) *GroupMutation {
-	m := &GroupMutation{
+// newAuditMutation creates new mutation for $n.Name.
+func newAuditMutation(c config, op Op, opts ...auditOption) *AuditMutation {
+	m := &AuditMutation{
 		config:        c,
 		op:            op,
-		typ:           TypeGroup,
+		typ:           TypeAudit,
 		clearedFields: make(map[string]struct{}),
 	}
 	for _, opt := range opts {
@@ -2531,20 +2566,20 @@ func newGroupMutation(c config, op Op, opts ...groupOption) *GroupMutation {
 	return m
 }
 
-// withGroupID sets the id field of the mutation.
-func withGroupID(id int) groupOption {
-	return func(m *GroupMutation) {
+// withAuditID sets the id field of the mutation.
+func withAuditID(id int) auditOption {
+	return func(m *AuditMutation) {
 		var (
 			err   error
 			once  sync.Once
-			value *Group
+			value *Audit
 		)
-		m.oldValue = func(ctx context.Context) (*Group, error) {
+		m.oldValue = func(ctx context.Context) (*Audit, error) {
 			once.Do(func() {
 				if m.done {
 					err = fmt.Errorf("querying old values post mutation is not allowed")
 				} else {
-					value, err = m.Client().Group.Get(ctx, id)
+					value, err = m.Client().Audit.Get(ctx, id)
 				}
 			})
 			return value, err
@@ -2553,10 +2588,10 @@ func withGroupID(id int) groupOption {
 	}
 }
 
-// withGroup sets the old Group of the mutation.
-func withGroup(node *Group) groupOption {
-	return func(m *GroupMutation) {
-		m.oldValue = func(context.Context) (*Group, error) {
+// withAudit sets the old Audit of the mutation.
+func withAudit(node *Audit) auditOption {
+	return func(m *AuditMutation) {
+		m.oldValue = func(context.Context) (*Audit, error) {
 			return node, nil
 		}
 		m.id = &node.ID
@@ -2565,7 +2600,7 @@ func withGroup(node *Group) groupOption {
 
 // Client returns a new `ent.Client` from the mutation. If the mutation was
 // executed in a transaction (ent.Tx), a transactional client is returned.
-func (m GroupMutation) Client() *Client {
+func (m AuditMutation) Client() *Client {
 	client := &Client{config: m.config}
 	client.init()
 	return client
@@ -2573,7 +2608,7 @@ func (m GroupMutation) Client() *Client {
 
 // Tx returns an `ent.Tx` for mutations that were executed in transactions;
 // it returns an error otherwise.
-func (m GroupMutation) Tx() (*Tx, error) {
+func (m AuditMutation) Tx() (*Tx, error) {
 	if _, ok := m.driver.(*txDriver); !ok {
 		return nil, fmt.Errorf("ent: mutation is not running in a transaction")
 	}
@@ -2584,159 +2619,314 @@ func (m GroupMutation) Tx() (*Tx, error) {
 
 // ID returns the id value in the mutation. Note that, the id
 // is available only if it was provided to the builder.
-func (m *GroupMutation) ID() (id int, exists bool) {
+func (m *AuditMutation) ID() (id int, exists bool) {
 	if m.id == nil {
 		return
 	}
 	return *m.id, true
 }
 
-// SetName sets the name field.
-func (m *GroupMutation) SetName(s string) {
-	m.name = &s
+// SetUsername sets the username field.
+func (m *AuditMutation) SetUsername(s string) {
+	m.username = &s
 }
 
-// Name returns the name value in the mutation.
-func (m *GroupMutation) Name() (r string, exists bool) {
-	v := m.name
+// Username returns the username value in the mutation.
+func (m *AuditMutation) Username() (r string, exists bool) {
+	v := m.username
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldName returns the old name value of the Group.
-// If the Group object wasn't provided to the builder, the object is fetched
+// OldUsername returns the old username value of the Audit.
+// If the Audit object wasn't provided to the builder, the object is fetched
 // from the database.
 // An error is returned if the mutation operation is not UpdateOne, or database query fails.
-func (m *GroupMutation) OldName(ctx context.Context) (v string, err error) {
+func (m *AuditMutation) OldUsername(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, fmt.Errorf("OldName is allowed only on UpdateOne operations")
+		return v, fmt.Errorf("OldUsername is allowed only on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, fmt.Errorf("OldName requires an ID field in the mutation")
+		return v, fmt.Errorf("OldUsername requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldName: %w", err)
+		return v, fmt.Errorf("querying old value for OldUsername: %w", err)
 	}
-	return oldValue.Name, nil
-}
-
-// ResetName reset all changes of the "name" field.
-func (m *GroupMutation) ResetName() {
-	m.name = nil
-}
-
-// SetApplicationID sets the application edge to Application by id.
-func (m *GroupMutation) SetApplicationID(id int) {
-	m.application = &id
+	return oldValue.Username, nil
 }
 
-// ClearApplication clears the application edge to Application.
-func (m *GroupMutation) ClearApplication() {
-	m.clearedapplication = true
+// ResetUsername reset all changes of the "username" field.
+func (m *AuditMutation) ResetUsername() {
+	m.username = nil
 }
 
-// ApplicationCleared returns if the edge application was cleared.
-func (m *GroupMutation) ApplicationCleared() bool {
-	return m.clearedapplication
+// SetAction sets the action field.
+func (m *AuditMutation) SetAction(a audit.Action) {
+	m.action = &a
 }
 
-// ApplicationID returns the application id in the mutation.
-func (m *GroupMutation) ApplicationID() (id int, exists bool) {
-	if m.application != nil {
-		return *m.application, true
+// Action returns the action value in the mutation.
+func (m *AuditMutation) Action() (r audit.Action, exists bool) {
+	v := m.action
+	if v == nil {
+		return
 	}
-	return
+	return *v, true
 }
 
-// ApplicationIDs returns the application ids in the mutation.
-// Note that ids always returns len(ids) <= 1 for unique edges, and you should use
-// ApplicationID instead. It exists only for internal usage by the builders.
-func (m *GroupMutation) ApplicationIDs() (ids []int) {
-	if id := m.application; id != nil {
-		ids = append(ids, *id)
+// OldAction returns the old action value of the Audit.
+// If the Audit object wasn't provided to the builder, the object is fetched
+// from the database.
+// An error is returned if the mutation operation is not UpdateOne, or database query fails.
+func (m *AuditMutation) OldAction(ctx context.Context) (v audit.Action, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, fmt.Errorf("OldAction is allowed only on UpdateOne operations")
 	}
-	return
+	if m.id == nil || m.oldValue == nil {
+		return v, fmt.Errorf("OldAction requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldAction: %w", err)
+	}
+	return oldValue.Action, nil
 }
 
-// ResetApplication reset all changes of the "application" edge.
-func (m *GroupMutation) ResetApplication() {
-	m.application = nil
-	m.clearedapplication = false
+// ResetAction reset all changes of the "action" field.
+func (m *AuditMutation) ResetAction() {
+	m.action = nil
 }
 
-// AddGraphIDs adds the graphs edge to Graph by ids.
-func (m *GroupMutation) AddGraphIDs(ids ...int) {
-	if m.graphs == nil {
-		m.graphs = make(map[int]struct{})
-	}
-	for i := range ids {
-		m.graphs[ids[i]] = struct{}{}
-	}
+// SetApplicationID sets the application_id field.
+func (m *AuditMutation) SetApplicationID(i int) {
+	m.application_id = &i
+	m.addapplication_id = nil
 }
 
-// ClearGraphs clears the graphs edge to Graph.
-func (m *GroupMutation) ClearGraphs() {
-	m.clearedgraphs = true
+// ApplicationID returns the application_id value in the mutation.
+func (m *AuditMutation) ApplicationID() (r int, exists bool) {
+	v := m.application_id
+	if v == nil {
+		return
+	}
+	return *v, true
 }
 
-// GraphsCleared returns if the edge graphs was cleared.
-func (m *GroupMutation) GraphsCleared() bool {
-	return m.clearedgraphs
+// OldApplicationID returns the old application_id value of the Audit.
+// If the Audit object wasn't provided to the builder, the object is fetched
+// from the database.
+// An error is returned if the mutation operation is not UpdateOne, or database query fails.
+func (m *AuditMutation) OldApplicationID(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, fmt.Errorf("OldApplicationID is allowed only on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, fmt.Errorf("OldApplicationID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldApplicationID: %w", err)
+	}
+	return oldValue.ApplicationID, nil
 }
 
-// RemoveGraphIDs removes the graphs edge to Graph by ids.
-func (m *GroupMutation) RemoveGraphIDs(ids ...int) {
-	if m.removedgraphs == nil {
-		m.removedgraphs = make(map[int]struct{})
+// AddApplicationID adds i to application_id.
+func (m *AuditMutation) AddApplicationID(i int) {
+	if m.addapplication_id != nil {
+		*m.addapplication_id += i
+	} else {
+		m.addapplication_id = &i
 	}
-	for i := range ids {
-		m.removedgraphs[ids[i]] = struct{}{}
+}
+
+// AddedApplicationID returns the value that was added to the application_id field in this mutation.
+func (m *AuditMutation) AddedApplicationID() (r int, exists bool) {
+	v := m.addapplication_id
+	if v == nil {
+		return
 	}
+	return *v, true
 }
 
-// RemovedGraphs returns the removed ids of graphs.
-func (m *GroupMutation) RemovedGraphsIDs() (ids []int) {
-	for id := range m.removedgraphs {
-		ids = append(ids, id)
+// ClearApplicationID clears the value of application_id.
+func (m *AuditMutation) ClearApplicationID() {
+	m.application_id = nil
+	m.addapplication_id = nil
+	m.clearedFields[audit.FieldApplicationID] = struct{}{}
+}
+
+// ApplicationIDCleared returns if the field application_id was cleared in this mutation.
+func (m *AuditMutation) ApplicationIDCleared() bool {
+	_, ok := m.clearedFields[audit.FieldApplicationID]
+	return ok
+}
+
+// ResetApplicationID reset all changes of the "application_id" field.
+func (m *AuditMutation) ResetApplicationID() {
+	m.application_id = nil
+	m.addapplication_id = nil
+	delete(m.clearedFields, audit.FieldApplicationID)
+}
+
+// SetTag sets the tag field.
+func (m *AuditMutation) SetTag(s string) {
+	m.tag = &s
+}
+
+// Tag returns the tag value in the mutation.
+func (m *AuditMutation) Tag() (r string, exists bool) {
+	v := m.tag
+	if v == nil {
+		return
 	}
-	return
+	return *v, true
 }
 
-// GraphsIDs returns the graphs ids in the mutation.
-func (m *GroupMutation) GraphsIDs() (ids []int) {
-	for id := range m.graphs {
-		ids = append(ids, id)
+// OldTag returns the old tag value of the Audit.
+// If the Audit object wasn't provided to the builder, the object is fetched
+// from the database.
+// An error is returned if the mutation operation is not UpdateOne, or database query fails.
+func (m *AuditMutation) OldTag(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, fmt.Errorf("OldTag is allowed only on UpdateOne operations")
 	}
-	return
+	if m.id == nil || m.oldValue == nil {
+		return v, fmt.Errorf("OldTag requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldTag: %w", err)
+	}
+	return oldValue.Tag, nil
 }
 
-// ResetGraphs reset all changes of the "graphs" edge.
-func (m *GroupMutation) ResetGraphs() {
-	m.graphs = nil
-	m.clearedgraphs = false
-	m.removedgraphs = nil
+// ClearTag clears the value of tag.
+func (m *AuditMutation) ClearTag() {
+	m.tag = nil
+	m.clearedFields[audit.FieldTag] = struct{}{}
+}
+
+// TagCleared returns if the field tag was cleared in this mutation.
+func (m *AuditMutation) TagCleared() bool {
+	_, ok := m.clearedFields[audit.FieldTag]
+	return ok
+}
+
+// ResetTag reset all changes of the "tag" field.
+func (m *AuditMutation) ResetTag() {
+	m.tag = nil
+	delete(m.clearedFields, audit.FieldTag)
+}
+
+// SetIP sets the ip field.
+func (m *AuditMutation) SetIP(s string) {
+	m.ip = &s
+}
+
+// IP returns the ip value in the mutation.
+func (m *AuditMutation) IP() (r string, exists bool) {
+	v := m.ip
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldIP returns the old ip value of the Audit.
+// If the Audit object wasn't provided to the builder, the object is fetched
+// from the database.
+// An error is returned if the mutation operation is not UpdateOne, or database query fails.
+func (m *AuditMutation) OldIP(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, fmt.Errorf("OldIP is allowed only on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, fmt.Errorf("OldIP requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldIP: %w", err)
+	}
+	return oldValue.IP, nil
+}
+
+// ResetIP reset all changes of the "ip" field.
+func (m *AuditMutation) ResetIP() {
+	m.ip = nil
+}
+
+// SetCreatedAt sets the created_at field.
+func (m *AuditMutation) SetCreatedAt(t time.Time) {
+	m.created_at = &t
+}
+
+// CreatedAt returns the created_at value in the mutation.
+func (m *AuditMutation) CreatedAt() (r time.Time, exists bool) {
+	v := m.created_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCreatedAt returns the old created_at value of the Audit.
+// If the Audit object wasn't provided to the builder, the object is fetched
+// from the database.
+// An error is returned if the mutation operation is not UpdateOne, or database query fails.
+func (m *AuditMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, fmt.Errorf("OldCreatedAt is allowed only on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, fmt.Errorf("OldCreatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
+	}
+	return oldValue.CreatedAt, nil
+}
+
+// ResetCreatedAt reset all changes of the "created_at" field.
+func (m *AuditMutation) ResetCreatedAt() {
+	m.created_at = nil
 }
 
 // Op returns the operation name.
-func (m *GroupMutation) Op() Op {
+func (m *AuditMutation) Op() Op {
 	return m.op
 }
 
-// Type returns the node type of this mutation (Group).
-func (m *GroupMutation) Type() string {
+// Type returns the node type of this mutation (Audit).
+func (m *AuditMutation) Type() string {
 	return m.typ
 }
 
 // Fields returns all fields that were changed during
 // this mutation. Note that, in order to get all numeric
 // fields that were in/decremented, call AddedFields().
-func (m *GroupMutation) Fields() []string {
-	fields := make([]string, 0, 1)
-	if m.name != nil {
-		fields = append(fields, group.FieldName)
+func (m *AuditMutation) Fields() []string {
+	fields := make([]string, 0, 6)
+	if m.username != nil {
+		fields = append(fields, audit.FieldUsername)
+	}
+	if m.action != nil {
+		fields = append(fields, audit.FieldAction)
+	}
+	if m.application_id != nil {
+		fields = append(fields, audit.FieldApplicationID)
+	}
+	if m.tag != nil {
+		fields = append(fields, audit.FieldTag)
+	}
+	if m.ip != nil {
+		fields = append(fields, audit.FieldIP)
+	}
+	if m.created_at != nil {
+		fields = append(fields, audit.FieldCreatedAt)
 	}
 	return fields
 }
@@ -2744,10 +2934,20 @@ func (m *GroupMutation) Fields() []string {
 // Field returns the value of a field with the given name.
 // The second boolean value indicates that this field was
 // not set, or was not define in the schema.
-func (m *GroupMutation) Field(name string) (ent.Value, bool) {
+func (m *AuditMutation) Field(name string) (ent.Value, bool) {
 	switch name {
-	case group.FieldName:
-		return m.Name()
+	case audit.FieldUsername:
+		return m.Username()
+	case audit.FieldAction:
+		return m.Action()
+	case audit.FieldApplicationID:
+		return m.ApplicationID()
+	case audit.FieldTag:
+		return m.Tag()
+	case audit.FieldIP:
+		return m.IP()
+	case audit.FieldCreatedAt:
+		return m.CreatedAt()
 	}
 	return nil, false
 }
@@ -2755,237 +2955,256 @@ func (m *GroupMutation) Field(name string) (ent.Value, bool) {
 // OldField returns the old value of the field from the database.
 // An error is returned if the mutation operation is not UpdateOne,
 // or the query to the database was failed.
-func (m *GroupMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+func (m *AuditMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
 	switch name {
-	case group.FieldName:
-		return m.OldName(ctx)
+	case audit.FieldUsername:
+		return m.OldUsername(ctx)
+	case audit.FieldAction:
+		return m.OldAction(ctx)
+	case audit.FieldApplicationID:
+		return m.OldApplicationID(ctx)
+	case audit.FieldTag:
+		return m.OldTag(ctx)
+	case audit.FieldIP:
+		return m.OldIP(ctx)
+	case audit.FieldCreatedAt:
+		return m.OldCreatedAt(ctx)
 	}
-	return nil, fmt.Errorf("unknown Group field %s", name)
+	return nil, fmt.Errorf("unknown Audit field %s", name)
 }
 
 // SetField sets the value for the given name. It returns an
 // error if the field is not defined in the schema, or if the
 // type mismatch the field type.
-func (m *GroupMutation) SetField(name string, value ent.Value) error {
+func (m *AuditMutation) SetField(name string, value ent.Value) error {
 	switch name {
-	case group.FieldName:
+	case audit.FieldUsername:
 		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetName(v)
+		m.SetUsername(v)
+		return nil
+	case audit.FieldAction:
+		v, ok := value.(audit.Action)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetAction(v)
+		return nil
+	case audit.FieldApplicationID:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetApplicationID(v)
+		return nil
+	case audit.FieldTag:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetTag(v)
+		return nil
+	case audit.FieldIP:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetIP(v)
+		return nil
+	case audit.FieldCreatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCreatedAt(v)
 		return nil
 	}
-	return fmt.Errorf("unknown Group field %s", name)
+	return fmt.Errorf("unknown Audit field %s", name)
 }
 
 // AddedFields returns all numeric fields that were incremented
 // or decremented during this mutation.
-func (m *GroupMutation) AddedFields() []string {
-	return nil
+func (m *AuditMutation) AddedFields() []string {
+	var fields []string
+	if m.addapplication_id != nil {
+		fields = append(fields, audit.FieldApplicationID)
+	}
+	return fields
 }
 
 // AddedField returns the numeric value that was in/decremented
 // from a field with the given name. The second value indicates
 // that this field was not set, or was not define in the schema.
-func (m *GroupMutation) AddedField(name string) (ent.Value, bool) {
+func (m *AuditMutation) AddedField(name string) (ent.Value, bool) {
+	switch name {
+	case audit.FieldApplicationID:
+		return m.AddedApplicationID()
+	}
 	return nil, false
 }
 
 // AddField adds the value for the given name. It returns an
 // error if the field is not defined in the schema, or if the
 // type mismatch the field type.
-func (m *GroupMutation) AddField(name string, value ent.Value) error {
+func (m *AuditMutation) AddField(name string, value ent.Value) error {
 	switch name {
+	case audit.FieldApplicationID:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddApplicationID(v)
+		return nil
 	}
-	return fmt.Errorf("unknown Group numeric field %s", name)
+	return fmt.Errorf("unknown Audit numeric field %s", name)
 }
 
 // ClearedFields returns all nullable fields that were cleared
 // during this mutation.
-func (m *GroupMutation) ClearedFields() []string {
-	return nil
+func (m *AuditMutation) ClearedFields() []string {
+	var fields []string
+	if m.FieldCleared(audit.FieldApplicationID) {
+		fields = append(fields, audit.FieldApplicationID)
+	}
+	if m.FieldCleared(audit.FieldTag) {
+		fields = append(fields, audit.FieldTag)
+	}
+	return fields
 }
 
 // FieldCleared returns a boolean indicates if this field was
 // cleared in this mutation.
-func (m *GroupMutation) FieldCleared(name string) bool {
+func (m *AuditMutation) FieldCleared(name string) bool {
 	_, ok := m.clearedFields[name]
 	return ok
 }
 
 // ClearField clears the value for the given name. It returns an
 // error if the field is not defined in the schema.
-func (m *GroupMutation) ClearField(name string) error {
-	return fmt.Errorf("unknown Group nullable field %s", name)
+func (m *AuditMutation) ClearField(name string) error {
+	switch name {
+	case audit.FieldApplicationID:
+		m.ClearApplicationID()
+		return nil
+	case audit.FieldTag:
+		m.ClearTag()
+		return nil
+	}
+	return fmt.Errorf("unknown Audit nullable field %s", name)
 }
 
 // ResetField resets all changes in the mutation regarding the
 // given field name. It returns an error if the field is not
 // defined in the schema.
-func (m *GroupMutation) ResetField(name string) error {
+func (m *AuditMutation) ResetField(name string) error {
 	switch name {
-	case group.FieldName:
-		m.ResetName()
+	case audit.FieldUsername:
+		m.ResetUsername()
+		return nil
+	case audit.FieldAction:
+		m.ResetAction()
+		return nil
+	case audit.FieldApplicationID:
+		m.ResetApplicationID()
+		return nil
+	case audit.FieldTag:
+		m.ResetTag()
+		return nil
+	case audit.FieldIP:
+		m.ResetIP()
+		return nil
+	case audit.FieldCreatedAt:
+		m.ResetCreatedAt()
 		return nil
 	}
-	return fmt.Errorf("unknown Group field %s", name)
+	return fmt.Errorf("unknown Audit field %s", name)
 }
 
 // AddedEdges returns all edge names that were set/added in this
 // mutation.
-func (m *GroupMutation) AddedEdges() []string {
-	edges := make([]string, 0, 2)
-	if m.application != nil {
-		edges = append(edges, group.EdgeApplication)
-	}
-	if m.graphs != nil {
-		edges = append(edges, group.EdgeGraphs)
-	}
+func (m *AuditMutation) AddedEdges() []string {
+	edges := make([]string, 0, 0)
 	return edges
 }
 
 // AddedIDs returns all ids (to other nodes) that were added for
 // the given edge name.
-func (m *GroupMutation) AddedIDs(name string) []ent.Value {
-	switch name {
-	case group.EdgeApplication:
-		if id := m.application; id != nil {
-			return []ent.Value{*id}
-		}
-	case group.EdgeGraphs:
-		ids := make([]ent.Value, 0, len(m.graphs))
-		for id := range m.graphs {
-			ids = append(ids, id)
-		}
-		return ids
-	}
+func (m *AuditMutation) AddedIDs(name string) []ent.Value {
 	return nil
 }
 
 // RemovedEdges returns all edge names that were removed in this
 // mutation.
-func (m *GroupMutation) RemovedEdges() []string {
-	edges := make([]string, 0, 2)
-	if m.removedgraphs != nil {
-		edges = append(edges, group.EdgeGraphs)
-	}
+func (m *AuditMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 0)
 	return edges
 }
 
 // RemovedIDs returns all ids (to other nodes) that were removed for
 // the given edge name.
-func (m *GroupMutation) RemovedIDs(name string) []ent.Value {
-	switch name {
-	case group.EdgeGraphs:
-		ids := make([]ent.Value, 0, len(m.removedgraphs))
-		for id := range m.removedgraphs {
-			ids = append(ids, id)
-		}
-		return ids
-	}
+func (m *AuditMutation) RemovedIDs(name string) []ent.Value {
 	return nil
 }
 
 // ClearedEdges returns all edge names that were cleared in this
 // mutation.
-func (m *GroupMutation) ClearedEdges() []string {
-	edges := make([]string, 0, 2)
-	if m.clearedapplication {
-		edges = append(edges, group.EdgeApplication)
-	}
-	if m.clearedgraphs {
-		edges = append(edges, group.EdgeGraphs)
-	}
+func (m *AuditMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 0)
 	return edges
 }
 
 // EdgeCleared returns a boolean indicates if this edge was
 // cleared in this mutation.
-func (m *GroupMutation) EdgeCleared(name string) bool {
-	switch name {
-	case group.EdgeApplication:
-		return m.clearedapplication
-	case group.EdgeGraphs:
-		return m.clearedgraphs
-	}
+func (m *AuditMutation) EdgeCleared(name string) bool {
 	return false
 }
 
 // ClearEdge clears the value for the given name. It returns an
 // error if the edge name is not defined in the schema.
-func (m *GroupMutation) ClearEdge(name string) error {
-	switch name {
-	case group.EdgeApplication:
-		m.ClearApplication()
-		return nil
-	}
-	return fmt.Errorf("unknown Group unique edge %s", name)
+func (m *AuditMutation) ClearEdge(name string) error {
+	return fmt.Errorf("unknown Audit unique edge %s", name)
 }
 
 // ResetEdge resets all changes in the mutation regarding the
 // given edge name. It returns an error if the edge is not
 // defined in the schema.
-func (m *GroupMutation) ResetEdge(name string) error {
-	switch name {
-	case group.EdgeApplication:
-		m.ResetApplication()
-		return nil
-	case group.EdgeGraphs:
-		m.ResetGraphs()
-		return nil
-	}
-	return fmt.Errorf("unknown Group edge %s", name)
+func (m *AuditMutation) ResetEdge(name string) error {
+	return fmt.Errorf("unknown Audit edge %s", name)
 }
 
-// HistogramMutation represents an operation that mutate the Histograms
+// BaselineMutation represents an operation that mutate the Baselines
 // nodes in the graph.
-type HistogramMutation struct {
+type BaselineMutation struct {
 	config
-	op            Op
-	typ           string
-	id            *int
-	time          *int64
-	addtime       *int64
-	count         *int64
-	addcount      *int64
-	min           *int64
-	addmin        *int64
-	max           *int64
-	addmax        *int64
-	mean          *float64
-	addmean       *float64
-	stddev        *float64
-	addstddev     *float64
-	median        *float64
-	addmedian     *float64
-	p75           *float64
-	addp75        *float64
-	p95           *float64
-	addp95        *float64
-	p99           *float64
-	addp99        *float64
-	p999          *float64
-	addp999       *float64
-	wID           *string
-	clearedFields map[string]struct{}
-	metric        *int
-	clearedmetric bool
-	done          bool
-	oldValue      func(context.Context) (*Histogram, error)
+	op                 Op
+	typ                string
+	id                 *int
+	tag                *string
+	tolerance          *float64
+	addtolerance       *float64
+	tolerances         *map[string]float64
+	created_at         *time.Time
+	updated_at         *time.Time
+	clearedFields      map[string]struct{}
+	application        *int
+	clearedapplication bool
+	done               bool
+	oldValue           func(context.Context) (*Baseline, error)
 }
 
-var _ ent.Mutation = (*HistogramMutation)(nil)
+var _ ent.Mutation = (*BaselineMutation)(nil)
 
-// histogramOption allows to manage the mutation configuration using functional options.
-type histogramOption func(*HistogramMutation)
+// baselineOption allows to manage the mutation configuration using functional options.
+type baselineOption func(*BaselineMutation)
 
-// newHistogramMutation creates new mutation for $n.Name.
-func newHistogramMutation(c config, op Op, opts ...histogramOption) *HistogramMutation {
-	m := &HistogramMutation{
+// newBaselineMutation creates new mutation for $n.Name.
+func newBaselineMutation(c config, op Op, opts ...baselineOption) *BaselineMutation {
+	m := &BaselineMutation{
 		config:        c,
 		op:            op,
-		typ:           TypeHistogram,
+		typ:           TypeBaseline,
 		clearedFields: make(map[string]struct{}),
 	}
 	for _, opt := range opts {
@@ -2994,20 +3213,20 @@ func newHistogramMutation(c config, op Op, opts ...histogramOption) *HistogramMu
 	return m
 }
 
-// withHistogramID sets the id field of the mutation.
-func withHistogramID(id int) histogramOption {
-	return func(m *HistogramMutation) {
+// withBaselineID sets the id field of the mutation.
+func withBaselineID(id int) baselineOption {
+	return func(m *BaselineMutation) {
 		var (
 			err   error
 			once  sync.Once
-			value *Histogram
+			value *Baseline
 		)
-		m.oldValue = func(ctx context.Context) (*Histogram, error) {
+		m.oldValue = func(ctx context.Context) (*Baseline, error) {
 			once.Do(func() {
 				if m.done {
 					err = fmt.Errorf("querying old values post mutation is not allowed")
 				} else {
-					value, err = m.Client().Histogram.Get(ctx, id)
+					value, err = m.Client().Baseline.Get(ctx, id)
 				}
 			})
 			return value, err
@@ -3016,10 +3235,10 @@ func withHistogramID(id int) histogramOption {
 	}
 }
 
-// withHistogram sets the old Histogram of the mutation.
-func withHistogram(node *Histogram) histogramOption {
-	return func(m *HistogramMutation) {
-		m.oldValue = func(context.Context) (*Histogram, error) {
+// withBaseline sets the old Baseline of the mutation.
+func withBaseline(node *Baseline) baselineOption {
+	return func(m *BaselineMutation) {
+		m.oldValue = func(context.Context) (*Baseline, error) {
 			return node, nil
 		}
 		m.id = &node.ID
@@ -3028,7 +3247,7 @@ func withHistogram(node *Histogram) histogramOption {
 
 // Client returns a new `ent.Client` from the mutation. If the mutation was
 // executed in a transaction (ent.Tx), a transactional client is returned.
-func (m HistogramMutation) Client() *Client {
+func (m BaselineMutation) Client() *Client {
 	client := &Client{config: m.config}
 	client.init()
 	return client
@@ -3036,7 +3255,7 @@ func (m HistogramMutation) Client() *Client {
 
 // Tx returns an `ent.Tx` for mutations that were executed in transactions;
 // it returns an error otherwise.
-func (m HistogramMutation) Tx() (*Tx, error) {
+func (m BaselineMutation) Tx() (*Tx, error) {
 	if _, ok := m.driver.(*txDriver); !ok {
 		return nil, fmt.Errorf("ent: mutation is not running in a transaction")
 	}
@@ -3047,694 +3266,822 @@ func (m HistogramMutation) Tx() (*Tx, error) {
 
 // ID returns the id value in the mutation. Note that, the id
 // is available only if it was provided to the builder.
-func (m *HistogramMutation) ID() (id int, exists bool) {
+func (m *BaselineMutation) ID() (id int, exists bool) {
 	if m.id == nil {
 		return
 	}
 	return *m.id, true
 }
 
-// SetTime sets the time field.
-func (m *HistogramMutation) SetTime(i int64) {
-	m.time = &i
-	m.addtime = nil
+// SetTag sets the tag field.
+func (m *BaselineMutation) SetTag(s string) {
+	m.tag = &s
 }
 
-// Time returns the time value in the mutation.
-func (m *HistogramMutation) Time() (r int64, exists bool) {
-	v := m.time
+// Tag returns the tag value in the mutation.
+func (m *BaselineMutation) Tag() (r string, exists bool) {
+	v := m.tag
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldTime returns the old time value of the Histogram.
-// If the Histogram object wasn't provided to the builder, the object is fetched
+// OldTag returns the old tag value of the Baseline.
+// If the Baseline object wasn't provided to the builder, the object is fetched
 // from the database.
 // An error is returned if the mutation operation is not UpdateOne, or database query fails.
-func (m *HistogramMutation) OldTime(ctx context.Context) (v int64, err error) {
+func (m *BaselineMutation) OldTag(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, fmt.Errorf("OldTime is allowed only on UpdateOne operations")
+		return v, fmt.Errorf("OldTag is allowed only on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, fmt.Errorf("OldTime requires an ID field in the mutation")
+		return v, fmt.Errorf("OldTag requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldTime: %w", err)
-	}
-	return oldValue.Time, nil
-}
-
-// AddTime adds i to time.
-func (m *HistogramMutation) AddTime(i int64) {
-	if m.addtime != nil {
-		*m.addtime += i
-	} else {
-		m.addtime = &i
-	}
-}
-
-// AddedTime returns the value that was added to the time field in this mutation.
-func (m *HistogramMutation) AddedTime() (r int64, exists bool) {
-	v := m.addtime
-	if v == nil {
-		return
+		return v, fmt.Errorf("querying old value for OldTag: %w", err)
 	}
-	return *v, true
+	return oldValue.Tag, nil
 }
 
-// ResetTime reset all changes of the "time" field.
-func (m *HistogramMutation) ResetTime() {
-	m.time = nil
-	m.addtime = nil
+// ResetTag reset all changes of the "tag" field.
+func (m *BaselineMutation) ResetTag() {
+	m.tag = nil
 }
 
-// SetCount sets the count field.
-func (m *HistogramMutation) SetCount(i int64) {
-	m.count = &i
-	m.addcount = nil
+// SetTolerance sets the tolerance field.
+func (m *BaselineMutation) SetTolerance(f float64) {
+	m.tolerance = &f
+	m.addtolerance = nil
 }
 
-// Count returns the count value in the mutation.
-func (m *HistogramMutation) Count() (r int64, exists bool) {
-	v := m.count
+// Tolerance returns the tolerance value in the mutation.
+func (m *BaselineMutation) Tolerance() (r float64, exists bool) {
+	v := m.tolerance
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldCount returns the old count value of the Histogram.
-// If the Histogram object wasn't provided to the builder, the object is fetched
+// OldTolerance returns the old tolerance value of the Baseline.
+// If the Baseline object wasn't provided to the builder, the object is fetched
 // from the database.
 // An error is returned if the mutation operation is not UpdateOne, or database query fails.
-func (m *HistogramMutation) OldCount(ctx context.Context) (v int64, err error) {
+func (m *BaselineMutation) OldTolerance(ctx context.Context) (v float64, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, fmt.Errorf("OldCount is allowed only on UpdateOne operations")
+		return v, fmt.Errorf("OldTolerance is allowed only on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, fmt.Errorf("OldCount requires an ID field in the mutation")
+		return v, fmt.Errorf("OldTolerance requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldCount: %w", err)
+		return v, fmt.Errorf("querying old value for OldTolerance: %w", err)
 	}
-	return oldValue.Count, nil
+	return oldValue.Tolerance, nil
 }
 
-// AddCount adds i to count.
-func (m *HistogramMutation) AddCount(i int64) {
-	if m.addcount != nil {
-		*m.addcount += i
+// AddTolerance adds f to tolerance.
+func (m *BaselineMutation) AddTolerance(f float64) {
+	if m.addtolerance != nil {
+		*m.addtolerance += f
 	} else {
-		m.addcount = &i
+		m.addtolerance = &f
 	}
 }
 
-// AddedCount returns the value that was added to the count field in this mutation.
-func (m *HistogramMutation) AddedCount() (r int64, exists bool) {
-	v := m.addcount
+// AddedTolerance returns the value that was added to the tolerance field in this mutation.
+func (m *BaselineMutation) AddedTolerance() (r float64, exists bool) {
+	v := m.addtolerance
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// ResetCount reset all changes of the "count" field.
-func (m *HistogramMutation) ResetCount() {
-	m.count = nil
-	m.addcount = nil
+// ResetTolerance reset all changes of the "tolerance" field.
+func (m *BaselineMutation) ResetTolerance() {
+	m.tolerance = nil
+	m.addtolerance = nil
 }
 
-// SetMin sets the min field.
-func (m *HistogramMutation) SetMin(i int64) {
-	m.min = &i
-	m.addmin = nil
+// SetTolerances sets the tolerances field.
+func (m *BaselineMutation) SetTolerances(value map[string]float64) {
+	m.tolerances = &value
 }
 
-// Min returns the min value in the mutation.
-func (m *HistogramMutation) Min() (r int64, exists bool) {
-	v := m.min
+// Tolerances returns the tolerances value in the mutation.
+func (m *BaselineMutation) Tolerances() (r map[string]float64, exists bool) {
+	v := m.tolerances
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldMin returns the old min value of the Histogram.
-// If the Histogram object wasn't provided to the builder, the object is fetched
+// OldTolerances returns the old tolerances value of the Baseline.
+// If the Baseline object wasn't provided to the builder, the object is fetched
 // from the database.
 // An error is returned if the mutation operation is not UpdateOne, or database query fails.
-func (m *HistogramMutation) OldMin(ctx context.Context) (v int64, err error) {
+func (m *BaselineMutation) OldTolerances(ctx context.Context) (v map[string]float64, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, fmt.Errorf("OldMin is allowed only on UpdateOne operations")
+		return v, fmt.Errorf("OldTolerances is allowed only on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, fmt.Errorf("OldMin requires an ID field in the mutation")
+		return v, fmt.Errorf("OldTolerances requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldMin: %w", err)
+		return v, fmt.Errorf("querying old value for OldTolerances: %w", err)
 	}
-	return oldValue.Min, nil
+	return oldValue.Tolerances, nil
 }
 
-// AddMin adds i to min.
-func (m *HistogramMutation) AddMin(i int64) {
-	if m.addmin != nil {
-		*m.addmin += i
-	} else {
-		m.addmin = &i
-	}
+// ClearTolerances clears the value of tolerances.
+func (m *BaselineMutation) ClearTolerances() {
+	m.tolerances = nil
+	m.clearedFields[baseline.FieldTolerances] = struct{}{}
 }
 
-// AddedMin returns the value that was added to the min field in this mutation.
-func (m *HistogramMutation) AddedMin() (r int64, exists bool) {
-	v := m.addmin
-	if v == nil {
-		return
-	}
-	return *v, true
+// TolerancesCleared returns if the field tolerances was cleared in this mutation.
+func (m *BaselineMutation) TolerancesCleared() bool {
+	_, ok := m.clearedFields[baseline.FieldTolerances]
+	return ok
 }
 
-// ResetMin reset all changes of the "min" field.
-func (m *HistogramMutation) ResetMin() {
-	m.min = nil
-	m.addmin = nil
+// ResetTolerances reset all changes of the "tolerances" field.
+func (m *BaselineMutation) ResetTolerances() {
+	m.tolerances = nil
+	delete(m.clearedFields, baseline.FieldTolerances)
 }
 
-// SetMax sets the max field.
-func (m *HistogramMutation) SetMax(i int64) {
-	m.max = &i
-	m.addmax = nil
+// SetCreatedAt sets the created_at field.
+func (m *BaselineMutation) SetCreatedAt(t time.Time) {
+	m.created_at = &t
 }
 
-// Max returns the max value in the mutation.
-func (m *HistogramMutation) Max() (r int64, exists bool) {
-	v := m.max
+// CreatedAt returns the created_at value in the mutation.
+func (m *BaselineMutation) CreatedAt() (r time.Time, exists bool) {
+	v := m.created_at
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldMax returns the old max value of the Histogram.
-// If the Histogram object wasn't provided to the builder, the object is fetched
+// OldCreatedAt returns the old created_at value of the Baseline.
+// If the Baseline object wasn't provided to the builder, the object is fetched
 // from the database.
 // An error is returned if the mutation operation is not UpdateOne, or database query fails.
-func (m *HistogramMutation) OldMax(ctx context.Context) (v int64, err error) {
+func (m *BaselineMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, fmt.Errorf("OldMax is allowed only on UpdateOne operations")
+		return v, fmt.Errorf("OldCreatedAt is allowed only on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, fmt.Errorf("OldMax requires an ID field in the mutation")
+		return v, fmt.Errorf("OldCreatedAt requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldMax: %w", err)
-	}
-	return oldValue.Max, nil
-}
-
-// AddMax adds i to max.
-func (m *HistogramMutation) AddMax(i int64) {
-	if m.addmax != nil {
-		*m.addmax += i
-	} else {
-		m.addmax = &i
-	}
-}
-
-// AddedMax returns the value that was added to the max field in this mutation.
-func (m *HistogramMutation) AddedMax() (r int64, exists bool) {
-	v := m.addmax
-	if v == nil {
-		return
+		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
 	}
-	return *v, true
+	return oldValue.CreatedAt, nil
 }
 
-// ResetMax reset all changes of the "max" field.
-func (m *HistogramMutation) ResetMax() {
-	m.max = nil
-	m.addmax = nil
+// ResetCreatedAt reset all changes of the "created_at" field.
+func (m *BaselineMutation) ResetCreatedAt() {
+	m.created_at = nil
 }
 
-// SetMean sets the mean field.
-func (m *HistogramMutation) SetMean(f float64) {
-	m.mean = &f
-	m.addmean = nil
+// SetUpdatedAt sets the updated_at field.
+func (m *BaselineMutation) SetUpdatedAt(t time.Time) {
+	m.updated_at = &t
 }
 
-// Mean returns the mean value in the mutation.
-func (m *HistogramMutation) Mean() (r float64, exists bool) {
-	v := m.mean
+// UpdatedAt returns the updated_at value in the mutation.
+func (m *BaselineMutation) UpdatedAt() (r time.Time, exists bool) {
+	v := m.updated_at
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldMean returns the old mean value of the Histogram.
-// If the Histogram object wasn't provided to the builder, the object is fetched
+// OldUpdatedAt returns the old updated_at value of the Baseline.
+// If the Baseline object wasn't provided to the builder, the object is fetched
 // from the database.
 // An error is returned if the mutation operation is not UpdateOne, or database query fails.
-func (m *HistogramMutation) OldMean(ctx context.Context) (v float64, err error) {
+func (m *BaselineMutation) OldUpdatedAt(ctx context.Context) (v time.Time, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, fmt.Errorf("OldMean is allowed only on UpdateOne operations")
+		return v, fmt.Errorf("OldUpdatedAt is allowed only on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, fmt.Errorf("OldMean requires an ID field in the mutation")
+		return v, fmt.Errorf("OldUpdatedAt requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldMean: %w", err)
+		return v, fmt.Errorf("querying old value for OldUpdatedAt: %w", err)
 	}
-	return oldValue.Mean, nil
+	return oldValue.UpdatedAt, nil
 }
 
-// AddMean adds f to mean.
-func (m *HistogramMutation) AddMean(f float64) {
-	if m.addmean != nil {
-		*m.addmean += f
-	} else {
-		m.addmean = &f
-	}
+// ResetUpdatedAt reset all changes of the "updated_at" field.
+func (m *BaselineMutation) ResetUpdatedAt() {
+	m.updated_at = nil
 }
 
-// AddedMean returns the value that was added to the mean field in this mutation.
-func (m *HistogramMutation) AddedMean() (r float64, exists bool) {
-	v := m.addmean
-	if v == nil {
-		return
-	}
-	return *v, true
+// SetApplicationID sets the application edge to Application by id.
+func (m *BaselineMutation) SetApplicationID(id int) {
+	m.application = &id
 }
 
-// ResetMean reset all changes of the "mean" field.
-func (m *HistogramMutation) ResetMean() {
-	m.mean = nil
-	m.addmean = nil
+// ClearApplication clears the application edge to Application.
+func (m *BaselineMutation) ClearApplication() {
+	m.clearedapplication = true
 }
 
-// SetStddev sets the stddev field.
-func (m *HistogramMutation) SetStddev(f float64) {
-	m.stddev = &f
-	m.addstddev = nil
+// ApplicationCleared returns if the edge application was cleared.
+func (m *BaselineMutation) ApplicationCleared() bool {
+	return m.clearedapplication
 }
 
-// Stddev returns the stddev value in the mutation.
-func (m *HistogramMutation) Stddev() (r float64, exists bool) {
-	v := m.stddev
-	if v == nil {
-		return
+// ApplicationID returns the application id in the mutation.
+func (m *BaselineMutation) ApplicationID() (id int, exists bool) {
+	if m.application != nil {
+		return *m.application, true
 	}
-	return *v, true
-}
-
-// OldStddev returns the old stddev value of the Histogram.
-// If the Histogram object wasn't provided to the builder, the object is fetched
-// from the database.
-// An error is returned if the mutation operation is not UpdateOne, or database query fails.
-func (m *HistogramMutation) OldStddev(ctx context.Context) (v float64, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, fmt.Errorf("OldStddev is allowed only on UpdateOne operations")
-	}
-	if m.id == nil || m.oldValue == nil {
-		return v, fmt.Errorf("OldStddev requires an ID field in the mutation")
-	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldStddev: %w", err)
-	}
-	return oldValue.Stddev, nil
+	return
 }
 
-// AddStddev adds f to stddev.
-func (m *HistogramMutation) AddStddev(f float64) {
-	if m.addstddev != nil {
-		*m.addstddev += f
-	} else {
-		m.addstddev = &f
+// ApplicationIDs returns the application ids in the mutation.
+// Note that ids always returns len(ids) <= 1 for unique edges, and you should use
+// ApplicationID instead. It exists only for internal usage by the builders.
+func (m *BaselineMutation) ApplicationIDs() (ids []int) {
+	if id := m.application; id != nil {
+		ids = append(ids, *id)
 	}
+	return
 }
 
-// AddedStddev returns the value that was added to the stddev field in this mutation.
-func (m *HistogramMutation) AddedStddev() (r float64, exists bool) {
-	v := m.addstddev
-	if v == nil {
-		return
-	}
-	return *v, true
+// ResetApplication reset all changes of the "application" edge.
+func (m *BaselineMutation) ResetApplication() {
+	m.application = nil
+	m.clearedapplication = false
 }
 
-// ResetStddev reset all changes of the "stddev" field.
-func (m *HistogramMutation) ResetStddev() {
-	m.stddev = nil
-	m.addstddev = nil
+// Op returns the operation name.
+func (m *BaselineMutation) Op() Op {
+	return m.op
 }
 
-// SetMedian sets the median field.
-func (m *HistogramMutation) SetMedian(f float64) {
-	m.median = &f
-	m.addmedian = nil
+// Type returns the node type of this mutation (Baseline).
+func (m *BaselineMutation) Type() string {
+	return m.typ
 }
 
-// Median returns the median value in the mutation.
-func (m *HistogramMutation) Median() (r float64, exists bool) {
-	v := m.median
-	if v == nil {
-		return
+// Fields returns all fields that were changed during
+// this mutation. Note that, in order to get all numeric
+// fields that were in/decremented, call AddedFields().
+func (m *BaselineMutation) Fields() []string {
+	fields := make([]string, 0, 5)
+	if m.tag != nil {
+		fields = append(fields, baseline.FieldTag)
 	}
-	return *v, true
-}
-
-// OldMedian returns the old median value of the Histogram.
-// If the Histogram object wasn't provided to the builder, the object is fetched
-// from the database.
-// An error is returned if the mutation operation is not UpdateOne, or database query fails.
-func (m *HistogramMutation) OldMedian(ctx context.Context) (v float64, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, fmt.Errorf("OldMedian is allowed only on UpdateOne operations")
+	if m.tolerance != nil {
+		fields = append(fields, baseline.FieldTolerance)
 	}
-	if m.id == nil || m.oldValue == nil {
-		return v, fmt.Errorf("OldMedian requires an ID field in the mutation")
+	if m.tolerances != nil {
+		fields = append(fields, baseline.FieldTolerances)
 	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldMedian: %w", err)
+	if m.created_at != nil {
+		fields = append(fields, baseline.FieldCreatedAt)
 	}
-	return oldValue.Median, nil
-}
-
-// AddMedian adds f to median.
-func (m *HistogramMutation) AddMedian(f float64) {
-	if m.addmedian != nil {
-		*m.addmedian += f
-	} else {
-		m.addmedian = &f
+	if m.updated_at != nil {
+		fields = append(fields, baseline.FieldUpdatedAt)
 	}
+	return fields
 }
 
-// AddedMedian returns the value that was added to the median field in this mutation.
-func (m *HistogramMutation) AddedMedian() (r float64, exists bool) {
-	v := m.addmedian
-	if v == nil {
-		return
+// Field returns the value of a field with the given name.
+// The second boolean value indicates that this field was
+// not set, or was not define in the schema.
+func (m *BaselineMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case baseline.FieldTag:
+		return m.Tag()
+	case baseline.FieldTolerance:
+		return m.Tolerance()
+	case baseline.FieldTolerances:
+		return m.Tolerances()
+	case baseline.FieldCreatedAt:
+		return m.CreatedAt()
+	case baseline.FieldUpdatedAt:
+		return m.UpdatedAt()
 	}
-	return *v, true
+	return nil, false
 }
 
-// ResetMedian reset all changes of the "median" field.
-func (m *HistogramMutation) ResetMedian() {
-	m.median = nil
-	m.addmedian = nil
+// OldField returns the old value of the field from the database.
+// An error is returned if the mutation operation is not UpdateOne,
+// or the query to the database was failed.
+func (m *BaselineMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case baseline.FieldTag:
+		return m.OldTag(ctx)
+	case baseline.FieldTolerance:
+		return m.OldTolerance(ctx)
+	case baseline.FieldTolerances:
+		return m.OldTolerances(ctx)
+	case baseline.FieldCreatedAt:
+		return m.OldCreatedAt(ctx)
+	case baseline.FieldUpdatedAt:
+		return m.OldUpdatedAt(ctx)
+	}
+	return nil, fmt.Errorf("unknown Baseline field %s", name)
 }
 
-// SetP75 sets the p75 field.
-func (m *HistogramMutation) SetP75(f float64) {
-	m.p75 = &f
-	m.addp75 = nil
+// SetField sets the value for the given name. It returns an
+// error if the field is not defined in the schema, or if the
+// type mismatch the field type.
+func (m *BaselineMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case baseline.FieldTag:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetTag(v)
+		return nil
+	case baseline.FieldTolerance:
+		v, ok := value.(float64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetTolerance(v)
+		return nil
+	case baseline.FieldTolerances:
+		v, ok := value.(map[string]float64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetTolerances(v)
+		return nil
+	case baseline.FieldCreatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCreatedAt(v)
+		return nil
+	case baseline.FieldUpdatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetUpdatedAt(v)
+		return nil
+	}
+	return fmt.Errorf("unknown Baseline field %s", name)
 }
 
-// P75 returns the p75 value in the mutation.
-func (m *HistogramMutation) P75() (r float64, exists bool) {
-	v := m.p75
-	if v == nil {
-		return
+// AddedFields returns all numeric fields that were incremented
+// or decremented during this mutation.
+func (m *BaselineMutation) AddedFields() []string {
+	var fields []string
+	if m.addtolerance != nil {
+		fields = append(fields, baseline.FieldTolerance)
 	}
-	return *v, true
+	return fields
 }
 
-// OldP75 returns the old p75 value of the Histogram.
-// If the Histogram object wasn't provided to the builder, the object is fetched
-// from the database.
-// An error is returned if the mutation operation is not UpdateOne, or database query fails.
-func (m *HistogramMutation) OldP75(ctx context.Context) (v float64, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, fmt.Errorf("OldP75 is allowed only on UpdateOne operations")
-	}
-	if m.id == nil || m.oldValue == nil {
-		return v, fmt.Errorf("OldP75 requires an ID field in the mutation")
-	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldP75: %w", err)
+// AddedField returns the numeric value that was in/decremented
+// from a field with the given name. The second value indicates
+// that this field was not set, or was not define in the schema.
+func (m *BaselineMutation) AddedField(name string) (ent.Value, bool) {
+	switch name {
+	case baseline.FieldTolerance:
+		return m.AddedTolerance()
 	}
-	return oldValue.P75, nil
+	return nil, false
 }
 
-// AddP75 adds f to p75.
-func (m *HistogramMutation) AddP75(f float64) {
-	if m.addp75 != nil {
-		*m.addp75 += f
-	} else {
-		m.addp75 = &f
+// AddField adds the value for the given name. It returns an
+// error if the field is not defined in the schema, or if the
+// type mismatch the field type.
+func (m *BaselineMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	case baseline.FieldTolerance:
+		v, ok := value.(float64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddTolerance(v)
+		return nil
 	}
+	return fmt.Errorf("unknown Baseline numeric field %s", name)
 }
 
-// AddedP75 returns the value that was added to the p75 field in this mutation.
-func (m *HistogramMutation) AddedP75() (r float64, exists bool) {
-	v := m.addp75
-	if v == nil {
-		return
+// ClearedFields returns all nullable fields that were cleared
+// during this mutation.
+func (m *BaselineMutation) ClearedFields() []string {
+	var fields []string
+	if m.FieldCleared(baseline.FieldTolerances) {
+		fields = append(fields, baseline.FieldTolerances)
 	}
-	return *v, true
+	return fields
 }
 
-// ResetP75 reset all changes of the "p75" field.
-func (m *HistogramMutation) ResetP75() {
-	m.p75 = nil
-	m.addp75 = nil
+// FieldCleared returns a boolean indicates if this field was
+// cleared in this mutation.
+func (m *BaselineMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
 }
 
-// SetP95 sets the p95 field.
-func (m *HistogramMutation) SetP95(f float64) {
-	m.p95 = &f
-	m.addp95 = nil
+// ClearField clears the value for the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *BaselineMutation) ClearField(name string) error {
+	switch name {
+	case baseline.FieldTolerances:
+		m.ClearTolerances()
+		return nil
+	}
+	return fmt.Errorf("unknown Baseline nullable field %s", name)
 }
 
-// P95 returns the p95 value in the mutation.
-func (m *HistogramMutation) P95() (r float64, exists bool) {
-	v := m.p95
-	if v == nil {
-		return
+// ResetField resets all changes in the mutation regarding the
+// given field name. It returns an error if the field is not
+// defined in the schema.
+func (m *BaselineMutation) ResetField(name string) error {
+	switch name {
+	case baseline.FieldTag:
+		m.ResetTag()
+		return nil
+	case baseline.FieldTolerance:
+		m.ResetTolerance()
+		return nil
+	case baseline.FieldTolerances:
+		m.ResetTolerances()
+		return nil
+	case baseline.FieldCreatedAt:
+		m.ResetCreatedAt()
+		return nil
+	case baseline.FieldUpdatedAt:
+		m.ResetUpdatedAt()
+		return nil
 	}
-	return *v, true
+	return fmt.Errorf("unknown Baseline field %s", name)
 }
 
-// OldP95 returns the old p95 value of the Histogram.
-// If the Histogram object wasn't provided to the builder, the object is fetched
-// from the database.
-// An error is returned if the mutation operation is not UpdateOne, or database query fails.
-func (m *HistogramMutation) OldP95(ctx context.Context) (v float64, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, fmt.Errorf("OldP95 is allowed only on UpdateOne operations")
-	}
-	if m.id == nil || m.oldValue == nil {
-		return v, fmt.Errorf("OldP95 requires an ID field in the mutation")
-	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldP95: %w", err)
+// AddedEdges returns all edge names that were set/added in this
+// mutation.
+func (m *BaselineMutation) AddedEdges() []string {
+	edges := make([]string, 0, 1)
+	if m.application != nil {
+		edges = append(edges, baseline.EdgeApplication)
 	}
-	return oldValue.P95, nil
+	return edges
 }
 
-// AddP95 adds f to p95.
-func (m *HistogramMutation) AddP95(f float64) {
-	if m.addp95 != nil {
-		*m.addp95 += f
-	} else {
-		m.addp95 = &f
+// AddedIDs returns all ids (to other nodes) that were added for
+// the given edge name.
+func (m *BaselineMutation) AddedIDs(name string) []ent.Value {
+	switch name {
+	case baseline.EdgeApplication:
+		if id := m.application; id != nil {
+			return []ent.Value{*id}
+		}
 	}
+	return nil
 }
 
-// AddedP95 returns the value that was added to the p95 field in this mutation.
-func (m *HistogramMutation) AddedP95() (r float64, exists bool) {
-	v := m.addp95
-	if v == nil {
-		return
+// RemovedEdges returns all edge names that were removed in this
+// mutation.
+func (m *BaselineMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 1)
+	return edges
+}
+
+// RemovedIDs returns all ids (to other nodes) that were removed for
+// the given edge name.
+func (m *BaselineMutation) RemovedIDs(name string) []ent.Value {
+	switch name {
 	}
-	return *v, true
+	return nil
 }
 
-// ResetP95 reset all changes of the "p95" field.
-func (m *HistogramMutation) ResetP95() {
-	m.p95 = nil
-	m.addp95 = nil
+// ClearedEdges returns all edge names that were cleared in this
+// mutation.
+func (m *BaselineMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 1)
+	if m.clearedapplication {
+		edges = append(edges, baseline.EdgeApplication)
+	}
+	return edges
 }
 
-// SetP99 sets the p99 field.
-func (m *HistogramMutation) SetP99(f float64) {
-	m.p99 = &f
-	m.addp99 = nil
+// EdgeCleared returns a boolean indicates if this edge was
+// cleared in this mutation.
+func (m *BaselineMutation) EdgeCleared(name string) bool {
+	switch name {
+	case baseline.EdgeApplication:
+		return m.clearedapplication
+	}
+	return false
 }
 
-// P99 returns the p99 value in the mutation.
-func (m *HistogramMutation) P99() (r float64, exists bool) {
-	v := m.p99
-	if v == nil {
-		return
+// ClearEdge clears the value for the given name. It returns an
+// error if the edge name is not defined in the schema.
+func (m *BaselineMutation) ClearEdge(name string) error {
+	switch name {
+	case baseline.EdgeApplication:
+		m.ClearApplication()
+		return nil
 	}
-	return *v, true
+	return fmt.Errorf("unknown Baseline unique edge %s", name)
 }
 
-// OldP99 returns the old p99 value of the Histogram.
-// If the Histogram object wasn't provided to the builder, the object is fetched
-// from the database.
-// An error is returned if the mutation operation is not UpdateOne, or database query fails.
-func (m *HistogramMutation) OldP99(ctx context.Context) (v float64, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, fmt.Errorf("OldP99 is allowed only on UpdateOne operations")
+// ResetEdge resets all changes in the mutation regarding the
+// given edge name. It returns an error if the edge is not
+// defined in the schema.
+func (m *BaselineMutation) ResetEdge(name string) error {
+	switch name {
+	case baseline.EdgeApplication:
+		m.ResetApplication()
+		return nil
 	}
-	if m.id == nil || m.oldValue == nil {
-		return v, fmt.Errorf("OldP99 requires an ID field in the mutation")
+	return fmt.Errorf("unknown Baseline edge %s", name)
+}
+
+// CounterMutation represents an operation that mutate the Counters
+// nodes in the graph.
+type CounterMutation struct {
+	config
+	op              Op
+	typ             string
+	id              *int
+	time            *int64
+	addtime         *int64
+	count           *int64
+	addcount        *int64
+	wID             *string
+	clearedFields   map[string]struct{}
+	metric          *int
+	clearedmetric   bool
+	executor        *int
+	clearedexecutor bool
+	done            bool
+	oldValue        func(context.Context) (*Counter, error)
+}
+
+var _ ent.Mutation = (*CounterMutation)(nil)
+
+// counterOption allows to manage the mutation configuration using functional options.
+type counterOption func(*CounterMutation)
+
+// newCounterMutation creates new mutation for $n.Name.
+func newCounterMutation(c config, op Op, opts ...counterOption) *CounterMutation {
+	m := &CounterMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeCounter,
+		clearedFields: make(map[string]struct{}),
 	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldP99: %w", err)
+	for _, opt := range opts {
+		opt(m)
 	}
-	return oldValue.P99, nil
+	return m
 }
 
-// AddP99 adds f to p99.
-func (m *HistogramMutation) AddP99(f float64) {
-	if m.addp99 != nil {
-		*m.addp99 += f
-	} else {
-		m.addp99 = &f
+// withCounterID sets the id field of the mutation.
+func withCounterID(id int) counterOption {
+	return func(m *CounterMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *Counter
+		)
+		m.oldValue = func(ctx context.Context) (*Counter, error) {
+			once.Do(func() {
+				if m.done {
+					err = fmt.Errorf("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().Counter.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
 	}
 }
 
-// AddedP99 returns the value that was added to the p99 field in this mutation.
-func (m *HistogramMutation) AddedP99() (r float64, exists bool) {
-	v := m.addp99
-	if v == nil {
-		return
+// withCounter sets the old Counter of the mutation.
+func withCounter(node *Counter) counterOption {
+	return func(m *CounterMutation) {
+		m.oldValue = func(context.Context) (*Counter, error) {
+			return node, nil
+		}
+		m.id = &node.ID
 	}
-	return *v, true
 }
 
-// ResetP99 reset all changes of the "p99" field.
-func (m *HistogramMutation) ResetP99() {
-	m.p99 = nil
-	m.addp99 = nil
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m CounterMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
 }
 
-// SetP999 sets the p999 field.
-func (m *HistogramMutation) SetP999(f float64) {
-	m.p999 = &f
-	m.addp999 = nil
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m CounterMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, fmt.Errorf("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
 }
 
-// P999 returns the p999 value in the mutation.
-func (m *HistogramMutation) P999() (r float64, exists bool) {
-	v := m.p999
+// ID returns the id value in the mutation. Note that, the id
+// is available only if it was provided to the builder.
+func (m *CounterMutation) ID() (id int, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// SetTime sets the time field.
+func (m *CounterMutation) SetTime(i int64) {
+	m.time = &i
+	m.addtime = nil
+}
+
+// Time returns the time value in the mutation.
+func (m *CounterMutation) Time() (r int64, exists bool) {
+	v := m.time
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldP999 returns the old p999 value of the Histogram.
-// If the Histogram object wasn't provided to the builder, the object is fetched
+// OldTime returns the old time value of the Counter.
+// If the Counter object wasn't provided to the builder, the object is fetched
 // from the database.
 // An error is returned if the mutation operation is not UpdateOne, or database query fails.
-func (m *HistogramMutation) OldP999(ctx context.Context) (v float64, err error) {
+func (m *CounterMutation) OldTime(ctx context.Context) (v int64, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, fmt.Errorf("OldP999 is allowed only on UpdateOne operations")
+		return v, fmt.Errorf("OldTime is allowed only on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, fmt.Errorf("OldP999 requires an ID field in the mutation")
+		return v, fmt.Errorf("OldTime requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldP999: %w", err)
+		return v, fmt.Errorf("querying old value for OldTime: %w", err)
 	}
-	return oldValue.P999, nil
+	return oldValue.Time, nil
 }
 
-// AddP999 adds f to p999.
-func (m *HistogramMutation) AddP999(f float64) {
-	if m.addp999 != nil {
-		*m.addp999 += f
+// AddTime adds i to time.
+func (m *CounterMutation) AddTime(i int64) {
+	if m.addtime != nil {
+		*m.addtime += i
 	} else {
-		m.addp999 = &f
+		m.addtime = &i
 	}
 }
 
-// AddedP999 returns the value that was added to the p999 field in this mutation.
-func (m *HistogramMutation) AddedP999() (r float64, exists bool) {
-	v := m.addp999
+// AddedTime returns the value that was added to the time field in this mutation.
+func (m *CounterMutation) AddedTime() (r int64, exists bool) {
+	v := m.addtime
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// ResetP999 reset all changes of the "p999" field.
-func (m *HistogramMutation) ResetP999() {
-	m.p999 = nil
-	m.addp999 = nil
+// ResetTime reset all changes of the "time" field.
+func (m *CounterMutation) ResetTime() {
+	m.time = nil
+	m.addtime = nil
 }
 
-// SetWID sets the wID field.
-func (m *HistogramMutation) SetWID(s string) {
-	m.wID = &s
+// SetCount sets the count field.
+func (m *CounterMutation) SetCount(i int64) {
+	m.count = &i
+	m.addcount = nil
 }
 
-// WID returns the wID value in the mutation.
-func (m *HistogramMutation) WID() (r string, exists bool) {
-	v := m.wID
+// Count returns the count value in the mutation.
+func (m *CounterMutation) Count() (r int64, exists bool) {
+	v := m.count
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldWID returns the old wID value of the Histogram.
-// If the Histogram object wasn't provided to the builder, the object is fetched
+// OldCount returns the old count value of the Counter.
+// If the Counter object wasn't provided to the builder, the object is fetched
 // from the database.
 // An error is returned if the mutation operation is not UpdateOne, or database query fails.
-func (m *HistogramMutation) OldWID(ctx context.Context) (v string, err error) {
+func (m *CounterMutation) OldCount(ctx context.Context) (v int64, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, fmt.Errorf("OldWID is allowed only on UpdateOne operations")
+		return v, fmt.Errorf("OldCount is allowed only on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, fmt.Errorf("OldWID requires an ID field in the mutation")
+		return v, fmt.Errorf("OldCount requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldWID: %w", err)
+		return v, fmt.Errorf("querying old value for OldCount: %w", err)
 	}
-	return oldValue.WID, nil
-}
-
-// ResetWID reset all changes of the "wID" field.
-func (m *HistogramMutation) ResetWID() {
-	m.wID = nil
+	return oldValue.Count, nil
 }
 
-// SetMetricID sets the metric edge to Metric by id.
-func (m *HistogramMutation) SetMetricID(id int) {
+// AddCount adds i to count.
+func (m *CounterMutation) AddCount(i int64) {
+	if m.addcount != nil {
+		*m.addcount += i
+	} else {
+		m.addcount = &i
+	}
+}
+
+// AddedCount returns the value that was added to the count field in this mutation.
+func (m *CounterMutation) AddedCount() (r int64, exists bool) {
+	v := m.addcount
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetCount reset all changes of the "count" field.
+func (m *CounterMutation) ResetCount() {
+	m.count = nil
+	m.addcount = nil
+}
+
+// SetWID sets the wID field.
+func (m *CounterMutation) SetWID(s string) {
+	m.wID = &s
+}
+
+// WID returns the wID value in the mutation.
+func (m *CounterMutation) WID() (r string, exists bool) {
+	v := m.wID
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldWID returns the old wID value of the Counter.
+// If the Counter object wasn't provided to the builder, the object is fetched
+// from the database.
+// An error is returned if the mutation operation is not UpdateOne, or database query fails.
+func (m *CounterMutation) OldWID(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, fmt.Errorf("OldWID is allowed only on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, fmt.Errorf("OldWID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldWID: %w", err)
+	}
+	return oldValue.WID, nil
+}
+
+// ResetWID reset all changes of the "wID" field.
+func (m *CounterMutation) ResetWID() {
+	m.wID = nil
+}
+
+// SetMetricID sets the metric edge to Metric by id.
+func (m *CounterMutation) SetMetricID(id int) {
 	m.metric = &id
 }
 
 // ClearMetric clears the metric edge to Metric.
-func (m *HistogramMutation) ClearMetric() {
+func (m *CounterMutation) ClearMetric() {
 	m.clearedmetric = true
 }
 
 // MetricCleared returns if the edge metric was cleared.
-func (m *HistogramMutation) MetricCleared() bool {
+func (m *CounterMutation) MetricCleared() bool {
 	return m.clearedmetric
 }
 
 // MetricID returns the metric id in the mutation.
-func (m *HistogramMutation) MetricID() (id int, exists bool) {
+func (m *CounterMutation) MetricID() (id int, exists bool) {
 	if m.metric != nil {
 		return *m.metric, true
 	}
@@ -3744,7 +4091,7 @@ func (m *HistogramMutation) MetricID() (id int, exists bool) {
 // MetricIDs returns the metric ids in the mutation.
 // Note that ids always returns len(ids) <= 1 for unique edges, and you should use
 // MetricID instead. It exists only for internal usage by the builders.
-func (m *HistogramMutation) MetricIDs() (ids []int) {
+func (m *CounterMutation) MetricIDs() (ids []int) {
 	if id := m.metric; id != nil {
 		ids = append(ids, *id)
 	}
@@ -3752,61 +4099,73 @@ func (m *HistogramMutation) MetricIDs() (ids []int) {
 }
 
 // ResetMetric reset all changes of the "metric" edge.
-func (m *HistogramMutation) ResetMetric() {
+func (m *CounterMutation) ResetMetric() {
 	m.metric = nil
 	m.clearedmetric = false
 }
 
+// SetExecutorID sets the executor edge to Executor by id.
+func (m *CounterMutation) SetExecutorID(id int) {
+	m.executor = &id
+}
+
+// ClearExecutor clears the executor edge to Executor.
+func (m *CounterMutation) ClearExecutor() {
+	m.clearedexecutor = true
+}
+
+// ExecutorCleared returns if the edge executor was cleared.
+func (m *CounterMutation) ExecutorCleared() bool {
+	return m.clearedexecutor
+}
+
+// ExecutorID returns the executor id in the mutation.
+func (m *CounterMutation) ExecutorID() (id int, exists bool) {
+	if m.executor != nil {
+		return *m.executor, true
+	}
+	return
+}
+
+// ExecutorIDs returns the executor ids in the mutation.
+// Note that ids always returns len(ids) <= 1 for unique edges, and you should use
+// ExecutorID instead. It exists only for internal usage by the builders.
+func (m *CounterMutation) ExecutorIDs() (ids []int) {
+	if id := m.executor; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetExecutor reset all changes of the "executor" edge.
+func (m *CounterMutation) ResetExecutor() {
+	m.executor = nil
+	m.clearedexecutor = false
+}
+
 // Op returns the operation name.
-func (m *HistogramMutation) Op() Op {
+func (m *CounterMutation) Op() Op {
 	return m.op
 }
 
-// Type returns the node type of this mutation (Histogram).
-func (m *HistogramMutation) Type() string {
+// Type returns the node type of this mutation (Counter).
+func (m *CounterMutation) Type() string {
 	return m.typ
 }
 
 // Fields returns all fields that were changed during
 // this mutation. Note that, in order to get all numeric
 // fields that were in/decremented, call AddedFields().
-func (m *HistogramMutation) Fields() []string {
-	fields := make([]string, 0, 12)
+func (m *CounterMutation) Fields() []string {
+	fields := make([]string, 0, 3)
 	if m.time != nil {
-		fields = append(fields, histogram.FieldTime)
+		fields = append(fields, counter.FieldTime)
 	}
 	if m.count != nil {
-		fields = append(fields, histogram.FieldCount)
-	}
-	if m.min != nil {
-		fields = append(fields, histogram.FieldMin)
-	}
-	if m.max != nil {
-		fields = append(fields, histogram.FieldMax)
-	}
-	if m.mean != nil {
-		fields = append(fields, histogram.FieldMean)
-	}
-	if m.stddev != nil {
-		fields = append(fields, histogram.FieldStddev)
-	}
-	if m.median != nil {
-		fields = append(fields, histogram.FieldMedian)
-	}
-	if m.p75 != nil {
-		fields = append(fields, histogram.FieldP75)
-	}
-	if m.p95 != nil {
-		fields = append(fields, histogram.FieldP95)
-	}
-	if m.p99 != nil {
-		fields = append(fields, histogram.FieldP99)
-	}
-	if m.p999 != nil {
-		fields = append(fields, histogram.FieldP999)
+		fields = append(fields, counter.FieldCount)
 	}
 	if m.wID != nil {
-		fields = append(fields, histogram.FieldWID)
+		fields = append(fields, counter.FieldWID)
 	}
 	return fields
 }
@@ -3814,31 +4173,13 @@ func (m *HistogramMutation) Fields() []string {
 // Field returns the value of a field with the given name.
 // The second boolean value indicates that this field was
 // not set, or was not define in the schema.
-func (m *HistogramMutation) Field(name string) (ent.Value, bool) {
+func (m *CounterMutation) Field(name string) (ent.Value, bool) {
 	switch name {
-	case histogram.FieldTime:
+	case counter.FieldTime:
 		return m.Time()
-	case histogram.FieldCount:
+	case counter.FieldCount:
 		return m.Count()
-	case histogram.FieldMin:
-		return m.Min()
-	case histogram.FieldMax:
-		return m.Max()
-	case histogram.FieldMean:
-		return m.Mean()
-	case histogram.FieldStddev:
-		return m.Stddev()
-	case histogram.FieldMedian:
-		return m.Median()
-	case histogram.FieldP75:
-		return m.P75()
-	case histogram.FieldP95:
-		return m.P95()
-	case histogram.FieldP99:
-		return m.P99()
-	case histogram.FieldP999:
-		return m.P999()
-	case histogram.FieldWID:
+	case counter.FieldWID:
 		return m.WID()
 	}
 	return nil, false
@@ -3847,466 +4188,7847 @@ func (m *HistogramMutation) Field(name string) (ent.Value, bool) {
 // OldField returns the old value of the field from the database.
 // An error is returned if the mutation operation is not UpdateOne,
 // or the query to the database was failed.
-func (m *HistogramMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+func (m *CounterMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
 	switch name {
-	case histogram.FieldTime:
+	case counter.FieldTime:
 		return m.OldTime(ctx)
-	case histogram.FieldCount:
+	case counter.FieldCount:
 		return m.OldCount(ctx)
-	case histogram.FieldMin:
-		return m.OldMin(ctx)
-	case histogram.FieldMax:
-		return m.OldMax(ctx)
-	case histogram.FieldMean:
-		return m.OldMean(ctx)
-	case histogram.FieldStddev:
-		return m.OldStddev(ctx)
-	case histogram.FieldMedian:
-		return m.OldMedian(ctx)
-	case histogram.FieldP75:
-		return m.OldP75(ctx)
-	case histogram.FieldP95:
-		return m.OldP95(ctx)
-	case histogram.FieldP99:
-		return m.OldP99(ctx)
-	case histogram.FieldP999:
-		return m.OldP999(ctx)
-	case histogram.FieldWID:
+	case counter.FieldWID:
 		return m.OldWID(ctx)
 	}
-	return nil, fmt.Errorf("unknown Histogram field %s", name)
+	return nil, fmt.Errorf("unknown Counter field %s", name)
 }
 
 // SetField sets the value for the given name. It returns an
 // error if the field is not defined in the schema, or if the
 // type mismatch the field type.
-func (m *HistogramMutation) SetField(name string, value ent.Value) error {
+func (m *CounterMutation) SetField(name string, value ent.Value) error {
 	switch name {
-	case histogram.FieldTime:
+	case counter.FieldTime:
 		v, ok := value.(int64)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
 		m.SetTime(v)
 		return nil
-	case histogram.FieldCount:
+	case counter.FieldCount:
 		v, ok := value.(int64)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
 		m.SetCount(v)
 		return nil
-	case histogram.FieldMin:
-		v, ok := value.(int64)
+	case counter.FieldWID:
+		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetMin(v)
+		m.SetWID(v)
 		return nil
-	case histogram.FieldMax:
+	}
+	return fmt.Errorf("unknown Counter field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented
+// or decremented during this mutation.
+func (m *CounterMutation) AddedFields() []string {
+	var fields []string
+	if m.addtime != nil {
+		fields = append(fields, counter.FieldTime)
+	}
+	if m.addcount != nil {
+		fields = append(fields, counter.FieldCount)
+	}
+	return fields
+}
+
+// AddedField returns the numeric value that was in/decremented
+// from a field with the given name. The second value indicates
+// that this field was not set, or was not define in the schema.
+func (m *CounterMutation) AddedField(name string) (ent.Value, bool) {
+	switch name {
+	case counter.FieldTime:
+		return m.AddedTime()
+	case counter.FieldCount:
+		return m.AddedCount()
+	}
+	return nil, false
+}
+
+// AddField adds the value for the given name. It returns an
+// error if the field is not defined in the schema, or if the
+// type mismatch the field type.
+func (m *CounterMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	case counter.FieldTime:
 		v, ok := value.(int64)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetMax(v)
-		return nil
-	case histogram.FieldMean:
-		v, ok := value.(float64)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetMean(v)
+		m.AddTime(v)
 		return nil
-	case histogram.FieldStddev:
-		v, ok := value.(float64)
+	case counter.FieldCount:
+		v, ok := value.(int64)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetStddev(v)
+		m.AddCount(v)
 		return nil
-	case histogram.FieldMedian:
-		v, ok := value.(float64)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetMedian(v)
+	}
+	return fmt.Errorf("unknown Counter numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared
+// during this mutation.
+func (m *CounterMutation) ClearedFields() []string {
+	return nil
+}
+
+// FieldCleared returns a boolean indicates if this field was
+// cleared in this mutation.
+func (m *CounterMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value for the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *CounterMutation) ClearField(name string) error {
+	return fmt.Errorf("unknown Counter nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation regarding the
+// given field name. It returns an error if the field is not
+// defined in the schema.
+func (m *CounterMutation) ResetField(name string) error {
+	switch name {
+	case counter.FieldTime:
+		m.ResetTime()
 		return nil
-	case histogram.FieldP75:
-		v, ok := value.(float64)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetP75(v)
+	case counter.FieldCount:
+		m.ResetCount()
 		return nil
-	case histogram.FieldP95:
-		v, ok := value.(float64)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetP95(v)
+	case counter.FieldWID:
+		m.ResetWID()
 		return nil
-	case histogram.FieldP99:
-		v, ok := value.(float64)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
+	}
+	return fmt.Errorf("unknown Counter field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this
+// mutation.
+func (m *CounterMutation) AddedEdges() []string {
+	edges := make([]string, 0, 2)
+	if m.metric != nil {
+		edges = append(edges, counter.EdgeMetric)
+	}
+	if m.executor != nil {
+		edges = append(edges, counter.EdgeExecutor)
+	}
+	return edges
+}
+
+// AddedIDs returns all ids (to other nodes) that were added for
+// the given edge name.
+func (m *CounterMutation) AddedIDs(name string) []ent.Value {
+	switch name {
+	case counter.EdgeMetric:
+		if id := m.metric; id != nil {
+			return []ent.Value{*id}
 		}
-		m.SetP99(v)
-		return nil
-	case histogram.FieldP999:
-		v, ok := value.(float64)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
+	case counter.EdgeExecutor:
+		if id := m.executor; id != nil {
+			return []ent.Value{*id}
 		}
-		m.SetP999(v)
+	}
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this
+// mutation.
+func (m *CounterMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 2)
+	return edges
+}
+
+// RemovedIDs returns all ids (to other nodes) that were removed for
+// the given edge name.
+func (m *CounterMutation) RemovedIDs(name string) []ent.Value {
+	switch name {
+	}
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this
+// mutation.
+func (m *CounterMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 2)
+	if m.clearedmetric {
+		edges = append(edges, counter.EdgeMetric)
+	}
+	if m.clearedexecutor {
+		edges = append(edges, counter.EdgeExecutor)
+	}
+	return edges
+}
+
+// EdgeCleared returns a boolean indicates if this edge was
+// cleared in this mutation.
+func (m *CounterMutation) EdgeCleared(name string) bool {
+	switch name {
+	case counter.EdgeMetric:
+		return m.clearedmetric
+	case counter.EdgeExecutor:
+		return m.clearedexecutor
+	}
+	return false
+}
+
+// ClearEdge clears the value for the given name. It returns an
+// error if the edge name is not defined in the schema.
+func (m *CounterMutation) ClearEdge(name string) error {
+	switch name {
+	case counter.EdgeMetric:
+		m.ClearMetric()
 		return nil
-	case histogram.FieldWID:
-		v, ok := value.(string)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetWID(v)
+	case counter.EdgeExecutor:
+		m.ClearExecutor()
+		return nil
+	}
+	return fmt.Errorf("unknown Counter unique edge %s", name)
+}
+
+// ResetEdge resets all changes in the mutation regarding the
+// given edge name. It returns an error if the edge is not
+// defined in the schema.
+func (m *CounterMutation) ResetEdge(name string) error {
+	switch name {
+	case counter.EdgeMetric:
+		m.ResetMetric()
 		return nil
+	case counter.EdgeExecutor:
+		m.ResetExecutor()
+		return nil
+	}
+	return fmt.Errorf("unknown Counter edge %s", name)
+}
+
+// EventMutation represents an operation that mutate the Events
+// nodes in the graph.
+type EventMutation struct {
+	config
+	op                 Op
+	typ                string
+	id                 *int
+	name               *string
+	time               *int64
+	addtime            *int64
+	source             *string
+	wID                *string
+	attrs              *map[string]string
+	clearedFields      map[string]struct{}
+	application        *int
+	clearedapplication bool
+	done               bool
+	oldValue           func(context.Context) (*Event, error)
+}
+
+var _ ent.Mutation = (*EventMutation)(nil)
+
+// eventOption allows to manage the mutation configuration using functional options.
+type eventOption func(*EventMutation)
+
+// newEventMutation creates new mutation for $n.Name.
+func newEventMutation(c config, op Op, opts ...eventOption) *EventMutation {
+	m := &EventMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeEvent,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withEventID sets the id field of the mutation.
+func withEventID(id int) eventOption {
+	return func(m *EventMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *Event
+		)
+		m.oldValue = func(ctx context.Context) (*Event, error) {
+			once.Do(func() {
+				if m.done {
+					err = fmt.Errorf("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().Event.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withEvent sets the old Event of the mutation.
+func withEvent(node *Event) eventOption {
+	return func(m *EventMutation) {
+		m.oldValue = func(context.Context) (*Event, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m EventMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m EventMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, fmt.Errorf("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// ID returns the id value in the mutation. Note that, the id
+// is available only if it was provided to the builder.
+func (m *EventMutation) ID() (id int, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// SetName sets the name field.
+func (m *EventMutation) SetName(s string) {
+	m.name = &s
+}
+
+// Name returns the name value in the mutation.
+func (m *EventMutation) Name() (r string, exists bool) {
+	v := m.name
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldName returns the old name value of the Event.
+// If the Event object wasn't provided to the builder, the object is fetched
+// from the database.
+// An error is returned if the mutation operation is not UpdateOne, or database query fails.
+func (m *EventMutation) OldName(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, fmt.Errorf("OldName is allowed only on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, fmt.Errorf("OldName requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldName: %w", err)
+	}
+	return oldValue.Name, nil
+}
+
+// ResetName reset all changes of the "name" field.
+func (m *EventMutation) ResetName() {
+	m.name = nil
+}
+
+// SetTime sets the time field.
+func (m *EventMutation) SetTime(i int64) {
+	m.time = &i
+	m.addtime = nil
+}
+
+// Time returns the time value in the mutation.
+func (m *EventMutation) Time() (r int64, exists bool) {
+	v := m.time
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldTime returns the old time value of the Event.
+// If the Event object wasn't provided to the builder, the object is fetched
+// from the database.
+// An error is returned if the mutation operation is not UpdateOne, or database query fails.
+func (m *EventMutation) OldTime(ctx context.Context) (v int64, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, fmt.Errorf("OldTime is allowed only on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, fmt.Errorf("OldTime requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldTime: %w", err)
+	}
+	return oldValue.Time, nil
+}
+
+// AddTime adds i to time.
+func (m *EventMutation) AddTime(i int64) {
+	if m.addtime != nil {
+		*m.addtime += i
+	} else {
+		m.addtime = &i
+	}
+}
+
+// AddedTime returns the value that was added to the time field in this mutation.
+func (m *EventMutation) AddedTime() (r int64, exists bool) {
+	v := m.addtime
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetTime reset all changes of the "time" field.
+func (m *EventMutation) ResetTime() {
+	m.time = nil
+	m.addtime = nil
+}
+
+// SetSource sets the source field.
+func (m *EventMutation) SetSource(s string) {
+	m.source = &s
+}
+
+// Source returns the source value in the mutation.
+func (m *EventMutation) Source() (r string, exists bool) {
+	v := m.source
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldSource returns the old source value of the Event.
+// If the Event object wasn't provided to the builder, the object is fetched
+// from the database.
+// An error is returned if the mutation operation is not UpdateOne, or database query fails.
+func (m *EventMutation) OldSource(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, fmt.Errorf("OldSource is allowed only on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, fmt.Errorf("OldSource requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldSource: %w", err)
+	}
+	return oldValue.Source, nil
+}
+
+// ResetSource reset all changes of the "source" field.
+func (m *EventMutation) ResetSource() {
+	m.source = nil
+}
+
+// SetWID sets the wID field.
+func (m *EventMutation) SetWID(s string) {
+	m.wID = &s
+}
+
+// WID returns the wID value in the mutation.
+func (m *EventMutation) WID() (r string, exists bool) {
+	v := m.wID
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldWID returns the old wID value of the Event.
+// If the Event object wasn't provided to the builder, the object is fetched
+// from the database.
+// An error is returned if the mutation operation is not UpdateOne, or database query fails.
+func (m *EventMutation) OldWID(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, fmt.Errorf("OldWID is allowed only on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, fmt.Errorf("OldWID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldWID: %w", err)
+	}
+	return oldValue.WID, nil
+}
+
+// ClearWID clears the value of wID.
+func (m *EventMutation) ClearWID() {
+	m.wID = nil
+	m.clearedFields[event.FieldWID] = struct{}{}
+}
+
+// WIDCleared returns if the field wID was cleared in this mutation.
+func (m *EventMutation) WIDCleared() bool {
+	_, ok := m.clearedFields[event.FieldWID]
+	return ok
+}
+
+// ResetWID reset all changes of the "wID" field.
+func (m *EventMutation) ResetWID() {
+	m.wID = nil
+	delete(m.clearedFields, event.FieldWID)
+}
+
+// SetAttrs sets the attrs field.
+func (m *EventMutation) SetAttrs(value map[string]string) {
+	m.attrs = &value
+}
+
+// Attrs returns the attrs value in the mutation.
+func (m *EventMutation) Attrs() (r map[string]string, exists bool) {
+	v := m.attrs
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldAttrs returns the old attrs value of the Event.
+// If the Event object wasn't provided to the builder, the object is fetched
+// from the database.
+// An error is returned if the mutation operation is not UpdateOne, or database query fails.
+func (m *EventMutation) OldAttrs(ctx context.Context) (v map[string]string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, fmt.Errorf("OldAttrs is allowed only on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, fmt.Errorf("OldAttrs requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldAttrs: %w", err)
+	}
+	return oldValue.Attrs, nil
+}
+
+// ClearAttrs clears the value of attrs.
+func (m *EventMutation) ClearAttrs() {
+	m.attrs = nil
+	m.clearedFields[event.FieldAttrs] = struct{}{}
+}
+
+// AttrsCleared returns if the field attrs was cleared in this mutation.
+func (m *EventMutation) AttrsCleared() bool {
+	_, ok := m.clearedFields[event.FieldAttrs]
+	return ok
+}
+
+// ResetAttrs reset all changes of the "attrs" field.
+func (m *EventMutation) ResetAttrs() {
+	m.attrs = nil
+	delete(m.clearedFields, event.FieldAttrs)
+}
+
+// SetApplicationID sets the application edge to Application by id.
+func (m *EventMutation) SetApplicationID(id int) {
+	m.application = &id
+}
+
+// ClearApplication clears the application edge to Application.
+func (m *EventMutation) ClearApplication() {
+	m.clearedapplication = true
+}
+
+// ApplicationCleared returns if the edge application was cleared.
+func (m *EventMutation) ApplicationCleared() bool {
+	return m.clearedapplication
+}
+
+// ApplicationID returns the application id in the mutation.
+func (m *EventMutation) ApplicationID() (id int, exists bool) {
+	if m.application != nil {
+		return *m.application, true
+	}
+	return
+}
+
+// ApplicationIDs returns the application ids in the mutation.
+// Note that ids always returns len(ids) <= 1 for unique edges, and you should use
+// ApplicationID instead. It exists only for internal usage by the builders.
+func (m *EventMutation) ApplicationIDs() (ids []int) {
+	if id := m.application; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetApplication reset all changes of the "application" edge.
+func (m *EventMutation) ResetApplication() {
+	m.application = nil
+	m.clearedapplication = false
+}
+
+// Op returns the operation name.
+func (m *EventMutation) Op() Op {
+	return m.op
+}
+
+// Type returns the node type of this mutation (Event).
+func (m *EventMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during
+// this mutation. Note that, in order to get all numeric
+// fields that were in/decremented, call AddedFields().
+func (m *EventMutation) Fields() []string {
+	fields := make([]string, 0, 5)
+	if m.name != nil {
+		fields = append(fields, event.FieldName)
+	}
+	if m.time != nil {
+		fields = append(fields, event.FieldTime)
+	}
+	if m.source != nil {
+		fields = append(fields, event.FieldSource)
+	}
+	if m.wID != nil {
+		fields = append(fields, event.FieldWID)
+	}
+	if m.attrs != nil {
+		fields = append(fields, event.FieldAttrs)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name.
+// The second boolean value indicates that this field was
+// not set, or was not define in the schema.
+func (m *EventMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case event.FieldName:
+		return m.Name()
+	case event.FieldTime:
+		return m.Time()
+	case event.FieldSource:
+		return m.Source()
+	case event.FieldWID:
+		return m.WID()
+	case event.FieldAttrs:
+		return m.Attrs()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database.
+// An error is returned if the mutation operation is not UpdateOne,
+// or the query to the database was failed.
+func (m *EventMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case event.FieldName:
+		return m.OldName(ctx)
+	case event.FieldTime:
+		return m.OldTime(ctx)
+	case event.FieldSource:
+		return m.OldSource(ctx)
+	case event.FieldWID:
+		return m.OldWID(ctx)
+	case event.FieldAttrs:
+		return m.OldAttrs(ctx)
+	}
+	return nil, fmt.Errorf("unknown Event field %s", name)
+}
+
+// SetField sets the value for the given name. It returns an
+// error if the field is not defined in the schema, or if the
+// type mismatch the field type.
+func (m *EventMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case event.FieldName:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetName(v)
+		return nil
+	case event.FieldTime:
+		v, ok := value.(int64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetTime(v)
+		return nil
+	case event.FieldSource:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetSource(v)
+		return nil
+	case event.FieldWID:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetWID(v)
+		return nil
+	case event.FieldAttrs:
+		v, ok := value.(map[string]string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetAttrs(v)
+		return nil
+	}
+	return fmt.Errorf("unknown Event field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented
+// or decremented during this mutation.
+func (m *EventMutation) AddedFields() []string {
+	var fields []string
+	if m.addtime != nil {
+		fields = append(fields, event.FieldTime)
+	}
+	return fields
+}
+
+// AddedField returns the numeric value that was in/decremented
+// from a field with the given name. The second value indicates
+// that this field was not set, or was not define in the schema.
+func (m *EventMutation) AddedField(name string) (ent.Value, bool) {
+	switch name {
+	case event.FieldTime:
+		return m.AddedTime()
+	}
+	return nil, false
+}
+
+// AddField adds the value for the given name. It returns an
+// error if the field is not defined in the schema, or if the
+// type mismatch the field type.
+func (m *EventMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	case event.FieldTime:
+		v, ok := value.(int64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddTime(v)
+		return nil
+	}
+	return fmt.Errorf("unknown Event numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared
+// during this mutation.
+func (m *EventMutation) ClearedFields() []string {
+	var fields []string
+	if m.FieldCleared(event.FieldWID) {
+		fields = append(fields, event.FieldWID)
+	}
+	if m.FieldCleared(event.FieldAttrs) {
+		fields = append(fields, event.FieldAttrs)
+	}
+	return fields
+}
+
+// FieldCleared returns a boolean indicates if this field was
+// cleared in this mutation.
+func (m *EventMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value for the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *EventMutation) ClearField(name string) error {
+	switch name {
+	case event.FieldWID:
+		m.ClearWID()
+		return nil
+	case event.FieldAttrs:
+		m.ClearAttrs()
+		return nil
+	}
+	return fmt.Errorf("unknown Event nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation regarding the
+// given field name. It returns an error if the field is not
+// defined in the schema.
+func (m *EventMutation) ResetField(name string) error {
+	switch name {
+	case event.FieldName:
+		m.ResetName()
+		return nil
+	case event.FieldTime:
+		m.ResetTime()
+		return nil
+	case event.FieldSource:
+		m.ResetSource()
+		return nil
+	case event.FieldWID:
+		m.ResetWID()
+		return nil
+	case event.FieldAttrs:
+		m.ResetAttrs()
+		return nil
+	}
+	return fmt.Errorf("unknown Event field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this
+// mutation.
+func (m *EventMutation) AddedEdges() []string {
+	edges := make([]string, 0, 1)
+	if m.application != nil {
+		edges = append(edges, event.EdgeApplication)
+	}
+	return edges
+}
+
+// AddedIDs returns all ids (to other nodes) that were added for
+// the given edge name.
+func (m *EventMutation) AddedIDs(name string) []ent.Value {
+	switch name {
+	case event.EdgeApplication:
+		if id := m.application; id != nil {
+			return []ent.Value{*id}
+		}
+	}
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this
+// mutation.
+func (m *EventMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 1)
+	return edges
+}
+
+// RemovedIDs returns all ids (to other nodes) that were removed for
+// the given edge name.
+func (m *EventMutation) RemovedIDs(name string) []ent.Value {
+	switch name {
+	}
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this
+// mutation.
+func (m *EventMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 1)
+	if m.clearedapplication {
+		edges = append(edges, event.EdgeApplication)
+	}
+	return edges
+}
+
+// EdgeCleared returns a boolean indicates if this edge was
+// cleared in this mutation.
+func (m *EventMutation) EdgeCleared(name string) bool {
+	switch name {
+	case event.EdgeApplication:
+		return m.clearedapplication
+	}
+	return false
+}
+
+// ClearEdge clears the value for the given name. It returns an
+// error if the edge name is not defined in the schema.
+func (m *EventMutation) ClearEdge(name string) error {
+	switch name {
+	case event.EdgeApplication:
+		m.ClearApplication()
+		return nil
+	}
+	return fmt.Errorf("unknown Event unique edge %s", name)
+}
+
+// ResetEdge resets all changes in the mutation regarding the
+// given edge name. It returns an error if the edge is not
+// defined in the schema.
+func (m *EventMutation) ResetEdge(name string) error {
+	switch name {
+	case event.EdgeApplication:
+		m.ResetApplication()
+		return nil
+	}
+	return fmt.Errorf("unknown Event edge %s", name)
+}
+
+// ExecutorMutation represents an operation that mutate the Executors
+// nodes in the graph.
+type ExecutorMutation struct {
+	config
+	op                 Op
+	typ                string
+	id                 *int
+	eID                *string
+	clearedFields      map[string]struct{}
+	application        *int
+	clearedapplication bool
+	counters           map[int]struct{}
+	removedcounters    map[int]struct{}
+	clearedcounters    bool
+	histograms         map[int]struct{}
+	removedhistograms  map[int]struct{}
+	clearedhistograms  bool
+	gauges             map[int]struct{}
+	removedgauges      map[int]struct{}
+	clearedgauges      bool
+	done               bool
+	oldValue           func(context.Context) (*Executor, error)
+}
+
+var _ ent.Mutation = (*ExecutorMutation)(nil)
+
+// executorOption allows to manage the mutation configuration using functional options.
+type executorOption func(*ExecutorMutation)
+
+// newExecutorMutation creates new mutation for $n.Name.
+func newExecutorMutation(c config, op Op, opts ...executorOption) *ExecutorMutation {
+	m := &ExecutorMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeExecutor,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withExecutorID sets the id field of the mutation.
+func withExecutorID(id int) executorOption {
+	return func(m *ExecutorMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *Executor
+		)
+		m.oldValue = func(ctx context.Context) (*Executor, error) {
+			once.Do(func() {
+				if m.done {
+					err = fmt.Errorf("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().Executor.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withExecutor sets the old Executor of the mutation.
+func withExecutor(node *Executor) executorOption {
+	return func(m *ExecutorMutation) {
+		m.oldValue = func(context.Context) (*Executor, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m ExecutorMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m ExecutorMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, fmt.Errorf("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// ID returns the id value in the mutation. Note that, the id
+// is available only if it was provided to the builder.
+func (m *ExecutorMutation) ID() (id int, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// SetEID sets the eID field.
+func (m *ExecutorMutation) SetEID(s string) {
+	m.eID = &s
+}
+
+// EID returns the eID value in the mutation.
+func (m *ExecutorMutation) EID() (r string, exists bool) {
+	v := m.eID
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldEID returns the old eID value of the Executor.
+// If the Executor object wasn't provided to the builder, the object is fetched
+// from the database.
+// An error is returned if the mutation operation is not UpdateOne, or database query fails.
+func (m *ExecutorMutation) OldEID(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, fmt.Errorf("OldEID is allowed only on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, fmt.Errorf("OldEID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldEID: %w", err)
+	}
+	return oldValue.EID, nil
+}
+
+// ResetEID reset all changes of the "eID" field.
+func (m *ExecutorMutation) ResetEID() {
+	m.eID = nil
+}
+
+// SetApplicationID sets the application edge to Application by id.
+func (m *ExecutorMutation) SetApplicationID(id int) {
+	m.application = &id
+}
+
+// ClearApplication clears the application edge to Application.
+func (m *ExecutorMutation) ClearApplication() {
+	m.clearedapplication = true
+}
+
+// ApplicationCleared returns if the edge application was cleared.
+func (m *ExecutorMutation) ApplicationCleared() bool {
+	return m.clearedapplication
+}
+
+// ApplicationID returns the application id in the mutation.
+func (m *ExecutorMutation) ApplicationID() (id int, exists bool) {
+	if m.application != nil {
+		return *m.application, true
+	}
+	return
+}
+
+// ApplicationIDs returns the application ids in the mutation.
+// Note that ids always returns len(ids) <= 1 for unique edges, and you should use
+// ApplicationID instead. It exists only for internal usage by the builders.
+func (m *ExecutorMutation) ApplicationIDs() (ids []int) {
+	if id := m.application; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetApplication reset all changes of the "application" edge.
+func (m *ExecutorMutation) ResetApplication() {
+	m.application = nil
+	m.clearedapplication = false
+}
+
+// AddCounterIDs adds the counters edge to Counter by ids.
+func (m *ExecutorMutation) AddCounterIDs(ids ...int) {
+	if m.counters == nil {
+		m.counters = make(map[int]struct{})
+	}
+	for i := range ids {
+		m.counters[ids[i]] = struct{}{}
+	}
+}
+
+// ClearCounters clears the counters edge to Counter.
+func (m *ExecutorMutation) ClearCounters() {
+	m.clearedcounters = true
+}
+
+// CountersCleared returns if the edge counters was cleared.
+func (m *ExecutorMutation) CountersCleared() bool {
+	return m.clearedcounters
+}
+
+// RemoveCounterIDs removes the counters edge to Counter by ids.
+func (m *ExecutorMutation) RemoveCounterIDs(ids ...int) {
+	if m.removedcounters == nil {
+		m.removedcounters = make(map[int]struct{})
+	}
+	for i := range ids {
+		m.removedcounters[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedCounters returns the removed ids of counters.
+func (m *ExecutorMutation) RemovedCountersIDs() (ids []int) {
+	for id := range m.removedcounters {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// CountersIDs returns the counters ids in the mutation.
+func (m *ExecutorMutation) CountersIDs() (ids []int) {
+	for id := range m.counters {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetCounters reset all changes of the "counters" edge.
+func (m *ExecutorMutation) ResetCounters() {
+	m.counters = nil
+	m.clearedcounters = false
+	m.removedcounters = nil
+}
+
+// AddHistogramIDs adds the histograms edge to Histogram by ids.
+func (m *ExecutorMutation) AddHistogramIDs(ids ...int) {
+	if m.histograms == nil {
+		m.histograms = make(map[int]struct{})
+	}
+	for i := range ids {
+		m.histograms[ids[i]] = struct{}{}
+	}
+}
+
+// ClearHistograms clears the histograms edge to Histogram.
+func (m *ExecutorMutation) ClearHistograms() {
+	m.clearedhistograms = true
+}
+
+// HistogramsCleared returns if the edge histograms was cleared.
+func (m *ExecutorMutation) HistogramsCleared() bool {
+	return m.clearedhistograms
+}
+
+// RemoveHistogramIDs removes the histograms edge to Histogram by ids.
+func (m *ExecutorMutation) RemoveHistogramIDs(ids ...int) {
+	if m.removedhistograms == nil {
+		m.removedhistograms = make(map[int]struct{})
+	}
+	for i := range ids {
+		m.removedhistograms[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedHistograms returns the removed ids of histograms.
+func (m *ExecutorMutation) RemovedHistogramsIDs() (ids []int) {
+	for id := range m.removedhistograms {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// HistogramsIDs returns the histograms ids in the mutation.
+func (m *ExecutorMutation) HistogramsIDs() (ids []int) {
+	for id := range m.histograms {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetHistograms reset all changes of the "histograms" edge.
+func (m *ExecutorMutation) ResetHistograms() {
+	m.histograms = nil
+	m.clearedhistograms = false
+	m.removedhistograms = nil
+}
+
+// AddGaugeIDs adds the gauges edge to Gauge by ids.
+func (m *ExecutorMutation) AddGaugeIDs(ids ...int) {
+	if m.gauges == nil {
+		m.gauges = make(map[int]struct{})
+	}
+	for i := range ids {
+		m.gauges[ids[i]] = struct{}{}
+	}
+}
+
+// ClearGauges clears the gauges edge to Gauge.
+func (m *ExecutorMutation) ClearGauges() {
+	m.clearedgauges = true
+}
+
+// GaugesCleared returns if the edge gauges was cleared.
+func (m *ExecutorMutation) GaugesCleared() bool {
+	return m.clearedgauges
+}
+
+// RemoveGaugeIDs removes the gauges edge to Gauge by ids.
+func (m *ExecutorMutation) RemoveGaugeIDs(ids ...int) {
+	if m.removedgauges == nil {
+		m.removedgauges = make(map[int]struct{})
+	}
+	for i := range ids {
+		m.removedgauges[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedGauges returns the removed ids of gauges.
+func (m *ExecutorMutation) RemovedGaugesIDs() (ids []int) {
+	for id := range m.removedgauges {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// GaugesIDs returns the gauges ids in the mutation.
+func (m *ExecutorMutation) GaugesIDs() (ids []int) {
+	for id := range m.gauges {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetGauges reset all changes of the "gauges" edge.
+func (m *ExecutorMutation) ResetGauges() {
+	m.gauges = nil
+	m.clearedgauges = false
+	m.removedgauges = nil
+}
+
+// Op returns the operation name.
+func (m *ExecutorMutation) Op() Op {
+	return m.op
+}
+
+// Type returns the node type of this mutation (Executor).
+func (m *ExecutorMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during
+// this mutation. Note that, in order to get all numeric
+// fields that were in/decremented, call AddedFields().
+func (m *ExecutorMutation) Fields() []string {
+	fields := make([]string, 0, 1)
+	if m.eID != nil {
+		fields = append(fields, executor.FieldEID)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name.
+// The second boolean value indicates that this field was
+// not set, or was not define in the schema.
+func (m *ExecutorMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case executor.FieldEID:
+		return m.EID()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database.
+// An error is returned if the mutation operation is not UpdateOne,
+// or the query to the database was failed.
+func (m *ExecutorMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case executor.FieldEID:
+		return m.OldEID(ctx)
+	}
+	return nil, fmt.Errorf("unknown Executor field %s", name)
+}
+
+// SetField sets the value for the given name. It returns an
+// error if the field is not defined in the schema, or if the
+// type mismatch the field type.
+func (m *ExecutorMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case executor.FieldEID:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetEID(v)
+		return nil
+	}
+	return fmt.Errorf("unknown Executor field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented
+// or decremented during this mutation.
+func (m *ExecutorMutation) AddedFields() []string {
+	return nil
+}
+
+// AddedField returns the numeric value that was in/decremented
+// from a field with the given name. The second value indicates
+// that this field was not set, or was not define in the schema.
+func (m *ExecutorMutation) AddedField(name string) (ent.Value, bool) {
+	return nil, false
+}
+
+// AddField adds the value for the given name. It returns an
+// error if the field is not defined in the schema, or if the
+// type mismatch the field type.
+func (m *ExecutorMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	}
+	return fmt.Errorf("unknown Executor numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared
+// during this mutation.
+func (m *ExecutorMutation) ClearedFields() []string {
+	return nil
+}
+
+// FieldCleared returns a boolean indicates if this field was
+// cleared in this mutation.
+func (m *ExecutorMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value for the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *ExecutorMutation) ClearField(name string) error {
+	return fmt.Errorf("unknown Executor nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation regarding the
+// given field name. It returns an error if the field is not
+// defined in the schema.
+func (m *ExecutorMutation) ResetField(name string) error {
+	switch name {
+	case executor.FieldEID:
+		m.ResetEID()
+		return nil
+	}
+	return fmt.Errorf("unknown Executor field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this
+// mutation.
+func (m *ExecutorMutation) AddedEdges() []string {
+	edges := make([]string, 0, 4)
+	if m.application != nil {
+		edges = append(edges, executor.EdgeApplication)
+	}
+	if m.counters != nil {
+		edges = append(edges, executor.EdgeCounters)
+	}
+	if m.histograms != nil {
+		edges = append(edges, executor.EdgeHistograms)
+	}
+	if m.gauges != nil {
+		edges = append(edges, executor.EdgeGauges)
+	}
+	return edges
+}
+
+// AddedIDs returns all ids (to other nodes) that were added for
+// the given edge name.
+func (m *ExecutorMutation) AddedIDs(name string) []ent.Value {
+	switch name {
+	case executor.EdgeApplication:
+		if id := m.application; id != nil {
+			return []ent.Value{*id}
+		}
+	case executor.EdgeCounters:
+		ids := make([]ent.Value, 0, len(m.counters))
+		for id := range m.counters {
+			ids = append(ids, id)
+		}
+		return ids
+	case executor.EdgeHistograms:
+		ids := make([]ent.Value, 0, len(m.histograms))
+		for id := range m.histograms {
+			ids = append(ids, id)
+		}
+		return ids
+	case executor.EdgeGauges:
+		ids := make([]ent.Value, 0, len(m.gauges))
+		for id := range m.gauges {
+			ids = append(ids, id)
+		}
+		return ids
+	}
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this
+// mutation.
+func (m *ExecutorMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 4)
+	if m.removedcounters != nil {
+		edges = append(edges, executor.EdgeCounters)
+	}
+	if m.removedhistograms != nil {
+		edges = append(edges, executor.EdgeHistograms)
+	}
+	if m.removedgauges != nil {
+		edges = append(edges, executor.EdgeGauges)
+	}
+	return edges
+}
+
+// RemovedIDs returns all ids (to other nodes) that were removed for
+// the given edge name.
+func (m *ExecutorMutation) RemovedIDs(name string) []ent.Value {
+	switch name {
+	case executor.EdgeCounters:
+		ids := make([]ent.Value, 0, len(m.removedcounters))
+		for id := range m.removedcounters {
+			ids = append(ids, id)
+		}
+		return ids
+	case executor.EdgeHistograms:
+		ids := make([]ent.Value, 0, len(m.removedhistograms))
+		for id := range m.removedhistograms {
+			ids = append(ids, id)
+		}
+		return ids
+	case executor.EdgeGauges:
+		ids := make([]ent.Value, 0, len(m.removedgauges))
+		for id := range m.removedgauges {
+			ids = append(ids, id)
+		}
+		return ids
+	}
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this
+// mutation.
+func (m *ExecutorMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 4)
+	if m.clearedapplication {
+		edges = append(edges, executor.EdgeApplication)
+	}
+	if m.clearedcounters {
+		edges = append(edges, executor.EdgeCounters)
+	}
+	if m.clearedhistograms {
+		edges = append(edges, executor.EdgeHistograms)
+	}
+	if m.clearedgauges {
+		edges = append(edges, executor.EdgeGauges)
+	}
+	return edges
+}
+
+// EdgeCleared returns a boolean indicates if this edge was
+// cleared in this mutation.
+func (m *ExecutorMutation) EdgeCleared(name string) bool {
+	switch name {
+	case executor.EdgeApplication:
+		return m.clearedapplication
+	case executor.EdgeCounters:
+		return m.clearedcounters
+	case executor.EdgeHistograms:
+		return m.clearedhistograms
+	case executor.EdgeGauges:
+		return m.clearedgauges
+	}
+	return false
+}
+
+// ClearEdge clears the value for the given name. It returns an
+// error if the edge name is not defined in the schema.
+func (m *ExecutorMutation) ClearEdge(name string) error {
+	switch name {
+	case executor.EdgeApplication:
+		m.ClearApplication()
+		return nil
+	}
+	return fmt.Errorf("unknown Executor unique edge %s", name)
+}
+
+// ResetEdge resets all changes in the mutation regarding the
+// given edge name. It returns an error if the edge is not
+// defined in the schema.
+func (m *ExecutorMutation) ResetEdge(name string) error {
+	switch name {
+	case executor.EdgeApplication:
+		m.ResetApplication()
+		return nil
+	case executor.EdgeCounters:
+		m.ResetCounters()
+		return nil
+	case executor.EdgeHistograms:
+		m.ResetHistograms()
+		return nil
+	case executor.EdgeGauges:
+		m.ResetGauges()
+		return nil
+	}
+	return fmt.Errorf("unknown Executor edge %s", name)
+}
+
+// GaugeMutation represents an operation that mutate the Gauges
+// nodes in the graph.
+type GaugeMutation struct {
+	config
+	op              Op
+	typ             string
+	id              *int
+	time            *int64
+	addtime         *int64
+	value           *int64
+	addvalue        *int64
+	wID             *string
+	clearedFields   map[string]struct{}
+	metric          *int
+	clearedmetric   bool
+	executor        *int
+	clearedexecutor bool
+	done            bool
+	oldValue        func(context.Context) (*Gauge, error)
+}
+
+var _ ent.Mutation = (*GaugeMutation)(nil)
+
+// gaugeOption allows to manage the mutation configuration using functional options.
+type gaugeOption func(*GaugeMutation)
+
+// newGaugeMutation creates new mutation for $n.Name.
+func newGaugeMutation(c config, op Op, opts ...gaugeOption) *GaugeMutation {
+	m := &GaugeMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeGauge,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withGaugeID sets the id field of the mutation.
+func withGaugeID(id int) gaugeOption {
+	return func(m *GaugeMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *Gauge
+		)
+		m.oldValue = func(ctx context.Context) (*Gauge, error) {
+			once.Do(func() {
+				if m.done {
+					err = fmt.Errorf("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().Gauge.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withGauge sets the old Gauge of the mutation.
+func withGauge(node *Gauge) gaugeOption {
+	return func(m *GaugeMutation) {
+		m.oldValue = func(context.Context) (*Gauge, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m GaugeMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m GaugeMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, fmt.Errorf("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// ID returns the id value in the mutation. Note that, the id
+// is available only if it was provided to the builder.
+func (m *GaugeMutation) ID() (id int, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// SetTime sets the time field.
+func (m *GaugeMutation) SetTime(i int64) {
+	m.time = &i
+	m.addtime = nil
+}
+
+// Time returns the time value in the mutation.
+func (m *GaugeMutation) Time() (r int64, exists bool) {
+	v := m.time
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldTime returns the old time value of the Gauge.
+// If the Gauge object wasn't provided to the builder, the object is fetched
+// from the database.
+// An error is returned if the mutation operation is not UpdateOne, or database query fails.
+func (m *GaugeMutation) OldTime(ctx context.Context) (v int64, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, fmt.Errorf("OldTime is allowed only on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, fmt.Errorf("OldTime requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldTime: %w", err)
+	}
+	return oldValue.Time, nil
+}
+
+// AddTime adds i to time.
+func (m *GaugeMutation) AddTime(i int64) {
+	if m.addtime != nil {
+		*m.addtime += i
+	} else {
+		m.addtime = &i
+	}
+}
+
+// AddedTime returns the value that was added to the time field in this mutation.
+func (m *GaugeMutation) AddedTime() (r int64, exists bool) {
+	v := m.addtime
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetTime reset all changes of the "time" field.
+func (m *GaugeMutation) ResetTime() {
+	m.time = nil
+	m.addtime = nil
+}
+
+// SetValue sets the value field.
+func (m *GaugeMutation) SetValue(i int64) {
+	m.value = &i
+	m.addvalue = nil
+}
+
+// Value returns the value value in the mutation.
+func (m *GaugeMutation) Value() (r int64, exists bool) {
+	v := m.value
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldValue returns the old value value of the Gauge.
+// If the Gauge object wasn't provided to the builder, the object is fetched
+// from the database.
+// An error is returned if the mutation operation is not UpdateOne, or database query fails.
+func (m *GaugeMutation) OldValue(ctx context.Context) (v int64, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, fmt.Errorf("OldValue is allowed only on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, fmt.Errorf("OldValue requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldValue: %w", err)
+	}
+	return oldValue.Value, nil
+}
+
+// AddValue adds i to value.
+func (m *GaugeMutation) AddValue(i int64) {
+	if m.addvalue != nil {
+		*m.addvalue += i
+	} else {
+		m.addvalue = &i
+	}
+}
+
+// AddedValue returns the value that was added to the value field in this mutation.
+func (m *GaugeMutation) AddedValue() (r int64, exists bool) {
+	v := m.addvalue
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetValue reset all changes of the "value" field.
+func (m *GaugeMutation) ResetValue() {
+	m.value = nil
+	m.addvalue = nil
+}
+
+// SetWID sets the wID field.
+func (m *GaugeMutation) SetWID(s string) {
+	m.wID = &s
+}
+
+// WID returns the wID value in the mutation.
+func (m *GaugeMutation) WID() (r string, exists bool) {
+	v := m.wID
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldWID returns the old wID value of the Gauge.
+// If the Gauge object wasn't provided to the builder, the object is fetched
+// from the database.
+// An error is returned if the mutation operation is not UpdateOne, or database query fails.
+func (m *GaugeMutation) OldWID(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, fmt.Errorf("OldWID is allowed only on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, fmt.Errorf("OldWID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldWID: %w", err)
+	}
+	return oldValue.WID, nil
+}
+
+// ResetWID reset all changes of the "wID" field.
+func (m *GaugeMutation) ResetWID() {
+	m.wID = nil
+}
+
+// SetMetricID sets the metric edge to Metric by id.
+func (m *GaugeMutation) SetMetricID(id int) {
+	m.metric = &id
+}
+
+// ClearMetric clears the metric edge to Metric.
+func (m *GaugeMutation) ClearMetric() {
+	m.clearedmetric = true
+}
+
+// MetricCleared returns if the edge metric was cleared.
+func (m *GaugeMutation) MetricCleared() bool {
+	return m.clearedmetric
+}
+
+// MetricID returns the metric id in the mutation.
+func (m *GaugeMutation) MetricID() (id int, exists bool) {
+	if m.metric != nil {
+		return *m.metric, true
+	}
+	return
+}
+
+// MetricIDs returns the metric ids in the mutation.
+// Note that ids always returns len(ids) <= 1 for unique edges, and you should use
+// MetricID instead. It exists only for internal usage by the builders.
+func (m *GaugeMutation) MetricIDs() (ids []int) {
+	if id := m.metric; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetMetric reset all changes of the "metric" edge.
+func (m *GaugeMutation) ResetMetric() {
+	m.metric = nil
+	m.clearedmetric = false
+}
+
+// SetExecutorID sets the executor edge to Executor by id.
+func (m *GaugeMutation) SetExecutorID(id int) {
+	m.executor = &id
+}
+
+// ClearExecutor clears the executor edge to Executor.
+func (m *GaugeMutation) ClearExecutor() {
+	m.clearedexecutor = true
+}
+
+// ExecutorCleared returns if the edge executor was cleared.
+func (m *GaugeMutation) ExecutorCleared() bool {
+	return m.clearedexecutor
+}
+
+// ExecutorID returns the executor id in the mutation.
+func (m *GaugeMutation) ExecutorID() (id int, exists bool) {
+	if m.executor != nil {
+		return *m.executor, true
+	}
+	return
+}
+
+// ExecutorIDs returns the executor ids in the mutation.
+// Note that ids always returns len(ids) <= 1 for unique edges, and you should use
+// ExecutorID instead. It exists only for internal usage by the builders.
+func (m *GaugeMutation) ExecutorIDs() (ids []int) {
+	if id := m.executor; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetExecutor reset all changes of the "executor" edge.
+func (m *GaugeMutation) ResetExecutor() {
+	m.executor = nil
+	m.clearedexecutor = false
+}
+
+// Op returns the operation name.
+func (m *GaugeMutation) Op() Op {
+	return m.op
+}
+
+// Type returns the node type of this mutation (Gauge).
+func (m *GaugeMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during
+// this mutation. Note that, in order to get all numeric
+// fields that were in/decremented, call AddedFields().
+func (m *GaugeMutation) Fields() []string {
+	fields := make([]string, 0, 3)
+	if m.time != nil {
+		fields = append(fields, gauge.FieldTime)
+	}
+	if m.value != nil {
+		fields = append(fields, gauge.FieldValue)
+	}
+	if m.wID != nil {
+		fields = append(fields, gauge.FieldWID)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name.
+// The second boolean value indicates that this field was
+// not set, or was not define in the schema.
+func (m *GaugeMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case gauge.FieldTime:
+		return m.Time()
+	case gauge.FieldValue:
+		return m.Value()
+	case gauge.FieldWID:
+		return m.WID()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database.
+// An error is returned if the mutation operation is not UpdateOne,
+// or the query to the database was failed.
+func (m *GaugeMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case gauge.FieldTime:
+		return m.OldTime(ctx)
+	case gauge.FieldValue:
+		return m.OldValue(ctx)
+	case gauge.FieldWID:
+		return m.OldWID(ctx)
+	}
+	return nil, fmt.Errorf("unknown Gauge field %s", name)
+}
+
+// SetField sets the value for the given name. It returns an
+// error if the field is not defined in the schema, or if the
+// type mismatch the field type.
+func (m *GaugeMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case gauge.FieldTime:
+		v, ok := value.(int64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetTime(v)
+		return nil
+	case gauge.FieldValue:
+		v, ok := value.(int64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetValue(v)
+		return nil
+	case gauge.FieldWID:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetWID(v)
+		return nil
+	}
+	return fmt.Errorf("unknown Gauge field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented
+// or decremented during this mutation.
+func (m *GaugeMutation) AddedFields() []string {
+	var fields []string
+	if m.addtime != nil {
+		fields = append(fields, gauge.FieldTime)
+	}
+	if m.addvalue != nil {
+		fields = append(fields, gauge.FieldValue)
+	}
+	return fields
+}
+
+// AddedField returns the numeric value that was in/decremented
+// from a field with the given name. The second value indicates
+// that this field was not set, or was not define in the schema.
+func (m *GaugeMutation) AddedField(name string) (ent.Value, bool) {
+	switch name {
+	case gauge.FieldTime:
+		return m.AddedTime()
+	case gauge.FieldValue:
+		return m.AddedValue()
+	}
+	return nil, false
+}
+
+// AddField adds the value for the given name. It returns an
+// error if the field is not defined in the schema, or if the
+// type mismatch the field type.
+func (m *GaugeMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	case gauge.FieldTime:
+		v, ok := value.(int64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddTime(v)
+		return nil
+	case gauge.FieldValue:
+		v, ok := value.(int64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddValue(v)
+		return nil
+	}
+	return fmt.Errorf("unknown Gauge numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared
+// during this mutation.
+func (m *GaugeMutation) ClearedFields() []string {
+	return nil
+}
+
+// FieldCleared returns a boolean indicates if this field was
+// cleared in this mutation.
+func (m *GaugeMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value for the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *GaugeMutation) ClearField(name string) error {
+	return fmt.Errorf("unknown Gauge nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation regarding the
+// given field name. It returns an error if the field is not
+// defined in the schema.
+func (m *GaugeMutation) ResetField(name string) error {
+	switch name {
+	case gauge.FieldTime:
+		m.ResetTime()
+		return nil
+	case gauge.FieldValue:
+		m.ResetValue()
+		return nil
+	case gauge.FieldWID:
+		m.ResetWID()
+		return nil
+	}
+	return fmt.Errorf("unknown Gauge field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this
+// mutation.
+func (m *GaugeMutation) AddedEdges() []string {
+	edges := make([]string, 0, 2)
+	if m.metric != nil {
+		edges = append(edges, gauge.EdgeMetric)
+	}
+	if m.executor != nil {
+		edges = append(edges, gauge.EdgeExecutor)
+	}
+	return edges
+}
+
+// AddedIDs returns all ids (to other nodes) that were added for
+// the given edge name.
+func (m *GaugeMutation) AddedIDs(name string) []ent.Value {
+	switch name {
+	case gauge.EdgeMetric:
+		if id := m.metric; id != nil {
+			return []ent.Value{*id}
+		}
+	case gauge.EdgeExecutor:
+		if id := m.executor; id != nil {
+			return []ent.Value{*id}
+		}
+	}
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this
+// mutation.
+func (m *GaugeMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 2)
+	return edges
+}
+
+// RemovedIDs returns all ids (to other nodes) that were removed for
+// the given edge name.
+func (m *GaugeMutation) RemovedIDs(name string) []ent.Value {
+	switch name {
+	}
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this
+// mutation.
+func (m *GaugeMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 2)
+	if m.clearedmetric {
+		edges = append(edges, gauge.EdgeMetric)
+	}
+	if m.clearedexecutor {
+		edges = append(edges, gauge.EdgeExecutor)
+	}
+	return edges
+}
+
+// EdgeCleared returns a boolean indicates if this edge was
+// cleared in this mutation.
+func (m *GaugeMutation) EdgeCleared(name string) bool {
+	switch name {
+	case gauge.EdgeMetric:
+		return m.clearedmetric
+	case gauge.EdgeExecutor:
+		return m.clearedexecutor
+	}
+	return false
+}
+
+// ClearEdge clears the value for the given name. It returns an
+// error if the edge name is not defined in the schema.
+func (m *GaugeMutation) ClearEdge(name string) error {
+	switch name {
+	case gauge.EdgeMetric:
+		m.ClearMetric()
+		return nil
+	case gauge.EdgeExecutor:
+		m.ClearExecutor()
+		return nil
+	}
+	return fmt.Errorf("unknown Gauge unique edge %s", name)
+}
+
+// ResetEdge resets all changes in the mutation regarding the
+// given edge name. It returns an error if the edge is not
+// defined in the schema.
+func (m *GaugeMutation) ResetEdge(name string) error {
+	switch name {
+	case gauge.EdgeMetric:
+		m.ResetMetric()
+		return nil
+	case gauge.EdgeExecutor:
+		m.ResetExecutor()
+		return nil
+	}
+	return fmt.Errorf("unknown Gauge edge %s", name)
+}
+
+// GraphMutation represents an operation that mutate the Graphs
+// nodes in the graph.
+type GraphMutation struct {
+	config
+	op             Op
+	typ            string
+	id             *int
+	title          *string
+	unit           *string
+	clearedFields  map[string]struct{}
+	group          *int
+	clearedgroup   bool
+	metrics        map[int]struct{}
+	removedmetrics map[int]struct{}
+	clearedmetrics bool
+	done           bool
+	oldValue       func(context.Context) (*Graph, error)
+}
+
+var _ ent.Mutation = (*GraphMutation)(nil)
+
+// graphOption allows to manage the mutation configuration using functional options.
+type graphOption func(*GraphMutation)
+
+// newGraphMutation creates new mutation for $n.Name.
+func newGraphMutation(c config, op Op, opts ...graphOption) *GraphMutation {
+	m := &GraphMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeGraph,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withGraphID sets the id field of the mutation.
+func withGraphID(id int) graphOption {
+	return func(m *GraphMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *Graph
+		)
+		m.oldValue = func(ctx context.Context) (*Graph, error) {
+			once.Do(func() {
+				if m.done {
+					err = fmt.Errorf("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().Graph.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withGraph sets the old Graph of the mutation.
+func withGraph(node *Graph) graphOption {
+	return func(m *GraphMutation) {
+		m.oldValue = func(context.Context) (*Graph, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m GraphMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m GraphMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, fmt.Errorf("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// ID returns the id value in the mutation. Note that, the id
+// is available only if it was provided to the builder.
+func (m *GraphMutation) ID() (id int, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// SetTitle sets the title field.
+func (m *GraphMutation) SetTitle(s string) {
+	m.title = &s
+}
+
+// Title returns the title value in the mutation.
+func (m *GraphMutation) Title() (r string, exists bool) {
+	v := m.title
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldTitle returns the old title value of the Graph.
+// If the Graph object wasn't provided to the builder, the object is fetched
+// from the database.
+// An error is returned if the mutation operation is not UpdateOne, or database query fails.
+func (m *GraphMutation) OldTitle(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, fmt.Errorf("OldTitle is allowed only on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, fmt.Errorf("OldTitle requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldTitle: %w", err)
+	}
+	return oldValue.Title, nil
+}
+
+// ResetTitle reset all changes of the "title" field.
+func (m *GraphMutation) ResetTitle() {
+	m.title = nil
+}
+
+// SetUnit sets the unit field.
+func (m *GraphMutation) SetUnit(s string) {
+	m.unit = &s
+}
+
+// Unit returns the unit value in the mutation.
+func (m *GraphMutation) Unit() (r string, exists bool) {
+	v := m.unit
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldUnit returns the old unit value of the Graph.
+// If the Graph object wasn't provided to the builder, the object is fetched
+// from the database.
+// An error is returned if the mutation operation is not UpdateOne, or database query fails.
+func (m *GraphMutation) OldUnit(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, fmt.Errorf("OldUnit is allowed only on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, fmt.Errorf("OldUnit requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldUnit: %w", err)
+	}
+	return oldValue.Unit, nil
+}
+
+// ResetUnit reset all changes of the "unit" field.
+func (m *GraphMutation) ResetUnit() {
+	m.unit = nil
+}
+
+// SetGroupID sets the group edge to Group by id.
+func (m *GraphMutation) SetGroupID(id int) {
+	m.group = &id
+}
+
+// ClearGroup clears the group edge to Group.
+func (m *GraphMutation) ClearGroup() {
+	m.clearedgroup = true
+}
+
+// GroupCleared returns if the edge group was cleared.
+func (m *GraphMutation) GroupCleared() bool {
+	return m.clearedgroup
+}
+
+// GroupID returns the group id in the mutation.
+func (m *GraphMutation) GroupID() (id int, exists bool) {
+	if m.group != nil {
+		return *m.group, true
+	}
+	return
+}
+
+// GroupIDs returns the group ids in the mutation.
+// Note that ids always returns len(ids) <= 1 for unique edges, and you should use
+// GroupID instead. It exists only for internal usage by the builders.
+func (m *GraphMutation) GroupIDs() (ids []int) {
+	if id := m.group; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetGroup reset all changes of the "group" edge.
+func (m *GraphMutation) ResetGroup() {
+	m.group = nil
+	m.clearedgroup = false
+}
+
+// AddMetricIDs adds the metrics edge to Metric by ids.
+func (m *GraphMutation) AddMetricIDs(ids ...int) {
+	if m.metrics == nil {
+		m.metrics = make(map[int]struct{})
+	}
+	for i := range ids {
+		m.metrics[ids[i]] = struct{}{}
+	}
+}
+
+// ClearMetrics clears the metrics edge to Metric.
+func (m *GraphMutation) ClearMetrics() {
+	m.clearedmetrics = true
+}
+
+// MetricsCleared returns if the edge metrics was cleared.
+func (m *GraphMutation) MetricsCleared() bool {
+	return m.clearedmetrics
+}
+
+// RemoveMetricIDs removes the metrics edge to Metric by ids.
+func (m *GraphMutation) RemoveMetricIDs(ids ...int) {
+	if m.removedmetrics == nil {
+		m.removedmetrics = make(map[int]struct{})
+	}
+	for i := range ids {
+		m.removedmetrics[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedMetrics returns the removed ids of metrics.
+func (m *GraphMutation) RemovedMetricsIDs() (ids []int) {
+	for id := range m.removedmetrics {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// MetricsIDs returns the metrics ids in the mutation.
+func (m *GraphMutation) MetricsIDs() (ids []int) {
+	for id := range m.metrics {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetMetrics reset all changes of the "metrics" edge.
+func (m *GraphMutation) ResetMetrics() {
+	m.metrics = nil
+	m.clearedmetrics = false
+	m.removedmetrics = nil
+}
+
+// Op returns the operation name.
+func (m *GraphMutation) Op() Op {
+	return m.op
+}
+
+// Type returns the node type of this mutation (Graph).
+func (m *GraphMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during
+// this mutation. Note that, in order to get all numeric
+// fields that were in/decremented, call AddedFields().
+func (m *GraphMutation) Fields() []string {
+	fields := make([]string, 0, 2)
+	if m.title != nil {
+		fields = append(fields, graph.FieldTitle)
+	}
+	if m.unit != nil {
+		fields = append(fields, graph.FieldUnit)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name.
+// The second boolean value indicates that this field was
+// not set, or was not define in the schema.
+func (m *GraphMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case graph.FieldTitle:
+		return m.Title()
+	case graph.FieldUnit:
+		return m.Unit()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database.
+// An error is returned if the mutation operation is not UpdateOne,
+// or the query to the database was failed.
+func (m *GraphMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case graph.FieldTitle:
+		return m.OldTitle(ctx)
+	case graph.FieldUnit:
+		return m.OldUnit(ctx)
+	}
+	return nil, fmt.Errorf("unknown Graph field %s", name)
+}
+
+// SetField sets the value for the given name. It returns an
+// error if the field is not defined in the schema, or if the
+// type mismatch the field type.
+func (m *GraphMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case graph.FieldTitle:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetTitle(v)
+		return nil
+	case graph.FieldUnit:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetUnit(v)
+		return nil
+	}
+	return fmt.Errorf("unknown Graph field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented
+// or decremented during this mutation.
+func (m *GraphMutation) AddedFields() []string {
+	return nil
+}
+
+// AddedField returns the numeric value that was in/decremented
+// from a field with the given name. The second value indicates
+// that this field was not set, or was not define in the schema.
+func (m *GraphMutation) AddedField(name string) (ent.Value, bool) {
+	return nil, false
+}
+
+// AddField adds the value for the given name. It returns an
+// error if the field is not defined in the schema, or if the
+// type mismatch the field type.
+func (m *GraphMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	}
+	return fmt.Errorf("unknown Graph numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared
+// during this mutation.
+func (m *GraphMutation) ClearedFields() []string {
+	return nil
+}
+
+// FieldCleared returns a boolean indicates if this field was
+// cleared in this mutation.
+func (m *GraphMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value for the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *GraphMutation) ClearField(name string) error {
+	return fmt.Errorf("unknown Graph nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation regarding the
+// given field name. It returns an error if the field is not
+// defined in the schema.
+func (m *GraphMutation) ResetField(name string) error {
+	switch name {
+	case graph.FieldTitle:
+		m.ResetTitle()
+		return nil
+	case graph.FieldUnit:
+		m.ResetUnit()
+		return nil
+	}
+	return fmt.Errorf("unknown Graph field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this
+// mutation.
+func (m *GraphMutation) AddedEdges() []string {
+	edges := make([]string, 0, 2)
+	if m.group != nil {
+		edges = append(edges, graph.EdgeGroup)
+	}
+	if m.metrics != nil {
+		edges = append(edges, graph.EdgeMetrics)
+	}
+	return edges
+}
+
+// AddedIDs returns all ids (to other nodes) that were added for
+// the given edge name.
+func (m *GraphMutation) AddedIDs(name string) []ent.Value {
+	switch name {
+	case graph.EdgeGroup:
+		if id := m.group; id != nil {
+			return []ent.Value{*id}
+		}
+	case graph.EdgeMetrics:
+		ids := make([]ent.Value, 0, len(m.metrics))
+		for id := range m.metrics {
+			ids = append(ids, id)
+		}
+		return ids
+	}
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this
+// mutation.
+func (m *GraphMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 2)
+	if m.removedmetrics != nil {
+		edges = append(edges, graph.EdgeMetrics)
+	}
+	return edges
+}
+
+// RemovedIDs returns all ids (to other nodes) that were removed for
+// the given edge name.
+func (m *GraphMutation) RemovedIDs(name string) []ent.Value {
+	switch name {
+	case graph.EdgeMetrics:
+		ids := make([]ent.Value, 0, len(m.removedmetrics))
+		for id := range m.removedmetrics {
+			ids = append(ids, id)
+		}
+		return ids
+	}
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this
+// mutation.
+func (m *GraphMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 2)
+	if m.clearedgroup {
+		edges = append(edges, graph.EdgeGroup)
+	}
+	if m.clearedmetrics {
+		edges = append(edges, graph.EdgeMetrics)
+	}
+	return edges
+}
+
+// EdgeCleared returns a boolean indicates if this edge was
+// cleared in this mutation.
+func (m *GraphMutation) EdgeCleared(name string) bool {
+	switch name {
+	case graph.EdgeGroup:
+		return m.clearedgroup
+	case graph.EdgeMetrics:
+		return m.clearedmetrics
+	}
+	return false
+}
+
+// ClearEdge clears the value for the given name. It returns an
+// error if the edge name is not defined in the schema.
+func (m *GraphMutation) ClearEdge(name string) error {
+	switch name {
+	case graph.EdgeGroup:
+		m.ClearGroup()
+		return nil
+	}
+	return fmt.Errorf("unknown Graph unique edge %s", name)
+}
+
+// ResetEdge resets all changes in the mutation regarding the
+// given edge name. It returns an error if the edge is not
+// defined in the schema.
+func (m *GraphMutation) ResetEdge(name string) error {
+	switch name {
+	case graph.EdgeGroup:
+		m.ResetGroup()
+		return nil
+	case graph.EdgeMetrics:
+		m.ResetMetrics()
+		return nil
+	}
+	return fmt.Errorf("unknown Graph edge %s", name)
+}
+
+// GroupMutation represents an operation that mutate the Groups
+// nodes in the graph.
+type GroupMutation struct {
+	config
+	op                 Op
+	typ                string
+	id                 *int
+	name               *string
+	clearedFields      map[string]struct{}
+	application        *int
+	clearedapplication bool
+	graphs             map[int]struct{}
+	removedgraphs      map[int]struct{}
+	clearedgraphs      bool
+	done               bool
+	oldValue           func(context.Context) (*Group, error)
+}
+
+var _ ent.Mutation = (*GroupMutation)(nil)
+
+// groupOption allows to manage the mutation configuration using functional options.
+type groupOption func(*GroupMutation)
+
+// newGroupMutation creates new mutation for $n.Name.
+func newGroupMutation(c config, op Op, opts ...groupOption) *GroupMutation {
+	m := &GroupMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeGroup,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withGroupID sets the id field of the mutation.
+func withGroupID(id int) groupOption {
+	return func(m *GroupMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *Group
+		)
+		m.oldValue = func(ctx context.Context) (*Group, error) {
+			once.Do(func() {
+				if m.done {
+					err = fmt.Errorf("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().Group.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withGroup sets the old Group of the mutation.
+func withGroup(node *Group) groupOption {
+	return func(m *GroupMutation) {
+		m.oldValue = func(context.Context) (*Group, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m GroupMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m GroupMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, fmt.Errorf("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// ID returns the id value in the mutation. Note that, the id
+// is available only if it was provided to the builder.
+func (m *GroupMutation) ID() (id int, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// SetName sets the name field.
+func (m *GroupMutation) SetName(s string) {
+	m.name = &s
+}
+
+// Name returns the name value in the mutation.
+func (m *GroupMutation) Name() (r string, exists bool) {
+	v := m.name
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldName returns the old name value of the Group.
+// If the Group object wasn't provided to the builder, the object is fetched
+// from the database.
+// An error is returned if the mutation operation is not UpdateOne, or database query fails.
+func (m *GroupMutation) OldName(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, fmt.Errorf("OldName is allowed only on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, fmt.Errorf("OldName requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldName: %w", err)
+	}
+	return oldValue.Name, nil
+}
+
+// ResetName reset all changes of the "name" field.
+func (m *GroupMutation) ResetName() {
+	m.name = nil
+}
+
+// SetApplicationID sets the application edge to Application by id.
+func (m *GroupMutation) SetApplicationID(id int) {
+	m.application = &id
+}
+
+// ClearApplication clears the application edge to Application.
+func (m *GroupMutation) ClearApplication() {
+	m.clearedapplication = true
+}
+
+// ApplicationCleared returns if the edge application was cleared.
+func (m *GroupMutation) ApplicationCleared() bool {
+	return m.clearedapplication
+}
+
+// ApplicationID returns the application id in the mutation.
+func (m *GroupMutation) ApplicationID() (id int, exists bool) {
+	if m.application != nil {
+		return *m.application, true
+	}
+	return
+}
+
+// ApplicationIDs returns the application ids in the mutation.
+// Note that ids always returns len(ids) <= 1 for unique edges, and you should use
+// ApplicationID instead. It exists only for internal usage by the builders.
+func (m *GroupMutation) ApplicationIDs() (ids []int) {
+	if id := m.application; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetApplication reset all changes of the "application" edge.
+func (m *GroupMutation) ResetApplication() {
+	m.application = nil
+	m.clearedapplication = false
+}
+
+// AddGraphIDs adds the graphs edge to Graph by ids.
+func (m *GroupMutation) AddGraphIDs(ids ...int) {
+	if m.graphs == nil {
+		m.graphs = make(map[int]struct{})
+	}
+	for i := range ids {
+		m.graphs[ids[i]] = struct{}{}
+	}
+}
+
+// ClearGraphs clears the graphs edge to Graph.
+func (m *GroupMutation) ClearGraphs() {
+	m.clearedgraphs = true
+}
+
+// GraphsCleared returns if the edge graphs was cleared.
+func (m *GroupMutation) GraphsCleared() bool {
+	return m.clearedgraphs
+}
+
+// RemoveGraphIDs removes the graphs edge to Graph by ids.
+func (m *GroupMutation) RemoveGraphIDs(ids ...int) {
+	if m.removedgraphs == nil {
+		m.removedgraphs = make(map[int]struct{})
+	}
+	for i := range ids {
+		m.removedgraphs[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedGraphs returns the removed ids of graphs.
+func (m *GroupMutation) RemovedGraphsIDs() (ids []int) {
+	for id := range m.removedgraphs {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// GraphsIDs returns the graphs ids in the mutation.
+func (m *GroupMutation) GraphsIDs() (ids []int) {
+	for id := range m.graphs {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetGraphs reset all changes of the "graphs" edge.
+func (m *GroupMutation) ResetGraphs() {
+	m.graphs = nil
+	m.clearedgraphs = false
+	m.removedgraphs = nil
+}
+
+// Op returns the operation name.
+func (m *GroupMutation) Op() Op {
+	return m.op
+}
+
+// Type returns the node type of this mutation (Group).
+func (m *GroupMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during
+// this mutation. Note that, in order to get all numeric
+// fields that were in/decremented, call AddedFields().
+func (m *GroupMutation) Fields() []string {
+	fields := make([]string, 0, 1)
+	if m.name != nil {
+		fields = append(fields, group.FieldName)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name.
+// The second boolean value indicates that this field was
+// not set, or was not define in the schema.
+func (m *GroupMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case group.FieldName:
+		return m.Name()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database.
+// An error is returned if the mutation operation is not UpdateOne,
+// or the query to the database was failed.
+func (m *GroupMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case group.FieldName:
+		return m.OldName(ctx)
+	}
+	return nil, fmt.Errorf("unknown Group field %s", name)
+}
+
+// SetField sets the value for the given name. It returns an
+// error if the field is not defined in the schema, or if the
+// type mismatch the field type.
+func (m *GroupMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case group.FieldName:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetName(v)
+		return nil
+	}
+	return fmt.Errorf("unknown Group field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented
+// or decremented during this mutation.
+func (m *GroupMutation) AddedFields() []string {
+	return nil
+}
+
+// AddedField returns the numeric value that was in/decremented
+// from a field with the given name. The second value indicates
+// that this field was not set, or was not define in the schema.
+func (m *GroupMutation) AddedField(name string) (ent.Value, bool) {
+	return nil, false
+}
+
+// AddField adds the value for the given name. It returns an
+// error if the field is not defined in the schema, or if the
+// type mismatch the field type.
+func (m *GroupMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	}
+	return fmt.Errorf("unknown Group numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared
+// during this mutation.
+func (m *GroupMutation) ClearedFields() []string {
+	return nil
+}
+
+// FieldCleared returns a boolean indicates if this field was
+// cleared in this mutation.
+func (m *GroupMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value for the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *GroupMutation) ClearField(name string) error {
+	return fmt.Errorf("unknown Group nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation regarding the
+// given field name. It returns an error if the field is not
+// defined in the schema.
+func (m *GroupMutation) ResetField(name string) error {
+	switch name {
+	case group.FieldName:
+		m.ResetName()
+		return nil
+	}
+	return fmt.Errorf("unknown Group field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this
+// mutation.
+func (m *GroupMutation) AddedEdges() []string {
+	edges := make([]string, 0, 2)
+	if m.application != nil {
+		edges = append(edges, group.EdgeApplication)
+	}
+	if m.graphs != nil {
+		edges = append(edges, group.EdgeGraphs)
+	}
+	return edges
+}
+
+// AddedIDs returns all ids (to other nodes) that were added for
+// the given edge name.
+func (m *GroupMutation) AddedIDs(name string) []ent.Value {
+	switch name {
+	case group.EdgeApplication:
+		if id := m.application; id != nil {
+			return []ent.Value{*id}
+		}
+	case group.EdgeGraphs:
+		ids := make([]ent.Value, 0, len(m.graphs))
+		for id := range m.graphs {
+			ids = append(ids, id)
+		}
+		return ids
+	}
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this
+// mutation.
+func (m *GroupMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 2)
+	if m.removedgraphs != nil {
+		edges = append(edges, group.EdgeGraphs)
+	}
+	return edges
+}
+
+// RemovedIDs returns all ids (to other nodes) that were removed for
+// the given edge name.
+func (m *GroupMutation) RemovedIDs(name string) []ent.Value {
+	switch name {
+	case group.EdgeGraphs:
+		ids := make([]ent.Value, 0, len(m.removedgraphs))
+		for id := range m.removedgraphs {
+			ids = append(ids, id)
+		}
+		return ids
+	}
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this
+// mutation.
+func (m *GroupMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 2)
+	if m.clearedapplication {
+		edges = append(edges, group.EdgeApplication)
+	}
+	if m.clearedgraphs {
+		edges = append(edges, group.EdgeGraphs)
+	}
+	return edges
+}
+
+// EdgeCleared returns a boolean indicates if this edge was
+// cleared in this mutation.
+func (m *GroupMutation) EdgeCleared(name string) bool {
+	switch name {
+	case group.EdgeApplication:
+		return m.clearedapplication
+	case group.EdgeGraphs:
+		return m.clearedgraphs
+	}
+	return false
+}
+
+// ClearEdge clears the value for the given name. It returns an
+// error if the edge name is not defined in the schema.
+func (m *GroupMutation) ClearEdge(name string) error {
+	switch name {
+	case group.EdgeApplication:
+		m.ClearApplication()
+		return nil
+	}
+	return fmt.Errorf("unknown Group unique edge %s", name)
+}
+
+// ResetEdge resets all changes in the mutation regarding the
+// given edge name. It returns an error if the edge is not
+// defined in the schema.
+func (m *GroupMutation) ResetEdge(name string) error {
+	switch name {
+	case group.EdgeApplication:
+		m.ResetApplication()
+		return nil
+	case group.EdgeGraphs:
+		m.ResetGraphs()
+		return nil
+	}
+	return fmt.Errorf("unknown Group edge %s", name)
+}
+
+// HistogramMutation represents an operation that mutate the Histograms
+// nodes in the graph.
+type HistogramMutation struct {
+	config
+	op              Op
+	typ             string
+	id              *int
+	time            *int64
+	addtime         *int64
+	count           *int64
+	addcount        *int64
+	min             *int64
+	addmin          *int64
+	max             *int64
+	addmax          *int64
+	mean            *float64
+	addmean         *float64
+	stddev          *float64
+	addstddev       *float64
+	median          *float64
+	addmedian       *float64
+	p75             *float64
+	addp75          *float64
+	p95             *float64
+	addp95          *float64
+	p99             *float64
+	addp99          *float64
+	p999            *float64
+	addp999         *float64
+	wID             *string
+	clearedFields   map[string]struct{}
+	metric          *int
+	clearedmetric   bool
+	executor        *int
+	clearedexecutor bool
+	done            bool
+	oldValue        func(context.Context) (*Histogram, error)
+}
+
+var _ ent.Mutation = (*HistogramMutation)(nil)
+
+// histogramOption allows to manage the mutation configuration using functional options.
+type histogramOption func(*HistogramMutation)
+
+// newHistogramMutation creates new mutation for $n.Name.
+func newHistogramMutation(c config, op Op, opts ...histogramOption) *HistogramMutation {
+	m := &HistogramMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeHistogram,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withHistogramID sets the id field of the mutation.
+func withHistogramID(id int) histogramOption {
+	return func(m *HistogramMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *Histogram
+		)
+		m.oldValue = func(ctx context.Context) (*Histogram, error) {
+			once.Do(func() {
+				if m.done {
+					err = fmt.Errorf("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().Histogram.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withHistogram sets the old Histogram of the mutation.
+func withHistogram(node *Histogram) histogramOption {
+	return func(m *HistogramMutation) {
+		m.oldValue = func(context.Context) (*Histogram, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m HistogramMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m HistogramMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, fmt.Errorf("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// ID returns the id value in the mutation. Note that, the id
+// is available only if it was provided to the builder.
+func (m *HistogramMutation) ID() (id int, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// SetTime sets the time field.
+func (m *HistogramMutation) SetTime(i int64) {
+	m.time = &i
+	m.addtime = nil
+}
+
+// Time returns the time value in the mutation.
+func (m *HistogramMutation) Time() (r int64, exists bool) {
+	v := m.time
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldTime returns the old time value of the Histogram.
+// If the Histogram object wasn't provided to the builder, the object is fetched
+// from the database.
+// An error is returned if the mutation operation is not UpdateOne, or database query fails.
+func (m *HistogramMutation) OldTime(ctx context.Context) (v int64, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, fmt.Errorf("OldTime is allowed only on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, fmt.Errorf("OldTime requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldTime: %w", err)
+	}
+	return oldValue.Time, nil
+}
+
+// AddTime adds i to time.
+func (m *HistogramMutation) AddTime(i int64) {
+	if m.addtime != nil {
+		*m.addtime += i
+	} else {
+		m.addtime = &i
+	}
+}
+
+// AddedTime returns the value that was added to the time field in this mutation.
+func (m *HistogramMutation) AddedTime() (r int64, exists bool) {
+	v := m.addtime
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetTime reset all changes of the "time" field.
+func (m *HistogramMutation) ResetTime() {
+	m.time = nil
+	m.addtime = nil
+}
+
+// SetCount sets the count field.
+func (m *HistogramMutation) SetCount(i int64) {
+	m.count = &i
+	m.addcount = nil
+}
+
+// Count returns the count value in the mutation.
+func (m *HistogramMutation) Count() (r int64, exists bool) {
+	v := m.count
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCount returns the old count value of the Histogram.
+// If the Histogram object wasn't provided to the builder, the object is fetched
+// from the database.
+// An error is returned if the mutation operation is not UpdateOne, or database query fails.
+func (m *HistogramMutation) OldCount(ctx context.Context) (v int64, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, fmt.Errorf("OldCount is allowed only on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, fmt.Errorf("OldCount requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCount: %w", err)
+	}
+	return oldValue.Count, nil
+}
+
+// AddCount adds i to count.
+func (m *HistogramMutation) AddCount(i int64) {
+	if m.addcount != nil {
+		*m.addcount += i
+	} else {
+		m.addcount = &i
+	}
+}
+
+// AddedCount returns the value that was added to the count field in this mutation.
+func (m *HistogramMutation) AddedCount() (r int64, exists bool) {
+	v := m.addcount
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetCount reset all changes of the "count" field.
+func (m *HistogramMutation) ResetCount() {
+	m.count = nil
+	m.addcount = nil
+}
+
+// SetMin sets the min field.
+func (m *HistogramMutation) SetMin(i int64) {
+	m.min = &i
+	m.addmin = nil
+}
+
+// Min returns the min value in the mutation.
+func (m *HistogramMutation) Min() (r int64, exists bool) {
+	v := m.min
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldMin returns the old min value of the Histogram.
+// If the Histogram object wasn't provided to the builder, the object is fetched
+// from the database.
+// An error is returned if the mutation operation is not UpdateOne, or database query fails.
+func (m *HistogramMutation) OldMin(ctx context.Context) (v int64, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, fmt.Errorf("OldMin is allowed only on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, fmt.Errorf("OldMin requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldMin: %w", err)
+	}
+	return oldValue.Min, nil
+}
+
+// AddMin adds i to min.
+func (m *HistogramMutation) AddMin(i int64) {
+	if m.addmin != nil {
+		*m.addmin += i
+	} else {
+		m.addmin = &i
+	}
+}
+
+// AddedMin returns the value that was added to the min field in this mutation.
+func (m *HistogramMutation) AddedMin() (r int64, exists bool) {
+	v := m.addmin
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetMin reset all changes of the "min" field.
+func (m *HistogramMutation) ResetMin() {
+	m.min = nil
+	m.addmin = nil
+}
+
+// SetMax sets the max field.
+func (m *HistogramMutation) SetMax(i int64) {
+	m.max = &i
+	m.addmax = nil
+}
+
+// Max returns the max value in the mutation.
+func (m *HistogramMutation) Max() (r int64, exists bool) {
+	v := m.max
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldMax returns the old max value of the Histogram.
+// If the Histogram object wasn't provided to the builder, the object is fetched
+// from the database.
+// An error is returned if the mutation operation is not UpdateOne, or database query fails.
+func (m *HistogramMutation) OldMax(ctx context.Context) (v int64, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, fmt.Errorf("OldMax is allowed only on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, fmt.Errorf("OldMax requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldMax: %w", err)
+	}
+	return oldValue.Max, nil
+}
+
+// AddMax adds i to max.
+func (m *HistogramMutation) AddMax(i int64) {
+	if m.addmax != nil {
+		*m.addmax += i
+	} else {
+		m.addmax = &i
+	}
+}
+
+// AddedMax returns the value that was added to the max field in this mutation.
+func (m *HistogramMutation) AddedMax() (r int64, exists bool) {
+	v := m.addmax
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetMax reset all changes of the "max" field.
+func (m *HistogramMutation) ResetMax() {
+	m.max = nil
+	m.addmax = nil
+}
+
+// SetMean sets the mean field.
+func (m *HistogramMutation) SetMean(f float64) {
+	m.mean = &f
+	m.addmean = nil
+}
+
+// Mean returns the mean value in the mutation.
+func (m *HistogramMutation) Mean() (r float64, exists bool) {
+	v := m.mean
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldMean returns the old mean value of the Histogram.
+// If the Histogram object wasn't provided to the builder, the object is fetched
+// from the database.
+// An error is returned if the mutation operation is not UpdateOne, or database query fails.
+func (m *HistogramMutation) OldMean(ctx context.Context) (v float64, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, fmt.Errorf("OldMean is allowed only on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, fmt.Errorf("OldMean requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldMean: %w", err)
+	}
+	return oldValue.Mean, nil
+}
+
+// AddMean adds f to mean.
+func (m *HistogramMutation) AddMean(f float64) {
+	if m.addmean != nil {
+		*m.addmean += f
+	} else {
+		m.addmean = &f
+	}
+}
+
+// AddedMean returns the value that was added to the mean field in this mutation.
+func (m *HistogramMutation) AddedMean() (r float64, exists bool) {
+	v := m.addmean
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetMean reset all changes of the "mean" field.
+func (m *HistogramMutation) ResetMean() {
+	m.mean = nil
+	m.addmean = nil
+}
+
+// SetStddev sets the stddev field.
+func (m *HistogramMutation) SetStddev(f float64) {
+	m.stddev = &f
+	m.addstddev = nil
+}
+
+// Stddev returns the stddev value in the mutation.
+func (m *HistogramMutation) Stddev() (r float64, exists bool) {
+	v := m.stddev
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldStddev returns the old stddev value of the Histogram.
+// If the Histogram object wasn't provided to the builder, the object is fetched
+// from the database.
+// An error is returned if the mutation operation is not UpdateOne, or database query fails.
+func (m *HistogramMutation) OldStddev(ctx context.Context) (v float64, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, fmt.Errorf("OldStddev is allowed only on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, fmt.Errorf("OldStddev requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldStddev: %w", err)
+	}
+	return oldValue.Stddev, nil
+}
+
+// AddStddev adds f to stddev.
+func (m *HistogramMutation) AddStddev(f float64) {
+	if m.addstddev != nil {
+		*m.addstddev += f
+	} else {
+		m.addstddev = &f
+	}
+}
+
+// AddedStddev returns the value that was added to the stddev field in this mutation.
+func (m *HistogramMutation) AddedStddev() (r float64, exists bool) {
+	v := m.addstddev
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetStddev reset all changes of the "stddev" field.
+func (m *HistogramMutation) ResetStddev() {
+	m.stddev = nil
+	m.addstddev = nil
+}
+
+// SetMedian sets the median field.
+func (m *HistogramMutation) SetMedian(f float64) {
+	m.median = &f
+	m.addmedian = nil
+}
+
+// Median returns the median value in the mutation.
+func (m *HistogramMutation) Median() (r float64, exists bool) {
+	v := m.median
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldMedian returns the old median value of the Histogram.
+// If the Histogram object wasn't provided to the builder, the object is fetched
+// from the database.
+// An error is returned if the mutation operation is not UpdateOne, or database query fails.
+func (m *HistogramMutation) OldMedian(ctx context.Context) (v float64, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, fmt.Errorf("OldMedian is allowed only on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, fmt.Errorf("OldMedian requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldMedian: %w", err)
+	}
+	return oldValue.Median, nil
+}
+
+// AddMedian adds f to median.
+func (m *HistogramMutation) AddMedian(f float64) {
+	if m.addmedian != nil {
+		*m.addmedian += f
+	} else {
+		m.addmedian = &f
+	}
+}
+
+// AddedMedian returns the value that was added to the median field in this mutation.
+func (m *HistogramMutation) AddedMedian() (r float64, exists bool) {
+	v := m.addmedian
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetMedian reset all changes of the "median" field.
+func (m *HistogramMutation) ResetMedian() {
+	m.median = nil
+	m.addmedian = nil
+}
+
+// SetP75 sets the p75 field.
+func (m *HistogramMutation) SetP75(f float64) {
+	m.p75 = &f
+	m.addp75 = nil
+}
+
+// P75 returns the p75 value in the mutation.
+func (m *HistogramMutation) P75() (r float64, exists bool) {
+	v := m.p75
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldP75 returns the old p75 value of the Histogram.
+// If the Histogram object wasn't provided to the builder, the object is fetched
+// from the database.
+// An error is returned if the mutation operation is not UpdateOne, or database query fails.
+func (m *HistogramMutation) OldP75(ctx context.Context) (v float64, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, fmt.Errorf("OldP75 is allowed only on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, fmt.Errorf("OldP75 requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldP75: %w", err)
+	}
+	return oldValue.P75, nil
+}
+
+// AddP75 adds f to p75.
+func (m *HistogramMutation) AddP75(f float64) {
+	if m.addp75 != nil {
+		*m.addp75 += f
+	} else {
+		m.addp75 = &f
+	}
+}
+
+// AddedP75 returns the value that was added to the p75 field in this mutation.
+func (m *HistogramMutation) AddedP75() (r float64, exists bool) {
+	v := m.addp75
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetP75 reset all changes of the "p75" field.
+func (m *HistogramMutation) ResetP75() {
+	m.p75 = nil
+	m.addp75 = nil
+}
+
+// SetP95 sets the p95 field.
+func (m *HistogramMutation) SetP95(f float64) {
+	m.p95 = &f
+	m.addp95 = nil
+}
+
+// P95 returns the p95 value in the mutation.
+func (m *HistogramMutation) P95() (r float64, exists bool) {
+	v := m.p95
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldP95 returns the old p95 value of the Histogram.
+// If the Histogram object wasn't provided to the builder, the object is fetched
+// from the database.
+// An error is returned if the mutation operation is not UpdateOne, or database query fails.
+func (m *HistogramMutation) OldP95(ctx context.Context) (v float64, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, fmt.Errorf("OldP95 is allowed only on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, fmt.Errorf("OldP95 requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldP95: %w", err)
+	}
+	return oldValue.P95, nil
+}
+
+// AddP95 adds f to p95.
+func (m *HistogramMutation) AddP95(f float64) {
+	if m.addp95 != nil {
+		*m.addp95 += f
+	} else {
+		m.addp95 = &f
+	}
+}
+
+// AddedP95 returns the value that was added to the p95 field in this mutation.
+func (m *HistogramMutation) AddedP95() (r float64, exists bool) {
+	v := m.addp95
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetP95 reset all changes of the "p95" field.
+func (m *HistogramMutation) ResetP95() {
+	m.p95 = nil
+	m.addp95 = nil
+}
+
+// SetP99 sets the p99 field.
+func (m *HistogramMutation) SetP99(f float64) {
+	m.p99 = &f
+	m.addp99 = nil
+}
+
+// P99 returns the p99 value in the mutation.
+func (m *HistogramMutation) P99() (r float64, exists bool) {
+	v := m.p99
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldP99 returns the old p99 value of the Histogram.
+// If the Histogram object wasn't provided to the builder, the object is fetched
+// from the database.
+// An error is returned if the mutation operation is not UpdateOne, or database query fails.
+func (m *HistogramMutation) OldP99(ctx context.Context) (v float64, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, fmt.Errorf("OldP99 is allowed only on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, fmt.Errorf("OldP99 requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldP99: %w", err)
+	}
+	return oldValue.P99, nil
+}
+
+// AddP99 adds f to p99.
+func (m *HistogramMutation) AddP99(f float64) {
+	if m.addp99 != nil {
+		*m.addp99 += f
+	} else {
+		m.addp99 = &f
+	}
+}
+
+// AddedP99 returns the value that was added to the p99 field in this mutation.
+func (m *HistogramMutation) AddedP99() (r float64, exists bool) {
+	v := m.addp99
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetP99 reset all changes of the "p99" field.
+func (m *HistogramMutation) ResetP99() {
+	m.p99 = nil
+	m.addp99 = nil
+}
+
+// SetP999 sets the p999 field.
+func (m *HistogramMutation) SetP999(f float64) {
+	m.p999 = &f
+	m.addp999 = nil
+}
+
+// P999 returns the p999 value in the mutation.
+func (m *HistogramMutation) P999() (r float64, exists bool) {
+	v := m.p999
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldP999 returns the old p999 value of the Histogram.
+// If the Histogram object wasn't provided to the builder, the object is fetched
+// from the database.
+// An error is returned if the mutation operation is not UpdateOne, or database query fails.
+func (m *HistogramMutation) OldP999(ctx context.Context) (v float64, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, fmt.Errorf("OldP999 is allowed only on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, fmt.Errorf("OldP999 requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldP999: %w", err)
+	}
+	return oldValue.P999, nil
+}
+
+// AddP999 adds f to p999.
+func (m *HistogramMutation) AddP999(f float64) {
+	if m.addp999 != nil {
+		*m.addp999 += f
+	} else {
+		m.addp999 = &f
+	}
+}
+
+// AddedP999 returns the value that was added to the p999 field in this mutation.
+func (m *HistogramMutation) AddedP999() (r float64, exists bool) {
+	v := m.addp999
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetP999 reset all changes of the "p999" field.
+func (m *HistogramMutation) ResetP999() {
+	m.p999 = nil
+	m.addp999 = nil
+}
+
+// SetWID sets the wID field.
+func (m *HistogramMutation) SetWID(s string) {
+	m.wID = &s
+}
+
+// WID returns the wID value in the mutation.
+func (m *HistogramMutation) WID() (r string, exists bool) {
+	v := m.wID
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldWID returns the old wID value of the Histogram.
+// If the Histogram object wasn't provided to the builder, the object is fetched
+// from the database.
+// An error is returned if the mutation operation is not UpdateOne, or database query fails.
+func (m *HistogramMutation) OldWID(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, fmt.Errorf("OldWID is allowed only on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, fmt.Errorf("OldWID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldWID: %w", err)
+	}
+	return oldValue.WID, nil
+}
+
+// ResetWID reset all changes of the "wID" field.
+func (m *HistogramMutation) ResetWID() {
+	m.wID = nil
+}
+
+// SetMetricID sets the metric edge to Metric by id.
+func (m *HistogramMutation) SetMetricID(id int) {
+	m.metric = &id
+}
+
+// ClearMetric clears the metric edge to Metric.
+func (m *HistogramMutation) ClearMetric() {
+	m.clearedmetric = true
+}
+
+// MetricCleared returns if the edge metric was cleared.
+func (m *HistogramMutation) MetricCleared() bool {
+	return m.clearedmetric
+}
+
+// MetricID returns the metric id in the mutation.
+func (m *HistogramMutation) MetricID() (id int, exists bool) {
+	if m.metric != nil {
+		return *m.metric, true
+	}
+	return
+}
+
+// MetricIDs returns the metric ids in the mutation.
+// Note that ids always returns len(ids) <= 1 for unique edges, and you should use
+// MetricID instead. It exists only for internal usage by the builders.
+func (m *HistogramMutation) MetricIDs() (ids []int) {
+	if id := m.metric; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetMetric reset all changes of the "metric" edge.
+func (m *HistogramMutation) ResetMetric() {
+	m.metric = nil
+	m.clearedmetric = false
+}
+
+// SetExecutorID sets the executor edge to Executor by id.
+func (m *HistogramMutation) SetExecutorID(id int) {
+	m.executor = &id
+}
+
+// ClearExecutor clears the executor edge to Executor.
+func (m *HistogramMutation) ClearExecutor() {
+	m.clearedexecutor = true
+}
+
+// ExecutorCleared returns if the edge executor was cleared.
+func (m *HistogramMutation) ExecutorCleared() bool {
+	return m.clearedexecutor
+}
+
+// ExecutorID returns the executor id in the mutation.
+func (m *HistogramMutation) ExecutorID() (id int, exists bool) {
+	if m.executor != nil {
+		return *m.executor, true
+	}
+	return
+}
+
+// ExecutorIDs returns the executor ids in the mutation.
+// Note that ids always returns len(ids) <= 1 for unique edges, and you should use
+// ExecutorID instead. It exists only for internal usage by the builders.
+func (m *HistogramMutation) ExecutorIDs() (ids []int) {
+	if id := m.executor; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetExecutor reset all changes of the "executor" edge.
+func (m *HistogramMutation) ResetExecutor() {
+	m.executor = nil
+	m.clearedexecutor = false
+}
+
+// Op returns the operation name.
+func (m *HistogramMutation) Op() Op {
+	return m.op
+}
+
+// Type returns the node type of this mutation (Histogram).
+func (m *HistogramMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during
+// this mutation. Note that, in order to get all numeric
+// fields that were in/decremented, call AddedFields().
+func (m *HistogramMutation) Fields() []string {
+	fields := make([]string, 0, 12)
+	if m.time != nil {
+		fields = append(fields, histogram.FieldTime)
+	}
+	if m.count != nil {
+		fields = append(fields, histogram.FieldCount)
+	}
+	if m.min != nil {
+		fields = append(fields, histogram.FieldMin)
+	}
+	if m.max != nil {
+		fields = append(fields, histogram.FieldMax)
+	}
+	if m.mean != nil {
+		fields = append(fields, histogram.FieldMean)
+	}
+	if m.stddev != nil {
+		fields = append(fields, histogram.FieldStddev)
+	}
+	if m.median != nil {
+		fields = append(fields, histogram.FieldMedian)
+	}
+	if m.p75 != nil {
+		fields = append(fields, histogram.FieldP75)
+	}
+	if m.p95 != nil {
+		fields = append(fields, histogram.FieldP95)
+	}
+	if m.p99 != nil {
+		fields = append(fields, histogram.FieldP99)
+	}
+	if m.p999 != nil {
+		fields = append(fields, histogram.FieldP999)
+	}
+	if m.wID != nil {
+		fields = append(fields, histogram.FieldWID)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name.
+// The second boolean value indicates that this field was
+// not set, or was not define in the schema.
+func (m *HistogramMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case histogram.FieldTime:
+		return m.Time()
+	case histogram.FieldCount:
+		return m.Count()
+	case histogram.FieldMin:
+		return m.Min()
+	case histogram.FieldMax:
+		return m.Max()
+	case histogram.FieldMean:
+		return m.Mean()
+	case histogram.FieldStddev:
+		return m.Stddev()
+	case histogram.FieldMedian:
+		return m.Median()
+	case histogram.FieldP75:
+		return m.P75()
+	case histogram.FieldP95:
+		return m.P95()
+	case histogram.FieldP99:
+		return m.P99()
+	case histogram.FieldP999:
+		return m.P999()
+	case histogram.FieldWID:
+		return m.WID()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database.
+// An error is returned if the mutation operation is not UpdateOne,
+// or the query to the database was failed.
+func (m *HistogramMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case histogram.FieldTime:
+		return m.OldTime(ctx)
+	case histogram.FieldCount:
+		return m.OldCount(ctx)
+	case histogram.FieldMin:
+		return m.OldMin(ctx)
+	case histogram.FieldMax:
+		return m.OldMax(ctx)
+	case histogram.FieldMean:
+		return m.OldMean(ctx)
+	case histogram.FieldStddev:
+		return m.OldStddev(ctx)
+	case histogram.FieldMedian:
+		return m.OldMedian(ctx)
+	case histogram.FieldP75:
+		return m.OldP75(ctx)
+	case histogram.FieldP95:
+		return m.OldP95(ctx)
+	case histogram.FieldP99:
+		return m.OldP99(ctx)
+	case histogram.FieldP999:
+		return m.OldP999(ctx)
+	case histogram.FieldWID:
+		return m.OldWID(ctx)
+	}
+	return nil, fmt.Errorf("unknown Histogram field %s", name)
+}
+
+// SetField sets the value for the given name. It returns an
+// error if the field is not defined in the schema, or if the
+// type mismatch the field type.
+func (m *HistogramMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case histogram.FieldTime:
+		v, ok := value.(int64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetTime(v)
+		return nil
+	case histogram.FieldCount:
+		v, ok := value.(int64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCount(v)
+		return nil
+	case histogram.FieldMin:
+		v, ok := value.(int64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetMin(v)
+		return nil
+	case histogram.FieldMax:
+		v, ok := value.(int64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetMax(v)
+		return nil
+	case histogram.FieldMean:
+		v, ok := value.(float64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetMean(v)
+		return nil
+	case histogram.FieldStddev:
+		v, ok := value.(float64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetStddev(v)
+		return nil
+	case histogram.FieldMedian:
+		v, ok := value.(float64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetMedian(v)
+		return nil
+	case histogram.FieldP75:
+		v, ok := value.(float64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetP75(v)
+		return nil
+	case histogram.FieldP95:
+		v, ok := value.(float64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetP95(v)
+		return nil
+	case histogram.FieldP99:
+		v, ok := value.(float64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetP99(v)
+		return nil
+	case histogram.FieldP999:
+		v, ok := value.(float64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetP999(v)
+		return nil
+	case histogram.FieldWID:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetWID(v)
+		return nil
+	}
+	return fmt.Errorf("unknown Histogram field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented
+// or decremented during this mutation.
+func (m *HistogramMutation) AddedFields() []string {
+	var fields []string
+	if m.addtime != nil {
+		fields = append(fields, histogram.FieldTime)
+	}
+	if m.addcount != nil {
+		fields = append(fields, histogram.FieldCount)
+	}
+	if m.addmin != nil {
+		fields = append(fields, histogram.FieldMin)
+	}
+	if m.addmax != nil {
+		fields = append(fields, histogram.FieldMax)
+	}
+	if m.addmean != nil {
+		fields = append(fields, histogram.FieldMean)
+	}
+	if m.addstddev != nil {
+		fields = append(fields, histogram.FieldStddev)
+	}
+	if m.addmedian != nil {
+		fields = append(fields, histogram.FieldMedian)
+	}
+	if m.addp75 != nil {
+		fields = append(fields, histogram.FieldP75)
+	}
+	if m.addp95 != nil {
+		fields = append(fields, histogram.FieldP95)
+	}
+	if m.addp99 != nil {
+		fields = append(fields, histogram.FieldP99)
+	}
+	if m.addp999 != nil {
+		fields = append(fields, histogram.FieldP999)
+	}
+	return fields
+}
+
+// AddedField returns the numeric value that was in/decremented
+// from a field with the given name. The second value indicates
+// that this field was not set, or was not define in the schema.
+func (m *HistogramMutation) AddedField(name string) (ent.Value, bool) {
+	switch name {
+	case histogram.FieldTime:
+		return m.AddedTime()
+	case histogram.FieldCount:
+		return m.AddedCount()
+	case histogram.FieldMin:
+		return m.AddedMin()
+	case histogram.FieldMax:
+		return m.AddedMax()
+	case histogram.FieldMean:
+		return m.AddedMean()
+	case histogram.FieldStddev:
+		return m.AddedStddev()
+	case histogram.FieldMedian:
+		return m.AddedMedian()
+	case histogram.FieldP75:
+		return m.AddedP75()
+	case histogram.FieldP95:
+		return m.AddedP95()
+	case histogram.FieldP99:
+		return m.AddedP99()
+	case histogram.FieldP999:
+		return m.AddedP999()
+	}
+	return nil, false
+}
+
+// AddField adds the value for the given name. It returns an
+// error if the field is not defined in the schema, or if the
+// type mismatch the field type.
+func (m *HistogramMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	case histogram.FieldTime:
+		v, ok := value.(int64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddTime(v)
+		return nil
+	case histogram.FieldCount:
+		v, ok := value.(int64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddCount(v)
+		return nil
+	case histogram.FieldMin:
+		v, ok := value.(int64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddMin(v)
+		return nil
+	case histogram.FieldMax:
+		v, ok := value.(int64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddMax(v)
+		return nil
+	case histogram.FieldMean:
+		v, ok := value.(float64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddMean(v)
+		return nil
+	case histogram.FieldStddev:
+		v, ok := value.(float64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddStddev(v)
+		return nil
+	case histogram.FieldMedian:
+		v, ok := value.(float64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddMedian(v)
+		return nil
+	case histogram.FieldP75:
+		v, ok := value.(float64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddP75(v)
+		return nil
+	case histogram.FieldP95:
+		v, ok := value.(float64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddP95(v)
+		return nil
+	case histogram.FieldP99:
+		v, ok := value.(float64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddP99(v)
+		return nil
+	case histogram.FieldP999:
+		v, ok := value.(float64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddP999(v)
+		return nil
+	}
+	return fmt.Errorf("unknown Histogram numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared
+// during this mutation.
+func (m *HistogramMutation) ClearedFields() []string {
+	return nil
+}
+
+// FieldCleared returns a boolean indicates if this field was
+// cleared in this mutation.
+func (m *HistogramMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value for the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *HistogramMutation) ClearField(name string) error {
+	return fmt.Errorf("unknown Histogram nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation regarding the
+// given field name. It returns an error if the field is not
+// defined in the schema.
+func (m *HistogramMutation) ResetField(name string) error {
+	switch name {
+	case histogram.FieldTime:
+		m.ResetTime()
+		return nil
+	case histogram.FieldCount:
+		m.ResetCount()
+		return nil
+	case histogram.FieldMin:
+		m.ResetMin()
+		return nil
+	case histogram.FieldMax:
+		m.ResetMax()
+		return nil
+	case histogram.FieldMean:
+		m.ResetMean()
+		return nil
+	case histogram.FieldStddev:
+		m.ResetStddev()
+		return nil
+	case histogram.FieldMedian:
+		m.ResetMedian()
+		return nil
+	case histogram.FieldP75:
+		m.ResetP75()
+		return nil
+	case histogram.FieldP95:
+		m.ResetP95()
+		return nil
+	case histogram.FieldP99:
+		m.ResetP99()
+		return nil
+	case histogram.FieldP999:
+		m.ResetP999()
+		return nil
+	case histogram.FieldWID:
+		m.ResetWID()
+		return nil
+	}
+	return fmt.Errorf("unknown Histogram field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this
+// mutation.
+func (m *HistogramMutation) AddedEdges() []string {
+	edges := make([]string, 0, 2)
+	if m.metric != nil {
+		edges = append(edges, histogram.EdgeMetric)
+	}
+	if m.executor != nil {
+		edges = append(edges, histogram.EdgeExecutor)
+	}
+	return edges
+}
+
+// AddedIDs returns all ids (to other nodes) that were added for
+// the given edge name.
+func (m *HistogramMutation) AddedIDs(name string) []ent.Value {
+	switch name {
+	case histogram.EdgeMetric:
+		if id := m.metric; id != nil {
+			return []ent.Value{*id}
+		}
+	case histogram.EdgeExecutor:
+		if id := m.executor; id != nil {
+			return []ent.Value{*id}
+		}
+	}
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this
+// mutation.
+func (m *HistogramMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 2)
+	return edges
+}
+
+// RemovedIDs returns all ids (to other nodes) that were removed for
+// the given edge name.
+func (m *HistogramMutation) RemovedIDs(name string) []ent.Value {
+	switch name {
+	}
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this
+// mutation.
+func (m *HistogramMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 2)
+	if m.clearedmetric {
+		edges = append(edges, histogram.EdgeMetric)
+	}
+	if m.clearedexecutor {
+		edges = append(edges, histogram.EdgeExecutor)
+	}
+	return edges
+}
+
+// EdgeCleared returns a boolean indicates if this edge was
+// cleared in this mutation.
+func (m *HistogramMutation) EdgeCleared(name string) bool {
+	switch name {
+	case histogram.EdgeMetric:
+		return m.clearedmetric
+	case histogram.EdgeExecutor:
+		return m.clearedexecutor
+	}
+	return false
+}
+
+// ClearEdge clears the value for the given name. It returns an
+// error if the edge name is not defined in the schema.
+func (m *HistogramMutation) ClearEdge(name string) error {
+	switch name {
+	case histogram.EdgeMetric:
+		m.ClearMetric()
+		return nil
+	case histogram.EdgeExecutor:
+		m.ClearExecutor()
+		return nil
+	}
+	return fmt.Errorf("unknown Histogram unique edge %s", name)
+}
+
+// ResetEdge resets all changes in the mutation regarding the
+// given edge name. It returns an error if the edge is not
+// defined in the schema.
+func (m *HistogramMutation) ResetEdge(name string) error {
+	switch name {
+	case histogram.EdgeMetric:
+		m.ResetMetric()
+		return nil
+	case histogram.EdgeExecutor:
+		m.ResetExecutor()
+		return nil
+	}
+	return fmt.Errorf("unknown Histogram edge %s", name)
+}
+
+// MetricMutation represents an operation that mutate the Metrics
+// nodes in the graph.
+type MetricMutation struct {
+	config
+	op                   Op
+	typ                  string
+	id                   *int
+	title                *string
+	_type                *string
+	downsampled_until    *int64
+	adddownsampled_until *int64
+	clearedFields        map[string]struct{}
+	graph                *int
+	clearedgraph         bool
+	histograms           map[int]struct{}
+	removedhistograms    map[int]struct{}
+	clearedhistograms    bool
+	counters             map[int]struct{}
+	removedcounters      map[int]struct{}
+	clearedcounters      bool
+	gauges               map[int]struct{}
+	removedgauges        map[int]struct{}
+	clearedgauges        bool
+	done                 bool
+	oldValue             func(context.Context) (*Metric, error)
+}
+
+var _ ent.Mutation = (*MetricMutation)(nil)
+
+// metricOption allows to manage the mutation configuration using functional options.
+type metricOption func(*MetricMutation)
+
+// newMetricMutation creates new mutation for $n.Name.
+func newMetricMutation(c config, op Op, opts ...metricOption) *MetricMutation {
+	m := &MetricMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeMetric,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withMetricID sets the id field of the mutation.
+func withMetricID(id int) metricOption {
+	return func(m *MetricMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *Metric
+		)
+		m.oldValue = func(ctx context.Context) (*Metric, error) {
+			once.Do(func() {
+				if m.done {
+					err = fmt.Errorf("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().Metric.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withMetric sets the old Metric of the mutation.
+func withMetric(node *Metric) metricOption {
+	return func(m *MetricMutation) {
+		m.oldValue = func(context.Context) (*Metric, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m MetricMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m MetricMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, fmt.Errorf("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// ID returns the id value in the mutation. Note that, the id
+// is available only if it was provided to the builder.
+func (m *MetricMutation) ID() (id int, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// SetTitle sets the title field.
+func (m *MetricMutation) SetTitle(s string) {
+	m.title = &s
+}
+
+// Title returns the title value in the mutation.
+func (m *MetricMutation) Title() (r string, exists bool) {
+	v := m.title
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldTitle returns the old title value of the Metric.
+// If the Metric object wasn't provided to the builder, the object is fetched
+// from the database.
+// An error is returned if the mutation operation is not UpdateOne, or database query fails.
+func (m *MetricMutation) OldTitle(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, fmt.Errorf("OldTitle is allowed only on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, fmt.Errorf("OldTitle requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldTitle: %w", err)
+	}
+	return oldValue.Title, nil
+}
+
+// ResetTitle reset all changes of the "title" field.
+func (m *MetricMutation) ResetTitle() {
+	m.title = nil
+}
+
+// SetType sets the type field.
+func (m *MetricMutation) SetType(s string) {
+	m._type = &s
+}
+
+// GetType returns the type value in the mutation.
+func (m *MetricMutation) GetType() (r string, exists bool) {
+	v := m._type
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldType returns the old type value of the Metric.
+// If the Metric object wasn't provided to the builder, the object is fetched
+// from the database.
+// An error is returned if the mutation operation is not UpdateOne, or database query fails.
+func (m *MetricMutation) OldType(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, fmt.Errorf("OldType is allowed only on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, fmt.Errorf("OldType requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldType: %w", err)
+	}
+	return oldValue.Type, nil
+}
+
+// ResetType reset all changes of the "type" field.
+func (m *MetricMutation) ResetType() {
+	m._type = nil
+}
+
+// SetDownsampledUntil sets the downsampled_until field.
+func (m *MetricMutation) SetDownsampledUntil(i int64) {
+	m.downsampled_until = &i
+	m.adddownsampled_until = nil
+}
+
+// DownsampledUntil returns the downsampled_until value in the mutation.
+func (m *MetricMutation) DownsampledUntil() (r int64, exists bool) {
+	v := m.downsampled_until
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldDownsampledUntil returns the old downsampled_until value of the Metric.
+// If the Metric object wasn't provided to the builder, the object is fetched
+// from the database.
+// An error is returned if the mutation operation is not UpdateOne, or database query fails.
+func (m *MetricMutation) OldDownsampledUntil(ctx context.Context) (v int64, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, fmt.Errorf("OldDownsampledUntil is allowed only on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, fmt.Errorf("OldDownsampledUntil requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldDownsampledUntil: %w", err)
+	}
+	return oldValue.DownsampledUntil, nil
+}
+
+// AddDownsampledUntil adds i to downsampled_until.
+func (m *MetricMutation) AddDownsampledUntil(i int64) {
+	if m.adddownsampled_until != nil {
+		*m.adddownsampled_until += i
+	} else {
+		m.adddownsampled_until = &i
+	}
+}
+
+// AddedDownsampledUntil returns the value that was added to the downsampled_until field in this mutation.
+func (m *MetricMutation) AddedDownsampledUntil() (r int64, exists bool) {
+	v := m.adddownsampled_until
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ClearDownsampledUntil clears the value of downsampled_until.
+func (m *MetricMutation) ClearDownsampledUntil() {
+	m.downsampled_until = nil
+	m.adddownsampled_until = nil
+	m.clearedFields[metric.FieldDownsampledUntil] = struct{}{}
+}
+
+// DownsampledUntilCleared returns if the field downsampled_until was cleared in this mutation.
+func (m *MetricMutation) DownsampledUntilCleared() bool {
+	_, ok := m.clearedFields[metric.FieldDownsampledUntil]
+	return ok
+}
+
+// ResetDownsampledUntil reset all changes of the "downsampled_until" field.
+func (m *MetricMutation) ResetDownsampledUntil() {
+	m.downsampled_until = nil
+	m.adddownsampled_until = nil
+	delete(m.clearedFields, metric.FieldDownsampledUntil)
+}
+
+// SetGraphID sets the graph edge to Graph by id.
+func (m *MetricMutation) SetGraphID(id int) {
+	m.graph = &id
+}
+
+// ClearGraph clears the graph edge to Graph.
+func (m *MetricMutation) ClearGraph() {
+	m.clearedgraph = true
+}
+
+// GraphCleared returns if the edge graph was cleared.
+func (m *MetricMutation) GraphCleared() bool {
+	return m.clearedgraph
+}
+
+// GraphID returns the graph id in the mutation.
+func (m *MetricMutation) GraphID() (id int, exists bool) {
+	if m.graph != nil {
+		return *m.graph, true
+	}
+	return
+}
+
+// GraphIDs returns the graph ids in the mutation.
+// Note that ids always returns len(ids) <= 1 for unique edges, and you should use
+// GraphID instead. It exists only for internal usage by the builders.
+func (m *MetricMutation) GraphIDs() (ids []int) {
+	if id := m.graph; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetGraph reset all changes of the "graph" edge.
+func (m *MetricMutation) ResetGraph() {
+	m.graph = nil
+	m.clearedgraph = false
+}
+
+// AddHistogramIDs adds the histograms edge to Histogram by ids.
+func (m *MetricMutation) AddHistogramIDs(ids ...int) {
+	if m.histograms == nil {
+		m.histograms = make(map[int]struct{})
+	}
+	for i := range ids {
+		m.histograms[ids[i]] = struct{}{}
+	}
+}
+
+// ClearHistograms clears the histograms edge to Histogram.
+func (m *MetricMutation) ClearHistograms() {
+	m.clearedhistograms = true
+}
+
+// HistogramsCleared returns if the edge histograms was cleared.
+func (m *MetricMutation) HistogramsCleared() bool {
+	return m.clearedhistograms
+}
+
+// RemoveHistogramIDs removes the histograms edge to Histogram by ids.
+func (m *MetricMutation) RemoveHistogramIDs(ids ...int) {
+	if m.removedhistograms == nil {
+		m.removedhistograms = make(map[int]struct{})
+	}
+	for i := range ids {
+		m.removedhistograms[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedHistograms returns the removed ids of histograms.
+func (m *MetricMutation) RemovedHistogramsIDs() (ids []int) {
+	for id := range m.removedhistograms {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// HistogramsIDs returns the histograms ids in the mutation.
+func (m *MetricMutation) HistogramsIDs() (ids []int) {
+	for id := range m.histograms {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetHistograms reset all changes of the "histograms" edge.
+func (m *MetricMutation) ResetHistograms() {
+	m.histograms = nil
+	m.clearedhistograms = false
+	m.removedhistograms = nil
+}
+
+// AddCounterIDs adds the counters edge to Counter by ids.
+func (m *MetricMutation) AddCounterIDs(ids ...int) {
+	if m.counters == nil {
+		m.counters = make(map[int]struct{})
+	}
+	for i := range ids {
+		m.counters[ids[i]] = struct{}{}
+	}
+}
+
+// ClearCounters clears the counters edge to Counter.
+func (m *MetricMutation) ClearCounters() {
+	m.clearedcounters = true
+}
+
+// CountersCleared returns if the edge counters was cleared.
+func (m *MetricMutation) CountersCleared() bool {
+	return m.clearedcounters
+}
+
+// RemoveCounterIDs removes the counters edge to Counter by ids.
+func (m *MetricMutation) RemoveCounterIDs(ids ...int) {
+	if m.removedcounters == nil {
+		m.removedcounters = make(map[int]struct{})
+	}
+	for i := range ids {
+		m.removedcounters[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedCounters returns the removed ids of counters.
+func (m *MetricMutation) RemovedCountersIDs() (ids []int) {
+	for id := range m.removedcounters {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// CountersIDs returns the counters ids in the mutation.
+func (m *MetricMutation) CountersIDs() (ids []int) {
+	for id := range m.counters {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetCounters reset all changes of the "counters" edge.
+func (m *MetricMutation) ResetCounters() {
+	m.counters = nil
+	m.clearedcounters = false
+	m.removedcounters = nil
+}
+
+// AddGaugeIDs adds the gauges edge to Gauge by ids.
+func (m *MetricMutation) AddGaugeIDs(ids ...int) {
+	if m.gauges == nil {
+		m.gauges = make(map[int]struct{})
+	}
+	for i := range ids {
+		m.gauges[ids[i]] = struct{}{}
+	}
+}
+
+// ClearGauges clears the gauges edge to Gauge.
+func (m *MetricMutation) ClearGauges() {
+	m.clearedgauges = true
+}
+
+// GaugesCleared returns if the edge gauges was cleared.
+func (m *MetricMutation) GaugesCleared() bool {
+	return m.clearedgauges
+}
+
+// RemoveGaugeIDs removes the gauges edge to Gauge by ids.
+func (m *MetricMutation) RemoveGaugeIDs(ids ...int) {
+	if m.removedgauges == nil {
+		m.removedgauges = make(map[int]struct{})
+	}
+	for i := range ids {
+		m.removedgauges[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedGauges returns the removed ids of gauges.
+func (m *MetricMutation) RemovedGaugesIDs() (ids []int) {
+	for id := range m.removedgauges {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// GaugesIDs returns the gauges ids in the mutation.
+func (m *MetricMutation) GaugesIDs() (ids []int) {
+	for id := range m.gauges {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetGauges reset all changes of the "gauges" edge.
+func (m *MetricMutation) ResetGauges() {
+	m.gauges = nil
+	m.clearedgauges = false
+	m.removedgauges = nil
+}
+
+// Op returns the operation name.
+func (m *MetricMutation) Op() Op {
+	return m.op
+}
+
+// Type returns the node type of this mutation (Metric).
+func (m *MetricMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during
+// this mutation. Note that, in order to get all numeric
+// fields that were in/decremented, call AddedFields().
+func (m *MetricMutation) Fields() []string {
+	fields := make([]string, 0, 3)
+	if m.title != nil {
+		fields = append(fields, metric.FieldTitle)
+	}
+	if m._type != nil {
+		fields = append(fields, metric.FieldType)
+	}
+	if m.downsampled_until != nil {
+		fields = append(fields, metric.FieldDownsampledUntil)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name.
+// The second boolean value indicates that this field was
+// not set, or was not define in the schema.
+func (m *MetricMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case metric.FieldTitle:
+		return m.Title()
+	case metric.FieldType:
+		return m.GetType()
+	case metric.FieldDownsampledUntil:
+		return m.DownsampledUntil()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database.
+// An error is returned if the mutation operation is not UpdateOne,
+// or the query to the database was failed.
+func (m *MetricMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case metric.FieldTitle:
+		return m.OldTitle(ctx)
+	case metric.FieldType:
+		return m.OldType(ctx)
+	case metric.FieldDownsampledUntil:
+		return m.OldDownsampledUntil(ctx)
+	}
+	return nil, fmt.Errorf("unknown Metric field %s", name)
+}
+
+// SetField sets the value for the given name. It returns an
+// error if the field is not defined in the schema, or if the
+// type mismatch the field type.
+func (m *MetricMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case metric.FieldTitle:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetTitle(v)
+		return nil
+	case metric.FieldType:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetType(v)
+		return nil
+	case metric.FieldDownsampledUntil:
+		v, ok := value.(int64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetDownsampledUntil(v)
+		return nil
+	}
+	return fmt.Errorf("unknown Metric field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented
+// or decremented during this mutation.
+func (m *MetricMutation) AddedFields() []string {
+	var fields []string
+	if m.adddownsampled_until != nil {
+		fields = append(fields, metric.FieldDownsampledUntil)
+	}
+	return fields
+}
+
+// AddedField returns the numeric value that was in/decremented
+// from a field with the given name. The second value indicates
+// that this field was not set, or was not define in the schema.
+func (m *MetricMutation) AddedField(name string) (ent.Value, bool) {
+	switch name {
+	case metric.FieldDownsampledUntil:
+		return m.AddedDownsampledUntil()
+	}
+	return nil, false
+}
+
+// AddField adds the value for the given name. It returns an
+// error if the field is not defined in the schema, or if the
+// type mismatch the field type.
+func (m *MetricMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	case metric.FieldDownsampledUntil:
+		v, ok := value.(int64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddDownsampledUntil(v)
+		return nil
+	}
+	return fmt.Errorf("unknown Metric numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared
+// during this mutation.
+func (m *MetricMutation) ClearedFields() []string {
+	var fields []string
+	if m.FieldCleared(metric.FieldDownsampledUntil) {
+		fields = append(fields, metric.FieldDownsampledUntil)
+	}
+	return fields
+}
+
+// FieldCleared returns a boolean indicates if this field was
+// cleared in this mutation.
+func (m *MetricMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value for the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *MetricMutation) ClearField(name string) error {
+	switch name {
+	case metric.FieldDownsampledUntil:
+		m.ClearDownsampledUntil()
+		return nil
+	}
+	return fmt.Errorf("unknown Metric nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation regarding the
+// given field name. It returns an error if the field is not
+// defined in the schema.
+func (m *MetricMutation) ResetField(name string) error {
+	switch name {
+	case metric.FieldTitle:
+		m.ResetTitle()
+		return nil
+	case metric.FieldType:
+		m.ResetType()
+		return nil
+	case metric.FieldDownsampledUntil:
+		m.ResetDownsampledUntil()
+		return nil
+	}
+	return fmt.Errorf("unknown Metric field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this
+// mutation.
+func (m *MetricMutation) AddedEdges() []string {
+	edges := make([]string, 0, 4)
+	if m.graph != nil {
+		edges = append(edges, metric.EdgeGraph)
+	}
+	if m.histograms != nil {
+		edges = append(edges, metric.EdgeHistograms)
+	}
+	if m.counters != nil {
+		edges = append(edges, metric.EdgeCounters)
+	}
+	if m.gauges != nil {
+		edges = append(edges, metric.EdgeGauges)
+	}
+	return edges
+}
+
+// AddedIDs returns all ids (to other nodes) that were added for
+// the given edge name.
+func (m *MetricMutation) AddedIDs(name string) []ent.Value {
+	switch name {
+	case metric.EdgeGraph:
+		if id := m.graph; id != nil {
+			return []ent.Value{*id}
+		}
+	case metric.EdgeHistograms:
+		ids := make([]ent.Value, 0, len(m.histograms))
+		for id := range m.histograms {
+			ids = append(ids, id)
+		}
+		return ids
+	case metric.EdgeCounters:
+		ids := make([]ent.Value, 0, len(m.counters))
+		for id := range m.counters {
+			ids = append(ids, id)
+		}
+		return ids
+	case metric.EdgeGauges:
+		ids := make([]ent.Value, 0, len(m.gauges))
+		for id := range m.gauges {
+			ids = append(ids, id)
+		}
+		return ids
+	}
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this
+// mutation.
+func (m *MetricMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 4)
+	if m.removedhistograms != nil {
+		edges = append(edges, metric.EdgeHistograms)
+	}
+	if m.removedcounters != nil {
+		edges = append(edges, metric.EdgeCounters)
+	}
+	if m.removedgauges != nil {
+		edges = append(edges, metric.EdgeGauges)
+	}
+	return edges
+}
+
+// RemovedIDs returns all ids (to other nodes) that were removed for
+// the given edge name.
+func (m *MetricMutation) RemovedIDs(name string) []ent.Value {
+	switch name {
+	case metric.EdgeHistograms:
+		ids := make([]ent.Value, 0, len(m.removedhistograms))
+		for id := range m.removedhistograms {
+			ids = append(ids, id)
+		}
+		return ids
+	case metric.EdgeCounters:
+		ids := make([]ent.Value, 0, len(m.removedcounters))
+		for id := range m.removedcounters {
+			ids = append(ids, id)
+		}
+		return ids
+	case metric.EdgeGauges:
+		ids := make([]ent.Value, 0, len(m.removedgauges))
+		for id := range m.removedgauges {
+			ids = append(ids, id)
+		}
+		return ids
+	}
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this
+// mutation.
+func (m *MetricMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 4)
+	if m.clearedgraph {
+		edges = append(edges, metric.EdgeGraph)
+	}
+	if m.clearedhistograms {
+		edges = append(edges, metric.EdgeHistograms)
+	}
+	if m.clearedcounters {
+		edges = append(edges, metric.EdgeCounters)
+	}
+	if m.clearedgauges {
+		edges = append(edges, metric.EdgeGauges)
+	}
+	return edges
+}
+
+// EdgeCleared returns a boolean indicates if this edge was
+// cleared in this mutation.
+func (m *MetricMutation) EdgeCleared(name string) bool {
+	switch name {
+	case metric.EdgeGraph:
+		return m.clearedgraph
+	case metric.EdgeHistograms:
+		return m.clearedhistograms
+	case metric.EdgeCounters:
+		return m.clearedcounters
+	case metric.EdgeGauges:
+		return m.clearedgauges
+	}
+	return false
+}
+
+// ClearEdge clears the value for the given name. It returns an
+// error if the edge name is not defined in the schema.
+func (m *MetricMutation) ClearEdge(name string) error {
+	switch name {
+	case metric.EdgeGraph:
+		m.ClearGraph()
+		return nil
+	}
+	return fmt.Errorf("unknown Metric unique edge %s", name)
+}
+
+// ResetEdge resets all changes in the mutation regarding the
+// given edge name. It returns an error if the edge is not
+// defined in the schema.
+func (m *MetricMutation) ResetEdge(name string) error {
+	switch name {
+	case metric.EdgeGraph:
+		m.ResetGraph()
+		return nil
+	case metric.EdgeHistograms:
+		m.ResetHistograms()
+		return nil
+	case metric.EdgeCounters:
+		m.ResetCounters()
+		return nil
+	case metric.EdgeGauges:
+		m.ResetGauges()
+		return nil
+	}
+	return fmt.Errorf("unknown Metric edge %s", name)
+}
+
+// ProjectMutation represents an operation that mutate the Projects
+// nodes in the graph.
+type ProjectMutation struct {
+	config
+	op                  Op
+	typ                 string
+	id                  *int
+	name                *string
+	max_queued          *int
+	addmax_queued       *int
+	raw_retention       *int64
+	addraw_retention    *int64
+	rollup_retention    *int64
+	addrollup_retention *int64
+	created_at          *time.Time
+	updated_at          *time.Time
+	clearedFields       map[string]struct{}
+	applications        map[int]struct{}
+	removedapplications map[int]struct{}
+	clearedapplications bool
+	members             map[int]struct{}
+	removedmembers      map[int]struct{}
+	clearedmembers      bool
+	done                bool
+	oldValue            func(context.Context) (*Project, error)
+}
+
+var _ ent.Mutation = (*ProjectMutation)(nil)
+
+// projectOption allows to manage the mutation configuration using functional options.
+type projectOption func(*ProjectMutation)
+
+// newProjectMutation creates new mutation for $n.Name.
+func newProjectMutation(c config, op Op, opts ...projectOption) *ProjectMutation {
+	m := &ProjectMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeProject,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withProjectID sets the id field of the mutation.
+func withProjectID(id int) projectOption {
+	return func(m *ProjectMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *Project
+		)
+		m.oldValue = func(ctx context.Context) (*Project, error) {
+			once.Do(func() {
+				if m.done {
+					err = fmt.Errorf("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().Project.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withProject sets the old Project of the mutation.
+func withProject(node *Project) projectOption {
+	return func(m *ProjectMutation) {
+		m.oldValue = func(context.Context) (*Project, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m ProjectMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m ProjectMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, fmt.Errorf("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// ID returns the id value in the mutation. Note that, the id
+// is available only if it was provided to the builder.
+func (m *ProjectMutation) ID() (id int, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// SetName sets the name field.
+func (m *ProjectMutation) SetName(s string) {
+	m.name = &s
+}
+
+// Name returns the name value in the mutation.
+func (m *ProjectMutation) Name() (r string, exists bool) {
+	v := m.name
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldName returns the old name value of the Project.
+// If the Project object wasn't provided to the builder, the object is fetched
+// from the database.
+// An error is returned if the mutation operation is not UpdateOne, or database query fails.
+func (m *ProjectMutation) OldName(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, fmt.Errorf("OldName is allowed only on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, fmt.Errorf("OldName requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldName: %w", err)
+	}
+	return oldValue.Name, nil
+}
+
+// ResetName reset all changes of the "name" field.
+func (m *ProjectMutation) ResetName() {
+	m.name = nil
+}
+
+// SetMaxQueued sets the max_queued field.
+func (m *ProjectMutation) SetMaxQueued(i int) {
+	m.max_queued = &i
+	m.addmax_queued = nil
+}
+
+// MaxQueued returns the max_queued value in the mutation.
+func (m *ProjectMutation) MaxQueued() (r int, exists bool) {
+	v := m.max_queued
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldMaxQueued returns the old max_queued value of the Project.
+// If the Project object wasn't provided to the builder, the object is fetched
+// from the database.
+// An error is returned if the mutation operation is not UpdateOne, or database query fails.
+func (m *ProjectMutation) OldMaxQueued(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, fmt.Errorf("OldMaxQueued is allowed only on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, fmt.Errorf("OldMaxQueued requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldMaxQueued: %w", err)
+	}
+	return oldValue.MaxQueued, nil
+}
+
+// AddMaxQueued adds i to max_queued.
+func (m *ProjectMutation) AddMaxQueued(i int) {
+	if m.addmax_queued != nil {
+		*m.addmax_queued += i
+	} else {
+		m.addmax_queued = &i
+	}
+}
+
+// AddedMaxQueued returns the value that was added to the max_queued field in this mutation.
+func (m *ProjectMutation) AddedMaxQueued() (r int, exists bool) {
+	v := m.addmax_queued
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetMaxQueued reset all changes of the "max_queued" field.
+func (m *ProjectMutation) ResetMaxQueued() {
+	m.max_queued = nil
+	m.addmax_queued = nil
+}
+
+// SetRawRetention sets the raw_retention field.
+func (m *ProjectMutation) SetRawRetention(i int64) {
+	m.raw_retention = &i
+	m.addraw_retention = nil
+}
+
+// RawRetention returns the raw_retention value in the mutation.
+func (m *ProjectMutation) RawRetention() (r int64, exists bool) {
+	v := m.raw_retention
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldRawRetention returns the old raw_retention value of the Project.
+// If the Project object wasn't provided to the builder, the object is fetched
+// from the database.
+// An error is returned if the mutation operation is not UpdateOne, or database query fails.
+func (m *ProjectMutation) OldRawRetention(ctx context.Context) (v int64, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, fmt.Errorf("OldRawRetention is allowed only on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, fmt.Errorf("OldRawRetention requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldRawRetention: %w", err)
+	}
+	return oldValue.RawRetention, nil
+}
+
+// AddRawRetention adds i to raw_retention.
+func (m *ProjectMutation) AddRawRetention(i int64) {
+	if m.addraw_retention != nil {
+		*m.addraw_retention += i
+	} else {
+		m.addraw_retention = &i
+	}
+}
+
+// AddedRawRetention returns the value that was added to the raw_retention field in this mutation.
+func (m *ProjectMutation) AddedRawRetention() (r int64, exists bool) {
+	v := m.addraw_retention
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetRawRetention reset all changes of the "raw_retention" field.
+func (m *ProjectMutation) ResetRawRetention() {
+	m.raw_retention = nil
+	m.addraw_retention = nil
+}
+
+// SetRollupRetention sets the rollup_retention field.
+func (m *ProjectMutation) SetRollupRetention(i int64) {
+	m.rollup_retention = &i
+	m.addrollup_retention = nil
+}
+
+// RollupRetention returns the rollup_retention value in the mutation.
+func (m *ProjectMutation) RollupRetention() (r int64, exists bool) {
+	v := m.rollup_retention
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldRollupRetention returns the old rollup_retention value of the Project.
+// If the Project object wasn't provided to the builder, the object is fetched
+// from the database.
+// An error is returned if the mutation operation is not UpdateOne, or database query fails.
+func (m *ProjectMutation) OldRollupRetention(ctx context.Context) (v int64, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, fmt.Errorf("OldRollupRetention is allowed only on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, fmt.Errorf("OldRollupRetention requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldRollupRetention: %w", err)
+	}
+	return oldValue.RollupRetention, nil
+}
+
+// AddRollupRetention adds i to rollup_retention.
+func (m *ProjectMutation) AddRollupRetention(i int64) {
+	if m.addrollup_retention != nil {
+		*m.addrollup_retention += i
+	} else {
+		m.addrollup_retention = &i
+	}
+}
+
+// AddedRollupRetention returns the value that was added to the rollup_retention field in this mutation.
+func (m *ProjectMutation) AddedRollupRetention() (r int64, exists bool) {
+	v := m.addrollup_retention
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetRollupRetention reset all changes of the "rollup_retention" field.
+func (m *ProjectMutation) ResetRollupRetention() {
+	m.rollup_retention = nil
+	m.addrollup_retention = nil
+}
+
+// SetCreatedAt sets the created_at field.
+func (m *ProjectMutation) SetCreatedAt(t time.Time) {
+	m.created_at = &t
+}
+
+// CreatedAt returns the created_at value in the mutation.
+func (m *ProjectMutation) CreatedAt() (r time.Time, exists bool) {
+	v := m.created_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCreatedAt returns the old created_at value of the Project.
+// If the Project object wasn't provided to the builder, the object is fetched
+// from the database.
+// An error is returned if the mutation operation is not UpdateOne, or database query fails.
+func (m *ProjectMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, fmt.Errorf("OldCreatedAt is allowed only on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, fmt.Errorf("OldCreatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
+	}
+	return oldValue.CreatedAt, nil
+}
+
+// ResetCreatedAt reset all changes of the "created_at" field.
+func (m *ProjectMutation) ResetCreatedAt() {
+	m.created_at = nil
+}
+
+// SetUpdatedAt sets the updated_at field.
+func (m *ProjectMutation) SetUpdatedAt(t time.Time) {
+	m.updated_at = &t
+}
+
+// UpdatedAt returns the updated_at value in the mutation.
+func (m *ProjectMutation) UpdatedAt() (r time.Time, exists bool) {
+	v := m.updated_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldUpdatedAt returns the old updated_at value of the Project.
+// If the Project object wasn't provided to the builder, the object is fetched
+// from the database.
+// An error is returned if the mutation operation is not UpdateOne, or database query fails.
+func (m *ProjectMutation) OldUpdatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, fmt.Errorf("OldUpdatedAt is allowed only on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, fmt.Errorf("OldUpdatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldUpdatedAt: %w", err)
+	}
+	return oldValue.UpdatedAt, nil
+}
+
+// ResetUpdatedAt reset all changes of the "updated_at" field.
+func (m *ProjectMutation) ResetUpdatedAt() {
+	m.updated_at = nil
+}
+
+// AddApplicationIDs adds the applications edge to Application by ids.
+func (m *ProjectMutation) AddApplicationIDs(ids ...int) {
+	if m.applications == nil {
+		m.applications = make(map[int]struct{})
+	}
+	for i := range ids {
+		m.applications[ids[i]] = struct{}{}
+	}
+}
+
+// ClearApplications clears the applications edge to Application.
+func (m *ProjectMutation) ClearApplications() {
+	m.clearedapplications = true
+}
+
+// ApplicationsCleared returns if the edge applications was cleared.
+func (m *ProjectMutation) ApplicationsCleared() bool {
+	return m.clearedapplications
+}
+
+// RemoveApplicationIDs removes the applications edge to Application by ids.
+func (m *ProjectMutation) RemoveApplicationIDs(ids ...int) {
+	if m.removedapplications == nil {
+		m.removedapplications = make(map[int]struct{})
+	}
+	for i := range ids {
+		m.removedapplications[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedApplications returns the removed ids of applications.
+func (m *ProjectMutation) RemovedApplicationsIDs() (ids []int) {
+	for id := range m.removedapplications {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ApplicationsIDs returns the applications ids in the mutation.
+func (m *ProjectMutation) ApplicationsIDs() (ids []int) {
+	for id := range m.applications {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetApplications reset all changes of the "applications" edge.
+func (m *ProjectMutation) ResetApplications() {
+	m.applications = nil
+	m.clearedapplications = false
+	m.removedapplications = nil
+}
+
+// AddMemberIDs adds the members edge to User by ids.
+func (m *ProjectMutation) AddMemberIDs(ids ...int) {
+	if m.members == nil {
+		m.members = make(map[int]struct{})
+	}
+	for i := range ids {
+		m.members[ids[i]] = struct{}{}
+	}
+}
+
+// ClearMembers clears the members edge to User.
+func (m *ProjectMutation) ClearMembers() {
+	m.clearedmembers = true
+}
+
+// MembersCleared returns if the edge members was cleared.
+func (m *ProjectMutation) MembersCleared() bool {
+	return m.clearedmembers
+}
+
+// RemoveMemberIDs removes the members edge to User by ids.
+func (m *ProjectMutation) RemoveMemberIDs(ids ...int) {
+	if m.removedmembers == nil {
+		m.removedmembers = make(map[int]struct{})
+	}
+	for i := range ids {
+		m.removedmembers[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedMembers returns the removed ids of members.
+func (m *ProjectMutation) RemovedMembersIDs() (ids []int) {
+	for id := range m.removedmembers {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// MembersIDs returns the members ids in the mutation.
+func (m *ProjectMutation) MembersIDs() (ids []int) {
+	for id := range m.members {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetMembers reset all changes of the "members" edge.
+func (m *ProjectMutation) ResetMembers() {
+	m.members = nil
+	m.clearedmembers = false
+	m.removedmembers = nil
+}
+
+// Op returns the operation name.
+func (m *ProjectMutation) Op() Op {
+	return m.op
+}
+
+// Type returns the node type of this mutation (Project).
+func (m *ProjectMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during
+// this mutation. Note that, in order to get all numeric
+// fields that were in/decremented, call AddedFields().
+func (m *ProjectMutation) Fields() []string {
+	fields := make([]string, 0, 6)
+	if m.name != nil {
+		fields = append(fields, project.FieldName)
+	}
+	if m.max_queued != nil {
+		fields = append(fields, project.FieldMaxQueued)
+	}
+	if m.raw_retention != nil {
+		fields = append(fields, project.FieldRawRetention)
+	}
+	if m.rollup_retention != nil {
+		fields = append(fields, project.FieldRollupRetention)
+	}
+	if m.created_at != nil {
+		fields = append(fields, project.FieldCreatedAt)
+	}
+	if m.updated_at != nil {
+		fields = append(fields, project.FieldUpdatedAt)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name.
+// The second boolean value indicates that this field was
+// not set, or was not define in the schema.
+func (m *ProjectMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case project.FieldName:
+		return m.Name()
+	case project.FieldMaxQueued:
+		return m.MaxQueued()
+	case project.FieldRawRetention:
+		return m.RawRetention()
+	case project.FieldRollupRetention:
+		return m.RollupRetention()
+	case project.FieldCreatedAt:
+		return m.CreatedAt()
+	case project.FieldUpdatedAt:
+		return m.UpdatedAt()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database.
+// An error is returned if the mutation operation is not UpdateOne,
+// or the query to the database was failed.
+func (m *ProjectMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case project.FieldName:
+		return m.OldName(ctx)
+	case project.FieldMaxQueued:
+		return m.OldMaxQueued(ctx)
+	case project.FieldRawRetention:
+		return m.OldRawRetention(ctx)
+	case project.FieldRollupRetention:
+		return m.OldRollupRetention(ctx)
+	case project.FieldCreatedAt:
+		return m.OldCreatedAt(ctx)
+	case project.FieldUpdatedAt:
+		return m.OldUpdatedAt(ctx)
+	}
+	return nil, fmt.Errorf("unknown Project field %s", name)
+}
+
+// SetField sets the value for the given name. It returns an
+// error if the field is not defined in the schema, or if the
+// type mismatch the field type.
+func (m *ProjectMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case project.FieldName:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetName(v)
+		return nil
+	case project.FieldMaxQueued:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetMaxQueued(v)
+		return nil
+	case project.FieldRawRetention:
+		v, ok := value.(int64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetRawRetention(v)
+		return nil
+	case project.FieldRollupRetention:
+		v, ok := value.(int64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetRollupRetention(v)
+		return nil
+	case project.FieldCreatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCreatedAt(v)
+		return nil
+	case project.FieldUpdatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetUpdatedAt(v)
+		return nil
+	}
+	return fmt.Errorf("unknown Project field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented
+// or decremented during this mutation.
+func (m *ProjectMutation) AddedFields() []string {
+	var fields []string
+	if m.addmax_queued != nil {
+		fields = append(fields, project.FieldMaxQueued)
+	}
+	if m.addraw_retention != nil {
+		fields = append(fields, project.FieldRawRetention)
+	}
+	if m.addrollup_retention != nil {
+		fields = append(fields, project.FieldRollupRetention)
+	}
+	return fields
+}
+
+// AddedField returns the numeric value that was in/decremented
+// from a field with the given name. The second value indicates
+// that this field was not set, or was not define in the schema.
+func (m *ProjectMutation) AddedField(name string) (ent.Value, bool) {
+	switch name {
+	case project.FieldMaxQueued:
+		return m.AddedMaxQueued()
+	case project.FieldRawRetention:
+		return m.AddedRawRetention()
+	case project.FieldRollupRetention:
+		return m.AddedRollupRetention()
+	}
+	return nil, false
+}
+
+// AddField adds the value for the given name. It returns an
+// error if the field is not defined in the schema, or if the
+// type mismatch the field type.
+func (m *ProjectMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	case project.FieldMaxQueued:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddMaxQueued(v)
+		return nil
+	case project.FieldRawRetention:
+		v, ok := value.(int64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddRawRetention(v)
+		return nil
+	case project.FieldRollupRetention:
+		v, ok := value.(int64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddRollupRetention(v)
+		return nil
+	}
+	return fmt.Errorf("unknown Project numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared
+// during this mutation.
+func (m *ProjectMutation) ClearedFields() []string {
+	return nil
+}
+
+// FieldCleared returns a boolean indicates if this field was
+// cleared in this mutation.
+func (m *ProjectMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value for the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *ProjectMutation) ClearField(name string) error {
+	return fmt.Errorf("unknown Project nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation regarding the
+// given field name. It returns an error if the field is not
+// defined in the schema.
+func (m *ProjectMutation) ResetField(name string) error {
+	switch name {
+	case project.FieldName:
+		m.ResetName()
+		return nil
+	case project.FieldMaxQueued:
+		m.ResetMaxQueued()
+		return nil
+	case project.FieldRawRetention:
+		m.ResetRawRetention()
+		return nil
+	case project.FieldRollupRetention:
+		m.ResetRollupRetention()
+		return nil
+	case project.FieldCreatedAt:
+		m.ResetCreatedAt()
+		return nil
+	case project.FieldUpdatedAt:
+		m.ResetUpdatedAt()
+		return nil
+	}
+	return fmt.Errorf("unknown Project field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this
+// mutation.
+func (m *ProjectMutation) AddedEdges() []string {
+	edges := make([]string, 0, 2)
+	if m.applications != nil {
+		edges = append(edges, project.EdgeApplications)
+	}
+	if m.members != nil {
+		edges = append(edges, project.EdgeMembers)
+	}
+	return edges
+}
+
+// AddedIDs returns all ids (to other nodes) that were added for
+// the given edge name.
+func (m *ProjectMutation) AddedIDs(name string) []ent.Value {
+	switch name {
+	case project.EdgeApplications:
+		ids := make([]ent.Value, 0, len(m.applications))
+		for id := range m.applications {
+			ids = append(ids, id)
+		}
+		return ids
+	case project.EdgeMembers:
+		ids := make([]ent.Value, 0, len(m.members))
+		for id := range m.members {
+			ids = append(ids, id)
+		}
+		return ids
+	}
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this
+// mutation.
+func (m *ProjectMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 2)
+	if m.removedapplications != nil {
+		edges = append(edges, project.EdgeApplications)
+	}
+	if m.removedmembers != nil {
+		edges = append(edges, project.EdgeMembers)
+	}
+	return edges
+}
+
+// RemovedIDs returns all ids (to other nodes) that were removed for
+// the given edge name.
+func (m *ProjectMutation) RemovedIDs(name string) []ent.Value {
+	switch name {
+	case project.EdgeApplications:
+		ids := make([]ent.Value, 0, len(m.removedapplications))
+		for id := range m.removedapplications {
+			ids = append(ids, id)
+		}
+		return ids
+	case project.EdgeMembers:
+		ids := make([]ent.Value, 0, len(m.removedmembers))
+		for id := range m.removedmembers {
+			ids = append(ids, id)
+		}
+		return ids
+	}
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this
+// mutation.
+func (m *ProjectMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 2)
+	if m.clearedapplications {
+		edges = append(edges, project.EdgeApplications)
+	}
+	if m.clearedmembers {
+		edges = append(edges, project.EdgeMembers)
+	}
+	return edges
+}
+
+// EdgeCleared returns a boolean indicates if this edge was
+// cleared in this mutation.
+func (m *ProjectMutation) EdgeCleared(name string) bool {
+	switch name {
+	case project.EdgeApplications:
+		return m.clearedapplications
+	case project.EdgeMembers:
+		return m.clearedmembers
+	}
+	return false
+}
+
+// ClearEdge clears the value for the given name. It returns an
+// error if the edge name is not defined in the schema.
+func (m *ProjectMutation) ClearEdge(name string) error {
+	switch name {
+	}
+	return fmt.Errorf("unknown Project unique edge %s", name)
+}
+
+// ResetEdge resets all changes in the mutation regarding the
+// given edge name. It returns an error if the edge is not
+// defined in the schema.
+func (m *ProjectMutation) ResetEdge(name string) error {
+	switch name {
+	case project.EdgeApplications:
+		m.ResetApplications()
+		return nil
+	case project.EdgeMembers:
+		m.ResetMembers()
+		return nil
+	}
+	return fmt.Errorf("unknown Project edge %s", name)
+}
+
+// SubscriptionMutation represents an operation that mutate the Subscriptions
+// nodes in the graph.
+type SubscriptionMutation struct {
+	config
+	op                Op
+	typ               string
+	id                *int
+	application_id    *int
+	addapplication_id *int
+	tag               *string
+	created_at        *time.Time
+	clearedFields     map[string]struct{}
+	user              *int
+	cleareduser       bool
+	done              bool
+	oldValue          func(context.Context) (*Subscription, error)
+}
+
+var _ ent.Mutation = (*SubscriptionMutation)(nil)
+
+// subscriptionOption allows to manage the mutation configuration using functional options.
+type subscriptionOption func(*SubscriptionMutation)
+
+// newSubscriptionMutation creates new mutation for $n.Name.
+func newSubscriptionMutation(c config, op Op, opts ...subscriptionOption) *SubscriptionMutation {
+	m := &SubscriptionMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeSubscription,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withSubscriptionID sets the id field of the mutation.
+func withSubscriptionID(id int) subscriptionOption {
+	return func(m *SubscriptionMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *Subscription
+		)
+		m.oldValue = func(ctx context.Context) (*Subscription, error) {
+			once.Do(func() {
+				if m.done {
+					err = fmt.Errorf("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().Subscription.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withSubscription sets the old Subscription of the mutation.
+func withSubscription(node *Subscription) subscriptionOption {
+	return func(m *SubscriptionMutation) {
+		m.oldValue = func(context.Context) (*Subscription, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m SubscriptionMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m SubscriptionMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, fmt.Errorf("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// ID returns the id value in the mutation. Note that, the id
+// is available only if it was provided to the builder.
+func (m *SubscriptionMutation) ID() (id int, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// SetApplicationID sets the application_id field.
+func (m *SubscriptionMutation) SetApplicationID(i int) {
+	m.application_id = &i
+	m.addapplication_id = nil
+}
+
+// ApplicationID returns the application_id value in the mutation.
+func (m *SubscriptionMutation) ApplicationID() (r int, exists bool) {
+	v := m.application_id
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldApplicationID returns the old application_id value of the Subscription.
+// If the Subscription object wasn't provided to the builder, the object is fetched
+// from the database.
+// An error is returned if the mutation operation is not UpdateOne, or database query fails.
+func (m *SubscriptionMutation) OldApplicationID(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, fmt.Errorf("OldApplicationID is allowed only on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, fmt.Errorf("OldApplicationID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldApplicationID: %w", err)
+	}
+	return oldValue.ApplicationID, nil
+}
+
+// AddApplicationID adds i to application_id.
+func (m *SubscriptionMutation) AddApplicationID(i int) {
+	if m.addapplication_id != nil {
+		*m.addapplication_id += i
+	} else {
+		m.addapplication_id = &i
+	}
+}
+
+// AddedApplicationID returns the value that was added to the application_id field in this mutation.
+func (m *SubscriptionMutation) AddedApplicationID() (r int, exists bool) {
+	v := m.addapplication_id
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ClearApplicationID clears the value of application_id.
+func (m *SubscriptionMutation) ClearApplicationID() {
+	m.application_id = nil
+	m.addapplication_id = nil
+	m.clearedFields[subscription.FieldApplicationID] = struct{}{}
+}
+
+// ApplicationIDCleared returns if the field application_id was cleared in this mutation.
+func (m *SubscriptionMutation) ApplicationIDCleared() bool {
+	_, ok := m.clearedFields[subscription.FieldApplicationID]
+	return ok
+}
+
+// ResetApplicationID reset all changes of the "application_id" field.
+func (m *SubscriptionMutation) ResetApplicationID() {
+	m.application_id = nil
+	m.addapplication_id = nil
+	delete(m.clearedFields, subscription.FieldApplicationID)
+}
+
+// SetTag sets the tag field.
+func (m *SubscriptionMutation) SetTag(s string) {
+	m.tag = &s
+}
+
+// Tag returns the tag value in the mutation.
+func (m *SubscriptionMutation) Tag() (r string, exists bool) {
+	v := m.tag
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldTag returns the old tag value of the Subscription.
+// If the Subscription object wasn't provided to the builder, the object is fetched
+// from the database.
+// An error is returned if the mutation operation is not UpdateOne, or database query fails.
+func (m *SubscriptionMutation) OldTag(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, fmt.Errorf("OldTag is allowed only on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, fmt.Errorf("OldTag requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldTag: %w", err)
+	}
+	return oldValue.Tag, nil
+}
+
+// ClearTag clears the value of tag.
+func (m *SubscriptionMutation) ClearTag() {
+	m.tag = nil
+	m.clearedFields[subscription.FieldTag] = struct{}{}
+}
+
+// TagCleared returns if the field tag was cleared in this mutation.
+func (m *SubscriptionMutation) TagCleared() bool {
+	_, ok := m.clearedFields[subscription.FieldTag]
+	return ok
+}
+
+// ResetTag reset all changes of the "tag" field.
+func (m *SubscriptionMutation) ResetTag() {
+	m.tag = nil
+	delete(m.clearedFields, subscription.FieldTag)
+}
+
+// SetCreatedAt sets the created_at field.
+func (m *SubscriptionMutation) SetCreatedAt(t time.Time) {
+	m.created_at = &t
+}
+
+// CreatedAt returns the created_at value in the mutation.
+func (m *SubscriptionMutation) CreatedAt() (r time.Time, exists bool) {
+	v := m.created_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCreatedAt returns the old created_at value of the Subscription.
+// If the Subscription object wasn't provided to the builder, the object is fetched
+// from the database.
+// An error is returned if the mutation operation is not UpdateOne, or database query fails.
+func (m *SubscriptionMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, fmt.Errorf("OldCreatedAt is allowed only on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, fmt.Errorf("OldCreatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
+	}
+	return oldValue.CreatedAt, nil
+}
+
+// ResetCreatedAt reset all changes of the "created_at" field.
+func (m *SubscriptionMutation) ResetCreatedAt() {
+	m.created_at = nil
+}
+
+// SetUserID sets the user edge to User by id.
+func (m *SubscriptionMutation) SetUserID(id int) {
+	m.user = &id
+}
+
+// ClearUser clears the user edge to User.
+func (m *SubscriptionMutation) ClearUser() {
+	m.cleareduser = true
+}
+
+// UserCleared returns if the edge user was cleared.
+func (m *SubscriptionMutation) UserCleared() bool {
+	return m.cleareduser
+}
+
+// UserID returns the user id in the mutation.
+func (m *SubscriptionMutation) UserID() (id int, exists bool) {
+	if m.user != nil {
+		return *m.user, true
+	}
+	return
+}
+
+// UserIDs returns the user ids in the mutation.
+// Note that ids always returns len(ids) <= 1 for unique edges, and you should use
+// UserID instead. It exists only for internal usage by the builders.
+func (m *SubscriptionMutation) UserIDs() (ids []int) {
+	if id := m.user; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetUser reset all changes of the "user" edge.
+func (m *SubscriptionMutation) ResetUser() {
+	m.user = nil
+	m.cleareduser = false
+}
+
+// Op returns the operation name.
+func (m *SubscriptionMutation) Op() Op {
+	return m.op
+}
+
+// Type returns the node type of this mutation (Subscription).
+func (m *SubscriptionMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during
+// this mutation. Note that, in order to get all numeric
+// fields that were in/decremented, call AddedFields().
+func (m *SubscriptionMutation) Fields() []string {
+	fields := make([]string, 0, 3)
+	if m.application_id != nil {
+		fields = append(fields, subscription.FieldApplicationID)
+	}
+	if m.tag != nil {
+		fields = append(fields, subscription.FieldTag)
+	}
+	if m.created_at != nil {
+		fields = append(fields, subscription.FieldCreatedAt)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name.
+// The second boolean value indicates that this field was
+// not set, or was not define in the schema.
+func (m *SubscriptionMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case subscription.FieldApplicationID:
+		return m.ApplicationID()
+	case subscription.FieldTag:
+		return m.Tag()
+	case subscription.FieldCreatedAt:
+		return m.CreatedAt()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database.
+// An error is returned if the mutation operation is not UpdateOne,
+// or the query to the database was failed.
+func (m *SubscriptionMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case subscription.FieldApplicationID:
+		return m.OldApplicationID(ctx)
+	case subscription.FieldTag:
+		return m.OldTag(ctx)
+	case subscription.FieldCreatedAt:
+		return m.OldCreatedAt(ctx)
+	}
+	return nil, fmt.Errorf("unknown Subscription field %s", name)
+}
+
+// SetField sets the value for the given name. It returns an
+// error if the field is not defined in the schema, or if the
+// type mismatch the field type.
+func (m *SubscriptionMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case subscription.FieldApplicationID:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetApplicationID(v)
+		return nil
+	case subscription.FieldTag:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetTag(v)
+		return nil
+	case subscription.FieldCreatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCreatedAt(v)
+		return nil
+	}
+	return fmt.Errorf("unknown Subscription field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented
+// or decremented during this mutation.
+func (m *SubscriptionMutation) AddedFields() []string {
+	var fields []string
+	if m.addapplication_id != nil {
+		fields = append(fields, subscription.FieldApplicationID)
+	}
+	return fields
+}
+
+// AddedField returns the numeric value that was in/decremented
+// from a field with the given name. The second value indicates
+// that this field was not set, or was not define in the schema.
+func (m *SubscriptionMutation) AddedField(name string) (ent.Value, bool) {
+	switch name {
+	case subscription.FieldApplicationID:
+		return m.AddedApplicationID()
+	}
+	return nil, false
+}
+
+// AddField adds the value for the given name. It returns an
+// error if the field is not defined in the schema, or if the
+// type mismatch the field type.
+func (m *SubscriptionMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	case subscription.FieldApplicationID:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddApplicationID(v)
+		return nil
+	}
+	return fmt.Errorf("unknown Subscription numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared
+// during this mutation.
+func (m *SubscriptionMutation) ClearedFields() []string {
+	var fields []string
+	if m.FieldCleared(subscription.FieldApplicationID) {
+		fields = append(fields, subscription.FieldApplicationID)
+	}
+	if m.FieldCleared(subscription.FieldTag) {
+		fields = append(fields, subscription.FieldTag)
+	}
+	return fields
+}
+
+// FieldCleared returns a boolean indicates if this field was
+// cleared in this mutation.
+func (m *SubscriptionMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value for the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *SubscriptionMutation) ClearField(name string) error {
+	switch name {
+	case subscription.FieldApplicationID:
+		m.ClearApplicationID()
+		return nil
+	case subscription.FieldTag:
+		m.ClearTag()
+		return nil
+	}
+	return fmt.Errorf("unknown Subscription nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation regarding the
+// given field name. It returns an error if the field is not
+// defined in the schema.
+func (m *SubscriptionMutation) ResetField(name string) error {
+	switch name {
+	case subscription.FieldApplicationID:
+		m.ResetApplicationID()
+		return nil
+	case subscription.FieldTag:
+		m.ResetTag()
+		return nil
+	case subscription.FieldCreatedAt:
+		m.ResetCreatedAt()
+		return nil
+	}
+	return fmt.Errorf("unknown Subscription field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this
+// mutation.
+func (m *SubscriptionMutation) AddedEdges() []string {
+	edges := make([]string, 0, 1)
+	if m.user != nil {
+		edges = append(edges, subscription.EdgeUser)
+	}
+	return edges
+}
+
+// AddedIDs returns all ids (to other nodes) that were added for
+// the given edge name.
+func (m *SubscriptionMutation) AddedIDs(name string) []ent.Value {
+	switch name {
+	case subscription.EdgeUser:
+		if id := m.user; id != nil {
+			return []ent.Value{*id}
+		}
+	}
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this
+// mutation.
+func (m *SubscriptionMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 1)
+	return edges
+}
+
+// RemovedIDs returns all ids (to other nodes) that were removed for
+// the given edge name.
+func (m *SubscriptionMutation) RemovedIDs(name string) []ent.Value {
+	switch name {
+	}
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this
+// mutation.
+func (m *SubscriptionMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 1)
+	if m.cleareduser {
+		edges = append(edges, subscription.EdgeUser)
+	}
+	return edges
+}
+
+// EdgeCleared returns a boolean indicates if this edge was
+// cleared in this mutation.
+func (m *SubscriptionMutation) EdgeCleared(name string) bool {
+	switch name {
+	case subscription.EdgeUser:
+		return m.cleareduser
+	}
+	return false
+}
+
+// ClearEdge clears the value for the given name. It returns an
+// error if the edge name is not defined in the schema.
+func (m *SubscriptionMutation) ClearEdge(name string) error {
+	switch name {
+	case subscription.EdgeUser:
+		m.ClearUser()
+		return nil
+	}
+	return fmt.Errorf("unknown Subscription unique edge %s", name)
+}
+
+// ResetEdge resets all changes in the mutation regarding the
+// given edge name. It returns an error if the edge is not
+// defined in the schema.
+func (m *SubscriptionMutation) ResetEdge(name string) error {
+	switch name {
+	case subscription.EdgeUser:
+		m.ResetUser()
+		return nil
+	}
+	return fmt.Errorf("unknown Subscription edge %s", name)
+}
+
+// TagMutation represents an operation that mutate the Tags
+// nodes in the graph.
+type TagMutation struct {
+	config
+	op                 Op
+	typ                string
+	id                 *int
+	name               *string
+	clearedFields      map[string]struct{}
+	application        *int
+	clearedapplication bool
+	done               bool
+	oldValue           func(context.Context) (*Tag, error)
+}
+
+var _ ent.Mutation = (*TagMutation)(nil)
+
+// tagOption allows to manage the mutation configuration using functional options.
+type tagOption func(*TagMutation)
+
+// newTagMutation creates new mutation for $n.Name.
+func newTagMutation(c config, op Op, opts ...tagOption) *TagMutation {
+	m := &TagMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeTag,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withTagID sets the id field of the mutation.
+func withTagID(id int) tagOption {
+	return func(m *TagMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *Tag
+		)
+		m.oldValue = func(ctx context.Context) (*Tag, error) {
+			once.Do(func() {
+				if m.done {
+					err = fmt.Errorf("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().Tag.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withTag sets the old Tag of the mutation.
+func withTag(node *Tag) tagOption {
+	return func(m *TagMutation) {
+		m.oldValue = func(context.Context) (*Tag, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m TagMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m TagMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, fmt.Errorf("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// ID returns the id value in the mutation. Note that, the id
+// is available only if it was provided to the builder.
+func (m *TagMutation) ID() (id int, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// SetName sets the name field.
+func (m *TagMutation) SetName(s string) {
+	m.name = &s
+}
+
+// Name returns the name value in the mutation.
+func (m *TagMutation) Name() (r string, exists bool) {
+	v := m.name
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldName returns the old name value of the Tag.
+// If the Tag object wasn't provided to the builder, the object is fetched
+// from the database.
+// An error is returned if the mutation operation is not UpdateOne, or database query fails.
+func (m *TagMutation) OldName(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, fmt.Errorf("OldName is allowed only on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, fmt.Errorf("OldName requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldName: %w", err)
+	}
+	return oldValue.Name, nil
+}
+
+// ResetName reset all changes of the "name" field.
+func (m *TagMutation) ResetName() {
+	m.name = nil
+}
+
+// SetApplicationID sets the application edge to Application by id.
+func (m *TagMutation) SetApplicationID(id int) {
+	m.application = &id
+}
+
+// ClearApplication clears the application edge to Application.
+func (m *TagMutation) ClearApplication() {
+	m.clearedapplication = true
+}
+
+// ApplicationCleared returns if the edge application was cleared.
+func (m *TagMutation) ApplicationCleared() bool {
+	return m.clearedapplication
+}
+
+// ApplicationID returns the application id in the mutation.
+func (m *TagMutation) ApplicationID() (id int, exists bool) {
+	if m.application != nil {
+		return *m.application, true
+	}
+	return
+}
+
+// ApplicationIDs returns the application ids in the mutation.
+// Note that ids always returns len(ids) <= 1 for unique edges, and you should use
+// ApplicationID instead. It exists only for internal usage by the builders.
+func (m *TagMutation) ApplicationIDs() (ids []int) {
+	if id := m.application; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetApplication reset all changes of the "application" edge.
+func (m *TagMutation) ResetApplication() {
+	m.application = nil
+	m.clearedapplication = false
+}
+
+// Op returns the operation name.
+func (m *TagMutation) Op() Op {
+	return m.op
+}
+
+// Type returns the node type of this mutation (Tag).
+func (m *TagMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during
+// this mutation. Note that, in order to get all numeric
+// fields that were in/decremented, call AddedFields().
+func (m *TagMutation) Fields() []string {
+	fields := make([]string, 0, 1)
+	if m.name != nil {
+		fields = append(fields, tag.FieldName)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name.
+// The second boolean value indicates that this field was
+// not set, or was not define in the schema.
+func (m *TagMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case tag.FieldName:
+		return m.Name()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database.
+// An error is returned if the mutation operation is not UpdateOne,
+// or the query to the database was failed.
+func (m *TagMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case tag.FieldName:
+		return m.OldName(ctx)
+	}
+	return nil, fmt.Errorf("unknown Tag field %s", name)
+}
+
+// SetField sets the value for the given name. It returns an
+// error if the field is not defined in the schema, or if the
+// type mismatch the field type.
+func (m *TagMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case tag.FieldName:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetName(v)
+		return nil
+	}
+	return fmt.Errorf("unknown Tag field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented
+// or decremented during this mutation.
+func (m *TagMutation) AddedFields() []string {
+	return nil
+}
+
+// AddedField returns the numeric value that was in/decremented
+// from a field with the given name. The second value indicates
+// that this field was not set, or was not define in the schema.
+func (m *TagMutation) AddedField(name string) (ent.Value, bool) {
+	return nil, false
+}
+
+// AddField adds the value for the given name. It returns an
+// error if the field is not defined in the schema, or if the
+// type mismatch the field type.
+func (m *TagMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	}
+	return fmt.Errorf("unknown Tag numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared
+// during this mutation.
+func (m *TagMutation) ClearedFields() []string {
+	return nil
+}
+
+// FieldCleared returns a boolean indicates if this field was
+// cleared in this mutation.
+func (m *TagMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value for the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *TagMutation) ClearField(name string) error {
+	return fmt.Errorf("unknown Tag nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation regarding the
+// given field name. It returns an error if the field is not
+// defined in the schema.
+func (m *TagMutation) ResetField(name string) error {
+	switch name {
+	case tag.FieldName:
+		m.ResetName()
+		return nil
+	}
+	return fmt.Errorf("unknown Tag field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this
+// mutation.
+func (m *TagMutation) AddedEdges() []string {
+	edges := make([]string, 0, 1)
+	if m.application != nil {
+		edges = append(edges, tag.EdgeApplication)
+	}
+	return edges
+}
+
+// AddedIDs returns all ids (to other nodes) that were added for
+// the given edge name.
+func (m *TagMutation) AddedIDs(name string) []ent.Value {
+	switch name {
+	case tag.EdgeApplication:
+		if id := m.application; id != nil {
+			return []ent.Value{*id}
+		}
+	}
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this
+// mutation.
+func (m *TagMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 1)
+	return edges
+}
+
+// RemovedIDs returns all ids (to other nodes) that were removed for
+// the given edge name.
+func (m *TagMutation) RemovedIDs(name string) []ent.Value {
+	switch name {
+	}
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this
+// mutation.
+func (m *TagMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 1)
+	if m.clearedapplication {
+		edges = append(edges, tag.EdgeApplication)
+	}
+	return edges
+}
+
+// EdgeCleared returns a boolean indicates if this edge was
+// cleared in this mutation.
+func (m *TagMutation) EdgeCleared(name string) bool {
+	switch name {
+	case tag.EdgeApplication:
+		return m.clearedapplication
+	}
+	return false
+}
+
+// ClearEdge clears the value for the given name. It returns an
+// error if the edge name is not defined in the schema.
+func (m *TagMutation) ClearEdge(name string) error {
+	switch name {
+	case tag.EdgeApplication:
+		m.ClearApplication()
+		return nil
+	}
+	return fmt.Errorf("unknown Tag unique edge %s", name)
+}
+
+// ResetEdge resets all changes in the mutation regarding the
+// given edge name. It returns an error if the edge is not
+// defined in the schema.
+func (m *TagMutation) ResetEdge(name string) error {
+	switch name {
+	case tag.EdgeApplication:
+		m.ResetApplication()
+		return nil
+	}
+	return fmt.Errorf("unknown Tag edge %s", name)
+}
+
+// UserMutation represents an operation that mutate the Users
+// nodes in the graph.
+type UserMutation struct {
+	config
+	op                   Op
+	typ                  string
+	id                   *int
+	username             *string
+	password_hash        *string
+	role                 *user.Role
+	email                *string
+	created_at           *time.Time
+	updated_at           *time.Time
+	clearedFields        map[string]struct{}
+	tokens               map[int]struct{}
+	removedtokens        map[int]struct{}
+	clearedtokens        bool
+	subscriptions        map[int]struct{}
+	removedsubscriptions map[int]struct{}
+	clearedsubscriptions bool
+	projects             map[int]struct{}
+	removedprojects      map[int]struct{}
+	clearedprojects      bool
+	done                 bool
+	oldValue             func(context.Context) (*User, error)
+}
+
+var _ ent.Mutation = (*UserMutation)(nil)
+
+// userOption allows to manage the mutation configuration using functional options.
+type userOption func(*UserMutation)
+
+// newUserMutation creates new mutation for $n.Name.
+func newUserMutation(c config, op Op, opts ...userOption) *UserMutation {
+	m := &UserMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeUser,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withUserID sets the id field of the mutation.
+func withUserID(id int) userOption {
+	return func(m *UserMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *User
+		)
+		m.oldValue = func(ctx context.Context) (*User, error) {
+			once.Do(func() {
+				if m.done {
+					err = fmt.Errorf("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().User.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withUser sets the old User of the mutation.
+func withUser(node *User) userOption {
+	return func(m *UserMutation) {
+		m.oldValue = func(context.Context) (*User, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m UserMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m UserMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, fmt.Errorf("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// ID returns the id value in the mutation. Note that, the id
+// is available only if it was provided to the builder.
+func (m *UserMutation) ID() (id int, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// SetUsername sets the username field.
+func (m *UserMutation) SetUsername(s string) {
+	m.username = &s
+}
+
+// Username returns the username value in the mutation.
+func (m *UserMutation) Username() (r string, exists bool) {
+	v := m.username
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldUsername returns the old username value of the User.
+// If the User object wasn't provided to the builder, the object is fetched
+// from the database.
+// An error is returned if the mutation operation is not UpdateOne, or database query fails.
+func (m *UserMutation) OldUsername(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, fmt.Errorf("OldUsername is allowed only on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, fmt.Errorf("OldUsername requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldUsername: %w", err)
+	}
+	return oldValue.Username, nil
+}
+
+// ResetUsername reset all changes of the "username" field.
+func (m *UserMutation) ResetUsername() {
+	m.username = nil
+}
+
+// SetPasswordHash sets the password_hash field.
+func (m *UserMutation) SetPasswordHash(s string) {
+	m.password_hash = &s
+}
+
+// PasswordHash returns the password_hash value in the mutation.
+func (m *UserMutation) PasswordHash() (r string, exists bool) {
+	v := m.password_hash
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldPasswordHash returns the old password_hash value of the User.
+// If the User object wasn't provided to the builder, the object is fetched
+// from the database.
+// An error is returned if the mutation operation is not UpdateOne, or database query fails.
+func (m *UserMutation) OldPasswordHash(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, fmt.Errorf("OldPasswordHash is allowed only on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, fmt.Errorf("OldPasswordHash requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldPasswordHash: %w", err)
+	}
+	return oldValue.PasswordHash, nil
+}
+
+// ResetPasswordHash reset all changes of the "password_hash" field.
+func (m *UserMutation) ResetPasswordHash() {
+	m.password_hash = nil
+}
+
+// SetRole sets the role field.
+func (m *UserMutation) SetRole(u user.Role) {
+	m.role = &u
+}
+
+// Role returns the role value in the mutation.
+func (m *UserMutation) Role() (r user.Role, exists bool) {
+	v := m.role
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldRole returns the old role value of the User.
+// If the User object wasn't provided to the builder, the object is fetched
+// from the database.
+// An error is returned if the mutation operation is not UpdateOne, or database query fails.
+func (m *UserMutation) OldRole(ctx context.Context) (v user.Role, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, fmt.Errorf("OldRole is allowed only on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, fmt.Errorf("OldRole requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldRole: %w", err)
+	}
+	return oldValue.Role, nil
+}
+
+// ResetRole reset all changes of the "role" field.
+func (m *UserMutation) ResetRole() {
+	m.role = nil
+}
+
+// SetEmail sets the email field.
+func (m *UserMutation) SetEmail(s string) {
+	m.email = &s
+}
+
+// Email returns the email value in the mutation.
+func (m *UserMutation) Email() (r string, exists bool) {
+	v := m.email
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldEmail returns the old email value of the User.
+// If the User object wasn't provided to the builder, the object is fetched
+// from the database.
+// An error is returned if the mutation operation is not UpdateOne, or database query fails.
+func (m *UserMutation) OldEmail(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, fmt.Errorf("OldEmail is allowed only on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, fmt.Errorf("OldEmail requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldEmail: %w", err)
+	}
+	return oldValue.Email, nil
+}
+
+// ClearEmail clears the value of email.
+func (m *UserMutation) ClearEmail() {
+	m.email = nil
+	m.clearedFields[user.FieldEmail] = struct{}{}
+}
+
+// EmailCleared returns if the field email was cleared in this mutation.
+func (m *UserMutation) EmailCleared() bool {
+	_, ok := m.clearedFields[user.FieldEmail]
+	return ok
+}
+
+// ResetEmail reset all changes of the "email" field.
+func (m *UserMutation) ResetEmail() {
+	m.email = nil
+	delete(m.clearedFields, user.FieldEmail)
+}
+
+// SetCreatedAt sets the created_at field.
+func (m *UserMutation) SetCreatedAt(t time.Time) {
+	m.created_at = &t
+}
+
+// CreatedAt returns the created_at value in the mutation.
+func (m *UserMutation) CreatedAt() (r time.Time, exists bool) {
+	v := m.created_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCreatedAt returns the old created_at value of the User.
+// If the User object wasn't provided to the builder, the object is fetched
+// from the database.
+// An error is returned if the mutation operation is not UpdateOne, or database query fails.
+func (m *UserMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, fmt.Errorf("OldCreatedAt is allowed only on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, fmt.Errorf("OldCreatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
+	}
+	return oldValue.CreatedAt, nil
+}
+
+// ResetCreatedAt reset all changes of the "created_at" field.
+func (m *UserMutation) ResetCreatedAt() {
+	m.created_at = nil
+}
+
+// SetUpdatedAt sets the updated_at field.
+func (m *UserMutation) SetUpdatedAt(t time.Time) {
+	m.updated_at = &t
+}
+
+// UpdatedAt returns the updated_at value in the mutation.
+func (m *UserMutation) UpdatedAt() (r time.Time, exists bool) {
+	v := m.updated_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldUpdatedAt returns the old updated_at value of the User.
+// If the User object wasn't provided to the builder, the object is fetched
+// from the database.
+// An error is returned if the mutation operation is not UpdateOne, or database query fails.
+func (m *UserMutation) OldUpdatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, fmt.Errorf("OldUpdatedAt is allowed only on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, fmt.Errorf("OldUpdatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldUpdatedAt: %w", err)
+	}
+	return oldValue.UpdatedAt, nil
+}
+
+// ResetUpdatedAt reset all changes of the "updated_at" field.
+func (m *UserMutation) ResetUpdatedAt() {
+	m.updated_at = nil
+}
+
+// AddTokenIDs adds the tokens edge to APIToken by ids.
+func (m *UserMutation) AddTokenIDs(ids ...int) {
+	if m.tokens == nil {
+		m.tokens = make(map[int]struct{})
+	}
+	for i := range ids {
+		m.tokens[ids[i]] = struct{}{}
+	}
+}
+
+// ClearTokens clears the tokens edge to APIToken.
+func (m *UserMutation) ClearTokens() {
+	m.clearedtokens = true
+}
+
+// TokensCleared returns if the edge tokens was cleared.
+func (m *UserMutation) TokensCleared() bool {
+	return m.clearedtokens
+}
+
+// RemoveTokenIDs removes the tokens edge to APIToken by ids.
+func (m *UserMutation) RemoveTokenIDs(ids ...int) {
+	if m.removedtokens == nil {
+		m.removedtokens = make(map[int]struct{})
+	}
+	for i := range ids {
+		m.removedtokens[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedTokens returns the removed ids of tokens.
+func (m *UserMutation) RemovedTokensIDs() (ids []int) {
+	for id := range m.removedtokens {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// TokensIDs returns the tokens ids in the mutation.
+func (m *UserMutation) TokensIDs() (ids []int) {
+	for id := range m.tokens {
+		ids = append(ids, id)
 	}
-	return fmt.Errorf("unknown Histogram field %s", name)
+	return
 }
 
-// AddedFields returns all numeric fields that were incremented
-// or decremented during this mutation.
-func (m *HistogramMutation) AddedFields() []string {
-	var fields []string
-	if m.addtime != nil {
-		fields = append(fields, histogram.FieldTime)
+// ResetTokens reset all changes of the "tokens" edge.
+func (m *UserMutation) ResetTokens() {
+	m.tokens = nil
+	m.clearedtokens = false
+	m.removedtokens = nil
+}
+
+// AddSubscriptionIDs adds the subscriptions edge to Subscription by ids.
+func (m *UserMutation) AddSubscriptionIDs(ids ...int) {
+	if m.subscriptions == nil {
+		m.subscriptions = make(map[int]struct{})
 	}
-	if m.addcount != nil {
-		fields = append(fields, histogram.FieldCount)
+	for i := range ids {
+		m.subscriptions[ids[i]] = struct{}{}
 	}
-	if m.addmin != nil {
-		fields = append(fields, histogram.FieldMin)
+}
+
+// ClearSubscriptions clears the subscriptions edge to Subscription.
+func (m *UserMutation) ClearSubscriptions() {
+	m.clearedsubscriptions = true
+}
+
+// SubscriptionsCleared returns if the edge subscriptions was cleared.
+func (m *UserMutation) SubscriptionsCleared() bool {
+	return m.clearedsubscriptions
+}
+
+// RemoveSubscriptionIDs removes the subscriptions edge to Subscription by ids.
+func (m *UserMutation) RemoveSubscriptionIDs(ids ...int) {
+	if m.removedsubscriptions == nil {
+		m.removedsubscriptions = make(map[int]struct{})
 	}
-	if m.addmax != nil {
-		fields = append(fields, histogram.FieldMax)
+	for i := range ids {
+		m.removedsubscriptions[ids[i]] = struct{}{}
 	}
-	if m.addmean != nil {
-		fields = append(fields, histogram.FieldMean)
+}
+
+// RemovedSubscriptions returns the removed ids of subscriptions.
+func (m *UserMutation) RemovedSubscriptionsIDs() (ids []int) {
+	for id := range m.removedsubscriptions {
+		ids = append(ids, id)
 	}
-	if m.addstddev != nil {
-		fields = append(fields, histogram.FieldStddev)
+	return
+}
+
+// SubscriptionsIDs returns the subscriptions ids in the mutation.
+func (m *UserMutation) SubscriptionsIDs() (ids []int) {
+	for id := range m.subscriptions {
+		ids = append(ids, id)
 	}
-	if m.addmedian != nil {
-		fields = append(fields, histogram.FieldMedian)
+	return
+}
+
+// ResetSubscriptions reset all changes of the "subscriptions" edge.
+func (m *UserMutation) ResetSubscriptions() {
+	m.subscriptions = nil
+	m.clearedsubscriptions = false
+	m.removedsubscriptions = nil
+}
+
+// AddProjectIDs adds the projects edge to Project by ids.
+func (m *UserMutation) AddProjectIDs(ids ...int) {
+	if m.projects == nil {
+		m.projects = make(map[int]struct{})
 	}
-	if m.addp75 != nil {
-		fields = append(fields, histogram.FieldP75)
+	for i := range ids {
+		m.projects[ids[i]] = struct{}{}
 	}
-	if m.addp95 != nil {
-		fields = append(fields, histogram.FieldP95)
+}
+
+// ClearProjects clears the projects edge to Project.
+func (m *UserMutation) ClearProjects() {
+	m.clearedprojects = true
+}
+
+// ProjectsCleared returns if the edge projects was cleared.
+func (m *UserMutation) ProjectsCleared() bool {
+	return m.clearedprojects
+}
+
+// RemoveProjectIDs removes the projects edge to Project by ids.
+func (m *UserMutation) RemoveProjectIDs(ids ...int) {
+	if m.removedprojects == nil {
+		m.removedprojects = make(map[int]struct{})
 	}
-	if m.addp99 != nil {
-		fields = append(fields, histogram.FieldP99)
+	for i := range ids {
+		m.removedprojects[ids[i]] = struct{}{}
 	}
-	if m.addp999 != nil {
-		fields = append(fields, histogram.FieldP999)
+}
+
+// RemovedProjects returns the removed ids of projects.
+func (m *UserMutation) RemovedProjectsIDs() (ids []int) {
+	for id := range m.removedprojects {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ProjectsIDs returns the projects ids in the mutation.
+func (m *UserMutation) ProjectsIDs() (ids []int) {
+	for id := range m.projects {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetProjects reset all changes of the "projects" edge.
+func (m *UserMutation) ResetProjects() {
+	m.projects = nil
+	m.clearedprojects = false
+	m.removedprojects = nil
+}
+
+// Op returns the operation name.
+func (m *UserMutation) Op() Op {
+	return m.op
+}
+
+// Type returns the node type of this mutation (User).
+func (m *UserMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during
+// this mutation. Note that, in order to get all numeric
+// fields that were in/decremented, call AddedFields().
+func (m *UserMutation) Fields() []string {
+	fields := make([]string, 0, 6)
+	if m.username != nil {
+		fields = append(fields, user.FieldUsername)
+	}
+	if m.password_hash != nil {
+		fields = append(fields, user.FieldPasswordHash)
+	}
+	if m.role != nil {
+		fields = append(fields, user.FieldRole)
+	}
+	if m.email != nil {
+		fields = append(fields, user.FieldEmail)
+	}
+	if m.created_at != nil {
+		fields = append(fields, user.FieldCreatedAt)
+	}
+	if m.updated_at != nil {
+		fields = append(fields, user.FieldUpdatedAt)
 	}
 	return fields
 }
 
-// AddedField returns the numeric value that was in/decremented
-// from a field with the given name. The second value indicates
-// that this field was not set, or was not define in the schema.
-func (m *HistogramMutation) AddedField(name string) (ent.Value, bool) {
+// Field returns the value of a field with the given name.
+// The second boolean value indicates that this field was
+// not set, or was not define in the schema.
+func (m *UserMutation) Field(name string) (ent.Value, bool) {
 	switch name {
-	case histogram.FieldTime:
-		return m.AddedTime()
-	case histogram.FieldCount:
-		return m.AddedCount()
-	case histogram.FieldMin:
-		return m.AddedMin()
-	case histogram.FieldMax:
-		return m.AddedMax()
-	case histogram.FieldMean:
-		return m.AddedMean()
-	case histogram.FieldStddev:
-		return m.AddedStddev()
-	case histogram.FieldMedian:
-		return m.AddedMedian()
-	case histogram.FieldP75:
-		return m.AddedP75()
-	case histogram.FieldP95:
-		return m.AddedP95()
-	case histogram.FieldP99:
-		return m.AddedP99()
-	case histogram.FieldP999:
-		return m.AddedP999()
+	case user.FieldUsername:
+		return m.Username()
+	case user.FieldPasswordHash:
+		return m.PasswordHash()
+	case user.FieldRole:
+		return m.Role()
+	case user.FieldEmail:
+		return m.Email()
+	case user.FieldCreatedAt:
+		return m.CreatedAt()
+	case user.FieldUpdatedAt:
+		return m.UpdatedAt()
 	}
 	return nil, false
 }
 
-// AddField adds the value for the given name. It returns an
+// OldField returns the old value of the field from the database.
+// An error is returned if the mutation operation is not UpdateOne,
+// or the query to the database was failed.
+func (m *UserMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case user.FieldUsername:
+		return m.OldUsername(ctx)
+	case user.FieldPasswordHash:
+		return m.OldPasswordHash(ctx)
+	case user.FieldRole:
+		return m.OldRole(ctx)
+	case user.FieldEmail:
+		return m.OldEmail(ctx)
+	case user.FieldCreatedAt:
+		return m.OldCreatedAt(ctx)
+	case user.FieldUpdatedAt:
+		return m.OldUpdatedAt(ctx)
+	}
+	return nil, fmt.Errorf("unknown User field %s", name)
+}
+
+// SetField sets the value for the given name. It returns an
 // error if the field is not defined in the schema, or if the
 // type mismatch the field type.
-func (m *HistogramMutation) AddField(name string, value ent.Value) error {
+func (m *UserMutation) SetField(name string, value ent.Value) error {
 	switch name {
-	case histogram.FieldTime:
-		v, ok := value.(int64)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.AddTime(v)
-		return nil
-	case histogram.FieldCount:
-		v, ok := value.(int64)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.AddCount(v)
-		return nil
-	case histogram.FieldMin:
-		v, ok := value.(int64)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.AddMin(v)
-		return nil
-	case histogram.FieldMax:
-		v, ok := value.(int64)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.AddMax(v)
-		return nil
-	case histogram.FieldMean:
-		v, ok := value.(float64)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.AddMean(v)
-		return nil
-	case histogram.FieldStddev:
-		v, ok := value.(float64)
+	case user.FieldUsername:
+		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.AddStddev(v)
+		m.SetUsername(v)
 		return nil
-	case histogram.FieldMedian:
-		v, ok := value.(float64)
+	case user.FieldPasswordHash:
+		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.AddMedian(v)
+		m.SetPasswordHash(v)
 		return nil
-	case histogram.FieldP75:
-		v, ok := value.(float64)
+	case user.FieldRole:
+		v, ok := value.(user.Role)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.AddP75(v)
+		m.SetRole(v)
 		return nil
-	case histogram.FieldP95:
-		v, ok := value.(float64)
+	case user.FieldEmail:
+		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.AddP95(v)
+		m.SetEmail(v)
 		return nil
-	case histogram.FieldP99:
-		v, ok := value.(float64)
+	case user.FieldCreatedAt:
+		v, ok := value.(time.Time)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.AddP99(v)
+		m.SetCreatedAt(v)
 		return nil
-	case histogram.FieldP999:
-		v, ok := value.(float64)
+	case user.FieldUpdatedAt:
+		v, ok := value.(time.Time)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.AddP999(v)
+		m.SetUpdatedAt(v)
 		return nil
 	}
-	return fmt.Errorf("unknown Histogram numeric field %s", name)
+	return fmt.Errorf("unknown User field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented
+// or decremented during this mutation.
+func (m *UserMutation) AddedFields() []string {
+	return nil
+}
+
+// AddedField returns the numeric value that was in/decremented
+// from a field with the given name. The second value indicates
+// that this field was not set, or was not define in the schema.
+func (m *UserMutation) AddedField(name string) (ent.Value, bool) {
+	return nil, false
+}
+
+// AddField adds the value for the given name. It returns an
+// error if the field is not defined in the schema, or if the
+// type mismatch the field type.
+func (m *UserMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	}
+	return fmt.Errorf("unknown User numeric field %s", name)
 }
 
 // ClearedFields returns all nullable fields that were cleared
 // during this mutation.
-func (m *HistogramMutation) ClearedFields() []string {
-	return nil
+func (m *UserMutation) ClearedFields() []string {
+	var fields []string
+	if m.FieldCleared(user.FieldEmail) {
+		fields = append(fields, user.FieldEmail)
+	}
+	return fields
 }
 
 // FieldCleared returns a boolean indicates if this field was
 // cleared in this mutation.
-func (m *HistogramMutation) FieldCleared(name string) bool {
+func (m *UserMutation) FieldCleared(name string) bool {
 	_, ok := m.clearedFields[name]
 	return ok
 }
 
 // ClearField clears the value for the given name. It returns an
 // error if the field is not defined in the schema.
-func (m *HistogramMutation) ClearField(name string) error {
-	return fmt.Errorf("unknown Histogram nullable field %s", name)
+func (m *UserMutation) ClearField(name string) error {
+	switch name {
+	case user.FieldEmail:
+		m.ClearEmail()
+		return nil
+	}
+	return fmt.Errorf("unknown User nullable field %s", name)
 }
 
 // ResetField resets all changes in the mutation regarding the
 // given field name. It returns an error if the field is not
 // defined in the schema.
-func (m *HistogramMutation) ResetField(name string) error {
+func (m *UserMutation) ResetField(name string) error {
 	switch name {
-	case histogram.FieldTime:
-		m.ResetTime()
-		return nil
-	case histogram.FieldCount:
-		m.ResetCount()
-		return nil
-	case histogram.FieldMin:
-		m.ResetMin()
-		return nil
-	case histogram.FieldMax:
-		m.ResetMax()
-		return nil
-	case histogram.FieldMean:
-		m.ResetMean()
-		return nil
-	case histogram.FieldStddev:
-		m.ResetStddev()
+	case user.FieldUsername:
+		m.ResetUsername()
 		return nil
-	case histogram.FieldMedian:
-		m.ResetMedian()
-		return nil
-	case histogram.FieldP75:
-		m.ResetP75()
+	case user.FieldPasswordHash:
+		m.ResetPasswordHash()
 		return nil
-	case histogram.FieldP95:
-		m.ResetP95()
+	case user.FieldRole:
+		m.ResetRole()
 		return nil
-	case histogram.FieldP99:
-		m.ResetP99()
+	case user.FieldEmail:
+		m.ResetEmail()
 		return nil
-	case histogram.FieldP999:
-		m.ResetP999()
+	case user.FieldCreatedAt:
+		m.ResetCreatedAt()
 		return nil
-	case histogram.FieldWID:
-		m.ResetWID()
+	case user.FieldUpdatedAt:
+		m.ResetUpdatedAt()
 		return nil
 	}
-	return fmt.Errorf("unknown Histogram field %s", name)
+	return fmt.Errorf("unknown User field %s", name)
 }
 
 // AddedEdges returns all edge names that were set/added in this
 // mutation.
-func (m *HistogramMutation) AddedEdges() []string {
-	edges := make([]string, 0, 1)
-	if m.metric != nil {
-		edges = append(edges, histogram.EdgeMetric)
+func (m *UserMutation) AddedEdges() []string {
+	edges := make([]string, 0, 3)
+	if m.tokens != nil {
+		edges = append(edges, user.EdgeTokens)
+	}
+	if m.subscriptions != nil {
+		edges = append(edges, user.EdgeSubscriptions)
+	}
+	if m.projects != nil {
+		edges = append(edges, user.EdgeProjects)
 	}
 	return edges
 }
 
 // AddedIDs returns all ids (to other nodes) that were added for
 // the given edge name.
-func (m *HistogramMutation) AddedIDs(name string) []ent.Value {
+func (m *UserMutation) AddedIDs(name string) []ent.Value {
 	switch name {
-	case histogram.EdgeMetric:
-		if id := m.metric; id != nil {
-			return []ent.Value{*id}
+	case user.EdgeTokens:
+		ids := make([]ent.Value, 0, len(m.tokens))
+		for id := range m.tokens {
+			ids = append(ids, id)
+		}
+		return ids
+	case user.EdgeSubscriptions:
+		ids := make([]ent.Value, 0, len(m.subscriptions))
+		for id := range m.subscriptions {
+			ids = append(ids, id)
+		}
+		return ids
+	case user.EdgeProjects:
+		ids := make([]ent.Value, 0, len(m.projects))
+		for id := range m.projects {
+			ids = append(ids, id)
 		}
+		return ids
 	}
 	return nil
 }
 
 // RemovedEdges returns all edge names that were removed in this
 // mutation.
-func (m *HistogramMutation) RemovedEdges() []string {
-	edges := make([]string, 0, 1)
+func (m *UserMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 3)
+	if m.removedtokens != nil {
+		edges = append(edges, user.EdgeTokens)
+	}
+	if m.removedsubscriptions != nil {
+		edges = append(edges, user.EdgeSubscriptions)
+	}
+	if m.removedprojects != nil {
+		edges = append(edges, user.EdgeProjects)
+	}
 	return edges
 }
 
 // RemovedIDs returns all ids (to other nodes) that were removed for
 // the given edge name.
-func (m *HistogramMutation) RemovedIDs(name string) []ent.Value {
+func (m *UserMutation) RemovedIDs(name string) []ent.Value {
 	switch name {
+	case user.EdgeTokens:
+		ids := make([]ent.Value, 0, len(m.removedtokens))
+		for id := range m.removedtokens {
+			ids = append(ids, id)
+		}
+		return ids
+	case user.EdgeSubscriptions:
+		ids := make([]ent.Value, 0, len(m.removedsubscriptions))
+		for id := range m.removedsubscriptions {
+			ids = append(ids, id)
+		}
+		return ids
+	case user.EdgeProjects:
+		ids := make([]ent.Value, 0, len(m.removedprojects))
+		for id := range m.removedprojects {
+			ids = append(ids, id)
+		}
+		return ids
 	}
 	return nil
 }
 
 // ClearedEdges returns all edge names that were cleared in this
 // mutation.
-func (m *HistogramMutation) ClearedEdges() []string {
-	edges := make([]string, 0, 1)
-	if m.clearedmetric {
-		edges = append(edges, histogram.EdgeMetric)
+func (m *UserMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 3)
+	if m.clearedtokens {
+		edges = append(edges, user.EdgeTokens)
+	}
+	if m.clearedsubscriptions {
+		edges = append(edges, user.EdgeSubscriptions)
+	}
+	if m.clearedprojects {
+		edges = append(edges, user.EdgeProjects)
 	}
 	return edges
 }
 
 // EdgeCleared returns a boolean indicates if this edge was
 // cleared in this mutation.
-func (m *HistogramMutation) EdgeCleared(name string) bool {
+func (m *UserMutation) EdgeCleared(name string) bool {
 	switch name {
-	case histogram.EdgeMetric:
-		return m.clearedmetric
+	case user.EdgeTokens:
+		return m.clearedtokens
+	case user.EdgeSubscriptions:
+		return m.clearedsubscriptions
+	case user.EdgeProjects:
+		return m.clearedprojects
 	}
 	return false
 }
 
 // ClearEdge clears the value for the given name. It returns an
 // error if the edge name is not defined in the schema.
-func (m *HistogramMutation) ClearEdge(name string) error {
+func (m *UserMutation) ClearEdge(name string) error {
 	switch name {
-	case histogram.EdgeMetric:
-		m.ClearMetric()
-		return nil
 	}
-	return fmt.Errorf("unknown Histogram unique edge %s", name)
+	return fmt.Errorf("unknown User unique edge %s", name)
 }
 
 // ResetEdge resets all changes in the mutation regarding the
 // given edge name. It returns an error if the edge is not
 // defined in the schema.
-func (m *HistogramMutation) ResetEdge(name string) error {
+func (m *UserMutation) ResetEdge(name string) error {
 	switch name {
-	case histogram.EdgeMetric:
-		m.ResetMetric()
+	case user.EdgeTokens:
+		m.ResetTokens()
+		return nil
+	case user.EdgeSubscriptions:
+		m.ResetSubscriptions()
+		return nil
+	case user.EdgeProjects:
+		m.ResetProjects()
 		return nil
 	}
-	return fmt.Errorf("unknown Histogram edge %s", name)
+	return fmt.Errorf("unknown User edge %s", name)
 }
 
-// MetricMutation represents an operation that mutate the Metrics
+// WebhookMutation represents an operation that mutate the Webhooks
 // nodes in the graph.
-type MetricMutation struct {
+type WebhookMutation struct {
 	config
 	op                Op
 	typ               string
 	id                *int
-	title             *string
-	_type             *string
+	name              *string
+	url               *string
+	tag               *string
+	events            *[]string
+	template          *string
+	secret            *string
+	created_at        *time.Time
+	updated_at        *time.Time
 	clearedFields     map[string]struct{}
-	graph             *int
-	clearedgraph      bool
-	histograms        map[int]struct{}
-	removedhistograms map[int]struct{}
-	clearedhistograms bool
-	counters          map[int]struct{}
-	removedcounters   map[int]struct{}
-	clearedcounters   bool
-	gauges            map[int]struct{}
-	removedgauges     map[int]struct{}
-	clearedgauges     bool
+	deliveries        map[int]struct{}
+	removeddeliveries map[int]struct{}
+	cleareddeliveries bool
 	done              bool
-	oldValue          func(context.Context) (*Metric, error)
+	oldValue          func(context.Context) (*Webhook, error)
 }
 
-var _ ent.Mutation = (*MetricMutation)(nil)
+var _ ent.Mutation = (*WebhookMutation)(nil)
 
-// metricOption allows to manage the mutation configuration using functional options.
-type metricOption func(*MetricMutation)
+// webhookOption allows to manage the mutation configuration using functional options.
+type webhookOption func(*WebhookMutation)
 
-// newMetricMutation creates new mutation for $n.Name.
-func newMetricMutation(c config, op Op, opts ...metricOption) *MetricMutation {
-	m := &MetricMutation{
+// newWebhookMutation creates new mutation for $n.Name.
+func newWebhookMutation(c config, op Op, opts ...webhookOption) *WebhookMutation {
+	m := &WebhookMutation{
 		config:        c,
 		op:            op,
-		typ:           TypeMetric,
+		typ:           TypeWebhook,
 		clearedFields: make(map[string]struct{}),
 	}
 	for _, opt := range opts {
@@ -4315,20 +12037,20 @@ func newMetricMutation(c config, op Op, opts ...metricOption) *MetricMutation {
 	return m
 }
 
-// withMetricID sets the id field of the mutation.
-func withMetricID(id int) metricOption {
-	return func(m *MetricMutation) {
+// withWebhookID sets the id field of the mutation.
+func withWebhookID(id int) webhookOption {
+	return func(m *WebhookMutation) {
 		var (
 			err   error
 			once  sync.Once
-			value *Metric
+			value *Webhook
 		)
-		m.oldValue = func(ctx context.Context) (*Metric, error) {
+		m.oldValue = func(ctx context.Context) (*Webhook, error) {
 			once.Do(func() {
 				if m.done {
 					err = fmt.Errorf("querying old values post mutation is not allowed")
 				} else {
-					value, err = m.Client().Metric.Get(ctx, id)
+					value, err = m.Client().Webhook.Get(ctx, id)
 				}
 			})
 			return value, err
@@ -4337,10 +12059,10 @@ func withMetricID(id int) metricOption {
 	}
 }
 
-// withMetric sets the old Metric of the mutation.
-func withMetric(node *Metric) metricOption {
-	return func(m *MetricMutation) {
-		m.oldValue = func(context.Context) (*Metric, error) {
+// withWebhook sets the old Webhook of the mutation.
+func withWebhook(node *Webhook) webhookOption {
+	return func(m *WebhookMutation) {
+		m.oldValue = func(context.Context) (*Webhook, error) {
 			return node, nil
 		}
 		m.id = &node.ID
@@ -4349,7 +12071,7 @@ func withMetric(node *Metric) metricOption {
 
 // Client returns a new `ent.Client` from the mutation. If the mutation was
 // executed in a transaction (ent.Tx), a transactional client is returned.
-func (m MetricMutation) Client() *Client {
+func (m WebhookMutation) Client() *Client {
 	client := &Client{config: m.config}
 	client.init()
 	return client
@@ -4357,7 +12079,7 @@ func (m MetricMutation) Client() *Client {
 
 // Tx returns an `ent.Tx` for mutations that were executed in transactions;
 // it returns an error otherwise.
-func (m MetricMutation) Tx() (*Tx, error) {
+func (m WebhookMutation) Tx() (*Tx, error) {
 	if _, ok := m.driver.(*txDriver); !ok {
 		return nil, fmt.Errorf("ent: mutation is not running in a transaction")
 	}
@@ -4368,759 +12090,1364 @@ func (m MetricMutation) Tx() (*Tx, error) {
 
 // ID returns the id value in the mutation. Note that, the id
 // is available only if it was provided to the builder.
-func (m *MetricMutation) ID() (id int, exists bool) {
+func (m *WebhookMutation) ID() (id int, exists bool) {
 	if m.id == nil {
 		return
 	}
 	return *m.id, true
 }
 
-// SetTitle sets the title field.
-func (m *MetricMutation) SetTitle(s string) {
-	m.title = &s
+// SetName sets the name field.
+func (m *WebhookMutation) SetName(s string) {
+	m.name = &s
 }
 
-// Title returns the title value in the mutation.
-func (m *MetricMutation) Title() (r string, exists bool) {
-	v := m.title
+// Name returns the name value in the mutation.
+func (m *WebhookMutation) Name() (r string, exists bool) {
+	v := m.name
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldTitle returns the old title value of the Metric.
-// If the Metric object wasn't provided to the builder, the object is fetched
+// OldName returns the old name value of the Webhook.
+// If the Webhook object wasn't provided to the builder, the object is fetched
 // from the database.
 // An error is returned if the mutation operation is not UpdateOne, or database query fails.
-func (m *MetricMutation) OldTitle(ctx context.Context) (v string, err error) {
+func (m *WebhookMutation) OldName(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, fmt.Errorf("OldTitle is allowed only on UpdateOne operations")
+		return v, fmt.Errorf("OldName is allowed only on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, fmt.Errorf("OldTitle requires an ID field in the mutation")
+		return v, fmt.Errorf("OldName requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldTitle: %w", err)
+		return v, fmt.Errorf("querying old value for OldName: %w", err)
 	}
-	return oldValue.Title, nil
+	return oldValue.Name, nil
+}
+
+// ResetName reset all changes of the "name" field.
+func (m *WebhookMutation) ResetName() {
+	m.name = nil
+}
+
+// SetURL sets the url field.
+func (m *WebhookMutation) SetURL(s string) {
+	m.url = &s
+}
+
+// URL returns the url value in the mutation.
+func (m *WebhookMutation) URL() (r string, exists bool) {
+	v := m.url
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldURL returns the old url value of the Webhook.
+// If the Webhook object wasn't provided to the builder, the object is fetched
+// from the database.
+// An error is returned if the mutation operation is not UpdateOne, or database query fails.
+func (m *WebhookMutation) OldURL(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, fmt.Errorf("OldURL is allowed only on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, fmt.Errorf("OldURL requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldURL: %w", err)
+	}
+	return oldValue.URL, nil
+}
+
+// ResetURL reset all changes of the "url" field.
+func (m *WebhookMutation) ResetURL() {
+	m.url = nil
+}
+
+// SetTag sets the tag field.
+func (m *WebhookMutation) SetTag(s string) {
+	m.tag = &s
+}
+
+// Tag returns the tag value in the mutation.
+func (m *WebhookMutation) Tag() (r string, exists bool) {
+	v := m.tag
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldTag returns the old tag value of the Webhook.
+// If the Webhook object wasn't provided to the builder, the object is fetched
+// from the database.
+// An error is returned if the mutation operation is not UpdateOne, or database query fails.
+func (m *WebhookMutation) OldTag(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, fmt.Errorf("OldTag is allowed only on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, fmt.Errorf("OldTag requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldTag: %w", err)
+	}
+	return oldValue.Tag, nil
+}
+
+// ClearTag clears the value of tag.
+func (m *WebhookMutation) ClearTag() {
+	m.tag = nil
+	m.clearedFields[webhook.FieldTag] = struct{}{}
+}
+
+// TagCleared returns if the field tag was cleared in this mutation.
+func (m *WebhookMutation) TagCleared() bool {
+	_, ok := m.clearedFields[webhook.FieldTag]
+	return ok
+}
+
+// ResetTag reset all changes of the "tag" field.
+func (m *WebhookMutation) ResetTag() {
+	m.tag = nil
+	delete(m.clearedFields, webhook.FieldTag)
+}
+
+// SetEvents sets the events field.
+func (m *WebhookMutation) SetEvents(s []string) {
+	m.events = &s
+}
+
+// Events returns the events value in the mutation.
+func (m *WebhookMutation) Events() (r []string, exists bool) {
+	v := m.events
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldEvents returns the old events value of the Webhook.
+// If the Webhook object wasn't provided to the builder, the object is fetched
+// from the database.
+// An error is returned if the mutation operation is not UpdateOne, or database query fails.
+func (m *WebhookMutation) OldEvents(ctx context.Context) (v []string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, fmt.Errorf("OldEvents is allowed only on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, fmt.Errorf("OldEvents requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldEvents: %w", err)
+	}
+	return oldValue.Events, nil
+}
+
+// ClearEvents clears the value of events.
+func (m *WebhookMutation) ClearEvents() {
+	m.events = nil
+	m.clearedFields[webhook.FieldEvents] = struct{}{}
+}
+
+// EventsCleared returns if the field events was cleared in this mutation.
+func (m *WebhookMutation) EventsCleared() bool {
+	_, ok := m.clearedFields[webhook.FieldEvents]
+	return ok
+}
+
+// ResetEvents reset all changes of the "events" field.
+func (m *WebhookMutation) ResetEvents() {
+	m.events = nil
+	delete(m.clearedFields, webhook.FieldEvents)
+}
+
+// SetTemplate sets the template field.
+func (m *WebhookMutation) SetTemplate(s string) {
+	m.template = &s
+}
+
+// Template returns the template value in the mutation.
+func (m *WebhookMutation) Template() (r string, exists bool) {
+	v := m.template
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldTemplate returns the old template value of the Webhook.
+// If the Webhook object wasn't provided to the builder, the object is fetched
+// from the database.
+// An error is returned if the mutation operation is not UpdateOne, or database query fails.
+func (m *WebhookMutation) OldTemplate(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, fmt.Errorf("OldTemplate is allowed only on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, fmt.Errorf("OldTemplate requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldTemplate: %w", err)
+	}
+	return oldValue.Template, nil
+}
+
+// ClearTemplate clears the value of template.
+func (m *WebhookMutation) ClearTemplate() {
+	m.template = nil
+	m.clearedFields[webhook.FieldTemplate] = struct{}{}
+}
+
+// TemplateCleared returns if the field template was cleared in this mutation.
+func (m *WebhookMutation) TemplateCleared() bool {
+	_, ok := m.clearedFields[webhook.FieldTemplate]
+	return ok
+}
+
+// ResetTemplate reset all changes of the "template" field.
+func (m *WebhookMutation) ResetTemplate() {
+	m.template = nil
+	delete(m.clearedFields, webhook.FieldTemplate)
+}
+
+// SetSecret sets the secret field.
+func (m *WebhookMutation) SetSecret(s string) {
+	m.secret = &s
+}
+
+// Secret returns the secret value in the mutation.
+func (m *WebhookMutation) Secret() (r string, exists bool) {
+	v := m.secret
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldSecret returns the old secret value of the Webhook.
+// If the Webhook object wasn't provided to the builder, the object is fetched
+// from the database.
+// An error is returned if the mutation operation is not UpdateOne, or database query fails.
+func (m *WebhookMutation) OldSecret(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, fmt.Errorf("OldSecret is allowed only on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, fmt.Errorf("OldSecret requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldSecret: %w", err)
+	}
+	return oldValue.Secret, nil
+}
+
+// ClearSecret clears the value of secret.
+func (m *WebhookMutation) ClearSecret() {
+	m.secret = nil
+	m.clearedFields[webhook.FieldSecret] = struct{}{}
+}
+
+// SecretCleared returns if the field secret was cleared in this mutation.
+func (m *WebhookMutation) SecretCleared() bool {
+	_, ok := m.clearedFields[webhook.FieldSecret]
+	return ok
+}
+
+// ResetSecret reset all changes of the "secret" field.
+func (m *WebhookMutation) ResetSecret() {
+	m.secret = nil
+	delete(m.clearedFields, webhook.FieldSecret)
+}
+
+// SetCreatedAt sets the created_at field.
+func (m *WebhookMutation) SetCreatedAt(t time.Time) {
+	m.created_at = &t
+}
+
+// CreatedAt returns the created_at value in the mutation.
+func (m *WebhookMutation) CreatedAt() (r time.Time, exists bool) {
+	v := m.created_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCreatedAt returns the old created_at value of the Webhook.
+// If the Webhook object wasn't provided to the builder, the object is fetched
+// from the database.
+// An error is returned if the mutation operation is not UpdateOne, or database query fails.
+func (m *WebhookMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, fmt.Errorf("OldCreatedAt is allowed only on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, fmt.Errorf("OldCreatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
+	}
+	return oldValue.CreatedAt, nil
 }
 
-// ResetTitle reset all changes of the "title" field.
-func (m *MetricMutation) ResetTitle() {
-	m.title = nil
+// ResetCreatedAt reset all changes of the "created_at" field.
+func (m *WebhookMutation) ResetCreatedAt() {
+	m.created_at = nil
 }
 
-// SetType sets the type field.
-func (m *MetricMutation) SetType(s string) {
-	m._type = &s
+// SetUpdatedAt sets the updated_at field.
+func (m *WebhookMutation) SetUpdatedAt(t time.Time) {
+	m.updated_at = &t
 }
 
-// GetType returns the type value in the mutation.
-func (m *MetricMutation) GetType() (r string, exists bool) {
-	v := m._type
+// UpdatedAt returns the updated_at value in the mutation.
+func (m *WebhookMutation) UpdatedAt() (r time.Time, exists bool) {
+	v := m.updated_at
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldType returns the old type value of the Metric.
-// If the Metric object wasn't provided to the builder, the object is fetched
+// OldUpdatedAt returns the old updated_at value of the Webhook.
+// If the Webhook object wasn't provided to the builder, the object is fetched
 // from the database.
 // An error is returned if the mutation operation is not UpdateOne, or database query fails.
-func (m *MetricMutation) OldType(ctx context.Context) (v string, err error) {
+func (m *WebhookMutation) OldUpdatedAt(ctx context.Context) (v time.Time, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, fmt.Errorf("OldType is allowed only on UpdateOne operations")
+		return v, fmt.Errorf("OldUpdatedAt is allowed only on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, fmt.Errorf("OldType requires an ID field in the mutation")
+		return v, fmt.Errorf("OldUpdatedAt requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldType: %w", err)
+		return v, fmt.Errorf("querying old value for OldUpdatedAt: %w", err)
 	}
-	return oldValue.Type, nil
+	return oldValue.UpdatedAt, nil
 }
 
-// ResetType reset all changes of the "type" field.
-func (m *MetricMutation) ResetType() {
-	m._type = nil
+// ResetUpdatedAt reset all changes of the "updated_at" field.
+func (m *WebhookMutation) ResetUpdatedAt() {
+	m.updated_at = nil
 }
 
-// SetGraphID sets the graph edge to Graph by id.
-func (m *MetricMutation) SetGraphID(id int) {
-	m.graph = &id
+// AddDeliveryIDs adds the deliveries edge to WebhookDelivery by ids.
+func (m *WebhookMutation) AddDeliveryIDs(ids ...int) {
+	if m.deliveries == nil {
+		m.deliveries = make(map[int]struct{})
+	}
+	for i := range ids {
+		m.deliveries[ids[i]] = struct{}{}
+	}
 }
 
-// ClearGraph clears the graph edge to Graph.
-func (m *MetricMutation) ClearGraph() {
-	m.clearedgraph = true
+// ClearDeliveries clears the deliveries edge to WebhookDelivery.
+func (m *WebhookMutation) ClearDeliveries() {
+	m.cleareddeliveries = true
 }
 
-// GraphCleared returns if the edge graph was cleared.
-func (m *MetricMutation) GraphCleared() bool {
-	return m.clearedgraph
+// DeliveriesCleared returns if the edge deliveries was cleared.
+func (m *WebhookMutation) DeliveriesCleared() bool {
+	return m.cleareddeliveries
 }
 
-// GraphID returns the graph id in the mutation.
-func (m *MetricMutation) GraphID() (id int, exists bool) {
-	if m.graph != nil {
-		return *m.graph, true
+// RemoveDeliveryIDs removes the deliveries edge to WebhookDelivery by ids.
+func (m *WebhookMutation) RemoveDeliveryIDs(ids ...int) {
+	if m.removeddeliveries == nil {
+		m.removeddeliveries = make(map[int]struct{})
+	}
+	for i := range ids {
+		m.removeddeliveries[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedDeliveries returns the removed ids of deliveries.
+func (m *WebhookMutation) RemovedDeliveriesIDs() (ids []int) {
+	for id := range m.removeddeliveries {
+		ids = append(ids, id)
 	}
 	return
 }
 
-// GraphIDs returns the graph ids in the mutation.
-// Note that ids always returns len(ids) <= 1 for unique edges, and you should use
-// GraphID instead. It exists only for internal usage by the builders.
-func (m *MetricMutation) GraphIDs() (ids []int) {
-	if id := m.graph; id != nil {
-		ids = append(ids, *id)
+// DeliveriesIDs returns the deliveries ids in the mutation.
+func (m *WebhookMutation) DeliveriesIDs() (ids []int) {
+	for id := range m.deliveries {
+		ids = append(ids, id)
 	}
 	return
 }
 
-// ResetGraph reset all changes of the "graph" edge.
-func (m *MetricMutation) ResetGraph() {
-	m.graph = nil
-	m.clearedgraph = false
+// ResetDeliveries reset all changes of the "deliveries" edge.
+func (m *WebhookMutation) ResetDeliveries() {
+	m.deliveries = nil
+	m.cleareddeliveries = false
+	m.removeddeliveries = nil
 }
 
-// AddHistogramIDs adds the histograms edge to Histogram by ids.
-func (m *MetricMutation) AddHistogramIDs(ids ...int) {
-	if m.histograms == nil {
-		m.histograms = make(map[int]struct{})
+// Op returns the operation name.
+func (m *WebhookMutation) Op() Op {
+	return m.op
+}
+
+// Type returns the node type of this mutation (Webhook).
+func (m *WebhookMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during
+// this mutation. Note that, in order to get all numeric
+// fields that were in/decremented, call AddedFields().
+func (m *WebhookMutation) Fields() []string {
+	fields := make([]string, 0, 8)
+	if m.name != nil {
+		fields = append(fields, webhook.FieldName)
 	}
-	for i := range ids {
-		m.histograms[ids[i]] = struct{}{}
+	if m.url != nil {
+		fields = append(fields, webhook.FieldURL)
+	}
+	if m.tag != nil {
+		fields = append(fields, webhook.FieldTag)
+	}
+	if m.events != nil {
+		fields = append(fields, webhook.FieldEvents)
+	}
+	if m.template != nil {
+		fields = append(fields, webhook.FieldTemplate)
+	}
+	if m.secret != nil {
+		fields = append(fields, webhook.FieldSecret)
+	}
+	if m.created_at != nil {
+		fields = append(fields, webhook.FieldCreatedAt)
 	}
+	if m.updated_at != nil {
+		fields = append(fields, webhook.FieldUpdatedAt)
+	}
+	return fields
 }
 
-// ClearHistograms clears the histograms edge to Histogram.
-func (m *MetricMutation) ClearHistograms() {
-	m.clearedhistograms = true
+// Field returns the value of a field with the given name.
+// The second boolean value indicates that this field was
+// not set, or was not define in the schema.
+func (m *WebhookMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case webhook.FieldName:
+		return m.Name()
+	case webhook.FieldURL:
+		return m.URL()
+	case webhook.FieldTag:
+		return m.Tag()
+	case webhook.FieldEvents:
+		return m.Events()
+	case webhook.FieldTemplate:
+		return m.Template()
+	case webhook.FieldSecret:
+		return m.Secret()
+	case webhook.FieldCreatedAt:
+		return m.CreatedAt()
+	case webhook.FieldUpdatedAt:
+		return m.UpdatedAt()
+	}
+	return nil, false
 }
 
-// HistogramsCleared returns if the edge histograms was cleared.
-func (m *MetricMutation) HistogramsCleared() bool {
-	return m.clearedhistograms
+// OldField returns the old value of the field from the database.
+// An error is returned if the mutation operation is not UpdateOne,
+// or the query to the database was failed.
+func (m *WebhookMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case webhook.FieldName:
+		return m.OldName(ctx)
+	case webhook.FieldURL:
+		return m.OldURL(ctx)
+	case webhook.FieldTag:
+		return m.OldTag(ctx)
+	case webhook.FieldEvents:
+		return m.OldEvents(ctx)
+	case webhook.FieldTemplate:
+		return m.OldTemplate(ctx)
+	case webhook.FieldSecret:
+		return m.OldSecret(ctx)
+	case webhook.FieldCreatedAt:
+		return m.OldCreatedAt(ctx)
+	case webhook.FieldUpdatedAt:
+		return m.OldUpdatedAt(ctx)
+	}
+	return nil, fmt.Errorf("unknown Webhook field %s", name)
 }
 
-// RemoveHistogramIDs removes the histograms edge to Histogram by ids.
-func (m *MetricMutation) RemoveHistogramIDs(ids ...int) {
-	if m.removedhistograms == nil {
-		m.removedhistograms = make(map[int]struct{})
+// SetField sets the value for the given name. It returns an
+// error if the field is not defined in the schema, or if the
+// type mismatch the field type.
+func (m *WebhookMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case webhook.FieldName:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetName(v)
+		return nil
+	case webhook.FieldURL:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetURL(v)
+		return nil
+	case webhook.FieldTag:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetTag(v)
+		return nil
+	case webhook.FieldEvents:
+		v, ok := value.([]string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetEvents(v)
+		return nil
+	case webhook.FieldTemplate:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetTemplate(v)
+		return nil
+	case webhook.FieldSecret:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetSecret(v)
+		return nil
+	case webhook.FieldCreatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCreatedAt(v)
+		return nil
+	case webhook.FieldUpdatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetUpdatedAt(v)
+		return nil
 	}
-	for i := range ids {
-		m.removedhistograms[ids[i]] = struct{}{}
+	return fmt.Errorf("unknown Webhook field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented
+// or decremented during this mutation.
+func (m *WebhookMutation) AddedFields() []string {
+	return nil
+}
+
+// AddedField returns the numeric value that was in/decremented
+// from a field with the given name. The second value indicates
+// that this field was not set, or was not define in the schema.
+func (m *WebhookMutation) AddedField(name string) (ent.Value, bool) {
+	return nil, false
+}
+
+// AddField adds the value for the given name. It returns an
+// error if the field is not defined in the schema, or if the
+// type mismatch the field type.
+func (m *WebhookMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	}
+	return fmt.Errorf("unknown Webhook numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared
+// during this mutation.
+func (m *WebhookMutation) ClearedFields() []string {
+	var fields []string
+	if m.FieldCleared(webhook.FieldTag) {
+		fields = append(fields, webhook.FieldTag)
+	}
+	if m.FieldCleared(webhook.FieldEvents) {
+		fields = append(fields, webhook.FieldEvents)
+	}
+	if m.FieldCleared(webhook.FieldTemplate) {
+		fields = append(fields, webhook.FieldTemplate)
+	}
+	if m.FieldCleared(webhook.FieldSecret) {
+		fields = append(fields, webhook.FieldSecret)
+	}
+	return fields
+}
+
+// FieldCleared returns a boolean indicates if this field was
+// cleared in this mutation.
+func (m *WebhookMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value for the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *WebhookMutation) ClearField(name string) error {
+	switch name {
+	case webhook.FieldTag:
+		m.ClearTag()
+		return nil
+	case webhook.FieldEvents:
+		m.ClearEvents()
+		return nil
+	case webhook.FieldTemplate:
+		m.ClearTemplate()
+		return nil
+	case webhook.FieldSecret:
+		m.ClearSecret()
+		return nil
+	}
+	return fmt.Errorf("unknown Webhook nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation regarding the
+// given field name. It returns an error if the field is not
+// defined in the schema.
+func (m *WebhookMutation) ResetField(name string) error {
+	switch name {
+	case webhook.FieldName:
+		m.ResetName()
+		return nil
+	case webhook.FieldURL:
+		m.ResetURL()
+		return nil
+	case webhook.FieldTag:
+		m.ResetTag()
+		return nil
+	case webhook.FieldEvents:
+		m.ResetEvents()
+		return nil
+	case webhook.FieldTemplate:
+		m.ResetTemplate()
+		return nil
+	case webhook.FieldSecret:
+		m.ResetSecret()
+		return nil
+	case webhook.FieldCreatedAt:
+		m.ResetCreatedAt()
+		return nil
+	case webhook.FieldUpdatedAt:
+		m.ResetUpdatedAt()
+		return nil
+	}
+	return fmt.Errorf("unknown Webhook field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this
+// mutation.
+func (m *WebhookMutation) AddedEdges() []string {
+	edges := make([]string, 0, 1)
+	if m.deliveries != nil {
+		edges = append(edges, webhook.EdgeDeliveries)
+	}
+	return edges
+}
+
+// AddedIDs returns all ids (to other nodes) that were added for
+// the given edge name.
+func (m *WebhookMutation) AddedIDs(name string) []ent.Value {
+	switch name {
+	case webhook.EdgeDeliveries:
+		ids := make([]ent.Value, 0, len(m.deliveries))
+		for id := range m.deliveries {
+			ids = append(ids, id)
+		}
+		return ids
+	}
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this
+// mutation.
+func (m *WebhookMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 1)
+	if m.removeddeliveries != nil {
+		edges = append(edges, webhook.EdgeDeliveries)
+	}
+	return edges
+}
+
+// RemovedIDs returns all ids (to other nodes) that were removed for
+// the given edge name.
+func (m *WebhookMutation) RemovedIDs(name string) []ent.Value {
+	switch name {
+	case webhook.EdgeDeliveries:
+		ids := make([]ent.Value, 0, len(m.removeddeliveries))
+		for id := range m.removeddeliveries {
+			ids = append(ids, id)
+		}
+		return ids
 	}
+	return nil
 }
 
-// RemovedHistograms returns the removed ids of histograms.
-func (m *MetricMutation) RemovedHistogramsIDs() (ids []int) {
-	for id := range m.removedhistograms {
-		ids = append(ids, id)
+// ClearedEdges returns all edge names that were cleared in this
+// mutation.
+func (m *WebhookMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 1)
+	if m.cleareddeliveries {
+		edges = append(edges, webhook.EdgeDeliveries)
 	}
-	return
+	return edges
 }
 
-// HistogramsIDs returns the histograms ids in the mutation.
-func (m *MetricMutation) HistogramsIDs() (ids []int) {
-	for id := range m.histograms {
-		ids = append(ids, id)
+// EdgeCleared returns a boolean indicates if this edge was
+// cleared in this mutation.
+func (m *WebhookMutation) EdgeCleared(name string) bool {
+	switch name {
+	case webhook.EdgeDeliveries:
+		return m.cleareddeliveries
 	}
-	return
+	return false
 }
 
-// ResetHistograms reset all changes of the "histograms" edge.
-func (m *MetricMutation) ResetHistograms() {
-	m.histograms = nil
-	m.clearedhistograms = false
-	m.removedhistograms = nil
+// ClearEdge clears the value for the given name. It returns an
+// error if the edge name is not defined in the schema.
+func (m *WebhookMutation) ClearEdge(name string) error {
+	switch name {
+	}
+	return fmt.Errorf("unknown Webhook unique edge %s", name)
 }
 
-// AddCounterIDs adds the counters edge to Counter by ids.
-func (m *MetricMutation) AddCounterIDs(ids ...int) {
-	if m.counters == nil {
-		m.counters = make(map[int]struct{})
-	}
-	for i := range ids {
-		m.counters[ids[i]] = struct{}{}
+// ResetEdge resets all changes in the mutation regarding the
+// given edge name. It returns an error if the edge is not
+// defined in the schema.
+func (m *WebhookMutation) ResetEdge(name string) error {
+	switch name {
+	case webhook.EdgeDeliveries:
+		m.ResetDeliveries()
+		return nil
 	}
+	return fmt.Errorf("unknown Webhook edge %s", name)
 }
 
-// ClearCounters clears the counters edge to Counter.
-func (m *MetricMutation) ClearCounters() {
-	m.clearedcounters = true
+// WebhookDeliveryMutation represents an operation that mutate the WebhookDeliveries
+// nodes in the graph.
+type WebhookDeliveryMutation struct {
+	config
+	op                Op
+	typ               string
+	id                *int
+	event             *string
+	application_id    *int
+	addapplication_id *int
+	payload           *string
+	attempts          *int
+	addattempts       *int
+	status_code       *int
+	addstatus_code    *int
+	error             *string
+	success           *bool
+	created_at        *time.Time
+	delivered_at      *time.Time
+	clearedFields     map[string]struct{}
+	webhook           *int
+	clearedwebhook    bool
+	done              bool
+	oldValue          func(context.Context) (*WebhookDelivery, error)
 }
 
-// CountersCleared returns if the edge counters was cleared.
-func (m *MetricMutation) CountersCleared() bool {
-	return m.clearedcounters
-}
+var _ ent.Mutation = (*WebhookDeliveryMutation)(nil)
 
-// RemoveCounterIDs removes the counters edge to Counter by ids.
-func (m *MetricMutation) RemoveCounterIDs(ids ...int) {
-	if m.removedcounters == nil {
-		m.removedcounters = make(map[int]struct{})
+// webhookdeliveryOption allows to manage the mutation configuration using functional options.
+type webhookdeliveryOption func(*WebhookDeliveryMutation)
+
+// newWebhookDeliveryMutation creates new mutation for $n.Name.
+func newWebhookDeliveryMutation(c config, op Op, opts ...webhookdeliveryOption) *WebhookDeliveryMutation {
+	m := &WebhookDeliveryMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeWebhookDelivery,
+		clearedFields: make(map[string]struct{}),
 	}
-	for i := range ids {
-		m.removedcounters[ids[i]] = struct{}{}
+	for _, opt := range opts {
+		opt(m)
 	}
+	return m
 }
 
-// RemovedCounters returns the removed ids of counters.
-func (m *MetricMutation) RemovedCountersIDs() (ids []int) {
-	for id := range m.removedcounters {
-		ids = append(ids, id)
+// withWebhookDeliveryID sets the id field of the mutation.
+func withWebhookDeliveryID(id int) webhookdeliveryOption {
+	return func(m *WebhookDeliveryMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *WebhookDelivery
+		)
+		m.oldValue = func(ctx context.Context) (*WebhookDelivery, error) {
+			once.Do(func() {
+				if m.done {
+					err = fmt.Errorf("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().WebhookDelivery.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
 	}
-	return
 }
 
-// CountersIDs returns the counters ids in the mutation.
-func (m *MetricMutation) CountersIDs() (ids []int) {
-	for id := range m.counters {
-		ids = append(ids, id)
+// withWebhookDelivery sets the old WebhookDelivery of the mutation.
+func withWebhookDelivery(node *WebhookDelivery) webhookdeliveryOption {
+	return func(m *WebhookDeliveryMutation) {
+		m.oldValue = func(context.Context) (*WebhookDelivery, error) {
+			return node, nil
+		}
+		m.id = &node.ID
 	}
-	return
 }
 
-// ResetCounters reset all changes of the "counters" edge.
-func (m *MetricMutation) ResetCounters() {
-	m.counters = nil
-	m.clearedcounters = false
-	m.removedcounters = nil
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m WebhookDeliveryMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
 }
 
-// AddGaugeIDs adds the gauges edge to Gauge by ids.
-func (m *MetricMutation) AddGaugeIDs(ids ...int) {
-	if m.gauges == nil {
-		m.gauges = make(map[int]struct{})
-	}
-	for i := range ids {
-		m.gauges[ids[i]] = struct{}{}
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m WebhookDeliveryMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, fmt.Errorf("ent: mutation is not running in a transaction")
 	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
 }
 
-// ClearGauges clears the gauges edge to Gauge.
-func (m *MetricMutation) ClearGauges() {
-	m.clearedgauges = true
+// ID returns the id value in the mutation. Note that, the id
+// is available only if it was provided to the builder.
+func (m *WebhookDeliveryMutation) ID() (id int, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
 }
 
-// GaugesCleared returns if the edge gauges was cleared.
-func (m *MetricMutation) GaugesCleared() bool {
-	return m.clearedgauges
+// SetEvent sets the event field.
+func (m *WebhookDeliveryMutation) SetEvent(s string) {
+	m.event = &s
 }
 
-// RemoveGaugeIDs removes the gauges edge to Gauge by ids.
-func (m *MetricMutation) RemoveGaugeIDs(ids ...int) {
-	if m.removedgauges == nil {
-		m.removedgauges = make(map[int]struct{})
-	}
-	for i := range ids {
-		m.removedgauges[ids[i]] = struct{}{}
+// Event returns the event value in the mutation.
+func (m *WebhookDeliveryMutation) Event() (r string, exists bool) {
+	v := m.event
+	if v == nil {
+		return
 	}
+	return *v, true
 }
 
-// RemovedGauges returns the removed ids of gauges.
-func (m *MetricMutation) RemovedGaugesIDs() (ids []int) {
-	for id := range m.removedgauges {
-		ids = append(ids, id)
+// OldEvent returns the old event value of the WebhookDelivery.
+// If the WebhookDelivery object wasn't provided to the builder, the object is fetched
+// from the database.
+// An error is returned if the mutation operation is not UpdateOne, or database query fails.
+func (m *WebhookDeliveryMutation) OldEvent(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, fmt.Errorf("OldEvent is allowed only on UpdateOne operations")
 	}
-	return
-}
-
-// GaugesIDs returns the gauges ids in the mutation.
-func (m *MetricMutation) GaugesIDs() (ids []int) {
-	for id := range m.gauges {
-		ids = append(ids, id)
+	if m.id == nil || m.oldValue == nil {
+		return v, fmt.Errorf("OldEvent requires an ID field in the mutation")
 	}
-	return
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldEvent: %w", err)
+	}
+	return oldValue.Event, nil
 }
 
-// ResetGauges reset all changes of the "gauges" edge.
-func (m *MetricMutation) ResetGauges() {
-	m.gauges = nil
-	m.clearedgauges = false
-	m.removedgauges = nil
+// ResetEvent reset all changes of the "event" field.
+func (m *WebhookDeliveryMutation) ResetEvent() {
+	m.event = nil
 }
 
-// Op returns the operation name.
-func (m *MetricMutation) Op() Op {
-	return m.op
+// SetApplicationID sets the application_id field.
+func (m *WebhookDeliveryMutation) SetApplicationID(i int) {
+	m.application_id = &i
+	m.addapplication_id = nil
 }
 
-// Type returns the node type of this mutation (Metric).
-func (m *MetricMutation) Type() string {
-	return m.typ
+// ApplicationID returns the application_id value in the mutation.
+func (m *WebhookDeliveryMutation) ApplicationID() (r int, exists bool) {
+	v := m.application_id
+	if v == nil {
+		return
+	}
+	return *v, true
 }
 
-// Fields returns all fields that were changed during
-// this mutation. Note that, in order to get all numeric
-// fields that were in/decremented, call AddedFields().
-func (m *MetricMutation) Fields() []string {
-	fields := make([]string, 0, 2)
-	if m.title != nil {
-		fields = append(fields, metric.FieldTitle)
+// OldApplicationID returns the old application_id value of the WebhookDelivery.
+// If the WebhookDelivery object wasn't provided to the builder, the object is fetched
+// from the database.
+// An error is returned if the mutation operation is not UpdateOne, or database query fails.
+func (m *WebhookDeliveryMutation) OldApplicationID(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, fmt.Errorf("OldApplicationID is allowed only on UpdateOne operations")
 	}
-	if m._type != nil {
-		fields = append(fields, metric.FieldType)
+	if m.id == nil || m.oldValue == nil {
+		return v, fmt.Errorf("OldApplicationID requires an ID field in the mutation")
 	}
-	return fields
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldApplicationID: %w", err)
+	}
+	return oldValue.ApplicationID, nil
 }
 
-// Field returns the value of a field with the given name.
-// The second boolean value indicates that this field was
-// not set, or was not define in the schema.
-func (m *MetricMutation) Field(name string) (ent.Value, bool) {
-	switch name {
-	case metric.FieldTitle:
-		return m.Title()
-	case metric.FieldType:
-		return m.GetType()
+// AddApplicationID adds i to application_id.
+func (m *WebhookDeliveryMutation) AddApplicationID(i int) {
+	if m.addapplication_id != nil {
+		*m.addapplication_id += i
+	} else {
+		m.addapplication_id = &i
 	}
-	return nil, false
 }
 
-// OldField returns the old value of the field from the database.
-// An error is returned if the mutation operation is not UpdateOne,
-// or the query to the database was failed.
-func (m *MetricMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
-	switch name {
-	case metric.FieldTitle:
-		return m.OldTitle(ctx)
-	case metric.FieldType:
-		return m.OldType(ctx)
+// AddedApplicationID returns the value that was added to the application_id field in this mutation.
+func (m *WebhookDeliveryMutation) AddedApplicationID() (r int, exists bool) {
+	v := m.addapplication_id
+	if v == nil {
+		return
 	}
-	return nil, fmt.Errorf("unknown Metric field %s", name)
+	return *v, true
 }
 
-// SetField sets the value for the given name. It returns an
-// error if the field is not defined in the schema, or if the
-// type mismatch the field type.
-func (m *MetricMutation) SetField(name string, value ent.Value) error {
-	switch name {
-	case metric.FieldTitle:
-		v, ok := value.(string)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetTitle(v)
-		return nil
-	case metric.FieldType:
-		v, ok := value.(string)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetType(v)
-		return nil
+// ResetApplicationID reset all changes of the "application_id" field.
+func (m *WebhookDeliveryMutation) ResetApplicationID() {
+	m.application_id = nil
+	m.addapplication_id = nil
+}
+
+// SetPayload sets the payload field.
+func (m *WebhookDeliveryMutation) SetPayload(s string) {
+	m.payload = &s
+}
+
+// Payload returns the payload value in the mutation.
+func (m *WebhookDeliveryMutation) Payload() (r string, exists bool) {
+	v := m.payload
+	if v == nil {
+		return
 	}
-	return fmt.Errorf("unknown Metric field %s", name)
+	return *v, true
+}
+
+// OldPayload returns the old payload value of the WebhookDelivery.
+// If the WebhookDelivery object wasn't provided to the builder, the object is fetched
+// from the database.
+// An error is returned if the mutation operation is not UpdateOne, or database query fails.
+func (m *WebhookDeliveryMutation) OldPayload(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, fmt.Errorf("OldPayload is allowed only on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, fmt.Errorf("OldPayload requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldPayload: %w", err)
+	}
+	return oldValue.Payload, nil
 }
 
-// AddedFields returns all numeric fields that were incremented
-// or decremented during this mutation.
-func (m *MetricMutation) AddedFields() []string {
-	return nil
+// ResetPayload reset all changes of the "payload" field.
+func (m *WebhookDeliveryMutation) ResetPayload() {
+	m.payload = nil
 }
 
-// AddedField returns the numeric value that was in/decremented
-// from a field with the given name. The second value indicates
-// that this field was not set, or was not define in the schema.
-func (m *MetricMutation) AddedField(name string) (ent.Value, bool) {
-	return nil, false
+// SetAttempts sets the attempts field.
+func (m *WebhookDeliveryMutation) SetAttempts(i int) {
+	m.attempts = &i
+	m.addattempts = nil
 }
 
-// AddField adds the value for the given name. It returns an
-// error if the field is not defined in the schema, or if the
-// type mismatch the field type.
-func (m *MetricMutation) AddField(name string, value ent.Value) error {
-	switch name {
+// Attempts returns the attempts value in the mutation.
+func (m *WebhookDeliveryMutation) Attempts() (r int, exists bool) {
+	v := m.attempts
+	if v == nil {
+		return
 	}
-	return fmt.Errorf("unknown Metric numeric field %s", name)
+	return *v, true
 }
 
-// ClearedFields returns all nullable fields that were cleared
-// during this mutation.
-func (m *MetricMutation) ClearedFields() []string {
-	return nil
+// OldAttempts returns the old attempts value of the WebhookDelivery.
+// If the WebhookDelivery object wasn't provided to the builder, the object is fetched
+// from the database.
+// An error is returned if the mutation operation is not UpdateOne, or database query fails.
+func (m *WebhookDeliveryMutation) OldAttempts(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, fmt.Errorf("OldAttempts is allowed only on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, fmt.Errorf("OldAttempts requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldAttempts: %w", err)
+	}
+	return oldValue.Attempts, nil
 }
 
-// FieldCleared returns a boolean indicates if this field was
-// cleared in this mutation.
-func (m *MetricMutation) FieldCleared(name string) bool {
-	_, ok := m.clearedFields[name]
-	return ok
+// AddAttempts adds i to attempts.
+func (m *WebhookDeliveryMutation) AddAttempts(i int) {
+	if m.addattempts != nil {
+		*m.addattempts += i
+	} else {
+		m.addattempts = &i
+	}
 }
 
-// ClearField clears the value for the given name. It returns an
-// error if the field is not defined in the schema.
-func (m *MetricMutation) ClearField(name string) error {
-	return fmt.Errorf("unknown Metric nullable field %s", name)
+// AddedAttempts returns the value that was added to the attempts field in this mutation.
+func (m *WebhookDeliveryMutation) AddedAttempts() (r int, exists bool) {
+	v := m.addattempts
+	if v == nil {
+		return
+	}
+	return *v, true
 }
 
-// ResetField resets all changes in the mutation regarding the
-// given field name. It returns an error if the field is not
-// defined in the schema.
-func (m *MetricMutation) ResetField(name string) error {
-	switch name {
-	case metric.FieldTitle:
-		m.ResetTitle()
-		return nil
-	case metric.FieldType:
-		m.ResetType()
-		return nil
-	}
-	return fmt.Errorf("unknown Metric field %s", name)
+// ResetAttempts reset all changes of the "attempts" field.
+func (m *WebhookDeliveryMutation) ResetAttempts() {
+	m.attempts = nil
+	m.addattempts = nil
 }
 
-// AddedEdges returns all edge names that were set/added in this
-// mutation.
-func (m *MetricMutation) AddedEdges() []string {
-	edges := make([]string, 0, 4)
-	if m.graph != nil {
-		edges = append(edges, metric.EdgeGraph)
+// SetStatusCode sets the status_code field.
+func (m *WebhookDeliveryMutation) SetStatusCode(i int) {
+	m.status_code = &i
+	m.addstatus_code = nil
+}
+
+// StatusCode returns the status_code value in the mutation.
+func (m *WebhookDeliveryMutation) StatusCode() (r int, exists bool) {
+	v := m.status_code
+	if v == nil {
+		return
 	}
-	if m.histograms != nil {
-		edges = append(edges, metric.EdgeHistograms)
+	return *v, true
+}
+
+// OldStatusCode returns the old status_code value of the WebhookDelivery.
+// If the WebhookDelivery object wasn't provided to the builder, the object is fetched
+// from the database.
+// An error is returned if the mutation operation is not UpdateOne, or database query fails.
+func (m *WebhookDeliveryMutation) OldStatusCode(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, fmt.Errorf("OldStatusCode is allowed only on UpdateOne operations")
 	}
-	if m.counters != nil {
-		edges = append(edges, metric.EdgeCounters)
+	if m.id == nil || m.oldValue == nil {
+		return v, fmt.Errorf("OldStatusCode requires an ID field in the mutation")
 	}
-	if m.gauges != nil {
-		edges = append(edges, metric.EdgeGauges)
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldStatusCode: %w", err)
 	}
-	return edges
+	return oldValue.StatusCode, nil
 }
 
-// AddedIDs returns all ids (to other nodes) that were added for
-// the given edge name.
-func (m *MetricMutation) AddedIDs(name string) []ent.Value {
-	switch name {
-	case metric.EdgeGraph:
-		if id := m.graph; id != nil {
-			return []ent.Value{*id}
-		}
-	case metric.EdgeHistograms:
-		ids := make([]ent.Value, 0, len(m.histograms))
-		for id := range m.histograms {
-			ids = append(ids, id)
-		}
-		return ids
-	case metric.EdgeCounters:
-		ids := make([]ent.Value, 0, len(m.counters))
-		for id := range m.counters {
-			ids = append(ids, id)
-		}
-		return ids
-	case metric.EdgeGauges:
-		ids := make([]ent.Value, 0, len(m.gauges))
-		for id := range m.gauges {
-			ids = append(ids, id)
-		}
-		return ids
+// AddStatusCode adds i to status_code.
+func (m *WebhookDeliveryMutation) AddStatusCode(i int) {
+	if m.addstatus_code != nil {
+		*m.addstatus_code += i
+	} else {
+		m.addstatus_code = &i
 	}
-	return nil
 }
 
-// RemovedEdges returns all edge names that were removed in this
-// mutation.
-func (m *MetricMutation) RemovedEdges() []string {
-	edges := make([]string, 0, 4)
-	if m.removedhistograms != nil {
-		edges = append(edges, metric.EdgeHistograms)
-	}
-	if m.removedcounters != nil {
-		edges = append(edges, metric.EdgeCounters)
-	}
-	if m.removedgauges != nil {
-		edges = append(edges, metric.EdgeGauges)
+// AddedStatusCode returns the value that was added to the status_code field in this mutation.
+func (m *WebhookDeliveryMutation) AddedStatusCode() (r int, exists bool) {
+	v := m.addstatus_code
+	if v == nil {
+		return
 	}
-	return edges
+	return *v, true
 }
 
-// RemovedIDs returns all ids (to other nodes) that were removed for
-// the given edge name.
-func (m *MetricMutation) RemovedIDs(name string) []ent.Value {
-	switch name {
-	case metric.EdgeHistograms:
-		ids := make([]ent.Value, 0, len(m.removedhistograms))
-		for id := range m.removedhistograms {
-			ids = append(ids, id)
-		}
-		return ids
-	case metric.EdgeCounters:
-		ids := make([]ent.Value, 0, len(m.removedcounters))
-		for id := range m.removedcounters {
-			ids = append(ids, id)
-		}
-		return ids
-	case metric.EdgeGauges:
-		ids := make([]ent.Value, 0, len(m.removedgauges))
-		for id := range m.removedgauges {
-			ids = append(ids, id)
-		}
-		return ids
-	}
-	return nil
+// ResetStatusCode reset all changes of the "status_code" field.
+func (m *WebhookDeliveryMutation) ResetStatusCode() {
+	m.status_code = nil
+	m.addstatus_code = nil
 }
 
-// ClearedEdges returns all edge names that were cleared in this
-// mutation.
-func (m *MetricMutation) ClearedEdges() []string {
-	edges := make([]string, 0, 4)
-	if m.clearedgraph {
-		edges = append(edges, metric.EdgeGraph)
+// SetError sets the error field.
+func (m *WebhookDeliveryMutation) SetError(s string) {
+	m.error = &s
+}
+
+// Error returns the error value in the mutation.
+func (m *WebhookDeliveryMutation) Error() (r string, exists bool) {
+	v := m.error
+	if v == nil {
+		return
 	}
-	if m.clearedhistograms {
-		edges = append(edges, metric.EdgeHistograms)
+	return *v, true
+}
+
+// OldError returns the old error value of the WebhookDelivery.
+// If the WebhookDelivery object wasn't provided to the builder, the object is fetched
+// from the database.
+// An error is returned if the mutation operation is not UpdateOne, or database query fails.
+func (m *WebhookDeliveryMutation) OldError(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, fmt.Errorf("OldError is allowed only on UpdateOne operations")
 	}
-	if m.clearedcounters {
-		edges = append(edges, metric.EdgeCounters)
+	if m.id == nil || m.oldValue == nil {
+		return v, fmt.Errorf("OldError requires an ID field in the mutation")
 	}
-	if m.clearedgauges {
-		edges = append(edges, metric.EdgeGauges)
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldError: %w", err)
 	}
-	return edges
+	return oldValue.Error, nil
 }
 
-// EdgeCleared returns a boolean indicates if this edge was
-// cleared in this mutation.
-func (m *MetricMutation) EdgeCleared(name string) bool {
-	switch name {
-	case metric.EdgeGraph:
-		return m.clearedgraph
-	case metric.EdgeHistograms:
-		return m.clearedhistograms
-	case metric.EdgeCounters:
-		return m.clearedcounters
-	case metric.EdgeGauges:
-		return m.clearedgauges
-	}
-	return false
+// ClearError clears the value of error.
+func (m *WebhookDeliveryMutation) ClearError() {
+	m.error = nil
+	m.clearedFields[webhookdelivery.FieldError] = struct{}{}
 }
 
-// ClearEdge clears the value for the given name. It returns an
-// error if the edge name is not defined in the schema.
-func (m *MetricMutation) ClearEdge(name string) error {
-	switch name {
-	case metric.EdgeGraph:
-		m.ClearGraph()
-		return nil
-	}
-	return fmt.Errorf("unknown Metric unique edge %s", name)
+// ErrorCleared returns if the field error was cleared in this mutation.
+func (m *WebhookDeliveryMutation) ErrorCleared() bool {
+	_, ok := m.clearedFields[webhookdelivery.FieldError]
+	return ok
 }
 
-// ResetEdge resets all changes in the mutation regarding the
-// given edge name. It returns an error if the edge is not
-// defined in the schema.
-func (m *MetricMutation) ResetEdge(name string) error {
-	switch name {
-	case metric.EdgeGraph:
-		m.ResetGraph()
-		return nil
-	case metric.EdgeHistograms:
-		m.ResetHistograms()
-		return nil
-	case metric.EdgeCounters:
-		m.ResetCounters()
-		return nil
-	case metric.EdgeGauges:
-		m.ResetGauges()
-		return nil
-	}
-	return fmt.Errorf("unknown Metric edge %s", name)
+// ResetError reset all changes of the "error" field.
+func (m *WebhookDeliveryMutation) ResetError() {
+	m.error = nil
+	delete(m.clearedFields, webhookdelivery.FieldError)
 }
 
-// TagMutation represents an operation that mutate the Tags
-// nodes in the graph.
-type TagMutation struct {
-	config
-	op                 Op
-	typ                string
-	id                 *int
-	name               *string
-	clearedFields      map[string]struct{}
-	application        *int
-	clearedapplication bool
-	done               bool
-	oldValue           func(context.Context) (*Tag, error)
+// SetSuccess sets the success field.
+func (m *WebhookDeliveryMutation) SetSuccess(b bool) {
+	m.success = &b
 }
 
-var _ ent.Mutation = (*TagMutation)(nil)
-
-// tagOption allows to manage the mutation configuration using functional options.
-type tagOption func(*TagMutation)
+// Success returns the success value in the mutation.
+func (m *WebhookDeliveryMutation) Success() (r bool, exists bool) {
+	v := m.success
+	if v == nil {
+		return
+	}
+	return *v, true
+}
 
-// newTagMutation creates new mutation for $n.Name.
-func newTagMutation(c config, op Op, opts ...tagOption) *TagMutation {
-	m := &TagMutation{
-		config:        c,
-		op:            op,
-		typ:           TypeTag,
-		clearedFields: make(map[string]struct{}),
+// OldSuccess returns the old success value of the WebhookDelivery.
+// If the WebhookDelivery object wasn't provided to the builder, the object is fetched
+// from the database.
+// An error is returned if the mutation operation is not UpdateOne, or database query fails.
+func (m *WebhookDeliveryMutation) OldSuccess(ctx context.Context) (v bool, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, fmt.Errorf("OldSuccess is allowed only on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, fmt.Errorf("OldSuccess requires an ID field in the mutation")
 	}
-	for _, opt := range opts {
-		opt(m)
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldSuccess: %w", err)
 	}
-	return m
+	return oldValue.Success, nil
 }
 
-// withTagID sets the id field of the mutation.
-func withTagID(id int) tagOption {
-	return func(m *TagMutation) {
-		var (
-			err   error
-			once  sync.Once
-			value *Tag
-		)
-		m.oldValue = func(ctx context.Context) (*Tag, error) {
-			once.Do(func() {
-				if m.done {
-					err = fmt.Errorf("querying old values post mutation is not allowed")
-				} else {
-					value, err = m.Client().Tag.Get(ctx, id)
-				}
-			})
-			return value, err
-		}
-		m.id = &id
-	}
+// ResetSuccess reset all changes of the "success" field.
+func (m *WebhookDeliveryMutation) ResetSuccess() {
+	m.success = nil
 }
 
-// withTag sets the old Tag of the mutation.
-func withTag(node *Tag) tagOption {
-	return func(m *TagMutation) {
-		m.oldValue = func(context.Context) (*Tag, error) {
-			return node, nil
-		}
-		m.id = &node.ID
-	}
+// SetCreatedAt sets the created_at field.
+func (m *WebhookDeliveryMutation) SetCreatedAt(t time.Time) {
+	m.created_at = &t
 }
 
-// Client returns a new `ent.Client` from the mutation. If the mutation was
-// executed in a transaction (ent.Tx), a transactional client is returned.
-func (m TagMutation) Client() *Client {
-	client := &Client{config: m.config}
-	client.init()
-	return client
+// CreatedAt returns the created_at value in the mutation.
+func (m *WebhookDeliveryMutation) CreatedAt() (r time.Time, exists bool) {
+	v := m.created_at
+	if v == nil {
+		return
+	}
+	return *v, true
 }
 
-// Tx returns an `ent.Tx` for mutations that were executed in transactions;
-// it returns an error otherwise.
-func (m TagMutation) Tx() (*Tx, error) {
-	if _, ok := m.driver.(*txDriver); !ok {
-		return nil, fmt.Errorf("ent: mutation is not running in a transaction")
+// OldCreatedAt returns the old created_at value of the WebhookDelivery.
+// If the WebhookDelivery object wasn't provided to the builder, the object is fetched
+// from the database.
+// An error is returned if the mutation operation is not UpdateOne, or database query fails.
+func (m *WebhookDeliveryMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, fmt.Errorf("OldCreatedAt is allowed only on UpdateOne operations")
 	}
-	tx := &Tx{config: m.config}
-	tx.init()
-	return tx, nil
+	if m.id == nil || m.oldValue == nil {
+		return v, fmt.Errorf("OldCreatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
+	}
+	return oldValue.CreatedAt, nil
 }
 
-// ID returns the id value in the mutation. Note that, the id
-// is available only if it was provided to the builder.
-func (m *TagMutation) ID() (id int, exists bool) {
-	if m.id == nil {
-		return
-	}
-	return *m.id, true
+// ResetCreatedAt reset all changes of the "created_at" field.
+func (m *WebhookDeliveryMutation) ResetCreatedAt() {
+	m.created_at = nil
 }
 
-// SetName sets the name field.
-func (m *TagMutation) SetName(s string) {
-	m.name = &s
+// SetDeliveredAt sets the delivered_at field.
+func (m *WebhookDeliveryMutation) SetDeliveredAt(t time.Time) {
+	m.delivered_at = &t
 }
 
-// Name returns the name value in the mutation.
-func (m *TagMutation) Name() (r string, exists bool) {
-	v := m.name
+// DeliveredAt returns the delivered_at value in the mutation.
+func (m *WebhookDeliveryMutation) DeliveredAt() (r time.Time, exists bool) {
+	v := m.delivered_at
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldName returns the old name value of the Tag.
-// If the Tag object wasn't provided to the builder, the object is fetched
+// OldDeliveredAt returns the old delivered_at value of the WebhookDelivery.
+// If the WebhookDelivery object wasn't provided to the builder, the object is fetched
 // from the database.
 // An error is returned if the mutation operation is not UpdateOne, or database query fails.
-func (m *TagMutation) OldName(ctx context.Context) (v string, err error) {
+func (m *WebhookDeliveryMutation) OldDeliveredAt(ctx context.Context) (v *time.Time, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, fmt.Errorf("OldName is allowed only on UpdateOne operations")
+		return v, fmt.Errorf("OldDeliveredAt is allowed only on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, fmt.Errorf("OldName requires an ID field in the mutation")
+		return v, fmt.Errorf("OldDeliveredAt requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldName: %w", err)
+		return v, fmt.Errorf("querying old value for OldDeliveredAt: %w", err)
 	}
-	return oldValue.Name, nil
+	return oldValue.DeliveredAt, nil
 }
 
-// ResetName reset all changes of the "name" field.
-func (m *TagMutation) ResetName() {
-	m.name = nil
+// ClearDeliveredAt clears the value of delivered_at.
+func (m *WebhookDeliveryMutation) ClearDeliveredAt() {
+	m.delivered_at = nil
+	m.clearedFields[webhookdelivery.FieldDeliveredAt] = struct{}{}
 }
 
-// SetApplicationID sets the application edge to Application by id.
-func (m *TagMutation) SetApplicationID(id int) {
-	m.application = &id
+// DeliveredAtCleared returns if the field delivered_at was cleared in this mutation.
+func (m *WebhookDeliveryMutation) DeliveredAtCleared() bool {
+	_, ok := m.clearedFields[webhookdelivery.FieldDeliveredAt]
+	return ok
 }
 
-// ClearApplication clears the application edge to Application.
-func (m *TagMutation) ClearApplication() {
-	m.clearedapplication = true
+// ResetDeliveredAt reset all changes of the "delivered_at" field.
+func (m *WebhookDeliveryMutation) ResetDeliveredAt() {
+	m.delivered_at = nil
+	delete(m.clearedFields, webhookdelivery.FieldDeliveredAt)
 }
 
-// ApplicationCleared returns if the edge application was cleared.
-func (m *TagMutation) ApplicationCleared() bool {
-	return m.clearedapplication
+// SetWebhookID sets the webhook edge to Webhook by id.
+func (m *WebhookDeliveryMutation) SetWebhookID(id int) {
+	m.webhook = &id
 }
 
-// ApplicationID returns the application id in the mutation.
-func (m *TagMutation) ApplicationID() (id int, exists bool) {
-	if m.application != nil {
-		return *m.application, true
+// ClearWebhook clears the webhook edge to Webhook.
+func (m *WebhookDeliveryMutation) ClearWebhook() {
+	m.clearedwebhook = true
+}
+
+// WebhookCleared returns if the edge webhook was cleared.
+func (m *WebhookDeliveryMutation) WebhookCleared() bool {
+	return m.clearedwebhook
+}
+
+// WebhookID returns the webhook id in the mutation.
+func (m *WebhookDeliveryMutation) WebhookID() (id int, exists bool) {
+	if m.webhook != nil {
+		return *m.webhook, true
 	}
 	return
 }
 
-// ApplicationIDs returns the application ids in the mutation.
+// WebhookIDs returns the webhook ids in the mutation.
 // Note that ids always returns len(ids) <= 1 for unique edges, and you should use
-// ApplicationID instead. It exists only for internal usage by the builders.
-func (m *TagMutation) ApplicationIDs() (ids []int) {
-	if id := m.application; id != nil {
+// WebhookID instead. It exists only for internal usage by the builders.
+func (m *WebhookDeliveryMutation) WebhookIDs() (ids []int) {
+	if id := m.webhook; id != nil {
 		ids = append(ids, *id)
 	}
 	return
 }
 
-// ResetApplication reset all changes of the "application" edge.
-func (m *TagMutation) ResetApplication() {
-	m.application = nil
-	m.clearedapplication = false
+// ResetWebhook reset all changes of the "webhook" edge.
+func (m *WebhookDeliveryMutation) ResetWebhook() {
+	m.webhook = nil
+	m.clearedwebhook = false
 }
 
 // Op returns the operation name.
-func (m *TagMutation) Op() Op {
+func (m *WebhookDeliveryMutation) Op() Op {
 	return m.op
 }
 
-// Type returns the node type of this mutation (Tag).
-func (m *TagMutation) Type() string {
+// Type returns the node type of this mutation (WebhookDelivery).
+func (m *WebhookDeliveryMutation) Type() string {
 	return m.typ
 }
 
 // Fields returns all fields that were changed during
 // this mutation. Note that, in order to get all numeric
 // fields that were in/decremented, call AddedFields().
-func (m *TagMutation) Fields() []string {
-	fields := make([]string, 0, 1)
-	if m.name != nil {
-		fields = append(fields, tag.FieldName)
+func (m *WebhookDeliveryMutation) Fields() []string {
+	fields := make([]string, 0, 9)
+	if m.event != nil {
+		fields = append(fields, webhookdelivery.FieldEvent)
+	}
+	if m.application_id != nil {
+		fields = append(fields, webhookdelivery.FieldApplicationID)
+	}
+	if m.payload != nil {
+		fields = append(fields, webhookdelivery.FieldPayload)
+	}
+	if m.attempts != nil {
+		fields = append(fields, webhookdelivery.FieldAttempts)
+	}
+	if m.status_code != nil {
+		fields = append(fields, webhookdelivery.FieldStatusCode)
+	}
+	if m.error != nil {
+		fields = append(fields, webhookdelivery.FieldError)
+	}
+	if m.success != nil {
+		fields = append(fields, webhookdelivery.FieldSuccess)
+	}
+	if m.created_at != nil {
+		fields = append(fields, webhookdelivery.FieldCreatedAt)
+	}
+	if m.delivered_at != nil {
+		fields = append(fields, webhookdelivery.FieldDeliveredAt)
 	}
 	return fields
 }
@@ -5128,10 +13455,26 @@ func (m *TagMutation) Fields() []string {
 // Field returns the value of a field with the given name.
 // The second boolean value indicates that this field was
 // not set, or was not define in the schema.
-func (m *TagMutation) Field(name string) (ent.Value, bool) {
+func (m *WebhookDeliveryMutation) Field(name string) (ent.Value, bool) {
 	switch name {
-	case tag.FieldName:
-		return m.Name()
+	case webhookdelivery.FieldEvent:
+		return m.Event()
+	case webhookdelivery.FieldApplicationID:
+		return m.ApplicationID()
+	case webhookdelivery.FieldPayload:
+		return m.Payload()
+	case webhookdelivery.FieldAttempts:
+		return m.Attempts()
+	case webhookdelivery.FieldStatusCode:
+		return m.StatusCode()
+	case webhookdelivery.FieldError:
+		return m.Error()
+	case webhookdelivery.FieldSuccess:
+		return m.Success()
+	case webhookdelivery.FieldCreatedAt:
+		return m.CreatedAt()
+	case webhookdelivery.FieldDeliveredAt:
+		return m.DeliveredAt()
 	}
 	return nil, false
 }
@@ -5139,99 +13482,249 @@ func (m *TagMutation) Field(name string) (ent.Value, bool) {
 // OldField returns the old value of the field from the database.
 // An error is returned if the mutation operation is not UpdateOne,
 // or the query to the database was failed.
-func (m *TagMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+func (m *WebhookDeliveryMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
 	switch name {
-	case tag.FieldName:
-		return m.OldName(ctx)
+	case webhookdelivery.FieldEvent:
+		return m.OldEvent(ctx)
+	case webhookdelivery.FieldApplicationID:
+		return m.OldApplicationID(ctx)
+	case webhookdelivery.FieldPayload:
+		return m.OldPayload(ctx)
+	case webhookdelivery.FieldAttempts:
+		return m.OldAttempts(ctx)
+	case webhookdelivery.FieldStatusCode:
+		return m.OldStatusCode(ctx)
+	case webhookdelivery.FieldError:
+		return m.OldError(ctx)
+	case webhookdelivery.FieldSuccess:
+		return m.OldSuccess(ctx)
+	case webhookdelivery.FieldCreatedAt:
+		return m.OldCreatedAt(ctx)
+	case webhookdelivery.FieldDeliveredAt:
+		return m.OldDeliveredAt(ctx)
 	}
-	return nil, fmt.Errorf("unknown Tag field %s", name)
+	return nil, fmt.Errorf("unknown WebhookDelivery field %s", name)
 }
 
 // SetField sets the value for the given name. It returns an
 // error if the field is not defined in the schema, or if the
 // type mismatch the field type.
-func (m *TagMutation) SetField(name string, value ent.Value) error {
+func (m *WebhookDeliveryMutation) SetField(name string, value ent.Value) error {
 	switch name {
-	case tag.FieldName:
+	case webhookdelivery.FieldEvent:
 		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetName(v)
+		m.SetEvent(v)
+		return nil
+	case webhookdelivery.FieldApplicationID:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetApplicationID(v)
+		return nil
+	case webhookdelivery.FieldPayload:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetPayload(v)
+		return nil
+	case webhookdelivery.FieldAttempts:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetAttempts(v)
+		return nil
+	case webhookdelivery.FieldStatusCode:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetStatusCode(v)
+		return nil
+	case webhookdelivery.FieldError:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetError(v)
+		return nil
+	case webhookdelivery.FieldSuccess:
+		v, ok := value.(bool)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetSuccess(v)
+		return nil
+	case webhookdelivery.FieldCreatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCreatedAt(v)
+		return nil
+	case webhookdelivery.FieldDeliveredAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetDeliveredAt(v)
 		return nil
 	}
-	return fmt.Errorf("unknown Tag field %s", name)
+	return fmt.Errorf("unknown WebhookDelivery field %s", name)
 }
 
 // AddedFields returns all numeric fields that were incremented
 // or decremented during this mutation.
-func (m *TagMutation) AddedFields() []string {
-	return nil
+func (m *WebhookDeliveryMutation) AddedFields() []string {
+	var fields []string
+	if m.addapplication_id != nil {
+		fields = append(fields, webhookdelivery.FieldApplicationID)
+	}
+	if m.addattempts != nil {
+		fields = append(fields, webhookdelivery.FieldAttempts)
+	}
+	if m.addstatus_code != nil {
+		fields = append(fields, webhookdelivery.FieldStatusCode)
+	}
+	return fields
 }
 
 // AddedField returns the numeric value that was in/decremented
 // from a field with the given name. The second value indicates
 // that this field was not set, or was not define in the schema.
-func (m *TagMutation) AddedField(name string) (ent.Value, bool) {
+func (m *WebhookDeliveryMutation) AddedField(name string) (ent.Value, bool) {
+	switch name {
+	case webhookdelivery.FieldApplicationID:
+		return m.AddedApplicationID()
+	case webhookdelivery.FieldAttempts:
+		return m.AddedAttempts()
+	case webhookdelivery.FieldStatusCode:
+		return m.AddedStatusCode()
+	}
 	return nil, false
 }
 
 // AddField adds the value for the given name. It returns an
 // error if the field is not defined in the schema, or if the
 // type mismatch the field type.
-func (m *TagMutation) AddField(name string, value ent.Value) error {
+func (m *WebhookDeliveryMutation) AddField(name string, value ent.Value) error {
 	switch name {
+	case webhookdelivery.FieldApplicationID:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddApplicationID(v)
+		return nil
+	case webhookdelivery.FieldAttempts:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddAttempts(v)
+		return nil
+	case webhookdelivery.FieldStatusCode:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddStatusCode(v)
+		return nil
 	}
-	return fmt.Errorf("unknown Tag numeric field %s", name)
+	return fmt.Errorf("unknown WebhookDelivery numeric field %s", name)
 }
 
 // ClearedFields returns all nullable fields that were cleared
 // during this mutation.
-func (m *TagMutation) ClearedFields() []string {
-	return nil
+func (m *WebhookDeliveryMutation) ClearedFields() []string {
+	var fields []string
+	if m.FieldCleared(webhookdelivery.FieldError) {
+		fields = append(fields, webhookdelivery.FieldError)
+	}
+	if m.FieldCleared(webhookdelivery.FieldDeliveredAt) {
+		fields = append(fields, webhookdelivery.FieldDeliveredAt)
+	}
+	return fields
 }
 
 // FieldCleared returns a boolean indicates if this field was
 // cleared in this mutation.
-func (m *TagMutation) FieldCleared(name string) bool {
+func (m *WebhookDeliveryMutation) FieldCleared(name string) bool {
 	_, ok := m.clearedFields[name]
 	return ok
 }
 
 // ClearField clears the value for the given name. It returns an
 // error if the field is not defined in the schema.
-func (m *TagMutation) ClearField(name string) error {
-	return fmt.Errorf("unknown Tag nullable field %s", name)
+func (m *WebhookDeliveryMutation) ClearField(name string) error {
+	switch name {
+	case webhookdelivery.FieldError:
+		m.ClearError()
+		return nil
+	case webhookdelivery.FieldDeliveredAt:
+		m.ClearDeliveredAt()
+		return nil
+	}
+	return fmt.Errorf("unknown WebhookDelivery nullable field %s", name)
 }
 
 // ResetField resets all changes in the mutation regarding the
 // given field name. It returns an error if the field is not
 // defined in the schema.
-func (m *TagMutation) ResetField(name string) error {
+func (m *WebhookDeliveryMutation) ResetField(name string) error {
 	switch name {
-	case tag.FieldName:
-		m.ResetName()
+	case webhookdelivery.FieldEvent:
+		m.ResetEvent()
+		return nil
+	case webhookdelivery.FieldApplicationID:
+		m.ResetApplicationID()
+		return nil
+	case webhookdelivery.FieldPayload:
+		m.ResetPayload()
+		return nil
+	case webhookdelivery.FieldAttempts:
+		m.ResetAttempts()
+		return nil
+	case webhookdelivery.FieldStatusCode:
+		m.ResetStatusCode()
+		return nil
+	case webhookdelivery.FieldError:
+		m.ResetError()
+		return nil
+	case webhookdelivery.FieldSuccess:
+		m.ResetSuccess()
+		return nil
+	case webhookdelivery.FieldCreatedAt:
+		m.ResetCreatedAt()
+		return nil
+	case webhookdelivery.FieldDeliveredAt:
+		m.ResetDeliveredAt()
 		return nil
 	}
-	return fmt.Errorf("unknown Tag field %s", name)
+	return fmt.Errorf("unknown WebhookDelivery field %s", name)
 }
 
 // AddedEdges returns all edge names that were set/added in this
 // mutation.
-func (m *TagMutation) AddedEdges() []string {
+func (m *WebhookDeliveryMutation) AddedEdges() []string {
 	edges := make([]string, 0, 1)
-	if m.application != nil {
-		edges = append(edges, tag.EdgeApplication)
+	if m.webhook != nil {
+		edges = append(edges, webhookdelivery.EdgeWebhook)
 	}
 	return edges
 }
 
 // AddedIDs returns all ids (to other nodes) that were added for
 // the given edge name.
-func (m *TagMutation) AddedIDs(name string) []ent.Value {
+func (m *WebhookDeliveryMutation) AddedIDs(name string) []ent.Value {
 	switch name {
-	case tag.EdgeApplication:
-		if id := m.application; id != nil {
+	case webhookdelivery.EdgeWebhook:
+		if id := m.webhook; id != nil {
 			return []ent.Value{*id}
 		}
 	}
@@ -5240,14 +13733,14 @@ func (m *TagMutation) AddedIDs(name string) []ent.Value {
 
 // RemovedEdges returns all edge names that were removed in this
 // mutation.
-func (m *TagMutation) RemovedEdges() []string {
+func (m *WebhookDeliveryMutation) RemovedEdges() []string {
 	edges := make([]string, 0, 1)
 	return edges
 }
 
 // RemovedIDs returns all ids (to other nodes) that were removed for
 // the given edge name.
-func (m *TagMutation) RemovedIDs(name string) []ent.Value {
+func (m *WebhookDeliveryMutation) RemovedIDs(name string) []ent.Value {
 	switch name {
 	}
 	return nil
@@ -5255,43 +13748,43 @@ func (m *TagMutation) RemovedIDs(name string) []ent.Value {
 
 // ClearedEdges returns all edge names that were cleared in this
 // mutation.
-func (m *TagMutation) ClearedEdges() []string {
+func (m *WebhookDeliveryMutation) ClearedEdges() []string {
 	edges := make([]string, 0, 1)
-	if m.clearedapplication {
-		edges = append(edges, tag.EdgeApplication)
+	if m.clearedwebhook {
+		edges = append(edges, webhookdelivery.EdgeWebhook)
 	}
 	return edges
 }
 
 // EdgeCleared returns a boolean indicates if this edge was
 // cleared in this mutation.
-func (m *TagMutation) EdgeCleared(name string) bool {
+func (m *WebhookDeliveryMutation) EdgeCleared(name string) bool {
 	switch name {
-	case tag.EdgeApplication:
-		return m.clearedapplication
+	case webhookdelivery.EdgeWebhook:
+		return m.clearedwebhook
 	}
 	return false
 }
 
 // ClearEdge clears the value for the given name. It returns an
 // error if the edge name is not defined in the schema.
-func (m *TagMutation) ClearEdge(name string) error {
+func (m *WebhookDeliveryMutation) ClearEdge(name string) error {
 	switch name {
-	case tag.EdgeApplication:
-		m.ClearApplication()
+	case webhookdelivery.EdgeWebhook:
+		m.ClearWebhook()
 		return nil
 	}
-	return fmt.Errorf("unknown Tag unique edge %s", name)
+	return fmt.Errorf("unknown WebhookDelivery unique edge %s", name)
 }
 
 // ResetEdge resets all changes in the mutation regarding the
 // given edge name. It returns an error if the edge is not
 // defined in the schema.
-func (m *TagMutation) ResetEdge(name string) error {
+func (m *WebhookDeliveryMutation) ResetEdge(name string) error {
 	switch name {
-	case tag.EdgeApplication:
-		m.ResetApplication()
+	case webhookdelivery.EdgeWebhook:
+		m.ResetWebhook()
 		return nil
 	}
-	return fmt.Errorf("unknown Tag edge %s", name)
+	return fmt.Errorf("unknown WebhookDelivery edge %s", name)
 }
@@ -0,0 +1,179 @@
+// Code generated by entc, DO NOT EDIT.
+
+package ent
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/facebook/ent/dialect/sql"
+	"github.com/gobench-io/gobench/ent/project"
+)
+
+// Project is the model entity for the Project schema.
+type Project struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID int `json:"id,omitempty"`
+	// Name holds the value of the "name" field.
+	Name string `json:"name,omitempty"`
+	// MaxQueued holds the value of the "max_queued" field.
+	MaxQueued int `json:"max_queued,omitempty"`
+	// RawRetention holds the value of the "raw_retention" field.
+	RawRetention int64 `json:"raw_retention,omitempty"`
+	// RollupRetention holds the value of the "rollup_retention" field.
+	RollupRetention int64 `json:"rollup_retention,omitempty"`
+	// CreatedAt holds the value of the "created_at" field.
+	CreatedAt time.Time `json:"created_at,omitempty"`
+	// UpdatedAt holds the value of the "updated_at" field.
+	UpdatedAt time.Time `json:"updated_at,omitempty"`
+	// Edges holds the relations/edges for other nodes in the graph.
+	// The values are being populated by the ProjectQuery when eager-loading is set.
+	Edges ProjectEdges `json:"edges"`
+}
+
+// ProjectEdges holds the relations/edges for other nodes in the graph.
+type ProjectEdges struct {
+	// Applications holds the value of the applications edge.
+	Applications []*Application
+	// Members holds the value of the members edge.
+	Members []*User
+	// loadedTypes holds the information for reporting if a
+	// type was loaded (or requested) in eager-loading or not.
+	loadedTypes [2]bool
+}
+
+// ApplicationsOrErr returns the Applications value or an error if the edge
+// was not loaded in eager-loading.
+func (e ProjectEdges) ApplicationsOrErr() ([]*Application, error) {
+	if e.loadedTypes[0] {
+		return e.Applications, nil
+	}
+	return nil, &NotLoadedError{edge: "applications"}
+}
+
+// MembersOrErr returns the Members value or an error if the edge
+// was not loaded in eager-loading.
+func (e ProjectEdges) MembersOrErr() ([]*User, error) {
+	if e.loadedTypes[1] {
+		return e.Members, nil
+	}
+	return nil, &NotLoadedError{edge: "members"}
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*Project) scanValues() []interface{} {
+	return []interface{}{
+		&sql.NullInt64{},  // id
+		&sql.NullString{}, // name
+		&sql.NullInt64{},  // max_queued
+		&sql.NullInt64{},  // raw_retention
+		&sql.NullInt64{},  // rollup_retention
+		&sql.NullTime{},   // created_at
+		&sql.NullTime{},   // updated_at
+	}
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the Project fields.
+func (pr *Project) assignValues(values ...interface{}) error {
+	if m, n := len(values), len(project.Columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	value, ok := values[0].(*sql.NullInt64)
+	if !ok {
+		return fmt.Errorf("unexpected type %T for field id", value)
+	}
+	pr.ID = int(value.Int64)
+	values = values[1:]
+	if value, ok := values[0].(*sql.NullString); !ok {
+		return fmt.Errorf("unexpected type %T for field name", values[0])
+	} else if value.Valid {
+		pr.Name = value.String
+	}
+	if value, ok := values[1].(*sql.NullInt64); !ok {
+		return fmt.Errorf("unexpected type %T for field max_queued", values[1])
+	} else if value.Valid {
+		pr.MaxQueued = int(value.Int64)
+	}
+	if value, ok := values[2].(*sql.NullInt64); !ok {
+		return fmt.Errorf("unexpected type %T for field raw_retention", values[2])
+	} else if value.Valid {
+		pr.RawRetention = value.Int64
+	}
+	if value, ok := values[3].(*sql.NullInt64); !ok {
+		return fmt.Errorf("unexpected type %T for field rollup_retention", values[3])
+	} else if value.Valid {
+		pr.RollupRetention = value.Int64
+	}
+	if value, ok := values[4].(*sql.NullTime); !ok {
+		return fmt.Errorf("unexpected type %T for field created_at", values[4])
+	} else if value.Valid {
+		pr.CreatedAt = value.Time
+	}
+	if value, ok := values[5].(*sql.NullTime); !ok {
+		return fmt.Errorf("unexpected type %T for field updated_at", values[5])
+	} else if value.Valid {
+		pr.UpdatedAt = value.Time
+	}
+	return nil
+}
+
+// QueryApplications queries the applications edge of the Project.
+func (pr *Project) QueryApplications() *ApplicationQuery {
+	return (&ProjectClient{config: pr.config}).QueryApplications(pr)
+}
+
+// QueryMembers queries the members edge of the Project.
+func (pr *Project) QueryMembers() *UserQuery {
+	return (&ProjectClient{config: pr.config}).QueryMembers(pr)
+}
+
+// Update returns a builder for updating this Project.
+// Note that, you need to call Project.Unwrap() before calling this method, if this Project
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (pr *Project) Update() *ProjectUpdateOne {
+	return (&ProjectClient{config: pr.config}).UpdateOne(pr)
+}
+
+// Unwrap unwraps the entity that was returned from a transaction after it was closed,
+// so that all next queries will be executed through the driver which created the transaction.
+func (pr *Project) Unwrap() *Project {
+	tx, ok := pr.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: Project is not a transactional entity")
+	}
+	pr.config.driver = tx.drv
+	return pr
+}
+
+// String implements the fmt.Stringer.
+func (pr *Project) String() string {
+	var builder strings.Builder
+	builder.WriteString("Project(")
+	builder.WriteString(fmt.Sprintf("id=%v", pr.ID))
+	builder.WriteString(", name=")
+	builder.WriteString(pr.Name)
+	builder.WriteString(", max_queued=")
+	builder.WriteString(fmt.Sprintf("%v", pr.MaxQueued))
+	builder.WriteString(", raw_retention=")
+	builder.WriteString(fmt.Sprintf("%v", pr.RawRetention))
+	builder.WriteString(", rollup_retention=")
+	builder.WriteString(fmt.Sprintf("%v", pr.RollupRetention))
+	builder.WriteString(", created_at=")
+	builder.WriteString(pr.CreatedAt.Format(time.ANSIC))
+	builder.WriteString(", updated_at=")
+	builder.WriteString(pr.UpdatedAt.Format(time.ANSIC))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// Projects is a parsable slice of Project.
+type Projects []*Project
+
+func (pr Projects) config(cfg config) {
+	for _i := range pr {
+		pr[_i].config = cfg
+	}
+}
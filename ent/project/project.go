@@ -0,0 +1,96 @@
+// Code generated by entc, DO NOT EDIT.
+
+package project
+
+import (
+	"time"
+)
+
+const (
+	// Label holds the string label denoting the project type in the database.
+	Label = "project"
+	// FieldID holds the string denoting the id field in the database.
+	FieldID = "id"
+	// FieldName holds the string denoting the name field in the database.
+	FieldName = "name"
+	// FieldMaxQueued holds the string denoting the max_queued field in the database.
+	FieldMaxQueued = "max_queued"
+	// FieldRawRetention holds the string denoting the raw_retention field in the database.
+	FieldRawRetention = "raw_retention"
+	// FieldRollupRetention holds the string denoting the rollup_retention field in the database.
+	FieldRollupRetention = "rollup_retention"
+	// FieldCreatedAt holds the string denoting the created_at field in the database.
+	FieldCreatedAt = "created_at"
+	// FieldUpdatedAt holds the string denoting the updated_at field in the database.
+	FieldUpdatedAt = "updated_at"
+
+	// EdgeApplications holds the string denoting the applications edge name in mutations.
+	EdgeApplications = "applications"
+	// EdgeMembers holds the string denoting the members edge name in mutations.
+	EdgeMembers = "members"
+
+	// Table holds the table name of the project in the database.
+	Table = "projects"
+	// ApplicationsTable is the table the holds the applications relation/edge.
+	ApplicationsTable = "applications"
+	// ApplicationsInverseTable is the table name for the Application entity.
+	// It exists in this package in order to avoid circular dependency with the "application" package.
+	ApplicationsInverseTable = "applications"
+	// ApplicationsColumn is the table column denoting the applications relation/edge.
+	ApplicationsColumn = "project_applications"
+	// MembersTable is the table the holds the members relation/edge. The primary key declared below.
+	MembersTable = "project_members"
+	// MembersInverseTable is the table name for the User entity.
+	// It exists in this package in order to avoid circular dependency with the "user" package.
+	MembersInverseTable = "users"
+)
+
+// Columns holds all SQL columns for project fields.
+var Columns = []string{
+	FieldID,
+	FieldName,
+	FieldMaxQueued,
+	FieldRawRetention,
+	FieldRollupRetention,
+	FieldCreatedAt,
+	FieldUpdatedAt,
+}
+
+var (
+	// MembersPrimaryKey and MembersColumn2 are the table columns denoting the
+	// primary key for the members relation (M2M).
+	MembersPrimaryKey = []string{"project_id", "user_id"}
+)
+
+// ValidColumn reports if the column name is valid (part of the table columns).
+func ValidColumn(column string) bool {
+	for i := range Columns {
+		if column == Columns[i] {
+			return true
+		}
+	}
+	return false
+}
+
+var (
+	// NameValidator is a validator for the "name" field. It is called by the builders before save.
+	NameValidator func(string) error
+	// DefaultMaxQueued holds the default value on creation for the max_queued field.
+	DefaultMaxQueued int
+	// MaxQueuedValidator is a validator for the "max_queued" field. It is called by the builders before save.
+	MaxQueuedValidator func(int) error
+	// DefaultRawRetention holds the default value on creation for the raw_retention field.
+	DefaultRawRetention int64
+	// RawRetentionValidator is a validator for the "raw_retention" field. It is called by the builders before save.
+	RawRetentionValidator func(int64) error
+	// DefaultRollupRetention holds the default value on creation for the rollup_retention field.
+	DefaultRollupRetention int64
+	// RollupRetentionValidator is a validator for the "rollup_retention" field. It is called by the builders before save.
+	RollupRetentionValidator func(int64) error
+	// DefaultCreatedAt holds the default value on creation for the created_at field.
+	DefaultCreatedAt func() time.Time
+	// DefaultUpdatedAt holds the default value on creation for the updated_at field.
+	DefaultUpdatedAt func() time.Time
+	// UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
+	UpdateDefaultUpdatedAt func() time.Time
+)
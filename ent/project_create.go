@@ -0,0 +1,426 @@
+// Code generated by entc, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/facebook/ent/dialect/sql/sqlgraph"
+	"github.com/facebook/ent/schema/field"
+	"github.com/gobench-io/gobench/ent/application"
+	"github.com/gobench-io/gobench/ent/project"
+	"github.com/gobench-io/gobench/ent/user"
+)
+
+// ProjectCreate is the builder for creating a Project entity.
+type ProjectCreate struct {
+	config
+	mutation *ProjectMutation
+	hooks    []Hook
+}
+
+// SetName sets the name field.
+func (pc *ProjectCreate) SetName(s string) *ProjectCreate {
+	pc.mutation.SetName(s)
+	return pc
+}
+
+// SetMaxQueued sets the max_queued field.
+func (pc *ProjectCreate) SetMaxQueued(i int) *ProjectCreate {
+	pc.mutation.SetMaxQueued(i)
+	return pc
+}
+
+// SetNillableMaxQueued sets the max_queued field if the given value is not nil.
+func (pc *ProjectCreate) SetNillableMaxQueued(i *int) *ProjectCreate {
+	if i != nil {
+		pc.SetMaxQueued(*i)
+	}
+	return pc
+}
+
+// SetRawRetention sets the raw_retention field.
+func (pc *ProjectCreate) SetRawRetention(i int64) *ProjectCreate {
+	pc.mutation.SetRawRetention(i)
+	return pc
+}
+
+// SetNillableRawRetention sets the raw_retention field if the given value is not nil.
+func (pc *ProjectCreate) SetNillableRawRetention(i *int64) *ProjectCreate {
+	if i != nil {
+		pc.SetRawRetention(*i)
+	}
+	return pc
+}
+
+// SetRollupRetention sets the rollup_retention field.
+func (pc *ProjectCreate) SetRollupRetention(i int64) *ProjectCreate {
+	pc.mutation.SetRollupRetention(i)
+	return pc
+}
+
+// SetNillableRollupRetention sets the rollup_retention field if the given value is not nil.
+func (pc *ProjectCreate) SetNillableRollupRetention(i *int64) *ProjectCreate {
+	if i != nil {
+		pc.SetRollupRetention(*i)
+	}
+	return pc
+}
+
+// SetCreatedAt sets the created_at field.
+func (pc *ProjectCreate) SetCreatedAt(t time.Time) *ProjectCreate {
+	pc.mutation.SetCreatedAt(t)
+	return pc
+}
+
+// SetNillableCreatedAt sets the created_at field if the given value is not nil.
+func (pc *ProjectCreate) SetNillableCreatedAt(t *time.Time) *ProjectCreate {
+	if t != nil {
+		pc.SetCreatedAt(*t)
+	}
+	return pc
+}
+
+// SetUpdatedAt sets the updated_at field.
+func (pc *ProjectCreate) SetUpdatedAt(t time.Time) *ProjectCreate {
+	pc.mutation.SetUpdatedAt(t)
+	return pc
+}
+
+// SetNillableUpdatedAt sets the updated_at field if the given value is not nil.
+func (pc *ProjectCreate) SetNillableUpdatedAt(t *time.Time) *ProjectCreate {
+	if t != nil {
+		pc.SetUpdatedAt(*t)
+	}
+	return pc
+}
+
+// AddApplicationIDs adds the applications edge to Application by ids.
+func (pc *ProjectCreate) AddApplicationIDs(ids ...int) *ProjectCreate {
+	pc.mutation.AddApplicationIDs(ids...)
+	return pc
+}
+
+// AddApplications adds the applications edges to Application.
+func (pc *ProjectCreate) AddApplications(a ...*Application) *ProjectCreate {
+	ids := make([]int, len(a))
+	for i := range a {
+		ids[i] = a[i].ID
+	}
+	return pc.AddApplicationIDs(ids...)
+}
+
+// AddMemberIDs adds the members edge to User by ids.
+func (pc *ProjectCreate) AddMemberIDs(ids ...int) *ProjectCreate {
+	pc.mutation.AddMemberIDs(ids...)
+	return pc
+}
+
+// AddMembers adds the members edges to User.
+func (pc *ProjectCreate) AddMembers(u ...*User) *ProjectCreate {
+	ids := make([]int, len(u))
+	for i := range u {
+		ids[i] = u[i].ID
+	}
+	return pc.AddMemberIDs(ids...)
+}
+
+// Mutation returns the ProjectMutation object of the builder.
+func (pc *ProjectCreate) Mutation() *ProjectMutation {
+	return pc.mutation
+}
+
+// Save creates the Project in the database.
+func (pc *ProjectCreate) Save(ctx context.Context) (*Project, error) {
+	var (
+		err  error
+		node *Project
+	)
+	pc.defaults()
+	if len(pc.hooks) == 0 {
+		if err = pc.check(); err != nil {
+			return nil, err
+		}
+		node, err = pc.sqlSave(ctx)
+	} else {
+		var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+			mutation, ok := m.(*ProjectMutation)
+			if !ok {
+				return nil, fmt.Errorf("unexpected mutation type %T", m)
+			}
+			if err = pc.check(); err != nil {
+				return nil, err
+			}
+			pc.mutation = mutation
+			node, err = pc.sqlSave(ctx)
+			mutation.done = true
+			return node, err
+		})
+		for i := len(pc.hooks) - 1; i >= 0; i-- {
+			mut = pc.hooks[i](mut)
+		}
+		if _, err := mut.Mutate(ctx, pc.mutation); err != nil {
+			return nil, err
+		}
+	}
+	return node, err
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (pc *ProjectCreate) SaveX(ctx context.Context) *Project {
+	v, err := pc.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// defaults sets the default values of the builder before save.
+func (pc *ProjectCreate) defaults() {
+	if _, ok := pc.mutation.MaxQueued(); !ok {
+		v := project.DefaultMaxQueued
+		pc.mutation.SetMaxQueued(v)
+	}
+	if _, ok := pc.mutation.RawRetention(); !ok {
+		v := project.DefaultRawRetention
+		pc.mutation.SetRawRetention(v)
+	}
+	if _, ok := pc.mutation.RollupRetention(); !ok {
+		v := project.DefaultRollupRetention
+		pc.mutation.SetRollupRetention(v)
+	}
+	if _, ok := pc.mutation.CreatedAt(); !ok {
+		v := project.DefaultCreatedAt()
+		pc.mutation.SetCreatedAt(v)
+	}
+	if _, ok := pc.mutation.UpdatedAt(); !ok {
+		v := project.DefaultUpdatedAt()
+		pc.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (pc *ProjectCreate) check() error {
+	if _, ok := pc.mutation.Name(); !ok {
+		return &ValidationError{Name: "name", err: errors.New("ent: missing required field \"name\"")}
+	}
+	if v, ok := pc.mutation.Name(); ok {
+		if err := project.NameValidator(v); err != nil {
+			return &ValidationError{Name: "name", err: fmt.Errorf("ent: validator failed for field \"name\": %w", err)}
+		}
+	}
+	if _, ok := pc.mutation.MaxQueued(); !ok {
+		return &ValidationError{Name: "max_queued", err: errors.New("ent: missing required field \"max_queued\"")}
+	}
+	if v, ok := pc.mutation.MaxQueued(); ok {
+		if err := project.MaxQueuedValidator(v); err != nil {
+			return &ValidationError{Name: "max_queued", err: fmt.Errorf("ent: validator failed for field \"max_queued\": %w", err)}
+		}
+	}
+	if _, ok := pc.mutation.RawRetention(); !ok {
+		return &ValidationError{Name: "raw_retention", err: errors.New("ent: missing required field \"raw_retention\"")}
+	}
+	if v, ok := pc.mutation.RawRetention(); ok {
+		if err := project.RawRetentionValidator(v); err != nil {
+			return &ValidationError{Name: "raw_retention", err: fmt.Errorf("ent: validator failed for field \"raw_retention\": %w", err)}
+		}
+	}
+	if _, ok := pc.mutation.RollupRetention(); !ok {
+		return &ValidationError{Name: "rollup_retention", err: errors.New("ent: missing required field \"rollup_retention\"")}
+	}
+	if v, ok := pc.mutation.RollupRetention(); ok {
+		if err := project.RollupRetentionValidator(v); err != nil {
+			return &ValidationError{Name: "rollup_retention", err: fmt.Errorf("ent: validator failed for field \"rollup_retention\": %w", err)}
+		}
+	}
+	if _, ok := pc.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New("ent: missing required field \"created_at\"")}
+	}
+	if _, ok := pc.mutation.UpdatedAt(); !ok {
+		return &ValidationError{Name: "updated_at", err: errors.New("ent: missing required field \"updated_at\"")}
+	}
+	return nil
+}
+
+func (pc *ProjectCreate) sqlSave(ctx context.Context) (*Project, error) {
+	_node, _spec := pc.createSpec()
+	if err := sqlgraph.CreateNode(ctx, pc.driver, _spec); err != nil {
+		if cerr, ok := isSQLConstraintError(err); ok {
+			err = cerr
+		}
+		return nil, err
+	}
+	id := _spec.ID.Value.(int64)
+	_node.ID = int(id)
+	return _node, nil
+}
+
+func (pc *ProjectCreate) createSpec() (*Project, *sqlgraph.CreateSpec) {
+	var (
+		_node = &Project{config: pc.config}
+		_spec = &sqlgraph.CreateSpec{
+			Table: project.Table,
+			ID: &sqlgraph.FieldSpec{
+				Type:   field.TypeInt,
+				Column: project.FieldID,
+			},
+		}
+	)
+	if value, ok := pc.mutation.Name(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: project.FieldName,
+		})
+		_node.Name = value
+	}
+	if value, ok := pc.mutation.MaxQueued(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt,
+			Value:  value,
+			Column: project.FieldMaxQueued,
+		})
+		_node.MaxQueued = value
+	}
+	if value, ok := pc.mutation.RawRetention(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt64,
+			Value:  value,
+			Column: project.FieldRawRetention,
+		})
+		_node.RawRetention = value
+	}
+	if value, ok := pc.mutation.RollupRetention(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt64,
+			Value:  value,
+			Column: project.FieldRollupRetention,
+		})
+		_node.RollupRetention = value
+	}
+	if value, ok := pc.mutation.CreatedAt(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeTime,
+			Value:  value,
+			Column: project.FieldCreatedAt,
+		})
+		_node.CreatedAt = value
+	}
+	if value, ok := pc.mutation.UpdatedAt(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeTime,
+			Value:  value,
+			Column: project.FieldUpdatedAt,
+		})
+		_node.UpdatedAt = value
+	}
+	if nodes := pc.mutation.ApplicationsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   project.ApplicationsTable,
+			Columns: []string{project.ApplicationsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt,
+					Column: application.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := pc.mutation.MembersIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: false,
+			Table:   project.MembersTable,
+			Columns: project.MembersPrimaryKey,
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt,
+					Column: user.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	return _node, _spec
+}
+
+// ProjectCreateBulk is the builder for creating a bulk of Project entities.
+type ProjectCreateBulk struct {
+	config
+	builders []*ProjectCreate
+}
+
+// Save creates the Project entities in the database.
+func (pcb *ProjectCreateBulk) Save(ctx context.Context) ([]*Project, error) {
+	specs := make([]*sqlgraph.CreateSpec, len(pcb.builders))
+	nodes := make([]*Project, len(pcb.builders))
+	mutators := make([]Mutator, len(pcb.builders))
+	for i := range pcb.builders {
+		func(i int, root context.Context) {
+			builder := pcb.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*ProjectMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				nodes[i], specs[i] = builder.createSpec()
+				var err error
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, pcb.builders[i+1].mutation)
+				} else {
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, pcb.driver, &sqlgraph.BatchCreateSpec{Nodes: specs}); err != nil {
+						if cerr, ok := isSQLConstraintError(err); ok {
+							err = cerr
+						}
+					}
+				}
+				mutation.done = true
+				if err != nil {
+					return nil, err
+				}
+				id := specs[i].ID.Value.(int64)
+				nodes[i].ID = int(id)
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, pcb.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (pcb *ProjectCreateBulk) SaveX(ctx context.Context) []*Project {
+	v, err := pcb.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
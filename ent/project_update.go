@@ -0,0 +1,953 @@
+// Code generated by entc, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/facebook/ent/dialect/sql"
+	"github.com/facebook/ent/dialect/sql/sqlgraph"
+	"github.com/facebook/ent/schema/field"
+	"github.com/gobench-io/gobench/ent/application"
+	"github.com/gobench-io/gobench/ent/predicate"
+	"github.com/gobench-io/gobench/ent/project"
+	"github.com/gobench-io/gobench/ent/user"
+)
+
+// ProjectUpdate is the builder for updating Project entities.
+type ProjectUpdate struct {
+	config
+	hooks      []Hook
+	mutation   *ProjectMutation
+	predicates []predicate.Project
+}
+
+// Where adds a new predicate for the builder.
+func (pu *ProjectUpdate) Where(ps ...predicate.Project) *ProjectUpdate {
+	pu.predicates = append(pu.predicates, ps...)
+	return pu
+}
+
+// SetName sets the name field.
+func (pu *ProjectUpdate) SetName(s string) *ProjectUpdate {
+	pu.mutation.SetName(s)
+	return pu
+}
+
+// SetMaxQueued sets the max_queued field.
+func (pu *ProjectUpdate) SetMaxQueued(i int) *ProjectUpdate {
+	pu.mutation.ResetMaxQueued()
+	pu.mutation.SetMaxQueued(i)
+	return pu
+}
+
+// SetNillableMaxQueued sets the max_queued field if the given value is not nil.
+func (pu *ProjectUpdate) SetNillableMaxQueued(i *int) *ProjectUpdate {
+	if i != nil {
+		pu.SetMaxQueued(*i)
+	}
+	return pu
+}
+
+// AddMaxQueued adds i to max_queued.
+func (pu *ProjectUpdate) AddMaxQueued(i int) *ProjectUpdate {
+	pu.mutation.AddMaxQueued(i)
+	return pu
+}
+
+// SetRawRetention sets the raw_retention field.
+func (pu *ProjectUpdate) SetRawRetention(i int64) *ProjectUpdate {
+	pu.mutation.ResetRawRetention()
+	pu.mutation.SetRawRetention(i)
+	return pu
+}
+
+// SetNillableRawRetention sets the raw_retention field if the given value is not nil.
+func (pu *ProjectUpdate) SetNillableRawRetention(i *int64) *ProjectUpdate {
+	if i != nil {
+		pu.SetRawRetention(*i)
+	}
+	return pu
+}
+
+// AddRawRetention adds i to raw_retention.
+func (pu *ProjectUpdate) AddRawRetention(i int64) *ProjectUpdate {
+	pu.mutation.AddRawRetention(i)
+	return pu
+}
+
+// SetRollupRetention sets the rollup_retention field.
+func (pu *ProjectUpdate) SetRollupRetention(i int64) *ProjectUpdate {
+	pu.mutation.ResetRollupRetention()
+	pu.mutation.SetRollupRetention(i)
+	return pu
+}
+
+// SetNillableRollupRetention sets the rollup_retention field if the given value is not nil.
+func (pu *ProjectUpdate) SetNillableRollupRetention(i *int64) *ProjectUpdate {
+	if i != nil {
+		pu.SetRollupRetention(*i)
+	}
+	return pu
+}
+
+// AddRollupRetention adds i to rollup_retention.
+func (pu *ProjectUpdate) AddRollupRetention(i int64) *ProjectUpdate {
+	pu.mutation.AddRollupRetention(i)
+	return pu
+}
+
+// SetCreatedAt sets the created_at field.
+func (pu *ProjectUpdate) SetCreatedAt(t time.Time) *ProjectUpdate {
+	pu.mutation.SetCreatedAt(t)
+	return pu
+}
+
+// SetNillableCreatedAt sets the created_at field if the given value is not nil.
+func (pu *ProjectUpdate) SetNillableCreatedAt(t *time.Time) *ProjectUpdate {
+	if t != nil {
+		pu.SetCreatedAt(*t)
+	}
+	return pu
+}
+
+// SetUpdatedAt sets the updated_at field.
+func (pu *ProjectUpdate) SetUpdatedAt(t time.Time) *ProjectUpdate {
+	pu.mutation.SetUpdatedAt(t)
+	return pu
+}
+
+// AddApplicationIDs adds the applications edge to Application by ids.
+func (pu *ProjectUpdate) AddApplicationIDs(ids ...int) *ProjectUpdate {
+	pu.mutation.AddApplicationIDs(ids...)
+	return pu
+}
+
+// AddApplications adds the applications edges to Application.
+func (pu *ProjectUpdate) AddApplications(a ...*Application) *ProjectUpdate {
+	ids := make([]int, len(a))
+	for i := range a {
+		ids[i] = a[i].ID
+	}
+	return pu.AddApplicationIDs(ids...)
+}
+
+// AddMemberIDs adds the members edge to User by ids.
+func (pu *ProjectUpdate) AddMemberIDs(ids ...int) *ProjectUpdate {
+	pu.mutation.AddMemberIDs(ids...)
+	return pu
+}
+
+// AddMembers adds the members edges to User.
+func (pu *ProjectUpdate) AddMembers(u ...*User) *ProjectUpdate {
+	ids := make([]int, len(u))
+	for i := range u {
+		ids[i] = u[i].ID
+	}
+	return pu.AddMemberIDs(ids...)
+}
+
+// Mutation returns the ProjectMutation object of the builder.
+func (pu *ProjectUpdate) Mutation() *ProjectMutation {
+	return pu.mutation
+}
+
+// ClearApplications clears all "applications" edges to type Application.
+func (pu *ProjectUpdate) ClearApplications() *ProjectUpdate {
+	pu.mutation.ClearApplications()
+	return pu
+}
+
+// RemoveApplicationIDs removes the applications edge to Application by ids.
+func (pu *ProjectUpdate) RemoveApplicationIDs(ids ...int) *ProjectUpdate {
+	pu.mutation.RemoveApplicationIDs(ids...)
+	return pu
+}
+
+// RemoveApplications removes applications edges to Application.
+func (pu *ProjectUpdate) RemoveApplications(a ...*Application) *ProjectUpdate {
+	ids := make([]int, len(a))
+	for i := range a {
+		ids[i] = a[i].ID
+	}
+	return pu.RemoveApplicationIDs(ids...)
+}
+
+// ClearMembers clears all "members" edges to type User.
+func (pu *ProjectUpdate) ClearMembers() *ProjectUpdate {
+	pu.mutation.ClearMembers()
+	return pu
+}
+
+// RemoveMemberIDs removes the members edge to User by ids.
+func (pu *ProjectUpdate) RemoveMemberIDs(ids ...int) *ProjectUpdate {
+	pu.mutation.RemoveMemberIDs(ids...)
+	return pu
+}
+
+// RemoveMembers removes members edges to User.
+func (pu *ProjectUpdate) RemoveMembers(u ...*User) *ProjectUpdate {
+	ids := make([]int, len(u))
+	for i := range u {
+		ids[i] = u[i].ID
+	}
+	return pu.RemoveMemberIDs(ids...)
+}
+
+// Save executes the query and returns the number of rows/vertices matched by this operation.
+func (pu *ProjectUpdate) Save(ctx context.Context) (int, error) {
+	var (
+		err      error
+		affected int
+	)
+	pu.defaults()
+	if len(pu.hooks) == 0 {
+		if err = pu.check(); err != nil {
+			return 0, err
+		}
+		affected, err = pu.sqlSave(ctx)
+	} else {
+		var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+			mutation, ok := m.(*ProjectMutation)
+			if !ok {
+				return nil, fmt.Errorf("unexpected mutation type %T", m)
+			}
+			if err = pu.check(); err != nil {
+				return 0, err
+			}
+			pu.mutation = mutation
+			affected, err = pu.sqlSave(ctx)
+			mutation.done = true
+			return affected, err
+		})
+		for i := len(pu.hooks) - 1; i >= 0; i-- {
+			mut = pu.hooks[i](mut)
+		}
+		if _, err := mut.Mutate(ctx, pu.mutation); err != nil {
+			return 0, err
+		}
+	}
+	return affected, err
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (pu *ProjectUpdate) SaveX(ctx context.Context) int {
+	affected, err := pu.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (pu *ProjectUpdate) Exec(ctx context.Context) error {
+	_, err := pu.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (pu *ProjectUpdate) ExecX(ctx context.Context) {
+	if err := pu.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (pu *ProjectUpdate) defaults() {
+	if _, ok := pu.mutation.UpdatedAt(); !ok {
+		v := project.UpdateDefaultUpdatedAt()
+		pu.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (pu *ProjectUpdate) check() error {
+	if v, ok := pu.mutation.Name(); ok {
+		if err := project.NameValidator(v); err != nil {
+			return &ValidationError{Name: "name", err: fmt.Errorf("ent: validator failed for field \"name\": %w", err)}
+		}
+	}
+	if v, ok := pu.mutation.MaxQueued(); ok {
+		if err := project.MaxQueuedValidator(v); err != nil {
+			return &ValidationError{Name: "max_queued", err: fmt.Errorf("ent: validator failed for field \"max_queued\": %w", err)}
+		}
+	}
+	if v, ok := pu.mutation.RawRetention(); ok {
+		if err := project.RawRetentionValidator(v); err != nil {
+			return &ValidationError{Name: "raw_retention", err: fmt.Errorf("ent: validator failed for field \"raw_retention\": %w", err)}
+		}
+	}
+	if v, ok := pu.mutation.RollupRetention(); ok {
+		if err := project.RollupRetentionValidator(v); err != nil {
+			return &ValidationError{Name: "rollup_retention", err: fmt.Errorf("ent: validator failed for field \"rollup_retention\": %w", err)}
+		}
+	}
+	return nil
+}
+
+func (pu *ProjectUpdate) sqlSave(ctx context.Context) (n int, err error) {
+	_spec := &sqlgraph.UpdateSpec{
+		Node: &sqlgraph.NodeSpec{
+			Table:   project.Table,
+			Columns: project.Columns,
+			ID: &sqlgraph.FieldSpec{
+				Type:   field.TypeInt,
+				Column: project.FieldID,
+			},
+		},
+	}
+	if ps := pu.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := pu.mutation.Name(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: project.FieldName,
+		})
+	}
+	if value, ok := pu.mutation.MaxQueued(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt,
+			Value:  value,
+			Column: project.FieldMaxQueued,
+		})
+	}
+	if value, ok := pu.mutation.AddedMaxQueued(); ok {
+		_spec.Fields.Add = append(_spec.Fields.Add, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt,
+			Value:  value,
+			Column: project.FieldMaxQueued,
+		})
+	}
+	if value, ok := pu.mutation.RawRetention(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt64,
+			Value:  value,
+			Column: project.FieldRawRetention,
+		})
+	}
+	if value, ok := pu.mutation.AddedRawRetention(); ok {
+		_spec.Fields.Add = append(_spec.Fields.Add, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt64,
+			Value:  value,
+			Column: project.FieldRawRetention,
+		})
+	}
+	if value, ok := pu.mutation.RollupRetention(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt64,
+			Value:  value,
+			Column: project.FieldRollupRetention,
+		})
+	}
+	if value, ok := pu.mutation.AddedRollupRetention(); ok {
+		_spec.Fields.Add = append(_spec.Fields.Add, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt64,
+			Value:  value,
+			Column: project.FieldRollupRetention,
+		})
+	}
+	if value, ok := pu.mutation.CreatedAt(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeTime,
+			Value:  value,
+			Column: project.FieldCreatedAt,
+		})
+	}
+	if value, ok := pu.mutation.UpdatedAt(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeTime,
+			Value:  value,
+			Column: project.FieldUpdatedAt,
+		})
+	}
+	if pu.mutation.ApplicationsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   project.ApplicationsTable,
+			Columns: []string{project.ApplicationsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt,
+					Column: application.FieldID,
+				},
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := pu.mutation.RemovedApplicationsIDs(); len(nodes) > 0 && !pu.mutation.ApplicationsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   project.ApplicationsTable,
+			Columns: []string{project.ApplicationsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt,
+					Column: application.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := pu.mutation.ApplicationsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   project.ApplicationsTable,
+			Columns: []string{project.ApplicationsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt,
+					Column: application.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if pu.mutation.MembersCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: false,
+			Table:   project.MembersTable,
+			Columns: project.MembersPrimaryKey,
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt,
+					Column: user.FieldID,
+				},
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := pu.mutation.RemovedMembersIDs(); len(nodes) > 0 && !pu.mutation.MembersCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: false,
+			Table:   project.MembersTable,
+			Columns: project.MembersPrimaryKey,
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt,
+					Column: user.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := pu.mutation.MembersIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: false,
+			Table:   project.MembersTable,
+			Columns: project.MembersPrimaryKey,
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt,
+					Column: user.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if n, err = sqlgraph.UpdateNodes(ctx, pu.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{project.Label}
+		} else if cerr, ok := isSQLConstraintError(err); ok {
+			err = cerr
+		}
+		return 0, err
+	}
+	return n, nil
+}
+
+// ProjectUpdateOne is the builder for updating a single Project entity.
+type ProjectUpdateOne struct {
+	config
+	hooks    []Hook
+	mutation *ProjectMutation
+}
+
+// SetName sets the name field.
+func (puo *ProjectUpdateOne) SetName(s string) *ProjectUpdateOne {
+	puo.mutation.SetName(s)
+	return puo
+}
+
+// SetMaxQueued sets the max_queued field.
+func (puo *ProjectUpdateOne) SetMaxQueued(i int) *ProjectUpdateOne {
+	puo.mutation.ResetMaxQueued()
+	puo.mutation.SetMaxQueued(i)
+	return puo
+}
+
+// SetNillableMaxQueued sets the max_queued field if the given value is not nil.
+func (puo *ProjectUpdateOne) SetNillableMaxQueued(i *int) *ProjectUpdateOne {
+	if i != nil {
+		puo.SetMaxQueued(*i)
+	}
+	return puo
+}
+
+// AddMaxQueued adds i to max_queued.
+func (puo *ProjectUpdateOne) AddMaxQueued(i int) *ProjectUpdateOne {
+	puo.mutation.AddMaxQueued(i)
+	return puo
+}
+
+// SetRawRetention sets the raw_retention field.
+func (puo *ProjectUpdateOne) SetRawRetention(i int64) *ProjectUpdateOne {
+	puo.mutation.ResetRawRetention()
+	puo.mutation.SetRawRetention(i)
+	return puo
+}
+
+// SetNillableRawRetention sets the raw_retention field if the given value is not nil.
+func (puo *ProjectUpdateOne) SetNillableRawRetention(i *int64) *ProjectUpdateOne {
+	if i != nil {
+		puo.SetRawRetention(*i)
+	}
+	return puo
+}
+
+// AddRawRetention adds i to raw_retention.
+func (puo *ProjectUpdateOne) AddRawRetention(i int64) *ProjectUpdateOne {
+	puo.mutation.AddRawRetention(i)
+	return puo
+}
+
+// SetRollupRetention sets the rollup_retention field.
+func (puo *ProjectUpdateOne) SetRollupRetention(i int64) *ProjectUpdateOne {
+	puo.mutation.ResetRollupRetention()
+	puo.mutation.SetRollupRetention(i)
+	return puo
+}
+
+// SetNillableRollupRetention sets the rollup_retention field if the given value is not nil.
+func (puo *ProjectUpdateOne) SetNillableRollupRetention(i *int64) *ProjectUpdateOne {
+	if i != nil {
+		puo.SetRollupRetention(*i)
+	}
+	return puo
+}
+
+// AddRollupRetention adds i to rollup_retention.
+func (puo *ProjectUpdateOne) AddRollupRetention(i int64) *ProjectUpdateOne {
+	puo.mutation.AddRollupRetention(i)
+	return puo
+}
+
+// SetCreatedAt sets the created_at field.
+func (puo *ProjectUpdateOne) SetCreatedAt(t time.Time) *ProjectUpdateOne {
+	puo.mutation.SetCreatedAt(t)
+	return puo
+}
+
+// SetNillableCreatedAt sets the created_at field if the given value is not nil.
+func (puo *ProjectUpdateOne) SetNillableCreatedAt(t *time.Time) *ProjectUpdateOne {
+	if t != nil {
+		puo.SetCreatedAt(*t)
+	}
+	return puo
+}
+
+// SetUpdatedAt sets the updated_at field.
+func (puo *ProjectUpdateOne) SetUpdatedAt(t time.Time) *ProjectUpdateOne {
+	puo.mutation.SetUpdatedAt(t)
+	return puo
+}
+
+// AddApplicationIDs adds the applications edge to Application by ids.
+func (puo *ProjectUpdateOne) AddApplicationIDs(ids ...int) *ProjectUpdateOne {
+	puo.mutation.AddApplicationIDs(ids...)
+	return puo
+}
+
+// AddApplications adds the applications edges to Application.
+func (puo *ProjectUpdateOne) AddApplications(a ...*Application) *ProjectUpdateOne {
+	ids := make([]int, len(a))
+	for i := range a {
+		ids[i] = a[i].ID
+	}
+	return puo.AddApplicationIDs(ids...)
+}
+
+// AddMemberIDs adds the members edge to User by ids.
+func (puo *ProjectUpdateOne) AddMemberIDs(ids ...int) *ProjectUpdateOne {
+	puo.mutation.AddMemberIDs(ids...)
+	return puo
+}
+
+// AddMembers adds the members edges to User.
+func (puo *ProjectUpdateOne) AddMembers(u ...*User) *ProjectUpdateOne {
+	ids := make([]int, len(u))
+	for i := range u {
+		ids[i] = u[i].ID
+	}
+	return puo.AddMemberIDs(ids...)
+}
+
+// Mutation returns the ProjectMutation object of the builder.
+func (puo *ProjectUpdateOne) Mutation() *ProjectMutation {
+	return puo.mutation
+}
+
+// ClearApplications clears all "applications" edges to type Application.
+func (puo *ProjectUpdateOne) ClearApplications() *ProjectUpdateOne {
+	puo.mutation.ClearApplications()
+	return puo
+}
+
+// RemoveApplicationIDs removes the applications edge to Application by ids.
+func (puo *ProjectUpdateOne) RemoveApplicationIDs(ids ...int) *ProjectUpdateOne {
+	puo.mutation.RemoveApplicationIDs(ids...)
+	return puo
+}
+
+// RemoveApplications removes applications edges to Application.
+func (puo *ProjectUpdateOne) RemoveApplications(a ...*Application) *ProjectUpdateOne {
+	ids := make([]int, len(a))
+	for i := range a {
+		ids[i] = a[i].ID
+	}
+	return puo.RemoveApplicationIDs(ids...)
+}
+
+// ClearMembers clears all "members" edges to type User.
+func (puo *ProjectUpdateOne) ClearMembers() *ProjectUpdateOne {
+	puo.mutation.ClearMembers()
+	return puo
+}
+
+// RemoveMemberIDs removes the members edge to User by ids.
+func (puo *ProjectUpdateOne) RemoveMemberIDs(ids ...int) *ProjectUpdateOne {
+	puo.mutation.RemoveMemberIDs(ids...)
+	return puo
+}
+
+// RemoveMembers removes members edges to User.
+func (puo *ProjectUpdateOne) RemoveMembers(u ...*User) *ProjectUpdateOne {
+	ids := make([]int, len(u))
+	for i := range u {
+		ids[i] = u[i].ID
+	}
+	return puo.RemoveMemberIDs(ids...)
+}
+
+// Save executes the query and returns the updated entity.
+func (puo *ProjectUpdateOne) Save(ctx context.Context) (*Project, error) {
+	var (
+		err  error
+		node *Project
+	)
+	puo.defaults()
+	if len(puo.hooks) == 0 {
+		if err = puo.check(); err != nil {
+			return nil, err
+		}
+		node, err = puo.sqlSave(ctx)
+	} else {
+		var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+			mutation, ok := m.(*ProjectMutation)
+			if !ok {
+				return nil, fmt.Errorf("unexpected mutation type %T", m)
+			}
+			if err = puo.check(); err != nil {
+				return nil, err
+			}
+			puo.mutation = mutation
+			node, err = puo.sqlSave(ctx)
+			mutation.done = true
+			return node, err
+		})
+		for i := len(puo.hooks) - 1; i >= 0; i-- {
+			mut = puo.hooks[i](mut)
+		}
+		if _, err := mut.Mutate(ctx, puo.mutation); err != nil {
+			return nil, err
+		}
+	}
+	return node, err
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (puo *ProjectUpdateOne) SaveX(ctx context.Context) *Project {
+	node, err := puo.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (puo *ProjectUpdateOne) Exec(ctx context.Context) error {
+	_, err := puo.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (puo *ProjectUpdateOne) ExecX(ctx context.Context) {
+	if err := puo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (puo *ProjectUpdateOne) defaults() {
+	if _, ok := puo.mutation.UpdatedAt(); !ok {
+		v := project.UpdateDefaultUpdatedAt()
+		puo.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (puo *ProjectUpdateOne) check() error {
+	if v, ok := puo.mutation.Name(); ok {
+		if err := project.NameValidator(v); err != nil {
+			return &ValidationError{Name: "name", err: fmt.Errorf("ent: validator failed for field \"name\": %w", err)}
+		}
+	}
+	if v, ok := puo.mutation.MaxQueued(); ok {
+		if err := project.MaxQueuedValidator(v); err != nil {
+			return &ValidationError{Name: "max_queued", err: fmt.Errorf("ent: validator failed for field \"max_queued\": %w", err)}
+		}
+	}
+	if v, ok := puo.mutation.RawRetention(); ok {
+		if err := project.RawRetentionValidator(v); err != nil {
+			return &ValidationError{Name: "raw_retention", err: fmt.Errorf("ent: validator failed for field \"raw_retention\": %w", err)}
+		}
+	}
+	if v, ok := puo.mutation.RollupRetention(); ok {
+		if err := project.RollupRetentionValidator(v); err != nil {
+			return &ValidationError{Name: "rollup_retention", err: fmt.Errorf("ent: validator failed for field \"rollup_retention\": %w", err)}
+		}
+	}
+	return nil
+}
+
+func (puo *ProjectUpdateOne) sqlSave(ctx context.Context) (_node *Project, err error) {
+	_spec := &sqlgraph.UpdateSpec{
+		Node: &sqlgraph.NodeSpec{
+			Table:   project.Table,
+			Columns: project.Columns,
+			ID: &sqlgraph.FieldSpec{
+				Type:   field.TypeInt,
+				Column: project.FieldID,
+			},
+		},
+	}
+	id, ok := puo.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "ID", err: fmt.Errorf("missing Project.ID for update")}
+	}
+	_spec.Node.ID.Value = id
+	if value, ok := puo.mutation.Name(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: project.FieldName,
+		})
+	}
+	if value, ok := puo.mutation.MaxQueued(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt,
+			Value:  value,
+			Column: project.FieldMaxQueued,
+		})
+	}
+	if value, ok := puo.mutation.AddedMaxQueued(); ok {
+		_spec.Fields.Add = append(_spec.Fields.Add, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt,
+			Value:  value,
+			Column: project.FieldMaxQueued,
+		})
+	}
+	if value, ok := puo.mutation.RawRetention(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt64,
+			Value:  value,
+			Column: project.FieldRawRetention,
+		})
+	}
+	if value, ok := puo.mutation.AddedRawRetention(); ok {
+		_spec.Fields.Add = append(_spec.Fields.Add, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt64,
+			Value:  value,
+			Column: project.FieldRawRetention,
+		})
+	}
+	if value, ok := puo.mutation.RollupRetention(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt64,
+			Value:  value,
+			Column: project.FieldRollupRetention,
+		})
+	}
+	if value, ok := puo.mutation.AddedRollupRetention(); ok {
+		_spec.Fields.Add = append(_spec.Fields.Add, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt64,
+			Value:  value,
+			Column: project.FieldRollupRetention,
+		})
+	}
+	if value, ok := puo.mutation.CreatedAt(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeTime,
+			Value:  value,
+			Column: project.FieldCreatedAt,
+		})
+	}
+	if value, ok := puo.mutation.UpdatedAt(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeTime,
+			Value:  value,
+			Column: project.FieldUpdatedAt,
+		})
+	}
+	if puo.mutation.ApplicationsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   project.ApplicationsTable,
+			Columns: []string{project.ApplicationsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt,
+					Column: application.FieldID,
+				},
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := puo.mutation.RemovedApplicationsIDs(); len(nodes) > 0 && !puo.mutation.ApplicationsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   project.ApplicationsTable,
+			Columns: []string{project.ApplicationsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt,
+					Column: application.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := puo.mutation.ApplicationsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   project.ApplicationsTable,
+			Columns: []string{project.ApplicationsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt,
+					Column: application.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if puo.mutation.MembersCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: false,
+			Table:   project.MembersTable,
+			Columns: project.MembersPrimaryKey,
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt,
+					Column: user.FieldID,
+				},
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := puo.mutation.RemovedMembersIDs(); len(nodes) > 0 && !puo.mutation.MembersCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: false,
+			Table:   project.MembersTable,
+			Columns: project.MembersPrimaryKey,
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt,
+					Column: user.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := puo.mutation.MembersIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: false,
+			Table:   project.MembersTable,
+			Columns: project.MembersPrimaryKey,
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt,
+					Column: user.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_node = &Project{config: puo.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues()
+	if err = sqlgraph.UpdateNode(ctx, puo.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{project.Label}
+		} else if cerr, ok := isSQLConstraintError(err); ok {
+			err = cerr
+		}
+		return nil, err
+	}
+	return _node, nil
+}
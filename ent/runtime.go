@@ -5,15 +5,52 @@ package ent
 import (
 	"time"
 
+	"github.com/gobench-io/gobench/ent/apitoken"
 	"github.com/gobench-io/gobench/ent/application"
+	"github.com/gobench-io/gobench/ent/audit"
+	"github.com/gobench-io/gobench/ent/baseline"
+	"github.com/gobench-io/gobench/ent/counter"
+	"github.com/gobench-io/gobench/ent/event"
+	"github.com/gobench-io/gobench/ent/executor"
+	"github.com/gobench-io/gobench/ent/gauge"
+	"github.com/gobench-io/gobench/ent/histogram"
+	"github.com/gobench-io/gobench/ent/project"
 	"github.com/gobench-io/gobench/ent/schema"
+	"github.com/gobench-io/gobench/ent/subscription"
 	"github.com/gobench-io/gobench/ent/tag"
+	"github.com/gobench-io/gobench/ent/user"
+	"github.com/gobench-io/gobench/ent/webhook"
+	"github.com/gobench-io/gobench/ent/webhookdelivery"
 )
 
 // The init function reads all schema descriptors with runtime
 // code (default values, validators or hooks) and stitches it
 // to their package variables.
 func init() {
+	apitokenFields := schema.APIToken{}.Fields()
+	_ = apitokenFields
+	// apitokenDescName is the schema descriptor for name field.
+	apitokenDescName := apitokenFields[0].Descriptor()
+	// apitoken.NameValidator is a validator for the "name" field. It is called by the builders before save.
+	apitoken.NameValidator = func() func(string) error {
+		validators := apitokenDescName.Validators
+		fns := [...]func(string) error{
+			validators[0].(func(string) error),
+			validators[1].(func(string) error),
+		}
+		return func(name string) error {
+			for _, fn := range fns {
+				if err := fn(name); err != nil {
+					return err
+				}
+			}
+			return nil
+		}
+	}()
+	// apitokenDescCreatedAt is the schema descriptor for created_at field.
+	apitokenDescCreatedAt := apitokenFields[6].Descriptor()
+	// apitoken.DefaultCreatedAt holds the default value on creation for the created_at field.
+	apitoken.DefaultCreatedAt = apitokenDescCreatedAt.Default.(func() time.Time)
 	applicationFields := schema.Application{}.Fields()
 	_ = applicationFields
 	// applicationDescCreatedAt is the schema descriptor for created_at field.
@@ -27,13 +64,141 @@ func init() {
 	// application.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
 	application.UpdateDefaultUpdatedAt = applicationDescUpdatedAt.UpdateDefault.(func() time.Time)
 	// applicationDescGomod is the schema descriptor for gomod field.
-	applicationDescGomod := applicationFields[6].Descriptor()
+	applicationDescGomod := applicationFields[7].Descriptor()
 	// application.DefaultGomod holds the default value on creation for the gomod field.
 	application.DefaultGomod = applicationDescGomod.Default.(string)
 	// applicationDescGosum is the schema descriptor for gosum field.
-	applicationDescGosum := applicationFields[7].Descriptor()
+	applicationDescGosum := applicationFields[8].Descriptor()
 	// application.DefaultGosum holds the default value on creation for the gosum field.
 	application.DefaultGosum = applicationDescGosum.Default.(string)
+	// applicationDescImported is the schema descriptor for imported field.
+	applicationDescImported := applicationFields[11].Descriptor()
+	// application.DefaultImported holds the default value on creation for the imported field.
+	application.DefaultImported = applicationDescImported.Default.(bool)
+	auditFields := schema.Audit{}.Fields()
+	_ = auditFields
+	// auditDescCreatedAt is the schema descriptor for created_at field.
+	auditDescCreatedAt := auditFields[5].Descriptor()
+	// audit.DefaultCreatedAt holds the default value on creation for the created_at field.
+	audit.DefaultCreatedAt = auditDescCreatedAt.Default.(func() time.Time)
+	baselineFields := schema.Baseline{}.Fields()
+	_ = baselineFields
+	// baselineDescTag is the schema descriptor for tag field.
+	baselineDescTag := baselineFields[0].Descriptor()
+	// baseline.TagValidator is a validator for the "tag" field. It is called by the builders before save.
+	baseline.TagValidator = func() func(string) error {
+		validators := baselineDescTag.Validators
+		fns := [...]func(string) error{
+			validators[0].(func(string) error),
+			validators[1].(func(string) error),
+		}
+		return func(tag string) error {
+			for _, fn := range fns {
+				if err := fn(tag); err != nil {
+					return err
+				}
+			}
+			return nil
+		}
+	}()
+	// baselineDescTolerance is the schema descriptor for tolerance field.
+	baselineDescTolerance := baselineFields[1].Descriptor()
+	// baseline.DefaultTolerance holds the default value on creation for the tolerance field.
+	baseline.DefaultTolerance = baselineDescTolerance.Default.(float64)
+	// baselineDescCreatedAt is the schema descriptor for created_at field.
+	baselineDescCreatedAt := baselineFields[3].Descriptor()
+	// baseline.DefaultCreatedAt holds the default value on creation for the created_at field.
+	baseline.DefaultCreatedAt = baselineDescCreatedAt.Default.(func() time.Time)
+	// baselineDescUpdatedAt is the schema descriptor for updated_at field.
+	baselineDescUpdatedAt := baselineFields[4].Descriptor()
+	// baseline.DefaultUpdatedAt holds the default value on creation for the updated_at field.
+	baseline.DefaultUpdatedAt = baselineDescUpdatedAt.Default.(func() time.Time)
+	// baseline.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
+	baseline.UpdateDefaultUpdatedAt = baselineDescUpdatedAt.UpdateDefault.(func() time.Time)
+	counterFields := schema.Counter{}.Fields()
+	_ = counterFields
+	// counterDescWID is the schema descriptor for wID field.
+	counterDescWID := counterFields[2].Descriptor()
+	// counter.DefaultWID holds the default value on creation for the wID field.
+	counter.DefaultWID = counterDescWID.Default.(string)
+	eventFields := schema.Event{}.Fields()
+	_ = eventFields
+	// eventDescName is the schema descriptor for name field.
+	eventDescName := eventFields[0].Descriptor()
+	// event.NameValidator is a validator for the "name" field. It is called by the builders before save.
+	event.NameValidator = eventDescName.Validators[0].(func(string) error)
+	executorFields := schema.Executor{}.Fields()
+	_ = executorFields
+	// executorDescEID is the schema descriptor for eID field.
+	executorDescEID := executorFields[0].Descriptor()
+	// executor.EIDValidator is a validator for the "eID" field. It is called by the builders before save.
+	executor.EIDValidator = executorDescEID.Validators[0].(func(string) error)
+	gaugeFields := schema.Gauge{}.Fields()
+	_ = gaugeFields
+	// gaugeDescWID is the schema descriptor for wID field.
+	gaugeDescWID := gaugeFields[2].Descriptor()
+	// gauge.DefaultWID holds the default value on creation for the wID field.
+	gauge.DefaultWID = gaugeDescWID.Default.(string)
+	histogramFields := schema.Histogram{}.Fields()
+	_ = histogramFields
+	// histogramDescWID is the schema descriptor for wID field.
+	histogramDescWID := histogramFields[11].Descriptor()
+	// histogram.DefaultWID holds the default value on creation for the wID field.
+	histogram.DefaultWID = histogramDescWID.Default.(string)
+	projectFields := schema.Project{}.Fields()
+	_ = projectFields
+	// projectDescName is the schema descriptor for name field.
+	projectDescName := projectFields[0].Descriptor()
+	// project.NameValidator is a validator for the "name" field. It is called by the builders before save.
+	project.NameValidator = func() func(string) error {
+		validators := projectDescName.Validators
+		fns := [...]func(string) error{
+			validators[0].(func(string) error),
+			validators[1].(func(string) error),
+		}
+		return func(name string) error {
+			for _, fn := range fns {
+				if err := fn(name); err != nil {
+					return err
+				}
+			}
+			return nil
+		}
+	}()
+	// projectDescMaxQueued is the schema descriptor for max_queued field.
+	projectDescMaxQueued := projectFields[1].Descriptor()
+	// project.DefaultMaxQueued holds the default value on creation for the max_queued field.
+	project.DefaultMaxQueued = projectDescMaxQueued.Default.(int)
+	// project.MaxQueuedValidator is a validator for the "max_queued" field. It is called by the builders before save.
+	project.MaxQueuedValidator = projectDescMaxQueued.Validators[0].(func(int) error)
+	// projectDescRawRetention is the schema descriptor for raw_retention field.
+	projectDescRawRetention := projectFields[2].Descriptor()
+	// project.DefaultRawRetention holds the default value on creation for the raw_retention field.
+	project.DefaultRawRetention = projectDescRawRetention.Default.(int64)
+	// project.RawRetentionValidator is a validator for the "raw_retention" field. It is called by the builders before save.
+	project.RawRetentionValidator = projectDescRawRetention.Validators[0].(func(int64) error)
+	// projectDescRollupRetention is the schema descriptor for rollup_retention field.
+	projectDescRollupRetention := projectFields[3].Descriptor()
+	// project.DefaultRollupRetention holds the default value on creation for the rollup_retention field.
+	project.DefaultRollupRetention = projectDescRollupRetention.Default.(int64)
+	// project.RollupRetentionValidator is a validator for the "rollup_retention" field. It is called by the builders before save.
+	project.RollupRetentionValidator = projectDescRollupRetention.Validators[0].(func(int64) error)
+	// projectDescCreatedAt is the schema descriptor for created_at field.
+	projectDescCreatedAt := projectFields[4].Descriptor()
+	// project.DefaultCreatedAt holds the default value on creation for the created_at field.
+	project.DefaultCreatedAt = projectDescCreatedAt.Default.(func() time.Time)
+	// projectDescUpdatedAt is the schema descriptor for updated_at field.
+	projectDescUpdatedAt := projectFields[5].Descriptor()
+	// project.DefaultUpdatedAt holds the default value on creation for the updated_at field.
+	project.DefaultUpdatedAt = projectDescUpdatedAt.Default.(func() time.Time)
+	// project.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
+	project.UpdateDefaultUpdatedAt = projectDescUpdatedAt.UpdateDefault.(func() time.Time)
+	subscriptionFields := schema.Subscription{}.Fields()
+	_ = subscriptionFields
+	// subscriptionDescCreatedAt is the schema descriptor for created_at field.
+	subscriptionDescCreatedAt := subscriptionFields[2].Descriptor()
+	// subscription.DefaultCreatedAt holds the default value on creation for the created_at field.
+	subscription.DefaultCreatedAt = subscriptionDescCreatedAt.Default.(func() time.Time)
 	tagFields := schema.Tag{}.Fields()
 	_ = tagFields
 	// tagDescName is the schema descriptor for name field.
@@ -54,4 +219,90 @@ func init() {
 			return nil
 		}
 	}()
+	userFields := schema.User{}.Fields()
+	_ = userFields
+	// userDescUsername is the schema descriptor for username field.
+	userDescUsername := userFields[0].Descriptor()
+	// user.UsernameValidator is a validator for the "username" field. It is called by the builders before save.
+	user.UsernameValidator = func() func(string) error {
+		validators := userDescUsername.Validators
+		fns := [...]func(string) error{
+			validators[0].(func(string) error),
+			validators[1].(func(string) error),
+		}
+		return func(username string) error {
+			for _, fn := range fns {
+				if err := fn(username); err != nil {
+					return err
+				}
+			}
+			return nil
+		}
+	}()
+	// userDescEmail is the schema descriptor for email field.
+	userDescEmail := userFields[3].Descriptor()
+	// user.EmailValidator is a validator for the "email" field. It is called by the builders before save.
+	user.EmailValidator = userDescEmail.Validators[0].(func(string) error)
+	// userDescCreatedAt is the schema descriptor for created_at field.
+	userDescCreatedAt := userFields[4].Descriptor()
+	// user.DefaultCreatedAt holds the default value on creation for the created_at field.
+	user.DefaultCreatedAt = userDescCreatedAt.Default.(func() time.Time)
+	// userDescUpdatedAt is the schema descriptor for updated_at field.
+	userDescUpdatedAt := userFields[5].Descriptor()
+	// user.DefaultUpdatedAt holds the default value on creation for the updated_at field.
+	user.DefaultUpdatedAt = userDescUpdatedAt.Default.(func() time.Time)
+	// user.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
+	user.UpdateDefaultUpdatedAt = userDescUpdatedAt.UpdateDefault.(func() time.Time)
+	webhookFields := schema.Webhook{}.Fields()
+	_ = webhookFields
+	// webhookDescName is the schema descriptor for name field.
+	webhookDescName := webhookFields[0].Descriptor()
+	// webhook.NameValidator is a validator for the "name" field. It is called by the builders before save.
+	webhook.NameValidator = func() func(string) error {
+		validators := webhookDescName.Validators
+		fns := [...]func(string) error{
+			validators[0].(func(string) error),
+			validators[1].(func(string) error),
+		}
+		return func(name string) error {
+			for _, fn := range fns {
+				if err := fn(name); err != nil {
+					return err
+				}
+			}
+			return nil
+		}
+	}()
+	// webhookDescURL is the schema descriptor for url field.
+	webhookDescURL := webhookFields[1].Descriptor()
+	// webhook.URLValidator is a validator for the "url" field. It is called by the builders before save.
+	webhook.URLValidator = webhookDescURL.Validators[0].(func(string) error)
+	// webhookDescCreatedAt is the schema descriptor for created_at field.
+	webhookDescCreatedAt := webhookFields[6].Descriptor()
+	// webhook.DefaultCreatedAt holds the default value on creation for the created_at field.
+	webhook.DefaultCreatedAt = webhookDescCreatedAt.Default.(func() time.Time)
+	// webhookDescUpdatedAt is the schema descriptor for updated_at field.
+	webhookDescUpdatedAt := webhookFields[7].Descriptor()
+	// webhook.DefaultUpdatedAt holds the default value on creation for the updated_at field.
+	webhook.DefaultUpdatedAt = webhookDescUpdatedAt.Default.(func() time.Time)
+	// webhook.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
+	webhook.UpdateDefaultUpdatedAt = webhookDescUpdatedAt.UpdateDefault.(func() time.Time)
+	webhookdeliveryFields := schema.WebhookDelivery{}.Fields()
+	_ = webhookdeliveryFields
+	// webhookdeliveryDescAttempts is the schema descriptor for attempts field.
+	webhookdeliveryDescAttempts := webhookdeliveryFields[3].Descriptor()
+	// webhookdelivery.DefaultAttempts holds the default value on creation for the attempts field.
+	webhookdelivery.DefaultAttempts = webhookdeliveryDescAttempts.Default.(int)
+	// webhookdeliveryDescStatusCode is the schema descriptor for status_code field.
+	webhookdeliveryDescStatusCode := webhookdeliveryFields[4].Descriptor()
+	// webhookdelivery.DefaultStatusCode holds the default value on creation for the status_code field.
+	webhookdelivery.DefaultStatusCode = webhookdeliveryDescStatusCode.Default.(int)
+	// webhookdeliveryDescSuccess is the schema descriptor for success field.
+	webhookdeliveryDescSuccess := webhookdeliveryFields[6].Descriptor()
+	// webhookdelivery.DefaultSuccess holds the default value on creation for the success field.
+	webhookdelivery.DefaultSuccess = webhookdeliveryDescSuccess.Default.(bool)
+	// webhookdeliveryDescCreatedAt is the schema descriptor for created_at field.
+	webhookdeliveryDescCreatedAt := webhookdeliveryFields[7].Descriptor()
+	// webhookdelivery.DefaultCreatedAt holds the default value on creation for the created_at field.
+	webhookdelivery.DefaultCreatedAt = webhookdeliveryDescCreatedAt.Default.(func() time.Time)
 }
@@ -0,0 +1,52 @@
+package schema
+
+import (
+	"time"
+
+	"github.com/facebook/ent"
+	"github.com/facebook/ent/schema/edge"
+	"github.com/facebook/ent/schema/field"
+)
+
+// APIToken holds the schema definition for the APIToken entity.
+// An API token logs a user in without a password until it expires or is
+// deleted, with at most the role of its scope.
+type APIToken struct {
+	ent.Schema
+}
+
+// Fields of the APIToken.
+func (APIToken) Fields() []ent.Field {
+	return []ent.Field{
+		field.String("name").
+			NotEmpty().
+			MaxLen(255),
+		// token_hash is the sha256 of the token, the token is shown once
+		field.String("token_hash").
+			Sensitive().
+			Unique(),
+		// prefix is the start of the token, to tell the tokens apart
+		field.String("prefix"),
+		field.Enum("scope").
+			Values("read", "write", "admin").
+			Default("read"),
+		field.Time("last_used_at").
+			Optional().
+			Nillable(),
+		field.Time("expires_at").
+			Optional().
+			Nillable(),
+		field.Time("created_at").
+			Default(time.Now),
+	}
+}
+
+// Edges of the APIToken.
+func (APIToken) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.From("user", User.Type).
+			Ref("tokens").
+			Unique().
+			Required(),
+	}
+}
@@ -6,6 +6,8 @@ import (
 	"github.com/facebook/ent"
 	"github.com/facebook/ent/schema/edge"
 	"github.com/facebook/ent/schema/field"
+	"github.com/gobench-io/gobench/sink"
+	"github.com/gobench-io/gobench/verdict"
 )
 
 // Application holds the schema definition for the Application entity.
@@ -25,11 +27,35 @@ func (Application) Fields() []ent.Field {
 		field.Time("updated_at").
 			Default(time.Now).
 			UpdateDefault(time.Now),
+		// finished_at is set on the transition to a final status, the end of
+		// the run window
+		field.Time("finished_at").
+			Optional(),
 		field.Text("scenario"),
 		field.Text("gomod").
 			Default(""),
 		field.Text("gosum").
 			Default(""),
+		field.JSON("sinks", []sink.Config{}).
+			Optional(),
+		// the JSON array of the sink tokens, by sink index. A text field since
+		// the JSON fields cannot be sensitive.
+		field.Text("sink_tokens").
+			Optional().
+			Sensitive(),
+		// imported applications come from an archive of another server,
+		// they are read-only
+		field.Bool("imported").
+			Default(false).
+			Optional(),
+		// verdict of the run against its baseline: pass, regressed or
+		// improved, empty when there is no baseline
+		field.String("verdict").
+			Optional(),
+		field.JSON("checks", []verdict.Check{}).
+			Optional(),
+		field.Int("baseline_app_id").
+			Optional(),
 	}
 }
 
@@ -38,5 +64,11 @@ func (Application) Edges() []ent.Edge {
 	return []ent.Edge{
 		edge.To("groups", Group.Type),
 		edge.To("tags", Tag.Type),
+		edge.To("baselines", Baseline.Type),
+		edge.To("events", Event.Type),
+		edge.To("executors", Executor.Type),
+		edge.From("project", Project.Type).
+			Ref("applications").
+			Unique(),
 	}
 }
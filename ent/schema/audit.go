@@ -0,0 +1,50 @@
+package schema
+
+import (
+	"time"
+
+	"github.com/facebook/ent"
+	"github.com/facebook/ent/schema/field"
+	"github.com/facebook/ent/schema/index"
+)
+
+// Audit holds the schema definition for the Audit entity.
+// An audit records an action of a user on the server. It keeps the username
+// and the application ID as values, to outlive the user and the application.
+type Audit struct {
+	ent.Schema
+}
+
+// Fields of the Audit.
+func (Audit) Fields() []ent.Field {
+	return []ent.Field{
+		field.String("username"),
+		field.Enum("action").
+			Values("login", "login_failed", "create", "import", "cancel",
+				"delete", "tag", "untag"),
+		// application_id is the target of the application actions
+		field.Int("application_id").
+			Optional(),
+		// tag is the name of the tag of the tag actions
+		field.String("tag").
+			Optional(),
+		// ip is the source address of the request
+		field.String("ip"),
+		field.Time("created_at").
+			Default(time.Now),
+	}
+}
+
+// Edges of the Audit.
+func (Audit) Edges() []ent.Edge {
+	return nil
+}
+
+// Indexes of the Audit.
+func (Audit) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("created_at"),
+		index.Fields("username"),
+		index.Fields("application_id"),
+	}
+}
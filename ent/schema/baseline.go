@@ -0,0 +1,47 @@
+package schema
+
+import (
+	"time"
+
+	"github.com/facebook/ent"
+	"github.com/facebook/ent/schema/edge"
+	"github.com/facebook/ent/schema/field"
+)
+
+// Baseline holds the schema definition for the Baseline entity.
+// The finished runs with the tag of a baseline are judged against the
+// application of the baseline.
+type Baseline struct {
+	ent.Schema
+}
+
+// Fields of the Baseline.
+func (Baseline) Fields() []ent.Field {
+	return []ent.Field{
+		field.String("tag").
+			NotEmpty().
+			MaxLen(255).
+			Unique(),
+		// tolerance is the allowed change of a stat, in percent
+		field.Float("tolerance").
+			Default(10),
+		// tolerances overrides the tolerance by metric title
+		field.JSON("tolerances", map[string]float64{}).
+			Optional(),
+		field.Time("created_at").
+			Default(time.Now),
+		field.Time("updated_at").
+			Default(time.Now).
+			UpdateDefault(time.Now),
+	}
+}
+
+// Edges of the Baseline.
+func (Baseline) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.From("application", Application.Type).
+			Ref("baselines").
+			Unique().
+			Required(),
+	}
+}
@@ -20,7 +20,9 @@ func (Counter) Fields() []ent.Field {
 		field.Int64("time").StructTag(`json:"time"`),
 		field.Int64("count").StructTag(`json:"count"`),
 
-		field.String("wID").StructTag(`json:"wId"`),
+		// deprecated, the executor edge replaces it. Kept for the rows
+		// written before the executors, they are moved on the master start.
+		field.String("wID").Default("").StructTag(`json:"-"`),
 	}
 }
 
@@ -28,5 +30,6 @@ func (Counter) Fields() []ent.Field {
 func (Counter) Edges() []ent.Edge {
 	return []ent.Edge{
 		edge.From("metric", Metric.Type).Ref("counters").Unique(),
+		edge.From("executor", Executor.Type).Ref("counters").Unique(),
 	}
 }
@@ -0,0 +1,55 @@
+package schema
+
+import (
+	"github.com/facebook/ent"
+	"github.com/facebook/ent/schema/edge"
+	"github.com/facebook/ent/schema/field"
+	"github.com/facebook/ent/schema/index"
+)
+
+// Event holds the schema definition for the Event entity.
+// An event marks a moment of the timeline of an application, emitted by the
+// scenario or recorded on the job status transitions.
+type Event struct {
+	ent.Schema
+}
+
+// Fields of the Event.
+func (Event) Fields() []ent.Field {
+	return []ent.Field{
+		field.String("name").
+			NotEmpty().
+			StructTag(`json:"name"`),
+		// ms, as the metric points
+		field.Int64("time").
+			StructTag(`json:"time"`),
+		// scenario or system
+		field.String("source").
+			StructTag(`json:"source"`),
+		// executor ID of the scenario events
+		field.String("wID").
+			Optional().
+			StructTag(`json:"wId"`),
+		field.JSON("attrs", map[string]string{}).
+			Optional().
+			StructTag(`json:"attrs"`),
+	}
+}
+
+// Edges of the Event.
+func (Event) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.From("application", Application.Type).
+			Ref("events").
+			Unique().
+			Required(),
+	}
+}
+
+// Indexes of the Event.
+func (Event) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("time").
+			Edges("application"),
+	}
+}
@@ -0,0 +1,46 @@
+package schema
+
+import (
+	"github.com/facebook/ent"
+	"github.com/facebook/ent/schema/edge"
+	"github.com/facebook/ent/schema/field"
+	"github.com/facebook/ent/schema/index"
+)
+
+// Executor holds the schema definition for the Executor entity.
+// An executor runs a part of the virtual users of an application, its
+// metric points refer to it rather than repeating its ID on every row.
+type Executor struct {
+	ent.Schema
+}
+
+// Fields of the Executor.
+func (Executor) Fields() []ent.Field {
+	return []ent.Field{
+		field.String("eID").
+			NotEmpty().
+			StructTag(`json:"eId"`),
+	}
+}
+
+// Edges of the Executor.
+func (Executor) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.From("application", Application.Type).
+			Ref("executors").
+			Unique().
+			Required(),
+		edge.To("counters", Counter.Type),
+		edge.To("histograms", Histogram.Type),
+		edge.To("gauges", Gauge.Type),
+	}
+}
+
+// Indexes of the Executor.
+func (Executor) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("eID").
+			Edges("application").
+			Unique(),
+	}
+}
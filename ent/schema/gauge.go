@@ -20,7 +20,8 @@ func (Gauge) Fields() []ent.Field {
 		field.Int64("time").StructTag(`json:"time"`),
 		field.Int64("value").StructTag(`json:"value"`),
 
-		field.String("wID").StructTag(`json:"wId"`),
+		// deprecated, as the wID of the counter
+		field.String("wID").Default("").StructTag(`json:"-"`),
 	}
 }
 
@@ -28,5 +29,6 @@ func (Gauge) Fields() []ent.Field {
 func (Gauge) Edges() []ent.Edge {
 	return []ent.Edge{
 		edge.From("metric", Metric.Type).Ref("gauges").Unique(),
+		edge.From("executor", Executor.Type).Ref("gauges").Unique(),
 	}
 }
@@ -29,7 +29,8 @@ func (Histogram) Fields() []ent.Field {
 		field.Float("p99").StructTag(`json:"p99"`),
 		field.Float("p999").StructTag(`json:"p999"`),
 
-		field.String("wID").StructTag(`json:"wId"`),
+		// deprecated, as the wID of the counter
+		field.String("wID").Default("").StructTag(`json:"-"`),
 	}
 }
 
@@ -37,5 +38,6 @@ func (Histogram) Fields() []ent.Field {
 func (Histogram) Edges() []ent.Edge {
 	return []ent.Edge{
 		edge.From("metric", Metric.Type).Ref("histograms").Unique(),
+		edge.From("executor", Executor.Type).Ref("histograms").Unique(),
 	}
 }
@@ -16,6 +16,8 @@ func (Metric) Fields() []ent.Field {
 	return []ent.Field{
 		field.String("title").Immutable().StructTag(`json:"title"`),
 		field.String("type").StructTag(`json:"type"`),
+		// the points before, in ms, are already downsampled
+		field.Int64("downsampled_until").Optional().StructTag(`json:"-"`),
 	}
 }
 
@@ -0,0 +1,53 @@
+package schema
+
+import (
+	"time"
+
+	"github.com/facebook/ent"
+	"github.com/facebook/ent/schema/edge"
+	"github.com/facebook/ent/schema/field"
+)
+
+// Project holds the schema definition for the Project entity.
+// A project owns the applications of a team, only its members and the
+// admins see them. Its limits let the teams share the server.
+type Project struct {
+	ent.Schema
+}
+
+// Fields of the Project.
+func (Project) Fields() []ent.Field {
+	return []ent.Field{
+		field.String("name").
+			NotEmpty().
+			MaxLen(255).
+			Unique(),
+		// max_queued is the number of pending or running applications of
+		// the project, 0 for no limit
+		field.Int("max_queued").
+			NonNegative().
+			Default(0),
+		// raw_retention and rollup_retention replace the retention of the
+		// server for the applications of the project, in seconds, 0 to
+		// keep the retention of the server
+		field.Int64("raw_retention").
+			NonNegative().
+			Default(0),
+		field.Int64("rollup_retention").
+			NonNegative().
+			Default(0),
+		field.Time("created_at").
+			Default(time.Now),
+		field.Time("updated_at").
+			Default(time.Now).
+			UpdateDefault(time.Now),
+	}
+}
+
+// Edges of the Project.
+func (Project) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.To("applications", Application.Type),
+		edge.To("members", User.Type),
+	}
+}
@@ -0,0 +1,40 @@
+package schema
+
+import (
+	"time"
+
+	"github.com/facebook/ent"
+	"github.com/facebook/ent/schema/edge"
+	"github.com/facebook/ent/schema/field"
+)
+
+// Subscription holds the schema definition for the Subscription entity.
+// A subscription emails a user when an application ends, either one
+// application or the applications with a tag.
+type Subscription struct {
+	ent.Schema
+}
+
+// Fields of the Subscription.
+func (Subscription) Fields() []ent.Field {
+	return []ent.Field{
+		// application_id is the application of the subscription, 0 for a
+		// subscription to a tag
+		field.Int("application_id").
+			Optional(),
+		field.String("tag").
+			Optional(),
+		field.Time("created_at").
+			Default(time.Now),
+	}
+}
+
+// Edges of the Subscription.
+func (Subscription) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.From("user", User.Type).
+			Ref("subscriptions").
+			Unique().
+			Required(),
+	}
+}
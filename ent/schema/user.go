@@ -0,0 +1,51 @@
+package schema
+
+import (
+	"time"
+
+	"github.com/facebook/ent"
+	"github.com/facebook/ent/schema/edge"
+	"github.com/facebook/ent/schema/field"
+)
+
+// User holds the schema definition for the User entity.
+// The role of a user is what it may do: a viewer reads, a runner also
+// creates and cancels applications, an admin also manages the users.
+type User struct {
+	ent.Schema
+}
+
+// Fields of the User.
+func (User) Fields() []ent.Field {
+	return []ent.Field{
+		field.String("username").
+			NotEmpty().
+			MaxLen(255).
+			Unique(),
+		// password_hash is the bcrypt hash of the password
+		field.String("password_hash").
+			Sensitive(),
+		field.Enum("role").
+			Values("viewer", "runner", "admin").
+			Default("viewer"),
+		// email receives the notifications of the subscriptions
+		field.String("email").
+			Optional().
+			MaxLen(255),
+		field.Time("created_at").
+			Default(time.Now),
+		field.Time("updated_at").
+			Default(time.Now).
+			UpdateDefault(time.Now),
+	}
+}
+
+// Edges of the User.
+func (User) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.To("tokens", APIToken.Type),
+		edge.To("subscriptions", Subscription.Type),
+		edge.From("projects", Project.Type).
+			Ref("members"),
+	}
+}
@@ -0,0 +1,54 @@
+package schema
+
+import (
+	"time"
+
+	"github.com/facebook/ent"
+	"github.com/facebook/ent/schema/edge"
+	"github.com/facebook/ent/schema/field"
+)
+
+// Webhook holds the schema definition for the Webhook entity.
+// A webhook posts the status transitions of the applications to a URL, of
+// every application or of the applications with a tag.
+type Webhook struct {
+	ent.Schema
+}
+
+// Fields of the Webhook.
+func (Webhook) Fields() []ent.Field {
+	return []ent.Field{
+		field.String("name").
+			NotEmpty().
+			MaxLen(255),
+		field.String("url").
+			NotEmpty(),
+		// tag limits the webhook to the applications with the tag, empty
+		// for every application
+		field.String("tag").
+			Optional(),
+		// events are the events posted, empty for all of them
+		field.JSON("events", []string{}).
+			Optional(),
+		// template is a text/template of the JSON body, empty for the
+		// default payload
+		field.Text("template").
+			Optional(),
+		// secret signs the body with HMAC-SHA256
+		field.String("secret").
+			Optional().
+			Sensitive(),
+		field.Time("created_at").
+			Default(time.Now),
+		field.Time("updated_at").
+			Default(time.Now).
+			UpdateDefault(time.Now),
+	}
+}
+
+// Edges of the Webhook.
+func (Webhook) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.To("deliveries", WebhookDelivery.Type),
+	}
+}
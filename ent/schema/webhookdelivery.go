@@ -0,0 +1,61 @@
+package schema
+
+import (
+	"time"
+
+	"github.com/facebook/ent"
+	"github.com/facebook/ent/schema/edge"
+	"github.com/facebook/ent/schema/field"
+	"github.com/facebook/ent/schema/index"
+)
+
+// WebhookDelivery holds the schema definition for the WebhookDelivery entity.
+// A delivery is the post of an event to a webhook, with its attempts.
+type WebhookDelivery struct {
+	ent.Schema
+}
+
+// Fields of the WebhookDelivery.
+func (WebhookDelivery) Fields() []ent.Field {
+	return []ent.Field{
+		// event is start, finish, error, cancel or breach
+		field.String("event"),
+		// application_id is kept as a value, to outlive the application
+		field.Int("application_id"),
+		field.Text("payload"),
+		field.Int("attempts").
+			Default(0),
+		// status_code is the HTTP status of the last attempt, 0 when the
+		// request failed
+		field.Int("status_code").
+			Default(0),
+		// error is the failure of the last attempt
+		field.String("error").
+			Optional(),
+		field.Bool("success").
+			Default(false),
+		field.Time("created_at").
+			Default(time.Now),
+		field.Time("delivered_at").
+			Optional().
+			Nillable(),
+	}
+}
+
+// Edges of the WebhookDelivery.
+func (WebhookDelivery) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.From("webhook", Webhook.Type).
+			Ref("deliveries").
+			Unique().
+			Required(),
+	}
+}
+
+// Indexes of the WebhookDelivery.
+func (WebhookDelivery) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("created_at").
+			Edges("webhook"),
+	}
+}
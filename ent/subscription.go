@@ -0,0 +1,156 @@
+// Code generated by entc, DO NOT EDIT.
+
+package ent
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/facebook/ent/dialect/sql"
+	"github.com/gobench-io/gobench/ent/subscription"
+	"github.com/gobench-io/gobench/ent/user"
+)
+
+// Subscription is the model entity for the Subscription schema.
+type Subscription struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID int `json:"id,omitempty"`
+	// ApplicationID holds the value of the "application_id" field.
+	ApplicationID int `json:"application_id,omitempty"`
+	// Tag holds the value of the "tag" field.
+	Tag string `json:"tag,omitempty"`
+	// CreatedAt holds the value of the "created_at" field.
+	CreatedAt time.Time `json:"created_at,omitempty"`
+	// Edges holds the relations/edges for other nodes in the graph.
+	// The values are being populated by the SubscriptionQuery when eager-loading is set.
+	Edges              SubscriptionEdges `json:"edges"`
+	user_subscriptions *int
+}
+
+// SubscriptionEdges holds the relations/edges for other nodes in the graph.
+type SubscriptionEdges struct {
+	// User holds the value of the user edge.
+	User *User
+	// loadedTypes holds the information for reporting if a
+	// type was loaded (or requested) in eager-loading or not.
+	loadedTypes [1]bool
+}
+
+// UserOrErr returns the User value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e SubscriptionEdges) UserOrErr() (*User, error) {
+	if e.loadedTypes[0] {
+		if e.User == nil {
+			// The edge user was loaded in eager-loading,
+			// but was not found.
+			return nil, &NotFoundError{label: user.Label}
+		}
+		return e.User, nil
+	}
+	return nil, &NotLoadedError{edge: "user"}
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*Subscription) scanValues() []interface{} {
+	return []interface{}{
+		&sql.NullInt64{},  // id
+		&sql.NullInt64{},  // application_id
+		&sql.NullString{}, // tag
+		&sql.NullTime{},   // created_at
+	}
+}
+
+// fkValues returns the types for scanning foreign-keys values from sql.Rows.
+func (*Subscription) fkValues() []interface{} {
+	return []interface{}{
+		&sql.NullInt64{}, // user_subscriptions
+	}
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the Subscription fields.
+func (s *Subscription) assignValues(values ...interface{}) error {
+	if m, n := len(values), len(subscription.Columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	value, ok := values[0].(*sql.NullInt64)
+	if !ok {
+		return fmt.Errorf("unexpected type %T for field id", value)
+	}
+	s.ID = int(value.Int64)
+	values = values[1:]
+	if value, ok := values[0].(*sql.NullInt64); !ok {
+		return fmt.Errorf("unexpected type %T for field application_id", values[0])
+	} else if value.Valid {
+		s.ApplicationID = int(value.Int64)
+	}
+	if value, ok := values[1].(*sql.NullString); !ok {
+		return fmt.Errorf("unexpected type %T for field tag", values[1])
+	} else if value.Valid {
+		s.Tag = value.String
+	}
+	if value, ok := values[2].(*sql.NullTime); !ok {
+		return fmt.Errorf("unexpected type %T for field created_at", values[2])
+	} else if value.Valid {
+		s.CreatedAt = value.Time
+	}
+	values = values[3:]
+	if len(values) == len(subscription.ForeignKeys) {
+		if value, ok := values[0].(*sql.NullInt64); !ok {
+			return fmt.Errorf("unexpected type %T for edge-field user_subscriptions", value)
+		} else if value.Valid {
+			s.user_subscriptions = new(int)
+			*s.user_subscriptions = int(value.Int64)
+		}
+	}
+	return nil
+}
+
+// QueryUser queries the user edge of the Subscription.
+func (s *Subscription) QueryUser() *UserQuery {
+	return (&SubscriptionClient{config: s.config}).QueryUser(s)
+}
+
+// Update returns a builder for updating this Subscription.
+// Note that, you need to call Subscription.Unwrap() before calling this method, if this Subscription
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (s *Subscription) Update() *SubscriptionUpdateOne {
+	return (&SubscriptionClient{config: s.config}).UpdateOne(s)
+}
+
+// Unwrap unwraps the entity that was returned from a transaction after it was closed,
+// so that all next queries will be executed through the driver which created the transaction.
+func (s *Subscription) Unwrap() *Subscription {
+	tx, ok := s.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: Subscription is not a transactional entity")
+	}
+	s.config.driver = tx.drv
+	return s
+}
+
+// String implements the fmt.Stringer.
+func (s *Subscription) String() string {
+	var builder strings.Builder
+	builder.WriteString("Subscription(")
+	builder.WriteString(fmt.Sprintf("id=%v", s.ID))
+	builder.WriteString(", application_id=")
+	builder.WriteString(fmt.Sprintf("%v", s.ApplicationID))
+	builder.WriteString(", tag=")
+	builder.WriteString(s.Tag)
+	builder.WriteString(", created_at=")
+	builder.WriteString(s.CreatedAt.Format(time.ANSIC))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// Subscriptions is a parsable slice of Subscription.
+type Subscriptions []*Subscription
+
+func (s Subscriptions) config(cfg config) {
+	for _i := range s {
+		s[_i].config = cfg
+	}
+}
@@ -0,0 +1,66 @@
+// Code generated by entc, DO NOT EDIT.
+
+package subscription
+
+import (
+	"time"
+)
+
+const (
+	// Label holds the string label denoting the subscription type in the database.
+	Label = "subscription"
+	// FieldID holds the string denoting the id field in the database.
+	FieldID = "id"
+	// FieldApplicationID holds the string denoting the application_id field in the database.
+	FieldApplicationID = "application_id"
+	// FieldTag holds the string denoting the tag field in the database.
+	FieldTag = "tag"
+	// FieldCreatedAt holds the string denoting the created_at field in the database.
+	FieldCreatedAt = "created_at"
+
+	// EdgeUser holds the string denoting the user edge name in mutations.
+	EdgeUser = "user"
+
+	// Table holds the table name of the subscription in the database.
+	Table = "subscriptions"
+	// UserTable is the table the holds the user relation/edge.
+	UserTable = "subscriptions"
+	// UserInverseTable is the table name for the User entity.
+	// It exists in this package in order to avoid circular dependency with the "user" package.
+	UserInverseTable = "users"
+	// UserColumn is the table column denoting the user relation/edge.
+	UserColumn = "user_subscriptions"
+)
+
+// Columns holds all SQL columns for subscription fields.
+var Columns = []string{
+	FieldID,
+	FieldApplicationID,
+	FieldTag,
+	FieldCreatedAt,
+}
+
+// ForeignKeys holds the SQL foreign-keys that are owned by the Subscription type.
+var ForeignKeys = []string{
+	"user_subscriptions",
+}
+
+// ValidColumn reports if the column name is valid (part of the table columns).
+func ValidColumn(column string) bool {
+	for i := range Columns {
+		if column == Columns[i] {
+			return true
+		}
+	}
+	for i := range ForeignKeys {
+		if column == ForeignKeys[i] {
+			return true
+		}
+	}
+	return false
+}
+
+var (
+	// DefaultCreatedAt holds the default value on creation for the created_at field.
+	DefaultCreatedAt func() time.Time
+)
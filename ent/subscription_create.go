@@ -0,0 +1,281 @@
+// Code generated by entc, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/facebook/ent/dialect/sql/sqlgraph"
+	"github.com/facebook/ent/schema/field"
+	"github.com/gobench-io/gobench/ent/subscription"
+	"github.com/gobench-io/gobench/ent/user"
+)
+
+// SubscriptionCreate is the builder for creating a Subscription entity.
+type SubscriptionCreate struct {
+	config
+	mutation *SubscriptionMutation
+	hooks    []Hook
+}
+
+// SetApplicationID sets the application_id field.
+func (sc *SubscriptionCreate) SetApplicationID(i int) *SubscriptionCreate {
+	sc.mutation.SetApplicationID(i)
+	return sc
+}
+
+// SetNillableApplicationID sets the application_id field if the given value is not nil.
+func (sc *SubscriptionCreate) SetNillableApplicationID(i *int) *SubscriptionCreate {
+	if i != nil {
+		sc.SetApplicationID(*i)
+	}
+	return sc
+}
+
+// SetTag sets the tag field.
+func (sc *SubscriptionCreate) SetTag(s string) *SubscriptionCreate {
+	sc.mutation.SetTag(s)
+	return sc
+}
+
+// SetNillableTag sets the tag field if the given value is not nil.
+func (sc *SubscriptionCreate) SetNillableTag(s *string) *SubscriptionCreate {
+	if s != nil {
+		sc.SetTag(*s)
+	}
+	return sc
+}
+
+// SetCreatedAt sets the created_at field.
+func (sc *SubscriptionCreate) SetCreatedAt(t time.Time) *SubscriptionCreate {
+	sc.mutation.SetCreatedAt(t)
+	return sc
+}
+
+// SetNillableCreatedAt sets the created_at field if the given value is not nil.
+func (sc *SubscriptionCreate) SetNillableCreatedAt(t *time.Time) *SubscriptionCreate {
+	if t != nil {
+		sc.SetCreatedAt(*t)
+	}
+	return sc
+}
+
+// SetUserID sets the user edge to User by id.
+func (sc *SubscriptionCreate) SetUserID(id int) *SubscriptionCreate {
+	sc.mutation.SetUserID(id)
+	return sc
+}
+
+// SetUser sets the user edge to User.
+func (sc *SubscriptionCreate) SetUser(u *User) *SubscriptionCreate {
+	return sc.SetUserID(u.ID)
+}
+
+// Mutation returns the SubscriptionMutation object of the builder.
+func (sc *SubscriptionCreate) Mutation() *SubscriptionMutation {
+	return sc.mutation
+}
+
+// Save creates the Subscription in the database.
+func (sc *SubscriptionCreate) Save(ctx context.Context) (*Subscription, error) {
+	var (
+		err  error
+		node *Subscription
+	)
+	sc.defaults()
+	if len(sc.hooks) == 0 {
+		if err = sc.check(); err != nil {
+			return nil, err
+		}
+		node, err = sc.sqlSave(ctx)
+	} else {
+		var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+			mutation, ok := m.(*SubscriptionMutation)
+			if !ok {
+				return nil, fmt.Errorf("unexpected mutation type %T", m)
+			}
+			if err = sc.check(); err != nil {
+				return nil, err
+			}
+			sc.mutation = mutation
+			node, err = sc.sqlSave(ctx)
+			mutation.done = true
+			return node, err
+		})
+		for i := len(sc.hooks) - 1; i >= 0; i-- {
+			mut = sc.hooks[i](mut)
+		}
+		if _, err := mut.Mutate(ctx, sc.mutation); err != nil {
+			return nil, err
+		}
+	}
+	return node, err
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (sc *SubscriptionCreate) SaveX(ctx context.Context) *Subscription {
+	v, err := sc.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// defaults sets the default values of the builder before save.
+func (sc *SubscriptionCreate) defaults() {
+	if _, ok := sc.mutation.CreatedAt(); !ok {
+		v := subscription.DefaultCreatedAt()
+		sc.mutation.SetCreatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (sc *SubscriptionCreate) check() error {
+	if _, ok := sc.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New("ent: missing required field \"created_at\"")}
+	}
+	if _, ok := sc.mutation.UserID(); !ok {
+		return &ValidationError{Name: "user", err: errors.New("ent: missing required edge \"user\"")}
+	}
+	return nil
+}
+
+func (sc *SubscriptionCreate) sqlSave(ctx context.Context) (*Subscription, error) {
+	_node, _spec := sc.createSpec()
+	if err := sqlgraph.CreateNode(ctx, sc.driver, _spec); err != nil {
+		if cerr, ok := isSQLConstraintError(err); ok {
+			err = cerr
+		}
+		return nil, err
+	}
+	id := _spec.ID.Value.(int64)
+	_node.ID = int(id)
+	return _node, nil
+}
+
+func (sc *SubscriptionCreate) createSpec() (*Subscription, *sqlgraph.CreateSpec) {
+	var (
+		_node = &Subscription{config: sc.config}
+		_spec = &sqlgraph.CreateSpec{
+			Table: subscription.Table,
+			ID: &sqlgraph.FieldSpec{
+				Type:   field.TypeInt,
+				Column: subscription.FieldID,
+			},
+		}
+	)
+	if value, ok := sc.mutation.ApplicationID(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt,
+			Value:  value,
+			Column: subscription.FieldApplicationID,
+		})
+		_node.ApplicationID = value
+	}
+	if value, ok := sc.mutation.Tag(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: subscription.FieldTag,
+		})
+		_node.Tag = value
+	}
+	if value, ok := sc.mutation.CreatedAt(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeTime,
+			Value:  value,
+			Column: subscription.FieldCreatedAt,
+		})
+		_node.CreatedAt = value
+	}
+	if nodes := sc.mutation.UserIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   subscription.UserTable,
+			Columns: []string{subscription.UserColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt,
+					Column: user.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	return _node, _spec
+}
+
+// SubscriptionCreateBulk is the builder for creating a bulk of Subscription entities.
+type SubscriptionCreateBulk struct {
+	config
+	builders []*SubscriptionCreate
+}
+
+// Save creates the Subscription entities in the database.
+func (scb *SubscriptionCreateBulk) Save(ctx context.Context) ([]*Subscription, error) {
+	specs := make([]*sqlgraph.CreateSpec, len(scb.builders))
+	nodes := make([]*Subscription, len(scb.builders))
+	mutators := make([]Mutator, len(scb.builders))
+	for i := range scb.builders {
+		func(i int, root context.Context) {
+			builder := scb.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*SubscriptionMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				nodes[i], specs[i] = builder.createSpec()
+				var err error
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, scb.builders[i+1].mutation)
+				} else {
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, scb.driver, &sqlgraph.BatchCreateSpec{Nodes: specs}); err != nil {
+						if cerr, ok := isSQLConstraintError(err); ok {
+							err = cerr
+						}
+					}
+				}
+				mutation.done = true
+				if err != nil {
+					return nil, err
+				}
+				id := specs[i].ID.Value.(int64)
+				nodes[i].ID = int(id)
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, scb.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (scb *SubscriptionCreateBulk) SaveX(ctx context.Context) []*Subscription {
+	v, err := scb.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
@@ -0,0 +1,543 @@
+// Code generated by entc, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/facebook/ent/dialect/sql"
+	"github.com/facebook/ent/dialect/sql/sqlgraph"
+	"github.com/facebook/ent/schema/field"
+	"github.com/gobench-io/gobench/ent/predicate"
+	"github.com/gobench-io/gobench/ent/subscription"
+	"github.com/gobench-io/gobench/ent/user"
+)
+
+// SubscriptionUpdate is the builder for updating Subscription entities.
+type SubscriptionUpdate struct {
+	config
+	hooks      []Hook
+	mutation   *SubscriptionMutation
+	predicates []predicate.Subscription
+}
+
+// Where adds a new predicate for the builder.
+func (su *SubscriptionUpdate) Where(ps ...predicate.Subscription) *SubscriptionUpdate {
+	su.predicates = append(su.predicates, ps...)
+	return su
+}
+
+// SetApplicationID sets the application_id field.
+func (su *SubscriptionUpdate) SetApplicationID(i int) *SubscriptionUpdate {
+	su.mutation.ResetApplicationID()
+	su.mutation.SetApplicationID(i)
+	return su
+}
+
+// SetNillableApplicationID sets the application_id field if the given value is not nil.
+func (su *SubscriptionUpdate) SetNillableApplicationID(i *int) *SubscriptionUpdate {
+	if i != nil {
+		su.SetApplicationID(*i)
+	}
+	return su
+}
+
+// AddApplicationID adds i to application_id.
+func (su *SubscriptionUpdate) AddApplicationID(i int) *SubscriptionUpdate {
+	su.mutation.AddApplicationID(i)
+	return su
+}
+
+// ClearApplicationID clears the value of application_id.
+func (su *SubscriptionUpdate) ClearApplicationID() *SubscriptionUpdate {
+	su.mutation.ClearApplicationID()
+	return su
+}
+
+// SetTag sets the tag field.
+func (su *SubscriptionUpdate) SetTag(s string) *SubscriptionUpdate {
+	su.mutation.SetTag(s)
+	return su
+}
+
+// SetNillableTag sets the tag field if the given value is not nil.
+func (su *SubscriptionUpdate) SetNillableTag(s *string) *SubscriptionUpdate {
+	if s != nil {
+		su.SetTag(*s)
+	}
+	return su
+}
+
+// ClearTag clears the value of tag.
+func (su *SubscriptionUpdate) ClearTag() *SubscriptionUpdate {
+	su.mutation.ClearTag()
+	return su
+}
+
+// SetCreatedAt sets the created_at field.
+func (su *SubscriptionUpdate) SetCreatedAt(t time.Time) *SubscriptionUpdate {
+	su.mutation.SetCreatedAt(t)
+	return su
+}
+
+// SetNillableCreatedAt sets the created_at field if the given value is not nil.
+func (su *SubscriptionUpdate) SetNillableCreatedAt(t *time.Time) *SubscriptionUpdate {
+	if t != nil {
+		su.SetCreatedAt(*t)
+	}
+	return su
+}
+
+// SetUserID sets the user edge to User by id.
+func (su *SubscriptionUpdate) SetUserID(id int) *SubscriptionUpdate {
+	su.mutation.SetUserID(id)
+	return su
+}
+
+// SetUser sets the user edge to User.
+func (su *SubscriptionUpdate) SetUser(u *User) *SubscriptionUpdate {
+	return su.SetUserID(u.ID)
+}
+
+// Mutation returns the SubscriptionMutation object of the builder.
+func (su *SubscriptionUpdate) Mutation() *SubscriptionMutation {
+	return su.mutation
+}
+
+// ClearUser clears the "user" edge to type User.
+func (su *SubscriptionUpdate) ClearUser() *SubscriptionUpdate {
+	su.mutation.ClearUser()
+	return su
+}
+
+// Save executes the query and returns the number of rows/vertices matched by this operation.
+func (su *SubscriptionUpdate) Save(ctx context.Context) (int, error) {
+	var (
+		err      error
+		affected int
+	)
+	if len(su.hooks) == 0 {
+		if err = su.check(); err != nil {
+			return 0, err
+		}
+		affected, err = su.sqlSave(ctx)
+	} else {
+		var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+			mutation, ok := m.(*SubscriptionMutation)
+			if !ok {
+				return nil, fmt.Errorf("unexpected mutation type %T", m)
+			}
+			if err = su.check(); err != nil {
+				return 0, err
+			}
+			su.mutation = mutation
+			affected, err = su.sqlSave(ctx)
+			mutation.done = true
+			return affected, err
+		})
+		for i := len(su.hooks) - 1; i >= 0; i-- {
+			mut = su.hooks[i](mut)
+		}
+		if _, err := mut.Mutate(ctx, su.mutation); err != nil {
+			return 0, err
+		}
+	}
+	return affected, err
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (su *SubscriptionUpdate) SaveX(ctx context.Context) int {
+	affected, err := su.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (su *SubscriptionUpdate) Exec(ctx context.Context) error {
+	_, err := su.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (su *SubscriptionUpdate) ExecX(ctx context.Context) {
+	if err := su.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (su *SubscriptionUpdate) check() error {
+	if _, ok := su.mutation.UserID(); su.mutation.UserCleared() && !ok {
+		return errors.New("ent: clearing a required unique edge \"user\"")
+	}
+	return nil
+}
+
+func (su *SubscriptionUpdate) sqlSave(ctx context.Context) (n int, err error) {
+	_spec := &sqlgraph.UpdateSpec{
+		Node: &sqlgraph.NodeSpec{
+			Table:   subscription.Table,
+			Columns: subscription.Columns,
+			ID: &sqlgraph.FieldSpec{
+				Type:   field.TypeInt,
+				Column: subscription.FieldID,
+			},
+		},
+	}
+	if ps := su.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := su.mutation.ApplicationID(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt,
+			Value:  value,
+			Column: subscription.FieldApplicationID,
+		})
+	}
+	if value, ok := su.mutation.AddedApplicationID(); ok {
+		_spec.Fields.Add = append(_spec.Fields.Add, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt,
+			Value:  value,
+			Column: subscription.FieldApplicationID,
+		})
+	}
+	if su.mutation.ApplicationIDCleared() {
+		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt,
+			Column: subscription.FieldApplicationID,
+		})
+	}
+	if value, ok := su.mutation.Tag(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: subscription.FieldTag,
+		})
+	}
+	if su.mutation.TagCleared() {
+		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Column: subscription.FieldTag,
+		})
+	}
+	if value, ok := su.mutation.CreatedAt(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeTime,
+			Value:  value,
+			Column: subscription.FieldCreatedAt,
+		})
+	}
+	if su.mutation.UserCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   subscription.UserTable,
+			Columns: []string{subscription.UserColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt,
+					Column: user.FieldID,
+				},
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := su.mutation.UserIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   subscription.UserTable,
+			Columns: []string{subscription.UserColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt,
+					Column: user.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if n, err = sqlgraph.UpdateNodes(ctx, su.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{subscription.Label}
+		} else if cerr, ok := isSQLConstraintError(err); ok {
+			err = cerr
+		}
+		return 0, err
+	}
+	return n, nil
+}
+
+// SubscriptionUpdateOne is the builder for updating a single Subscription entity.
+type SubscriptionUpdateOne struct {
+	config
+	hooks    []Hook
+	mutation *SubscriptionMutation
+}
+
+// SetApplicationID sets the application_id field.
+func (suo *SubscriptionUpdateOne) SetApplicationID(i int) *SubscriptionUpdateOne {
+	suo.mutation.ResetApplicationID()
+	suo.mutation.SetApplicationID(i)
+	return suo
+}
+
+// SetNillableApplicationID sets the application_id field if the given value is not nil.
+func (suo *SubscriptionUpdateOne) SetNillableApplicationID(i *int) *SubscriptionUpdateOne {
+	if i != nil {
+		suo.SetApplicationID(*i)
+	}
+	return suo
+}
+
+// AddApplicationID adds i to application_id.
+func (suo *SubscriptionUpdateOne) AddApplicationID(i int) *SubscriptionUpdateOne {
+	suo.mutation.AddApplicationID(i)
+	return suo
+}
+
+// ClearApplicationID clears the value of application_id.
+func (suo *SubscriptionUpdateOne) ClearApplicationID() *SubscriptionUpdateOne {
+	suo.mutation.ClearApplicationID()
+	return suo
+}
+
+// SetTag sets the tag field.
+func (suo *SubscriptionUpdateOne) SetTag(s string) *SubscriptionUpdateOne {
+	suo.mutation.SetTag(s)
+	return suo
+}
+
+// SetNillableTag sets the tag field if the given value is not nil.
+func (suo *SubscriptionUpdateOne) SetNillableTag(s *string) *SubscriptionUpdateOne {
+	if s != nil {
+		suo.SetTag(*s)
+	}
+	return suo
+}
+
+// ClearTag clears the value of tag.
+func (suo *SubscriptionUpdateOne) ClearTag() *SubscriptionUpdateOne {
+	suo.mutation.ClearTag()
+	return suo
+}
+
+// SetCreatedAt sets the created_at field.
+func (suo *SubscriptionUpdateOne) SetCreatedAt(t time.Time) *SubscriptionUpdateOne {
+	suo.mutation.SetCreatedAt(t)
+	return suo
+}
+
+// SetNillableCreatedAt sets the created_at field if the given value is not nil.
+func (suo *SubscriptionUpdateOne) SetNillableCreatedAt(t *time.Time) *SubscriptionUpdateOne {
+	if t != nil {
+		suo.SetCreatedAt(*t)
+	}
+	return suo
+}
+
+// SetUserID sets the user edge to User by id.
+func (suo *SubscriptionUpdateOne) SetUserID(id int) *SubscriptionUpdateOne {
+	suo.mutation.SetUserID(id)
+	return suo
+}
+
+// SetUser sets the user edge to User.
+func (suo *SubscriptionUpdateOne) SetUser(u *User) *SubscriptionUpdateOne {
+	return suo.SetUserID(u.ID)
+}
+
+// Mutation returns the SubscriptionMutation object of the builder.
+func (suo *SubscriptionUpdateOne) Mutation() *SubscriptionMutation {
+	return suo.mutation
+}
+
+// ClearUser clears the "user" edge to type User.
+func (suo *SubscriptionUpdateOne) ClearUser() *SubscriptionUpdateOne {
+	suo.mutation.ClearUser()
+	return suo
+}
+
+// Save executes the query and returns the updated entity.
+func (suo *SubscriptionUpdateOne) Save(ctx context.Context) (*Subscription, error) {
+	var (
+		err  error
+		node *Subscription
+	)
+	if len(suo.hooks) == 0 {
+		if err = suo.check(); err != nil {
+			return nil, err
+		}
+		node, err = suo.sqlSave(ctx)
+	} else {
+		var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+			mutation, ok := m.(*SubscriptionMutation)
+			if !ok {
+				return nil, fmt.Errorf("unexpected mutation type %T", m)
+			}
+			if err = suo.check(); err != nil {
+				return nil, err
+			}
+			suo.mutation = mutation
+			node, err = suo.sqlSave(ctx)
+			mutation.done = true
+			return node, err
+		})
+		for i := len(suo.hooks) - 1; i >= 0; i-- {
+			mut = suo.hooks[i](mut)
+		}
+		if _, err := mut.Mutate(ctx, suo.mutation); err != nil {
+			return nil, err
+		}
+	}
+	return node, err
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (suo *SubscriptionUpdateOne) SaveX(ctx context.Context) *Subscription {
+	node, err := suo.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (suo *SubscriptionUpdateOne) Exec(ctx context.Context) error {
+	_, err := suo.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (suo *SubscriptionUpdateOne) ExecX(ctx context.Context) {
+	if err := suo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (suo *SubscriptionUpdateOne) check() error {
+	if _, ok := suo.mutation.UserID(); suo.mutation.UserCleared() && !ok {
+		return errors.New("ent: clearing a required unique edge \"user\"")
+	}
+	return nil
+}
+
+func (suo *SubscriptionUpdateOne) sqlSave(ctx context.Context) (_node *Subscription, err error) {
+	_spec := &sqlgraph.UpdateSpec{
+		Node: &sqlgraph.NodeSpec{
+			Table:   subscription.Table,
+			Columns: subscription.Columns,
+			ID: &sqlgraph.FieldSpec{
+				Type:   field.TypeInt,
+				Column: subscription.FieldID,
+			},
+		},
+	}
+	id, ok := suo.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "ID", err: fmt.Errorf("missing Subscription.ID for update")}
+	}
+	_spec.Node.ID.Value = id
+	if value, ok := suo.mutation.ApplicationID(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt,
+			Value:  value,
+			Column: subscription.FieldApplicationID,
+		})
+	}
+	if value, ok := suo.mutation.AddedApplicationID(); ok {
+		_spec.Fields.Add = append(_spec.Fields.Add, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt,
+			Value:  value,
+			Column: subscription.FieldApplicationID,
+		})
+	}
+	if suo.mutation.ApplicationIDCleared() {
+		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt,
+			Column: subscription.FieldApplicationID,
+		})
+	}
+	if value, ok := suo.mutation.Tag(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: subscription.FieldTag,
+		})
+	}
+	if suo.mutation.TagCleared() {
+		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Column: subscription.FieldTag,
+		})
+	}
+	if value, ok := suo.mutation.CreatedAt(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeTime,
+			Value:  value,
+			Column: subscription.FieldCreatedAt,
+		})
+	}
+	if suo.mutation.UserCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   subscription.UserTable,
+			Columns: []string{subscription.UserColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt,
+					Column: user.FieldID,
+				},
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := suo.mutation.UserIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   subscription.UserTable,
+			Columns: []string{subscription.UserColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt,
+					Column: user.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_node = &Subscription{config: suo.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues()
+	if err = sqlgraph.UpdateNode(ctx, suo.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{subscription.Label}
+		} else if cerr, ok := isSQLConstraintError(err); ok {
+			err = cerr
+		}
+		return nil, err
+	}
+	return _node, nil
+}
@@ -257,7 +257,8 @@ func (tq *TagQuery) Clone() *TagQuery {
 	}
 }
 
-//  WithApplication tells the query-builder to eager-loads the nodes that are connected to
+//	WithApplication tells the query-builder to eager-loads the nodes that are connected to
+//
 // the "application" edge. The optional arguments used to configure the query builder of the edge.
 func (tq *TagQuery) WithApplication(opts ...func(*ApplicationQuery)) *TagQuery {
 	query := &ApplicationQuery{config: tq.config}
@@ -274,7 +275,7 @@ func (tq *TagQuery) WithApplication(opts ...func(*ApplicationQuery)) *TagQuery {
 // Example:
 //
 //	var v []struct {
-//		Name string `json:"name,omitempty"`
+//		Name string `json:"name"`
 //		Count int `json:"count,omitempty"`
 //	}
 //
@@ -282,7 +283,6 @@ func (tq *TagQuery) WithApplication(opts ...func(*ApplicationQuery)) *TagQuery {
 //		GroupBy(tag.FieldName).
 //		Aggregate(ent.Count()).
 //		Scan(ctx, &v)
-//
 func (tq *TagQuery) GroupBy(field string, fields ...string) *TagGroupBy {
 	group := &TagGroupBy{config: tq.config}
 	group.fields = append([]string{field}, fields...)
@@ -300,13 +300,12 @@ func (tq *TagQuery) GroupBy(field string, fields ...string) *TagGroupBy {
 // Example:
 //
 //	var v []struct {
-//		Name string `json:"name,omitempty"`
+//		Name string `json:"name"`
 //	}
 //
 //	client.Tag.Query().
 //		Select(tag.FieldName).
 //		Scan(ctx, &v)
-//
 func (tq *TagQuery) Select(field string, fields ...string) *TagSelect {
 	selector := &TagSelect{config: tq.config}
 	selector.fields = append([]string{field}, fields...)
@@ -12,10 +12,20 @@ import (
 // Tx is a transactional client that is created by calling Client.Tx().
 type Tx struct {
 	config
+	// APIToken is the client for interacting with the APIToken builders.
+	APIToken *APITokenClient
 	// Application is the client for interacting with the Application builders.
 	Application *ApplicationClient
+	// Audit is the client for interacting with the Audit builders.
+	Audit *AuditClient
+	// Baseline is the client for interacting with the Baseline builders.
+	Baseline *BaselineClient
 	// Counter is the client for interacting with the Counter builders.
 	Counter *CounterClient
+	// Event is the client for interacting with the Event builders.
+	Event *EventClient
+	// Executor is the client for interacting with the Executor builders.
+	Executor *ExecutorClient
 	// Gauge is the client for interacting with the Gauge builders.
 	Gauge *GaugeClient
 	// Graph is the client for interacting with the Graph builders.
@@ -26,8 +36,18 @@ type Tx struct {
 	Histogram *HistogramClient
 	// Metric is the client for interacting with the Metric builders.
 	Metric *MetricClient
+	// Project is the client for interacting with the Project builders.
+	Project *ProjectClient
+	// Subscription is the client for interacting with the Subscription builders.
+	Subscription *SubscriptionClient
 	// Tag is the client for interacting with the Tag builders.
 	Tag *TagClient
+	// User is the client for interacting with the User builders.
+	User *UserClient
+	// Webhook is the client for interacting with the Webhook builders.
+	Webhook *WebhookClient
+	// WebhookDelivery is the client for interacting with the WebhookDelivery builders.
+	WebhookDelivery *WebhookDeliveryClient
 
 	// lazily loaded.
 	client     *Client
@@ -163,14 +183,24 @@ func (tx *Tx) Client() *Client {
 }
 
 func (tx *Tx) init() {
+	tx.APIToken = NewAPITokenClient(tx.config)
 	tx.Application = NewApplicationClient(tx.config)
+	tx.Audit = NewAuditClient(tx.config)
+	tx.Baseline = NewBaselineClient(tx.config)
 	tx.Counter = NewCounterClient(tx.config)
+	tx.Event = NewEventClient(tx.config)
+	tx.Executor = NewExecutorClient(tx.config)
 	tx.Gauge = NewGaugeClient(tx.config)
 	tx.Graph = NewGraphClient(tx.config)
 	tx.Group = NewGroupClient(tx.config)
 	tx.Histogram = NewHistogramClient(tx.config)
 	tx.Metric = NewMetricClient(tx.config)
+	tx.Project = NewProjectClient(tx.config)
+	tx.Subscription = NewSubscriptionClient(tx.config)
 	tx.Tag = NewTagClient(tx.config)
+	tx.User = NewUserClient(tx.config)
+	tx.Webhook = NewWebhookClient(tx.config)
+	tx.WebhookDelivery = NewWebhookDeliveryClient(tx.config)
 }
 
 // txDriver wraps the given dialect.Tx with a nop dialect.Driver implementation.
@@ -180,7 +210,7 @@ func (tx *Tx) init() {
 // of them in order to commit or rollback the transaction.
 //
 // If a closed transaction is embedded in one of the generated entities, and the entity
-// applies a query, for example: Application.QueryXXX(), the query will be executed
+// applies a query, for example: APIToken.QueryXXX(), the query will be executed
 // through the driver which created this transaction.
 //
 // Note that txDriver is not goroutine safe.
@@ -0,0 +1,186 @@
+// Code generated by entc, DO NOT EDIT.
+
+package ent
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/facebook/ent/dialect/sql"
+	"github.com/gobench-io/gobench/ent/webhook"
+)
+
+// Webhook is the model entity for the Webhook schema.
+type Webhook struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID int `json:"id,omitempty"`
+	// Name holds the value of the "name" field.
+	Name string `json:"name,omitempty"`
+	// URL holds the value of the "url" field.
+	URL string `json:"url,omitempty"`
+	// Tag holds the value of the "tag" field.
+	Tag string `json:"tag,omitempty"`
+	// Events holds the value of the "events" field.
+	Events []string `json:"events,omitempty"`
+	// Template holds the value of the "template" field.
+	Template string `json:"template,omitempty"`
+	// Secret holds the value of the "secret" field.
+	Secret string `json:"-"`
+	// CreatedAt holds the value of the "created_at" field.
+	CreatedAt time.Time `json:"created_at,omitempty"`
+	// UpdatedAt holds the value of the "updated_at" field.
+	UpdatedAt time.Time `json:"updated_at,omitempty"`
+	// Edges holds the relations/edges for other nodes in the graph.
+	// The values are being populated by the WebhookQuery when eager-loading is set.
+	Edges WebhookEdges `json:"edges"`
+}
+
+// WebhookEdges holds the relations/edges for other nodes in the graph.
+type WebhookEdges struct {
+	// Deliveries holds the value of the deliveries edge.
+	Deliveries []*WebhookDelivery
+	// loadedTypes holds the information for reporting if a
+	// type was loaded (or requested) in eager-loading or not.
+	loadedTypes [1]bool
+}
+
+// DeliveriesOrErr returns the Deliveries value or an error if the edge
+// was not loaded in eager-loading.
+func (e WebhookEdges) DeliveriesOrErr() ([]*WebhookDelivery, error) {
+	if e.loadedTypes[0] {
+		return e.Deliveries, nil
+	}
+	return nil, &NotLoadedError{edge: "deliveries"}
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*Webhook) scanValues() []interface{} {
+	return []interface{}{
+		&sql.NullInt64{},  // id
+		&sql.NullString{}, // name
+		&sql.NullString{}, // url
+		&sql.NullString{}, // tag
+		&[]byte{},         // events
+		&sql.NullString{}, // template
+		&sql.NullString{}, // secret
+		&sql.NullTime{},   // created_at
+		&sql.NullTime{},   // updated_at
+	}
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the Webhook fields.
+func (w *Webhook) assignValues(values ...interface{}) error {
+	if m, n := len(values), len(webhook.Columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	value, ok := values[0].(*sql.NullInt64)
+	if !ok {
+		return fmt.Errorf("unexpected type %T for field id", value)
+	}
+	w.ID = int(value.Int64)
+	values = values[1:]
+	if value, ok := values[0].(*sql.NullString); !ok {
+		return fmt.Errorf("unexpected type %T for field name", values[0])
+	} else if value.Valid {
+		w.Name = value.String
+	}
+	if value, ok := values[1].(*sql.NullString); !ok {
+		return fmt.Errorf("unexpected type %T for field url", values[1])
+	} else if value.Valid {
+		w.URL = value.String
+	}
+	if value, ok := values[2].(*sql.NullString); !ok {
+		return fmt.Errorf("unexpected type %T for field tag", values[2])
+	} else if value.Valid {
+		w.Tag = value.String
+	}
+
+	if value, ok := values[3].(*[]byte); !ok {
+		return fmt.Errorf("unexpected type %T for field events", values[3])
+	} else if value != nil && len(*value) > 0 {
+		if err := json.Unmarshal(*value, &w.Events); err != nil {
+			return fmt.Errorf("unmarshal field events: %v", err)
+		}
+	}
+	if value, ok := values[4].(*sql.NullString); !ok {
+		return fmt.Errorf("unexpected type %T for field template", values[4])
+	} else if value.Valid {
+		w.Template = value.String
+	}
+	if value, ok := values[5].(*sql.NullString); !ok {
+		return fmt.Errorf("unexpected type %T for field secret", values[5])
+	} else if value.Valid {
+		w.Secret = value.String
+	}
+	if value, ok := values[6].(*sql.NullTime); !ok {
+		return fmt.Errorf("unexpected type %T for field created_at", values[6])
+	} else if value.Valid {
+		w.CreatedAt = value.Time
+	}
+	if value, ok := values[7].(*sql.NullTime); !ok {
+		return fmt.Errorf("unexpected type %T for field updated_at", values[7])
+	} else if value.Valid {
+		w.UpdatedAt = value.Time
+	}
+	return nil
+}
+
+// QueryDeliveries queries the deliveries edge of the Webhook.
+func (w *Webhook) QueryDeliveries() *WebhookDeliveryQuery {
+	return (&WebhookClient{config: w.config}).QueryDeliveries(w)
+}
+
+// Update returns a builder for updating this Webhook.
+// Note that, you need to call Webhook.Unwrap() before calling this method, if this Webhook
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (w *Webhook) Update() *WebhookUpdateOne {
+	return (&WebhookClient{config: w.config}).UpdateOne(w)
+}
+
+// Unwrap unwraps the entity that was returned from a transaction after it was closed,
+// so that all next queries will be executed through the driver which created the transaction.
+func (w *Webhook) Unwrap() *Webhook {
+	tx, ok := w.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: Webhook is not a transactional entity")
+	}
+	w.config.driver = tx.drv
+	return w
+}
+
+// String implements the fmt.Stringer.
+func (w *Webhook) String() string {
+	var builder strings.Builder
+	builder.WriteString("Webhook(")
+	builder.WriteString(fmt.Sprintf("id=%v", w.ID))
+	builder.WriteString(", name=")
+	builder.WriteString(w.Name)
+	builder.WriteString(", url=")
+	builder.WriteString(w.URL)
+	builder.WriteString(", tag=")
+	builder.WriteString(w.Tag)
+	builder.WriteString(", events=")
+	builder.WriteString(fmt.Sprintf("%v", w.Events))
+	builder.WriteString(", template=")
+	builder.WriteString(w.Template)
+	builder.WriteString(", secret=<sensitive>")
+	builder.WriteString(", created_at=")
+	builder.WriteString(w.CreatedAt.Format(time.ANSIC))
+	builder.WriteString(", updated_at=")
+	builder.WriteString(w.UpdatedAt.Format(time.ANSIC))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// Webhooks is a parsable slice of Webhook.
+type Webhooks []*Webhook
+
+func (w Webhooks) config(cfg config) {
+	for _i := range w {
+		w[_i].config = cfg
+	}
+}
@@ -0,0 +1,391 @@
+// Code generated by entc, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/facebook/ent/dialect/sql/sqlgraph"
+	"github.com/facebook/ent/schema/field"
+	"github.com/gobench-io/gobench/ent/webhook"
+	"github.com/gobench-io/gobench/ent/webhookdelivery"
+)
+
+// WebhookCreate is the builder for creating a Webhook entity.
+type WebhookCreate struct {
+	config
+	mutation *WebhookMutation
+	hooks    []Hook
+}
+
+// SetName sets the name field.
+func (wc *WebhookCreate) SetName(s string) *WebhookCreate {
+	wc.mutation.SetName(s)
+	return wc
+}
+
+// SetURL sets the url field.
+func (wc *WebhookCreate) SetURL(s string) *WebhookCreate {
+	wc.mutation.SetURL(s)
+	return wc
+}
+
+// SetTag sets the tag field.
+func (wc *WebhookCreate) SetTag(s string) *WebhookCreate {
+	wc.mutation.SetTag(s)
+	return wc
+}
+
+// SetNillableTag sets the tag field if the given value is not nil.
+func (wc *WebhookCreate) SetNillableTag(s *string) *WebhookCreate {
+	if s != nil {
+		wc.SetTag(*s)
+	}
+	return wc
+}
+
+// SetEvents sets the events field.
+func (wc *WebhookCreate) SetEvents(s []string) *WebhookCreate {
+	wc.mutation.SetEvents(s)
+	return wc
+}
+
+// SetTemplate sets the template field.
+func (wc *WebhookCreate) SetTemplate(s string) *WebhookCreate {
+	wc.mutation.SetTemplate(s)
+	return wc
+}
+
+// SetNillableTemplate sets the template field if the given value is not nil.
+func (wc *WebhookCreate) SetNillableTemplate(s *string) *WebhookCreate {
+	if s != nil {
+		wc.SetTemplate(*s)
+	}
+	return wc
+}
+
+// SetSecret sets the secret field.
+func (wc *WebhookCreate) SetSecret(s string) *WebhookCreate {
+	wc.mutation.SetSecret(s)
+	return wc
+}
+
+// SetNillableSecret sets the secret field if the given value is not nil.
+func (wc *WebhookCreate) SetNillableSecret(s *string) *WebhookCreate {
+	if s != nil {
+		wc.SetSecret(*s)
+	}
+	return wc
+}
+
+// SetCreatedAt sets the created_at field.
+func (wc *WebhookCreate) SetCreatedAt(t time.Time) *WebhookCreate {
+	wc.mutation.SetCreatedAt(t)
+	return wc
+}
+
+// SetNillableCreatedAt sets the created_at field if the given value is not nil.
+func (wc *WebhookCreate) SetNillableCreatedAt(t *time.Time) *WebhookCreate {
+	if t != nil {
+		wc.SetCreatedAt(*t)
+	}
+	return wc
+}
+
+// SetUpdatedAt sets the updated_at field.
+func (wc *WebhookCreate) SetUpdatedAt(t time.Time) *WebhookCreate {
+	wc.mutation.SetUpdatedAt(t)
+	return wc
+}
+
+// SetNillableUpdatedAt sets the updated_at field if the given value is not nil.
+func (wc *WebhookCreate) SetNillableUpdatedAt(t *time.Time) *WebhookCreate {
+	if t != nil {
+		wc.SetUpdatedAt(*t)
+	}
+	return wc
+}
+
+// AddDeliveryIDs adds the deliveries edge to WebhookDelivery by ids.
+func (wc *WebhookCreate) AddDeliveryIDs(ids ...int) *WebhookCreate {
+	wc.mutation.AddDeliveryIDs(ids...)
+	return wc
+}
+
+// AddDeliveries adds the deliveries edges to WebhookDelivery.
+func (wc *WebhookCreate) AddDeliveries(w ...*WebhookDelivery) *WebhookCreate {
+	ids := make([]int, len(w))
+	for i := range w {
+		ids[i] = w[i].ID
+	}
+	return wc.AddDeliveryIDs(ids...)
+}
+
+// Mutation returns the WebhookMutation object of the builder.
+func (wc *WebhookCreate) Mutation() *WebhookMutation {
+	return wc.mutation
+}
+
+// Save creates the Webhook in the database.
+func (wc *WebhookCreate) Save(ctx context.Context) (*Webhook, error) {
+	var (
+		err  error
+		node *Webhook
+	)
+	wc.defaults()
+	if len(wc.hooks) == 0 {
+		if err = wc.check(); err != nil {
+			return nil, err
+		}
+		node, err = wc.sqlSave(ctx)
+	} else {
+		var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+			mutation, ok := m.(*WebhookMutation)
+			if !ok {
+				return nil, fmt.Errorf("unexpected mutation type %T", m)
+			}
+			if err = wc.check(); err != nil {
+				return nil, err
+			}
+			wc.mutation = mutation
+			node, err = wc.sqlSave(ctx)
+			mutation.done = true
+			return node, err
+		})
+		for i := len(wc.hooks) - 1; i >= 0; i-- {
+			mut = wc.hooks[i](mut)
+		}
+		if _, err := mut.Mutate(ctx, wc.mutation); err != nil {
+			return nil, err
+		}
+	}
+	return node, err
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (wc *WebhookCreate) SaveX(ctx context.Context) *Webhook {
+	v, err := wc.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// defaults sets the default values of the builder before save.
+func (wc *WebhookCreate) defaults() {
+	if _, ok := wc.mutation.CreatedAt(); !ok {
+		v := webhook.DefaultCreatedAt()
+		wc.mutation.SetCreatedAt(v)
+	}
+	if _, ok := wc.mutation.UpdatedAt(); !ok {
+		v := webhook.DefaultUpdatedAt()
+		wc.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (wc *WebhookCreate) check() error {
+	if _, ok := wc.mutation.Name(); !ok {
+		return &ValidationError{Name: "name", err: errors.New("ent: missing required field \"name\"")}
+	}
+	if v, ok := wc.mutation.Name(); ok {
+		if err := webhook.NameValidator(v); err != nil {
+			return &ValidationError{Name: "name", err: fmt.Errorf("ent: validator failed for field \"name\": %w", err)}
+		}
+	}
+	if _, ok := wc.mutation.URL(); !ok {
+		return &ValidationError{Name: "url", err: errors.New("ent: missing required field \"url\"")}
+	}
+	if v, ok := wc.mutation.URL(); ok {
+		if err := webhook.URLValidator(v); err != nil {
+			return &ValidationError{Name: "url", err: fmt.Errorf("ent: validator failed for field \"url\": %w", err)}
+		}
+	}
+	if _, ok := wc.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New("ent: missing required field \"created_at\"")}
+	}
+	if _, ok := wc.mutation.UpdatedAt(); !ok {
+		return &ValidationError{Name: "updated_at", err: errors.New("ent: missing required field \"updated_at\"")}
+	}
+	return nil
+}
+
+func (wc *WebhookCreate) sqlSave(ctx context.Context) (*Webhook, error) {
+	_node, _spec := wc.createSpec()
+	if err := sqlgraph.CreateNode(ctx, wc.driver, _spec); err != nil {
+		if cerr, ok := isSQLConstraintError(err); ok {
+			err = cerr
+		}
+		return nil, err
+	}
+	id := _spec.ID.Value.(int64)
+	_node.ID = int(id)
+	return _node, nil
+}
+
+func (wc *WebhookCreate) createSpec() (*Webhook, *sqlgraph.CreateSpec) {
+	var (
+		_node = &Webhook{config: wc.config}
+		_spec = &sqlgraph.CreateSpec{
+			Table: webhook.Table,
+			ID: &sqlgraph.FieldSpec{
+				Type:   field.TypeInt,
+				Column: webhook.FieldID,
+			},
+		}
+	)
+	if value, ok := wc.mutation.Name(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: webhook.FieldName,
+		})
+		_node.Name = value
+	}
+	if value, ok := wc.mutation.URL(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: webhook.FieldURL,
+		})
+		_node.URL = value
+	}
+	if value, ok := wc.mutation.Tag(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: webhook.FieldTag,
+		})
+		_node.Tag = value
+	}
+	if value, ok := wc.mutation.Events(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeJSON,
+			Value:  value,
+			Column: webhook.FieldEvents,
+		})
+		_node.Events = value
+	}
+	if value, ok := wc.mutation.Template(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: webhook.FieldTemplate,
+		})
+		_node.Template = value
+	}
+	if value, ok := wc.mutation.Secret(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: webhook.FieldSecret,
+		})
+		_node.Secret = value
+	}
+	if value, ok := wc.mutation.CreatedAt(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeTime,
+			Value:  value,
+			Column: webhook.FieldCreatedAt,
+		})
+		_node.CreatedAt = value
+	}
+	if value, ok := wc.mutation.UpdatedAt(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeTime,
+			Value:  value,
+			Column: webhook.FieldUpdatedAt,
+		})
+		_node.UpdatedAt = value
+	}
+	if nodes := wc.mutation.DeliveriesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   webhook.DeliveriesTable,
+			Columns: []string{webhook.DeliveriesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt,
+					Column: webhookdelivery.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	return _node, _spec
+}
+
+// WebhookCreateBulk is the builder for creating a bulk of Webhook entities.
+type WebhookCreateBulk struct {
+	config
+	builders []*WebhookCreate
+}
+
+// Save creates the Webhook entities in the database.
+func (wcb *WebhookCreateBulk) Save(ctx context.Context) ([]*Webhook, error) {
+	specs := make([]*sqlgraph.CreateSpec, len(wcb.builders))
+	nodes := make([]*Webhook, len(wcb.builders))
+	mutators := make([]Mutator, len(wcb.builders))
+	for i := range wcb.builders {
+		func(i int, root context.Context) {
+			builder := wcb.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*WebhookMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				nodes[i], specs[i] = builder.createSpec()
+				var err error
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, wcb.builders[i+1].mutation)
+				} else {
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, wcb.driver, &sqlgraph.BatchCreateSpec{Nodes: specs}); err != nil {
+						if cerr, ok := isSQLConstraintError(err); ok {
+							err = cerr
+						}
+					}
+				}
+				mutation.done = true
+				if err != nil {
+					return nil, err
+				}
+				id := specs[i].ID.Value.(int64)
+				nodes[i].ID = int(id)
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, wcb.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (wcb *WebhookCreateBulk) SaveX(ctx context.Context) []*Webhook {
+	v, err := wcb.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
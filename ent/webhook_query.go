@@ -0,0 +1,949 @@
+// Code generated by entc, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"database/sql/driver"
+	"errors"
+	"fmt"
+	"math"
+
+	"github.com/facebook/ent/dialect/sql"
+	"github.com/facebook/ent/dialect/sql/sqlgraph"
+	"github.com/facebook/ent/schema/field"
+	"github.com/gobench-io/gobench/ent/predicate"
+	"github.com/gobench-io/gobench/ent/webhook"
+	"github.com/gobench-io/gobench/ent/webhookdelivery"
+)
+
+// WebhookQuery is the builder for querying Webhook entities.
+type WebhookQuery struct {
+	config
+	limit      *int
+	offset     *int
+	order      []OrderFunc
+	unique     []string
+	predicates []predicate.Webhook
+	// eager-loading edges.
+	withDeliveries *WebhookDeliveryQuery
+	// intermediate query (i.e. traversal path).
+	sql  *sql.Selector
+	path func(context.Context) (*sql.Selector, error)
+}
+
+// Where adds a new predicate for the builder.
+func (wq *WebhookQuery) Where(ps ...predicate.Webhook) *WebhookQuery {
+	wq.predicates = append(wq.predicates, ps...)
+	return wq
+}
+
+// Limit adds a limit step to the query.
+func (wq *WebhookQuery) Limit(limit int) *WebhookQuery {
+	wq.limit = &limit
+	return wq
+}
+
+// Offset adds an offset step to the query.
+func (wq *WebhookQuery) Offset(offset int) *WebhookQuery {
+	wq.offset = &offset
+	return wq
+}
+
+// Order adds an order step to the query.
+func (wq *WebhookQuery) Order(o ...OrderFunc) *WebhookQuery {
+	wq.order = append(wq.order, o...)
+	return wq
+}
+
+// QueryDeliveries chains the current query on the deliveries edge.
+func (wq *WebhookQuery) QueryDeliveries() *WebhookDeliveryQuery {
+	query := &WebhookDeliveryQuery{config: wq.config}
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := wq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := wq.sqlQuery()
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(webhook.Table, webhook.FieldID, selector),
+			sqlgraph.To(webhookdelivery.Table, webhookdelivery.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, webhook.DeliveriesTable, webhook.DeliveriesColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(wq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// First returns the first Webhook entity in the query. Returns *NotFoundError when no webhook was found.
+func (wq *WebhookQuery) First(ctx context.Context) (*Webhook, error) {
+	nodes, err := wq.Limit(1).All(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nil, &NotFoundError{webhook.Label}
+	}
+	return nodes[0], nil
+}
+
+// FirstX is like First, but panics if an error occurs.
+func (wq *WebhookQuery) FirstX(ctx context.Context) *Webhook {
+	node, err := wq.First(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return node
+}
+
+// FirstID returns the first Webhook id in the query. Returns *NotFoundError when no id was found.
+func (wq *WebhookQuery) FirstID(ctx context.Context) (id int, err error) {
+	var ids []int
+	if ids, err = wq.Limit(1).IDs(ctx); err != nil {
+		return
+	}
+	if len(ids) == 0 {
+		err = &NotFoundError{webhook.Label}
+		return
+	}
+	return ids[0], nil
+}
+
+// FirstXID is like FirstID, but panics if an error occurs.
+func (wq *WebhookQuery) FirstXID(ctx context.Context) int {
+	id, err := wq.FirstID(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return id
+}
+
+// Only returns the only Webhook entity in the query, returns an error if not exactly one entity was returned.
+func (wq *WebhookQuery) Only(ctx context.Context) (*Webhook, error) {
+	nodes, err := wq.Limit(2).All(ctx)
+	if err != nil {
+		return nil, err
+	}
+	switch len(nodes) {
+	case 1:
+		return nodes[0], nil
+	case 0:
+		return nil, &NotFoundError{webhook.Label}
+	default:
+		return nil, &NotSingularError{webhook.Label}
+	}
+}
+
+// OnlyX is like Only, but panics if an error occurs.
+func (wq *WebhookQuery) OnlyX(ctx context.Context) *Webhook {
+	node, err := wq.Only(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// OnlyID returns the only Webhook id in the query, returns an error if not exactly one id was returned.
+func (wq *WebhookQuery) OnlyID(ctx context.Context) (id int, err error) {
+	var ids []int
+	if ids, err = wq.Limit(2).IDs(ctx); err != nil {
+		return
+	}
+	switch len(ids) {
+	case 1:
+		id = ids[0]
+	case 0:
+		err = &NotFoundError{webhook.Label}
+	default:
+		err = &NotSingularError{webhook.Label}
+	}
+	return
+}
+
+// OnlyIDX is like OnlyID, but panics if an error occurs.
+func (wq *WebhookQuery) OnlyIDX(ctx context.Context) int {
+	id, err := wq.OnlyID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// All executes the query and returns a list of Webhooks.
+func (wq *WebhookQuery) All(ctx context.Context) ([]*Webhook, error) {
+	if err := wq.prepareQuery(ctx); err != nil {
+		return nil, err
+	}
+	return wq.sqlAll(ctx)
+}
+
+// AllX is like All, but panics if an error occurs.
+func (wq *WebhookQuery) AllX(ctx context.Context) []*Webhook {
+	nodes, err := wq.All(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return nodes
+}
+
+// IDs executes the query and returns a list of Webhook ids.
+func (wq *WebhookQuery) IDs(ctx context.Context) ([]int, error) {
+	var ids []int
+	if err := wq.Select(webhook.FieldID).Scan(ctx, &ids); err != nil {
+		return nil, err
+	}
+	return ids, nil
+}
+
+// IDsX is like IDs, but panics if an error occurs.
+func (wq *WebhookQuery) IDsX(ctx context.Context) []int {
+	ids, err := wq.IDs(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return ids
+}
+
+// Count returns the count of the given query.
+func (wq *WebhookQuery) Count(ctx context.Context) (int, error) {
+	if err := wq.prepareQuery(ctx); err != nil {
+		return 0, err
+	}
+	return wq.sqlCount(ctx)
+}
+
+// CountX is like Count, but panics if an error occurs.
+func (wq *WebhookQuery) CountX(ctx context.Context) int {
+	count, err := wq.Count(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return count
+}
+
+// Exist returns true if the query has elements in the graph.
+func (wq *WebhookQuery) Exist(ctx context.Context) (bool, error) {
+	if err := wq.prepareQuery(ctx); err != nil {
+		return false, err
+	}
+	return wq.sqlExist(ctx)
+}
+
+// ExistX is like Exist, but panics if an error occurs.
+func (wq *WebhookQuery) ExistX(ctx context.Context) bool {
+	exist, err := wq.Exist(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return exist
+}
+
+// Clone returns a duplicate of the query builder, including all associated steps. It can be
+// used to prepare common query builders and use them differently after the clone is made.
+func (wq *WebhookQuery) Clone() *WebhookQuery {
+	return &WebhookQuery{
+		config:     wq.config,
+		limit:      wq.limit,
+		offset:     wq.offset,
+		order:      append([]OrderFunc{}, wq.order...),
+		unique:     append([]string{}, wq.unique...),
+		predicates: append([]predicate.Webhook{}, wq.predicates...),
+		// clone intermediate query.
+		sql:  wq.sql.Clone(),
+		path: wq.path,
+	}
+}
+
+//	WithDeliveries tells the query-builder to eager-loads the nodes that are connected to
+//
+// the "deliveries" edge. The optional arguments used to configure the query builder of the edge.
+func (wq *WebhookQuery) WithDeliveries(opts ...func(*WebhookDeliveryQuery)) *WebhookQuery {
+	query := &WebhookDeliveryQuery{config: wq.config}
+	for _, opt := range opts {
+		opt(query)
+	}
+	wq.withDeliveries = query
+	return wq
+}
+
+// GroupBy used to group vertices by one or more fields/columns.
+// It is often used with aggregate functions, like: count, max, mean, min, sum.
+//
+// Example:
+//
+//	var v []struct {
+//		Name string `json:"name,omitempty"`
+//		Count int `json:"count,omitempty"`
+//	}
+//
+//	client.Webhook.Query().
+//		GroupBy(webhook.FieldName).
+//		Aggregate(ent.Count()).
+//		Scan(ctx, &v)
+func (wq *WebhookQuery) GroupBy(field string, fields ...string) *WebhookGroupBy {
+	group := &WebhookGroupBy{config: wq.config}
+	group.fields = append([]string{field}, fields...)
+	group.path = func(ctx context.Context) (prev *sql.Selector, err error) {
+		if err := wq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		return wq.sqlQuery(), nil
+	}
+	return group
+}
+
+// Select one or more fields from the given query.
+//
+// Example:
+//
+//	var v []struct {
+//		Name string `json:"name,omitempty"`
+//	}
+//
+//	client.Webhook.Query().
+//		Select(webhook.FieldName).
+//		Scan(ctx, &v)
+func (wq *WebhookQuery) Select(field string, fields ...string) *WebhookSelect {
+	selector := &WebhookSelect{config: wq.config}
+	selector.fields = append([]string{field}, fields...)
+	selector.path = func(ctx context.Context) (prev *sql.Selector, err error) {
+		if err := wq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		return wq.sqlQuery(), nil
+	}
+	return selector
+}
+
+func (wq *WebhookQuery) prepareQuery(ctx context.Context) error {
+	if wq.path != nil {
+		prev, err := wq.path(ctx)
+		if err != nil {
+			return err
+		}
+		wq.sql = prev
+	}
+	return nil
+}
+
+func (wq *WebhookQuery) sqlAll(ctx context.Context) ([]*Webhook, error) {
+	var (
+		nodes       = []*Webhook{}
+		_spec       = wq.querySpec()
+		loadedTypes = [1]bool{
+			wq.withDeliveries != nil,
+		}
+	)
+	_spec.ScanValues = func() []interface{} {
+		node := &Webhook{config: wq.config}
+		nodes = append(nodes, node)
+		values := node.scanValues()
+		return values
+	}
+	_spec.Assign = func(values ...interface{}) error {
+		if len(nodes) == 0 {
+			return fmt.Errorf("ent: Assign called without calling ScanValues")
+		}
+		node := nodes[len(nodes)-1]
+		node.Edges.loadedTypes = loadedTypes
+		return node.assignValues(values...)
+	}
+	if err := sqlgraph.QueryNodes(ctx, wq.driver, _spec); err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nodes, nil
+	}
+
+	if query := wq.withDeliveries; query != nil {
+		fks := make([]driver.Value, 0, len(nodes))
+		nodeids := make(map[int]*Webhook)
+		for i := range nodes {
+			fks = append(fks, nodes[i].ID)
+			nodeids[nodes[i].ID] = nodes[i]
+		}
+		query.withFKs = true
+		query.Where(predicate.WebhookDelivery(func(s *sql.Selector) {
+			s.Where(sql.InValues(webhook.DeliveriesColumn, fks...))
+		}))
+		neighbors, err := query.All(ctx)
+		if err != nil {
+			return nil, err
+		}
+		for _, n := range neighbors {
+			fk := n.webhook_deliveries
+			if fk == nil {
+				return nil, fmt.Errorf(`foreign-key "webhook_deliveries" is nil for node %v`, n.ID)
+			}
+			node, ok := nodeids[*fk]
+			if !ok {
+				return nil, fmt.Errorf(`unexpected foreign-key "webhook_deliveries" returned %v for node %v`, *fk, n.ID)
+			}
+			node.Edges.Deliveries = append(node.Edges.Deliveries, n)
+		}
+	}
+
+	return nodes, nil
+}
+
+func (wq *WebhookQuery) sqlCount(ctx context.Context) (int, error) {
+	_spec := wq.querySpec()
+	return sqlgraph.CountNodes(ctx, wq.driver, _spec)
+}
+
+func (wq *WebhookQuery) sqlExist(ctx context.Context) (bool, error) {
+	n, err := wq.sqlCount(ctx)
+	if err != nil {
+		return false, fmt.Errorf("ent: check existence: %v", err)
+	}
+	return n > 0, nil
+}
+
+func (wq *WebhookQuery) querySpec() *sqlgraph.QuerySpec {
+	_spec := &sqlgraph.QuerySpec{
+		Node: &sqlgraph.NodeSpec{
+			Table:   webhook.Table,
+			Columns: webhook.Columns,
+			ID: &sqlgraph.FieldSpec{
+				Type:   field.TypeInt,
+				Column: webhook.FieldID,
+			},
+		},
+		From:   wq.sql,
+		Unique: true,
+	}
+	if ps := wq.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if limit := wq.limit; limit != nil {
+		_spec.Limit = *limit
+	}
+	if offset := wq.offset; offset != nil {
+		_spec.Offset = *offset
+	}
+	if ps := wq.order; len(ps) > 0 {
+		_spec.Order = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector, webhook.ValidColumn)
+			}
+		}
+	}
+	return _spec
+}
+
+func (wq *WebhookQuery) sqlQuery() *sql.Selector {
+	builder := sql.Dialect(wq.driver.Dialect())
+	t1 := builder.Table(webhook.Table)
+	selector := builder.Select(t1.Columns(webhook.Columns...)...).From(t1)
+	if wq.sql != nil {
+		selector = wq.sql
+		selector.Select(selector.Columns(webhook.Columns...)...)
+	}
+	for _, p := range wq.predicates {
+		p(selector)
+	}
+	for _, p := range wq.order {
+		p(selector, webhook.ValidColumn)
+	}
+	if offset := wq.offset; offset != nil {
+		// limit is mandatory for offset clause. We start
+		// with default value, and override it below if needed.
+		selector.Offset(*offset).Limit(math.MaxInt32)
+	}
+	if limit := wq.limit; limit != nil {
+		selector.Limit(*limit)
+	}
+	return selector
+}
+
+// WebhookGroupBy is the builder for group-by Webhook entities.
+type WebhookGroupBy struct {
+	config
+	fields []string
+	fns    []AggregateFunc
+	// intermediate query (i.e. traversal path).
+	sql  *sql.Selector
+	path func(context.Context) (*sql.Selector, error)
+}
+
+// Aggregate adds the given aggregation functions to the group-by query.
+func (wgb *WebhookGroupBy) Aggregate(fns ...AggregateFunc) *WebhookGroupBy {
+	wgb.fns = append(wgb.fns, fns...)
+	return wgb
+}
+
+// Scan applies the group-by query and scan the result into the given value.
+func (wgb *WebhookGroupBy) Scan(ctx context.Context, v interface{}) error {
+	query, err := wgb.path(ctx)
+	if err != nil {
+		return err
+	}
+	wgb.sql = query
+	return wgb.sqlScan(ctx, v)
+}
+
+// ScanX is like Scan, but panics if an error occurs.
+func (wgb *WebhookGroupBy) ScanX(ctx context.Context, v interface{}) {
+	if err := wgb.Scan(ctx, v); err != nil {
+		panic(err)
+	}
+}
+
+// Strings returns list of strings from group-by. It is only allowed when querying group-by with one field.
+func (wgb *WebhookGroupBy) Strings(ctx context.Context) ([]string, error) {
+	if len(wgb.fields) > 1 {
+		return nil, errors.New("ent: WebhookGroupBy.Strings is not achievable when grouping more than 1 field")
+	}
+	var v []string
+	if err := wgb.Scan(ctx, &v); err != nil {
+		return nil, err
+	}
+	return v, nil
+}
+
+// StringsX is like Strings, but panics if an error occurs.
+func (wgb *WebhookGroupBy) StringsX(ctx context.Context) []string {
+	v, err := wgb.Strings(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// String returns a single string from group-by. It is only allowed when querying group-by with one field.
+func (wgb *WebhookGroupBy) String(ctx context.Context) (_ string, err error) {
+	var v []string
+	if v, err = wgb.Strings(ctx); err != nil {
+		return
+	}
+	switch len(v) {
+	case 1:
+		return v[0], nil
+	case 0:
+		err = &NotFoundError{webhook.Label}
+	default:
+		err = fmt.Errorf("ent: WebhookGroupBy.Strings returned %d results when one was expected", len(v))
+	}
+	return
+}
+
+// StringX is like String, but panics if an error occurs.
+func (wgb *WebhookGroupBy) StringX(ctx context.Context) string {
+	v, err := wgb.String(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Ints returns list of ints from group-by. It is only allowed when querying group-by with one field.
+func (wgb *WebhookGroupBy) Ints(ctx context.Context) ([]int, error) {
+	if len(wgb.fields) > 1 {
+		return nil, errors.New("ent: WebhookGroupBy.Ints is not achievable when grouping more than 1 field")
+	}
+	var v []int
+	if err := wgb.Scan(ctx, &v); err != nil {
+		return nil, err
+	}
+	return v, nil
+}
+
+// IntsX is like Ints, but panics if an error occurs.
+func (wgb *WebhookGroupBy) IntsX(ctx context.Context) []int {
+	v, err := wgb.Ints(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Int returns a single int from group-by. It is only allowed when querying group-by with one field.
+func (wgb *WebhookGroupBy) Int(ctx context.Context) (_ int, err error) {
+	var v []int
+	if v, err = wgb.Ints(ctx); err != nil {
+		return
+	}
+	switch len(v) {
+	case 1:
+		return v[0], nil
+	case 0:
+		err = &NotFoundError{webhook.Label}
+	default:
+		err = fmt.Errorf("ent: WebhookGroupBy.Ints returned %d results when one was expected", len(v))
+	}
+	return
+}
+
+// IntX is like Int, but panics if an error occurs.
+func (wgb *WebhookGroupBy) IntX(ctx context.Context) int {
+	v, err := wgb.Int(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Float64s returns list of float64s from group-by. It is only allowed when querying group-by with one field.
+func (wgb *WebhookGroupBy) Float64s(ctx context.Context) ([]float64, error) {
+	if len(wgb.fields) > 1 {
+		return nil, errors.New("ent: WebhookGroupBy.Float64s is not achievable when grouping more than 1 field")
+	}
+	var v []float64
+	if err := wgb.Scan(ctx, &v); err != nil {
+		return nil, err
+	}
+	return v, nil
+}
+
+// Float64sX is like Float64s, but panics if an error occurs.
+func (wgb *WebhookGroupBy) Float64sX(ctx context.Context) []float64 {
+	v, err := wgb.Float64s(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Float64 returns a single float64 from group-by. It is only allowed when querying group-by with one field.
+func (wgb *WebhookGroupBy) Float64(ctx context.Context) (_ float64, err error) {
+	var v []float64
+	if v, err = wgb.Float64s(ctx); err != nil {
+		return
+	}
+	switch len(v) {
+	case 1:
+		return v[0], nil
+	case 0:
+		err = &NotFoundError{webhook.Label}
+	default:
+		err = fmt.Errorf("ent: WebhookGroupBy.Float64s returned %d results when one was expected", len(v))
+	}
+	return
+}
+
+// Float64X is like Float64, but panics if an error occurs.
+func (wgb *WebhookGroupBy) Float64X(ctx context.Context) float64 {
+	v, err := wgb.Float64(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Bools returns list of bools from group-by. It is only allowed when querying group-by with one field.
+func (wgb *WebhookGroupBy) Bools(ctx context.Context) ([]bool, error) {
+	if len(wgb.fields) > 1 {
+		return nil, errors.New("ent: WebhookGroupBy.Bools is not achievable when grouping more than 1 field")
+	}
+	var v []bool
+	if err := wgb.Scan(ctx, &v); err != nil {
+		return nil, err
+	}
+	return v, nil
+}
+
+// BoolsX is like Bools, but panics if an error occurs.
+func (wgb *WebhookGroupBy) BoolsX(ctx context.Context) []bool {
+	v, err := wgb.Bools(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Bool returns a single bool from group-by. It is only allowed when querying group-by with one field.
+func (wgb *WebhookGroupBy) Bool(ctx context.Context) (_ bool, err error) {
+	var v []bool
+	if v, err = wgb.Bools(ctx); err != nil {
+		return
+	}
+	switch len(v) {
+	case 1:
+		return v[0], nil
+	case 0:
+		err = &NotFoundError{webhook.Label}
+	default:
+		err = fmt.Errorf("ent: WebhookGroupBy.Bools returned %d results when one was expected", len(v))
+	}
+	return
+}
+
+// BoolX is like Bool, but panics if an error occurs.
+func (wgb *WebhookGroupBy) BoolX(ctx context.Context) bool {
+	v, err := wgb.Bool(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+func (wgb *WebhookGroupBy) sqlScan(ctx context.Context, v interface{}) error {
+	for _, f := range wgb.fields {
+		if !webhook.ValidColumn(f) {
+			return &ValidationError{Name: f, err: fmt.Errorf("invalid field %q for group-by", f)}
+		}
+	}
+	selector := wgb.sqlQuery()
+	if err := selector.Err(); err != nil {
+		return err
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := wgb.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
+
+func (wgb *WebhookGroupBy) sqlQuery() *sql.Selector {
+	selector := wgb.sql
+	columns := make([]string, 0, len(wgb.fields)+len(wgb.fns))
+	columns = append(columns, wgb.fields...)
+	for _, fn := range wgb.fns {
+		columns = append(columns, fn(selector, webhook.ValidColumn))
+	}
+	return selector.Select(columns...).GroupBy(wgb.fields...)
+}
+
+// WebhookSelect is the builder for select fields of Webhook entities.
+type WebhookSelect struct {
+	config
+	fields []string
+	// intermediate query (i.e. traversal path).
+	sql  *sql.Selector
+	path func(context.Context) (*sql.Selector, error)
+}
+
+// Scan applies the selector query and scan the result into the given value.
+func (ws *WebhookSelect) Scan(ctx context.Context, v interface{}) error {
+	query, err := ws.path(ctx)
+	if err != nil {
+		return err
+	}
+	ws.sql = query
+	return ws.sqlScan(ctx, v)
+}
+
+// ScanX is like Scan, but panics if an error occurs.
+func (ws *WebhookSelect) ScanX(ctx context.Context, v interface{}) {
+	if err := ws.Scan(ctx, v); err != nil {
+		panic(err)
+	}
+}
+
+// Strings returns list of strings from selector. It is only allowed when selecting one field.
+func (ws *WebhookSelect) Strings(ctx context.Context) ([]string, error) {
+	if len(ws.fields) > 1 {
+		return nil, errors.New("ent: WebhookSelect.Strings is not achievable when selecting more than 1 field")
+	}
+	var v []string
+	if err := ws.Scan(ctx, &v); err != nil {
+		return nil, err
+	}
+	return v, nil
+}
+
+// StringsX is like Strings, but panics if an error occurs.
+func (ws *WebhookSelect) StringsX(ctx context.Context) []string {
+	v, err := ws.Strings(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// String returns a single string from selector. It is only allowed when selecting one field.
+func (ws *WebhookSelect) String(ctx context.Context) (_ string, err error) {
+	var v []string
+	if v, err = ws.Strings(ctx); err != nil {
+		return
+	}
+	switch len(v) {
+	case 1:
+		return v[0], nil
+	case 0:
+		err = &NotFoundError{webhook.Label}
+	default:
+		err = fmt.Errorf("ent: WebhookSelect.Strings returned %d results when one was expected", len(v))
+	}
+	return
+}
+
+// StringX is like String, but panics if an error occurs.
+func (ws *WebhookSelect) StringX(ctx context.Context) string {
+	v, err := ws.String(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Ints returns list of ints from selector. It is only allowed when selecting one field.
+func (ws *WebhookSelect) Ints(ctx context.Context) ([]int, error) {
+	if len(ws.fields) > 1 {
+		return nil, errors.New("ent: WebhookSelect.Ints is not achievable when selecting more than 1 field")
+	}
+	var v []int
+	if err := ws.Scan(ctx, &v); err != nil {
+		return nil, err
+	}
+	return v, nil
+}
+
+// IntsX is like Ints, but panics if an error occurs.
+func (ws *WebhookSelect) IntsX(ctx context.Context) []int {
+	v, err := ws.Ints(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Int returns a single int from selector. It is only allowed when selecting one field.
+func (ws *WebhookSelect) Int(ctx context.Context) (_ int, err error) {
+	var v []int
+	if v, err = ws.Ints(ctx); err != nil {
+		return
+	}
+	switch len(v) {
+	case 1:
+		return v[0], nil
+	case 0:
+		err = &NotFoundError{webhook.Label}
+	default:
+		err = fmt.Errorf("ent: WebhookSelect.Ints returned %d results when one was expected", len(v))
+	}
+	return
+}
+
+// IntX is like Int, but panics if an error occurs.
+func (ws *WebhookSelect) IntX(ctx context.Context) int {
+	v, err := ws.Int(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Float64s returns list of float64s from selector. It is only allowed when selecting one field.
+func (ws *WebhookSelect) Float64s(ctx context.Context) ([]float64, error) {
+	if len(ws.fields) > 1 {
+		return nil, errors.New("ent: WebhookSelect.Float64s is not achievable when selecting more than 1 field")
+	}
+	var v []float64
+	if err := ws.Scan(ctx, &v); err != nil {
+		return nil, err
+	}
+	return v, nil
+}
+
+// Float64sX is like Float64s, but panics if an error occurs.
+func (ws *WebhookSelect) Float64sX(ctx context.Context) []float64 {
+	v, err := ws.Float64s(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Float64 returns a single float64 from selector. It is only allowed when selecting one field.
+func (ws *WebhookSelect) Float64(ctx context.Context) (_ float64, err error) {
+	var v []float64
+	if v, err = ws.Float64s(ctx); err != nil {
+		return
+	}
+	switch len(v) {
+	case 1:
+		return v[0], nil
+	case 0:
+		err = &NotFoundError{webhook.Label}
+	default:
+		err = fmt.Errorf("ent: WebhookSelect.Float64s returned %d results when one was expected", len(v))
+	}
+	return
+}
+
+// Float64X is like Float64, but panics if an error occurs.
+func (ws *WebhookSelect) Float64X(ctx context.Context) float64 {
+	v, err := ws.Float64(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Bools returns list of bools from selector. It is only allowed when selecting one field.
+func (ws *WebhookSelect) Bools(ctx context.Context) ([]bool, error) {
+	if len(ws.fields) > 1 {
+		return nil, errors.New("ent: WebhookSelect.Bools is not achievable when selecting more than 1 field")
+	}
+	var v []bool
+	if err := ws.Scan(ctx, &v); err != nil {
+		return nil, err
+	}
+	return v, nil
+}
+
+// BoolsX is like Bools, but panics if an error occurs.
+func (ws *WebhookSelect) BoolsX(ctx context.Context) []bool {
+	v, err := ws.Bools(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Bool returns a single bool from selector. It is only allowed when selecting one field.
+func (ws *WebhookSelect) Bool(ctx context.Context) (_ bool, err error) {
+	var v []bool
+	if v, err = ws.Bools(ctx); err != nil {
+		return
+	}
+	switch len(v) {
+	case 1:
+		return v[0], nil
+	case 0:
+		err = &NotFoundError{webhook.Label}
+	default:
+		err = fmt.Errorf("ent: WebhookSelect.Bools returned %d results when one was expected", len(v))
+	}
+	return
+}
+
+// BoolX is like Bool, but panics if an error occurs.
+func (ws *WebhookSelect) BoolX(ctx context.Context) bool {
+	v, err := ws.Bool(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+func (ws *WebhookSelect) sqlScan(ctx context.Context, v interface{}) error {
+	for _, f := range ws.fields {
+		if !webhook.ValidColumn(f) {
+			return &ValidationError{Name: f, err: fmt.Errorf("invalid field %q for selection", f)}
+		}
+	}
+	rows := &sql.Rows{}
+	query, args := ws.sqlQuery().Query()
+	if err := ws.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
+
+func (ws *WebhookSelect) sqlQuery() sql.Querier {
+	selector := ws.sql
+	selector.Select(selector.Columns(ws.fields...)...)
+	return selector
+}
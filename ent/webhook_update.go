@@ -0,0 +1,816 @@
+// Code generated by entc, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/facebook/ent/dialect/sql"
+	"github.com/facebook/ent/dialect/sql/sqlgraph"
+	"github.com/facebook/ent/schema/field"
+	"github.com/gobench-io/gobench/ent/predicate"
+	"github.com/gobench-io/gobench/ent/webhook"
+	"github.com/gobench-io/gobench/ent/webhookdelivery"
+)
+
+// WebhookUpdate is the builder for updating Webhook entities.
+type WebhookUpdate struct {
+	config
+	hooks      []Hook
+	mutation   *WebhookMutation
+	predicates []predicate.Webhook
+}
+
+// Where adds a new predicate for the builder.
+func (wu *WebhookUpdate) Where(ps ...predicate.Webhook) *WebhookUpdate {
+	wu.predicates = append(wu.predicates, ps...)
+	return wu
+}
+
+// SetName sets the name field.
+func (wu *WebhookUpdate) SetName(s string) *WebhookUpdate {
+	wu.mutation.SetName(s)
+	return wu
+}
+
+// SetURL sets the url field.
+func (wu *WebhookUpdate) SetURL(s string) *WebhookUpdate {
+	wu.mutation.SetURL(s)
+	return wu
+}
+
+// SetTag sets the tag field.
+func (wu *WebhookUpdate) SetTag(s string) *WebhookUpdate {
+	wu.mutation.SetTag(s)
+	return wu
+}
+
+// SetNillableTag sets the tag field if the given value is not nil.
+func (wu *WebhookUpdate) SetNillableTag(s *string) *WebhookUpdate {
+	if s != nil {
+		wu.SetTag(*s)
+	}
+	return wu
+}
+
+// ClearTag clears the value of tag.
+func (wu *WebhookUpdate) ClearTag() *WebhookUpdate {
+	wu.mutation.ClearTag()
+	return wu
+}
+
+// SetEvents sets the events field.
+func (wu *WebhookUpdate) SetEvents(s []string) *WebhookUpdate {
+	wu.mutation.SetEvents(s)
+	return wu
+}
+
+// ClearEvents clears the value of events.
+func (wu *WebhookUpdate) ClearEvents() *WebhookUpdate {
+	wu.mutation.ClearEvents()
+	return wu
+}
+
+// SetTemplate sets the template field.
+func (wu *WebhookUpdate) SetTemplate(s string) *WebhookUpdate {
+	wu.mutation.SetTemplate(s)
+	return wu
+}
+
+// SetNillableTemplate sets the template field if the given value is not nil.
+func (wu *WebhookUpdate) SetNillableTemplate(s *string) *WebhookUpdate {
+	if s != nil {
+		wu.SetTemplate(*s)
+	}
+	return wu
+}
+
+// ClearTemplate clears the value of template.
+func (wu *WebhookUpdate) ClearTemplate() *WebhookUpdate {
+	wu.mutation.ClearTemplate()
+	return wu
+}
+
+// SetSecret sets the secret field.
+func (wu *WebhookUpdate) SetSecret(s string) *WebhookUpdate {
+	wu.mutation.SetSecret(s)
+	return wu
+}
+
+// SetNillableSecret sets the secret field if the given value is not nil.
+func (wu *WebhookUpdate) SetNillableSecret(s *string) *WebhookUpdate {
+	if s != nil {
+		wu.SetSecret(*s)
+	}
+	return wu
+}
+
+// ClearSecret clears the value of secret.
+func (wu *WebhookUpdate) ClearSecret() *WebhookUpdate {
+	wu.mutation.ClearSecret()
+	return wu
+}
+
+// SetCreatedAt sets the created_at field.
+func (wu *WebhookUpdate) SetCreatedAt(t time.Time) *WebhookUpdate {
+	wu.mutation.SetCreatedAt(t)
+	return wu
+}
+
+// SetNillableCreatedAt sets the created_at field if the given value is not nil.
+func (wu *WebhookUpdate) SetNillableCreatedAt(t *time.Time) *WebhookUpdate {
+	if t != nil {
+		wu.SetCreatedAt(*t)
+	}
+	return wu
+}
+
+// SetUpdatedAt sets the updated_at field.
+func (wu *WebhookUpdate) SetUpdatedAt(t time.Time) *WebhookUpdate {
+	wu.mutation.SetUpdatedAt(t)
+	return wu
+}
+
+// AddDeliveryIDs adds the deliveries edge to WebhookDelivery by ids.
+func (wu *WebhookUpdate) AddDeliveryIDs(ids ...int) *WebhookUpdate {
+	wu.mutation.AddDeliveryIDs(ids...)
+	return wu
+}
+
+// AddDeliveries adds the deliveries edges to WebhookDelivery.
+func (wu *WebhookUpdate) AddDeliveries(w ...*WebhookDelivery) *WebhookUpdate {
+	ids := make([]int, len(w))
+	for i := range w {
+		ids[i] = w[i].ID
+	}
+	return wu.AddDeliveryIDs(ids...)
+}
+
+// Mutation returns the WebhookMutation object of the builder.
+func (wu *WebhookUpdate) Mutation() *WebhookMutation {
+	return wu.mutation
+}
+
+// ClearDeliveries clears all "deliveries" edges to type WebhookDelivery.
+func (wu *WebhookUpdate) ClearDeliveries() *WebhookUpdate {
+	wu.mutation.ClearDeliveries()
+	return wu
+}
+
+// RemoveDeliveryIDs removes the deliveries edge to WebhookDelivery by ids.
+func (wu *WebhookUpdate) RemoveDeliveryIDs(ids ...int) *WebhookUpdate {
+	wu.mutation.RemoveDeliveryIDs(ids...)
+	return wu
+}
+
+// RemoveDeliveries removes deliveries edges to WebhookDelivery.
+func (wu *WebhookUpdate) RemoveDeliveries(w ...*WebhookDelivery) *WebhookUpdate {
+	ids := make([]int, len(w))
+	for i := range w {
+		ids[i] = w[i].ID
+	}
+	return wu.RemoveDeliveryIDs(ids...)
+}
+
+// Save executes the query and returns the number of rows/vertices matched by this operation.
+func (wu *WebhookUpdate) Save(ctx context.Context) (int, error) {
+	var (
+		err      error
+		affected int
+	)
+	wu.defaults()
+	if len(wu.hooks) == 0 {
+		if err = wu.check(); err != nil {
+			return 0, err
+		}
+		affected, err = wu.sqlSave(ctx)
+	} else {
+		var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+			mutation, ok := m.(*WebhookMutation)
+			if !ok {
+				return nil, fmt.Errorf("unexpected mutation type %T", m)
+			}
+			if err = wu.check(); err != nil {
+				return 0, err
+			}
+			wu.mutation = mutation
+			affected, err = wu.sqlSave(ctx)
+			mutation.done = true
+			return affected, err
+		})
+		for i := len(wu.hooks) - 1; i >= 0; i-- {
+			mut = wu.hooks[i](mut)
+		}
+		if _, err := mut.Mutate(ctx, wu.mutation); err != nil {
+			return 0, err
+		}
+	}
+	return affected, err
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (wu *WebhookUpdate) SaveX(ctx context.Context) int {
+	affected, err := wu.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (wu *WebhookUpdate) Exec(ctx context.Context) error {
+	_, err := wu.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (wu *WebhookUpdate) ExecX(ctx context.Context) {
+	if err := wu.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (wu *WebhookUpdate) defaults() {
+	if _, ok := wu.mutation.UpdatedAt(); !ok {
+		v := webhook.UpdateDefaultUpdatedAt()
+		wu.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (wu *WebhookUpdate) check() error {
+	if v, ok := wu.mutation.Name(); ok {
+		if err := webhook.NameValidator(v); err != nil {
+			return &ValidationError{Name: "name", err: fmt.Errorf("ent: validator failed for field \"name\": %w", err)}
+		}
+	}
+	if v, ok := wu.mutation.URL(); ok {
+		if err := webhook.URLValidator(v); err != nil {
+			return &ValidationError{Name: "url", err: fmt.Errorf("ent: validator failed for field \"url\": %w", err)}
+		}
+	}
+	return nil
+}
+
+func (wu *WebhookUpdate) sqlSave(ctx context.Context) (n int, err error) {
+	_spec := &sqlgraph.UpdateSpec{
+		Node: &sqlgraph.NodeSpec{
+			Table:   webhook.Table,
+			Columns: webhook.Columns,
+			ID: &sqlgraph.FieldSpec{
+				Type:   field.TypeInt,
+				Column: webhook.FieldID,
+			},
+		},
+	}
+	if ps := wu.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := wu.mutation.Name(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: webhook.FieldName,
+		})
+	}
+	if value, ok := wu.mutation.URL(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: webhook.FieldURL,
+		})
+	}
+	if value, ok := wu.mutation.Tag(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: webhook.FieldTag,
+		})
+	}
+	if wu.mutation.TagCleared() {
+		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Column: webhook.FieldTag,
+		})
+	}
+	if value, ok := wu.mutation.Events(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeJSON,
+			Value:  value,
+			Column: webhook.FieldEvents,
+		})
+	}
+	if wu.mutation.EventsCleared() {
+		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
+			Type:   field.TypeJSON,
+			Column: webhook.FieldEvents,
+		})
+	}
+	if value, ok := wu.mutation.Template(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: webhook.FieldTemplate,
+		})
+	}
+	if wu.mutation.TemplateCleared() {
+		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Column: webhook.FieldTemplate,
+		})
+	}
+	if value, ok := wu.mutation.Secret(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: webhook.FieldSecret,
+		})
+	}
+	if wu.mutation.SecretCleared() {
+		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Column: webhook.FieldSecret,
+		})
+	}
+	if value, ok := wu.mutation.CreatedAt(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeTime,
+			Value:  value,
+			Column: webhook.FieldCreatedAt,
+		})
+	}
+	if value, ok := wu.mutation.UpdatedAt(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeTime,
+			Value:  value,
+			Column: webhook.FieldUpdatedAt,
+		})
+	}
+	if wu.mutation.DeliveriesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   webhook.DeliveriesTable,
+			Columns: []string{webhook.DeliveriesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt,
+					Column: webhookdelivery.FieldID,
+				},
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := wu.mutation.RemovedDeliveriesIDs(); len(nodes) > 0 && !wu.mutation.DeliveriesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   webhook.DeliveriesTable,
+			Columns: []string{webhook.DeliveriesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt,
+					Column: webhookdelivery.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := wu.mutation.DeliveriesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   webhook.DeliveriesTable,
+			Columns: []string{webhook.DeliveriesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt,
+					Column: webhookdelivery.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if n, err = sqlgraph.UpdateNodes(ctx, wu.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{webhook.Label}
+		} else if cerr, ok := isSQLConstraintError(err); ok {
+			err = cerr
+		}
+		return 0, err
+	}
+	return n, nil
+}
+
+// WebhookUpdateOne is the builder for updating a single Webhook entity.
+type WebhookUpdateOne struct {
+	config
+	hooks    []Hook
+	mutation *WebhookMutation
+}
+
+// SetName sets the name field.
+func (wuo *WebhookUpdateOne) SetName(s string) *WebhookUpdateOne {
+	wuo.mutation.SetName(s)
+	return wuo
+}
+
+// SetURL sets the url field.
+func (wuo *WebhookUpdateOne) SetURL(s string) *WebhookUpdateOne {
+	wuo.mutation.SetURL(s)
+	return wuo
+}
+
+// SetTag sets the tag field.
+func (wuo *WebhookUpdateOne) SetTag(s string) *WebhookUpdateOne {
+	wuo.mutation.SetTag(s)
+	return wuo
+}
+
+// SetNillableTag sets the tag field if the given value is not nil.
+func (wuo *WebhookUpdateOne) SetNillableTag(s *string) *WebhookUpdateOne {
+	if s != nil {
+		wuo.SetTag(*s)
+	}
+	return wuo
+}
+
+// ClearTag clears the value of tag.
+func (wuo *WebhookUpdateOne) ClearTag() *WebhookUpdateOne {
+	wuo.mutation.ClearTag()
+	return wuo
+}
+
+// SetEvents sets the events field.
+func (wuo *WebhookUpdateOne) SetEvents(s []string) *WebhookUpdateOne {
+	wuo.mutation.SetEvents(s)
+	return wuo
+}
+
+// ClearEvents clears the value of events.
+func (wuo *WebhookUpdateOne) ClearEvents() *WebhookUpdateOne {
+	wuo.mutation.ClearEvents()
+	return wuo
+}
+
+// SetTemplate sets the template field.
+func (wuo *WebhookUpdateOne) SetTemplate(s string) *WebhookUpdateOne {
+	wuo.mutation.SetTemplate(s)
+	return wuo
+}
+
+// SetNillableTemplate sets the template field if the given value is not nil.
+func (wuo *WebhookUpdateOne) SetNillableTemplate(s *string) *WebhookUpdateOne {
+	if s != nil {
+		wuo.SetTemplate(*s)
+	}
+	return wuo
+}
+
+// ClearTemplate clears the value of template.
+func (wuo *WebhookUpdateOne) ClearTemplate() *WebhookUpdateOne {
+	wuo.mutation.ClearTemplate()
+	return wuo
+}
+
+// SetSecret sets the secret field.
+func (wuo *WebhookUpdateOne) SetSecret(s string) *WebhookUpdateOne {
+	wuo.mutation.SetSecret(s)
+	return wuo
+}
+
+// SetNillableSecret sets the secret field if the given value is not nil.
+func (wuo *WebhookUpdateOne) SetNillableSecret(s *string) *WebhookUpdateOne {
+	if s != nil {
+		wuo.SetSecret(*s)
+	}
+	return wuo
+}
+
+// ClearSecret clears the value of secret.
+func (wuo *WebhookUpdateOne) ClearSecret() *WebhookUpdateOne {
+	wuo.mutation.ClearSecret()
+	return wuo
+}
+
+// SetCreatedAt sets the created_at field.
+func (wuo *WebhookUpdateOne) SetCreatedAt(t time.Time) *WebhookUpdateOne {
+	wuo.mutation.SetCreatedAt(t)
+	return wuo
+}
+
+// SetNillableCreatedAt sets the created_at field if the given value is not nil.
+func (wuo *WebhookUpdateOne) SetNillableCreatedAt(t *time.Time) *WebhookUpdateOne {
+	if t != nil {
+		wuo.SetCreatedAt(*t)
+	}
+	return wuo
+}
+
+// SetUpdatedAt sets the updated_at field.
+func (wuo *WebhookUpdateOne) SetUpdatedAt(t time.Time) *WebhookUpdateOne {
+	wuo.mutation.SetUpdatedAt(t)
+	return wuo
+}
+
+// AddDeliveryIDs adds the deliveries edge to WebhookDelivery by ids.
+func (wuo *WebhookUpdateOne) AddDeliveryIDs(ids ...int) *WebhookUpdateOne {
+	wuo.mutation.AddDeliveryIDs(ids...)
+	return wuo
+}
+
+// AddDeliveries adds the deliveries edges to WebhookDelivery.
+func (wuo *WebhookUpdateOne) AddDeliveries(w ...*WebhookDelivery) *WebhookUpdateOne {
+	ids := make([]int, len(w))
+	for i := range w {
+		ids[i] = w[i].ID
+	}
+	return wuo.AddDeliveryIDs(ids...)
+}
+
+// Mutation returns the WebhookMutation object of the builder.
+func (wuo *WebhookUpdateOne) Mutation() *WebhookMutation {
+	return wuo.mutation
+}
+
+// ClearDeliveries clears all "deliveries" edges to type WebhookDelivery.
+func (wuo *WebhookUpdateOne) ClearDeliveries() *WebhookUpdateOne {
+	wuo.mutation.ClearDeliveries()
+	return wuo
+}
+
+// RemoveDeliveryIDs removes the deliveries edge to WebhookDelivery by ids.
+func (wuo *WebhookUpdateOne) RemoveDeliveryIDs(ids ...int) *WebhookUpdateOne {
+	wuo.mutation.RemoveDeliveryIDs(ids...)
+	return wuo
+}
+
+// RemoveDeliveries removes deliveries edges to WebhookDelivery.
+func (wuo *WebhookUpdateOne) RemoveDeliveries(w ...*WebhookDelivery) *WebhookUpdateOne {
+	ids := make([]int, len(w))
+	for i := range w {
+		ids[i] = w[i].ID
+	}
+	return wuo.RemoveDeliveryIDs(ids...)
+}
+
+// Save executes the query and returns the updated entity.
+func (wuo *WebhookUpdateOne) Save(ctx context.Context) (*Webhook, error) {
+	var (
+		err  error
+		node *Webhook
+	)
+	wuo.defaults()
+	if len(wuo.hooks) == 0 {
+		if err = wuo.check(); err != nil {
+			return nil, err
+		}
+		node, err = wuo.sqlSave(ctx)
+	} else {
+		var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+			mutation, ok := m.(*WebhookMutation)
+			if !ok {
+				return nil, fmt.Errorf("unexpected mutation type %T", m)
+			}
+			if err = wuo.check(); err != nil {
+				return nil, err
+			}
+			wuo.mutation = mutation
+			node, err = wuo.sqlSave(ctx)
+			mutation.done = true
+			return node, err
+		})
+		for i := len(wuo.hooks) - 1; i >= 0; i-- {
+			mut = wuo.hooks[i](mut)
+		}
+		if _, err := mut.Mutate(ctx, wuo.mutation); err != nil {
+			return nil, err
+		}
+	}
+	return node, err
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (wuo *WebhookUpdateOne) SaveX(ctx context.Context) *Webhook {
+	node, err := wuo.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (wuo *WebhookUpdateOne) Exec(ctx context.Context) error {
+	_, err := wuo.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (wuo *WebhookUpdateOne) ExecX(ctx context.Context) {
+	if err := wuo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (wuo *WebhookUpdateOne) defaults() {
+	if _, ok := wuo.mutation.UpdatedAt(); !ok {
+		v := webhook.UpdateDefaultUpdatedAt()
+		wuo.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (wuo *WebhookUpdateOne) check() error {
+	if v, ok := wuo.mutation.Name(); ok {
+		if err := webhook.NameValidator(v); err != nil {
+			return &ValidationError{Name: "name", err: fmt.Errorf("ent: validator failed for field \"name\": %w", err)}
+		}
+	}
+	if v, ok := wuo.mutation.URL(); ok {
+		if err := webhook.URLValidator(v); err != nil {
+			return &ValidationError{Name: "url", err: fmt.Errorf("ent: validator failed for field \"url\": %w", err)}
+		}
+	}
+	return nil
+}
+
+func (wuo *WebhookUpdateOne) sqlSave(ctx context.Context) (_node *Webhook, err error) {
+	_spec := &sqlgraph.UpdateSpec{
+		Node: &sqlgraph.NodeSpec{
+			Table:   webhook.Table,
+			Columns: webhook.Columns,
+			ID: &sqlgraph.FieldSpec{
+				Type:   field.TypeInt,
+				Column: webhook.FieldID,
+			},
+		},
+	}
+	id, ok := wuo.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "ID", err: fmt.Errorf("missing Webhook.ID for update")}
+	}
+	_spec.Node.ID.Value = id
+	if value, ok := wuo.mutation.Name(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: webhook.FieldName,
+		})
+	}
+	if value, ok := wuo.mutation.URL(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: webhook.FieldURL,
+		})
+	}
+	if value, ok := wuo.mutation.Tag(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: webhook.FieldTag,
+		})
+	}
+	if wuo.mutation.TagCleared() {
+		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Column: webhook.FieldTag,
+		})
+	}
+	if value, ok := wuo.mutation.Events(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeJSON,
+			Value:  value,
+			Column: webhook.FieldEvents,
+		})
+	}
+	if wuo.mutation.EventsCleared() {
+		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
+			Type:   field.TypeJSON,
+			Column: webhook.FieldEvents,
+		})
+	}
+	if value, ok := wuo.mutation.Template(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: webhook.FieldTemplate,
+		})
+	}
+	if wuo.mutation.TemplateCleared() {
+		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Column: webhook.FieldTemplate,
+		})
+	}
+	if value, ok := wuo.mutation.Secret(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: webhook.FieldSecret,
+		})
+	}
+	if wuo.mutation.SecretCleared() {
+		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Column: webhook.FieldSecret,
+		})
+	}
+	if value, ok := wuo.mutation.CreatedAt(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeTime,
+			Value:  value,
+			Column: webhook.FieldCreatedAt,
+		})
+	}
+	if value, ok := wuo.mutation.UpdatedAt(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeTime,
+			Value:  value,
+			Column: webhook.FieldUpdatedAt,
+		})
+	}
+	if wuo.mutation.DeliveriesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   webhook.DeliveriesTable,
+			Columns: []string{webhook.DeliveriesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt,
+					Column: webhookdelivery.FieldID,
+				},
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := wuo.mutation.RemovedDeliveriesIDs(); len(nodes) > 0 && !wuo.mutation.DeliveriesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   webhook.DeliveriesTable,
+			Columns: []string{webhook.DeliveriesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt,
+					Column: webhookdelivery.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := wuo.mutation.DeliveriesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   webhook.DeliveriesTable,
+			Columns: []string{webhook.DeliveriesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt,
+					Column: webhookdelivery.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_node = &Webhook{config: wuo.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues()
+	if err = sqlgraph.UpdateNode(ctx, wuo.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{webhook.Label}
+		} else if cerr, ok := isSQLConstraintError(err); ok {
+			err = cerr
+		}
+		return nil, err
+	}
+	return _node, nil
+}
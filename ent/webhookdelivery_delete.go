@@ -0,0 +1,109 @@
+// Code generated by entc, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/facebook/ent/dialect/sql"
+	"github.com/facebook/ent/dialect/sql/sqlgraph"
+	"github.com/facebook/ent/schema/field"
+	"github.com/gobench-io/gobench/ent/predicate"
+	"github.com/gobench-io/gobench/ent/webhookdelivery"
+)
+
+// WebhookDeliveryDelete is the builder for deleting a WebhookDelivery entity.
+type WebhookDeliveryDelete struct {
+	config
+	hooks      []Hook
+	mutation   *WebhookDeliveryMutation
+	predicates []predicate.WebhookDelivery
+}
+
+// Where adds a new predicate to the delete builder.
+func (wdd *WebhookDeliveryDelete) Where(ps ...predicate.WebhookDelivery) *WebhookDeliveryDelete {
+	wdd.predicates = append(wdd.predicates, ps...)
+	return wdd
+}
+
+// Exec executes the deletion query and returns how many vertices were deleted.
+func (wdd *WebhookDeliveryDelete) Exec(ctx context.Context) (int, error) {
+	var (
+		err      error
+		affected int
+	)
+	if len(wdd.hooks) == 0 {
+		affected, err = wdd.sqlExec(ctx)
+	} else {
+		var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+			mutation, ok := m.(*WebhookDeliveryMutation)
+			if !ok {
+				return nil, fmt.Errorf("unexpected mutation type %T", m)
+			}
+			wdd.mutation = mutation
+			affected, err = wdd.sqlExec(ctx)
+			mutation.done = true
+			return affected, err
+		})
+		for i := len(wdd.hooks) - 1; i >= 0; i-- {
+			mut = wdd.hooks[i](mut)
+		}
+		if _, err := mut.Mutate(ctx, wdd.mutation); err != nil {
+			return 0, err
+		}
+	}
+	return affected, err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (wdd *WebhookDeliveryDelete) ExecX(ctx context.Context) int {
+	n, err := wdd.Exec(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return n
+}
+
+func (wdd *WebhookDeliveryDelete) sqlExec(ctx context.Context) (int, error) {
+	_spec := &sqlgraph.DeleteSpec{
+		Node: &sqlgraph.NodeSpec{
+			Table: webhookdelivery.Table,
+			ID: &sqlgraph.FieldSpec{
+				Type:   field.TypeInt,
+				Column: webhookdelivery.FieldID,
+			},
+		},
+	}
+	if ps := wdd.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	return sqlgraph.DeleteNodes(ctx, wdd.driver, _spec)
+}
+
+// WebhookDeliveryDeleteOne is the builder for deleting a single WebhookDelivery entity.
+type WebhookDeliveryDeleteOne struct {
+	wdd *WebhookDeliveryDelete
+}
+
+// Exec executes the deletion query.
+func (wddo *WebhookDeliveryDeleteOne) Exec(ctx context.Context) error {
+	n, err := wddo.wdd.Exec(ctx)
+	switch {
+	case err != nil:
+		return err
+	case n == 0:
+		return &NotFoundError{webhookdelivery.Label}
+	default:
+		return nil
+	}
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (wddo *WebhookDeliveryDeleteOne) ExecX(ctx context.Context) {
+	wddo.wdd.ExecX(ctx)
+}
@@ -0,0 +1,956 @@
+// Code generated by entc, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math"
+
+	"github.com/facebook/ent/dialect/sql"
+	"github.com/facebook/ent/dialect/sql/sqlgraph"
+	"github.com/facebook/ent/schema/field"
+	"github.com/gobench-io/gobench/ent/predicate"
+	"github.com/gobench-io/gobench/ent/webhook"
+	"github.com/gobench-io/gobench/ent/webhookdelivery"
+)
+
+// WebhookDeliveryQuery is the builder for querying WebhookDelivery entities.
+type WebhookDeliveryQuery struct {
+	config
+	limit      *int
+	offset     *int
+	order      []OrderFunc
+	unique     []string
+	predicates []predicate.WebhookDelivery
+	// eager-loading edges.
+	withWebhook *WebhookQuery
+	withFKs     bool
+	// intermediate query (i.e. traversal path).
+	sql  *sql.Selector
+	path func(context.Context) (*sql.Selector, error)
+}
+
+// Where adds a new predicate for the builder.
+func (wdq *WebhookDeliveryQuery) Where(ps ...predicate.WebhookDelivery) *WebhookDeliveryQuery {
+	wdq.predicates = append(wdq.predicates, ps...)
+	return wdq
+}
+
+// Limit adds a limit step to the query.
+func (wdq *WebhookDeliveryQuery) Limit(limit int) *WebhookDeliveryQuery {
+	wdq.limit = &limit
+	return wdq
+}
+
+// Offset adds an offset step to the query.
+func (wdq *WebhookDeliveryQuery) Offset(offset int) *WebhookDeliveryQuery {
+	wdq.offset = &offset
+	return wdq
+}
+
+// Order adds an order step to the query.
+func (wdq *WebhookDeliveryQuery) Order(o ...OrderFunc) *WebhookDeliveryQuery {
+	wdq.order = append(wdq.order, o...)
+	return wdq
+}
+
+// QueryWebhook chains the current query on the webhook edge.
+func (wdq *WebhookDeliveryQuery) QueryWebhook() *WebhookQuery {
+	query := &WebhookQuery{config: wdq.config}
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := wdq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := wdq.sqlQuery()
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(webhookdelivery.Table, webhookdelivery.FieldID, selector),
+			sqlgraph.To(webhook.Table, webhook.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, webhookdelivery.WebhookTable, webhookdelivery.WebhookColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(wdq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// First returns the first WebhookDelivery entity in the query. Returns *NotFoundError when no webhookdelivery was found.
+func (wdq *WebhookDeliveryQuery) First(ctx context.Context) (*WebhookDelivery, error) {
+	nodes, err := wdq.Limit(1).All(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nil, &NotFoundError{webhookdelivery.Label}
+	}
+	return nodes[0], nil
+}
+
+// FirstX is like First, but panics if an error occurs.
+func (wdq *WebhookDeliveryQuery) FirstX(ctx context.Context) *WebhookDelivery {
+	node, err := wdq.First(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return node
+}
+
+// FirstID returns the first WebhookDelivery id in the query. Returns *NotFoundError when no id was found.
+func (wdq *WebhookDeliveryQuery) FirstID(ctx context.Context) (id int, err error) {
+	var ids []int
+	if ids, err = wdq.Limit(1).IDs(ctx); err != nil {
+		return
+	}
+	if len(ids) == 0 {
+		err = &NotFoundError{webhookdelivery.Label}
+		return
+	}
+	return ids[0], nil
+}
+
+// FirstXID is like FirstID, but panics if an error occurs.
+func (wdq *WebhookDeliveryQuery) FirstXID(ctx context.Context) int {
+	id, err := wdq.FirstID(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return id
+}
+
+// Only returns the only WebhookDelivery entity in the query, returns an error if not exactly one entity was returned.
+func (wdq *WebhookDeliveryQuery) Only(ctx context.Context) (*WebhookDelivery, error) {
+	nodes, err := wdq.Limit(2).All(ctx)
+	if err != nil {
+		return nil, err
+	}
+	switch len(nodes) {
+	case 1:
+		return nodes[0], nil
+	case 0:
+		return nil, &NotFoundError{webhookdelivery.Label}
+	default:
+		return nil, &NotSingularError{webhookdelivery.Label}
+	}
+}
+
+// OnlyX is like Only, but panics if an error occurs.
+func (wdq *WebhookDeliveryQuery) OnlyX(ctx context.Context) *WebhookDelivery {
+	node, err := wdq.Only(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// OnlyID returns the only WebhookDelivery id in the query, returns an error if not exactly one id was returned.
+func (wdq *WebhookDeliveryQuery) OnlyID(ctx context.Context) (id int, err error) {
+	var ids []int
+	if ids, err = wdq.Limit(2).IDs(ctx); err != nil {
+		return
+	}
+	switch len(ids) {
+	case 1:
+		id = ids[0]
+	case 0:
+		err = &NotFoundError{webhookdelivery.Label}
+	default:
+		err = &NotSingularError{webhookdelivery.Label}
+	}
+	return
+}
+
+// OnlyIDX is like OnlyID, but panics if an error occurs.
+func (wdq *WebhookDeliveryQuery) OnlyIDX(ctx context.Context) int {
+	id, err := wdq.OnlyID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// All executes the query and returns a list of WebhookDeliveries.
+func (wdq *WebhookDeliveryQuery) All(ctx context.Context) ([]*WebhookDelivery, error) {
+	if err := wdq.prepareQuery(ctx); err != nil {
+		return nil, err
+	}
+	return wdq.sqlAll(ctx)
+}
+
+// AllX is like All, but panics if an error occurs.
+func (wdq *WebhookDeliveryQuery) AllX(ctx context.Context) []*WebhookDelivery {
+	nodes, err := wdq.All(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return nodes
+}
+
+// IDs executes the query and returns a list of WebhookDelivery ids.
+func (wdq *WebhookDeliveryQuery) IDs(ctx context.Context) ([]int, error) {
+	var ids []int
+	if err := wdq.Select(webhookdelivery.FieldID).Scan(ctx, &ids); err != nil {
+		return nil, err
+	}
+	return ids, nil
+}
+
+// IDsX is like IDs, but panics if an error occurs.
+func (wdq *WebhookDeliveryQuery) IDsX(ctx context.Context) []int {
+	ids, err := wdq.IDs(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return ids
+}
+
+// Count returns the count of the given query.
+func (wdq *WebhookDeliveryQuery) Count(ctx context.Context) (int, error) {
+	if err := wdq.prepareQuery(ctx); err != nil {
+		return 0, err
+	}
+	return wdq.sqlCount(ctx)
+}
+
+// CountX is like Count, but panics if an error occurs.
+func (wdq *WebhookDeliveryQuery) CountX(ctx context.Context) int {
+	count, err := wdq.Count(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return count
+}
+
+// Exist returns true if the query has elements in the graph.
+func (wdq *WebhookDeliveryQuery) Exist(ctx context.Context) (bool, error) {
+	if err := wdq.prepareQuery(ctx); err != nil {
+		return false, err
+	}
+	return wdq.sqlExist(ctx)
+}
+
+// ExistX is like Exist, but panics if an error occurs.
+func (wdq *WebhookDeliveryQuery) ExistX(ctx context.Context) bool {
+	exist, err := wdq.Exist(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return exist
+}
+
+// Clone returns a duplicate of the query builder, including all associated steps. It can be
+// used to prepare common query builders and use them differently after the clone is made.
+func (wdq *WebhookDeliveryQuery) Clone() *WebhookDeliveryQuery {
+	return &WebhookDeliveryQuery{
+		config:     wdq.config,
+		limit:      wdq.limit,
+		offset:     wdq.offset,
+		order:      append([]OrderFunc{}, wdq.order...),
+		unique:     append([]string{}, wdq.unique...),
+		predicates: append([]predicate.WebhookDelivery{}, wdq.predicates...),
+		// clone intermediate query.
+		sql:  wdq.sql.Clone(),
+		path: wdq.path,
+	}
+}
+
+//	WithWebhook tells the query-builder to eager-loads the nodes that are connected to
+//
+// the "webhook" edge. The optional arguments used to configure the query builder of the edge.
+func (wdq *WebhookDeliveryQuery) WithWebhook(opts ...func(*WebhookQuery)) *WebhookDeliveryQuery {
+	query := &WebhookQuery{config: wdq.config}
+	for _, opt := range opts {
+		opt(query)
+	}
+	wdq.withWebhook = query
+	return wdq
+}
+
+// GroupBy used to group vertices by one or more fields/columns.
+// It is often used with aggregate functions, like: count, max, mean, min, sum.
+//
+// Example:
+//
+//	var v []struct {
+//		Event string `json:"event,omitempty"`
+//		Count int `json:"count,omitempty"`
+//	}
+//
+//	client.WebhookDelivery.Query().
+//		GroupBy(webhookdelivery.FieldEvent).
+//		Aggregate(ent.Count()).
+//		Scan(ctx, &v)
+func (wdq *WebhookDeliveryQuery) GroupBy(field string, fields ...string) *WebhookDeliveryGroupBy {
+	group := &WebhookDeliveryGroupBy{config: wdq.config}
+	group.fields = append([]string{field}, fields...)
+	group.path = func(ctx context.Context) (prev *sql.Selector, err error) {
+		if err := wdq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		return wdq.sqlQuery(), nil
+	}
+	return group
+}
+
+// Select one or more fields from the given query.
+//
+// Example:
+//
+//	var v []struct {
+//		Event string `json:"event,omitempty"`
+//	}
+//
+//	client.WebhookDelivery.Query().
+//		Select(webhookdelivery.FieldEvent).
+//		Scan(ctx, &v)
+func (wdq *WebhookDeliveryQuery) Select(field string, fields ...string) *WebhookDeliverySelect {
+	selector := &WebhookDeliverySelect{config: wdq.config}
+	selector.fields = append([]string{field}, fields...)
+	selector.path = func(ctx context.Context) (prev *sql.Selector, err error) {
+		if err := wdq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		return wdq.sqlQuery(), nil
+	}
+	return selector
+}
+
+func (wdq *WebhookDeliveryQuery) prepareQuery(ctx context.Context) error {
+	if wdq.path != nil {
+		prev, err := wdq.path(ctx)
+		if err != nil {
+			return err
+		}
+		wdq.sql = prev
+	}
+	return nil
+}
+
+func (wdq *WebhookDeliveryQuery) sqlAll(ctx context.Context) ([]*WebhookDelivery, error) {
+	var (
+		nodes       = []*WebhookDelivery{}
+		withFKs     = wdq.withFKs
+		_spec       = wdq.querySpec()
+		loadedTypes = [1]bool{
+			wdq.withWebhook != nil,
+		}
+	)
+	if wdq.withWebhook != nil {
+		withFKs = true
+	}
+	if withFKs {
+		_spec.Node.Columns = append(_spec.Node.Columns, webhookdelivery.ForeignKeys...)
+	}
+	_spec.ScanValues = func() []interface{} {
+		node := &WebhookDelivery{config: wdq.config}
+		nodes = append(nodes, node)
+		values := node.scanValues()
+		if withFKs {
+			values = append(values, node.fkValues()...)
+		}
+		return values
+	}
+	_spec.Assign = func(values ...interface{}) error {
+		if len(nodes) == 0 {
+			return fmt.Errorf("ent: Assign called without calling ScanValues")
+		}
+		node := nodes[len(nodes)-1]
+		node.Edges.loadedTypes = loadedTypes
+		return node.assignValues(values...)
+	}
+	if err := sqlgraph.QueryNodes(ctx, wdq.driver, _spec); err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nodes, nil
+	}
+
+	if query := wdq.withWebhook; query != nil {
+		ids := make([]int, 0, len(nodes))
+		nodeids := make(map[int][]*WebhookDelivery)
+		for i := range nodes {
+			if fk := nodes[i].webhook_deliveries; fk != nil {
+				ids = append(ids, *fk)
+				nodeids[*fk] = append(nodeids[*fk], nodes[i])
+			}
+		}
+		query.Where(webhook.IDIn(ids...))
+		neighbors, err := query.All(ctx)
+		if err != nil {
+			return nil, err
+		}
+		for _, n := range neighbors {
+			nodes, ok := nodeids[n.ID]
+			if !ok {
+				return nil, fmt.Errorf(`unexpected foreign-key "webhook_deliveries" returned %v`, n.ID)
+			}
+			for i := range nodes {
+				nodes[i].Edges.Webhook = n
+			}
+		}
+	}
+
+	return nodes, nil
+}
+
+func (wdq *WebhookDeliveryQuery) sqlCount(ctx context.Context) (int, error) {
+	_spec := wdq.querySpec()
+	return sqlgraph.CountNodes(ctx, wdq.driver, _spec)
+}
+
+func (wdq *WebhookDeliveryQuery) sqlExist(ctx context.Context) (bool, error) {
+	n, err := wdq.sqlCount(ctx)
+	if err != nil {
+		return false, fmt.Errorf("ent: check existence: %v", err)
+	}
+	return n > 0, nil
+}
+
+func (wdq *WebhookDeliveryQuery) querySpec() *sqlgraph.QuerySpec {
+	_spec := &sqlgraph.QuerySpec{
+		Node: &sqlgraph.NodeSpec{
+			Table:   webhookdelivery.Table,
+			Columns: webhookdelivery.Columns,
+			ID: &sqlgraph.FieldSpec{
+				Type:   field.TypeInt,
+				Column: webhookdelivery.FieldID,
+			},
+		},
+		From:   wdq.sql,
+		Unique: true,
+	}
+	if ps := wdq.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if limit := wdq.limit; limit != nil {
+		_spec.Limit = *limit
+	}
+	if offset := wdq.offset; offset != nil {
+		_spec.Offset = *offset
+	}
+	if ps := wdq.order; len(ps) > 0 {
+		_spec.Order = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector, webhookdelivery.ValidColumn)
+			}
+		}
+	}
+	return _spec
+}
+
+func (wdq *WebhookDeliveryQuery) sqlQuery() *sql.Selector {
+	builder := sql.Dialect(wdq.driver.Dialect())
+	t1 := builder.Table(webhookdelivery.Table)
+	selector := builder.Select(t1.Columns(webhookdelivery.Columns...)...).From(t1)
+	if wdq.sql != nil {
+		selector = wdq.sql
+		selector.Select(selector.Columns(webhookdelivery.Columns...)...)
+	}
+	for _, p := range wdq.predicates {
+		p(selector)
+	}
+	for _, p := range wdq.order {
+		p(selector, webhookdelivery.ValidColumn)
+	}
+	if offset := wdq.offset; offset != nil {
+		// limit is mandatory for offset clause. We start
+		// with default value, and override it below if needed.
+		selector.Offset(*offset).Limit(math.MaxInt32)
+	}
+	if limit := wdq.limit; limit != nil {
+		selector.Limit(*limit)
+	}
+	return selector
+}
+
+// WebhookDeliveryGroupBy is the builder for group-by WebhookDelivery entities.
+type WebhookDeliveryGroupBy struct {
+	config
+	fields []string
+	fns    []AggregateFunc
+	// intermediate query (i.e. traversal path).
+	sql  *sql.Selector
+	path func(context.Context) (*sql.Selector, error)
+}
+
+// Aggregate adds the given aggregation functions to the group-by query.
+func (wdgb *WebhookDeliveryGroupBy) Aggregate(fns ...AggregateFunc) *WebhookDeliveryGroupBy {
+	wdgb.fns = append(wdgb.fns, fns...)
+	return wdgb
+}
+
+// Scan applies the group-by query and scan the result into the given value.
+func (wdgb *WebhookDeliveryGroupBy) Scan(ctx context.Context, v interface{}) error {
+	query, err := wdgb.path(ctx)
+	if err != nil {
+		return err
+	}
+	wdgb.sql = query
+	return wdgb.sqlScan(ctx, v)
+}
+
+// ScanX is like Scan, but panics if an error occurs.
+func (wdgb *WebhookDeliveryGroupBy) ScanX(ctx context.Context, v interface{}) {
+	if err := wdgb.Scan(ctx, v); err != nil {
+		panic(err)
+	}
+}
+
+// Strings returns list of strings from group-by. It is only allowed when querying group-by with one field.
+func (wdgb *WebhookDeliveryGroupBy) Strings(ctx context.Context) ([]string, error) {
+	if len(wdgb.fields) > 1 {
+		return nil, errors.New("ent: WebhookDeliveryGroupBy.Strings is not achievable when grouping more than 1 field")
+	}
+	var v []string
+	if err := wdgb.Scan(ctx, &v); err != nil {
+		return nil, err
+	}
+	return v, nil
+}
+
+// StringsX is like Strings, but panics if an error occurs.
+func (wdgb *WebhookDeliveryGroupBy) StringsX(ctx context.Context) []string {
+	v, err := wdgb.Strings(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// String returns a single string from group-by. It is only allowed when querying group-by with one field.
+func (wdgb *WebhookDeliveryGroupBy) String(ctx context.Context) (_ string, err error) {
+	var v []string
+	if v, err = wdgb.Strings(ctx); err != nil {
+		return
+	}
+	switch len(v) {
+	case 1:
+		return v[0], nil
+	case 0:
+		err = &NotFoundError{webhookdelivery.Label}
+	default:
+		err = fmt.Errorf("ent: WebhookDeliveryGroupBy.Strings returned %d results when one was expected", len(v))
+	}
+	return
+}
+
+// StringX is like String, but panics if an error occurs.
+func (wdgb *WebhookDeliveryGroupBy) StringX(ctx context.Context) string {
+	v, err := wdgb.String(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Ints returns list of ints from group-by. It is only allowed when querying group-by with one field.
+func (wdgb *WebhookDeliveryGroupBy) Ints(ctx context.Context) ([]int, error) {
+	if len(wdgb.fields) > 1 {
+		return nil, errors.New("ent: WebhookDeliveryGroupBy.Ints is not achievable when grouping more than 1 field")
+	}
+	var v []int
+	if err := wdgb.Scan(ctx, &v); err != nil {
+		return nil, err
+	}
+	return v, nil
+}
+
+// IntsX is like Ints, but panics if an error occurs.
+func (wdgb *WebhookDeliveryGroupBy) IntsX(ctx context.Context) []int {
+	v, err := wdgb.Ints(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Int returns a single int from group-by. It is only allowed when querying group-by with one field.
+func (wdgb *WebhookDeliveryGroupBy) Int(ctx context.Context) (_ int, err error) {
+	var v []int
+	if v, err = wdgb.Ints(ctx); err != nil {
+		return
+	}
+	switch len(v) {
+	case 1:
+		return v[0], nil
+	case 0:
+		err = &NotFoundError{webhookdelivery.Label}
+	default:
+		err = fmt.Errorf("ent: WebhookDeliveryGroupBy.Ints returned %d results when one was expected", len(v))
+	}
+	return
+}
+
+// IntX is like Int, but panics if an error occurs.
+func (wdgb *WebhookDeliveryGroupBy) IntX(ctx context.Context) int {
+	v, err := wdgb.Int(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Float64s returns list of float64s from group-by. It is only allowed when querying group-by with one field.
+func (wdgb *WebhookDeliveryGroupBy) Float64s(ctx context.Context) ([]float64, error) {
+	if len(wdgb.fields) > 1 {
+		return nil, errors.New("ent: WebhookDeliveryGroupBy.Float64s is not achievable when grouping more than 1 field")
+	}
+	var v []float64
+	if err := wdgb.Scan(ctx, &v); err != nil {
+		return nil, err
+	}
+	return v, nil
+}
+
+// Float64sX is like Float64s, but panics if an error occurs.
+func (wdgb *WebhookDeliveryGroupBy) Float64sX(ctx context.Context) []float64 {
+	v, err := wdgb.Float64s(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Float64 returns a single float64 from group-by. It is only allowed when querying group-by with one field.
+func (wdgb *WebhookDeliveryGroupBy) Float64(ctx context.Context) (_ float64, err error) {
+	var v []float64
+	if v, err = wdgb.Float64s(ctx); err != nil {
+		return
+	}
+	switch len(v) {
+	case 1:
+		return v[0], nil
+	case 0:
+		err = &NotFoundError{webhookdelivery.Label}
+	default:
+		err = fmt.Errorf("ent: WebhookDeliveryGroupBy.Float64s returned %d results when one was expected", len(v))
+	}
+	return
+}
+
+// Float64X is like Float64, but panics if an error occurs.
+func (wdgb *WebhookDeliveryGroupBy) Float64X(ctx context.Context) float64 {
+	v, err := wdgb.Float64(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Bools returns list of bools from group-by. It is only allowed when querying group-by with one field.
+func (wdgb *WebhookDeliveryGroupBy) Bools(ctx context.Context) ([]bool, error) {
+	if len(wdgb.fields) > 1 {
+		return nil, errors.New("ent: WebhookDeliveryGroupBy.Bools is not achievable when grouping more than 1 field")
+	}
+	var v []bool
+	if err := wdgb.Scan(ctx, &v); err != nil {
+		return nil, err
+	}
+	return v, nil
+}
+
+// BoolsX is like Bools, but panics if an error occurs.
+func (wdgb *WebhookDeliveryGroupBy) BoolsX(ctx context.Context) []bool {
+	v, err := wdgb.Bools(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Bool returns a single bool from group-by. It is only allowed when querying group-by with one field.
+func (wdgb *WebhookDeliveryGroupBy) Bool(ctx context.Context) (_ bool, err error) {
+	var v []bool
+	if v, err = wdgb.Bools(ctx); err != nil {
+		return
+	}
+	switch len(v) {
+	case 1:
+		return v[0], nil
+	case 0:
+		err = &NotFoundError{webhookdelivery.Label}
+	default:
+		err = fmt.Errorf("ent: WebhookDeliveryGroupBy.Bools returned %d results when one was expected", len(v))
+	}
+	return
+}
+
+// BoolX is like Bool, but panics if an error occurs.
+func (wdgb *WebhookDeliveryGroupBy) BoolX(ctx context.Context) bool {
+	v, err := wdgb.Bool(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+func (wdgb *WebhookDeliveryGroupBy) sqlScan(ctx context.Context, v interface{}) error {
+	for _, f := range wdgb.fields {
+		if !webhookdelivery.ValidColumn(f) {
+			return &ValidationError{Name: f, err: fmt.Errorf("invalid field %q for group-by", f)}
+		}
+	}
+	selector := wdgb.sqlQuery()
+	if err := selector.Err(); err != nil {
+		return err
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := wdgb.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
+
+func (wdgb *WebhookDeliveryGroupBy) sqlQuery() *sql.Selector {
+	selector := wdgb.sql
+	columns := make([]string, 0, len(wdgb.fields)+len(wdgb.fns))
+	columns = append(columns, wdgb.fields...)
+	for _, fn := range wdgb.fns {
+		columns = append(columns, fn(selector, webhookdelivery.ValidColumn))
+	}
+	return selector.Select(columns...).GroupBy(wdgb.fields...)
+}
+
+// WebhookDeliverySelect is the builder for select fields of WebhookDelivery entities.
+type WebhookDeliverySelect struct {
+	config
+	fields []string
+	// intermediate query (i.e. traversal path).
+	sql  *sql.Selector
+	path func(context.Context) (*sql.Selector, error)
+}
+
+// Scan applies the selector query and scan the result into the given value.
+func (wds *WebhookDeliverySelect) Scan(ctx context.Context, v interface{}) error {
+	query, err := wds.path(ctx)
+	if err != nil {
+		return err
+	}
+	wds.sql = query
+	return wds.sqlScan(ctx, v)
+}
+
+// ScanX is like Scan, but panics if an error occurs.
+func (wds *WebhookDeliverySelect) ScanX(ctx context.Context, v interface{}) {
+	if err := wds.Scan(ctx, v); err != nil {
+		panic(err)
+	}
+}
+
+// Strings returns list of strings from selector. It is only allowed when selecting one field.
+func (wds *WebhookDeliverySelect) Strings(ctx context.Context) ([]string, error) {
+	if len(wds.fields) > 1 {
+		return nil, errors.New("ent: WebhookDeliverySelect.Strings is not achievable when selecting more than 1 field")
+	}
+	var v []string
+	if err := wds.Scan(ctx, &v); err != nil {
+		return nil, err
+	}
+	return v, nil
+}
+
+// StringsX is like Strings, but panics if an error occurs.
+func (wds *WebhookDeliverySelect) StringsX(ctx context.Context) []string {
+	v, err := wds.Strings(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// String returns a single string from selector. It is only allowed when selecting one field.
+func (wds *WebhookDeliverySelect) String(ctx context.Context) (_ string, err error) {
+	var v []string
+	if v, err = wds.Strings(ctx); err != nil {
+		return
+	}
+	switch len(v) {
+	case 1:
+		return v[0], nil
+	case 0:
+		err = &NotFoundError{webhookdelivery.Label}
+	default:
+		err = fmt.Errorf("ent: WebhookDeliverySelect.Strings returned %d results when one was expected", len(v))
+	}
+	return
+}
+
+// StringX is like String, but panics if an error occurs.
+func (wds *WebhookDeliverySelect) StringX(ctx context.Context) string {
+	v, err := wds.String(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Ints returns list of ints from selector. It is only allowed when selecting one field.
+func (wds *WebhookDeliverySelect) Ints(ctx context.Context) ([]int, error) {
+	if len(wds.fields) > 1 {
+		return nil, errors.New("ent: WebhookDeliverySelect.Ints is not achievable when selecting more than 1 field")
+	}
+	var v []int
+	if err := wds.Scan(ctx, &v); err != nil {
+		return nil, err
+	}
+	return v, nil
+}
+
+// IntsX is like Ints, but panics if an error occurs.
+func (wds *WebhookDeliverySelect) IntsX(ctx context.Context) []int {
+	v, err := wds.Ints(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Int returns a single int from selector. It is only allowed when selecting one field.
+func (wds *WebhookDeliverySelect) Int(ctx context.Context) (_ int, err error) {
+	var v []int
+	if v, err = wds.Ints(ctx); err != nil {
+		return
+	}
+	switch len(v) {
+	case 1:
+		return v[0], nil
+	case 0:
+		err = &NotFoundError{webhookdelivery.Label}
+	default:
+		err = fmt.Errorf("ent: WebhookDeliverySelect.Ints returned %d results when one was expected", len(v))
+	}
+	return
+}
+
+// IntX is like Int, but panics if an error occurs.
+func (wds *WebhookDeliverySelect) IntX(ctx context.Context) int {
+	v, err := wds.Int(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Float64s returns list of float64s from selector. It is only allowed when selecting one field.
+func (wds *WebhookDeliverySelect) Float64s(ctx context.Context) ([]float64, error) {
+	if len(wds.fields) > 1 {
+		return nil, errors.New("ent: WebhookDeliverySelect.Float64s is not achievable when selecting more than 1 field")
+	}
+	var v []float64
+	if err := wds.Scan(ctx, &v); err != nil {
+		return nil, err
+	}
+	return v, nil
+}
+
+// Float64sX is like Float64s, but panics if an error occurs.
+func (wds *WebhookDeliverySelect) Float64sX(ctx context.Context) []float64 {
+	v, err := wds.Float64s(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Float64 returns a single float64 from selector. It is only allowed when selecting one field.
+func (wds *WebhookDeliverySelect) Float64(ctx context.Context) (_ float64, err error) {
+	var v []float64
+	if v, err = wds.Float64s(ctx); err != nil {
+		return
+	}
+	switch len(v) {
+	case 1:
+		return v[0], nil
+	case 0:
+		err = &NotFoundError{webhookdelivery.Label}
+	default:
+		err = fmt.Errorf("ent: WebhookDeliverySelect.Float64s returned %d results when one was expected", len(v))
+	}
+	return
+}
+
+// Float64X is like Float64, but panics if an error occurs.
+func (wds *WebhookDeliverySelect) Float64X(ctx context.Context) float64 {
+	v, err := wds.Float64(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Bools returns list of bools from selector. It is only allowed when selecting one field.
+func (wds *WebhookDeliverySelect) Bools(ctx context.Context) ([]bool, error) {
+	if len(wds.fields) > 1 {
+		return nil, errors.New("ent: WebhookDeliverySelect.Bools is not achievable when selecting more than 1 field")
+	}
+	var v []bool
+	if err := wds.Scan(ctx, &v); err != nil {
+		return nil, err
+	}
+	return v, nil
+}
+
+// BoolsX is like Bools, but panics if an error occurs.
+func (wds *WebhookDeliverySelect) BoolsX(ctx context.Context) []bool {
+	v, err := wds.Bools(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Bool returns a single bool from selector. It is only allowed when selecting one field.
+func (wds *WebhookDeliverySelect) Bool(ctx context.Context) (_ bool, err error) {
+	var v []bool
+	if v, err = wds.Bools(ctx); err != nil {
+		return
+	}
+	switch len(v) {
+	case 1:
+		return v[0], nil
+	case 0:
+		err = &NotFoundError{webhookdelivery.Label}
+	default:
+		err = fmt.Errorf("ent: WebhookDeliverySelect.Bools returned %d results when one was expected", len(v))
+	}
+	return
+}
+
+// BoolX is like Bool, but panics if an error occurs.
+func (wds *WebhookDeliverySelect) BoolX(ctx context.Context) bool {
+	v, err := wds.Bool(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+func (wds *WebhookDeliverySelect) sqlScan(ctx context.Context, v interface{}) error {
+	for _, f := range wds.fields {
+		if !webhookdelivery.ValidColumn(f) {
+			return &ValidationError{Name: f, err: fmt.Errorf("invalid field %q for selection", f)}
+		}
+	}
+	rows := &sql.Rows{}
+	query, args := wds.sqlQuery().Query()
+	if err := wds.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
+
+func (wds *WebhookDeliverySelect) sqlQuery() sql.Querier {
+	selector := wds.sql
+	selector.Select(selector.Columns(wds.fields...)...)
+	return selector
+}
@@ -0,0 +1,803 @@
+// Code generated by entc, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/facebook/ent/dialect/sql"
+	"github.com/facebook/ent/dialect/sql/sqlgraph"
+	"github.com/facebook/ent/schema/field"
+	"github.com/gobench-io/gobench/ent/predicate"
+	"github.com/gobench-io/gobench/ent/webhook"
+	"github.com/gobench-io/gobench/ent/webhookdelivery"
+)
+
+// WebhookDeliveryUpdate is the builder for updating WebhookDelivery entities.
+type WebhookDeliveryUpdate struct {
+	config
+	hooks      []Hook
+	mutation   *WebhookDeliveryMutation
+	predicates []predicate.WebhookDelivery
+}
+
+// Where adds a new predicate for the builder.
+func (wdu *WebhookDeliveryUpdate) Where(ps ...predicate.WebhookDelivery) *WebhookDeliveryUpdate {
+	wdu.predicates = append(wdu.predicates, ps...)
+	return wdu
+}
+
+// SetEvent sets the event field.
+func (wdu *WebhookDeliveryUpdate) SetEvent(s string) *WebhookDeliveryUpdate {
+	wdu.mutation.SetEvent(s)
+	return wdu
+}
+
+// SetApplicationID sets the application_id field.
+func (wdu *WebhookDeliveryUpdate) SetApplicationID(i int) *WebhookDeliveryUpdate {
+	wdu.mutation.ResetApplicationID()
+	wdu.mutation.SetApplicationID(i)
+	return wdu
+}
+
+// AddApplicationID adds i to application_id.
+func (wdu *WebhookDeliveryUpdate) AddApplicationID(i int) *WebhookDeliveryUpdate {
+	wdu.mutation.AddApplicationID(i)
+	return wdu
+}
+
+// SetPayload sets the payload field.
+func (wdu *WebhookDeliveryUpdate) SetPayload(s string) *WebhookDeliveryUpdate {
+	wdu.mutation.SetPayload(s)
+	return wdu
+}
+
+// SetAttempts sets the attempts field.
+func (wdu *WebhookDeliveryUpdate) SetAttempts(i int) *WebhookDeliveryUpdate {
+	wdu.mutation.ResetAttempts()
+	wdu.mutation.SetAttempts(i)
+	return wdu
+}
+
+// SetNillableAttempts sets the attempts field if the given value is not nil.
+func (wdu *WebhookDeliveryUpdate) SetNillableAttempts(i *int) *WebhookDeliveryUpdate {
+	if i != nil {
+		wdu.SetAttempts(*i)
+	}
+	return wdu
+}
+
+// AddAttempts adds i to attempts.
+func (wdu *WebhookDeliveryUpdate) AddAttempts(i int) *WebhookDeliveryUpdate {
+	wdu.mutation.AddAttempts(i)
+	return wdu
+}
+
+// SetStatusCode sets the status_code field.
+func (wdu *WebhookDeliveryUpdate) SetStatusCode(i int) *WebhookDeliveryUpdate {
+	wdu.mutation.ResetStatusCode()
+	wdu.mutation.SetStatusCode(i)
+	return wdu
+}
+
+// SetNillableStatusCode sets the status_code field if the given value is not nil.
+func (wdu *WebhookDeliveryUpdate) SetNillableStatusCode(i *int) *WebhookDeliveryUpdate {
+	if i != nil {
+		wdu.SetStatusCode(*i)
+	}
+	return wdu
+}
+
+// AddStatusCode adds i to status_code.
+func (wdu *WebhookDeliveryUpdate) AddStatusCode(i int) *WebhookDeliveryUpdate {
+	wdu.mutation.AddStatusCode(i)
+	return wdu
+}
+
+// SetError sets the error field.
+func (wdu *WebhookDeliveryUpdate) SetError(s string) *WebhookDeliveryUpdate {
+	wdu.mutation.SetError(s)
+	return wdu
+}
+
+// SetNillableError sets the error field if the given value is not nil.
+func (wdu *WebhookDeliveryUpdate) SetNillableError(s *string) *WebhookDeliveryUpdate {
+	if s != nil {
+		wdu.SetError(*s)
+	}
+	return wdu
+}
+
+// ClearError clears the value of error.
+func (wdu *WebhookDeliveryUpdate) ClearError() *WebhookDeliveryUpdate {
+	wdu.mutation.ClearError()
+	return wdu
+}
+
+// SetSuccess sets the success field.
+func (wdu *WebhookDeliveryUpdate) SetSuccess(b bool) *WebhookDeliveryUpdate {
+	wdu.mutation.SetSuccess(b)
+	return wdu
+}
+
+// SetNillableSuccess sets the success field if the given value is not nil.
+func (wdu *WebhookDeliveryUpdate) SetNillableSuccess(b *bool) *WebhookDeliveryUpdate {
+	if b != nil {
+		wdu.SetSuccess(*b)
+	}
+	return wdu
+}
+
+// SetCreatedAt sets the created_at field.
+func (wdu *WebhookDeliveryUpdate) SetCreatedAt(t time.Time) *WebhookDeliveryUpdate {
+	wdu.mutation.SetCreatedAt(t)
+	return wdu
+}
+
+// SetNillableCreatedAt sets the created_at field if the given value is not nil.
+func (wdu *WebhookDeliveryUpdate) SetNillableCreatedAt(t *time.Time) *WebhookDeliveryUpdate {
+	if t != nil {
+		wdu.SetCreatedAt(*t)
+	}
+	return wdu
+}
+
+// SetDeliveredAt sets the delivered_at field.
+func (wdu *WebhookDeliveryUpdate) SetDeliveredAt(t time.Time) *WebhookDeliveryUpdate {
+	wdu.mutation.SetDeliveredAt(t)
+	return wdu
+}
+
+// SetNillableDeliveredAt sets the delivered_at field if the given value is not nil.
+func (wdu *WebhookDeliveryUpdate) SetNillableDeliveredAt(t *time.Time) *WebhookDeliveryUpdate {
+	if t != nil {
+		wdu.SetDeliveredAt(*t)
+	}
+	return wdu
+}
+
+// ClearDeliveredAt clears the value of delivered_at.
+func (wdu *WebhookDeliveryUpdate) ClearDeliveredAt() *WebhookDeliveryUpdate {
+	wdu.mutation.ClearDeliveredAt()
+	return wdu
+}
+
+// SetWebhookID sets the webhook edge to Webhook by id.
+func (wdu *WebhookDeliveryUpdate) SetWebhookID(id int) *WebhookDeliveryUpdate {
+	wdu.mutation.SetWebhookID(id)
+	return wdu
+}
+
+// SetWebhook sets the webhook edge to Webhook.
+func (wdu *WebhookDeliveryUpdate) SetWebhook(w *Webhook) *WebhookDeliveryUpdate {
+	return wdu.SetWebhookID(w.ID)
+}
+
+// Mutation returns the WebhookDeliveryMutation object of the builder.
+func (wdu *WebhookDeliveryUpdate) Mutation() *WebhookDeliveryMutation {
+	return wdu.mutation
+}
+
+// ClearWebhook clears the "webhook" edge to type Webhook.
+func (wdu *WebhookDeliveryUpdate) ClearWebhook() *WebhookDeliveryUpdate {
+	wdu.mutation.ClearWebhook()
+	return wdu
+}
+
+// Save executes the query and returns the number of rows/vertices matched by this operation.
+func (wdu *WebhookDeliveryUpdate) Save(ctx context.Context) (int, error) {
+	var (
+		err      error
+		affected int
+	)
+	if len(wdu.hooks) == 0 {
+		if err = wdu.check(); err != nil {
+			return 0, err
+		}
+		affected, err = wdu.sqlSave(ctx)
+	} else {
+		var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+			mutation, ok := m.(*WebhookDeliveryMutation)
+			if !ok {
+				return nil, fmt.Errorf("unexpected mutation type %T", m)
+			}
+			if err = wdu.check(); err != nil {
+				return 0, err
+			}
+			wdu.mutation = mutation
+			affected, err = wdu.sqlSave(ctx)
+			mutation.done = true
+			return affected, err
+		})
+		for i := len(wdu.hooks) - 1; i >= 0; i-- {
+			mut = wdu.hooks[i](mut)
+		}
+		if _, err := mut.Mutate(ctx, wdu.mutation); err != nil {
+			return 0, err
+		}
+	}
+	return affected, err
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (wdu *WebhookDeliveryUpdate) SaveX(ctx context.Context) int {
+	affected, err := wdu.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (wdu *WebhookDeliveryUpdate) Exec(ctx context.Context) error {
+	_, err := wdu.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (wdu *WebhookDeliveryUpdate) ExecX(ctx context.Context) {
+	if err := wdu.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (wdu *WebhookDeliveryUpdate) check() error {
+	if _, ok := wdu.mutation.WebhookID(); wdu.mutation.WebhookCleared() && !ok {
+		return errors.New("ent: clearing a required unique edge \"webhook\"")
+	}
+	return nil
+}
+
+func (wdu *WebhookDeliveryUpdate) sqlSave(ctx context.Context) (n int, err error) {
+	_spec := &sqlgraph.UpdateSpec{
+		Node: &sqlgraph.NodeSpec{
+			Table:   webhookdelivery.Table,
+			Columns: webhookdelivery.Columns,
+			ID: &sqlgraph.FieldSpec{
+				Type:   field.TypeInt,
+				Column: webhookdelivery.FieldID,
+			},
+		},
+	}
+	if ps := wdu.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := wdu.mutation.Event(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: webhookdelivery.FieldEvent,
+		})
+	}
+	if value, ok := wdu.mutation.ApplicationID(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt,
+			Value:  value,
+			Column: webhookdelivery.FieldApplicationID,
+		})
+	}
+	if value, ok := wdu.mutation.AddedApplicationID(); ok {
+		_spec.Fields.Add = append(_spec.Fields.Add, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt,
+			Value:  value,
+			Column: webhookdelivery.FieldApplicationID,
+		})
+	}
+	if value, ok := wdu.mutation.Payload(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: webhookdelivery.FieldPayload,
+		})
+	}
+	if value, ok := wdu.mutation.Attempts(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt,
+			Value:  value,
+			Column: webhookdelivery.FieldAttempts,
+		})
+	}
+	if value, ok := wdu.mutation.AddedAttempts(); ok {
+		_spec.Fields.Add = append(_spec.Fields.Add, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt,
+			Value:  value,
+			Column: webhookdelivery.FieldAttempts,
+		})
+	}
+	if value, ok := wdu.mutation.StatusCode(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt,
+			Value:  value,
+			Column: webhookdelivery.FieldStatusCode,
+		})
+	}
+	if value, ok := wdu.mutation.AddedStatusCode(); ok {
+		_spec.Fields.Add = append(_spec.Fields.Add, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt,
+			Value:  value,
+			Column: webhookdelivery.FieldStatusCode,
+		})
+	}
+	if value, ok := wdu.mutation.Error(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: webhookdelivery.FieldError,
+		})
+	}
+	if wdu.mutation.ErrorCleared() {
+		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Column: webhookdelivery.FieldError,
+		})
+	}
+	if value, ok := wdu.mutation.Success(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeBool,
+			Value:  value,
+			Column: webhookdelivery.FieldSuccess,
+		})
+	}
+	if value, ok := wdu.mutation.CreatedAt(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeTime,
+			Value:  value,
+			Column: webhookdelivery.FieldCreatedAt,
+		})
+	}
+	if value, ok := wdu.mutation.DeliveredAt(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeTime,
+			Value:  value,
+			Column: webhookdelivery.FieldDeliveredAt,
+		})
+	}
+	if wdu.mutation.DeliveredAtCleared() {
+		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
+			Type:   field.TypeTime,
+			Column: webhookdelivery.FieldDeliveredAt,
+		})
+	}
+	if wdu.mutation.WebhookCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   webhookdelivery.WebhookTable,
+			Columns: []string{webhookdelivery.WebhookColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt,
+					Column: webhook.FieldID,
+				},
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := wdu.mutation.WebhookIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   webhookdelivery.WebhookTable,
+			Columns: []string{webhookdelivery.WebhookColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt,
+					Column: webhook.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if n, err = sqlgraph.UpdateNodes(ctx, wdu.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{webhookdelivery.Label}
+		} else if cerr, ok := isSQLConstraintError(err); ok {
+			err = cerr
+		}
+		return 0, err
+	}
+	return n, nil
+}
+
+// WebhookDeliveryUpdateOne is the builder for updating a single WebhookDelivery entity.
+type WebhookDeliveryUpdateOne struct {
+	config
+	hooks    []Hook
+	mutation *WebhookDeliveryMutation
+}
+
+// SetEvent sets the event field.
+func (wduo *WebhookDeliveryUpdateOne) SetEvent(s string) *WebhookDeliveryUpdateOne {
+	wduo.mutation.SetEvent(s)
+	return wduo
+}
+
+// SetApplicationID sets the application_id field.
+func (wduo *WebhookDeliveryUpdateOne) SetApplicationID(i int) *WebhookDeliveryUpdateOne {
+	wduo.mutation.ResetApplicationID()
+	wduo.mutation.SetApplicationID(i)
+	return wduo
+}
+
+// AddApplicationID adds i to application_id.
+func (wduo *WebhookDeliveryUpdateOne) AddApplicationID(i int) *WebhookDeliveryUpdateOne {
+	wduo.mutation.AddApplicationID(i)
+	return wduo
+}
+
+// SetPayload sets the payload field.
+func (wduo *WebhookDeliveryUpdateOne) SetPayload(s string) *WebhookDeliveryUpdateOne {
+	wduo.mutation.SetPayload(s)
+	return wduo
+}
+
+// SetAttempts sets the attempts field.
+func (wduo *WebhookDeliveryUpdateOne) SetAttempts(i int) *WebhookDeliveryUpdateOne {
+	wduo.mutation.ResetAttempts()
+	wduo.mutation.SetAttempts(i)
+	return wduo
+}
+
+// SetNillableAttempts sets the attempts field if the given value is not nil.
+func (wduo *WebhookDeliveryUpdateOne) SetNillableAttempts(i *int) *WebhookDeliveryUpdateOne {
+	if i != nil {
+		wduo.SetAttempts(*i)
+	}
+	return wduo
+}
+
+// AddAttempts adds i to attempts.
+func (wduo *WebhookDeliveryUpdateOne) AddAttempts(i int) *WebhookDeliveryUpdateOne {
+	wduo.mutation.AddAttempts(i)
+	return wduo
+}
+
+// SetStatusCode sets the status_code field.
+func (wduo *WebhookDeliveryUpdateOne) SetStatusCode(i int) *WebhookDeliveryUpdateOne {
+	wduo.mutation.ResetStatusCode()
+	wduo.mutation.SetStatusCode(i)
+	return wduo
+}
+
+// SetNillableStatusCode sets the status_code field if the given value is not nil.
+func (wduo *WebhookDeliveryUpdateOne) SetNillableStatusCode(i *int) *WebhookDeliveryUpdateOne {
+	if i != nil {
+		wduo.SetStatusCode(*i)
+	}
+	return wduo
+}
+
+// AddStatusCode adds i to status_code.
+func (wduo *WebhookDeliveryUpdateOne) AddStatusCode(i int) *WebhookDeliveryUpdateOne {
+	wduo.mutation.AddStatusCode(i)
+	return wduo
+}
+
+// SetError sets the error field.
+func (wduo *WebhookDeliveryUpdateOne) SetError(s string) *WebhookDeliveryUpdateOne {
+	wduo.mutation.SetError(s)
+	return wduo
+}
+
+// SetNillableError sets the error field if the given value is not nil.
+func (wduo *WebhookDeliveryUpdateOne) SetNillableError(s *string) *WebhookDeliveryUpdateOne {
+	if s != nil {
+		wduo.SetError(*s)
+	}
+	return wduo
+}
+
+// ClearError clears the value of error.
+func (wduo *WebhookDeliveryUpdateOne) ClearError() *WebhookDeliveryUpdateOne {
+	wduo.mutation.ClearError()
+	return wduo
+}
+
+// SetSuccess sets the success field.
+func (wduo *WebhookDeliveryUpdateOne) SetSuccess(b bool) *WebhookDeliveryUpdateOne {
+	wduo.mutation.SetSuccess(b)
+	return wduo
+}
+
+// SetNillableSuccess sets the success field if the given value is not nil.
+func (wduo *WebhookDeliveryUpdateOne) SetNillableSuccess(b *bool) *WebhookDeliveryUpdateOne {
+	if b != nil {
+		wduo.SetSuccess(*b)
+	}
+	return wduo
+}
+
+// SetCreatedAt sets the created_at field.
+func (wduo *WebhookDeliveryUpdateOne) SetCreatedAt(t time.Time) *WebhookDeliveryUpdateOne {
+	wduo.mutation.SetCreatedAt(t)
+	return wduo
+}
+
+// SetNillableCreatedAt sets the created_at field if the given value is not nil.
+func (wduo *WebhookDeliveryUpdateOne) SetNillableCreatedAt(t *time.Time) *WebhookDeliveryUpdateOne {
+	if t != nil {
+		wduo.SetCreatedAt(*t)
+	}
+	return wduo
+}
+
+// SetDeliveredAt sets the delivered_at field.
+func (wduo *WebhookDeliveryUpdateOne) SetDeliveredAt(t time.Time) *WebhookDeliveryUpdateOne {
+	wduo.mutation.SetDeliveredAt(t)
+	return wduo
+}
+
+// SetNillableDeliveredAt sets the delivered_at field if the given value is not nil.
+func (wduo *WebhookDeliveryUpdateOne) SetNillableDeliveredAt(t *time.Time) *WebhookDeliveryUpdateOne {
+	if t != nil {
+		wduo.SetDeliveredAt(*t)
+	}
+	return wduo
+}
+
+// ClearDeliveredAt clears the value of delivered_at.
+func (wduo *WebhookDeliveryUpdateOne) ClearDeliveredAt() *WebhookDeliveryUpdateOne {
+	wduo.mutation.ClearDeliveredAt()
+	return wduo
+}
+
+// SetWebhookID sets the webhook edge to Webhook by id.
+func (wduo *WebhookDeliveryUpdateOne) SetWebhookID(id int) *WebhookDeliveryUpdateOne {
+	wduo.mutation.SetWebhookID(id)
+	return wduo
+}
+
+// SetWebhook sets the webhook edge to Webhook.
+func (wduo *WebhookDeliveryUpdateOne) SetWebhook(w *Webhook) *WebhookDeliveryUpdateOne {
+	return wduo.SetWebhookID(w.ID)
+}
+
+// Mutation returns the WebhookDeliveryMutation object of the builder.
+func (wduo *WebhookDeliveryUpdateOne) Mutation() *WebhookDeliveryMutation {
+	return wduo.mutation
+}
+
+// ClearWebhook clears the "webhook" edge to type Webhook.
+func (wduo *WebhookDeliveryUpdateOne) ClearWebhook() *WebhookDeliveryUpdateOne {
+	wduo.mutation.ClearWebhook()
+	return wduo
+}
+
+// Save executes the query and returns the updated entity.
+func (wduo *WebhookDeliveryUpdateOne) Save(ctx context.Context) (*WebhookDelivery, error) {
+	var (
+		err  error
+		node *WebhookDelivery
+	)
+	if len(wduo.hooks) == 0 {
+		if err = wduo.check(); err != nil {
+			return nil, err
+		}
+		node, err = wduo.sqlSave(ctx)
+	} else {
+		var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+			mutation, ok := m.(*WebhookDeliveryMutation)
+			if !ok {
+				return nil, fmt.Errorf("unexpected mutation type %T", m)
+			}
+			if err = wduo.check(); err != nil {
+				return nil, err
+			}
+			wduo.mutation = mutation
+			node, err = wduo.sqlSave(ctx)
+			mutation.done = true
+			return node, err
+		})
+		for i := len(wduo.hooks) - 1; i >= 0; i-- {
+			mut = wduo.hooks[i](mut)
+		}
+		if _, err := mut.Mutate(ctx, wduo.mutation); err != nil {
+			return nil, err
+		}
+	}
+	return node, err
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (wduo *WebhookDeliveryUpdateOne) SaveX(ctx context.Context) *WebhookDelivery {
+	node, err := wduo.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (wduo *WebhookDeliveryUpdateOne) Exec(ctx context.Context) error {
+	_, err := wduo.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (wduo *WebhookDeliveryUpdateOne) ExecX(ctx context.Context) {
+	if err := wduo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (wduo *WebhookDeliveryUpdateOne) check() error {
+	if _, ok := wduo.mutation.WebhookID(); wduo.mutation.WebhookCleared() && !ok {
+		return errors.New("ent: clearing a required unique edge \"webhook\"")
+	}
+	return nil
+}
+
+func (wduo *WebhookDeliveryUpdateOne) sqlSave(ctx context.Context) (_node *WebhookDelivery, err error) {
+	_spec := &sqlgraph.UpdateSpec{
+		Node: &sqlgraph.NodeSpec{
+			Table:   webhookdelivery.Table,
+			Columns: webhookdelivery.Columns,
+			ID: &sqlgraph.FieldSpec{
+				Type:   field.TypeInt,
+				Column: webhookdelivery.FieldID,
+			},
+		},
+	}
+	id, ok := wduo.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "ID", err: fmt.Errorf("missing WebhookDelivery.ID for update")}
+	}
+	_spec.Node.ID.Value = id
+	if value, ok := wduo.mutation.Event(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: webhookdelivery.FieldEvent,
+		})
+	}
+	if value, ok := wduo.mutation.ApplicationID(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt,
+			Value:  value,
+			Column: webhookdelivery.FieldApplicationID,
+		})
+	}
+	if value, ok := wduo.mutation.AddedApplicationID(); ok {
+		_spec.Fields.Add = append(_spec.Fields.Add, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt,
+			Value:  value,
+			Column: webhookdelivery.FieldApplicationID,
+		})
+	}
+	if value, ok := wduo.mutation.Payload(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: webhookdelivery.FieldPayload,
+		})
+	}
+	if value, ok := wduo.mutation.Attempts(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt,
+			Value:  value,
+			Column: webhookdelivery.FieldAttempts,
+		})
+	}
+	if value, ok := wduo.mutation.AddedAttempts(); ok {
+		_spec.Fields.Add = append(_spec.Fields.Add, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt,
+			Value:  value,
+			Column: webhookdelivery.FieldAttempts,
+		})
+	}
+	if value, ok := wduo.mutation.StatusCode(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt,
+			Value:  value,
+			Column: webhookdelivery.FieldStatusCode,
+		})
+	}
+	if value, ok := wduo.mutation.AddedStatusCode(); ok {
+		_spec.Fields.Add = append(_spec.Fields.Add, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt,
+			Value:  value,
+			Column: webhookdelivery.FieldStatusCode,
+		})
+	}
+	if value, ok := wduo.mutation.Error(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: webhookdelivery.FieldError,
+		})
+	}
+	if wduo.mutation.ErrorCleared() {
+		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Column: webhookdelivery.FieldError,
+		})
+	}
+	if value, ok := wduo.mutation.Success(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeBool,
+			Value:  value,
+			Column: webhookdelivery.FieldSuccess,
+		})
+	}
+	if value, ok := wduo.mutation.CreatedAt(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeTime,
+			Value:  value,
+			Column: webhookdelivery.FieldCreatedAt,
+		})
+	}
+	if value, ok := wduo.mutation.DeliveredAt(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeTime,
+			Value:  value,
+			Column: webhookdelivery.FieldDeliveredAt,
+		})
+	}
+	if wduo.mutation.DeliveredAtCleared() {
+		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
+			Type:   field.TypeTime,
+			Column: webhookdelivery.FieldDeliveredAt,
+		})
+	}
+	if wduo.mutation.WebhookCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   webhookdelivery.WebhookTable,
+			Columns: []string{webhookdelivery.WebhookColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt,
+					Column: webhook.FieldID,
+				},
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := wduo.mutation.WebhookIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   webhookdelivery.WebhookTable,
+			Columns: []string{webhookdelivery.WebhookColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt,
+					Column: webhook.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_node = &WebhookDelivery{config: wduo.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues()
+	if err = sqlgraph.UpdateNode(ctx, wduo.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{webhookdelivery.Label}
+		} else if cerr, ok := isSQLConstraintError(err); ok {
+			err = cerr
+		}
+		return nil, err
+	}
+	return _node, nil
+}
@@ -6,11 +6,11 @@ package main
 
 import (
 	"context"
-	"log"
 	"time"
 
 	httpClient "github.com/gobench-io/gobench/clients/http"
 	"github.com/gobench-io/gobench/dis"
+	"github.com/gobench-io/gobench/executor"
 	"github.com/gobench-io/gobench/executor/scenario"
 )
 
@@ -27,7 +27,7 @@ func export() scenario.Vus {
 func f(ctx context.Context, vui int) {
 	client, err := httpClient.NewHttpClient(ctx, "home")
 	if err != nil {
-		log.Println("create new client fail: " + err.Error())
+		executor.GetLogger(ctx).Errorw("create new client fail", "err", err)
 		return
 	}
 
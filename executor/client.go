@@ -37,6 +37,14 @@ func Notify(title string, value int64) error {
 	return clientConnect.Notify(title, value)
 }
 
+// Event marks a moment of the run on the timeline of the application, like
+// the start of a phase or a failover, so that the charts can overlay it
+//
+//	executor.Event("phase 2 started", map[string]string{"vus": "100"})
+func Event(name string, attrs map[string]string) error {
+	return getExecutor().Event(name, attrs)
+}
+
 // SetClientConnect setup new clientConnectInstance. Use to support testing only
 func SetClientConnect(cc ClientConnector) error {
 	clientConnectInstance = cc
@@ -35,6 +35,9 @@ var (
 
 	ErrAppCancel = errors.New("application is cancel")
 	ErrAppPanic  = errors.New("application is panic")
+
+	ErrEventName    = errors.New("event name is required")
+	ErrNotConnected = errors.New("executor is not connected to the agent")
 )
 
 type unit struct {
@@ -176,11 +179,13 @@ func (e *Executor) runScen(ctx context.Context, done chan<- error) {
 	var wg sync.WaitGroup
 	wg.Add(totalVu)
 
+	start := time.Now()
+
 	for i := range vus {
 		go func(i int) {
 			for j := 0; j < vus[i].Nu; j++ {
 				go func(i, j int) {
-					vus[i].Fu(ctx, j)
+					vus[i].Fu(withVu(ctx, i, j, start), j)
 					wg.Done()
 				}(i, j)
 				dis.SleepRatePoisson(vus[i].Rate)
@@ -407,3 +412,28 @@ func (e *Executor) Notify(title string, value int64) error {
 
 	return nil
 }
+
+// Event sends a timeline event to the master, with the current time
+func (e *Executor) Event(name string, attrs map[string]string) error {
+	if name == "" {
+		return ErrEventName
+	}
+
+	e.mu.Lock()
+	rc := e.rc
+	e.mu.Unlock()
+
+	if rc == nil {
+		return ErrNotConnected
+	}
+
+	_, err := rc.Event(context.TODO(), &pb.EventReq{
+		AppID: int64(e.appID),
+		EID:   e.id,
+		Time:  timestampMs(),
+		Name:  name,
+		Attrs: attrs,
+	})
+
+	return err
+}
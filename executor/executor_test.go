@@ -14,6 +14,7 @@ import (
 	"github.com/gobench-io/gobench/logger"
 	"github.com/gobench-io/gobench/pb"
 	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc"
 )
 
 func generate(t *testing.T) (string, string) {
@@ -180,3 +181,39 @@ func TestCancel(t *testing.T) {
 		t.Fatalf("Should have finish the running after cancel")
 	}
 }
+
+// eventLog records the events
+type eventLog struct {
+	*nopLog
+	reqs []*pb.EventReq
+}
+
+func (l *eventLog) Event(ctx context.Context, req *pb.EventReq, opts ...grpc.CallOption) (*pb.EventRes, error) {
+	l.reqs = append(l.reqs, req)
+	return new(pb.EventRes), nil
+}
+
+func TestEvent(t *testing.T) {
+	e, err := NewExecutor(&Options{AppID: 12}, logger.NewNopLogger())
+	assert.Nil(t, err)
+
+	// not connected yet
+	e.rc = nil
+	assert.Equal(t, ErrNotConnected, Event("phase 1 started", nil))
+
+	el := &eventLog{nopLog: newNopMetricLog()}
+	e.rc = el
+
+	assert.Equal(t, ErrEventName, Event("", nil))
+
+	before := timestampMs()
+	assert.Nil(t, Event("phase 2 started", map[string]string{"vus": "100"}))
+	assert.Len(t, el.reqs, 1)
+
+	req := el.reqs[0]
+	assert.Equal(t, int64(12), req.AppID)
+	assert.Equal(t, e.id, req.EID)
+	assert.Equal(t, "phase 2 started", req.Name)
+	assert.Equal(t, map[string]string{"vus": "100"}, req.Attrs)
+	assert.True(t, req.Time >= before)
+}
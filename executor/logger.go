@@ -0,0 +1,109 @@
+package executor
+
+import (
+	"context"
+	"io"
+	"os"
+	"sync"
+	"time"
+
+	"go.uber.org/zap"
+	"go.uber.org/zap/zapcore"
+)
+
+// vuKey is the context key of the virtual user information
+type vuKey struct{}
+
+// vuInfo identifies a virtual user of a run
+type vuInfo struct {
+	index int       // index of the vu in its group
+	group int       // index of the Vu group in the scenario Vus
+	start time.Time // start of the run
+}
+
+// withVu returns a context of a virtual user
+func withVu(ctx context.Context, group, index int, start time.Time) context.Context {
+	return context.WithValue(ctx, vuKey{}, &vuInfo{
+		index: index,
+		group: group,
+		start: start,
+	})
+}
+
+// Logger writes the scenario logs to the user log as JSON lines with the
+// level, the message, the executor ID, the virtual user index and group, and
+// the seconds elapsed since the start of the run
+type Logger struct {
+	s     *zap.SugaredLogger
+	start time.Time
+}
+
+// the scenario logs of the executor share one writer, so that the lines of
+// concurrent virtual users never interleave. The agent copies the executor
+// stdout to the user log.
+var (
+	baseLoggerOnce sync.Once
+	baseLogger     *zap.SugaredLogger
+)
+
+func newBaseLogger(w io.Writer, eID string) *zap.SugaredLogger {
+	core := zapcore.NewCore(
+		zapcore.NewJSONEncoder(zap.NewProductionEncoderConfig()),
+		zapcore.Lock(zapcore.AddSync(w)),
+		zap.DebugLevel,
+	)
+	return zap.New(core).Sugar().With("eid", eID)
+}
+
+func newLogger(base *zap.SugaredLogger, vu *vuInfo) *Logger {
+	if vu == nil {
+		return &Logger{s: base}
+	}
+	return &Logger{
+		s:     base.With("vu", vu.index, "group", vu.group),
+		start: vu.start,
+	}
+}
+
+// GetLogger returns the logger of the virtual user running with the
+// context. Outside of a virtual user, the lines have no vu and group fields.
+//
+//	func f(ctx context.Context, vui int) {
+//		log := executor.GetLogger(ctx)
+//		log.Infow("connected", "host", host)
+//	}
+func GetLogger(ctx context.Context) *Logger {
+	baseLoggerOnce.Do(func() {
+		baseLogger = newBaseLogger(os.Stdout, getExecutor().id)
+	})
+
+	vu, _ := ctx.Value(vuKey{}).(*vuInfo)
+	return newLogger(baseLogger, vu)
+}
+
+func (l *Logger) with(keysAndValues []interface{}) []interface{} {
+	if l.start.IsZero() {
+		return keysAndValues
+	}
+	return append(keysAndValues, "elapsed", time.Since(l.start).Seconds())
+}
+
+// Debugw logs a message at debug level with some key value pairs
+func (l *Logger) Debugw(msg string, keysAndValues ...interface{}) {
+	l.s.Debugw(msg, l.with(keysAndValues)...)
+}
+
+// Infow logs a message at info level with some key value pairs
+func (l *Logger) Infow(msg string, keysAndValues ...interface{}) {
+	l.s.Infow(msg, l.with(keysAndValues)...)
+}
+
+// Warnw logs a message at warn level with some key value pairs
+func (l *Logger) Warnw(msg string, keysAndValues ...interface{}) {
+	l.s.Warnw(msg, l.with(keysAndValues)...)
+}
+
+// Errorw logs a message at error level with some key value pairs
+func (l *Logger) Errorw(msg string, keysAndValues ...interface{}) {
+	l.s.Errorw(msg, l.with(keysAndValues)...)
+}
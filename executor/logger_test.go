@@ -0,0 +1,74 @@
+package executor
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/gobench-io/gobench/executor/scenario"
+	"github.com/gobench-io/gobench/logger"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestLogger(t *testing.T) {
+	var buf bytes.Buffer
+	base := newBaseLogger(&buf, "host-1")
+
+	vu := &vuInfo{index: 3, group: 1, start: time.Now().Add(-2 * time.Second)}
+	newLogger(base, vu).Warnw("slow response", "status", 200)
+	newLogger(base, nil).Infow("no vu")
+
+	lines := strings.Split(strings.TrimSpace(buf.String()), "\n")
+	assert.Len(t, lines, 2)
+
+	var l1 map[string]interface{}
+	assert.Nil(t, json.Unmarshal([]byte(lines[0]), &l1))
+	assert.Equal(t, "warn", l1["level"])
+	assert.Equal(t, "slow response", l1["msg"])
+	assert.Equal(t, "host-1", l1["eid"])
+	assert.Equal(t, 3.0, l1["vu"])
+	assert.Equal(t, 1.0, l1["group"])
+	assert.Equal(t, 200.0, l1["status"])
+	assert.InDelta(t, 2, l1["elapsed"], 0.5)
+
+	var l2 map[string]interface{}
+	assert.Nil(t, json.Unmarshal([]byte(lines[1]), &l2))
+	assert.Equal(t, "info", l2["level"])
+	assert.NotContains(t, l2, "vu")
+	assert.NotContains(t, l2, "elapsed")
+}
+
+func TestVuContext(t *testing.T) {
+	var mu sync.Mutex
+	seen := map[[2]int]bool{}
+
+	fu := func(ctx context.Context, vui int) {
+		vu, ok := ctx.Value(vuKey{}).(*vuInfo)
+		assert.True(t, ok)
+		assert.Equal(t, vui, vu.index)
+
+		mu.Lock()
+		seen[[2]int{vu.group, vu.index}] = true
+		mu.Unlock()
+	}
+
+	e, err := NewExecutor(&Options{
+		Vus: scenario.Vus{
+			{Nu: 2, Rate: 1000, Fu: fu},
+			{Nu: 1, Rate: 1000, Fu: fu},
+		},
+	}, logger.NewNopLogger())
+	assert.Nil(t, err)
+
+	done := make(chan error, 1)
+	e.runScen(context.Background(), done)
+	assert.Nil(t, <-done)
+
+	assert.Equal(t, map[[2]int]bool{
+		{0, 0}: true, {0, 1}: true, {1, 0}: true,
+	}, seen)
+}
@@ -34,6 +34,10 @@ func (n *nopLog) FindCreateMetric(ctx context.Context, req *pb.FCMetricReq, opts
 	return new(pb.FCMetricRes), nil
 }
 
+func (n *nopLog) Event(ctx context.Context, req *pb.EventReq, opts ...grpc.CallOption) (*pb.EventRes, error) {
+	return new(pb.EventRes), nil
+}
+
 // NewNopMetricLog returns a no-op metric logger
 func newNopMetricLog() *nopLog {
 	return &nopLog{}
@@ -0,0 +1,305 @@
+package expr
+
+import (
+	"context"
+	"math"
+	"sort"
+)
+
+// MaxPoints is the largest number of steps of an evaluation
+const MaxPoints = 11000
+
+// Point is the value of a series at a time in ms
+type Point struct {
+	Time  int64   `json:"time"`
+	Value float64 `json:"value"`
+}
+
+// Series is the points of an executor, or of every executor once aggregated
+// with an empty executor ID
+type Series struct {
+	EID    string  `json:"eId"`
+	Points []Point `json:"points"`
+}
+
+// Source gives the points of the field of a metric by executor ID, in time
+// order, after from (exclusive) until end (inclusive). The field is empty
+// for the default field of the metric. A fault of the selector is an Error.
+type Source interface {
+	Series(ctx context.Context, title, field string, from, end int64) (map[string][]Point, error)
+}
+
+// grid is the n times of an evaluation, every step from start. The value at
+// a time is the last point of the step ending at that time.
+type grid struct {
+	start, step int64
+	n           int
+}
+
+func (g grid) time(i int) int64 {
+	return g.start + int64(i)*g.step
+}
+
+// value is a scalar, or series by executor ID on the grid with NaN where
+// there is no point
+type value struct {
+	scalar bool
+	v      float64
+	series map[string][]float64
+}
+
+func (g grid) series() []float64 {
+	vs := make([]float64, g.n)
+	for i := range vs {
+		vs[i] = math.NaN()
+	}
+	return vs
+}
+
+type evaluator struct {
+	ctx context.Context
+	src Source
+}
+
+// Eval evaluates the expression every step ms after from until end, in ms.
+// The steps are aligned on the epoch and the series sorted by executor ID.
+func (e *Expr) Eval(ctx context.Context, src Source, from, end, step int64) ([]Series, error) {
+	if step <= 0 {
+		return nil, Errorf("step must be positive")
+	}
+	// the first step after from
+	start := (from/step + 1) * step
+	if end < start {
+		return nil, Errorf("end must be after from")
+	}
+	n := (end-start)/step + 1
+	if n > MaxPoints {
+		return nil, Errorf("more than %d steps, increase the step", MaxPoints)
+	}
+	g := grid{start, step, int(n)}
+
+	ev := &evaluator{ctx, src}
+	v, err := ev.eval(e.root, g)
+	if err != nil {
+		return nil, err
+	}
+	if v.scalar {
+		vs := g.series()
+		for i := range vs {
+			vs[i] = v.v
+		}
+		v.series = map[string][]float64{"": vs}
+	}
+
+	ss := []Series{}
+	for eID, vs := range v.series {
+		s := Series{EID: eID, Points: []Point{}}
+		for i, x := range vs {
+			if !math.IsNaN(x) && !math.IsInf(x, 0) {
+				s.Points = append(s.Points, Point{g.time(i), x})
+			}
+		}
+		if len(s.Points) > 0 {
+			ss = append(ss, s)
+		}
+	}
+	sort.Slice(ss, func(i, j int) bool { return ss[i].EID < ss[j].EID })
+
+	return ss, nil
+}
+
+func (ev *evaluator) eval(n node, g grid) (*value, error) {
+	switch n := n.(type) {
+	case *numberNode:
+		return &value{scalar: true, v: n.v}, nil
+	case *selectorNode:
+		return ev.selector(n, g)
+	case *negNode:
+		x, err := ev.eval(n.x, g)
+		if err != nil {
+			return nil, err
+		}
+		return apply(x, func(v float64) float64 { return -v }), nil
+	case *binaryNode:
+		l, err := ev.eval(n.l, g)
+		if err != nil {
+			return nil, err
+		}
+		r, err := ev.eval(n.r, g)
+		if err != nil {
+			return nil, err
+		}
+		return binary(n.op, l, r), nil
+	case *callNode:
+		return ev.call(n, g)
+	}
+	return nil, Errorf("unknown node %T", n)
+}
+
+func (ev *evaluator) selector(n *selectorNode, g grid) (*value, error) {
+	ps, err := ev.src.Series(ev.ctx, n.title, n.field, g.start-g.step, g.time(g.n-1))
+	if err != nil {
+		return nil, err
+	}
+
+	v := &value{series: map[string][]float64{}}
+	for eID, points := range ps {
+		vs := g.series()
+		for _, p := range points {
+			d := p.Time - g.start
+			i := d / g.step
+			if d%g.step > 0 {
+				i++
+			}
+			if i >= 0 && i < int64(g.n) {
+				vs[i] = p.Value
+			}
+		}
+		v.series[eID] = vs
+	}
+	return v, nil
+}
+
+func (ev *evaluator) call(n *callNode, g grid) (*value, error) {
+	switch n.name {
+	case "rate":
+		// one more step for the increase of the first step
+		x, err := ev.eval(n.args[0], grid{g.start - g.step, g.step, g.n + 1})
+		if err != nil {
+			return nil, err
+		}
+		if x.scalar {
+			return nil, Errorf("rate expects a series")
+		}
+		v := &value{series: map[string][]float64{}}
+		secs := float64(g.step) / 1000
+		for eID, xs := range x.series {
+			vs := g.series()
+			for i := range vs {
+				inc := xs[i+1] - xs[i]
+				if inc < 0 {
+					// the counter restarted
+					inc = xs[i+1]
+				}
+				vs[i] = inc / secs
+			}
+			v.series[eID] = vs
+		}
+		return v, nil
+	case "shift":
+		return ev.eval(n.args[0], grid{g.start - n.d, g.step, g.n})
+	}
+
+	// the aggregations over the executors
+	x, err := ev.eval(n.args[0], g)
+	if err != nil || x.scalar {
+		return x, err
+	}
+	vs := g.series()
+	for i := range vs {
+		count, r := 0, math.NaN()
+		for _, xs := range x.series {
+			if math.IsNaN(xs[i]) {
+				continue
+			}
+			switch {
+			case count == 0:
+				r = xs[i]
+			case n.name == "min":
+				r = math.Min(r, xs[i])
+			case n.name == "max":
+				r = math.Max(r, xs[i])
+			default:
+				r += xs[i]
+			}
+			count++
+		}
+		if n.name == "avg" && count > 0 {
+			r /= float64(count)
+		}
+		vs[i] = r
+	}
+	return &value{series: map[string][]float64{"": vs}}, nil
+}
+
+// apply maps the values of a scalar or of series
+func apply(x *value, f func(float64) float64) *value {
+	if x.scalar {
+		return &value{scalar: true, v: f(x.v)}
+	}
+	v := &value{series: map[string][]float64{}}
+	for eID, xs := range x.series {
+		vs := make([]float64, len(xs))
+		for i := range xs {
+			vs[i] = f(xs[i])
+		}
+		v.series[eID] = vs
+	}
+	return v
+}
+
+func op(o string, l, r float64) float64 {
+	switch o {
+	case "+":
+		return l + r
+	case "-":
+		return l - r
+	case "*":
+		return l * r
+	}
+	if r == 0 {
+		return math.NaN()
+	}
+	return l / r
+}
+
+// aggregated returns the series of an aggregated value, nil otherwise
+func aggregated(x *value) []float64 {
+	if len(x.series) != 1 {
+		return nil
+	}
+	return x.series[""]
+}
+
+// binary applies an operator to two values. The series of two values are
+// matched by executor ID, an aggregated series matches every executor.
+func binary(o string, l, r *value) *value {
+	switch {
+	case l.scalar && r.scalar:
+		return &value{scalar: true, v: op(o, l.v, r.v)}
+	case l.scalar:
+		return apply(r, func(v float64) float64 { return op(o, l.v, v) })
+	case r.scalar:
+		return apply(l, func(v float64) float64 { return op(o, v, r.v) })
+	}
+
+	v := &value{series: map[string][]float64{}}
+	lAgg, rAgg := aggregated(l), aggregated(r)
+	for eID, ls := range l.series {
+		rs, ok := r.series[eID]
+		if !ok && rAgg != nil {
+			rs, ok = rAgg, true
+		}
+		if !ok {
+			continue
+		}
+		vs := make([]float64, len(ls))
+		for i := range ls {
+			vs[i] = op(o, ls[i], rs[i])
+		}
+		v.series[eID] = vs
+	}
+	if lAgg == nil || rAgg != nil {
+		return v
+	}
+	// an aggregated left value, with every executor of the right value
+	v.series = map[string][]float64{}
+	for eID, rs := range r.series {
+		vs := make([]float64, len(rs))
+		for i := range rs {
+			vs[i] = op(o, lAgg[i], rs[i])
+		}
+		v.series[eID] = vs
+	}
+	return v
+}
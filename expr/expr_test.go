@@ -0,0 +1,163 @@
+package expr
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// source has the series of 2 executors every 10 seconds from 10s to 60s:
+// ok counts 10 per second, fail 1 per second from e1 only, lat is i ms for
+// e1 and 10*i ms for e2
+type source struct{}
+
+func (source) Series(ctx context.Context, title, field string, from, end int64) (map[string][]Point, error) {
+	ps := map[string][]Point{}
+	for i := int64(1); i <= 6; i++ {
+		t := i * 10000
+		if t <= from || t > end {
+			continue
+		}
+		switch title {
+		case "home.ok":
+			ps["e1"] = append(ps["e1"], Point{t, float64(i * 100)})
+			ps["e2"] = append(ps["e2"], Point{t, float64(i * 100)})
+		case "home.fail":
+			ps["e1"] = append(ps["e1"], Point{t, float64(i * 10)})
+		case "home.lat":
+			if field != "p99" {
+				return nil, Errorf("no field %q", field)
+			}
+			ps["e1"] = append(ps["e1"], Point{t, float64(i)})
+			ps["e2"] = append(ps["e2"], Point{t, float64(10 * i)})
+		default:
+			return nil, Errorf("no metric %q", title)
+		}
+	}
+	return ps, nil
+}
+
+func eval(t *testing.T, s string, from, end int64) []Series {
+	e, err := Parse(s)
+	if !assert.Nil(t, err, s) {
+		return nil
+	}
+	ss, err := e.Eval(context.Background(), source{}, from, end, 10000)
+	assert.Nil(t, err, s)
+	return ss
+}
+
+func values(s Series) []float64 {
+	vs := []float64{}
+	for _, p := range s.Points {
+		vs = append(vs, p.Value)
+	}
+	return vs
+}
+
+func TestParse(t *testing.T) {
+	e, err := Parse(`rate(home.ok) / sum("my metric":p99) + shift(-home.fail, 1m30s) * 2.5`)
+	assert.Nil(t, err)
+	assert.Equal(t, []string{"home.ok", "my metric", "home.fail"}, e.Selectors())
+
+	for s, msg := range map[string]string{
+		"":                   "unexpected end of expression at 0",
+		"home.ok +":          "unexpected end of expression at 9",
+		"(home.ok":           `expected ")" at 8`,
+		"foo(home.ok)":       `unknown function "foo" at 0`,
+		"shift(home.ok)":     `expected ",", got ")" at 13`,
+		"shift(home.ok, 5)":  "shift expects a duration like 5m at 15",
+		"shift(home.ok, 5x)": `invalid duration "5x" at 15`,
+		"home.ok home.fail":  `unexpected "home.fail" at 8`,
+		`"home.ok`:           "unterminated string at 0",
+		"home.ok:":           "expected a field at 8",
+		"home.ok # 2":        `unexpected '#' at 8`,
+	} {
+		_, err := Parse(s)
+		var e *Error
+		if assert.True(t, errors.As(err, &e), s) {
+			assert.EqualError(t, err, msg, s)
+		}
+	}
+}
+
+func TestEval(t *testing.T) {
+	t.Run("scalars", func(t *testing.T) {
+		ss := eval(t, "1 + 2 * -3", 0, 30000)
+		assert.Equal(t, []Series{{"", []Point{{10000, -5}, {20000, -5}, {30000, -5}}}}, ss)
+	})
+
+	t.Run("selectors by executor", func(t *testing.T) {
+		ss := eval(t, "home.ok / 100", 0, 30000)
+		assert.Len(t, ss, 2)
+		assert.Equal(t, "e1", ss[0].EID)
+		assert.Equal(t, []float64{1, 2, 3}, values(ss[0]))
+		assert.Equal(t, int64(10000), ss[0].Points[0].Time)
+	})
+
+	t.Run("error rate", func(t *testing.T) {
+		// e2 has no failures, its series is not matched
+		ss := eval(t, "home.fail / (home.ok + home.fail)", 0, 60000)
+		assert.Len(t, ss, 1)
+		assert.Equal(t, "e1", ss[0].EID)
+		assert.InDelta(t, 10.0/110, ss[0].Points[0].Value, 1e-9)
+
+		ss = eval(t, "sum(home.fail) / sum(home.ok)", 0, 60000)
+		assert.Len(t, ss, 1)
+		assert.Equal(t, "", ss[0].EID)
+		assert.Equal(t, 0.05, ss[0].Points[5].Value)
+	})
+
+	t.Run("aggregations", func(t *testing.T) {
+		assert.Equal(t, []float64{10, 20}, values(eval(t, "max(home.lat:p99)", 0, 20000)[0]))
+		assert.Equal(t, []float64{1, 2}, values(eval(t, "min(home.lat:p99)", 0, 20000)[0]))
+		assert.Equal(t, []float64{11, 22}, values(eval(t, "sum(home.lat:p99)", 0, 20000)[0]))
+		assert.Equal(t, []float64{5.5, 11}, values(eval(t, "avg(home.lat:p99)", 0, 20000)[0]))
+	})
+
+	t.Run("an aggregated series matches every executor", func(t *testing.T) {
+		ss := eval(t, "home.lat:p99 / max(home.lat:p99)", 0, 10000)
+		assert.Len(t, ss, 2)
+		assert.Equal(t, []float64{0.1}, values(ss[0]))
+		assert.Equal(t, []float64{1}, values(ss[1]))
+	})
+
+	t.Run("rate", func(t *testing.T) {
+		ss := eval(t, "sum(rate(home.ok))", 10000, 40000)
+		// the first step has no previous point
+		assert.Equal(t, []float64{20, 20, 20}, values(ss[0]))
+		assert.Equal(t, int64(20000), ss[0].Points[0].Time)
+	})
+
+	t.Run("shift", func(t *testing.T) {
+		ss := eval(t, "home.fail - shift(home.fail, 20s)", 0, 60000)
+		assert.Equal(t, []float64{20, 20, 20, 20}, values(ss[0]))
+		assert.Equal(t, int64(30000), ss[0].Points[0].Time)
+	})
+
+	t.Run("division by zero has no point", func(t *testing.T) {
+		ss := eval(t, "home.ok / 0", 0, 60000)
+		assert.Len(t, ss, 0)
+	})
+
+	t.Run("faults", func(t *testing.T) {
+		for s, msg := range map[string]string{
+			"home.foo": `no metric "home.foo"`,
+			"home.lat": `no field ""`,
+			"rate(2)":  "rate expects a series",
+		} {
+			e, err := Parse(s)
+			assert.Nil(t, err)
+			_, err = e.Eval(context.Background(), source{}, 0, 60000, 10000)
+			assert.EqualError(t, err, msg)
+		}
+
+		e, _ := Parse("home.ok")
+		_, err := e.Eval(context.Background(), source{}, 0, (MaxPoints+1)*10000, 10000)
+		assert.EqualError(t, err, "more than 11000 steps, increase the step")
+		_, err = e.Eval(context.Background(), source{}, 60000, 0, 10000)
+		assert.EqualError(t, err, "end must be after from")
+	})
+}
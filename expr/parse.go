@@ -0,0 +1,369 @@
+// Package expr is a small expression language over the metric series of a
+// run. An expression combines metric selectors with numbers, arithmetic and
+// functions:
+//
+//	home.http_fail / (home.http_ok + home.http_fail)
+//	max(home.latency:p99)
+//	rate(home.http_ok) - shift(rate(home.http_ok), 1m)
+//
+// A selector is a metric title, quoted when it has other characters than
+// letters, digits, '_' and '.', with an optional field after a ':'. A title
+// in several groups is selected as "group/title". A selector has a series per
+// executor. sum, avg, min and max aggregate the
+// series of the executors, rate is the per second increase of a counter and
+// shift moves a series later in time by a duration.
+package expr
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+	"unicode"
+)
+
+// Error is a fault of an expression, with the position of the fault when it
+// is a syntax error
+type Error struct {
+	Pos int // byte offset, -1 when unknown
+	Msg string
+}
+
+func (e *Error) Error() string {
+	if e.Pos < 0 {
+		return e.Msg
+	}
+	return fmt.Sprintf("%s at %d", e.Msg, e.Pos)
+}
+
+// Errorf returns the Error of an expression
+func Errorf(format string, args ...interface{}) error {
+	return &Error{Pos: -1, Msg: fmt.Sprintf(format, args...)}
+}
+
+// functions are the functions with their number of arguments
+var functions = map[string]int{
+	"rate":  1,
+	"sum":   1,
+	"avg":   1,
+	"min":   1,
+	"max":   1,
+	"shift": 2,
+}
+
+type tokenKind int
+
+const (
+	tokEOF tokenKind = iota
+	tokNumber
+	tokDuration
+	tokIdent
+	tokString
+	tokOp // + - * / ( ) , :
+)
+
+type token struct {
+	kind tokenKind
+	text string
+	pos  int
+}
+
+// lex splits an expression into tokens
+func lex(s string) ([]token, error) {
+	var ts []token
+	isIdent := func(r rune) bool {
+		return r == '_' || r == '.' || unicode.IsLetter(r) || unicode.IsDigit(r)
+	}
+
+	for i := 0; i < len(s); {
+		c := rune(s[i])
+		switch {
+		case unicode.IsSpace(c):
+			i++
+		case strings.ContainsRune("+-*/(),:", c):
+			ts = append(ts, token{tokOp, string(c), i})
+			i++
+		case c == '"':
+			j := strings.IndexByte(s[i+1:], '"')
+			if j < 0 {
+				return nil, &Error{i, "unterminated string"}
+			}
+			ts = append(ts, token{tokString, s[i+1 : i+1+j], i})
+			i += j + 2
+		case unicode.IsDigit(c):
+			j := i
+			for j < len(s) && (unicode.IsDigit(rune(s[j])) || s[j] == '.') {
+				j++
+			}
+			// a duration like 1m30s
+			k := j
+			if k < len(s) && unicode.IsLetter(rune(s[k])) {
+				for k < len(s) && (isIdent(rune(s[k])) && s[k] != '_') {
+					k++
+				}
+			}
+			if k > j {
+				ts = append(ts, token{tokDuration, s[i:k], i})
+			} else {
+				ts = append(ts, token{tokNumber, s[i:j], i})
+			}
+			i = k
+		case isIdent(c):
+			j := i
+			for j < len(s) && isIdent(rune(s[j])) {
+				j++
+			}
+			ts = append(ts, token{tokIdent, s[i:j], i})
+			i = j
+		default:
+			return nil, &Error{i, fmt.Sprintf("unexpected %q", c)}
+		}
+	}
+
+	return append(ts, token{tokEOF, "", len(s)}), nil
+}
+
+// node is a node of the syntax tree
+type node interface{}
+
+type numberNode struct {
+	v float64
+}
+
+type selectorNode struct {
+	title string
+	field string
+}
+
+type negNode struct {
+	x node
+}
+
+type binaryNode struct {
+	op   string
+	l, r node
+}
+
+type callNode struct {
+	name string
+	args []node
+	d    int64 // ms, the duration of shift
+}
+
+// Expr is a parsed expression
+type Expr struct {
+	s    string
+	root node
+}
+
+func (e *Expr) String() string {
+	return e.s
+}
+
+// Selectors returns the metric titles of the expression
+func (e *Expr) Selectors() []string {
+	var titles []string
+	var walk func(n node)
+	walk = func(n node) {
+		switch n := n.(type) {
+		case *selectorNode:
+			titles = append(titles, n.title)
+		case *negNode:
+			walk(n.x)
+		case *binaryNode:
+			walk(n.l)
+			walk(n.r)
+		case *callNode:
+			for _, a := range n.args {
+				walk(a)
+			}
+		}
+	}
+	walk(e.root)
+	return titles
+}
+
+type parser struct {
+	ts []token
+	i  int
+}
+
+// Parse parses an expression
+func Parse(s string) (*Expr, error) {
+	ts, err := lex(s)
+	if err != nil {
+		return nil, err
+	}
+	p := &parser{ts: ts}
+	root, err := p.expr()
+	if err != nil {
+		return nil, err
+	}
+	if t := p.peek(); t.kind != tokEOF {
+		return nil, &Error{t.pos, fmt.Sprintf("unexpected %q", t.text)}
+	}
+	return &Expr{s, root}, nil
+}
+
+func (p *parser) peek() token {
+	return p.ts[p.i]
+}
+
+func (p *parser) next() token {
+	t := p.ts[p.i]
+	if t.kind != tokEOF {
+		p.i++
+	}
+	return t
+}
+
+func (p *parser) isOp(ops ...string) bool {
+	t := p.peek()
+	if t.kind != tokOp {
+		return false
+	}
+	for _, op := range ops {
+		if t.text == op {
+			return true
+		}
+	}
+	return false
+}
+
+func (p *parser) expect(op string) error {
+	if !p.isOp(op) {
+		t := p.peek()
+		if t.kind == tokEOF {
+			return &Error{t.pos, fmt.Sprintf("expected %q", op)}
+		}
+		return &Error{t.pos, fmt.Sprintf("expected %q, got %q", op, t.text)}
+	}
+	p.next()
+	return nil
+}
+
+// expr := term (('+' | '-') term)*
+func (p *parser) expr() (node, error) {
+	l, err := p.term()
+	if err != nil {
+		return nil, err
+	}
+	for p.isOp("+", "-") {
+		op := p.next().text
+		r, err := p.term()
+		if err != nil {
+			return nil, err
+		}
+		l = &binaryNode{op, l, r}
+	}
+	return l, nil
+}
+
+// term := unary (('*' | '/') unary)*
+func (p *parser) term() (node, error) {
+	l, err := p.unary()
+	if err != nil {
+		return nil, err
+	}
+	for p.isOp("*", "/") {
+		op := p.next().text
+		r, err := p.unary()
+		if err != nil {
+			return nil, err
+		}
+		l = &binaryNode{op, l, r}
+	}
+	return l, nil
+}
+
+// unary := '-' unary | primary
+func (p *parser) unary() (node, error) {
+	if p.isOp("-") {
+		p.next()
+		x, err := p.unary()
+		if err != nil {
+			return nil, err
+		}
+		return &negNode{x}, nil
+	}
+	return p.primary()
+}
+
+// primary := number | '(' expr ')' | ident '(' args ')' | selector
+func (p *parser) primary() (node, error) {
+	t := p.next()
+	switch t.kind {
+	case tokNumber:
+		v, err := strconv.ParseFloat(t.text, 64)
+		if err != nil {
+			return nil, &Error{t.pos, fmt.Sprintf("invalid number %q", t.text)}
+		}
+		return &numberNode{v}, nil
+	case tokOp:
+		if t.text != "(" {
+			break
+		}
+		x, err := p.expr()
+		if err != nil {
+			return nil, err
+		}
+		return x, p.expect(")")
+	case tokIdent:
+		if p.isOp("(") {
+			return p.call(t)
+		}
+		return p.selector(t)
+	case tokString:
+		return p.selector(t)
+	case tokEOF:
+		return nil, &Error{t.pos, "unexpected end of expression"}
+	}
+	return nil, &Error{t.pos, fmt.Sprintf("unexpected %q", t.text)}
+}
+
+// selector := (ident | string) [':' ident]
+func (p *parser) selector(t token) (node, error) {
+	n := &selectorNode{title: t.text}
+	if p.isOp(":") {
+		p.next()
+		f := p.next()
+		if f.kind != tokIdent {
+			return nil, &Error{f.pos, "expected a field"}
+		}
+		n.field = f.text
+	}
+	return n, nil
+}
+
+// call := ident '(' expr [',' duration] ')'
+func (p *parser) call(t token) (node, error) {
+	arity, ok := functions[t.text]
+	if !ok {
+		return nil, &Error{t.pos, fmt.Sprintf("unknown function %q", t.text)}
+	}
+	p.next() // (
+
+	n := &callNode{name: t.text}
+	x, err := p.expr()
+	if err != nil {
+		return nil, err
+	}
+	n.args = append(n.args, x)
+
+	if arity == 2 {
+		if err := p.expect(","); err != nil {
+			return nil, err
+		}
+		d := p.next()
+		if d.kind != tokDuration {
+			return nil, &Error{d.pos, fmt.Sprintf("%s expects a duration like 5m", t.text)}
+		}
+		dur, err := time.ParseDuration(d.text)
+		if err != nil {
+			return nil, &Error{d.pos, fmt.Sprintf("invalid duration %q", d.text)}
+		}
+		n.d = dur.Milliseconds()
+	}
+
+	return n, p.expect(")")
+}
@@ -9,9 +9,11 @@ require (
 	github.com/go-chi/cors v1.0.1
 	github.com/go-chi/jwtauth v4.0.4+incompatible
 	github.com/go-chi/render v1.0.1
+	github.com/go-sql-driver/mysql v1.7.1
 	github.com/golang-jwt/jwt v3.2.2+incompatible
 	github.com/golang/protobuf v1.4.3
 	github.com/google/uuid v1.1.2
+	github.com/lib/pq v1.10.9
 	github.com/mackerelio/go-osstat v0.1.0
 	github.com/mattn/go-sqlite3 v1.14.3
 	github.com/nats-io/nats-server/v2 v2.3.4 // indirect
@@ -19,6 +21,7 @@ require (
 	github.com/rcrowley/go-metrics v0.0.0-20190826022208-cac0b30c2563
 	github.com/stretchr/testify v1.6.1
 	go.uber.org/zap v1.15.0
+	golang.org/x/crypto v0.0.0-20210921155107-089bfa567519
 	golang.org/x/text v0.3.8 // indirect
 	google.golang.org/genproto v0.0.0-20210121164019-fc48d45331c7 // indirect
 	google.golang.org/grpc v1.36.0
@@ -1,9 +1,13 @@
 package main
 
 import (
+	"context"
+	"encoding/json"
 	"flag"
 	"fmt"
+	"io/ioutil"
 	"os"
+	"os/signal"
 
 	"github.com/gobench-io/gobench/logger"
 	"github.com/gobench-io/gobench/master"
@@ -16,7 +20,7 @@ import (
 var usageStr = `
 Usage: gobench [options]
 
-    --mode <mode>       Server mode. Must be one of the master, agent mode.
+    --mode <mode>       Server mode. Must be one of the master, agent, report, run mode.
                         Default is master
     --cluster-port <port>   Cluster port to solicit and connect (default: 6890)
                             Master and agent are required to have this option
@@ -28,11 +32,47 @@ Master Options:
     -p, --port <port>   Use port for web client (default: 8080).
     --dir <dir path>    Working directory (default: ${HOME}). The result database and logs will be stored on this folder.
     -db <file>          Location for the server database (default: ${HOME}/gobench.sqlite3)
+    --db-driver <name>  Database driver: sqlite3, postgres or mysql (default: sqlite3)
+    --db-dsn <dsn>      Database source. The sqlite3 file (default: <dir>/gobench.sqlite3),
+                        postgres://user:pw@host/db?sslmode=disable or user:pw@tcp(host:3306)/db.
+                        The schema is created or migrated on start.
     --admin-password    Password required to login web dashboard
+    --raw-retention <d>         Downsample the metric points older than d (default: 0, keeps them)
+    --rollup-retention <d>      Delete the metric points older than d (default: 0, keeps them)
+    --rollup-interval <d>       Interval of the downsampled points (default: 1m)
+    --maintenance-interval <d>  Period of the database maintenance, downsampling,
+                                deleting then vacuuming (default: 24h, 0 disables it)
+    --smtp-host <host>          SMTP server of the email notifications, none without it
+    --smtp-port <port>          Port of the SMTP server (default: 587)
+    --smtp-username <name>      SMTP username, no authentication without it
+    --smtp-password <password>  SMTP password
+    --smtp-from <address>       Sender address of the email notifications
+    --dashboard-url <url>       Base URL of the dashboard links in the emails
+                                (default: http://<hostname>:<port>)
 
 Agent Options:
     --route <host:port> The master address to solicit routes.
                         Every worker must have this option sothat worker can connect to a master
+
+Report Options:
+    --app-id <id>       Application to report
+    --output <file>     HTML report file (default: application-<id>.html)
+    --dir <dir path>    Working directory of the master that ran the application
+    --db-driver, --db-dsn  Database of the master that ran the application
+
+Run Options:
+    --scenario <file>   Scenario to run headless, without the web server
+    --gomod <file>      go.mod of the scenario
+    --gosum <file>      go.sum of the scenario
+    --name <name>       Application name (default: the scenario file name)
+    --tags <a,b>        Comma separated tags, a tag with a baseline judges the run
+    --junit <file>      Write the result as JUnit XML
+    --result <file>     Write the result as JSON
+    --dir <dir path>    Working directory (default: a temporary one). Set it, or
+                        --db-dsn, to the database of a master to judge the run
+                        against its baselines
+    --db-driver, --db-dsn  Database of the run, as the master
+                        The program exits with 1 when the run fails or regresses
 `
 
 func usage() {
@@ -70,9 +110,25 @@ func main() {
 
 	if opts.Mode == Master {
 		m, err := master.NewMaster(&master.Options{
-			Port:    opts.Port,
-			Program: opts.Program,
-			HomeDir: opts.Dir,
+			Port:     opts.Port,
+			Program:  opts.Program,
+			HomeDir:  opts.Dir,
+			DbDriver: opts.DbDriver,
+			DbDSN:    opts.DbDSN,
+			Retention: master.Retention{
+				Raw:      opts.RawRetention,
+				Rollup:   opts.RollupRetention,
+				Interval: opts.RollupInterval,
+				Every:    opts.MaintenanceInterval,
+			},
+			SMTP: master.SMTP{
+				Host:     opts.SMTPHost,
+				Port:     opts.SMTPPort,
+				Username: opts.SMTPUsername,
+				Password: opts.SMTPPassword,
+				From:     opts.SMTPFrom,
+			},
+			DashboardURL: opts.DashboardURL,
 		}, logger)
 		if err != nil {
 			printAndDie(fmt.Sprintf("%s: %s", exe, err))
@@ -91,4 +147,165 @@ func main() {
 
 		return
 	}
+
+	if opts.Mode == Report {
+		if err = writeReport(opts, logger); err != nil {
+			printAndDie(fmt.Sprintf("%s: %s", exe, err))
+		}
+		return
+	}
+
+	if opts.Mode == Run {
+		passed, err := runHeadless(opts, logger)
+		if err != nil {
+			printAndDie(fmt.Sprintf("%s: %s", exe, err))
+		}
+		if !passed {
+			os.Exit(1)
+		}
+		return
+	}
+}
+
+// writeReport writes the HTML report of an application of the master
+// database to a file
+func writeReport(opts *Options, logger logger.Logger) error {
+	m, err := master.NewMaster(&master.Options{
+		Program:  opts.Program,
+		HomeDir:  opts.Dir,
+		DbDriver: opts.DbDriver,
+		DbDSN:    opts.DbDSN,
+	}, logger)
+	if err != nil {
+		return err
+	}
+	if err = m.OpenDbReadOnly(); err != nil {
+		return err
+	}
+
+	f, err := os.Create(opts.Output)
+	if err != nil {
+		return err
+	}
+
+	if err = m.WriteReport(context.Background(), opts.AppID, f); err != nil {
+		f.Close()
+		return err
+	}
+	if err = f.Close(); err != nil {
+		return err
+	}
+
+	fmt.Printf("report of application %d written to %s\n", opts.AppID, opts.Output)
+
+	return nil
+}
+
+// readOptionalFile returns the content of a file, empty when there is no
+// filename
+func readOptionalFile(filename string) (string, error) {
+	if filename == "" {
+		return "", nil
+	}
+	b, err := ioutil.ReadFile(filename)
+	return string(b), err
+}
+
+// runHeadless runs a scenario on a master without the web server, waits for
+// the end of the run and writes its result files. It returns whether the run
+// passed, an interrupted run is cancelled and does not pass.
+func runHeadless(opts *Options, logger logger.Logger) (bool, error) {
+	scenario, err := readOptionalFile(opts.Scenario)
+	if err != nil {
+		return false, err
+	}
+	gomod, err := readOptionalFile(opts.Gomod)
+	if err != nil {
+		return false, err
+	}
+	gosum, err := readOptionalFile(opts.Gosum)
+	if err != nil {
+		return false, err
+	}
+
+	// without --dir, the run does not touch the database of a master
+	dir := opts.Dir
+	if dir == "" {
+		if dir, err = ioutil.TempDir("", "gobench-run-"); err != nil {
+			return false, err
+		}
+		defer os.RemoveAll(dir)
+	}
+
+	m, err := master.NewMaster(&master.Options{
+		Program:  opts.Program,
+		HomeDir:  dir,
+		DbDriver: opts.DbDriver,
+		DbDSN:    opts.DbDSN,
+	}, logger)
+	if err != nil {
+		return false, err
+	}
+	// the run leaves the other applications of a shared database to their
+	// master
+	m.SetIsScheduled(false).SetHandleSignals(false)
+	if err = m.Start(); err != nil {
+		return false, err
+	}
+
+	ctx := context.Background()
+
+	// the tags are read when the run is judged, after it ends
+	app, err := m.NewApplication(ctx, opts.Name, scenario, gomod, gosum, master.WithoutScheduler())
+	if err != nil {
+		return false, err
+	}
+	for _, t := range opts.Tags {
+		if _, err = m.SetApplicationTag(ctx, app.ID, t); err != nil {
+			return false, err
+		}
+	}
+
+	// an interrupt cancels the run, which then ends like the others, and
+	// the temporary directory is removed
+	runCtx, stop := signal.NotifyContext(ctx, os.Interrupt)
+	err = m.RunApplication(runCtx, app.ID)
+	stop()
+	if err != nil {
+		return false, err
+	}
+
+	res, err := m.ApplicationResult(ctx, app.ID)
+	if err != nil {
+		return false, err
+	}
+
+	if opts.JUnit != "" {
+		f, err := os.Create(opts.JUnit)
+		if err != nil {
+			return false, err
+		}
+		if err = res.WriteJUnit(f); err != nil {
+			f.Close()
+			return false, err
+		}
+		if err = f.Close(); err != nil {
+			return false, err
+		}
+	}
+
+	if opts.Result != "" {
+		b, err := json.MarshalIndent(res, "", "  ")
+		if err != nil {
+			return false, err
+		}
+		if err = ioutil.WriteFile(opts.Result, append(b, '\n'), 0644); err != nil {
+			return false, err
+		}
+	}
+
+	fmt.Printf("application %d %s, verdict %q, %d requests, %d errors, %.2f rps, p95 %.2f\n",
+		res.ID, res.Status, res.Verdict, res.Requests, res.Errors, res.RPS, res.P95)
+
+	return res.Passed, nil
 }
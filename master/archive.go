@@ -0,0 +1,650 @@
+package master
+
+import (
+	"archive/tar"
+	"bufio"
+	"bytes"
+	"compress/gzip"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"os"
+	"time"
+
+	"github.com/gobench-io/gobench/ent"
+	"github.com/gobench-io/gobench/executor/metrics"
+
+	entApp "github.com/gobench-io/gobench/ent/application"
+	entCounter "github.com/gobench-io/gobench/ent/counter"
+	entGauge "github.com/gobench-io/gobench/ent/gauge"
+	entHistogram "github.com/gobench-io/gobench/ent/histogram"
+)
+
+// archiveVersion is the version of the archive format
+const archiveVersion = 1
+
+// archive entries
+const (
+	archiveManifest  = "manifest.json"
+	archiveScenario  = "scenario.go"
+	archiveGomod     = "go.mod"
+	archiveGosum     = "go.sum"
+	archiveMetrics   = "metrics.jsonl"
+	archiveSystemLog = "logs/system.log"
+	archiveUserLog   = "logs/user.log"
+)
+
+// the limits of the entries of an imported archive, the small entries are
+// read in memory, the metrics and the logs are spooled to disk
+const (
+	archiveMaxFile  = 16 << 20
+	archiveMaxSpool = 2 << 30
+)
+
+// archive errors
+var (
+	ErrArchiveUnfinished = errors.New("cannot archive an unfinished application")
+	ErrArchiveInvalid    = errors.New("invalid archive")
+	ErrAppIsReadOnly     = errors.New("application is imported and read-only")
+)
+
+// ArchiveManifest describes the application of an archive
+type ArchiveManifest struct {
+	Version    int       `json:"version"`
+	ExportedAt time.Time `json:"exportedAt"`
+	Server     string    `json:"server"` // hostname of the exporting server
+
+	ID        int       `json:"id"`
+	Name      string    `json:"name"`
+	Status    string    `json:"status"`
+	CreatedAt time.Time `json:"createdAt"`
+	StartedAt time.Time `json:"startedAt"`
+	UpdatedAt time.Time `json:"updatedAt"`
+	// FinishedAt is zero in the archives of the older servers
+	FinishedAt time.Time `json:"finishedAt"`
+	Tags       []string  `json:"tags"`
+}
+
+// archiveMetric is one line of the metrics entry, a metric with its position
+// in the group/graph hierarchy and all of its points
+type archiveMetric struct {
+	Group string `json:"group"`
+	Graph string `json:"graph"`
+	Unit  string `json:"unit"`
+	Title string `json:"title"`
+	Type  string `json:"type"`
+
+	Counters   []archiveCounter   `json:"counters,omitempty"`
+	Histograms []archiveHistogram `json:"histograms,omitempty"`
+	Gauges     []archiveGauge     `json:"gauges,omitempty"`
+}
+
+// archive points do not depend on the database schema
+type archiveCounter struct {
+	EID   string `json:"eId"` // executor ID
+	Time  int64  `json:"time"`
+	Count int64  `json:"count"`
+}
+
+type archiveHistogram struct {
+	EID    string  `json:"eId"`
+	Time   int64   `json:"time"`
+	Count  int64   `json:"count"`
+	Min    int64   `json:"min"`
+	Max    int64   `json:"max"`
+	Mean   float64 `json:"mean"`
+	Stddev float64 `json:"stddev"`
+	Median float64 `json:"median"`
+	P75    float64 `json:"p75"`
+	P95    float64 `json:"p95"`
+	P99    float64 `json:"p99"`
+	P999   float64 `json:"p999"`
+}
+
+type archiveGauge struct {
+	EID   string `json:"eId"`
+	Time  int64  `json:"time"`
+	Value int64  `json:"value"`
+}
+
+// IsTerminal reports whether an application status is final
+func IsTerminal(status string) bool {
+	return status == string(jobFinished) || status == string(jobCancel) ||
+		status == string(jobError)
+}
+
+// ExportArchive writes an application as a tar.gz archive with a JSON
+// manifest, the scenario, go.mod, go.sum, all metric points and the logs.
+// Only finished, canceled or error applications can be archived.
+func (m *Master) ExportArchive(ctx context.Context, appID int, w io.Writer) error {
+	app, err := m.db.Application.
+		Query().
+		Where(entApp.ID(appID)).
+		WithTags().
+		Only(ctx)
+	if err != nil {
+		return err
+	}
+	if !IsTerminal(app.Status) {
+		return fmt.Errorf("%w: %s", ErrArchiveUnfinished, app.Status)
+	}
+
+	gw := gzip.NewWriter(w)
+	tw := tar.NewWriter(gw)
+
+	manifest := ArchiveManifest{
+		Version:    archiveVersion,
+		ExportedAt: time.Now(),
+		Server:     m.GetHostname(),
+		ID:         app.ID,
+		Name:       app.Name,
+		Status:     app.Status,
+		CreatedAt:  app.CreatedAt,
+		StartedAt:  app.StartedAt,
+		UpdatedAt:  app.UpdatedAt,
+		FinishedAt: app.FinishedAt,
+		Tags:       []string{},
+	}
+	for _, t := range app.Edges.Tags {
+		manifest.Tags = append(manifest.Tags, t.Name)
+	}
+
+	mb, err := json.MarshalIndent(manifest, "", "  ")
+	if err != nil {
+		return err
+	}
+
+	for _, e := range []struct {
+		name    string
+		content []byte
+	}{
+		{archiveManifest, mb},
+		{archiveScenario, []byte(app.Scenario)},
+		{archiveGomod, []byte(app.Gomod)},
+		{archiveGosum, []byte(app.Gosum)},
+	} {
+		if err = writeTarEntry(tw, e.name, e.content); err != nil {
+			return err
+		}
+	}
+
+	if err = m.archiveMetrics(ctx, tw, app); err != nil {
+		return err
+	}
+
+	_, sl, ul := m.Logpaths(app.ID)
+	for _, l := range []struct {
+		name string
+		path string
+	}{
+		{archiveSystemLog, sl},
+		{archiveUserLog, ul},
+	} {
+		if err = writeTarFile(tw, l.name, l.path); err != nil {
+			return err
+		}
+	}
+
+	if err = tw.Close(); err != nil {
+		return err
+	}
+	return gw.Close()
+}
+
+func writeTarEntry(tw *tar.Writer, name string, content []byte) error {
+	if err := tw.WriteHeader(&tar.Header{
+		Name:    name,
+		Mode:    0644,
+		Size:    int64(len(content)),
+		ModTime: time.Now(),
+	}); err != nil {
+		return err
+	}
+	_, err := tw.Write(content)
+	return err
+}
+
+// writeTarFile writes a file as an entry, none when the file does not exist
+func writeTarFile(tw *tar.Writer, name, path string) error {
+	f, err := os.Open(path)
+	if err != nil {
+		if os.IsNotExist(err) {
+			return nil
+		}
+		return err
+	}
+	defer f.Close()
+
+	fi, err := f.Stat()
+	if err != nil {
+		return err
+	}
+	if err = tw.WriteHeader(&tar.Header{
+		Name:    name,
+		Mode:    0644,
+		Size:    fi.Size(),
+		ModTime: time.Now(),
+	}); err != nil {
+		return err
+	}
+	// the log may still grow, the entry keeps the size of its header
+	_, err = io.Copy(tw, io.LimitReader(f, fi.Size()))
+	return err
+}
+
+// archiveMetrics writes the metrics entry. The size of the entry is required
+// before writing it, so the metrics are spooled to a temp file first.
+func (m *Master) archiveMetrics(ctx context.Context, tw *tar.Writer, app *ent.Application) error {
+	f, err := ioutil.TempFile("", "gobench-archive-*")
+	if err != nil {
+		return err
+	}
+	defer os.Remove(f.Name())
+	defer f.Close()
+
+	gs, err := app.QueryGroups().
+		WithGraphs(func(q *ent.GraphQuery) {
+			q.WithMetrics()
+		}).
+		All(ctx)
+	if err != nil {
+		return err
+	}
+
+	bw := bufio.NewWriter(f)
+	enc := json.NewEncoder(bw)
+
+	for _, g := range gs {
+		for _, gr := range g.Edges.Graphs {
+			for _, em := range gr.Edges.Metrics {
+				am := archiveMetric{
+					Group: g.Name,
+					Graph: gr.Title,
+					Unit:  gr.Unit,
+					Title: em.Title,
+					Type:  em.Type,
+				}
+
+				if err = archivePoints(ctx, em, &am); err != nil {
+					return err
+				}
+
+				if err = enc.Encode(am); err != nil {
+					return err
+				}
+			}
+		}
+	}
+
+	if err = bw.Flush(); err != nil {
+		return err
+	}
+
+	fi, err := f.Stat()
+	if err != nil {
+		return err
+	}
+	if _, err = f.Seek(0, io.SeekStart); err != nil {
+		return err
+	}
+
+	if err = tw.WriteHeader(&tar.Header{
+		Name:    archiveMetrics,
+		Mode:    0644,
+		Size:    fi.Size(),
+		ModTime: time.Now(),
+	}); err != nil {
+		return err
+	}
+	_, err = io.Copy(tw, f)
+
+	return err
+}
+
+func archivePoints(ctx context.Context, em *ent.Metric, am *archiveMetric) error {
+	switch metrics.MetricType(em.Type) {
+	case metrics.Counter:
+		cs, err := em.QueryCounters().
+			WithExecutor().
+			Order(ent.Asc(entCounter.FieldTime)).
+			All(ctx)
+		if err != nil {
+			return err
+		}
+		for _, c := range cs {
+			am.Counters = append(am.Counters, archiveCounter{ExecutorEID(c.Edges.Executor), c.Time, c.Count})
+		}
+	case metrics.Histogram:
+		hs, err := em.QueryHistograms().
+			WithExecutor().
+			Order(ent.Asc(entHistogram.FieldTime)).
+			All(ctx)
+		if err != nil {
+			return err
+		}
+		for _, h := range hs {
+			am.Histograms = append(am.Histograms, archiveHistogram{
+				ExecutorEID(h.Edges.Executor), h.Time, h.Count, h.Min, h.Max, h.Mean, h.Stddev,
+				h.Median, h.P75, h.P95, h.P99, h.P999,
+			})
+		}
+	case metrics.Gauge:
+		gs, err := em.QueryGauges().
+			WithExecutor().
+			Order(ent.Asc(entGauge.FieldTime)).
+			All(ctx)
+		if err != nil {
+			return err
+		}
+		for _, g := range gs {
+			am.Gauges = append(am.Gauges, archiveGauge{ExecutorEID(g.Edges.Executor), g.Time, g.Value})
+		}
+	}
+
+	return nil
+}
+
+// ImportArchive creates a read-only application from an archive made by
+// ExportArchive. The application keeps its original name, status and
+// timestamps. Nothing is left of an import that fails.
+func (m *Master) ImportArchive(ctx context.Context, r io.Reader, opts ...ApplicationOption) (app *ent.Application, err error) {
+	gr, err := gzip.NewReader(r)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrArchiveInvalid, err)
+	}
+	defer gr.Close()
+
+	var (
+		manifest *ArchiveManifest
+		files    = map[string][]byte{}
+		spools   = map[string]*os.File{} // metrics and logs
+	)
+	defer func() {
+		for _, f := range spools {
+			f.Close()
+			os.Remove(f.Name())
+		}
+	}()
+
+	tr := tar.NewReader(gr)
+	for {
+		hdr, err := tr.Next()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return nil, fmt.Errorf("%w: %v", ErrArchiveInvalid, err)
+		}
+
+		switch hdr.Name {
+		case archiveMetrics, archiveSystemLog, archiveUserLog:
+			// the metrics and the logs may be big, keep them on disk
+			f, err := ioutil.TempFile("", "gobench-import-*")
+			if err != nil {
+				return nil, err
+			}
+			spools[hdr.Name] = f
+			if err = copyEntry(f, tr, hdr, archiveMaxSpool); err != nil {
+				return nil, err
+			}
+			if _, err = f.Seek(0, io.SeekStart); err != nil {
+				return nil, err
+			}
+		case archiveManifest, archiveScenario, archiveGomod, archiveGosum:
+			var buf bytes.Buffer
+			if err = copyEntry(&buf, tr, hdr, archiveMaxFile); err != nil {
+				return nil, err
+			}
+			files[hdr.Name] = buf.Bytes()
+		}
+	}
+	// the end of the gzip stream checks its checksum, after the padding of
+	// the tar
+	n, err := io.Copy(ioutil.Discard, io.LimitReader(gr, archiveMaxFile+1))
+	if err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrArchiveInvalid, err)
+	}
+	if n > archiveMaxFile {
+		return nil, fmt.Errorf("%w: trailing data", ErrArchiveInvalid)
+	}
+
+	mb, ok := files[archiveManifest]
+	if !ok {
+		return nil, fmt.Errorf("%w: missing %s", ErrArchiveInvalid, archiveManifest)
+	}
+	manifest = new(ArchiveManifest)
+	if err = json.Unmarshal(mb, manifest); err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrArchiveInvalid, err)
+	}
+	if manifest.Version != archiveVersion {
+		return nil, fmt.Errorf("%w: unsupported version %d", ErrArchiveInvalid, manifest.Version)
+	}
+	if !IsTerminal(manifest.Status) {
+		return nil, fmt.Errorf("%w: %s application", ErrArchiveInvalid, manifest.Status)
+	}
+
+	def, err := m.DefaultProject(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	tx, err := m.db.Tx(ctx)
+	if err != nil {
+		return nil, err
+	}
+	defer func() {
+		if err != nil {
+			_ = tx.Rollback()
+		}
+	}()
+
+	ac := tx.Application.
+		Create().
+		SetName(manifest.Name).
+		SetStatus(manifest.Status).
+		SetCreatedAt(manifest.CreatedAt).
+		SetStartedAt(manifest.StartedAt).
+		SetUpdatedAt(manifest.UpdatedAt).
+		SetScenario(string(files[archiveScenario])).
+		SetGomod(string(files[archiveGomod])).
+		SetGosum(string(files[archiveGosum])).
+		SetImported(true)
+	if !manifest.FinishedAt.IsZero() {
+		ac.SetFinishedAt(manifest.FinishedAt)
+	}
+	for _, opt := range opts {
+		opt(ac)
+	}
+	if _, ok := ac.Mutation().ProjectID(); !ok {
+		ac.SetProjectID(def.ID)
+	}
+	app, err = ac.Save(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, t := range manifest.Tags {
+		if _, err = tx.Tag.Create().SetName(t).SetApplicationID(app.ID).Save(ctx); err != nil {
+			return nil, err
+		}
+	}
+
+	if f, ok := spools[archiveMetrics]; ok {
+		if err = importMetrics(ctx, tx, app, f); err != nil {
+			return nil, err
+		}
+	}
+
+	// the logs are written before the commit, and removed with the
+	// application when the import fails
+	folder, sl, ul := m.Logpaths(app.ID)
+	if err = os.MkdirAll(folder, os.ModePerm); err != nil {
+		return nil, err
+	}
+	defer func() {
+		if err != nil {
+			os.RemoveAll(folder)
+		}
+	}()
+	for name, path := range map[string]string{
+		archiveSystemLog: sl,
+		archiveUserLog:   ul,
+	} {
+		if f, ok := spools[name]; ok {
+			if err = copyFile(path, f); err != nil {
+				return nil, err
+			}
+		}
+	}
+
+	if err = tx.Commit(); err != nil {
+		return nil, err
+	}
+
+	// the entity of the transaction can not be queried after the commit
+	return m.db.Application.Get(ctx, app.ID)
+}
+
+// copyEntry copies a tar entry of at most max bytes
+func copyEntry(w io.Writer, tr *tar.Reader, hdr *tar.Header, max int64) error {
+	if hdr.Size > max {
+		return fmt.Errorf("%w: %s is larger than %d bytes", ErrArchiveInvalid, hdr.Name, max)
+	}
+	if _, err := io.Copy(w, io.LimitReader(tr, max)); err != nil {
+		return fmt.Errorf("%w: %v", ErrArchiveInvalid, err)
+	}
+	return nil
+}
+
+// copyFile writes the content of r to a new file
+func copyFile(path string, r io.Reader) error {
+	f, err := os.Create(path)
+	if err != nil {
+		return err
+	}
+	if _, err = io.Copy(f, r); err != nil {
+		f.Close()
+		return err
+	}
+	return f.Close()
+}
+
+// importBatch is the number of points inserted at once
+const importBatch = 500
+
+func importMetrics(ctx context.Context, tx *ent.Tx, app *ent.Application, r io.Reader) error {
+	groups := map[string]int{}
+	graphs := map[string]int{}
+	executors := map[string]*int{}
+
+	// executorID returns the executor of the imported application, nil for
+	// the points without one
+	executorID := func(eID string) (*int, error) {
+		if eID == "" {
+			return nil, nil
+		}
+		if id, ok := executors[eID]; ok {
+			return id, nil
+		}
+		e, err := tx.Executor.Create().SetEID(eID).SetApplicationID(app.ID).Save(ctx)
+		if err != nil {
+			return nil, err
+		}
+		executors[eID] = &e.ID
+		return &e.ID, nil
+	}
+
+	dec := json.NewDecoder(r)
+	for {
+		var am archiveMetric
+		err := dec.Decode(&am)
+		if err == io.EOF {
+			return nil
+		}
+		if err != nil {
+			return fmt.Errorf("%w: %v", ErrArchiveInvalid, err)
+		}
+
+		groupID, ok := groups[am.Group]
+		if !ok {
+			g, err := tx.Group.Create().SetName(am.Group).SetApplicationID(app.ID).Save(ctx)
+			if err != nil {
+				return err
+			}
+			groupID = g.ID
+			groups[am.Group] = groupID
+		}
+
+		graphKey := fmt.Sprintf("%d/%s/%s", groupID, am.Graph, am.Unit)
+		graphID, ok := graphs[graphKey]
+		if !ok {
+			g, err := tx.Graph.Create().SetTitle(am.Graph).SetUnit(am.Unit).SetGroupID(groupID).Save(ctx)
+			if err != nil {
+				return err
+			}
+			graphID = g.ID
+			graphs[graphKey] = graphID
+		}
+
+		em, err := tx.Metric.Create().SetTitle(am.Title).SetType(am.Type).SetGraphID(graphID).Save(ctx)
+		if err != nil {
+			return err
+		}
+
+		for i := 0; i < len(am.Counters); i += importBatch {
+			bs := []*ent.CounterCreate{}
+			for _, c := range am.Counters[i:min(i+importBatch, len(am.Counters))] {
+				exID, err := executorID(c.EID)
+				if err != nil {
+					return err
+				}
+				bs = append(bs, tx.Counter.Create().
+					SetMetricID(em.ID).SetNillableExecutorID(exID).SetTime(c.Time).SetCount(c.Count))
+			}
+			if _, err = tx.Counter.CreateBulk(bs...).Save(ctx); err != nil {
+				return err
+			}
+		}
+		for i := 0; i < len(am.Histograms); i += importBatch {
+			bs := []*ent.HistogramCreate{}
+			for _, h := range am.Histograms[i:min(i+importBatch, len(am.Histograms))] {
+				exID, err := executorID(h.EID)
+				if err != nil {
+					return err
+				}
+				bs = append(bs, tx.Histogram.Create().
+					SetMetricID(em.ID).SetNillableExecutorID(exID).SetTime(h.Time).
+					SetCount(h.Count).SetMin(h.Min).SetMax(h.Max).
+					SetMean(h.Mean).SetStddev(h.Stddev).SetMedian(h.Median).
+					SetP75(h.P75).SetP95(h.P95).SetP99(h.P99).SetP999(h.P999))
+			}
+			if _, err = tx.Histogram.CreateBulk(bs...).Save(ctx); err != nil {
+				return err
+			}
+		}
+		for i := 0; i < len(am.Gauges); i += importBatch {
+			bs := []*ent.GaugeCreate{}
+			for _, g := range am.Gauges[i:min(i+importBatch, len(am.Gauges))] {
+				exID, err := executorID(g.EID)
+				if err != nil {
+					return err
+				}
+				bs = append(bs, tx.Gauge.Create().
+					SetMetricID(em.ID).SetNillableExecutorID(exID).SetTime(g.Time).SetValue(g.Value))
+			}
+			if _, err = tx.Gauge.CreateBulk(bs...).Save(ctx); err != nil {
+				return err
+			}
+		}
+	}
+}
+
+func min(a, b int) int {
+	if a < b {
+		return a
+	}
+	return b
+}
@@ -0,0 +1,155 @@
+package master
+
+import (
+	"archive/tar"
+	"bytes"
+	"compress/gzip"
+	"context"
+	"errors"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/gobench-io/gobench/ent"
+	"github.com/stretchr/testify/assert"
+
+	entCounter "github.com/gobench-io/gobench/ent/counter"
+)
+
+func TestArchive(t *testing.T) {
+	ctx := context.Background()
+	m := seedMaster(t)
+
+	app := m.seedApplication(ctx, t)
+	m.seedMetrics(ctx, t, app, "e1", 1000, 3)
+	_, err := m.SetApplicationTag(ctx, app.ID, "v1")
+	assert.Nil(t, err)
+
+	folder, sl, ul := m.Logpaths(app.ID)
+	assert.Nil(t, os.MkdirAll(folder, os.ModePerm))
+	defer os.RemoveAll(folder)
+	assert.Nil(t, ioutil.WriteFile(sl, []byte("system\n"), 0644))
+	assert.Nil(t, ioutil.WriteFile(ul, []byte("user\n"), 0644))
+
+	t.Run("unfinished application", func(t *testing.T) {
+		var buf bytes.Buffer
+		err := m.ExportArchive(ctx, app.ID, &buf)
+		assert.True(t, errors.Is(err, ErrArchiveUnfinished))
+		assert.Equal(t, 0, buf.Len())
+	})
+
+	app, err = app.Update().SetStatus(string(jobFinished)).Save(ctx)
+	assert.Nil(t, err)
+
+	var buf bytes.Buffer
+	assert.Nil(t, m.ExportArchive(ctx, app.ID, &buf))
+
+	imported, err := m.ImportArchive(ctx, &buf)
+	assert.Nil(t, err)
+	assert.NotEqual(t, app.ID, imported.ID)
+	ifolder, isl, iul := m.Logpaths(imported.ID)
+	defer os.RemoveAll(ifolder)
+
+	assert.True(t, imported.Imported)
+	assert.Equal(t, app.Name, imported.Name)
+	assert.Equal(t, app.Scenario, imported.Scenario)
+	assert.Equal(t, app.Status, imported.Status)
+	assert.True(t, app.CreatedAt.Equal(imported.CreatedAt))
+	assert.True(t, app.UpdatedAt.Equal(imported.UpdatedAt))
+
+	tags, err := imported.QueryTags().All(ctx)
+	assert.Nil(t, err)
+	assert.Len(t, tags, 1)
+	assert.Equal(t, "v1", tags[0].Name)
+
+	ms, err := imported.QueryGroups().QueryGraphs().QueryMetrics().All(ctx)
+	assert.Nil(t, err)
+	assert.Len(t, ms, 3)
+	for _, em := range ms {
+		switch em.Title {
+		case "home.http_ok":
+			cs, err := em.QueryCounters().WithExecutor().
+				Order(ent.Asc(entCounter.FieldTime)).All(ctx)
+			assert.Nil(t, err)
+			assert.Len(t, cs, 3)
+			assert.Equal(t, "e1", ExecutorEID(cs[2].Edges.Executor))
+			assert.EqualValues(t, 31000, cs[2].Time)
+			assert.EqualValues(t, 300, cs[2].Count)
+		case "home.latency":
+			n, err := em.QueryHistograms().Count(ctx)
+			assert.Nil(t, err)
+			assert.Equal(t, 3, n)
+		case "home.conns":
+			n, err := em.QueryGauges().Count(ctx)
+			assert.Nil(t, err)
+			assert.Equal(t, 3, n)
+		}
+	}
+
+	b, err := ioutil.ReadFile(isl)
+	assert.Nil(t, err)
+	assert.Equal(t, "system\n", string(b))
+	b, err = ioutil.ReadFile(iul)
+	assert.Nil(t, err)
+	assert.Equal(t, "user\n", string(b))
+}
+
+func TestImportInvalidArchive(t *testing.T) {
+	m := seedMaster(t)
+
+	_, err := m.ImportArchive(context.Background(), bytes.NewBufferString("not a gzip"))
+	assert.True(t, errors.Is(err, ErrArchiveInvalid))
+}
+
+// tarGz builds an archive of the entries, in order
+func tarGz(t *testing.T, entries ...[2]string) *bytes.Buffer {
+	var buf bytes.Buffer
+	gw := gzip.NewWriter(&buf)
+	tw := tar.NewWriter(gw)
+	for _, e := range entries {
+		assert.Nil(t, writeTarEntry(tw, e[0], []byte(e[1])))
+	}
+	assert.Nil(t, tw.Close())
+	assert.Nil(t, gw.Close())
+	return &buf
+}
+
+func TestImportFailureLeavesNothing(t *testing.T) {
+	ctx := context.Background()
+	m := seedRetentionMaster(t, Retention{})
+
+	archive := tarGz(t,
+		[2]string{archiveManifest, `{"version": 1, "name": "partial", "status": "finished", "tags": ["nightly"]}`},
+		[2]string{archiveSystemLog, "system\n"},
+		[2]string{archiveMetrics, `{"group": "HTTP", "graph": "Response", "title": "home.http_ok", "type": "counter"}` + "\nnot json\n"},
+	)
+	_, err := m.ImportArchive(ctx, archive)
+	assert.True(t, errors.Is(err, ErrArchiveInvalid))
+
+	n, err := m.db.Application.Query().Count(ctx)
+	assert.Nil(t, err)
+	assert.Equal(t, 0, n)
+	n, err = m.db.Metric.Query().Count(ctx)
+	assert.Nil(t, err)
+	assert.Equal(t, 0, n)
+
+	// no logs either
+	fs, err := ioutil.ReadDir(filepath.Join(m.homeDir, "applications"))
+	if err == nil {
+		assert.Len(t, fs, 0)
+	}
+}
+
+func TestImportEntryTooLarge(t *testing.T) {
+	m := seedRetentionMaster(t, Retention{})
+
+	archive := tarGz(t,
+		[2]string{archiveManifest, `{"version": 1, "name": "big", "status": "finished"}`},
+		[2]string{archiveScenario, strings.Repeat("a", archiveMaxFile+1)},
+	)
+	_, err := m.ImportArchive(context.Background(), archive)
+	assert.True(t, errors.Is(err, ErrArchiveInvalid))
+	assert.Contains(t, err.Error(), "scenario.go is larger than")
+}
@@ -0,0 +1,107 @@
+package master
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/gobench-io/gobench/ent"
+
+	entAudit "github.com/gobench-io/gobench/ent/audit"
+)
+
+// MaxAuditLimit is the largest page of audits
+const MaxAuditLimit = 1000
+
+// DefaultAuditLimit is the page of audits without a limit
+const DefaultAuditLimit = 100
+
+// AuditEntry is an action of a user to record
+type AuditEntry struct {
+	Username      string
+	Action        entAudit.Action
+	ApplicationID int // 0 when the action has no application
+	Tag           string
+	IP            string
+}
+
+// AuditFilter selects the audits, the zero fields select all of them
+type AuditFilter struct {
+	Username      string
+	Action        entAudit.Action
+	ApplicationID int
+	Tag           string
+	From          time.Time
+	End           time.Time
+	// Before is the ID of the last audit of the previous page
+	Before int
+	Limit  int
+}
+
+// Validate checks the action and the limit of a filter
+func (f AuditFilter) Validate() error {
+	if f.Action != "" {
+		if err := entAudit.ActionValidator(f.Action); err != nil {
+			return err
+		}
+	}
+	if f.Limit < 0 || f.Limit > MaxAuditLimit {
+		return fmt.Errorf("limit must be between 1 and %d", MaxAuditLimit)
+	}
+	return nil
+}
+
+// Audit records an action of a user
+func (m *Master) Audit(ctx context.Context, e AuditEntry) (*ent.Audit, error) {
+	ac := m.db.Audit.
+		Create().
+		SetUsername(e.Username).
+		SetAction(e.Action).
+		SetIP(e.IP)
+	if e.ApplicationID != 0 {
+		ac.SetApplicationID(e.ApplicationID)
+	}
+	if e.Tag != "" {
+		ac.SetTag(e.Tag)
+	}
+	return ac.Save(ctx)
+}
+
+// ListAudits returns a page of the audits of a filter, the latest first
+func (m *Master) ListAudits(ctx context.Context, f AuditFilter) ([]*ent.Audit, error) {
+	if err := f.Validate(); err != nil {
+		return nil, err
+	}
+
+	q := m.db.Audit.Query()
+	if f.Username != "" {
+		q.Where(entAudit.Username(f.Username))
+	}
+	if f.Action != "" {
+		q.Where(entAudit.ActionEQ(f.Action))
+	}
+	if f.ApplicationID != 0 {
+		q.Where(entAudit.ApplicationID(f.ApplicationID))
+	}
+	if f.Tag != "" {
+		q.Where(entAudit.Tag(f.Tag))
+	}
+	if !f.From.IsZero() {
+		q.Where(entAudit.CreatedAtGTE(f.From))
+	}
+	if !f.End.IsZero() {
+		q.Where(entAudit.CreatedAtLTE(f.End))
+	}
+	if f.Before != 0 {
+		q.Where(entAudit.IDLT(f.Before))
+	}
+	limit := f.Limit
+	if limit == 0 {
+		limit = DefaultAuditLimit
+	}
+
+	return q.
+		Order(ent.Desc(entAudit.FieldID)).
+		Limit(limit).
+		All(ctx)
+}
@@ -0,0 +1,68 @@
+package master
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	entAudit "github.com/gobench-io/gobench/ent/audit"
+)
+
+func TestListAudits(t *testing.T) {
+	ctx := context.Background()
+	m := seedRetentionMaster(t, Retention{})
+
+	for _, e := range []AuditEntry{
+		{Username: "alice", Action: entAudit.ActionLogin, IP: "10.0.0.1"},
+		{Username: "alice", Action: entAudit.ActionCreate, ApplicationID: 1, IP: "10.0.0.1"},
+		{Username: "bob", Action: entAudit.ActionTag, ApplicationID: 1, Tag: "nightly", IP: "10.0.0.2"},
+		{Username: "bob", Action: entAudit.ActionCancel, ApplicationID: 1, IP: "10.0.0.2"},
+		{Username: "alice", Action: entAudit.ActionDelete, ApplicationID: 2, IP: "10.0.0.1"},
+	} {
+		_, err := m.Audit(ctx, e)
+		assert.Nil(t, err)
+	}
+
+	all, err := m.ListAudits(ctx, AuditFilter{})
+	assert.Nil(t, err)
+	assert.Len(t, all, 5)
+	assert.Equal(t, entAudit.ActionDelete, all[0].Action)
+
+	t.Run("filters", func(t *testing.T) {
+		as, err := m.ListAudits(ctx, AuditFilter{Username: "bob"})
+		assert.Nil(t, err)
+		assert.Len(t, as, 2)
+
+		as, err = m.ListAudits(ctx, AuditFilter{ApplicationID: 1, Action: entAudit.ActionCancel})
+		assert.Nil(t, err)
+		assert.Len(t, as, 1)
+		assert.Equal(t, "bob", as[0].Username)
+
+		as, err = m.ListAudits(ctx, AuditFilter{Tag: "nightly"})
+		assert.Nil(t, err)
+		assert.Len(t, as, 1)
+
+		as, err = m.ListAudits(ctx, AuditFilter{From: time.Now().Add(time.Minute)})
+		assert.Nil(t, err)
+		assert.Len(t, as, 0)
+	})
+
+	t.Run("pages", func(t *testing.T) {
+		page, err := m.ListAudits(ctx, AuditFilter{Limit: 2})
+		assert.Nil(t, err)
+		assert.Len(t, page, 2)
+		page, err = m.ListAudits(ctx, AuditFilter{Limit: 2, Before: page[1].ID})
+		assert.Nil(t, err)
+		assert.Len(t, page, 2)
+		assert.Equal(t, all[2].ID, page[0].ID)
+	})
+
+	t.Run("invalid filter", func(t *testing.T) {
+		_, err := m.ListAudits(ctx, AuditFilter{Action: "launch"})
+		assert.NotNil(t, err)
+		_, err = m.ListAudits(ctx, AuditFilter{Limit: MaxAuditLimit + 1})
+		assert.NotNil(t, err)
+	})
+}
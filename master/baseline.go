@@ -0,0 +1,202 @@
+package master
+
+import (
+	"context"
+	"errors"
+
+	"github.com/gobench-io/gobench/ent"
+	"github.com/gobench-io/gobench/executor/metrics"
+	"github.com/gobench-io/gobench/verdict"
+
+	entApp "github.com/gobench-io/gobench/ent/application"
+	entBaseline "github.com/gobench-io/gobench/ent/baseline"
+	entTag "github.com/gobench-io/gobench/ent/tag"
+)
+
+// DefaultTolerance is the allowed change of a stat from the baseline, in
+// percent
+const DefaultTolerance = 10.0
+
+// ErrBaselineUnfinished is returned when an unfinished application is set as
+// a baseline
+var ErrBaselineUnfinished = errors.New("only a finished application can be a baseline")
+
+// SetBaseline makes a finished application the baseline of a tag, replacing
+// the previous baseline of the tag
+func (m *Master) SetBaseline(ctx context.Context, appID int, tag string,
+	tolerance float64, tolerances map[string]float64,
+) (*ent.Baseline, error) {
+	app, err := m.db.Application.
+		Query().
+		Where(entApp.ID(appID)).
+		Only(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if app.Status != string(jobFinished) {
+		return nil, ErrBaselineUnfinished
+	}
+
+	b, err := m.db.Baseline.
+		Query().
+		Where(entBaseline.Tag(tag)).
+		Only(ctx)
+	if err != nil && !ent.IsNotFound(err) {
+		return nil, err
+	}
+
+	if b == nil {
+		b, err = m.db.Baseline.
+			Create().
+			SetTag(tag).
+			SetTolerance(tolerance).
+			SetTolerances(tolerances).
+			SetApplicationID(appID).
+			Save(ctx)
+	} else {
+		b, err = b.Update().
+			SetTolerance(tolerance).
+			SetTolerances(tolerances).
+			SetApplicationID(appID).
+			Save(ctx)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	return m.GetBaseline(ctx, b.ID)
+}
+
+// GetBaseline returns a baseline with its application
+func (m *Master) GetBaseline(ctx context.Context, id int) (*ent.Baseline, error) {
+	return m.db.Baseline.
+		Query().
+		Where(entBaseline.ID(id)).
+		WithApplication().
+		Only(ctx)
+}
+
+// ListBaselines returns all baselines with their application
+func (m *Master) ListBaselines(ctx context.Context) ([]*ent.Baseline, error) {
+	return m.db.Baseline.
+		Query().
+		WithApplication().
+		Order(ent.Asc(entBaseline.FieldTag)).
+		All(ctx)
+}
+
+// DeleteBaseline removes a baseline, the verdicts of the judged
+// applications are kept
+func (m *Master) DeleteBaseline(ctx context.Context, id int) error {
+	return m.db.Baseline.
+		DeleteOneID(id).
+		Exec(ctx)
+}
+
+// baselineOf returns the baseline of the first tag of an application that
+// has one, nil when there is none
+func (m *Master) baselineOf(ctx context.Context, app *ent.Application) (*ent.Baseline, error) {
+	tags, err := m.db.Tag.
+		Query().
+		Where(entTag.HasApplicationWith(entApp.ID(app.ID))).
+		Order(ent.Asc(entTag.FieldName)).
+		All(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, t := range tags {
+		b, err := m.db.Baseline.
+			Query().
+			Where(entBaseline.Tag(t.Name)).
+			WithApplication().
+			Only(ctx)
+		if err != nil {
+			if ent.IsNotFound(err) {
+				continue
+			}
+			return nil, err
+		}
+		// a baseline is not judged against itself
+		if b.Edges.Application == nil || b.Edges.Application.ID == app.ID {
+			continue
+		}
+		return b, nil
+	}
+
+	return nil, nil
+}
+
+// judge compares a finished application with the baseline of its tags, then
+// saves the verdict and the checks on the application. Applications without
+// a baseline are left untouched.
+func (m *Master) judge(ctx context.Context, app *ent.Application) (*ent.Application, error) {
+	b, err := m.baselineOf(ctx, app)
+	if err != nil || b == nil {
+		return app, err
+	}
+
+	refStats, err := m.ApplicationStats(ctx, b.Edges.Application)
+	if err != nil {
+		return app, err
+	}
+	stats, err := m.ApplicationStats(ctx, app)
+	if err != nil {
+		return app, err
+	}
+
+	checks := judgeStats(b, refStats, stats)
+
+	return app.Update().
+		SetVerdict(verdict.Of(checks)).
+		SetChecks(checks).
+		SetBaselineAppID(b.Edges.Application.ID).
+		Save(ctx)
+}
+
+// judgeStats checks the metrics found in both runs: p95 and p99 of the
+// histograms and the rate of the counters. Error counters regress when they
+// grow, the other counters when they drop. Gauges are not judged.
+func judgeStats(b *ent.Baseline, refStats, stats []*MetricStats) []verdict.Check {
+	refs := map[string]*MetricStats{}
+	for _, s := range refStats {
+		refs[metricKey(s.Group, s.Graph, s.Title)] = s
+	}
+
+	checks := []verdict.Check{}
+	for _, s := range stats {
+		ref, ok := refs[metricKey(s.Group, s.Graph, s.Title)]
+		if !ok || ref.Type != s.Type {
+			continue
+		}
+
+		tolerance := b.Tolerance
+		if t, ok := b.Tolerances[s.Title]; ok {
+			tolerance = t
+		}
+
+		check := func(stat string, refValue, value float64, lowerIsBetter bool) {
+			c := verdict.Check{
+				Group:     s.Group,
+				Graph:     s.Graph,
+				Metric:    s.Title,
+				Stat:      stat,
+				Baseline:  refValue,
+				Value:     value,
+				Tolerance: tolerance,
+			}
+			c.Judge(lowerIsBetter)
+			checks = append(checks, c)
+		}
+
+		switch metrics.MetricType(s.Type) {
+		case metrics.Histogram:
+			check("p95", ref.P95, s.P95, true)
+			check("p99", ref.P99, s.P99, true)
+		case metrics.Counter:
+			check("rate", ref.Rate, s.Rate, IsErrorMetric(s.Title))
+		}
+	}
+
+	return checks
+}
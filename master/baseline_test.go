@@ -0,0 +1,95 @@
+package master
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/gobench-io/gobench/ent"
+	"github.com/gobench-io/gobench/verdict"
+	"github.com/stretchr/testify/assert"
+
+	entHistogram "github.com/gobench-io/gobench/ent/histogram"
+	entMetric "github.com/gobench-io/gobench/ent/metric"
+)
+
+func (m *Master) seedFinishedApp(ctx context.Context, t *testing.T, tag string) (*ent.Application, int64) {
+	app := m.seedApplication(ctx, t)
+	_, hID, _ := m.seedMetrics(ctx, t, app, "e1", 1000, 2)
+	app, err := app.Update().SetStatus(string(jobFinished)).Save(ctx)
+	assert.Nil(t, err)
+	if tag != "" {
+		_, err = m.SetApplicationTag(ctx, app.ID, tag)
+		assert.Nil(t, err)
+	}
+	return app, hID
+}
+
+func TestBaseline(t *testing.T) {
+	ctx := context.Background()
+	m := seedMaster(t)
+
+	tag := "nightly-baseline"
+	ref, _ := m.seedFinishedApp(ctx, t, tag)
+
+	t.Run("unfinished application", func(t *testing.T) {
+		app := m.seedApplication(ctx, t)
+		_, err := m.SetBaseline(ctx, app.ID, tag, DefaultTolerance, nil)
+		assert.True(t, errors.Is(err, ErrBaselineUnfinished))
+	})
+
+	b, err := m.SetBaseline(ctx, ref.ID, tag, DefaultTolerance,
+		map[string]float64{"home.latency": 50})
+	assert.Nil(t, err)
+	assert.Equal(t, ref.ID, b.Edges.Application.ID)
+	assert.Equal(t, DefaultTolerance, b.Tolerance)
+
+	t.Run("baseline is not judged against itself", func(t *testing.T) {
+		app, err := m.judge(ctx, ref)
+		assert.Nil(t, err)
+		assert.Equal(t, "", app.Verdict)
+	})
+
+	t.Run("pass", func(t *testing.T) {
+		app, _ := m.seedFinishedApp(ctx, t, tag)
+		app, err := m.judge(ctx, app)
+		assert.Nil(t, err)
+		assert.Equal(t, verdict.Pass, app.Verdict)
+		assert.Equal(t, ref.ID, app.BaselineAppID)
+		// p95 and p99 of the histogram, rate of the counter
+		assert.Len(t, app.Checks, 3)
+	})
+
+	t.Run("regressed", func(t *testing.T) {
+		app, hID := m.seedFinishedApp(ctx, t, tag)
+		_, err := m.db.Histogram.Update().
+			Where(entHistogram.HasMetricWith(entMetric.ID(int(hID)))).
+			SetP99(80).
+			Save(ctx)
+		assert.Nil(t, err)
+
+		app, err = m.judge(ctx, app)
+		assert.Nil(t, err)
+		assert.Equal(t, verdict.Regressed, app.Verdict)
+		for _, c := range app.Checks {
+			if c.Stat == "p99" {
+				assert.Equal(t, verdict.Regressed, c.Verdict)
+				assert.Equal(t, 50.0, c.Tolerance)
+				assert.InDelta(t, 100, *c.Change, 0.001)
+			}
+		}
+	})
+
+	t.Run("without baseline", func(t *testing.T) {
+		app, _ := m.seedFinishedApp(ctx, t, "")
+		app, err := m.judge(ctx, app)
+		assert.Nil(t, err)
+		assert.Equal(t, "", app.Verdict)
+	})
+
+	t.Run("delete the application of a baseline", func(t *testing.T) {
+		assert.Nil(t, m.DeleteApplication(ctx, ref.ID))
+		_, err := m.GetBaseline(ctx, b.ID)
+		assert.True(t, ent.IsNotFound(err))
+	})
+}
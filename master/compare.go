@@ -0,0 +1,266 @@
+package master
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/gobench-io/gobench/ent"
+	"github.com/gobench-io/gobench/executor/metrics"
+
+	entApp "github.com/gobench-io/gobench/ent/application"
+	entCounter "github.com/gobench-io/gobench/ent/counter"
+	entGauge "github.com/gobench-io/gobench/ent/gauge"
+	entHistogram "github.com/gobench-io/gobench/ent/histogram"
+)
+
+// ErrCompareApps is returned when less than two applications are compared
+var ErrCompareApps = errors.New("at least two applications are required")
+
+// Comparison is the metric by metric diff of applications. The first
+// application is the reference of the deltas.
+type Comparison struct {
+	Applications []*CompareApp    `json:"applications"`
+	Metrics      []*CompareMetric `json:"metrics"`
+}
+
+// CompareApp is an application of a comparison with its totals
+type CompareApp struct {
+	ID        int    `json:"id"`
+	Name      string `json:"name"`
+	Status    string `json:"status"`
+	StartedAt int64  `json:"startedAt"` // ms
+
+	Requests   int64   `json:"requests"`   // total of all counters
+	Errors     int64   `json:"errors"`     // total of the error counters
+	Throughput float64 `json:"throughput"` // requests per second
+
+	Delta *StatsDelta `json:"delta,omitempty"`
+}
+
+// CompareMetric is a metric matched by group, graph and title. Stats, Deltas
+// and Series have one item per application, nil when the application does
+// not have the metric.
+type CompareMetric struct {
+	Group string `json:"group"`
+	Graph string `json:"graph"`
+	Unit  string `json:"unit"`
+	Title string `json:"title"`
+	Type  string `json:"type"`
+
+	Stats  []*MetricStats   `json:"stats"`
+	Deltas []*StatsDelta    `json:"deltas"`
+	Series [][]ComparePoint `json:"series"`
+}
+
+// ComparePoint is a point relative to the start of its run
+type ComparePoint struct {
+	Offset int64   `json:"offset"` // ms since the start of the run
+	EID    string  `json:"eId"`
+	Value  float64 `json:"value"` // counter count, gauge value or histogram mean
+	P95    float64 `json:"p95,omitempty"`
+	P99    float64 `json:"p99,omitempty"`
+}
+
+// StatsDelta is the change of the stats from the reference application in
+// percent. A field is nil when the reference value is zero.
+type StatsDelta struct {
+	Count      *float64 `json:"count,omitempty"`
+	Rate       *float64 `json:"rate,omitempty"`
+	Mean       *float64 `json:"mean,omitempty"`
+	P95        *float64 `json:"p95,omitempty"`
+	P99        *float64 `json:"p99,omitempty"`
+	Max        *float64 `json:"max,omitempty"`
+	Errors     *float64 `json:"errors,omitempty"`
+	Throughput *float64 `json:"throughput,omitempty"`
+}
+
+// pctChange returns the change from ref to v in percent
+func pctChange(ref, v float64) *float64 {
+	if ref == 0 {
+		if v == 0 {
+			zero := 0.0
+			return &zero
+		}
+		return nil
+	}
+	p := (v - ref) / ref * 100
+	return &p
+}
+
+func metricKey(group, graph, title string) string {
+	return fmt.Sprintf("%s\x00%s\x00%s", group, graph, title)
+}
+
+// Compare diffs the applications metric by metric
+func (m *Master) Compare(ctx context.Context, appIDs []int) (*Comparison, error) {
+	if len(appIDs) < 2 {
+		return nil, ErrCompareApps
+	}
+
+	apps := make([]*ent.Application, len(appIDs))
+	for i, id := range appIDs {
+		app, err := m.db.Application.
+			Query().
+			Where(entApp.ID(id)).
+			Only(ctx)
+		if err != nil {
+			return nil, err
+		}
+		apps[i] = app
+	}
+
+	res := &Comparison{
+		Applications: []*CompareApp{},
+		Metrics:      []*CompareMetric{},
+	}
+	index := map[string]*CompareMetric{}
+
+	for i, app := range apps {
+		ca := &CompareApp{
+			ID:     app.ID,
+			Name:   app.Name,
+			Status: app.Status,
+		}
+		if !app.StartedAt.IsZero() {
+			ca.StartedAt = app.StartedAt.UnixNano() / 1e6
+		}
+
+		stats, err := m.ApplicationStats(ctx, app)
+		if err != nil {
+			return nil, err
+		}
+
+		for _, s := range stats {
+			key := metricKey(s.Group, s.Graph, s.Title)
+			cm, ok := index[key]
+			if !ok {
+				cm = &CompareMetric{
+					Group:  s.Group,
+					Graph:  s.Graph,
+					Unit:   s.Unit,
+					Title:  s.Title,
+					Type:   s.Type,
+					Stats:  make([]*MetricStats, len(apps)),
+					Deltas: make([]*StatsDelta, len(apps)),
+					Series: make([][]ComparePoint, len(apps)),
+				}
+				index[key] = cm
+				res.Metrics = append(res.Metrics, cm)
+			}
+			cm.Stats[i] = s
+		}
+
+		sum := summarize(app, stats)
+		ca.Requests = sum.Requests
+		ca.Errors = sum.Errors
+		ca.Throughput = sum.RPS
+
+		res.Applications = append(res.Applications, ca)
+	}
+
+	ref := res.Applications[0]
+	for _, ca := range res.Applications[1:] {
+		ca.Delta = &StatsDelta{
+			Count:      pctChange(float64(ref.Requests), float64(ca.Requests)),
+			Errors:     pctChange(float64(ref.Errors), float64(ca.Errors)),
+			Throughput: pctChange(ref.Throughput, ca.Throughput),
+		}
+	}
+
+	for _, cm := range res.Metrics {
+		if err := m.compareSeries(ctx, apps, cm); err != nil {
+			return nil, err
+		}
+
+		rs := cm.Stats[0]
+		if rs == nil {
+			continue
+		}
+		for i, s := range cm.Stats[1:] {
+			if s == nil {
+				continue
+			}
+			cm.Deltas[i+1] = statsDelta(rs, s)
+		}
+	}
+
+	return res, nil
+}
+
+func statsDelta(ref, s *MetricStats) *StatsDelta {
+	switch metrics.MetricType(s.Type) {
+	case metrics.Counter:
+		return &StatsDelta{
+			Count: pctChange(float64(ref.Count), float64(s.Count)),
+			Rate:  pctChange(ref.Rate, s.Rate),
+		}
+	case metrics.Histogram:
+		return &StatsDelta{
+			Count: pctChange(float64(ref.Count), float64(s.Count)),
+			Mean:  pctChange(ref.Mean, s.Mean),
+			P95:   pctChange(ref.P95, s.P95),
+			P99:   pctChange(ref.P99, s.P99),
+			Max:   pctChange(ref.Max, s.Max),
+		}
+	case metrics.Gauge:
+		return &StatsDelta{
+			Mean: pctChange(ref.Mean, s.Mean),
+			Max:  pctChange(ref.Max, s.Max),
+		}
+	}
+	return nil
+}
+
+// compareSeries fills the time series of a compared metric, aligned on the
+// start of every run
+func (m *Master) compareSeries(ctx context.Context, apps []*ent.Application, cm *CompareMetric) (err error) {
+	for i, app := range apps {
+		if cm.Stats[i] == nil {
+			continue
+		}
+		if cm.Series[i], err = metricSeries(ctx, app, cm.Stats[i].metric); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func metricSeries(ctx context.Context, app *ent.Application, em *ent.Metric) ([]ComparePoint, error) {
+	ps := []ComparePoint{}
+
+	switch metrics.MetricType(em.Type) {
+	case metrics.Counter:
+		cs, err := em.QueryCounters().WithExecutor().Order(ent.Asc(entCounter.FieldTime)).All(ctx)
+		if err != nil || len(cs) == 0 {
+			return ps, err
+		}
+		start := runStart(app, cs[0].Time)
+		for _, c := range cs {
+			ps = append(ps, ComparePoint{Offset: c.Time - start, EID: ExecutorEID(c.Edges.Executor), Value: float64(c.Count)})
+		}
+	case metrics.Histogram:
+		hs, err := em.QueryHistograms().WithExecutor().Order(ent.Asc(entHistogram.FieldTime)).All(ctx)
+		if err != nil || len(hs) == 0 {
+			return ps, err
+		}
+		start := runStart(app, hs[0].Time)
+		for _, h := range hs {
+			ps = append(ps, ComparePoint{
+				Offset: h.Time - start, EID: ExecutorEID(h.Edges.Executor), Value: h.Mean, P95: h.P95, P99: h.P99,
+			})
+		}
+	case metrics.Gauge:
+		gs, err := em.QueryGauges().WithExecutor().Order(ent.Asc(entGauge.FieldTime)).All(ctx)
+		if err != nil || len(gs) == 0 {
+			return ps, err
+		}
+		start := runStart(app, gs[0].Time)
+		for _, g := range gs {
+			ps = append(ps, ComparePoint{Offset: g.Time - start, EID: ExecutorEID(g.Edges.Executor), Value: float64(g.Value)})
+		}
+	}
+
+	return ps, nil
+}
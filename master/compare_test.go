@@ -0,0 +1,73 @@
+package master
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCompare(t *testing.T) {
+	ctx := context.Background()
+	m := seedMaster(t)
+
+	app1 := m.seedApplication(ctx, t)
+	m.seedMetrics(ctx, t, app1, "e1", 1000, 2)
+	app2 := m.seedApplication(ctx, t)
+	m.seedMetrics(ctx, t, app2, "e1", 1000, 4)
+
+	c, err := m.Compare(ctx, []int{app1.ID, app2.ID})
+	assert.Nil(t, err)
+	assert.Len(t, c.Applications, 2)
+	assert.Len(t, c.Metrics, 3)
+
+	assert.EqualValues(t, 200, c.Applications[0].Requests)
+	assert.EqualValues(t, 400, c.Applications[1].Requests)
+	assert.Nil(t, c.Applications[0].Delta)
+	assert.InDelta(t, 100, *c.Applications[1].Delta.Count, 0.001)
+	assert.InDelta(t, 0, *c.Applications[1].Delta.Errors, 0.001)
+	// same rate for a twice longer run
+	assert.InDelta(t, 0, *c.Applications[1].Delta.Throughput, 0.001)
+
+	for _, cm := range c.Metrics {
+		assert.Equal(t, "HTTP", cm.Group)
+		assert.Equal(t, "Response", cm.Graph)
+		assert.Len(t, cm.Stats, 2)
+		assert.Nil(t, cm.Deltas[0])
+		assert.NotNil(t, cm.Deltas[1])
+		assert.Len(t, cm.Series[0], 2)
+		assert.Len(t, cm.Series[1], 4)
+		// aligned on the start of each run
+		assert.EqualValues(t, 10000, cm.Series[0][0].Offset)
+		assert.EqualValues(t, 10000, cm.Series[1][0].Offset)
+
+		switch cm.Title {
+		case "home.http_ok":
+			assert.EqualValues(t, 200, cm.Stats[0].Count)
+			assert.InDelta(t, 10, cm.Stats[0].Rate, 0.001)
+			assert.InDelta(t, 100, *cm.Deltas[1].Count, 0.001)
+			assert.InDelta(t, 0, *cm.Deltas[1].Rate, 0.001)
+		case "home.latency":
+			assert.InDelta(t, 30, cm.Stats[0].P95, 0.001)
+			assert.InDelta(t, 0, *cm.Deltas[1].P95, 0.001)
+			assert.InDelta(t, 0, *cm.Deltas[1].P99, 0.001)
+		case "home.conns":
+			assert.InDelta(t, 1.5, cm.Stats[0].Mean, 0.001)
+			assert.InDelta(t, 2.5, cm.Stats[1].Mean, 0.001)
+			assert.InDelta(t, 100.0*(2.5-1.5)/1.5, *cm.Deltas[1].Mean, 0.001)
+			assert.InDelta(t, 100, *cm.Deltas[1].Max, 0.001)
+		}
+	}
+
+	_, err = m.Compare(ctx, []int{app1.ID})
+	assert.True(t, errors.Is(err, ErrCompareApps))
+}
+
+func TestIsErrorMetric(t *testing.T) {
+	assert.True(t, IsErrorMetric("home.http_fail"))
+	assert.True(t, IsErrorMetric("mqtt.connection.connect.errors"))
+	assert.True(t, IsErrorMetric("mqtt.subscriber.suback.error"))
+	assert.False(t, IsErrorMetric("home.http_ok"))
+	assert.False(t, IsErrorMetric("home.latency"))
+}
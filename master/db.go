@@ -0,0 +1,221 @@
+package master
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"strings"
+
+	"github.com/facebook/ent/dialect"
+	"github.com/facebook/ent/dialect/sql"
+	"github.com/facebook/ent/dialect/sql/schema"
+	"github.com/facebook/ent/schema/field"
+	"github.com/go-sql-driver/mysql"
+	"github.com/gobench-io/gobench/ent/migrate"
+
+	entCounter "github.com/gobench-io/gobench/ent/counter"
+	entGauge "github.com/gobench-io/gobench/ent/gauge"
+	entHistogram "github.com/gobench-io/gobench/ent/histogram"
+
+	_ "github.com/lib/pq"
+	_ "github.com/mattn/go-sqlite3"
+)
+
+// ErrDbDriver is returned for an unsupported database driver
+var ErrDbDriver = errors.New("db driver must be one of sqlite3, postgres, mysql")
+
+// DbDrivers are the supported database drivers, sqlite3 first as the default
+var DbDrivers = []string{dialect.SQLite, dialect.Postgres, dialect.MySQL}
+
+// sqliteParams are the connection parameters of the sqlite database file
+const sqliteParams = "mode=rwc&cache=shared&&_busy_timeout=9999999&_fk=1"
+
+// sqliteReadOnlyParams open an existing sqlite database file without writing
+const sqliteReadOnlyParams = "mode=ro&_busy_timeout=9999999"
+
+// migrateDb creates the missing tables, columns and indexes. The charts, the
+// exports and the reports read the points of a metric in a time window, but
+// ent puts the fields before the edges in the columns of an index, so the
+// (metric, time) indexes are added to copies of the generated tables.
+func migrateDb(ctx context.Context, drv dialect.Driver) error {
+	tables := make([]*schema.Table, len(migrate.Tables))
+	for i, t := range migrate.Tables {
+		switch t {
+		case migrate.CountersTable, migrate.HistogramsTable, migrate.GaugesTable:
+			t = withMetricTimeIndex(t)
+		}
+		tables[i] = t
+	}
+
+	tables = append(tables, migrationsTable)
+
+	mg, err := schema.NewMigrate(drv)
+	if err != nil {
+		return err
+	}
+	return mg.Create(ctx, tables...)
+}
+
+// migrationName is the name of a data migration done
+var migrationName = &schema.Column{Name: "name", Type: field.TypeString, Size: 191}
+
+// migrationsTable records the data migrations done, so that they run once
+var migrationsTable = &schema.Table{
+	Name:       "migrations",
+	Columns:    []*schema.Column{migrationName},
+	PrimaryKey: []*schema.Column{migrationName},
+}
+
+// migrated tells if a data migration is done
+func migrated(ctx context.Context, drv *sql.Driver, name string) (bool, error) {
+	query, args := sql.Dialect(drv.Dialect()).
+		Select(migrationName.Name).
+		From(sql.Table(migrationsTable.Name)).
+		Where(sql.EQ(migrationName.Name, name)).
+		Query()
+
+	rows := &sql.Rows{}
+	if err := drv.Query(ctx, query, args, rows); err != nil {
+		return false, err
+	}
+	defer rows.Close()
+
+	return rows.Next(), rows.Err()
+}
+
+// setMigrated records a data migration as done
+func setMigrated(ctx context.Context, drv *sql.Driver, name string) error {
+	query, args := sql.Dialect(drv.Dialect()).
+		Insert(migrationsTable.Name).
+		Columns(migrationName.Name).
+		Values(name).
+		Query()
+	return drv.Exec(ctx, query, args, nil)
+}
+
+// withMetricTimeIndex returns a copy of a point table with the (metric, time)
+// index
+func withMetricTimeIndex(t *schema.Table) *schema.Table {
+	cols := map[string]*schema.Column{}
+	for _, c := range t.Columns {
+		cols[c.Name] = c
+	}
+
+	c := *t
+	c.Indexes = append(append([]*schema.Index{}, t.Indexes...), &schema.Index{
+		Name:    t.Name + "_metric_time",
+		Columns: []*schema.Column{cols["metric_"+t.Name], cols["time"]},
+	})
+	return &c
+}
+
+// ValidateDb checks a database driver with its DSN. The sqlite3 DSN is the
+// database file and may be empty, the postgres and mysql DSN is required.
+func ValidateDb(driver, dsn string) error {
+	switch driver {
+	case "", dialect.SQLite:
+		return nil
+	case dialect.Postgres, dialect.MySQL:
+		if dsn == "" {
+			return fmt.Errorf("%s must have a db dsn", driver)
+		}
+		return nil
+	}
+	return ErrDbDriver
+}
+
+// dataSource returns the source to open a database with. The ent mysql
+// dialect scans the time columns, so parseTime is always on.
+func dataSource(driver, dsn string) (string, error) {
+	switch driver {
+	case dialect.SQLite:
+		if strings.Contains(dsn, "?") {
+			return dsn + "&" + sqliteParams, nil
+		}
+		return dsn + "?" + sqliteParams, nil
+	case dialect.MySQL:
+		cfg, err := mysql.ParseDSN(dsn)
+		if err != nil {
+			return "", err
+		}
+		cfg.ParseTime = true
+		return cfg.FormatDSN(), nil
+	}
+	return dsn, nil
+}
+
+// openDb opens the database of the master
+func openDb(driver, dsn string) (*sql.Driver, error) {
+	source, err := dataSource(driver, dsn)
+	if err != nil {
+		return nil, err
+	}
+	drv, err := sql.Open(driver, source)
+	if err != nil {
+		return nil, fmt.Errorf("failed opening %s connection: %v", driver, err)
+	}
+	return drv, nil
+}
+
+// openDbReadOnly opens the database of the master without creating it. The
+// sqlite3 file must exist and is opened read-only.
+func openDbReadOnly(driver, dsn string) (*sql.Driver, error) {
+	if driver != dialect.SQLite {
+		return openDb(driver, dsn)
+	}
+	file, source := sqliteReadOnlySource(dsn)
+	if _, err := os.Stat(file); err != nil {
+		return nil, err
+	}
+	drv, err := sql.Open(driver, source)
+	if err != nil {
+		return nil, fmt.Errorf("failed opening %s connection: %v", driver, err)
+	}
+	return drv, nil
+}
+
+// sqliteReadOnlySource returns the file of a sqlite3 DSN, which may be a
+// "file:" URI with parameters, and the source to open it read-only
+func sqliteReadOnlySource(dsn string) (file, source string) {
+	uri := strings.TrimPrefix(dsn, "file:")
+	file, sep := uri, "?"
+	if i := strings.Index(uri, "?"); i >= 0 {
+		file, sep = uri[:i], "&"
+	}
+	return file, "file:" + uri + sep + sqliteReadOnlyParams
+}
+
+// DbSize returns the size in bytes of the database
+func (m *Master) DbSize() (int64, error) {
+	var query string
+	switch m.dbDriver {
+	case dialect.Postgres:
+		query = "SELECT pg_database_size(current_database())"
+	case dialect.MySQL:
+		query = "SELECT COALESCE(SUM(data_length + index_length), 0) " +
+			"FROM information_schema.tables WHERE table_schema = DATABASE()"
+	default:
+		fi, err := os.Stat(m.dbFilename)
+		if err != nil {
+			return 0, err
+		}
+		return fi.Size(), nil
+	}
+
+	// the mysql sum is a decimal
+	var size float64
+	err := m.dbDrv.DB().QueryRowContext(context.Background(), query).Scan(&size)
+	return int64(size), err
+}
+
+// vacuum reclaims the space of the deleted rows
+func (m *Master) vacuum(ctx context.Context) error {
+	query := "VACUUM"
+	if m.dbDriver == dialect.MySQL {
+		query = fmt.Sprintf("OPTIMIZE TABLE %s, %s, %s",
+			entCounter.Table, entHistogram.Table, entGauge.Table)
+	}
+	_, err := m.dbDrv.DB().ExecContext(ctx, query)
+	return err
+}
@@ -0,0 +1,134 @@
+package master
+
+import (
+	"context"
+	"os"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/facebook/ent/dialect"
+	"github.com/gobench-io/gobench/logger"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestValidateDb(t *testing.T) {
+	assert.Nil(t, ValidateDb("", ""))
+	assert.Nil(t, ValidateDb(dialect.SQLite, ""))
+	assert.Nil(t, ValidateDb(dialect.SQLite, "/tmp/foo.sqlite3"))
+	assert.Nil(t, ValidateDb(dialect.Postgres, "postgres://localhost/gobench"))
+	assert.Nil(t, ValidateDb(dialect.MySQL, "root@tcp(localhost:3306)/gobench"))
+
+	assert.EqualError(t, ValidateDb(dialect.Postgres, ""), "postgres must have a db dsn")
+	assert.EqualError(t, ValidateDb(dialect.MySQL, ""), "mysql must have a db dsn")
+	assert.Equal(t, ErrDbDriver, ValidateDb("oracle", "foo"))
+
+	_, err := NewMaster(&Options{DbDriver: "oracle"}, logger.NewNopLogger())
+	assert.Equal(t, ErrDbDriver, err)
+}
+
+func TestDataSource(t *testing.T) {
+	s, err := dataSource(dialect.SQLite, "/tmp/foo.sqlite3")
+	assert.Nil(t, err)
+	assert.Equal(t, "/tmp/foo.sqlite3?"+sqliteParams, s)
+
+	s, err = dataSource(dialect.SQLite, "file:/tmp/foo.sqlite3?_journal=WAL")
+	assert.Nil(t, err)
+	assert.Equal(t, "file:/tmp/foo.sqlite3?_journal=WAL&"+sqliteParams, s)
+
+	s, err = dataSource(dialect.Postgres, "postgres://localhost/gobench?sslmode=disable")
+	assert.Nil(t, err)
+	assert.Equal(t, "postgres://localhost/gobench?sslmode=disable", s)
+
+	s, err = dataSource(dialect.MySQL, "root:pw@tcp(localhost:3306)/gobench")
+	assert.Nil(t, err)
+	assert.True(t, strings.HasPrefix(s, "root:pw@tcp(localhost:3306)/gobench?"))
+	assert.Contains(t, s, "parseTime=true")
+
+	_, err = dataSource(dialect.MySQL, "not a dsn")
+	assert.NotNil(t, err)
+}
+
+func TestSqliteReadOnlySource(t *testing.T) {
+	f, s := sqliteReadOnlySource("/tmp/foo.sqlite3")
+	assert.Equal(t, "/tmp/foo.sqlite3", f)
+	assert.Equal(t, "file:/tmp/foo.sqlite3?"+sqliteReadOnlyParams, s)
+
+	f, s = sqliteReadOnlySource("file:/tmp/foo.sqlite3?_journal=WAL")
+	assert.Equal(t, "/tmp/foo.sqlite3", f)
+	assert.Equal(t, "file:/tmp/foo.sqlite3?_journal=WAL&"+sqliteReadOnlyParams, s)
+}
+
+func TestPointsQuery(t *testing.T) {
+	q, args := pointsQuery(dialect.Postgres, "counters", "metric_counters")
+	assert.Empty(t, args)
+	assert.Contains(t, q, `JOIN "groups" AS "g"`)
+	assert.Contains(t, q, `GROUP BY "g"."application_groups"`)
+
+	q, _ = pointsQuery(dialect.MySQL, "counters", "metric_counters")
+	assert.Contains(t, q, "JOIN `groups` AS `g`")
+}
+
+// TestExternalDb runs on the postgres and mysql servers of the
+// GOBENCH_TEST_POSTGRES_DSN and GOBENCH_TEST_MYSQL_DSN variables, for
+// example:
+//
+//	docker run -d -p 5432:5432 -e POSTGRES_PASSWORD=pw postgres
+//	GOBENCH_TEST_POSTGRES_DSN="postgres://postgres:pw@localhost/postgres?sslmode=disable" go test ./master
+//
+// It is skipped without them, but fails when GOBENCH_TEST_EXTERNAL_DB is set,
+// as in the external-db job of the CI.
+func TestExternalDb(t *testing.T) {
+	for driver, env := range map[string]string{
+		dialect.Postgres: "GOBENCH_TEST_POSTGRES_DSN",
+		dialect.MySQL:    "GOBENCH_TEST_MYSQL_DSN",
+	} {
+		driver, dsn := driver, os.Getenv(env)
+		t.Run(driver, func(t *testing.T) {
+			if dsn == "" {
+				if os.Getenv("GOBENCH_TEST_EXTERNAL_DB") != "" {
+					t.Fatalf("%s is not set", env)
+				}
+				t.Skipf("%s is not set", env)
+			}
+			ctx := context.Background()
+
+			m, err := NewMaster(&Options{
+				HomeDir:   t.TempDir(),
+				Program:   "gobench",
+				DbDriver:  driver,
+				DbDSN:     dsn,
+				Retention: Retention{Raw: time.Hour, Interval: time.Minute},
+			}, logger.NewNopLogger())
+			assert.Nil(t, err)
+			m.isScheduled = false
+			// twice, the second run migrates an up to date schema
+			assert.Nil(t, m.OpenDb())
+			assert.Nil(t, m.OpenDb())
+			assert.Nil(t, m.PingDb())
+
+			app := m.seedApplication(ctx, t)
+			m.seedMetrics(ctx, t, app, "e1", 1000*60000, 12)
+			_, err = app.Update().SetStatus(string(jobFinished)).Save(ctx)
+			assert.Nil(t, err)
+
+			size, err := m.DbSize()
+			assert.Nil(t, err)
+			assert.True(t, size > 0)
+
+			s, err := m.DbStats(ctx)
+			assert.Nil(t, err)
+			for _, as := range s.Applications {
+				if as.ID == app.ID {
+					assert.Equal(t, 36, as.Points)
+				}
+			}
+
+			r, err := m.Maintain(ctx)
+			assert.Nil(t, err)
+			assert.True(t, r.Downsampled >= 27)
+
+			assert.Nil(t, m.DeleteApplication(ctx, app.ID))
+		})
+	}
+}
@@ -0,0 +1,89 @@
+package master
+
+import (
+	"context"
+	"time"
+
+	"github.com/gobench-io/gobench/ent"
+
+	entApp "github.com/gobench-io/gobench/ent/application"
+	entEvent "github.com/gobench-io/gobench/ent/event"
+)
+
+// sources of the timeline events
+const (
+	EventSourceScenario = "scenario"
+	EventSourceSystem   = "system"
+)
+
+// StatusEventName is the name of the events recorded on the job status
+// transitions, the new status is the status attribute
+const StatusEventName = "status"
+
+// saveEvent stores a timeline event of an application and pushes it to the
+// live stream
+func (m *Master) saveEvent(ctx context.Context, appID int, e *ent.Event) (*ent.Event, error) {
+	ec := m.db.Event.
+		Create().
+		SetName(e.Name).
+		SetTime(e.Time).
+		SetSource(e.Source).
+		SetApplicationID(appID)
+	if e.WID != "" {
+		ec.SetWID(e.WID)
+	}
+	if len(e.Attrs) > 0 {
+		ec.SetAttrs(e.Attrs)
+	}
+
+	e, err := ec.Save(ctx)
+	if err != nil {
+		return nil, err
+	}
+	m.hub.publish(&Event{
+		Type:  EventTimeline,
+		AppID: appID,
+		Data:  e,
+	})
+
+	return e, nil
+}
+
+// statusChanged records a status transition of an application as a timeline
+// event, pushes it to the live stream, posts it to the webhooks and emails
+// the end of a run to its subscribers
+func (m *Master) statusChanged(ctx context.Context, app *ent.Application) {
+	m.hub.publishStatus(app)
+	m.notifyWebhooks(ctx, app)
+	m.notifySubscribers(ctx, app)
+
+	if _, err := m.saveEvent(ctx, app.ID, &ent.Event{
+		Name:   StatusEventName,
+		Time:   time.Now().UnixNano() / 1e6,
+		Source: EventSourceSystem,
+		Attrs:  map[string]string{"status": app.Status},
+	}); err != nil {
+		m.logger.Errorw("failed save the status event",
+			"application id", app.ID,
+			"err", err,
+		)
+	}
+}
+
+// ListEvents returns the timeline events of an application in time order,
+// from and end (ms) bound the time when not zero
+func (m *Master) ListEvents(ctx context.Context, appID int, from, end int64) ([]*ent.Event, error) {
+	q := m.db.Event.
+		Query().
+		Where(entEvent.HasApplicationWith(entApp.ID(appID)))
+	if from != 0 {
+		q.Where(entEvent.TimeGTE(from))
+	}
+	if end != 0 {
+		q.Where(entEvent.TimeLTE(end))
+	}
+
+	return q.
+		Order(ent.Asc(entEvent.FieldTime), ent.Asc(entEvent.FieldID)).
+		All(ctx)
+}
@@ -0,0 +1,76 @@
+package master
+
+import (
+	"context"
+	"testing"
+
+	"github.com/gobench-io/gobench/ent"
+	"github.com/gobench-io/gobench/pb"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestEvent(t *testing.T) {
+	ctx := context.Background()
+	m := seedMaster(t)
+	app := m.seedApplication(ctx, t)
+
+	events, unsubscribe := m.Subscribe(app.ID)
+	defer unsubscribe()
+
+	for _, req := range []*pb.EventReq{
+		{AppID: int64(app.ID), EID: "e1", Time: 2000, Name: "cache flushed"},
+		{AppID: int64(app.ID), EID: "e1", Time: 1000, Name: "phase 2 started",
+			Attrs: map[string]string{"vus": "100"}},
+	} {
+		_, err := m.Event(ctx, req)
+		assert.Nil(t, err)
+	}
+
+	// pushed to the live stream
+	e := <-events
+	assert.Equal(t, EventTimeline, e.Type)
+	assert.Equal(t, "cache flushed", e.Data.(*ent.Event).Name)
+	<-events
+
+	es, err := m.ListEvents(ctx, app.ID, 0, 0)
+	assert.Nil(t, err)
+	assert.Len(t, es, 2)
+	assert.Equal(t, "phase 2 started", es[0].Name)
+	assert.Equal(t, EventSourceScenario, es[0].Source)
+	assert.Equal(t, "e1", es[0].WID)
+	assert.Equal(t, map[string]string{"vus": "100"}, es[0].Attrs)
+	assert.Equal(t, "cache flushed", es[1].Name)
+
+	t.Run("bounds", func(t *testing.T) {
+		es, err := m.ListEvents(ctx, app.ID, 1500, 0)
+		assert.Nil(t, err)
+		assert.Len(t, es, 1)
+		assert.Equal(t, "cache flushed", es[0].Name)
+
+		es, err = m.ListEvents(ctx, app.ID, 0, 1500)
+		assert.Nil(t, err)
+		assert.Len(t, es, 1)
+		assert.Equal(t, "phase 2 started", es[0].Name)
+	})
+
+	t.Run("status transition", func(t *testing.T) {
+		m.job = nil
+		_, err := m.CancelApplication(ctx, app.ID)
+		assert.Nil(t, err)
+
+		es, err := m.ListEvents(ctx, app.ID, 0, 0)
+		assert.Nil(t, err)
+		assert.Len(t, es, 3)
+		last := es[2]
+		assert.Equal(t, StatusEventName, last.Name)
+		assert.Equal(t, EventSourceSystem, last.Source)
+		assert.Equal(t, map[string]string{"status": string(jobCancel)}, last.Attrs)
+	})
+
+	t.Run("deleted with the application", func(t *testing.T) {
+		assert.Nil(t, m.DeleteApplication(ctx, app.ID))
+		es, err := m.ListEvents(ctx, app.ID, 0, 0)
+		assert.Nil(t, err)
+		assert.Len(t, es, 0)
+	})
+}
@@ -0,0 +1,183 @@
+package master
+
+import (
+	"context"
+
+	"github.com/gobench-io/gobench/ent"
+
+	entApp "github.com/gobench-io/gobench/ent/application"
+	entCounter "github.com/gobench-io/gobench/ent/counter"
+	entExecutor "github.com/gobench-io/gobench/ent/executor"
+	entGauge "github.com/gobench-io/gobench/ent/gauge"
+	entHistogram "github.com/gobench-io/gobench/ent/histogram"
+	entMetric "github.com/gobench-io/gobench/ent/metric"
+)
+
+// executorKey identifies an executor of an application
+type executorKey struct {
+	appID int
+	eID   string
+}
+
+// ExecutorEID returns the executor ID of a metric point loaded with its
+// executor, empty otherwise
+func ExecutorEID(e *ent.Executor) string {
+	if e == nil {
+		return ""
+	}
+	return e.EID
+}
+
+// executorID returns the ID of the executor entity of an application. The
+// entity is created on the first metric point of the executor.
+func (m *Master) executorID(ctx context.Context, appID int, eID string) (int, error) {
+	m.exMu.Lock()
+	defer m.exMu.Unlock()
+
+	k := executorKey{appID, eID}
+	if id, ok := m.executors[k]; ok {
+		return id, nil
+	}
+
+	id, err := m.db.Executor.
+		Query().
+		Where(
+			entExecutor.EID(eID),
+			entExecutor.HasApplicationWith(entApp.ID(appID)),
+		).
+		OnlyID(ctx)
+	if ent.IsNotFound(err) {
+		var e *ent.Executor
+		e, err = m.db.Executor.
+			Create().
+			SetEID(eID).
+			SetApplicationID(appID).
+			Save(ctx)
+		if e != nil {
+			id = e.ID
+		}
+	}
+	if err != nil {
+		return 0, err
+	}
+
+	if m.executors == nil {
+		m.executors = make(map[executorKey]int)
+	}
+	m.executors[k] = id
+
+	return id, nil
+}
+
+// forgetExecutors drops the cached executors of an application that ended
+func (m *Master) forgetExecutors(appID int) {
+	m.exMu.Lock()
+	defer m.exMu.Unlock()
+
+	for k := range m.executors {
+		if k.appID == appID {
+			delete(m.executors, k)
+		}
+	}
+}
+
+// executorsMigration names the move of the points to the executors
+const executorsMigration = "executors"
+
+// migrateExecutors moves the metric points written with the executor ID on
+// every row to the executor entities. The points are scanned once, the
+// migration is recorded when done.
+func (m *Master) migrateExecutors(ctx context.Context) error {
+	done, err := migrated(ctx, m.dbDrv, executorsMigration)
+	if err != nil || done {
+		return err
+	}
+	if err = m.moveToExecutors(ctx); err != nil {
+		return err
+	}
+	return setMigrated(ctx, m.dbDrv, executorsMigration)
+}
+
+func (m *Master) moveToExecutors(ctx context.Context) error {
+	ms, err := m.db.Metric.
+		Query().
+		Where(entMetric.Or(
+			entMetric.HasCountersWith(entCounter.WIDNEQ("")),
+			entMetric.HasHistogramsWith(entHistogram.WIDNEQ("")),
+			entMetric.HasGaugesWith(entGauge.WIDNEQ("")),
+		)).
+		All(ctx)
+	if err != nil || len(ms) == 0 {
+		return err
+	}
+
+	m.logger.Infow("moving the metric points to the executors", "metrics", len(ms))
+
+	for _, em := range ms {
+		appID, err := em.QueryGraph().QueryGroup().QueryApplication().OnlyID(ctx)
+		if ent.IsNotFound(err) {
+			continue
+		}
+		if err != nil {
+			return err
+		}
+
+		// the executor IDs of the points of the metric
+		wIDs := map[string]bool{}
+		for _, q := range []func() ([]string, error){
+			func() ([]string, error) {
+				return em.QueryCounters().Where(entCounter.WIDNEQ("")).
+					GroupBy(entCounter.FieldWID).Strings(ctx)
+			},
+			func() ([]string, error) {
+				return em.QueryHistograms().Where(entHistogram.WIDNEQ("")).
+					GroupBy(entHistogram.FieldWID).Strings(ctx)
+			},
+			func() ([]string, error) {
+				return em.QueryGauges().Where(entGauge.WIDNEQ("")).
+					GroupBy(entGauge.FieldWID).Strings(ctx)
+			},
+		} {
+			ws, err := q()
+			if err != nil {
+				return err
+			}
+			for _, w := range ws {
+				wIDs[w] = true
+			}
+		}
+
+		for w := range wIDs {
+			exID, err := m.executorID(ctx, appID, w)
+			if err != nil {
+				return err
+			}
+			if _, err = m.db.Counter.
+				Update().
+				Where(entCounter.HasMetricWith(entMetric.ID(em.ID)), entCounter.WID(w)).
+				SetExecutorID(exID).
+				SetWID("").
+				Save(ctx); err != nil {
+				return err
+			}
+			if _, err = m.db.Histogram.
+				Update().
+				Where(entHistogram.HasMetricWith(entMetric.ID(em.ID)), entHistogram.WID(w)).
+				SetExecutorID(exID).
+				SetWID("").
+				Save(ctx); err != nil {
+				return err
+			}
+			if _, err = m.db.Gauge.
+				Update().
+				Where(entGauge.HasMetricWith(entMetric.ID(em.ID)), entGauge.WID(w)).
+				SetExecutorID(exID).
+				SetWID("").
+				Save(ctx); err != nil {
+				return err
+			}
+		}
+	}
+
+	return nil
+}
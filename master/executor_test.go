@@ -0,0 +1,248 @@
+package master
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/gobench-io/gobench/ent"
+	"github.com/gobench-io/gobench/ent/migrate"
+	"github.com/gobench-io/gobench/executor/metrics"
+	"github.com/gobench-io/gobench/logger"
+	"github.com/stretchr/testify/assert"
+
+	entCounter "github.com/gobench-io/gobench/ent/counter"
+	entExecutor "github.com/gobench-io/gobench/ent/executor"
+	entGauge "github.com/gobench-io/gobench/ent/gauge"
+	entMetric "github.com/gobench-io/gobench/ent/metric"
+)
+
+func TestExecutorID(t *testing.T) {
+	ctx := context.Background()
+	m := seedRetentionMaster(t, Retention{})
+	app1 := m.seedApplication(ctx, t)
+	app2 := m.seedApplication(ctx, t)
+
+	id1, err := m.executorID(ctx, app1.ID, "e1")
+	assert.Nil(t, err)
+	id, err := m.executorID(ctx, app1.ID, "e1")
+	assert.Nil(t, err)
+	assert.Equal(t, id1, id)
+
+	// the same executor ID on another application is another executor
+	id2, err := m.executorID(ctx, app2.ID, "e1")
+	assert.Nil(t, err)
+	assert.NotEqual(t, id1, id2)
+
+	// a new master finds the executor in the db
+	m.executors = nil
+	id, err = m.executorID(ctx, app1.ID, "e1")
+	assert.Nil(t, err)
+	assert.Equal(t, id1, id)
+
+	n, err := m.db.Executor.Query().Count(ctx)
+	assert.Nil(t, err)
+	assert.Equal(t, 2, n)
+}
+
+func TestMetricPointsExecutor(t *testing.T) {
+	ctx := context.Background()
+	m := seedRetentionMaster(t, Retention{})
+	app := m.seedApplication(ctx, t)
+	cID, _, _ := m.seedMetrics(ctx, t, app, "e1", 1000, 3)
+	m.seedMetrics(ctx, t, app, "e2", 1000, 2)
+
+	es, err := app.QueryExecutors().Order(ent.Asc(entExecutor.FieldEID)).All(ctx)
+	assert.Nil(t, err)
+	assert.Len(t, es, 2)
+	assert.Equal(t, "e1", es[0].EID)
+
+	n, err := es[0].QueryCounters().
+		Where(entCounter.HasMetricWith(entMetric.ID(int(cID)))).
+		Count(ctx)
+	assert.Nil(t, err)
+	assert.Equal(t, 3, n)
+}
+
+func TestMigrateExecutors(t *testing.T) {
+	ctx := context.Background()
+	m := seedRetentionMaster(t, Retention{})
+	app := m.seedApplication(ctx, t)
+
+	// the points written before the executors have the wID only
+	cID, _, gID := m.seedMetrics(ctx, t, app, "e1", 1000, 3)
+	for _, e := range []string{"e1", "e2"} {
+		_, err := m.db.Counter.Create().SetMetricID(int(cID)).SetWID(e).
+			SetTime(50000).SetCount(400).Save(ctx)
+		assert.Nil(t, err)
+		_, err = m.db.Gauge.Create().SetMetricID(int(gID)).SetWID(e).
+			SetTime(50000).SetValue(4).Save(ctx)
+		assert.Nil(t, err)
+	}
+
+	// a database of an older version has no migration recorded
+	_, err := m.dbDrv.DB().ExecContext(ctx, "DELETE FROM migrations")
+	assert.Nil(t, err)
+	m.executors = nil
+	assert.Nil(t, m.migrateExecutors(ctx))
+
+	legacy, err := m.db.Counter.Query().Where(entCounter.WIDNEQ("")).Count(ctx)
+	assert.Nil(t, err)
+	assert.Equal(t, 0, legacy)
+	legacy, err = m.db.Gauge.Query().Where(entGauge.WIDNEQ("")).Count(ctx)
+	assert.Nil(t, err)
+	assert.Equal(t, 0, legacy)
+
+	counts := map[string]int{}
+	cs, err := m.db.Counter.Query().WithExecutor().All(ctx)
+	assert.Nil(t, err)
+	for _, c := range cs {
+		counts[ExecutorEID(c.Edges.Executor)]++
+	}
+	assert.Equal(t, map[string]int{"e1": 4, "e2": 1}, counts)
+
+	n, err := app.QueryExecutors().Count(ctx)
+	assert.Nil(t, err)
+	assert.Equal(t, 2, n)
+
+	// the points are not scanned again
+	done, err := migrated(ctx, m.dbDrv, executorsMigration)
+	assert.Nil(t, err)
+	assert.True(t, done)
+	_, err = m.db.Counter.Create().SetMetricID(int(cID)).SetWID("e3").
+		SetTime(60000).SetCount(500).Save(ctx)
+	assert.Nil(t, err)
+	assert.Nil(t, m.migrateExecutors(ctx))
+	legacy, err = m.db.Counter.Query().Where(entCounter.WIDNEQ("")).Count(ctx)
+	assert.Nil(t, err)
+	assert.Equal(t, 1, legacy)
+}
+
+func TestForgetExecutors(t *testing.T) {
+	ctx := context.Background()
+	m := seedRetentionMaster(t, Retention{})
+	app := m.seedApplication(ctx, t)
+	other := m.seedApplication(ctx, t)
+
+	m.executors = nil
+	for _, a := range []*ent.Application{app, other} {
+		_, err := m.executorID(ctx, a.ID, "e1")
+		assert.Nil(t, err)
+	}
+
+	m.forgetExecutors(app.ID)
+	assert.Len(t, m.executors, 1)
+	_, ok := m.executors[executorKey{other.ID, "e1"}]
+	assert.True(t, ok)
+}
+
+func TestMetricTimeIndexes(t *testing.T) {
+	m := seedRetentionMaster(t, Retention{})
+
+	for _, table := range []string{"counters", "histograms", "gauges"} {
+		var sql string
+		err := m.dbDrv.DB().QueryRowContext(context.Background(),
+			"SELECT sql FROM sqlite_master WHERE type = 'index' AND name = ?",
+			table+"_metric_time").Scan(&sql)
+		assert.Nil(t, err)
+		assert.Contains(t, sql, fmt.Sprintf("`metric_%s`, `time`", table))
+	}
+
+	// the generated tables are left as they are
+	for _, i := range migrate.CountersTable.Indexes {
+		assert.NotEqual(t, "counters_metric_time", i.Name)
+	}
+}
+
+// seedMetricPoints writes the counter points of a run, every 10 seconds
+// during the given seconds, and returns the ID of the last metric
+func seedMetricPoints(b *testing.B, m *Master, nMetrics, nExecutors, seconds int) int {
+	ctx := context.Background()
+	app, err := m.NewApplication(ctx, "bench", "scenario", "", "")
+	if err != nil {
+		b.Fatal(err)
+	}
+	group, err := m.db.Group.Create().SetName("HTTP").SetApplicationID(app.ID).Save(ctx)
+	if err != nil {
+		b.Fatal(err)
+	}
+	graph, err := m.db.Graph.Create().SetTitle("Response").SetUnit("N").SetGroupID(group.ID).Save(ctx)
+	if err != nil {
+		b.Fatal(err)
+	}
+
+	var mID int
+	for i := 0; i < nMetrics; i++ {
+		em, err := m.db.Metric.Create().SetTitle(fmt.Sprintf("home.%d", i)).
+			SetType(string(metrics.Counter)).SetGraphID(graph.ID).Save(ctx)
+		if err != nil {
+			b.Fatal(err)
+		}
+		mID = em.ID
+
+		for e := 0; e < nExecutors; e++ {
+			exID, err := m.executorID(ctx, app.ID, fmt.Sprintf("e%d", e))
+			if err != nil {
+				b.Fatal(err)
+			}
+			// an hour of points per insert, under the sqlite variable limit
+			bulk := make([]*ent.CounterCreate, 0, 360)
+			for s := 0; s < seconds; s += 10 {
+				bulk = append(bulk, m.db.Counter.Create().SetMetricID(em.ID).
+					SetExecutorID(exID).SetTime(int64(s)*1000).SetCount(int64(s)))
+				if len(bulk) == cap(bulk) || s+10 >= seconds {
+					if _, err = m.db.Counter.CreateBulk(bulk...).Save(ctx); err != nil {
+						b.Fatal(err)
+					}
+					bulk = bulk[:0]
+				}
+			}
+		}
+	}
+
+	return mID
+}
+
+// BenchmarkCounterWindow reads a minute of a metric out of 2.88 million
+// points, 100 metrics of 10 executors during 8 hours, with and without the
+// (metric, time) index
+func BenchmarkCounterWindow(b *testing.B) {
+	ctx := context.Background()
+	m, err := NewMaster(&Options{HomeDir: b.TempDir(), Program: "gobench"}, logger.NewNopLogger())
+	if err != nil {
+		b.Fatal(err)
+	}
+	m.isScheduled = false
+	if err = m.OpenDb(); err != nil {
+		b.Fatal(err)
+	}
+	defer m.db.Close()
+
+	mID := seedMetricPoints(b, m, 100, 10, 8*3600)
+
+	window := func(b *testing.B) {
+		for i := 0; i < b.N; i++ {
+			from := int64(i%480) * 60000
+			cs, err := m.db.Counter.Query().
+				Where(
+					entCounter.HasMetricWith(entMetric.ID(mID)),
+					entCounter.TimeGTE(from),
+					entCounter.TimeLT(from+60000),
+				).
+				WithExecutor().
+				All(ctx)
+			if err != nil {
+				b.Fatal(err)
+			}
+			if len(cs) != 60 {
+				b.Fatalf("got %d points", len(cs))
+			}
+		}
+	}
+
+	b.Run("index", window)
+	if _, err = m.dbDrv.DB().ExecContext(ctx, "DROP INDEX counters_metric_time"); err != nil {
+		b.Fatal(err)
+	}
+	b.Run("no index", window)
+}
@@ -0,0 +1,168 @@
+package master
+
+import (
+	"bufio"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"strconv"
+	"strings"
+)
+
+// ErrLogLevel is returned for an unknown log level
+var ErrLogLevel = errors.New("level must be one of debug, info, warn, error, dpanic, panic, fatal")
+
+// log levels of zap, lowest first
+var logLevels = map[string]int{
+	"debug":   0,
+	"info":    1,
+	"warn":    2,
+	"warning": 2,
+	"error":   3,
+	"dpanic":  4,
+	"panic":   5,
+	"fatal":   6,
+}
+
+// LogQuery selects the lines of an application log
+type LogQuery struct {
+	Offset   int64  // byte offset to read from
+	Tail     int    // only the last lines, 0 for all of them
+	Level    string // minimum level, empty for all lines
+	Contains string // substring the lines must have
+
+	// fields the json lines must have, as the vu, group and eid fields of
+	// the scenario logger
+	Fields map[string]string
+}
+
+// Validate checks the query
+func (q *LogQuery) Validate() error {
+	if q.Offset < 0 {
+		return errors.New("offset must not be negative")
+	}
+	if q.Tail < 0 {
+		return errors.New("tail must not be negative")
+	}
+	if q.Level != "" {
+		if _, ok := logLevels[strings.ToLower(q.Level)]; !ok {
+			return ErrLogLevel
+		}
+	}
+	return nil
+}
+
+// lineFields returns the fields of a json line, nil for other lines
+func lineFields(line string) map[string]interface{} {
+	if !strings.HasPrefix(line, "{") {
+		return nil
+	}
+	var fields map[string]interface{}
+	if err := json.Unmarshal([]byte(line), &fields); err != nil {
+		return nil
+	}
+	return fields
+}
+
+// lineLevel returns the level of a json line of zap or of a console line
+// having the level in its first fields
+func lineLevel(line string, fields map[string]interface{}) (int, bool) {
+	if level, ok := fields["level"].(string); ok {
+		l, ok := logLevels[strings.ToLower(level)]
+		return l, ok
+	}
+
+	fs := strings.Fields(line)
+	if len(fs) > 3 {
+		fs = fs[:3]
+	}
+	for _, f := range fs {
+		if l, ok := logLevels[strings.ToLower(f)]; ok {
+			return l, true
+		}
+	}
+
+	return 0, false
+}
+
+// fieldString formats a json value as it is written in a query
+func fieldString(v interface{}) string {
+	switch v := v.(type) {
+	case string:
+		return v
+	case float64:
+		return strconv.FormatFloat(v, 'f', -1, 64)
+	default:
+		return fmt.Sprint(v)
+	}
+}
+
+// Match returns whether a line is selected by the query. With a level, the
+// lines without a known level are skipped. With fields, the lines that are
+// not json are skipped.
+func (q *LogQuery) Match(line string) bool {
+	if q.Contains != "" && !strings.Contains(line, q.Contains) {
+		return false
+	}
+	if q.Level == "" && len(q.Fields) == 0 {
+		return true
+	}
+
+	fields := lineFields(line)
+	if q.Level != "" {
+		l, ok := lineLevel(line, fields)
+		if !ok || l < logLevels[strings.ToLower(q.Level)] {
+			return false
+		}
+	}
+	for k, want := range q.Fields {
+		v, ok := fields[k]
+		if !ok || fieldString(v) != want {
+			return false
+		}
+	}
+
+	return true
+}
+
+// ReadLog reads the complete lines of a log file from the query offset and
+// returns those matching the query, with the offset of the first unread
+// byte. A line still being written is left for the next read.
+func ReadLog(filename string, q *LogQuery) ([]string, int64, error) {
+	f, err := os.Open(filename)
+	if err != nil {
+		return nil, q.Offset, err
+	}
+	defer f.Close()
+
+	if _, err = f.Seek(q.Offset, io.SeekStart); err != nil {
+		return nil, q.Offset, err
+	}
+
+	lines := []string{}
+	offset := q.Offset
+	br := bufio.NewReader(f)
+	for {
+		line, err := br.ReadString('\n')
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return nil, offset, err
+		}
+		offset += int64(len(line))
+
+		line = strings.TrimSuffix(line, "\n")
+		if !q.Match(line) {
+			continue
+		}
+		lines = append(lines, line)
+		if q.Tail > 0 && len(lines) > q.Tail {
+			lines = lines[1:]
+		}
+	}
+
+	return lines, offset, nil
+}
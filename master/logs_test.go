@@ -0,0 +1,113 @@
+package master
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestLineLevel(t *testing.T) {
+	for _, tt := range []struct {
+		line  string
+		level int
+		ok    bool
+	}{
+		{`{"level":"info","ts":1,"msg":"job new status"}`, 1, true},
+		{`{"level":"error","ts":1,"msg":"failed"}`, 3, true},
+		{"2020-10-01T10:00:00.000Z\tWARN\tconnection reset", 2, true},
+		{"2020-10-01T10:00:00.000Z\tinfo\tno error here", 1, true},
+		{"plain output with an error word far away", 0, false},
+		{"", 0, false},
+	} {
+		l, ok := lineLevel(tt.line, lineFields(tt.line))
+		assert.Equal(t, tt.ok, ok, tt.line)
+		assert.Equal(t, tt.level, l, tt.line)
+	}
+}
+
+func TestReadLog(t *testing.T) {
+	dir, err := ioutil.TempDir("", "gobench-log")
+	assert.Nil(t, err)
+	defer os.RemoveAll(dir)
+
+	filename := filepath.Join(dir, "system.log")
+	content := `{"level":"debug","msg":"one"}
+{"level":"info","msg":"two"}
+{"level":"error","msg":"three"}
+{"level":"info","msg":"four"}
+{"level":"warn","msg":"fi`
+	assert.Nil(t, ioutil.WriteFile(filename, []byte(content), 0644))
+
+	t.Run("all", func(t *testing.T) {
+		lines, offset, err := ReadLog(filename, &LogQuery{})
+		assert.Nil(t, err)
+		assert.Len(t, lines, 4)
+		// the unfinished line is left
+		assert.Equal(t, int64(len(content)-len(`{"level":"warn","msg":"fi`)), offset)
+
+		// continue from the offset
+		f, err := os.OpenFile(filename, os.O_APPEND|os.O_WRONLY, 0644)
+		assert.Nil(t, err)
+		_, err = f.WriteString("ve\"}\n")
+		assert.Nil(t, err)
+		assert.Nil(t, f.Close())
+
+		lines, _, err = ReadLog(filename, &LogQuery{Offset: offset})
+		assert.Nil(t, err)
+		assert.Equal(t, []string{`{"level":"warn","msg":"five"}`}, lines)
+	})
+
+	t.Run("tail", func(t *testing.T) {
+		lines, _, err := ReadLog(filename, &LogQuery{Tail: 2})
+		assert.Nil(t, err)
+		assert.Equal(t, []string{
+			`{"level":"info","msg":"four"}`,
+			`{"level":"warn","msg":"five"}`,
+		}, lines)
+	})
+
+	t.Run("filters", func(t *testing.T) {
+		lines, _, err := ReadLog(filename, &LogQuery{Level: "WARN"})
+		assert.Nil(t, err)
+		assert.Len(t, lines, 2)
+
+		lines, _, err = ReadLog(filename, &LogQuery{Level: "info", Contains: "f", Tail: 1})
+		assert.Nil(t, err)
+		assert.Equal(t, []string{`{"level":"warn","msg":"five"}`}, lines)
+	})
+
+	t.Run("not found", func(t *testing.T) {
+		_, _, err := ReadLog(filepath.Join(dir, "user.log"), &LogQuery{})
+		assert.True(t, os.IsNotExist(err))
+	})
+}
+
+func TestLogQueryFields(t *testing.T) {
+	line := `{"level":"info","msg":"connected","eid":"host-1","vu":3,"group":0}`
+	plain := "connected vu 3"
+
+	for _, tt := range []struct {
+		fields map[string]string
+		match  bool
+	}{
+		{map[string]string{"vu": "3"}, true},
+		{map[string]string{"vu": "3", "group": "0", "eid": "host-1"}, true},
+		{map[string]string{"vu": "4"}, false},
+		{map[string]string{"group": "1"}, false},
+		{map[string]string{"missing": "x"}, false},
+	} {
+		q := &LogQuery{Fields: tt.fields}
+		assert.Equal(t, tt.match, q.Match(line), tt.fields)
+		assert.False(t, q.Match(plain), tt.fields)
+	}
+}
+
+func TestLogQueryValidate(t *testing.T) {
+	assert.Nil(t, (&LogQuery{Level: "Error", Tail: 1}).Validate())
+	assert.Equal(t, ErrLogLevel, (&LogQuery{Level: "verbose"}).Validate())
+	assert.NotNil(t, (&LogQuery{Offset: -1}).Validate())
+	assert.NotNil(t, (&LogQuery{Tail: -1}).Validate())
+}
@@ -1,6 +1,7 @@
 package master
 
 import (
+	"encoding/json"
 	"errors"
 	"fmt"
 	"io"
@@ -19,13 +20,18 @@ import (
 	"github.com/gobench-io/gobench/agent"
 	"github.com/gobench-io/gobench/ent"
 	"github.com/gobench-io/gobench/ent/application"
+	"github.com/gobench-io/gobench/ent/baseline"
+	"github.com/gobench-io/gobench/ent/event"
+	"github.com/gobench-io/gobench/ent/project"
+	"github.com/gobench-io/gobench/ent/subscription"
 	"github.com/gobench-io/gobench/ent/tag"
 	"github.com/gobench-io/gobench/executor"
 	"github.com/gobench-io/gobench/logger"
+	"github.com/gobench-io/gobench/sink"
 
+	"github.com/facebook/ent/dialect"
 	"github.com/facebook/ent/dialect/sql"
 	"github.com/google/uuid"
-	_ "github.com/mattn/go-sqlite3"
 )
 
 // job status. The job is in either pending, provisioning, running, finished
@@ -52,13 +58,32 @@ type Master struct {
 
 	// database
 	isScheduled bool
+	hasSignals  bool // exit on SIGINT
 	homeDir     string
-	dbFilename  string
+	dbDriver    string
+	dbDSN       string
+	dbFilename  string // the sqlite3 database file
 	db          *ent.Client
 	dbDrv       *sql.Driver
 
 	la  *agent.Agent // local agent
 	job *job
+	hub *hub // live stream of the applications
+
+	webhooks *webhookSender
+
+	smtp         SMTP
+	dashboardURL string         // base URL of the links of the emails
+	mails        sync.WaitGroup // emails being sent
+
+	ingested    uint64 // number of metric points saved, access atomically
+	sinkDropped uint64 // number of metric points dropped by the sinks, access atomically
+
+	retention Retention
+	maint     maintenance
+
+	exMu      sync.Mutex          // protects executors
+	executors map[executorKey]int // executor entity IDs
 }
 
 type job struct {
@@ -71,6 +96,11 @@ type job struct {
 	ulogWriter io.WriteCloser
 	logger     logger.Logger
 	cancel     context.CancelFunc
+	onStatus   func(context.Context, *ent.Application) // status transition hook
+
+	sinks  sink.Fanout           // external metric sinks of the application
+	metaMu sync.Mutex            // protects metas
+	metas  map[int64]*metricMeta // metric ID - metric group/graph/title
 }
 
 type Options struct {
@@ -78,6 +108,19 @@ type Options struct {
 	Addr    string
 	Program string
 	HomeDir string
+
+	// database, sqlite3 by default on a file in the home directory
+	DbDriver string
+	DbDSN    string
+
+	// Retention of the metric points, the zero value keeps them forever
+	Retention Retention
+
+	// SMTP server of the email notifications, none without a host
+	SMTP SMTP
+	// DashboardURL is the base URL of the links of the emails, default
+	// http://<hostname>:<port>
+	DashboardURL string
 }
 
 // NewMaster will setup a new master struct given options and logger.
@@ -109,12 +152,37 @@ func NewMaster(opts *Options, logger logger.Logger) (m *Master, err error) {
 		homeDir: opts.HomeDir,
 		logger:  logger,
 		program: opts.Program,
+		hub:     newHub(),
+
+		webhooks: newWebhookSender(),
+
+		retention: opts.Retention,
+
+		smtp:         opts.SMTP,
+		dashboardURL: opts.DashboardURL,
+	}
+	if m.dashboardURL == "" {
+		m.dashboardURL = fmt.Sprintf("http://%s:%d", hostname, opts.Port)
 	}
 
 	m.start = time.Now()
-	m.dbFilename = path.Join(m.homeDir, "gobench.sqlite3")
+	if err = ValidateDb(opts.DbDriver, opts.DbDSN); err != nil {
+		return
+	}
+	m.dbDriver = opts.DbDriver
+	m.dbDSN = opts.DbDSN
+	if m.dbDriver == "" {
+		m.dbDriver = dialect.SQLite
+	}
+	if m.dbDriver == dialect.SQLite {
+		if m.dbDSN == "" {
+			m.dbDSN = path.Join(m.homeDir, "gobench.sqlite3")
+		}
+		m.dbFilename = m.dbDSN
+	}
 
 	m.isScheduled = true // by default
+	m.hasSignals = true
 
 	agentSocket := fmt.Sprintf("/tmp/gobench-agentsocket-%d", os.Getpid())
 	la, err := agent.NewAgent(&agent.Options{Socket: agentSocket}, m, logger)
@@ -142,23 +210,60 @@ func (m *Master) SetIsScheduled(is bool) *Master {
 	return m
 }
 
+// SetHandleSignals tells if the master shuts down the process on SIGINT, the
+// default. The headless runs cancel their application instead.
+func (m *Master) SetHandleSignals(handle bool) *Master {
+	m.mu.Lock()
+	m.hasSignals = handle
+	m.mu.Unlock()
+	return m
+}
+
 func (m *Master) Start() (err error) {
 	if err = m.setupDb(); err != nil {
 		return
 	}
 
-	m.handleSignals()
+	if m.hasSignals {
+		m.handleSignals()
+	}
 
 	if m.isScheduled {
 		go m.schedule()
 	}
 
+	if m.retention.Every > 0 {
+		go m.runMaintenance(m.retention.Every)
+	}
+
 	// start the local agent socket server that communicate with local executor
 	err = m.la.StartSocketServer()
 
 	return
 }
 
+// OpenDb opens the database only, without the scheduler and the local agent.
+// It is used by the offline commands.
+func (m *Master) OpenDb() error {
+	return m.setupDb()
+}
+
+// OpenDbReadOnly opens the database without migrating or writing it, for the
+// offline commands that only read
+func (m *Master) OpenDbReadOnly() error {
+	drv, err := openDbReadOnly(m.dbDriver, m.dbDSN)
+	if err != nil {
+		return err
+	}
+
+	m.mu.Lock()
+	m.db = ent.NewClient(ent.Driver(drv))
+	m.dbDrv = drv
+	m.mu.Unlock()
+
+	return nil
+}
+
 // CleanupRunningApps update last running app status from running -> error
 // It should be called when the master is booted
 func (m *Master) CleanupRunningApps() (err error) {
@@ -173,6 +278,7 @@ func (m *Master) CleanupRunningApps() (err error) {
 			application.Status(string(statusRunning)),
 		).
 		SetStatus(string(statusCancel)).
+		SetFinishedAt(time.Now()).
 		Save(ctx)
 
 	return
@@ -202,7 +308,8 @@ func (m *Master) finish(status status) error {
 	_ = m.logger.Sync()
 
 	// todo: if there is a running scenario, shutdown
-	// todo: send email if needed
+	// the emails of the ended runs are sent before leaving
+	m.mails.Wait()
 	return m.db.Close()
 }
 
@@ -211,19 +318,58 @@ func (m *Master) WebPort() int {
 	return m.port
 }
 
+// ApplicationOption sets an optional property of a new application
+type ApplicationOption func(*ent.ApplicationCreate)
+
+// WithSinks sets the metric sinks of a new application. Their tokens are
+// stored apart, in a field never rendered.
+func WithSinks(sinks []sink.Config) ApplicationOption {
+	return func(ac *ent.ApplicationCreate) {
+		ac.SetSinks(sinks)
+
+		tokens := make([]string, len(sinks))
+		found := false
+		for i, c := range sinks {
+			tokens[i] = c.Token
+			found = found || c.Token != ""
+		}
+		if found {
+			b, _ := json.Marshal(tokens)
+			ac.SetSinkTokens(string(b))
+		}
+	}
+}
+
+// WithoutScheduler creates an application the schedulers skip, for
+// RunApplication. A scheduler of another master on the same database never
+// picks it up.
+func WithoutScheduler() ApplicationOption {
+	return func(ac *ent.ApplicationCreate) {
+		ac.SetStatus(string(jobProvisioning))
+	}
+}
+
 // NewApplication create a new application with a name and a scenario
 // return the application id and error
-func (m *Master) NewApplication(ctx context.Context, name, scenario, gomod, gosum string) (
-	*ent.Application, error,
-) {
-	return m.db.Application.
+func (m *Master) NewApplication(ctx context.Context, name, scenario, gomod, gosum string,
+	opts ...ApplicationOption,
+) (*ent.Application, error) {
+	ac := m.db.Application.
 		Create().
 		SetName(name).
 		SetScenario(scenario).
 		SetGomod(gomod).
 		SetGosum(gosum).
-		SetStatus(string(jobPending)).
-		Save(ctx)
+		SetStatus(string(jobPending))
+
+	for _, opt := range opts {
+		opt(ac)
+	}
+	if err := m.inProject(ctx, ac); err != nil {
+		return nil, err
+	}
+
+	return ac.Save(ctx)
 }
 
 // DeleteApplication a pending/finished/canceled/error application
@@ -242,11 +388,54 @@ func (m *Master) DeleteApplication(ctx context.Context, appID int) error {
 		return fmt.Errorf(ErrCantDeleteApp.Error(), string(app.Status))
 	}
 
+	// the baselines of the application are meaningless without it
+	if _, err = m.db.Baseline.
+		Delete().
+		Where(baseline.HasApplicationWith(application.ID(appID))).
+		Exec(ctx); err != nil {
+		return err
+	}
+	if _, err = m.db.Event.
+		Delete().
+		Where(event.HasApplicationWith(application.ID(appID))).
+		Exec(ctx); err != nil {
+		return err
+	}
+	if _, err = m.db.Subscription.
+		Delete().
+		Where(subscription.ApplicationID(appID)).
+		Exec(ctx); err != nil {
+		return err
+	}
+
 	return m.db.Application.
 		DeleteOneID(appID).
 		Exec(ctx)
 }
 
+// WaitApplication polls an application until it is finished, canceled or in
+// error
+func (m *Master) WaitApplication(ctx context.Context, appID int, every time.Duration) (*ent.Application, error) {
+	t := time.NewTicker(every)
+	defer t.Stop()
+
+	for {
+		app, err := m.db.Application.Get(ctx, appID)
+		if err != nil {
+			return nil, err
+		}
+		if IsTerminal(app.Status) {
+			return app, nil
+		}
+
+		select {
+		case <-ctx.Done():
+			return app, ctx.Err()
+		case <-t.C:
+		}
+	}
+}
+
 // CancelApplication terminates an application
 // if the app is running, send cancel signal
 // if the app is finished/error, return ErrAppIsFinished error
@@ -289,10 +478,17 @@ func (m *Master) CancelApplication(ctx context.Context, appID int) (*ent.Applica
 	}
 
 	// else, update the status on db
-	return m.db.Application.
+	app, err = m.db.Application.
 		UpdateOneID(appID).
 		SetStatus(string(jobCancel)).
+		SetFinishedAt(time.Now()).
 		Save(ctx)
+	if err != nil {
+		return nil, err
+	}
+	m.statusChanged(ctx, app)
+
+	return app, nil
 }
 
 // GetTagByID get a tag for an application
@@ -326,6 +522,23 @@ func (m *Master) GetTagByApplication(ctx context.Context, app *ent.Application,
 	return tag, nil
 }
 
+// GetApplicationTagByID get a tag of an application, nil when the tag
+// belongs to another application
+func (m *Master) GetApplicationTagByID(ctx context.Context, appID, tagID int) (*ent.Tag, error) {
+	tag, err := m.db.Tag.
+		Query().
+		Where(tag.ID(tagID)).
+		Where(tag.HasApplicationWith(application.ID(appID))).
+		Only(ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, nil
+		}
+		return nil, err
+	}
+	return tag, nil
+}
+
 // SetApplicationTag create and new tag and assign it for an application
 func (m *Master) SetApplicationTag(ctx context.Context, appID int, tag string) (*ent.Tag, error) {
 	return m.db.Tag.
@@ -357,16 +570,13 @@ func (m *Master) setupDb() error {
 		return err
 	}
 
-	drv, err := sql.Open(
-		"sqlite3",
-		m.dbFilename+"?mode=rwc&cache=shared&&_busy_timeout=9999999&_fk=1",
-	)
+	drv, err := openDb(m.dbDriver, m.dbDSN)
 	if err != nil {
-		return fmt.Errorf("failed opening sqlite3 connection: %v", err)
+		return err
 	}
 	client := ent.NewClient(ent.Driver(drv))
 
-	if err = client.Schema.Create(context.Background()); err != nil {
+	if err = migrateDb(context.Background(), drv); err != nil {
 		return fmt.Errorf("failed creating schema resources: %v", err)
 	}
 
@@ -375,6 +585,13 @@ func (m *Master) setupDb() error {
 	m.dbDrv = drv
 	m.mu.Unlock()
 
+	if err = m.migrateExecutors(context.Background()); err != nil {
+		return fmt.Errorf("failed moving the metric points to the executors: %v", err)
+	}
+	if err = m.ensureDefaultProject(context.Background()); err != nil {
+		return fmt.Errorf("failed creating the default project: %v", err)
+	}
+
 	return nil
 }
 
@@ -389,23 +606,51 @@ func (m *Master) schedule() {
 		if err != nil {
 			continue
 		}
-		job := &job{
-			app:    app,
-			cancel: cancel,
-		}
-
-		if _, err = job.setLogs(m.Logpaths(app.ID)); err != nil {
+		if err = m.runApplication(ctx, cancel, app); err != nil {
 			m.logger.Errorw("failed set job logger", "err", err)
-			continue
 		}
-		defer job.ulogWriter.Close()
+	}
+}
+
+// RunApplication runs an application created WithoutScheduler, and returns
+// after its run. It is used without the scheduler, by the headless runs. The
+// result of the run is the status of the application.
+func (m *Master) RunApplication(ctx context.Context, appID int) error {
+	app, err := m.db.Application.Get(ctx, appID)
+	if err != nil {
+		return err
+	}
+	if app.Status != string(jobProvisioning) {
+		return fmt.Errorf("application %d is %s, not created without scheduler", appID, app.Status)
+	}
 
-		m.job = job
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
 
-		if err = m.run(ctx); err != nil {
-			m.logger.Errorw("failed run the job", "err", err)
-		}
+	return m.runApplication(ctx, cancel, app)
+}
+
+// runApplication runs an application as the job of the master. The errors of
+// the run end in the status of the application, only the error of the job
+// logger is returned.
+func (m *Master) runApplication(ctx context.Context, cancel context.CancelFunc, app *ent.Application) error {
+	job := &job{
+		app:      app,
+		cancel:   cancel,
+		onStatus: m.statusChanged,
+	}
+
+	if _, err := job.setLogs(m.Logpaths(app.ID)); err != nil {
+		return err
+	}
+	defer job.ulogWriter.Close()
+
+	m.job = job
+
+	if err := m.run(ctx); err != nil {
+		m.logger.Errorw("failed run the job", "err", err)
 	}
+	return nil
 }
 
 func (m *Master) run(ctx context.Context) (err error) {
@@ -432,9 +677,27 @@ func (m *Master) run(ctx context.Context) (err error) {
 			}
 		}
 
+		if err := j.closeSinks(); err != nil {
+			j.logger.Errorw("failed close metric sinks", "err", err)
+		}
+
 		// create new context
 		ctx := context.TODO()
+
+		// judge before the final status, so that a finished application
+		// always has its verdict
+		if je == jobFinished {
+			// a failed judge keeps the application without a verdict
+			app, err := m.judge(ctx, j.app)
+			if err != nil {
+				j.logger.Errorw("failed judge the application against its baseline", "err", err)
+			} else {
+				j.app = app
+			}
+		}
+
 		_ = j.setStatus(ctx, je)
+		m.forgetExecutors(j.app.ID)
 	}()
 
 	// change job to provisioning
@@ -445,6 +708,10 @@ func (m *Master) run(ctx context.Context) (err error) {
 	if err = m.jobCompile(ctx); err != nil {
 		return
 	}
+
+	if err = j.openSinks(m.SinkDir()); err != nil {
+		return
+	}
 	// todo: ditribute the plugin to other worker when run in cloud mode
 	// in this phase, the server run in local mode
 
@@ -488,19 +755,74 @@ func (m *Master) provision() (*ent.Application, error) {
 	return nil, nil
 }
 
+// nextApplication returns the oldest pending application of the project
+// that started a run the longest ago, so the projects take turns. The
+// applications without a project take their turn together, like a project.
 func (m *Master) nextApplication(ctx context.Context) (*ent.Application, error) {
-	app, err := m.db.
-		Application.
+	// nothing to run, the usual tick
+	oldest, err := m.db.Application.
 		Query().
-		Where(
-			application.Status(string(jobPending)),
-		).
-		Order(
-			ent.Asc(application.FieldCreatedAt),
-		).
+		Where(application.Status(string(jobPending))).
+		Order(ent.Asc(application.FieldCreatedAt)).
 		First(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	projectIDs, err := m.db.Project.
+		Query().
+		Where(project.HasApplicationsWith(application.Status(string(jobPending)))).
+		Order(ent.Asc(project.FieldID)).
+		IDs(ctx)
+	if err != nil {
+		return nil, err
+	}
+	orphans, err := m.db.Application.
+		Query().
+		Where(application.Status(string(jobPending)), ofProject(0)).
+		Exist(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if orphans {
+		projectIDs = append(projectIDs, 0)
+	}
+
+	var (
+		next *ent.Application
+		last time.Time
+	)
+	for _, id := range projectIDs {
+		started, err := m.lastStart(ctx, id)
+		if err != nil {
+			return nil, err
+		}
+		if next != nil && started.After(last) {
+			continue
+		}
+		app, err := m.db.Application.
+			Query().
+			Where(application.Status(string(jobPending)), ofProject(id)).
+			Order(ent.Asc(application.FieldCreatedAt)).
+			First(ctx)
+		if ent.IsNotFound(err) {
+			// started in the meantime
+			continue
+		}
+		if err != nil {
+			return nil, err
+		}
+		// the projects that never ran, or ran at the same time, take turns
+		// by their oldest application
+		if next == nil || started.Before(last) || app.CreatedAt.Before(next.CreatedAt) {
+			next, last = app, started
+		}
+	}
 
-	return app, err
+	if next == nil {
+		return oldest, nil
+	}
+	return next, nil
 }
 
 func fileToSave(dir, file string) (*os.File, string, error) {
@@ -643,18 +965,25 @@ func (j *job) setLogs(f, sf, uf string) (*job, error) {
 }
 
 func (j *job) setStatus(ctx context.Context, state jobState) (err error) {
-	j.app, err = j.app.Update().
-		SetStatus(string(state)).
-		Save(ctx)
-
+	au := j.app.Update().
+		SetStatus(string(state))
+	if IsTerminal(string(state)) {
+		au.SetFinishedAt(time.Now())
+	}
+	// a failed update keeps the application, for the final status
+	app, err := au.Save(ctx)
 	if err != nil {
 		return
 	}
+	j.app = app
 
 	j.logger.Infow("job new status",
 		"application id", j.app.ID,
 		"status", j.app.Status,
 	)
+	if j.onStatus != nil {
+		j.onStatus(ctx, j.app)
+	}
 
 	return
 }
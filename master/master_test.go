@@ -9,7 +9,9 @@ import (
 	"time"
 
 	"github.com/gobench-io/gobench/ent"
+	"github.com/gobench-io/gobench/executor/metrics"
 	"github.com/gobench-io/gobench/logger"
+	"github.com/gobench-io/gobench/pb"
 	_ "github.com/mattn/go-sqlite3"
 	"github.com/stretchr/testify/assert"
 )
@@ -42,6 +44,63 @@ func (m *Master) seedApplication(ctx context.Context, t *testing.T) *ent.Applica
 	return app
 }
 
+// seedMetrics creates a group with a counter, a histogram and a gauge metric
+// for an application, then logs the given number of points from an executor
+// every 10 seconds starting at t0 (ms)
+func (m *Master) seedMetrics(ctx context.Context, t *testing.T, app *ent.Application,
+	eID string, t0 int64, points int,
+) (counterID, histogramID, gaugeID int64) {
+	if m.job == nil {
+		m.job = &job{}
+	}
+	m.job.app = app
+
+	group, err := m.FindCreateGroup(ctx, &pb.FCGroupReq{AppID: int64(app.ID), Name: "HTTP"})
+	assert.Nil(t, err)
+	graph, err := m.FindCreateGraph(ctx, &pb.FCGraphReq{
+		AppID: int64(app.ID), Title: "Response", Unit: "N", GroupID: group.Id,
+	})
+	assert.Nil(t, err)
+
+	ids := []int64{}
+	for _, mt := range []struct {
+		title string
+		typ   metrics.MetricType
+	}{
+		{"home.http_ok", metrics.Counter},
+		{"home.latency", metrics.Histogram},
+		{"home.conns", metrics.Gauge},
+	} {
+		metric, err := m.FindCreateMetric(ctx, &pb.FCMetricReq{
+			AppID: int64(app.ID), Title: mt.title, Type: string(mt.typ), GraphID: graph.Id,
+		})
+		assert.Nil(t, err)
+		ids = append(ids, metric.Id)
+	}
+	counterID, histogramID, gaugeID = ids[0], ids[1], ids[2]
+
+	for i := 1; i <= points; i++ {
+		ts := t0 + int64(i)*10000
+		base := func(mID int64) *pb.BasedReqMetric {
+			return &pb.BasedReqMetric{AppID: int64(app.ID), EID: eID, MID: mID, Time: ts}
+		}
+		_, err = m.Counter(ctx, &pb.CounterReq{Base: base(counterID), Count: int64(i * 100)})
+		assert.Nil(t, err)
+		_, err = m.Histogram(ctx, &pb.HistogramReq{
+			Base: base(histogramID),
+			Histogram: &pb.HistogramValues{
+				Count: int64(i * 100), Min: 1, Max: 100, Mean: 10, Stddev: 1,
+				Median: 10, P75: 20, P95: 30, P99: 40, P999: 50,
+			},
+		})
+		assert.Nil(t, err)
+		_, err = m.Gauge(ctx, &pb.GaugeReq{Base: base(gaugeID), Gauge: int64(i)})
+		assert.Nil(t, err)
+	}
+
+	return
+}
+
 func localGobenchMod(t *testing.T) string {
 	testDir, _ := os.Getwd()
 	mainDir, _ := exec.Command("dirname", testDir).CombinedOutput()
@@ -94,6 +153,43 @@ func TestNextApplication(t *testing.T) {
 	})
 }
 
+func TestJobSetStatusFailed(t *testing.T) {
+	ctx := context.Background()
+	m := seedMaster(t)
+	app := m.seedApplication(ctx, t)
+
+	// the cancelled run keeps its application for the final status
+	cctx, cancel := context.WithCancel(ctx)
+	cancel()
+	j := &job{app: app}
+	assert.NotNil(t, j.setStatus(cctx, jobRunning))
+	assert.Equal(t, app, j.app)
+}
+
+func TestRunApplication(t *testing.T) {
+	ctx := context.Background()
+	m := seedMaster(t)
+	assert.Nil(t, m.cleanupDB())
+
+	pending, err := m.NewApplication(ctx, "pending", "scenario", "", "")
+	assert.Nil(t, err)
+	assert.NotNil(t, m.RunApplication(ctx, pending.ID))
+	_ = m.DeleteApplication(ctx, pending.ID)
+
+	// the schedulers skip the application
+	app, err := m.NewApplication(ctx, "headless", "not a scenario", "", "", WithoutScheduler())
+	assert.Nil(t, err)
+	_, err = m.nextApplication(ctx)
+	assert.True(t, ent.IsNotFound(err))
+
+	// the failed compile is the result of the run
+	assert.Nil(t, m.RunApplication(ctx, app.ID))
+	app, err = m.db.Application.Get(ctx, app.ID)
+	assert.Nil(t, err)
+	assert.Equal(t, string(jobError), app.Status)
+	assert.False(t, app.FinishedAt.IsZero())
+}
+
 func TestCompile(t *testing.T) {
 	t.Run("invalid scenario", func(t *testing.T) {
 		ctx := context.Background()
@@ -5,6 +5,7 @@ import (
 
 	"github.com/gobench-io/gobench/ent"
 	"github.com/gobench-io/gobench/pb"
+	"github.com/gobench-io/gobench/sink"
 
 	entApp "github.com/gobench-io/gobench/ent/application"
 	entGraph "github.com/gobench-io/gobench/ent/graph"
@@ -14,8 +15,12 @@ import (
 
 func (m *Master) Counter(ctx context.Context, req *pb.CounterReq) (*pb.CounterRes, error) {
 	// todo: check appID condition
-	_, err := m.db.Counter.Create().
-		SetWID(req.Base.EID).
+	exID, err := m.executorID(ctx, int(req.Base.AppID), req.Base.EID)
+	if err != nil {
+		return nil, err
+	}
+	c, err := m.db.Counter.Create().
+		SetExecutorID(exID).
 		SetMetricID(int(req.Base.MID)).
 		SetTime(req.Base.Time).
 		SetCount(req.Count).
@@ -23,6 +28,12 @@ func (m *Master) Counter(ctx context.Context, req *pb.CounterReq) (*pb.CounterRe
 	if err != nil {
 		return nil, err
 	}
+	m.addIngested(1)
+	m.hub.publishPoint(EventCounter, req.Base.AppID, req.Base.MID, req.Base.EID, c)
+
+	m.toSinks(ctx, req.Base, func(p *sink.Point) {
+		p.Count = req.Count
+	})
 
 	res := new(pb.CounterRes)
 
@@ -31,8 +42,12 @@ func (m *Master) Counter(ctx context.Context, req *pb.CounterReq) (*pb.CounterRe
 
 func (m *Master) Histogram(ctx context.Context, req *pb.HistogramReq) (*pb.HistogramRes, error) {
 	// todo: check appID condition
-	_, err := m.db.Histogram.Create().
-		SetWID(req.Base.EID).
+	exID, err := m.executorID(ctx, int(req.Base.AppID), req.Base.EID)
+	if err != nil {
+		return nil, err
+	}
+	h, err := m.db.Histogram.Create().
+		SetExecutorID(exID).
 		SetMetricID(int(req.Base.MID)).
 		SetTime(req.Base.Time).
 		SetCount(req.Histogram.Count).
@@ -49,6 +64,12 @@ func (m *Master) Histogram(ctx context.Context, req *pb.HistogramReq) (*pb.Histo
 	if err != nil {
 		return nil, err
 	}
+	m.addIngested(1)
+	m.hub.publishPoint(EventHistogram, req.Base.AppID, req.Base.MID, req.Base.EID, h)
+
+	m.toSinks(ctx, req.Base, func(p *sink.Point) {
+		p.Histogram = req.Histogram
+	})
 
 	res := new(pb.HistogramRes)
 
@@ -57,8 +78,12 @@ func (m *Master) Histogram(ctx context.Context, req *pb.HistogramReq) (*pb.Histo
 
 func (m *Master) Gauge(ctx context.Context, req *pb.GaugeReq) (*pb.GaugeRes, error) {
 	// todo: check appID condition
-	_, err := m.db.Gauge.Create().
-		SetWID(req.Base.EID).
+	exID, err := m.executorID(ctx, int(req.Base.AppID), req.Base.EID)
+	if err != nil {
+		return nil, err
+	}
+	g, err := m.db.Gauge.Create().
+		SetExecutorID(exID).
 		SetMetricID(int(req.Base.MID)).
 		SetTime(req.Base.Time).
 		SetValue(req.Gauge).
@@ -66,12 +91,35 @@ func (m *Master) Gauge(ctx context.Context, req *pb.GaugeReq) (*pb.GaugeRes, err
 	if err != nil {
 		return nil, err
 	}
+	m.addIngested(1)
+	m.hub.publishPoint(EventGauge, req.Base.AppID, req.Base.MID, req.Base.EID, g)
+
+	m.toSinks(ctx, req.Base, func(p *sink.Point) {
+		p.Value = req.Gauge
+	})
 
 	res := new(pb.GaugeRes)
 
 	return res, nil
 }
 
+// Event saves a timeline event of the scenario
+func (m *Master) Event(ctx context.Context, req *pb.EventReq) (*pb.EventRes, error) {
+	if _, err := m.saveEvent(ctx, int(req.AppID), &ent.Event{
+		Name:   req.Name,
+		Time:   req.Time,
+		Source: EventSourceScenario,
+		WID:    req.EID,
+		Attrs:  req.Attrs,
+	}); err != nil {
+		return nil, err
+	}
+
+	res := new(pb.EventRes)
+
+	return res, nil
+}
+
 // FindCreateGroup find or create new group
 // return the existing/new group ent, is created, and error
 func (m *Master) FindCreateGroup(ctx context.Context, req *pb.FCGroupReq) (res *pb.FCGroupRes, err error) {
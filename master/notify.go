@@ -0,0 +1,212 @@
+package master
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"mime"
+	"net"
+	"net/smtp"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/gobench-io/gobench/ent"
+	"github.com/gobench-io/gobench/ent/predicate"
+
+	entApp "github.com/gobench-io/gobench/ent/application"
+	entSub "github.com/gobench-io/gobench/ent/subscription"
+	entTag "github.com/gobench-io/gobench/ent/tag"
+	entUser "github.com/gobench-io/gobench/ent/user"
+)
+
+// SMTP is the mail server of the notifications, no email is sent without a
+// host
+type SMTP struct {
+	Host     string
+	Port     int
+	Username string // no authentication when empty
+	Password string
+	From     string
+}
+
+func (s SMTP) enabled() bool {
+	return s.Host != ""
+}
+
+// ErrSubscription is returned for a subscription to neither or both an
+// application and a tag
+var ErrSubscription = errors.New("subscribe to either an application or a tag")
+
+// notifyStatuses are the ends of a run emailed to the subscribers
+var notifyStatuses = map[string]bool{
+	string(jobFinished): true,
+	string(jobError):    true,
+	string(jobCancel):   true,
+}
+
+// CreateSubscription subscribes a user to the end of an application, or of
+// the applications with a tag
+func (m *Master) CreateSubscription(ctx context.Context, userID, appID int, tag string) (*ent.Subscription, error) {
+	if (appID == 0) == (tag == "") {
+		return nil, ErrSubscription
+	}
+	sc := m.db.Subscription.
+		Create().
+		SetUserID(userID)
+	if appID != 0 {
+		sc.SetApplicationID(appID)
+	} else {
+		sc.SetTag(tag)
+	}
+	return sc.Save(ctx)
+}
+
+// ListSubscriptions returns the subscriptions of a user, the latest first
+func (m *Master) ListSubscriptions(ctx context.Context, userID int) ([]*ent.Subscription, error) {
+	return m.db.Subscription.
+		Query().
+		Where(entSub.HasUserWith(entUser.ID(userID))).
+		Order(ent.Desc(entSub.FieldID)).
+		All(ctx)
+}
+
+// DeleteSubscription deletes a subscription of a user
+func (m *Master) DeleteSubscription(ctx context.Context, userID, id int) error {
+	s, err := m.db.Subscription.
+		Query().
+		Where(entSub.ID(id), entSub.HasUserWith(entUser.ID(userID))).
+		Only(ctx)
+	if err != nil {
+		return err
+	}
+	return m.db.Subscription.DeleteOne(s).Exec(ctx)
+}
+
+// subscribers returns the users with an email subscribed to an application
+// or to one of its tags, who see the application
+func (m *Master) subscribers(ctx context.Context, app *ent.Application) ([]*ent.User, error) {
+	tags, err := m.db.Tag.
+		Query().
+		Where(entTag.HasApplicationWith(entApp.ID(app.ID))).
+		All(ctx)
+	if err != nil {
+		return nil, err
+	}
+	subs := []predicate.Subscription{entSub.ApplicationID(app.ID)}
+	if len(tags) > 0 {
+		names := make([]string, 0, len(tags))
+		for _, t := range tags {
+			names = append(names, t.Name)
+		}
+		subs = append(subs, entSub.TagIn(names...))
+	}
+
+	users, err := m.db.User.
+		Query().
+		Where(
+			entUser.EmailNEQ(""),
+			entUser.HasSubscriptionsWith(entSub.Or(subs...)),
+		).
+		Order(ent.Asc(entUser.FieldID)).
+		All(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	visible := users[:0]
+	for _, u := range users {
+		ok, err := m.db.Application.
+			Query().
+			Where(entApp.ID(app.ID), VisibleApplications(u)).
+			Exist(ctx)
+		if err != nil {
+			return nil, err
+		}
+		if ok {
+			visible = append(visible, u)
+		}
+	}
+	return visible, nil
+}
+
+// notifySubscribers emails the end of an application to its subscribers, in
+// the background
+func (m *Master) notifySubscribers(ctx context.Context, app *ent.Application) {
+	if !m.smtp.enabled() || !notifyStatuses[app.Status] {
+		return
+	}
+	users, err := m.subscribers(ctx, app)
+	if err != nil {
+		m.logger.Errorw("failed find the subscribers", "application id", app.ID, "err", err)
+		return
+	}
+	if len(users) == 0 {
+		return
+	}
+
+	s, err := m.ApplicationSummary(ctx, app)
+	if err != nil {
+		m.logger.Errorw("failed summarize the application", "application id", app.ID, "err", err)
+	}
+	subject := fmt.Sprintf("[gobench] %s #%d %s", app.Name, app.ID, app.Status)
+	body := m.mailBody(app, s)
+
+	m.mails.Add(1)
+	go func() {
+		defer m.mails.Done()
+		for _, u := range users {
+			if err := m.sendMail(u.Email, subject, body); err != nil {
+				m.logger.Errorw("failed email the subscriber",
+					"application id", app.ID,
+					"username", u.Username,
+					"err", err,
+				)
+			}
+		}
+	}()
+}
+
+// ApplicationURL returns the dashboard page of an application
+func (m *Master) ApplicationURL(appID int) string {
+	return fmt.Sprintf("%s/#/applications/%d", strings.TrimSuffix(m.dashboardURL, "/"), appID)
+}
+
+// mailBody writes the summary of an application, without the numbers when
+// there is no summary
+func (m *Master) mailBody(app *ent.Application, s *Summary) string {
+	var b bytes.Buffer
+	fmt.Fprintf(&b, "The application %s (#%d) ended: %s.\n\n", app.Name, app.ID, app.Status)
+	if app.Verdict != "" {
+		fmt.Fprintf(&b, "Verdict:    %s\n", app.Verdict)
+	}
+	if s != nil {
+		fmt.Fprintf(&b, "Duration:   %.1fs\n", s.Duration)
+		fmt.Fprintf(&b, "Requests:   %d\n", s.Requests)
+		fmt.Fprintf(&b, "Errors:     %d (%.2f%%)\n", s.Errors, s.ErrorRate)
+		fmt.Fprintf(&b, "RPS:        %.2f\n", s.RPS)
+		fmt.Fprintf(&b, "Latency:    p50 %.2f, p95 %.2f, p99 %.2f\n", s.P50, s.P95, s.P99)
+	}
+	fmt.Fprintf(&b, "\n%s\n", m.ApplicationURL(app.ID))
+	return b.String()
+}
+
+// sendMail sends a plain text email through the SMTP server
+func (m *Master) sendMail(to, subject, body string) error {
+	var msg bytes.Buffer
+	fmt.Fprintf(&msg, "From: %s\r\n", m.smtp.From)
+	fmt.Fprintf(&msg, "To: %s\r\n", to)
+	fmt.Fprintf(&msg, "Subject: %s\r\n", mime.QEncoding.Encode("utf-8", subject))
+	fmt.Fprintf(&msg, "Date: %s\r\n", time.Now().Format(time.RFC1123Z))
+	msg.WriteString("MIME-Version: 1.0\r\n")
+	msg.WriteString("Content-Type: text/plain; charset=utf-8\r\n\r\n")
+	msg.WriteString(strings.ReplaceAll(body, "\n", "\r\n"))
+
+	var auth smtp.Auth
+	if m.smtp.Username != "" {
+		auth = smtp.PlainAuth("", m.smtp.Username, m.smtp.Password, m.smtp.Host)
+	}
+	addr := net.JoinHostPort(m.smtp.Host, strconv.Itoa(m.smtp.Port))
+	return smtp.SendMail(addr, auth, m.smtp.From, []string{to}, msg.Bytes())
+}
@@ -0,0 +1,189 @@
+package master
+
+import (
+	"context"
+	"encoding/base64"
+	"errors"
+	"net"
+	"net/textproto"
+	"strconv"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/gobench-io/gobench/ent"
+	"github.com/gobench-io/gobench/logger"
+
+	entUser "github.com/gobench-io/gobench/ent/user"
+)
+
+type fakeMail struct {
+	auth string // the PLAIN credentials
+	from string
+	to   []string
+	data string
+}
+
+// fakeSMTP is a local SMTP server that keeps the emails it receives
+type fakeSMTP struct {
+	l     net.Listener
+	mu    sync.Mutex
+	mails []fakeMail
+}
+
+func newFakeSMTP(t *testing.T) *fakeSMTP {
+	l, err := net.Listen("tcp", "127.0.0.1:0")
+	assert.Nil(t, err)
+	s := &fakeSMTP{l: l}
+	t.Cleanup(func() { l.Close() })
+	go func() {
+		for {
+			c, err := l.Accept()
+			if err != nil {
+				return
+			}
+			go s.serve(c)
+		}
+	}()
+	return s
+}
+
+func (s *fakeSMTP) port() int {
+	return s.l.Addr().(*net.TCPAddr).Port
+}
+
+func (s *fakeSMTP) received() []fakeMail {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return append([]fakeMail{}, s.mails...)
+}
+
+func (s *fakeSMTP) serve(c net.Conn) {
+	tc := textproto.NewConn(c)
+	defer tc.Close()
+
+	var m fakeMail
+	_ = tc.PrintfLine("220 fake ESMTP")
+	for {
+		line, err := tc.ReadLine()
+		if err != nil {
+			return
+		}
+		cmd := strings.ToUpper(strings.SplitN(line, " ", 2)[0])
+		arg := strings.TrimSpace(strings.TrimPrefix(line, strings.SplitN(line, " ", 2)[0]))
+		switch cmd {
+		case "EHLO", "HELO":
+			_ = tc.PrintfLine("250-fake")
+			_ = tc.PrintfLine("250 AUTH PLAIN")
+		case "AUTH":
+			b, _ := base64.StdEncoding.DecodeString(strings.TrimPrefix(arg, "PLAIN "))
+			m.auth = string(b)
+			_ = tc.PrintfLine("235 ok")
+		case "MAIL":
+			m.from = arg
+			_ = tc.PrintfLine("250 ok")
+		case "RCPT":
+			m.to = append(m.to, arg)
+			_ = tc.PrintfLine("250 ok")
+		case "DATA":
+			_ = tc.PrintfLine("354 go on")
+			b, err := tc.ReadDotBytes()
+			if err != nil {
+				return
+			}
+			m.data = string(b)
+			s.mu.Lock()
+			s.mails = append(s.mails, m)
+			s.mu.Unlock()
+			m = fakeMail{auth: m.auth}
+			_ = tc.PrintfLine("250 ok")
+		case "QUIT":
+			_ = tc.PrintfLine("221 bye")
+			return
+		default:
+			_ = tc.PrintfLine("250 ok")
+		}
+	}
+}
+
+func TestEmailNotifications(t *testing.T) {
+	ctx := context.Background()
+	m := seedRetentionMaster(t, Retention{})
+	smtpd := newFakeSMTP(t)
+	m.smtp = SMTP{
+		Host:     "127.0.0.1",
+		Port:     smtpd.port(),
+		Username: "gobench",
+		Password: "smtppw",
+		From:     "gobench@example.com",
+	}
+	m.dashboardURL = "http://gobench.example.com/"
+
+	app := m.seedApplication(ctx, t)
+	_, err := m.SetApplicationTag(ctx, app.ID, "nightly")
+	assert.Nil(t, err)
+
+	subscriber := func(name, email string, appID int, tag string) *ent.User {
+		u, err := m.CreateUser(ctx, name, name+"pw", entUser.RoleViewer)
+		assert.Nil(t, err)
+		if email != "" {
+			_, err = m.SetUserEmail(ctx, u.ID, email)
+			assert.Nil(t, err)
+		}
+		_, err = m.CreateSubscription(ctx, u.ID, appID, tag)
+		assert.Nil(t, err)
+		return u
+	}
+	subscriber("alice", "alice@example.com", app.ID, "")
+	subscriber("bob", "Bob <bob@example.com>", 0, "nightly")
+	// without an email
+	subscriber("dave", "", app.ID, "")
+	// carol does not see the application
+	carol := subscriber("carol", "carol@example.com", 0, "nightly")
+	def, err := m.DefaultProject(ctx)
+	assert.Nil(t, err)
+	assert.Nil(t, m.RemoveProjectMember(ctx, def.ID, carol.ID))
+
+	j := &job{app: app, logger: logger.NewNopLogger(), onStatus: m.statusChanged}
+	assert.Nil(t, j.setStatus(ctx, jobRunning))
+	m.mails.Wait()
+	assert.Len(t, smtpd.received(), 0)
+
+	assert.Nil(t, j.setStatus(ctx, jobFinished))
+	m.mails.Wait()
+
+	mails := smtpd.received()
+	assert.Len(t, mails, 2)
+	to := []string{}
+	for _, mail := range mails {
+		to = append(to, mail.to...)
+		assert.Equal(t, "\x00gobench\x00smtppw", mail.auth)
+		assert.Equal(t, "FROM:<gobench@example.com>", mail.from)
+		assert.Contains(t, mail.data, "Subject: [gobench] foo #"+strconv.Itoa(app.ID)+" finished")
+		assert.Contains(t, mail.data, "Requests:   0")
+		assert.Contains(t, mail.data,
+			"http://gobench.example.com/#/applications/"+strconv.Itoa(app.ID))
+	}
+	assert.Equal(t, []string{"TO:<alice@example.com>", "TO:<bob@example.com>"}, to)
+
+	t.Run("invalid", func(t *testing.T) {
+		_, err := m.SetUserEmail(ctx, carol.ID, "not an email")
+		assert.True(t, errors.Is(err, ErrInvalidEmail))
+		_, err = m.CreateSubscription(ctx, carol.ID, app.ID, "nightly")
+		assert.True(t, errors.Is(err, ErrSubscription))
+		_, err = m.CreateSubscription(ctx, carol.ID, 0, "")
+		assert.True(t, errors.Is(err, ErrSubscription))
+	})
+
+	t.Run("a user deletes its own subscriptions", func(t *testing.T) {
+		subs, err := m.ListSubscriptions(ctx, carol.ID)
+		assert.Nil(t, err)
+		assert.Len(t, subs, 1)
+		dave, err := m.UserByName(ctx, "dave")
+		assert.Nil(t, err)
+		assert.True(t, ent.IsNotFound(m.DeleteSubscription(ctx, dave.ID, subs[0].ID)))
+		assert.Nil(t, m.DeleteSubscription(ctx, carol.ID, subs[0].ID))
+	})
+}
@@ -0,0 +1,465 @@
+package master
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math"
+	"strconv"
+	"strings"
+
+	"github.com/facebook/ent/dialect/sql"
+	"github.com/gobench-io/gobench/ent"
+	"github.com/gobench-io/gobench/ent/predicate"
+	"github.com/gobench-io/gobench/executor/metrics"
+
+	entCounter "github.com/gobench-io/gobench/ent/counter"
+	entGauge "github.com/gobench-io/gobench/ent/gauge"
+	entHistogram "github.com/gobench-io/gobench/ent/histogram"
+)
+
+// MaxPointsLimit is the largest page of points, or of buckets
+const MaxPointsLimit = 10000
+
+// Agg is how the points of the executors are aggregated in a bucket
+type Agg string
+
+// The aggregations: sum, avg and max for the counters and the gauges, merge
+// and max for the histograms
+const (
+	AggSum   Agg = "sum"
+	AggAvg   Agg = "avg"
+	AggMax   Agg = "max"
+	AggMerge Agg = "merge"
+)
+
+// ErrCursor is returned for a cursor that is not from a previous page
+var ErrCursor = errors.New("invalid cursor")
+
+// PointsQuery selects the points of a metric, in time order.
+//
+// With a Step, the points are bucketed by steps of Step ms from the epoch.
+// A counter bucket aggregates the last count of every executor in the step,
+// a gauge bucket the mean value of every executor and a histogram bucket the
+// last histogram of every executor.
+type PointsQuery struct {
+	From  int64 // ms, exclusive, 0 for no bound
+	End   int64 // ms, inclusive, 0 for no bound
+	Step  int64 // ms, 0 for the raw points
+	Agg   Agg
+	Limit int // points, or buckets, per page, 0 for all
+	// Cursor is where the page starts, from the previous page
+	Cursor *Cursor
+
+	// before is the exclusive end of a page of buckets
+	before int64
+}
+
+// Cursor is the start of a page: after the point at Time with ID, or at the
+// bucket starting at Time
+type Cursor struct {
+	Time int64
+	ID   int
+}
+
+func (c *Cursor) String() string {
+	if c.ID == 0 {
+		return strconv.FormatInt(c.Time, 10)
+	}
+	return fmt.Sprintf("%d-%d", c.Time, c.ID)
+}
+
+// ParseCursor parses the cursor of a page
+func ParseCursor(s string) (*Cursor, error) {
+	c := &Cursor{}
+	ts, id := s, ""
+	i := strings.IndexByte(s, '-')
+	if i >= 0 {
+		ts, id = s[:i], s[i+1:]
+	}
+	var err error
+	if c.Time, err = strconv.ParseInt(ts, 10, 64); err != nil || c.Time < 0 {
+		return nil, ErrCursor
+	}
+	if i >= 0 {
+		if c.ID, err = strconv.Atoi(id); err != nil || c.ID <= 0 {
+			return nil, ErrCursor
+		}
+	}
+	return c, nil
+}
+
+// Validate checks the query of the points of a type of metric, and sets the
+// default aggregation: sum, or merge for the histograms
+func (q *PointsQuery) Validate(typ metrics.MetricType) error {
+	if q.Step < 0 {
+		return errors.New("step must be positive")
+	}
+	if q.Limit < 0 || q.Limit > MaxPointsLimit {
+		return fmt.Errorf("limit must be between 1 and %d", MaxPointsLimit)
+	}
+
+	aggs := []Agg{AggSum, AggAvg, AggMax}
+	if typ == metrics.Histogram {
+		aggs = []Agg{AggMerge, AggMax}
+	}
+	if q.Agg == "" {
+		q.Agg = aggs[0]
+	}
+	for _, a := range aggs {
+		if q.Agg == a {
+			return nil
+		}
+	}
+	return fmt.Errorf("agg of a %s must be one of %v", typ, aggs)
+}
+
+// where selects the points of the page, the point tables have the same time
+// and id columns
+func (q PointsQuery) where(s *sql.Selector) {
+	t := s.C(entCounter.FieldTime)
+	if q.From > 0 {
+		s.Where(sql.GT(t, q.From))
+	}
+	if q.End > 0 {
+		s.Where(sql.LTE(t, q.End))
+	}
+	if q.before > 0 {
+		s.Where(sql.LT(t, q.before))
+	}
+	if c := q.Cursor; c != nil {
+		s.Where(sql.Or(
+			sql.GT(t, c.Time),
+			sql.And(sql.EQ(t, c.Time), sql.GT(s.C(entCounter.FieldID), c.ID)),
+		))
+	}
+}
+
+// stepPage narrows the query to a page of Limit steps, from the step of the
+// first point. first returns the time of the first point of a query.
+func (q PointsQuery) stepPage(first func(PointsQuery) (int64, error)) (PointsQuery, error) {
+	if q.Limit == 0 {
+		return q, nil
+	}
+	start := int64(0)
+	if q.Cursor != nil {
+		start = q.Cursor.Time
+	}
+	t, err := first(q)
+	if err != nil {
+		return q, err
+	}
+	if t > start {
+		start = t - t%q.Step
+	}
+	q.Cursor = &Cursor{Time: start}
+	q.before = start + int64(q.Limit)*q.Step
+	return q, nil
+}
+
+// nextStep returns the cursor of the page after a page of buckets, nil for
+// the last page. exist reports whether a query has points.
+func (q PointsQuery) nextStep(page PointsQuery, exist func(PointsQuery) (bool, error)) (*Cursor, error) {
+	if page.before == 0 {
+		return nil, nil
+	}
+	q.Cursor = &Cursor{Time: page.before}
+	ok, err := exist(q)
+	if err != nil || !ok {
+		return nil, err
+	}
+	return q.Cursor, nil
+}
+
+// aggregate aggregates the values of the executors
+func aggregate(agg Agg, vs []int64) (r int64) {
+	for i, v := range vs {
+		switch {
+		case agg == AggMax && (i == 0 || v > r):
+			r = v
+		case agg != AggMax:
+			r += v
+		}
+	}
+	if agg == AggAvg && len(vs) > 0 {
+		r /= int64(len(vs))
+	}
+	return r
+}
+
+// CounterBucket is a step of a counter
+type CounterBucket struct {
+	Time      int64 `json:"time"` // ms, start of the step
+	Count     int64 `json:"count"`
+	Executors int   `json:"executors"`
+}
+
+// HistogramBucket is a step of a histogram. Merged, the statistics are
+// weighted by the count of every executor. Otherwise, they are the max over
+// the executors.
+type HistogramBucket struct {
+	Time      int64   `json:"time"` // ms, start of the step
+	Count     int64   `json:"count"`
+	Min       int64   `json:"min"`
+	Max       int64   `json:"max"`
+	Mean      float64 `json:"mean"`
+	Stddev    float64 `json:"stddev"`
+	Median    float64 `json:"median"`
+	P75       float64 `json:"p75"`
+	P95       float64 `json:"p95"`
+	P99       float64 `json:"p99"`
+	P999      float64 `json:"p999"`
+	Executors int     `json:"executors"`
+}
+
+// GaugeBucket is a step of a gauge
+type GaugeBucket struct {
+	Time      int64 `json:"time"` // ms, start of the step
+	Value     int64 `json:"value"`
+	Executors int   `json:"executors"`
+}
+
+func counterQuery(em *ent.Metric, q PointsQuery) *ent.CounterQuery {
+	return em.QueryCounters().
+		Where(predicate.Counter(q.where)).
+		Order(ent.Asc(entCounter.FieldTime), ent.Asc(entCounter.FieldID))
+}
+
+func histogramQuery(em *ent.Metric, q PointsQuery) *ent.HistogramQuery {
+	return em.QueryHistograms().
+		Where(predicate.Histogram(q.where)).
+		Order(ent.Asc(entHistogram.FieldTime), ent.Asc(entHistogram.FieldID))
+}
+
+func gaugeQuery(em *ent.Metric, q PointsQuery) *ent.GaugeQuery {
+	return em.QueryGauges().
+		Where(predicate.Gauge(q.where)).
+		Order(ent.Asc(entGauge.FieldTime), ent.Asc(entGauge.FieldID))
+}
+
+// CounterPoints returns a page of the points of a counter, with the cursor
+// of the next page
+func CounterPoints(ctx context.Context, em *ent.Metric, q PointsQuery) ([]*ent.Counter, *Cursor, error) {
+	query := counterQuery(em, q).WithExecutor()
+	if q.Limit > 0 {
+		query.Limit(q.Limit + 1)
+	}
+	cs, err := query.All(ctx)
+	if err != nil || q.Limit == 0 || len(cs) <= q.Limit {
+		return cs, nil, err
+	}
+	cs = cs[:q.Limit]
+	last := cs[len(cs)-1]
+	return cs, &Cursor{Time: last.Time, ID: last.ID}, nil
+}
+
+// HistogramPoints returns a page of the points of a histogram, with the
+// cursor of the next page
+func HistogramPoints(ctx context.Context, em *ent.Metric, q PointsQuery) ([]*ent.Histogram, *Cursor, error) {
+	query := histogramQuery(em, q).WithExecutor()
+	if q.Limit > 0 {
+		query.Limit(q.Limit + 1)
+	}
+	hs, err := query.All(ctx)
+	if err != nil || q.Limit == 0 || len(hs) <= q.Limit {
+		return hs, nil, err
+	}
+	hs = hs[:q.Limit]
+	last := hs[len(hs)-1]
+	return hs, &Cursor{Time: last.Time, ID: last.ID}, nil
+}
+
+// GaugePoints returns a page of the points of a gauge, with the cursor of the
+// next page
+func GaugePoints(ctx context.Context, em *ent.Metric, q PointsQuery) ([]*ent.Gauge, *Cursor, error) {
+	query := gaugeQuery(em, q).WithExecutor()
+	if q.Limit > 0 {
+		query.Limit(q.Limit + 1)
+	}
+	gs, err := query.All(ctx)
+	if err != nil || q.Limit == 0 || len(gs) <= q.Limit {
+		return gs, nil, err
+	}
+	gs = gs[:q.Limit]
+	last := gs[len(gs)-1]
+	return gs, &Cursor{Time: last.Time, ID: last.ID}, nil
+}
+
+// CounterBuckets returns a page of the steps of a counter, with the cursor of
+// the next page
+func CounterBuckets(ctx context.Context, em *ent.Metric, q PointsQuery) ([]*CounterBucket, *Cursor, error) {
+	bs := []*CounterBucket{}
+	page, err := q.stepPage(func(q PointsQuery) (int64, error) {
+		c, err := counterQuery(em, q).First(ctx)
+		if err != nil {
+			return 0, err
+		}
+		return c.Time, nil
+	})
+	if ent.IsNotFound(err) {
+		return bs, nil, nil
+	}
+	if err != nil {
+		return nil, nil, err
+	}
+
+	cs, err := counterQuery(em, page).WithExecutor().All(ctx)
+	if err != nil {
+		return nil, nil, err
+	}
+	var last map[string]int64
+	flush := func() {
+		vs := []int64{}
+		for _, v := range last {
+			vs = append(vs, v)
+		}
+		b := bs[len(bs)-1]
+		b.Count = aggregate(q.Agg, vs)
+		b.Executors = len(vs)
+	}
+	for _, c := range cs {
+		slot := c.Time - c.Time%q.Step
+		if len(bs) == 0 || bs[len(bs)-1].Time != slot {
+			if len(bs) > 0 {
+				flush()
+			}
+			bs = append(bs, &CounterBucket{Time: slot})
+			last = map[string]int64{}
+		}
+		last[ExecutorEID(c.Edges.Executor)] = c.Count
+	}
+	if len(bs) > 0 {
+		flush()
+	}
+
+	next, err := q.nextStep(page, func(q PointsQuery) (bool, error) {
+		return counterQuery(em, q).Exist(ctx)
+	})
+	return bs, next, err
+}
+
+// HistogramBuckets returns a page of the steps of a histogram, with the
+// cursor of the next page
+func HistogramBuckets(ctx context.Context, em *ent.Metric, q PointsQuery) ([]*HistogramBucket, *Cursor, error) {
+	bs := []*HistogramBucket{}
+	page, err := q.stepPage(func(q PointsQuery) (int64, error) {
+		h, err := histogramQuery(em, q).First(ctx)
+		if err != nil {
+			return 0, err
+		}
+		return h.Time, nil
+	})
+	if ent.IsNotFound(err) {
+		return bs, nil, nil
+	}
+	if err != nil {
+		return nil, nil, err
+	}
+
+	hs, err := histogramQuery(em, page).WithExecutor().All(ctx)
+	if err != nil {
+		return nil, nil, err
+	}
+	var last map[string]*ent.Histogram
+	flush := func() {
+		b := bs[len(bs)-1]
+		b.Executors = len(last)
+		b.Min = math.MaxInt64
+		for _, h := range last {
+			b.Count += h.Count
+			if h.Min < b.Min {
+				b.Min = h.Min
+			}
+			if h.Max > b.Max {
+				b.Max = h.Max
+			}
+		}
+		for _, h := range last {
+			stats := []*float64{&b.Mean, &b.Stddev, &b.Median, &b.P75, &b.P95, &b.P99, &b.P999}
+			for i, v := range []float64{h.Mean, h.Stddev, h.Median, h.P75, h.P95, h.P99, h.P999} {
+				switch {
+				case q.Agg == AggMax:
+					*stats[i] = math.Max(*stats[i], v)
+				case b.Count > 0:
+					*stats[i] += v * float64(h.Count) / float64(b.Count)
+				default:
+					*stats[i] += v / float64(len(last))
+				}
+			}
+		}
+	}
+	for _, h := range hs {
+		slot := h.Time - h.Time%q.Step
+		if len(bs) == 0 || bs[len(bs)-1].Time != slot {
+			if len(bs) > 0 {
+				flush()
+			}
+			bs = append(bs, &HistogramBucket{Time: slot})
+			last = map[string]*ent.Histogram{}
+		}
+		last[ExecutorEID(h.Edges.Executor)] = h
+	}
+	if len(bs) > 0 {
+		flush()
+	}
+
+	next, err := q.nextStep(page, func(q PointsQuery) (bool, error) {
+		return histogramQuery(em, q).Exist(ctx)
+	})
+	return bs, next, err
+}
+
+// GaugeBuckets returns a page of the steps of a gauge, with the cursor of the
+// next page
+func GaugeBuckets(ctx context.Context, em *ent.Metric, q PointsQuery) ([]*GaugeBucket, *Cursor, error) {
+	bs := []*GaugeBucket{}
+	page, err := q.stepPage(func(q PointsQuery) (int64, error) {
+		g, err := gaugeQuery(em, q).First(ctx)
+		if err != nil {
+			return 0, err
+		}
+		return g.Time, nil
+	})
+	if ent.IsNotFound(err) {
+		return bs, nil, nil
+	}
+	if err != nil {
+		return nil, nil, err
+	}
+
+	gs, err := gaugeQuery(em, page).WithExecutor().All(ctx)
+	if err != nil {
+		return nil, nil, err
+	}
+	var sum, count map[string]int64
+	flush := func() {
+		vs := []int64{}
+		for eID, s := range sum {
+			vs = append(vs, s/count[eID])
+		}
+		b := bs[len(bs)-1]
+		b.Value = aggregate(q.Agg, vs)
+		b.Executors = len(vs)
+	}
+	for _, g := range gs {
+		slot := g.Time - g.Time%q.Step
+		if len(bs) == 0 || bs[len(bs)-1].Time != slot {
+			if len(bs) > 0 {
+				flush()
+			}
+			bs = append(bs, &GaugeBucket{Time: slot})
+			sum, count = map[string]int64{}, map[string]int64{}
+		}
+		eID := ExecutorEID(g.Edges.Executor)
+		sum[eID] += g.Value
+		count[eID]++
+	}
+	if len(bs) > 0 {
+		flush()
+	}
+
+	next, err := q.nextStep(page, func(q PointsQuery) (bool, error) {
+		return gaugeQuery(em, q).Exist(ctx)
+	})
+	return bs, next, err
+}
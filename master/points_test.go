@@ -0,0 +1,187 @@
+package master
+
+import (
+	"context"
+	"testing"
+
+	"github.com/gobench-io/gobench/ent"
+	"github.com/gobench-io/gobench/executor/metrics"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParseCursor(t *testing.T) {
+	c, err := ParseCursor("1000-42")
+	assert.Nil(t, err)
+	assert.Equal(t, &Cursor{Time: 1000, ID: 42}, c)
+	assert.Equal(t, "1000-42", c.String())
+
+	c, err = ParseCursor("60000")
+	assert.Nil(t, err)
+	assert.Equal(t, &Cursor{Time: 60000}, c)
+	assert.Equal(t, "60000", c.String())
+
+	for _, s := range []string{"", "foo", "1000-", "1000-0", "-1", "1000-x"} {
+		_, err = ParseCursor(s)
+		assert.Equal(t, ErrCursor, err, s)
+	}
+}
+
+func TestPointsQueryValidate(t *testing.T) {
+	q := PointsQuery{}
+	assert.Nil(t, q.Validate(metrics.Counter))
+	assert.Equal(t, AggSum, q.Agg)
+
+	q = PointsQuery{}
+	assert.Nil(t, q.Validate(metrics.Histogram))
+	assert.Equal(t, AggMerge, q.Agg)
+
+	q = PointsQuery{Agg: AggMerge}
+	assert.EqualError(t, q.Validate(metrics.Gauge), "agg of a gauge must be one of [sum avg max]")
+	q = PointsQuery{Agg: AggAvg}
+	assert.EqualError(t, q.Validate(metrics.Histogram), "agg of a histogram must be one of [merge max]")
+
+	q = PointsQuery{Limit: MaxPointsLimit + 1}
+	assert.NotNil(t, q.Validate(metrics.Counter))
+	q = PointsQuery{Step: -1}
+	assert.NotNil(t, q.Validate(metrics.Counter))
+}
+
+// seedPointsMaster has 12 points of 2 executors every 10 seconds from a
+// minute boundary
+func seedPointsMaster(t *testing.T) (m *Master, c, h, g *ent.Metric, t0 int64) {
+	ctx := context.Background()
+	m = seedRetentionMaster(t, Retention{})
+	app := m.seedApplication(ctx, t)
+
+	t0 = 1000 * 60000
+	cID, hID, gID := m.seedMetrics(ctx, t, app, "e1", t0, 12)
+	m.seedMetrics(ctx, t, app, "e2", t0, 12)
+
+	var err error
+	c, err = m.db.Metric.Get(ctx, int(cID))
+	assert.Nil(t, err)
+	h, err = m.db.Metric.Get(ctx, int(hID))
+	assert.Nil(t, err)
+	g, err = m.db.Metric.Get(ctx, int(gID))
+	assert.Nil(t, err)
+	return
+}
+
+func TestPointsPages(t *testing.T) {
+	ctx := context.Background()
+	_, c, h, g, t0 := seedPointsMaster(t)
+
+	all, next, err := CounterPoints(ctx, c, PointsQuery{})
+	assert.Nil(t, err)
+	assert.Nil(t, next)
+	assert.Len(t, all, 24)
+
+	t.Run("pages have every point once", func(t *testing.T) {
+		q := PointsQuery{Limit: 5}
+		got := []*ent.Counter{}
+		for pages := 1; ; pages++ {
+			cs, next, err := CounterPoints(ctx, c, q)
+			assert.Nil(t, err)
+			got = append(got, cs...)
+			if next == nil {
+				assert.Equal(t, 5, pages)
+				break
+			}
+			assert.Len(t, cs, 5)
+			q.Cursor = next
+		}
+		assert.Len(t, got, 24)
+		for i := range got {
+			assert.Equal(t, all[i].ID, got[i].ID)
+			if i > 0 {
+				assert.True(t, got[i].Time >= got[i-1].Time)
+			}
+		}
+		assert.Equal(t, "e1", ExecutorEID(got[0].Edges.Executor))
+	})
+
+	t.Run("time window", func(t *testing.T) {
+		hs, next, err := HistogramPoints(ctx, h, PointsQuery{From: t0 + 10000, End: t0 + 30000, Limit: 4})
+		assert.Nil(t, err)
+		assert.Nil(t, next)
+		assert.Len(t, hs, 4)
+
+		gs, next, err := GaugePoints(ctx, g, PointsQuery{From: t0 + 10000, End: t0 + 30000, Limit: 3})
+		assert.Nil(t, err)
+		assert.Len(t, gs, 3)
+		assert.Equal(t, &Cursor{Time: gs[2].Time, ID: gs[2].ID}, next)
+	})
+}
+
+func TestPointsBuckets(t *testing.T) {
+	ctx := context.Background()
+	_, c, h, g, t0 := seedPointsMaster(t)
+
+	t.Run("counters", func(t *testing.T) {
+		for agg, counts := range map[Agg][]int64{
+			AggSum: {1000, 2200, 2400},
+			AggAvg: {500, 1100, 1200},
+			AggMax: {500, 1100, 1200},
+		} {
+			bs, next, err := CounterBuckets(ctx, c, PointsQuery{Step: 60000, Agg: agg})
+			assert.Nil(t, err)
+			assert.Nil(t, next)
+			got := []int64{}
+			for _, b := range bs {
+				got = append(got, b.Count)
+				assert.Equal(t, 2, b.Executors)
+			}
+			assert.Equal(t, counts, got, agg)
+			assert.Equal(t, t0+60000, bs[1].Time)
+		}
+	})
+
+	t.Run("gauges", func(t *testing.T) {
+		bs, _, err := GaugeBuckets(ctx, g, PointsQuery{Step: 60000, Agg: AggSum})
+		assert.Nil(t, err)
+		values := []int64{}
+		for _, b := range bs {
+			values = append(values, b.Value)
+		}
+		assert.Equal(t, []int64{6, 16, 24}, values)
+	})
+
+	t.Run("histograms", func(t *testing.T) {
+		bs, _, err := HistogramBuckets(ctx, h, PointsQuery{Step: 60000, Agg: AggMerge})
+		assert.Nil(t, err)
+		assert.Len(t, bs, 3)
+		assert.Equal(t, &HistogramBucket{
+			Time: t0, Count: 1000, Min: 1, Max: 100, Mean: 10, Stddev: 1,
+			Median: 10, P75: 20, P95: 30, P99: 40, P999: 50, Executors: 2,
+		}, bs[0])
+
+		bs, _, err = HistogramBuckets(ctx, h, PointsQuery{Step: 60000, Agg: AggMax})
+		assert.Nil(t, err)
+		assert.Equal(t, 30.0, bs[2].P95)
+		assert.EqualValues(t, 2400, bs[2].Count)
+	})
+
+	t.Run("pages of buckets", func(t *testing.T) {
+		q := PointsQuery{Step: 60000, Agg: AggSum, Limit: 2}
+		bs, next, err := CounterBuckets(ctx, c, q)
+		assert.Nil(t, err)
+		assert.Len(t, bs, 2)
+		assert.Equal(t, &Cursor{Time: t0 + 120000}, next)
+
+		q.Cursor = next
+		bs, next, err = CounterBuckets(ctx, c, q)
+		assert.Nil(t, err)
+		assert.Nil(t, next)
+		if assert.Len(t, bs, 1) {
+			assert.EqualValues(t, 2400, bs[0].Count)
+		}
+	})
+
+	t.Run("empty", func(t *testing.T) {
+		bs, next, err := GaugeBuckets(ctx, g, PointsQuery{From: t0 + 200000, Step: 60000, Agg: AggSum, Limit: 2})
+		assert.Nil(t, err)
+		assert.Nil(t, next)
+		assert.NotNil(t, bs)
+		assert.Len(t, bs, 0)
+	})
+}
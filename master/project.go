@@ -0,0 +1,238 @@
+package master
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/facebook/ent/dialect/sql"
+	"github.com/gobench-io/gobench/ent"
+	"github.com/gobench-io/gobench/ent/predicate"
+
+	entApp "github.com/gobench-io/gobench/ent/application"
+	entProject "github.com/gobench-io/gobench/ent/project"
+	entUser "github.com/gobench-io/gobench/ent/user"
+)
+
+// DefaultProjectName is the project of the applications created without a
+// project, and of the applications created before the projects. The new
+// users are its members.
+const DefaultProjectName = "default"
+
+var (
+	// ErrProjectQueueFull is returned when a project has its maximum of
+	// pending or running applications
+	ErrProjectQueueFull = errors.New("the project has too many pending or running applications")
+	// ErrProjectNotEmpty is returned when a project with applications is
+	// deleted
+	ErrProjectNotEmpty = errors.New("the project has applications")
+)
+
+// ProjectLimits are the limits of a project, a zero limit is none, a zero
+// retention keeps the retention of the server
+type ProjectLimits struct {
+	MaxQueued       int
+	RawRetention    time.Duration
+	RollupRetention time.Duration
+}
+
+// WithProject creates an application in a project
+func WithProject(projectID int) ApplicationOption {
+	return func(ac *ent.ApplicationCreate) {
+		ac.SetProjectID(projectID)
+	}
+}
+
+// VisibleApplications is the predicate of the applications a user sees, of
+// the projects of the user, or all of them for an admin
+func VisibleApplications(u *ent.User) predicate.Application {
+	if u.Role == entUser.RoleAdmin {
+		return func(*sql.Selector) {}
+	}
+	return entApp.HasProjectWith(entProject.HasMembersWith(entUser.ID(u.ID)))
+}
+
+// ensureDefaultProject creates the default project and moves the
+// applications without a project to it. The applications are added from the
+// project side, which keeps their updated_at.
+func (m *Master) ensureDefaultProject(ctx context.Context) error {
+	p, err := m.DefaultProject(ctx)
+	if err != nil {
+		return err
+	}
+	ids, err := m.db.Application.
+		Query().
+		Where(entApp.Not(entApp.HasProject())).
+		IDs(ctx)
+	if err != nil || len(ids) == 0 {
+		return err
+	}
+	return m.db.Project.
+		UpdateOne(p).
+		AddApplicationIDs(ids...).
+		Exec(ctx)
+}
+
+// DefaultProject returns the default project, creating it when needed
+func (m *Master) DefaultProject(ctx context.Context) (*ent.Project, error) {
+	p, err := m.db.Project.
+		Query().
+		Where(entProject.Name(DefaultProjectName)).
+		Only(ctx)
+	if ent.IsNotFound(err) {
+		return m.db.Project.
+			Create().
+			SetName(DefaultProjectName).
+			Save(ctx)
+	}
+	return p, err
+}
+
+// inProject puts an application created without a project in the default
+// project, and checks the queue limit of its project
+func (m *Master) inProject(ctx context.Context, ac *ent.ApplicationCreate) error {
+	projectID, ok := ac.Mutation().ProjectID()
+	if !ok {
+		p, err := m.DefaultProject(ctx)
+		if err != nil {
+			return err
+		}
+		ac.SetProjectID(p.ID)
+		projectID = p.ID
+	}
+
+	p, err := m.GetProject(ctx, projectID)
+	if err != nil {
+		return err
+	}
+	if p.MaxQueued == 0 {
+		return nil
+	}
+	queued, err := p.QueryApplications().
+		Where(entApp.StatusIn(string(jobPending), string(jobProvisioning),
+			string(jobRunning))).
+		Count(ctx)
+	if err != nil {
+		return err
+	}
+	if queued >= p.MaxQueued {
+		return fmt.Errorf("%w: %d", ErrProjectQueueFull, p.MaxQueued)
+	}
+	return nil
+}
+
+// CreateProject creates a project
+func (m *Master) CreateProject(ctx context.Context, name string, l ProjectLimits) (*ent.Project, error) {
+	return m.db.Project.
+		Create().
+		SetName(name).
+		SetMaxQueued(l.MaxQueued).
+		SetRawRetention(int64(l.RawRetention.Seconds())).
+		SetRollupRetention(int64(l.RollupRetention.Seconds())).
+		Save(ctx)
+}
+
+// GetProject returns a project by ID
+func (m *Master) GetProject(ctx context.Context, id int) (*ent.Project, error) {
+	return m.db.Project.
+		Query().
+		Where(entProject.ID(id)).
+		Only(ctx)
+}
+
+// ListProjects returns the projects of a user by name, all of them for an
+// admin
+func (m *Master) ListProjects(ctx context.Context, u *ent.User) ([]*ent.Project, error) {
+	q := m.db.Project.Query()
+	if u.Role != entUser.RoleAdmin {
+		q = q.Where(entProject.HasMembersWith(entUser.ID(u.ID)))
+	}
+	return q.Order(ent.Asc(entProject.FieldName)).All(ctx)
+}
+
+// UpdateProject changes the limits of a project
+func (m *Master) UpdateProject(ctx context.Context, id int, l ProjectLimits) (*ent.Project, error) {
+	return m.db.Project.
+		UpdateOneID(id).
+		SetMaxQueued(l.MaxQueued).
+		SetRawRetention(int64(l.RawRetention.Seconds())).
+		SetRollupRetention(int64(l.RollupRetention.Seconds())).
+		Save(ctx)
+}
+
+// DeleteProject deletes a project without applications
+func (m *Master) DeleteProject(ctx context.Context, id int) error {
+	p, err := m.GetProject(ctx, id)
+	if err != nil {
+		return err
+	}
+	n, err := p.QueryApplications().Count(ctx)
+	if err != nil {
+		return err
+	}
+	if n > 0 {
+		return ErrProjectNotEmpty
+	}
+	return m.db.Project.DeleteOne(p).Exec(ctx)
+}
+
+// ProjectMembers returns the members of a project by username
+func (m *Master) ProjectMembers(ctx context.Context, id int) ([]*ent.User, error) {
+	p, err := m.GetProject(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	return p.QueryMembers().Order(ent.Asc(entUser.FieldUsername)).All(ctx)
+}
+
+// AddProjectMember makes a user a member of a project
+func (m *Master) AddProjectMember(ctx context.Context, id, userID int) error {
+	if _, err := m.GetUser(ctx, userID); err != nil {
+		return err
+	}
+	return m.db.Project.UpdateOneID(id).AddMemberIDs(userID).Exec(ctx)
+}
+
+// RemoveProjectMember removes a user from a project
+func (m *Master) RemoveProjectMember(ctx context.Context, id, userID int) error {
+	return m.db.Project.UpdateOneID(id).RemoveMemberIDs(userID).Exec(ctx)
+}
+
+// IsProjectMember tells if a user sees the applications of a project, an
+// admin sees all of them
+func (m *Master) IsProjectMember(ctx context.Context, id int, u *ent.User) (bool, error) {
+	if u.Role == entUser.RoleAdmin {
+		return m.db.Project.Query().Where(entProject.ID(id)).Exist(ctx)
+	}
+	return m.db.Project.
+		Query().
+		Where(entProject.ID(id), entProject.HasMembersWith(entUser.ID(u.ID))).
+		Exist(ctx)
+}
+
+// ofProject selects the applications of a project, the applications without
+// a project when projectID is 0
+func ofProject(projectID int) predicate.Application {
+	if projectID == 0 {
+		return entApp.Not(entApp.HasProject())
+	}
+	return entApp.HasProjectWith(entProject.ID(projectID))
+}
+
+// lastStart returns when an application of a project last started, zero if
+// none did
+func (m *Master) lastStart(ctx context.Context, projectID int) (time.Time, error) {
+	app, err := m.db.Application.
+		Query().
+		Where(ofProject(projectID), entApp.StartedAtNotNil()).
+		Order(ent.Desc(entApp.FieldStartedAt)).
+		First(ctx)
+	if ent.IsNotFound(err) {
+		return time.Time{}, nil
+	}
+	if err != nil {
+		return time.Time{}, err
+	}
+	return app.StartedAt, nil
+}
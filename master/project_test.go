@@ -0,0 +1,212 @@
+package master
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	entApp "github.com/gobench-io/gobench/ent/application"
+	entUser "github.com/gobench-io/gobench/ent/user"
+)
+
+func TestDefaultProject(t *testing.T) {
+	ctx := context.Background()
+	m := seedRetentionMaster(t, Retention{})
+
+	app := m.seedApplication(ctx, t)
+	p, err := app.QueryProject().Only(ctx)
+	assert.Nil(t, err)
+	assert.Equal(t, DefaultProjectName, p.Name)
+
+	u, err := m.CreateUser(ctx, "alice", "alicepw", entUser.RoleViewer)
+	assert.Nil(t, err)
+	ok, err := m.IsProjectMember(ctx, p.ID, u)
+	assert.Nil(t, err)
+	assert.True(t, ok)
+
+	t.Run("the applications without a project move to the default one", func(t *testing.T) {
+		updatedAt := time.Now().Add(-time.Hour).Truncate(time.Second)
+		orphan, err := m.db.Application.Create().SetName("old").SetScenario("").
+			SetStatus(string(jobFinished)).SetUpdatedAt(updatedAt).Save(ctx)
+		assert.Nil(t, err)
+		assert.Nil(t, m.ensureDefaultProject(ctx))
+		op, err := orphan.QueryProject().Only(ctx)
+		assert.Nil(t, err)
+		assert.Equal(t, p.ID, op.ID)
+
+		// the move keeps the end of the run
+		orphan, err = m.db.Application.Get(ctx, orphan.ID)
+		assert.Nil(t, err)
+		assert.True(t, updatedAt.Equal(orphan.UpdatedAt))
+	})
+
+	assert.True(t, errors.Is(m.DeleteProject(ctx, p.ID), ErrProjectNotEmpty))
+}
+
+func TestProjectVisibility(t *testing.T) {
+	ctx := context.Background()
+	m := seedRetentionMaster(t, Retention{})
+
+	team, err := m.CreateProject(ctx, "team", ProjectLimits{})
+	assert.Nil(t, err)
+	mine, err := m.NewApplication(ctx, "mine", "scenario", "", "", WithProject(team.ID))
+	assert.Nil(t, err)
+	m.seedApplication(ctx, t)
+
+	alice, err := m.CreateUser(ctx, "alice", "alicepw", entUser.RoleRunner)
+	assert.Nil(t, err)
+	admin, err := m.CreateUser(ctx, "root", "rootpw", entUser.RoleAdmin)
+	assert.Nil(t, err)
+	// alice is only in the team
+	def, err := m.DefaultProject(ctx)
+	assert.Nil(t, err)
+	assert.Nil(t, m.RemoveProjectMember(ctx, def.ID, alice.ID))
+	assert.Nil(t, m.AddProjectMember(ctx, team.ID, alice.ID))
+
+	apps, err := m.db.Application.Query().Where(VisibleApplications(alice)).All(ctx)
+	assert.Nil(t, err)
+	assert.Len(t, apps, 1)
+	assert.Equal(t, mine.ID, apps[0].ID)
+
+	n, err := m.db.Application.Query().Where(VisibleApplications(admin)).Count(ctx)
+	assert.Nil(t, err)
+	assert.Equal(t, 2, n)
+
+	ps, err := m.ListProjects(ctx, alice)
+	assert.Nil(t, err)
+	assert.Len(t, ps, 1)
+	ps, err = m.ListProjects(ctx, admin)
+	assert.Nil(t, err)
+	assert.Len(t, ps, 2)
+
+	members, err := m.ProjectMembers(ctx, team.ID)
+	assert.Nil(t, err)
+	assert.Len(t, members, 1)
+	assert.Equal(t, "alice", members[0].Username)
+}
+
+func TestProjectQueue(t *testing.T) {
+	ctx := context.Background()
+	m := seedRetentionMaster(t, Retention{})
+
+	p, err := m.CreateProject(ctx, "team", ProjectLimits{MaxQueued: 1})
+	assert.Nil(t, err)
+
+	first, err := m.NewApplication(ctx, "first", "scenario", "", "", WithProject(p.ID))
+	assert.Nil(t, err)
+	_, err = m.NewApplication(ctx, "second", "scenario", "", "", WithProject(p.ID))
+	assert.True(t, errors.Is(err, ErrProjectQueueFull))
+
+	// a finished application leaves the queue
+	_, err = first.Update().SetStatus(string(jobFinished)).Save(ctx)
+	assert.Nil(t, err)
+	_, err = m.NewApplication(ctx, "second", "scenario", "", "", WithProject(p.ID))
+	assert.Nil(t, err)
+
+	p, err = m.UpdateProject(ctx, p.ID, ProjectLimits{})
+	assert.Nil(t, err)
+	assert.Equal(t, 0, p.MaxQueued)
+}
+
+func TestNextApplicationTakesTurns(t *testing.T) {
+	ctx := context.Background()
+	m := seedRetentionMaster(t, Retention{})
+
+	busy, err := m.CreateProject(ctx, "busy", ProjectLimits{})
+	assert.Nil(t, err)
+	idle, err := m.CreateProject(ctx, "idle", ProjectLimits{})
+	assert.Nil(t, err)
+
+	ran, err := m.NewApplication(ctx, "ran", "scenario", "", "", WithProject(busy.ID))
+	assert.Nil(t, err)
+	_, err = ran.Update().SetStatus(string(jobFinished)).
+		SetStartedAt(time.Now().Add(-time.Minute)).Save(ctx)
+	assert.Nil(t, err)
+
+	_, err = m.NewApplication(ctx, "busy 1", "scenario", "", "", WithProject(busy.ID))
+	assert.Nil(t, err)
+	_, err = m.NewApplication(ctx, "busy 2", "scenario", "", "", WithProject(busy.ID))
+	assert.Nil(t, err)
+	_, err = m.NewApplication(ctx, "idle 1", "scenario", "", "", WithProject(idle.ID))
+	assert.Nil(t, err)
+
+	// idle never ran, it goes before the older applications of busy
+	a, err := m.nextApplication(ctx)
+	assert.Nil(t, err)
+	assert.Equal(t, "idle 1", a.Name)
+
+	_, err = a.Update().SetStatus(string(jobFinished)).SetStartedAt(time.Now()).Save(ctx)
+	assert.Nil(t, err)
+	a, err = m.nextApplication(ctx)
+	assert.Nil(t, err)
+	assert.Equal(t, "busy 1", a.Name)
+}
+
+func TestNextApplicationWithoutProject(t *testing.T) {
+	ctx := context.Background()
+	m := seedRetentionMaster(t, Retention{})
+
+	p, err := m.CreateProject(ctx, "p", ProjectLimits{})
+	assert.Nil(t, err)
+
+	// the applications without a project ran last, p goes first
+	ran, err := m.NewApplication(ctx, "ran", "scenario", "", "")
+	assert.Nil(t, err)
+	_, err = ran.Update().ClearProject().SetStatus(string(jobFinished)).
+		SetStartedAt(time.Now()).Save(ctx)
+	assert.Nil(t, err)
+
+	orphan, err := m.NewApplication(ctx, "orphan", "scenario", "", "")
+	assert.Nil(t, err)
+	_, err = orphan.Update().ClearProject().Save(ctx)
+	assert.Nil(t, err)
+	_, err = m.NewApplication(ctx, "p 1", "scenario", "", "", WithProject(p.ID))
+	assert.Nil(t, err)
+
+	a, err := m.nextApplication(ctx)
+	assert.Nil(t, err)
+	assert.Equal(t, "p 1", a.Name)
+
+	// then the applications without a project take their turn
+	_, err = a.Update().SetStatus(string(jobFinished)).SetStartedAt(time.Now()).Save(ctx)
+	assert.Nil(t, err)
+	a, err = m.nextApplication(ctx)
+	assert.Nil(t, err)
+	assert.Equal(t, "orphan", a.Name)
+}
+
+func TestProjectRetention(t *testing.T) {
+	ctx := context.Background()
+	// the server keeps the points forever
+	m := seedRetentionMaster(t, Retention{})
+
+	short, err := m.CreateProject(ctx, "short", ProjectLimits{RollupRetention: time.Hour})
+	assert.Nil(t, err)
+	t0 := int64(1000 * 60000)
+
+	kept := m.seedApplication(ctx, t)
+	m.seedMetrics(ctx, t, kept, "e1", t0, 3)
+	gone, err := m.NewApplication(ctx, "gone", "scenario", "", "", WithProject(short.ID))
+	assert.Nil(t, err)
+	m.seedMetrics(ctx, t, gone, "e1", t0, 3)
+	for _, app := range []int{kept.ID, gone.ID} {
+		_, err = m.db.Application.UpdateOneID(app).SetStatus(string(jobFinished)).Save(ctx)
+		assert.Nil(t, err)
+	}
+
+	r, err := m.Maintain(ctx)
+	assert.Nil(t, err)
+	// a counter, a histogram and a gauge of 3 points
+	assert.Equal(t, 9, r.Deleted)
+
+	n, err := m.db.Counter.Query().QueryMetric().QueryGraph().QueryGroup().
+		QueryApplication().Where(entApp.ID(kept.ID)).Count(ctx)
+	assert.Nil(t, err)
+	assert.Equal(t, 1, n)
+	n, err = m.db.Counter.Query().Count(ctx)
+	assert.Nil(t, err)
+	assert.Equal(t, 3, n)
+}
@@ -0,0 +1,347 @@
+package master
+
+import (
+	"bufio"
+	"context"
+	"fmt"
+	"io"
+	"sort"
+	"strconv"
+	"strings"
+	"sync/atomic"
+	"time"
+
+	"github.com/gobench-io/gobench/ent"
+	"github.com/gobench-io/gobench/ent/predicate"
+	"github.com/gobench-io/gobench/executor/metrics"
+
+	entApp "github.com/gobench-io/gobench/ent/application"
+	entCounter "github.com/gobench-io/gobench/ent/counter"
+	entGauge "github.com/gobench-io/gobench/ent/gauge"
+	entHistogram "github.com/gobench-io/gobench/ent/histogram"
+)
+
+// latestWindow is how far back from the newest point of a metric we look for
+// the latest point of the other executors, in ms. It spans two reporting
+// intervals of the executor.
+const latestWindow = 20 * 1000
+
+// PrometheusContentType is the content type of the Prometheus text format
+const PrometheusContentType = "text/plain; version=0.0.4; charset=utf-8"
+
+// addIngested increases the number of metric points saved by the master
+func (m *Master) addIngested(n uint64) {
+	atomic.AddUint64(&m.ingested, n)
+}
+
+// Ingested returns the number of metric points saved since the master started
+func (m *Master) Ingested() uint64 {
+	return atomic.LoadUint64(&m.ingested)
+}
+
+// metricLabels is the prometheus labels of an application metric
+type metricLabels struct {
+	app      *ent.Application
+	group    string
+	graph    string
+	title    string
+	executor string
+}
+
+func (ml metricLabels) String() string {
+	return fmt.Sprintf(`application_id="%d",application="%s",group="%s",graph="%s",metric="%s",executor="%s"`,
+		ml.app.ID,
+		escapeLabel(ml.app.Name),
+		escapeLabel(ml.group),
+		escapeLabel(ml.graph),
+		escapeLabel(ml.title),
+		escapeLabel(ml.executor),
+	)
+}
+
+// escapeLabel escapes a label value as the Prometheus text format requires
+func escapeLabel(s string) string {
+	return strings.NewReplacer(`\`, `\\`, `"`, `\"`, "\n", `\n`).Replace(s)
+}
+
+func formatFloat(f float64) string {
+	return strconv.FormatFloat(f, 'g', -1, 64)
+}
+
+// WritePrometheus writes the master health and the latest values of every
+// metric of the running applications, those of the predicates only if any, in
+// Prometheus text format.
+// Counters map to counters, gauges to gauges, and histograms to summaries with
+// the stored quantiles.
+func (m *Master) WritePrometheus(ctx context.Context, w io.Writer, apps ...predicate.Application) error {
+	bw := bufio.NewWriter(w)
+
+	if err := m.writeHealth(ctx, bw); err != nil {
+		return err
+	}
+	if err := m.writeAppMetrics(ctx, bw, apps); err != nil {
+		return err
+	}
+
+	return bw.Flush()
+}
+
+func (m *Master) writeHealth(ctx context.Context, w io.Writer) error {
+	var rows []struct {
+		Status string `json:"status"`
+		Count  int    `json:"count"`
+	}
+	if err := m.db.Application.
+		Query().
+		GroupBy(entApp.FieldStatus).
+		Aggregate(ent.Count()).
+		Scan(ctx, &rows); err != nil {
+		return err
+	}
+
+	jobs := map[string]int{}
+	for _, s := range []jobState{
+		jobPending, jobProvisioning, jobRunning, jobFinished, jobCancel, jobError,
+	} {
+		jobs[string(s)] = 0
+	}
+	for _, r := range rows {
+		jobs[r.Status] = r.Count
+	}
+	statuses := make([]string, 0, len(jobs))
+	for s := range jobs {
+		statuses = append(statuses, s)
+	}
+	sort.Strings(statuses)
+
+	fmt.Fprintln(w, "# HELP gobench_jobs Number of applications by status.")
+	fmt.Fprintln(w, "# TYPE gobench_jobs gauge")
+	for _, s := range statuses {
+		fmt.Fprintf(w, "gobench_jobs{status=\"%s\"} %d\n", escapeLabel(s), jobs[s])
+	}
+
+	fmt.Fprintln(w, "# HELP gobench_metric_points_ingested_total Number of metric points saved by the master.")
+	fmt.Fprintln(w, "# TYPE gobench_metric_points_ingested_total counter")
+	fmt.Fprintf(w, "gobench_metric_points_ingested_total %d\n", m.Ingested())
+
+	fmt.Fprintln(w, "# HELP gobench_sink_points_dropped_total Number of metric points dropped by the full sink queues.")
+	fmt.Fprintln(w, "# TYPE gobench_sink_points_dropped_total counter")
+	fmt.Fprintf(w, "gobench_sink_points_dropped_total %d\n", m.SinkDropped())
+
+	size, err := m.DbSize()
+	if err != nil {
+		return err
+	}
+	fmt.Fprintln(w, "# HELP gobench_db_size_bytes Size of the master database.")
+	fmt.Fprintln(w, "# TYPE gobench_db_size_bytes gauge")
+	fmt.Fprintf(w, "gobench_db_size_bytes %d\n", size)
+
+	fmt.Fprintln(w, "# HELP gobench_uptime_seconds Number of seconds since the master started.")
+	fmt.Fprintln(w, "# TYPE gobench_uptime_seconds gauge")
+	fmt.Fprintf(w, "gobench_uptime_seconds %s\n", formatFloat(time.Since(m.start).Seconds()))
+
+	return nil
+}
+
+func (m *Master) writeAppMetrics(ctx context.Context, w io.Writer, ps []predicate.Application) error {
+	apps, err := m.db.Application.
+		Query().
+		Where(entApp.Status(string(jobRunning))).
+		Where(ps...).
+		Order(ent.Asc(entApp.FieldID)).
+		WithGroups(func(q *ent.GroupQuery) {
+			q.WithGraphs(func(q *ent.GraphQuery) {
+				q.WithMetrics()
+			})
+		}).
+		All(ctx)
+	if err != nil {
+		return err
+	}
+
+	var counters, gauges []string
+	var summaries []string
+
+	for _, app := range apps {
+		for _, group := range app.Edges.Groups {
+			for _, graph := range group.Edges.Graphs {
+				for _, metric := range graph.Edges.Metrics {
+					labels := metricLabels{
+						app:   app,
+						group: group.Name,
+						graph: graph.Title,
+						title: metric.Title,
+					}
+
+					switch metrics.MetricType(metric.Type) {
+					case metrics.Counter:
+						cs, err := latestCounters(ctx, metric)
+						if err != nil {
+							return err
+						}
+						for _, c := range cs {
+							labels.executor = ExecutorEID(c.Edges.Executor)
+							counters = append(counters,
+								fmt.Sprintf("gobench_counter_total{%s} %d %d", labels, c.Count, c.Time))
+						}
+					case metrics.Gauge:
+						gs, err := latestGauges(ctx, metric)
+						if err != nil {
+							return err
+						}
+						for _, g := range gs {
+							labels.executor = ExecutorEID(g.Edges.Executor)
+							gauges = append(gauges,
+								fmt.Sprintf("gobench_gauge{%s} %d %d", labels, g.Value, g.Time))
+						}
+					case metrics.Histogram:
+						hs, err := latestHistograms(ctx, metric)
+						if err != nil {
+							return err
+						}
+						for _, h := range hs {
+							labels.executor = ExecutorEID(h.Edges.Executor)
+							for _, q := range []struct {
+								quantile string
+								value    float64
+							}{
+								{"0.5", h.Median},
+								{"0.75", h.P75},
+								{"0.95", h.P95},
+								{"0.99", h.P99},
+								{"0.999", h.P999},
+							} {
+								summaries = append(summaries,
+									fmt.Sprintf("gobench_histogram{%s,quantile=\"%s\"} %s %d",
+										labels, q.quantile, formatFloat(q.value), h.Time))
+							}
+							summaries = append(summaries,
+								fmt.Sprintf("gobench_histogram_sum{%s} %s %d",
+									labels, formatFloat(h.Mean*float64(h.Count)), h.Time),
+								fmt.Sprintf("gobench_histogram_count{%s} %d %d",
+									labels, h.Count, h.Time),
+							)
+						}
+					}
+				}
+			}
+		}
+	}
+
+	fmt.Fprintln(w, "# HELP gobench_counter_total Latest value of a counter metric of a running application.")
+	fmt.Fprintln(w, "# TYPE gobench_counter_total counter")
+	for _, l := range counters {
+		fmt.Fprintln(w, l)
+	}
+	fmt.Fprintln(w, "# HELP gobench_gauge Latest value of a gauge metric of a running application.")
+	fmt.Fprintln(w, "# TYPE gobench_gauge gauge")
+	for _, l := range gauges {
+		fmt.Fprintln(w, l)
+	}
+	fmt.Fprintln(w, "# HELP gobench_histogram Latest snapshot of a histogram metric of a running application.")
+	fmt.Fprintln(w, "# TYPE gobench_histogram summary")
+	for _, l := range summaries {
+		fmt.Fprintln(w, l)
+	}
+
+	return nil
+}
+
+// latestCounters returns the latest counter of every executor of a metric
+func latestCounters(ctx context.Context, metric *ent.Metric) ([]*ent.Counter, error) {
+	last, err := metric.QueryCounters().
+		Order(ent.Desc(entCounter.FieldTime)).
+		First(ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, nil
+		}
+		return nil, err
+	}
+
+	cs, err := metric.QueryCounters().
+		WithExecutor().
+		Where(entCounter.TimeGT(last.Time - latestWindow)).
+		Order(ent.Desc(entCounter.FieldTime)).
+		All(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	seen := map[string]bool{}
+	res := []*ent.Counter{}
+	for _, c := range cs {
+		if seen[ExecutorEID(c.Edges.Executor)] {
+			continue
+		}
+		seen[ExecutorEID(c.Edges.Executor)] = true
+		res = append(res, c)
+	}
+	return res, nil
+}
+
+// latestGauges returns the latest gauge of every executor of a metric
+func latestGauges(ctx context.Context, metric *ent.Metric) ([]*ent.Gauge, error) {
+	last, err := metric.QueryGauges().
+		Order(ent.Desc(entGauge.FieldTime)).
+		First(ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, nil
+		}
+		return nil, err
+	}
+
+	gs, err := metric.QueryGauges().
+		WithExecutor().
+		Where(entGauge.TimeGT(last.Time - latestWindow)).
+		Order(ent.Desc(entGauge.FieldTime)).
+		All(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	seen := map[string]bool{}
+	res := []*ent.Gauge{}
+	for _, g := range gs {
+		if seen[ExecutorEID(g.Edges.Executor)] {
+			continue
+		}
+		seen[ExecutorEID(g.Edges.Executor)] = true
+		res = append(res, g)
+	}
+	return res, nil
+}
+
+// latestHistograms returns the latest histogram of every executor of a metric
+func latestHistograms(ctx context.Context, metric *ent.Metric) ([]*ent.Histogram, error) {
+	last, err := metric.QueryHistograms().
+		Order(ent.Desc(entHistogram.FieldTime)).
+		First(ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, nil
+		}
+		return nil, err
+	}
+
+	hs, err := metric.QueryHistograms().
+		WithExecutor().
+		Where(entHistogram.TimeGT(last.Time - latestWindow)).
+		Order(ent.Desc(entHistogram.FieldTime)).
+		All(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	seen := map[string]bool{}
+	res := []*ent.Histogram{}
+	for _, h := range hs {
+		if seen[ExecutorEID(h.Edges.Executor)] {
+			continue
+		}
+		seen[ExecutorEID(h.Edges.Executor)] = true
+		res = append(res, h)
+	}
+	return res, nil
+}
@@ -0,0 +1,54 @@
+package master
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestWritePrometheus(t *testing.T) {
+	ctx := context.Background()
+	m := seedMaster(t)
+
+	app := m.seedApplication(ctx, t)
+	app, err := app.Update().SetStatus(string(jobRunning)).Save(ctx)
+	assert.Nil(t, err)
+	defer app.Update().SetStatus(string(jobFinished)).Save(ctx)
+
+	before := m.Ingested()
+	m.seedMetrics(ctx, t, app, "e1", 1000, 3)
+	assert.EqualValues(t, 9, m.Ingested()-before)
+
+	var buf bytes.Buffer
+	assert.Nil(t, m.WritePrometheus(ctx, &buf))
+	out := buf.String()
+
+	labels := fmt.Sprintf(`application_id="%d",application="foo",group="HTTP",graph="Response"`, app.ID)
+
+	assert.Contains(t, out, "# TYPE gobench_jobs gauge")
+	assert.Contains(t, out, `gobench_jobs{status="running"}`)
+	assert.Contains(t, out, "# TYPE gobench_metric_points_ingested_total counter")
+	assert.Contains(t, out, "gobench_sink_points_dropped_total 0")
+	assert.Contains(t, out, "# TYPE gobench_db_size_bytes gauge")
+
+	assert.Contains(t, out, "# TYPE gobench_counter_total counter")
+	assert.Contains(t, out,
+		"gobench_counter_total{"+labels+`,metric="home.http_ok",executor="e1"} 300 31000`)
+	assert.Contains(t, out, "# TYPE gobench_gauge gauge")
+	assert.Contains(t, out,
+		"gobench_gauge{"+labels+`,metric="home.conns",executor="e1"} 3 31000`)
+	assert.Contains(t, out, "# TYPE gobench_histogram summary")
+	assert.Contains(t, out,
+		"gobench_histogram{"+labels+`,metric="home.latency",executor="e1",quantile="0.99"} 40 31000`)
+	assert.Contains(t, out,
+		"gobench_histogram_sum{"+labels+`,metric="home.latency",executor="e1"} 3000 31000`)
+	assert.Contains(t, out,
+		"gobench_histogram_count{"+labels+`,metric="home.latency",executor="e1"} 300 31000`)
+}
+
+func TestEscapeLabel(t *testing.T) {
+	assert.Equal(t, `a\"b\\c\nd`, escapeLabel("a\"b\\c\nd"))
+}
@@ -0,0 +1,245 @@
+package master
+
+import (
+	"context"
+	"strings"
+	"time"
+
+	"github.com/gobench-io/gobench/ent"
+	"github.com/gobench-io/gobench/executor/metrics"
+	"github.com/gobench-io/gobench/expr"
+
+	entApp "github.com/gobench-io/gobench/ent/application"
+	entCounter "github.com/gobench-io/gobench/ent/counter"
+	entGauge "github.com/gobench-io/gobench/ent/gauge"
+	entGroup "github.com/gobench-io/gobench/ent/group"
+	entHistogram "github.com/gobench-io/gobench/ent/histogram"
+	entMetric "github.com/gobench-io/gobench/ent/metric"
+)
+
+// Query is an expression evaluated over the metrics of an application.
+// From and End default to the run of the application, Step to the report
+// interval, in ms.
+type Query struct {
+	AppID int    `json:"appId"`
+	Expr  string `json:"expr"`
+	From  int64  `json:"from"`
+	End   int64  `json:"end"`
+	Step  int64  `json:"step"`
+}
+
+// QueryResult is the series of an evaluated query
+type QueryResult struct {
+	Query
+	Series []expr.Series `json:"series"`
+}
+
+// appSource gives the series of the metrics of an application
+type appSource struct {
+	app *ent.Application
+}
+
+// metric returns the metric of a selector, a title or "group/title" when
+// the title is in several groups
+func (s *appSource) metric(ctx context.Context, selector string) (*ent.Metric, error) {
+	ms, err := s.app.QueryGroups().QueryGraphs().QueryMetrics().
+		Where(entMetric.Title(selector)).
+		All(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	// the group names and the titles may have a '/' too, try every split
+	if len(ms) == 0 {
+		for i := strings.Index(selector, "/"); i >= 0; {
+			gms, err := s.app.QueryGroups().
+				Where(entGroup.Name(selector[:i])).
+				QueryGraphs().
+				QueryMetrics().
+				Where(entMetric.Title(selector[i+1:])).
+				All(ctx)
+			if err != nil {
+				return nil, err
+			}
+			ms = append(ms, gms...)
+
+			j := strings.Index(selector[i+1:], "/")
+			if j < 0 {
+				break
+			}
+			i += j + 1
+		}
+	}
+
+	switch len(ms) {
+	case 0:
+		return nil, expr.Errorf("no metric %q", selector)
+	case 1:
+		return ms[0], nil
+	}
+	return nil, expr.Errorf("metric %q is in several groups, select it as \"group/title\"", selector)
+}
+
+// metricField returns the value of a field of a point, the count of a
+// counter, the value of a gauge or the mean of a histogram by default
+func metricField(typ metrics.MetricType, field string) (func(interface{}) float64, error) {
+	switch typ {
+	case metrics.Counter:
+		if field == "" || field == "count" {
+			return func(p interface{}) float64 { return float64(p.(*ent.Counter).Count) }, nil
+		}
+	case metrics.Gauge:
+		if field == "" || field == "value" {
+			return func(p interface{}) float64 { return float64(p.(*ent.Gauge).Value) }, nil
+		}
+	case metrics.Histogram:
+		fields := map[string]func(*ent.Histogram) float64{
+			"count":  func(h *ent.Histogram) float64 { return float64(h.Count) },
+			"min":    func(h *ent.Histogram) float64 { return float64(h.Min) },
+			"max":    func(h *ent.Histogram) float64 { return float64(h.Max) },
+			"mean":   func(h *ent.Histogram) float64 { return h.Mean },
+			"stddev": func(h *ent.Histogram) float64 { return h.Stddev },
+			"median": func(h *ent.Histogram) float64 { return h.Median },
+			"p75":    func(h *ent.Histogram) float64 { return h.P75 },
+			"p95":    func(h *ent.Histogram) float64 { return h.P95 },
+			"p99":    func(h *ent.Histogram) float64 { return h.P99 },
+			"p999":   func(h *ent.Histogram) float64 { return h.P999 },
+		}
+		if field == "" {
+			field = "mean"
+		}
+		if f, ok := fields[field]; ok {
+			return func(p interface{}) float64 { return f(p.(*ent.Histogram)) }, nil
+		}
+	}
+	return nil, expr.Errorf("a %s has no field %q", typ, field)
+}
+
+func (s *appSource) Series(ctx context.Context, title, field string, from, end int64) (map[string][]expr.Point, error) {
+	em, err := s.metric(ctx, title)
+	if err != nil {
+		return nil, err
+	}
+	value, err := metricField(metrics.MetricType(em.Type), field)
+	if err != nil {
+		return nil, err
+	}
+
+	ps := map[string][]expr.Point{}
+	add := func(eID string, t int64, p interface{}) {
+		ps[eID] = append(ps[eID], expr.Point{Time: t, Value: value(p)})
+	}
+	q := PointsQuery{From: from, End: end}
+	switch metrics.MetricType(em.Type) {
+	case metrics.Counter:
+		cs, _, err := CounterPoints(ctx, em, q)
+		for _, c := range cs {
+			add(ExecutorEID(c.Edges.Executor), c.Time, c)
+		}
+		return ps, err
+	case metrics.Histogram:
+		hs, _, err := HistogramPoints(ctx, em, q)
+		for _, h := range hs {
+			add(ExecutorEID(h.Edges.Executor), h.Time, h)
+		}
+		return ps, err
+	case metrics.Gauge:
+		gs, _, err := GaugePoints(ctx, em, q)
+		for _, g := range gs {
+			add(ExecutorEID(g.Edges.Executor), g.Time, g)
+		}
+		return ps, err
+	}
+	return ps, nil
+}
+
+// pointsWindow returns the times of the first and the last points of the
+// selected metrics of an application, 0 without points
+func pointsWindow(ctx context.Context, src *appSource, selectors []string) (first, last int64, err error) {
+	for _, sel := range selectors {
+		em, err := src.metric(ctx, sel)
+		if err != nil {
+			return 0, 0, err
+		}
+
+		var ts []int64
+		switch metrics.MetricType(em.Type) {
+		case metrics.Counter:
+			for _, o := range []ent.OrderFunc{ent.Asc(entCounter.FieldTime), ent.Desc(entCounter.FieldTime)} {
+				if c, err := em.QueryCounters().Order(o).First(ctx); err == nil {
+					ts = append(ts, c.Time)
+				}
+			}
+		case metrics.Histogram:
+			for _, o := range []ent.OrderFunc{ent.Asc(entHistogram.FieldTime), ent.Desc(entHistogram.FieldTime)} {
+				if h, err := em.QueryHistograms().Order(o).First(ctx); err == nil {
+					ts = append(ts, h.Time)
+				}
+			}
+		case metrics.Gauge:
+			for _, o := range []ent.OrderFunc{ent.Asc(entGauge.FieldTime), ent.Desc(entGauge.FieldTime)} {
+				if g, err := em.QueryGauges().Order(o).First(ctx); err == nil {
+					ts = append(ts, g.Time)
+				}
+			}
+		}
+		if len(ts) != 2 {
+			continue
+		}
+		if first == 0 || ts[0] < first {
+			first = ts[0]
+		}
+		if ts[1] > last {
+			last = ts[1]
+		}
+	}
+
+	return first, last, nil
+}
+
+// Query evaluates an expression over the metrics of an application. A fault
+// of the expression is an expr.Error.
+func (m *Master) Query(ctx context.Context, q Query) (*QueryResult, error) {
+	e, err := expr.Parse(q.Expr)
+	if err != nil {
+		return nil, err
+	}
+	app, err := m.db.Application.Query().Where(entApp.ID(q.AppID)).Only(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	if q.Step == 0 {
+		q.Step = reportInterval
+	}
+	src := &appSource{app}
+	from, end := runWindow(app)
+	if q.From == 0 || q.End == 0 {
+		// without a start or a finish, the run spans its points
+		first, last, err := pointsWindow(ctx, src, e.Selectors())
+		if err != nil {
+			return nil, err
+		}
+		if from == 0 && first > 0 {
+			from = first - q.Step
+		}
+		if end == 0 {
+			end = last
+		}
+	}
+	if q.From == 0 {
+		q.From = from
+	}
+	if q.End == 0 {
+		q.End = end
+	}
+	if q.End == 0 {
+		q.End = time.Now().UnixNano() / int64(time.Millisecond)
+	}
+
+	ss, err := e.Eval(ctx, src, q.From, q.End, q.Step)
+	if err != nil {
+		return nil, err
+	}
+	return &QueryResult{q, ss}, nil
+}
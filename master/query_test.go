@@ -0,0 +1,93 @@
+package master
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/gobench-io/gobench/ent"
+	"github.com/gobench-io/gobench/executor/metrics"
+	"github.com/gobench-io/gobench/expr"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestQuery(t *testing.T) {
+	ctx := context.Background()
+	m := seedRetentionMaster(t, Retention{})
+	app := m.seedApplication(ctx, t)
+	t0 := int64(1000 * 60000)
+	m.seedMetrics(ctx, t, app, "e1", t0, 12)
+	m.seedMetrics(ctx, t, app, "e2", t0, 12)
+
+	t.Run("the run spans its points", func(t *testing.T) {
+		r, err := m.Query(ctx, Query{AppID: app.ID, Expr: "sum(home.http_ok)"})
+		assert.Nil(t, err)
+		assert.Equal(t, t0, r.From)
+		assert.Equal(t, t0+120000, r.End)
+		assert.EqualValues(t, reportInterval, r.Step)
+		if assert.Len(t, r.Series, 1) {
+			ps := r.Series[0].Points
+			assert.Len(t, ps, 12)
+			assert.Equal(t, expr.Point{Time: t0 + 10000, Value: 200}, ps[0])
+			assert.Equal(t, expr.Point{Time: t0 + 120000, Value: 2400}, ps[11])
+		}
+	})
+
+	t.Run("fields and rates", func(t *testing.T) {
+		r, err := m.Query(ctx, Query{
+			AppID: app.ID, Expr: "max(home.latency:p95) + rate(home.http_ok)",
+			From: t0 + 50000, End: t0 + 60000,
+		})
+		assert.Nil(t, err)
+		assert.Len(t, r.Series, 2)
+		for _, s := range r.Series {
+			assert.Equal(t, []expr.Point{{Time: t0 + 60000, Value: 40}}, s.Points)
+		}
+	})
+
+	t.Run("step", func(t *testing.T) {
+		r, err := m.Query(ctx, Query{AppID: app.ID, Expr: "home.conns", Step: 60000})
+		assert.Nil(t, err)
+		if assert.Len(t, r.Series, 2) {
+			assert.Equal(t, "e1", r.Series[0].EID)
+			assert.Equal(t, []expr.Point{
+				{Time: t0 + 60000, Value: 6}, {Time: t0 + 120000, Value: 12},
+			}, r.Series[0].Points)
+		}
+	})
+
+	t.Run("a title in several groups", func(t *testing.T) {
+		g, err := m.db.Group.Create().SetName("WS").SetApplication(app).Save(ctx)
+		assert.Nil(t, err)
+		gr, err := m.db.Graph.Create().SetTitle("Conns").SetUnit("N").SetGroup(g).Save(ctx)
+		assert.Nil(t, err)
+		_, err = m.db.Metric.Create().SetTitle("home.conns").SetType(string(metrics.Gauge)).
+			SetGraph(gr).Save(ctx)
+		assert.Nil(t, err)
+
+		_, err = m.Query(ctx, Query{AppID: app.ID, Expr: "home.conns"})
+		var e *expr.Error
+		if assert.True(t, errors.As(err, &e)) {
+			assert.Contains(t, e.Msg, "several groups")
+		}
+
+		r, err := m.Query(ctx, Query{AppID: app.ID, Expr: `"HTTP/home.conns"`, Step: 60000})
+		assert.Nil(t, err)
+		assert.Len(t, r.Series, 2)
+
+		r, err = m.Query(ctx, Query{AppID: app.ID, Expr: `"WS/home.conns"`, From: t0, End: t0 + 60000})
+		assert.Nil(t, err)
+		assert.Len(t, r.Series, 0)
+	})
+
+	t.Run("faults", func(t *testing.T) {
+		for _, s := range []string{"home.foo", "home.http_ok:p99", "home.latency:foo", "home.conns +"} {
+			_, err := m.Query(ctx, Query{AppID: app.ID, Expr: s})
+			var e *expr.Error
+			assert.True(t, errors.As(err, &e), s)
+		}
+
+		_, err := m.Query(ctx, Query{AppID: app.ID + 100, Expr: "home.conns"})
+		assert.True(t, ent.IsNotFound(err))
+	})
+}
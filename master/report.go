@@ -0,0 +1,303 @@
+package master
+
+import (
+	"context"
+	_ "embed" // report template
+	"fmt"
+	"html/template"
+	"io"
+	"math"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/gobench-io/gobench/ent"
+	"github.com/gobench-io/gobench/executor/metrics"
+
+	entApp "github.com/gobench-io/gobench/ent/application"
+	entCounter "github.com/gobench-io/gobench/ent/counter"
+	entGauge "github.com/gobench-io/gobench/ent/gauge"
+	entHistogram "github.com/gobench-io/gobench/ent/histogram"
+)
+
+// ReportContentType is the content type of the HTML report
+const ReportContentType = "text/html; charset=utf-8"
+
+//go:embed report.html
+var reportHTML string
+
+var reportTmpl = template.Must(template.New("report").Funcs(template.FuncMap{
+	"f2": func(f float64) string { return strconv.FormatFloat(f, 'f', 2, 64) },
+	"ts": func(t time.Time) string {
+		if t.IsZero() {
+			return "-"
+		}
+		return t.UTC().Format(time.RFC3339)
+	},
+	"pct": func(p *float64) string {
+		if p == nil {
+			return "-"
+		}
+		return fmt.Sprintf("%+.2f%%", *p)
+	},
+}).Parse(reportHTML))
+
+// chart size in px
+const (
+	chartWidth   = 720
+	chartHeight  = 240
+	chartPadLeft = 60
+	chartPadBot  = 30
+	chartPadTop  = 10
+	chartPadRgt  = 10
+)
+
+var chartColors = []string{
+	"#1f77b4", "#ff7f0e", "#2ca02c", "#d62728",
+	"#9467bd", "#8c564b", "#e377c2", "#17becf",
+}
+
+type reportData struct {
+	App       *ent.Application
+	Tags      []string
+	Summary   *Summary
+	Groups    []*reportGroup
+	Generated time.Time
+	Server    string
+}
+
+type reportGroup struct {
+	Name   string
+	Graphs []*reportGraph
+}
+
+type reportGraph struct {
+	Title string
+	Unit  string
+	Chart *chart
+}
+
+// xy is a point of a chart, x in seconds since the start of the run
+type xy struct {
+	X, Y float64
+}
+
+type chartSeries struct {
+	Title  string
+	Points []xy
+}
+
+type chartLine struct {
+	Title  string
+	Color  string
+	Points string // svg polyline points
+}
+
+type chartTick struct {
+	Pos   float64
+	Label string
+}
+
+// chart is a line chart rendered as inline svg
+type chart struct {
+	Width, Height  int
+	Left, Top      int
+	Right, Bottom  int
+	Lines          []chartLine
+	XTicks, YTicks []chartTick
+	Empty          bool
+}
+
+func newChart(series []chartSeries) *chart {
+	c := &chart{
+		Width:  chartWidth,
+		Height: chartHeight,
+		Left:   chartPadLeft,
+		Top:    chartPadTop,
+		Right:  chartWidth - chartPadRgt,
+		Bottom: chartHeight - chartPadBot,
+	}
+
+	maxX, maxY := 0.0, 0.0
+	n := 0
+	for _, s := range series {
+		for _, p := range s.Points {
+			maxX = math.Max(maxX, p.X)
+			maxY = math.Max(maxY, p.Y)
+			n++
+		}
+	}
+	if n == 0 {
+		c.Empty = true
+		return c
+	}
+	if maxX == 0 {
+		maxX = 1
+	}
+	if maxY == 0 {
+		maxY = 1
+	}
+
+	w := float64(c.Right - c.Left)
+	h := float64(c.Bottom - c.Top)
+	px := func(x float64) float64 { return float64(c.Left) + x/maxX*w }
+	py := func(y float64) float64 { return float64(c.Bottom) - y/maxY*h }
+
+	for i, s := range series {
+		pts := make([]string, 0, len(s.Points))
+		for _, p := range s.Points {
+			pts = append(pts, fmt.Sprintf("%.1f,%.1f", px(p.X), py(p.Y)))
+		}
+		c.Lines = append(c.Lines, chartLine{
+			Title:  s.Title,
+			Color:  chartColors[i%len(chartColors)],
+			Points: strings.Join(pts, " "),
+		})
+	}
+
+	for i := 0; i <= 4; i++ {
+		x := maxX * float64(i) / 4
+		y := maxY * float64(i) / 4
+		c.XTicks = append(c.XTicks, chartTick{px(x), strconv.FormatFloat(x, 'f', 0, 64) + "s"})
+		c.YTicks = append(c.YTicks, chartTick{py(y), strconv.FormatFloat(y, 'g', 4, 64)})
+	}
+
+	return c
+}
+
+// WriteReport writes a self-contained HTML report of an application: run
+// metadata, tags, the summary table, the baseline checks, a chart for every
+// graph and the scenario source.
+// Counters are charted as rates per second, histograms as p95 and gauges as
+// values, all summed over the executors every report interval.
+func (m *Master) WriteReport(ctx context.Context, appID int, w io.Writer) error {
+	app, err := m.db.Application.
+		Query().
+		Where(entApp.ID(appID)).
+		WithTags().
+		Only(ctx)
+	if err != nil {
+		return err
+	}
+
+	summary, err := m.ApplicationSummary(ctx, app)
+	if err != nil {
+		return err
+	}
+
+	data := &reportData{
+		App:       app,
+		Tags:      []string{},
+		Summary:   summary,
+		Generated: time.Now(),
+		Server:    m.GetHostname(),
+	}
+	for _, t := range app.Edges.Tags {
+		data.Tags = append(data.Tags, t.Name)
+	}
+	sort.Strings(data.Tags)
+
+	gs, err := app.QueryGroups().
+		WithGraphs(func(q *ent.GraphQuery) {
+			q.WithMetrics()
+		}).
+		All(ctx)
+	if err != nil {
+		return err
+	}
+
+	for _, g := range gs {
+		rg := &reportGroup{Name: g.Name}
+		for _, gr := range g.Edges.Graphs {
+			series := []chartSeries{}
+			for _, em := range gr.Edges.Metrics {
+				s, err := reportSeries(ctx, app, em)
+				if err != nil {
+					return err
+				}
+				series = append(series, s)
+			}
+			rg.Graphs = append(rg.Graphs, &reportGraph{
+				Title: gr.Title,
+				Unit:  gr.Unit,
+				Chart: newChart(series),
+			})
+		}
+		data.Groups = append(data.Groups, rg)
+	}
+
+	return reportTmpl.Execute(w, data)
+}
+
+// reportSeries buckets the points of a metric by report interval
+func reportSeries(ctx context.Context, app *ent.Application, em *ent.Metric) (chartSeries, error) {
+	s := chartSeries{Title: em.Title}
+	buckets := map[int64]float64{}
+
+	switch metrics.MetricType(em.Type) {
+	case metrics.Counter:
+		s.Title += " (per second)"
+		cs, err := em.QueryCounters().WithExecutor().Order(ent.Asc(entCounter.FieldTime)).All(ctx)
+		if err != nil || len(cs) == 0 {
+			return s, err
+		}
+		start := runStart(app, cs[0].Time)
+		type prev struct{ time, count int64 }
+		prevs := map[string]prev{}
+		for _, c := range cs {
+			p, ok := prevs[ExecutorEID(c.Edges.Executor)]
+			if !ok {
+				p = prev{start, 0}
+			}
+			prevs[ExecutorEID(c.Edges.Executor)] = prev{c.Time, c.Count}
+			if c.Time <= p.time {
+				continue
+			}
+			rate := float64(c.Count-p.count) / (float64(c.Time-p.time) / 1000)
+			buckets[(c.Time-start)/reportInterval] += math.Max(rate, 0)
+		}
+	case metrics.Histogram:
+		s.Title += " (p95)"
+		hs, err := em.QueryHistograms().Order(ent.Asc(entHistogram.FieldTime)).All(ctx)
+		if err != nil || len(hs) == 0 {
+			return s, err
+		}
+		start := runStart(app, hs[0].Time)
+		// count weighted p95 of the executors
+		weights := map[int64]float64{}
+		for _, h := range hs {
+			b := (h.Time - start) / reportInterval
+			buckets[b] += float64(h.Count) * h.P95
+			weights[b] += float64(h.Count)
+		}
+		for b, w := range weights {
+			if w > 0 {
+				buckets[b] /= w
+			}
+		}
+	case metrics.Gauge:
+		gs, err := em.QueryGauges().Order(ent.Asc(entGauge.FieldTime)).All(ctx)
+		if err != nil || len(gs) == 0 {
+			return s, err
+		}
+		start := runStart(app, gs[0].Time)
+		for _, g := range gs {
+			buckets[(g.Time-start)/reportInterval] += float64(g.Value)
+		}
+	}
+
+	keys := make([]int64, 0, len(buckets))
+	for b := range buckets {
+		keys = append(keys, b)
+	}
+	sort.Slice(keys, func(i, j int) bool { return keys[i] < keys[j] })
+	for _, b := range keys {
+		s.Points = append(s.Points, xy{
+			X: float64(b*reportInterval) / 1000,
+			Y: buckets[b],
+		})
+	}
+
+	return s, nil
+}
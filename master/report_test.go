@@ -0,0 +1,126 @@
+package master
+
+import (
+	"bytes"
+	"context"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/gobench-io/gobench/logger"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestWriteReport(t *testing.T) {
+	ctx := context.Background()
+	m := seedMaster(t)
+
+	app, err := m.NewApplication(ctx, "report <app>", "package main // scenario", "", "")
+	assert.Nil(t, err)
+	m.seedMetrics(ctx, t, app, "e1", 1000, 3)
+	m.seedMetrics(ctx, t, app, "e2", 1000, 3)
+	_, err = m.SetApplicationTag(ctx, app.ID, "nightly")
+	assert.Nil(t, err)
+
+	var buf bytes.Buffer
+	assert.Nil(t, m.WriteReport(ctx, app.ID, &buf))
+	html := buf.String()
+
+	assert.Contains(t, html, "<!DOCTYPE html>")
+	assert.Contains(t, html, "report &lt;app&gt;")
+	assert.Contains(t, html, `<span class="tag">nightly</span>`)
+	assert.Contains(t, html, "package main // scenario")
+	assert.Contains(t, html, "No baseline for this run.")
+	// one chart for the graph with a line per metric
+	assert.Equal(t, 1, strings.Count(html, "<svg"))
+	assert.Equal(t, 3, strings.Count(html, "<polyline"))
+	assert.Contains(t, html, "home.http_ok (per second)")
+	assert.Contains(t, html, "home.latency (p95)")
+	// summary of the two executors
+	assert.Contains(t, html, "<td>600</td>")
+	// self-contained
+	assert.NotContains(t, html, "<script")
+	assert.NotContains(t, html, "<link")
+}
+
+func TestReportSeries(t *testing.T) {
+	ctx := context.Background()
+	m := seedMaster(t)
+
+	app := m.seedApplication(ctx, t)
+	cID, hID, gID := m.seedMetrics(ctx, t, app, "e1", 1000, 3)
+	m.seedMetrics(ctx, t, app, "e2", 1000, 3)
+
+	em, err := m.db.Metric.Get(ctx, int(cID))
+	assert.Nil(t, err)
+	s, err := reportSeries(ctx, app, em)
+	assert.Nil(t, err)
+	// 100 per 10 seconds by each executor
+	assert.Equal(t, []xy{{10, 20}, {20, 20}, {30, 20}}, s.Points)
+
+	em, err = m.db.Metric.Get(ctx, int(hID))
+	assert.Nil(t, err)
+	s, err = reportSeries(ctx, app, em)
+	assert.Nil(t, err)
+	assert.Equal(t, []xy{{10, 30}, {20, 30}, {30, 30}}, s.Points)
+
+	em, err = m.db.Metric.Get(ctx, int(gID))
+	assert.Nil(t, err)
+	s, err = reportSeries(ctx, app, em)
+	assert.Nil(t, err)
+	assert.Equal(t, []xy{{10, 2}, {20, 4}, {30, 6}}, s.Points)
+}
+
+func TestNewChart(t *testing.T) {
+	c := newChart(nil)
+	assert.True(t, c.Empty)
+
+	c = newChart([]chartSeries{{Title: "a", Points: []xy{{0, 0}, {10, 5}}}})
+	assert.False(t, c.Empty)
+	assert.Len(t, c.Lines, 1)
+	assert.Equal(t, "60.0,210.0 710.0,10.0", c.Lines[0].Points)
+	assert.Len(t, c.XTicks, 5)
+	assert.Equal(t, "10s", c.XTicks[4].Label)
+}
+
+func TestWriteReportReadOnly(t *testing.T) {
+	ctx := context.Background()
+	m := seedRetentionMaster(t, Retention{})
+	app := m.seedApplication(ctx, t)
+	m.seedMetrics(ctx, t, app, "e1", 1000, 3)
+
+	ro, err := NewMaster(&Options{HomeDir: m.homeDir, Program: "gobench"}, logger.NewNopLogger())
+	assert.Nil(t, err)
+	assert.Nil(t, ro.OpenDbReadOnly())
+	t.Cleanup(func() { ro.db.Close() })
+
+	var buf bytes.Buffer
+	assert.Nil(t, ro.WriteReport(ctx, app.ID, &buf))
+	assert.Contains(t, buf.String(), "home.http_ok (per second)")
+
+	// nothing is written
+	_, err = ro.db.Project.Create().SetName("other").Save(ctx)
+	assert.Contains(t, err.Error(), "readonly")
+
+	t.Run("a dsn with parameters", func(t *testing.T) {
+		dsn := "file:" + filepath.Join(m.homeDir, "gobench.sqlite3") + "?cache=shared"
+		ro, err := NewMaster(&Options{HomeDir: m.homeDir, Program: "gobench",
+			DbDriver: "sqlite3", DbDSN: dsn}, logger.NewNopLogger())
+		assert.Nil(t, err)
+		assert.Nil(t, ro.OpenDbReadOnly())
+		defer ro.db.Close()
+
+		var buf bytes.Buffer
+		assert.Nil(t, ro.WriteReport(ctx, app.ID, &buf))
+	})
+
+	t.Run("no database", func(t *testing.T) {
+		home := t.TempDir()
+		ro, err := NewMaster(&Options{HomeDir: home, Program: "gobench"}, logger.NewNopLogger())
+		assert.Nil(t, err)
+		assert.True(t, os.IsNotExist(ro.OpenDbReadOnly()))
+		_, err = os.Stat(filepath.Join(home, "gobench.sqlite3"))
+		assert.True(t, os.IsNotExist(err))
+	})
+}
@@ -0,0 +1,228 @@
+package master
+
+import (
+	"context"
+	"encoding/xml"
+	"fmt"
+	"io"
+	"sort"
+	"strconv"
+	"time"
+
+	"github.com/gobench-io/gobench/ent"
+	"github.com/gobench-io/gobench/verdict"
+
+	entApp "github.com/gobench-io/gobench/ent/application"
+)
+
+// JUnitContentType is the content type of the JUnit XML result
+const JUnitContentType = "application/xml; charset=utf-8"
+
+// Result is the compact outcome of a run for CI pipelines. A run passes when
+// it finished and did not regress against its baseline.
+type Result struct {
+	ID            int       `json:"id"`
+	Name          string    `json:"name"`
+	Status        string    `json:"status"`
+	Passed        bool      `json:"passed"`
+	Verdict       string    `json:"verdict,omitempty"`
+	BaselineAppID int       `json:"baselineAppId,omitempty"`
+	Tags          []string  `json:"tags"`
+	StartedAt     time.Time `json:"startedAt"`
+	UpdatedAt     time.Time `json:"updatedAt"`
+
+	Duration  float64 `json:"duration"`
+	Requests  int64   `json:"requests"`
+	Errors    int64   `json:"errors"`
+	ErrorRate float64 `json:"errorRate"`
+	RPS       float64 `json:"rps"`
+	P50       float64 `json:"p50"`
+	P95       float64 `json:"p95"`
+	P99       float64 `json:"p99"`
+
+	Checks []verdict.Check `json:"checks"`
+}
+
+// ApplicationResult returns the result of an application
+func (m *Master) ApplicationResult(ctx context.Context, appID int) (*Result, error) {
+	app, err := m.db.Application.
+		Query().
+		Where(entApp.ID(appID)).
+		WithTags().
+		Only(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	s, err := m.ApplicationSummary(ctx, app)
+	if err != nil {
+		return nil, err
+	}
+
+	return newResult(app, s), nil
+}
+
+func newResult(app *ent.Application, s *Summary) *Result {
+	r := &Result{
+		ID:            app.ID,
+		Name:          app.Name,
+		Status:        app.Status,
+		Passed:        app.Status == string(jobFinished) && app.Verdict != verdict.Regressed,
+		Verdict:       app.Verdict,
+		BaselineAppID: app.BaselineAppID,
+		Tags:          []string{},
+		StartedAt:     app.StartedAt,
+		UpdatedAt:     app.UpdatedAt,
+
+		Duration:  s.Duration,
+		Requests:  s.Requests,
+		Errors:    s.Errors,
+		ErrorRate: s.ErrorRate,
+		RPS:       s.RPS,
+		P50:       s.P50,
+		P95:       s.P95,
+		P99:       s.P99,
+
+		Checks: app.Checks,
+	}
+	if r.Checks == nil {
+		r.Checks = []verdict.Check{}
+	}
+	for _, t := range app.Edges.Tags {
+		r.Tags = append(r.Tags, t.Name)
+	}
+	sort.Strings(r.Tags)
+
+	return r
+}
+
+type junitTestSuites struct {
+	XMLName  xml.Name         `xml:"testsuites"`
+	Name     string           `xml:"name,attr"`
+	Tests    int              `xml:"tests,attr"`
+	Failures int              `xml:"failures,attr"`
+	Time     string           `xml:"time,attr"`
+	Suites   []junitTestSuite `xml:"testsuite"`
+}
+
+type junitTestSuite struct {
+	Name       string          `xml:"name,attr"`
+	Tests      int             `xml:"tests,attr"`
+	Failures   int             `xml:"failures,attr"`
+	Time       string          `xml:"time,attr"`
+	Timestamp  string          `xml:"timestamp,attr,omitempty"`
+	Properties []junitProperty `xml:"properties>property"`
+	Cases      []junitTestCase `xml:"testcase"`
+}
+
+type junitProperty struct {
+	Name  string `xml:"name,attr"`
+	Value string `xml:"value,attr"`
+}
+
+type junitTestCase struct {
+	Name      string        `xml:"name,attr"`
+	Classname string        `xml:"classname,attr"`
+	Time      string        `xml:"time,attr"`
+	Failure   *junitFailure `xml:"failure,omitempty"`
+}
+
+type junitFailure struct {
+	Message string `xml:"message,attr"`
+	Type    string `xml:"type,attr"`
+	Text    string `xml:",chardata"`
+}
+
+// formatDecimal formats a float without exponent
+func formatDecimal(f float64) string {
+	return strconv.FormatFloat(f, 'f', -1, 64)
+}
+
+// WriteJUnit writes the result as a JUnit XML report. The run status and
+// every baseline check are test cases, a regressed check fails with the
+// observed values.
+func (r *Result) WriteJUnit(w io.Writer) error {
+	classname := "gobench." + r.Name
+	duration := formatDecimal(r.Duration)
+
+	suite := junitTestSuite{
+		Name: classname,
+		Time: duration,
+		Properties: []junitProperty{
+			{"application.id", strconv.Itoa(r.ID)},
+			{"status", r.Status},
+			{"verdict", r.Verdict},
+			{"requests", strconv.FormatInt(r.Requests, 10)},
+			{"errors", strconv.FormatInt(r.Errors, 10)},
+			{"errorRate", formatDecimal(r.ErrorRate)},
+			{"rps", formatDecimal(r.RPS)},
+			{"p50", formatDecimal(r.P50)},
+			{"p95", formatDecimal(r.P95)},
+			{"p99", formatDecimal(r.P99)},
+		},
+	}
+	if !r.StartedAt.IsZero() {
+		suite.Timestamp = r.StartedAt.UTC().Format("2006-01-02T15:04:05")
+	}
+
+	run := junitTestCase{
+		Name:      "run",
+		Classname: classname,
+		Time:      duration,
+	}
+	if r.Status != string(jobFinished) {
+		run.Failure = &junitFailure{
+			Message: fmt.Sprintf("application %d is %s", r.ID, r.Status),
+			Type:    r.Status,
+		}
+	}
+	suite.Cases = append(suite.Cases, run)
+
+	for _, c := range r.Checks {
+		tc := junitTestCase{
+			Name:      fmt.Sprintf("%s/%s/%s %s", c.Group, c.Graph, c.Metric, c.Stat),
+			Classname: classname,
+			Time:      "0",
+		}
+		if c.Verdict == verdict.Regressed {
+			change := "new"
+			if c.Change != nil {
+				change = fmt.Sprintf("%+.2f%%", *c.Change)
+			}
+			tc.Failure = &junitFailure{
+				Message: fmt.Sprintf("%s %s regressed: %s -> %s (%s), tolerance %s%%",
+					c.Metric, c.Stat, formatDecimal(c.Baseline), formatDecimal(c.Value), change,
+					formatDecimal(c.Tolerance)),
+				Type: verdict.Regressed,
+				Text: fmt.Sprintf("baseline application %d\n", r.BaselineAppID),
+			}
+		}
+		suite.Cases = append(suite.Cases, tc)
+	}
+
+	for _, tc := range suite.Cases {
+		suite.Tests++
+		if tc.Failure != nil {
+			suite.Failures++
+		}
+	}
+
+	doc := junitTestSuites{
+		Name:     "gobench",
+		Tests:    suite.Tests,
+		Failures: suite.Failures,
+		Time:     duration,
+		Suites:   []junitTestSuite{suite},
+	}
+
+	if _, err := io.WriteString(w, xml.Header); err != nil {
+		return err
+	}
+	enc := xml.NewEncoder(w)
+	enc.Indent("", "  ")
+	if err := enc.Encode(doc); err != nil {
+		return err
+	}
+	_, err := io.WriteString(w, "\n")
+	return err
+}
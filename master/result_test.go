@@ -0,0 +1,95 @@
+package master
+
+import (
+	"bytes"
+	"context"
+	"encoding/xml"
+	"testing"
+
+	"github.com/gobench-io/gobench/verdict"
+	"github.com/stretchr/testify/assert"
+
+	entHistogram "github.com/gobench-io/gobench/ent/histogram"
+	entMetric "github.com/gobench-io/gobench/ent/metric"
+)
+
+func TestApplicationResult(t *testing.T) {
+	ctx := context.Background()
+	m := seedMaster(t)
+
+	tag := "result-baseline"
+	ref, _ := m.seedFinishedApp(ctx, t, tag)
+	_, err := m.SetBaseline(ctx, ref.ID, tag, DefaultTolerance, nil)
+	assert.Nil(t, err)
+
+	t.Run("unfinished", func(t *testing.T) {
+		app := m.seedApplication(ctx, t)
+		res, err := m.ApplicationResult(ctx, app.ID)
+		assert.Nil(t, err)
+		assert.False(t, res.Passed)
+		assert.Equal(t, []string{}, res.Tags)
+		assert.Equal(t, []verdict.Check{}, res.Checks)
+
+		var buf bytes.Buffer
+		assert.Nil(t, res.WriteJUnit(&buf))
+		var doc junitTestSuites
+		assert.Nil(t, xml.Unmarshal(buf.Bytes(), &doc))
+		assert.Equal(t, 1, doc.Tests)
+		assert.Equal(t, 1, doc.Failures)
+		assert.Equal(t, "pending", doc.Suites[0].Cases[0].Failure.Type)
+	})
+
+	t.Run("passed", func(t *testing.T) {
+		app, _ := m.seedFinishedApp(ctx, t, tag)
+		_, err := m.judge(ctx, app)
+		assert.Nil(t, err)
+
+		res, err := m.ApplicationResult(ctx, app.ID)
+		assert.Nil(t, err)
+		assert.True(t, res.Passed)
+		assert.Equal(t, verdict.Pass, res.Verdict)
+		assert.Equal(t, ref.ID, res.BaselineAppID)
+		assert.Equal(t, []string{tag}, res.Tags)
+		assert.Len(t, res.Checks, 3)
+		assert.True(t, res.Requests > 0)
+
+		var buf bytes.Buffer
+		assert.Nil(t, res.WriteJUnit(&buf))
+		var doc junitTestSuites
+		assert.Nil(t, xml.Unmarshal(buf.Bytes(), &doc))
+		// the run and the checks
+		assert.Equal(t, 4, doc.Tests)
+		assert.Equal(t, 0, doc.Failures)
+	})
+
+	t.Run("regressed", func(t *testing.T) {
+		app, hID := m.seedFinishedApp(ctx, t, tag)
+		_, err := m.db.Histogram.Update().
+			Where(entHistogram.HasMetricWith(entMetric.ID(int(hID)))).
+			SetP95(500).
+			Save(ctx)
+		assert.Nil(t, err)
+		_, err = m.judge(ctx, app)
+		assert.Nil(t, err)
+
+		res, err := m.ApplicationResult(ctx, app.ID)
+		assert.Nil(t, err)
+		assert.False(t, res.Passed)
+		assert.Equal(t, verdict.Regressed, res.Verdict)
+
+		var buf bytes.Buffer
+		assert.Nil(t, res.WriteJUnit(&buf))
+		var doc junitTestSuites
+		assert.Nil(t, xml.Unmarshal(buf.Bytes(), &doc))
+		assert.Equal(t, 1, doc.Failures)
+		for _, tc := range doc.Suites[0].Cases {
+			if tc.Failure == nil {
+				continue
+			}
+			assert.Contains(t, tc.Name, "p95")
+			assert.Equal(t, verdict.Regressed, tc.Failure.Type)
+			assert.Contains(t, tc.Failure.Message, "-> 500")
+			assert.Contains(t, tc.Failure.Message, "tolerance 10%")
+		}
+	})
+}
@@ -0,0 +1,521 @@
+package master
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/facebook/ent/dialect/sql"
+	"github.com/gobench-io/gobench/ent"
+	"github.com/gobench-io/gobench/ent/predicate"
+	"github.com/gobench-io/gobench/executor/metrics"
+
+	entApp "github.com/gobench-io/gobench/ent/application"
+	entCounter "github.com/gobench-io/gobench/ent/counter"
+	entGauge "github.com/gobench-io/gobench/ent/gauge"
+	entGraph "github.com/gobench-io/gobench/ent/graph"
+	entGroup "github.com/gobench-io/gobench/ent/group"
+	entHistogram "github.com/gobench-io/gobench/ent/histogram"
+	entMetric "github.com/gobench-io/gobench/ent/metric"
+	entProject "github.com/gobench-io/gobench/ent/project"
+)
+
+// deleteChunk is the number of points deleted by one statement, below the
+// limit of host parameters of the databases
+const deleteChunk = 500
+
+// Retention is the metric retention policy of the master. The raw points
+// older than Raw are downsampled to one point per Interval, the points older
+// than Rollup are deleted. A zero duration keeps the points forever, a zero
+// Interval is DefaultRollupInterval and a zero Every disables the scheduled
+// maintenance.
+type Retention struct {
+	Raw      time.Duration
+	Rollup   time.Duration
+	Interval time.Duration
+	Every    time.Duration
+}
+
+// DefaultRetention keeps every point, the daily maintenance has nothing to
+// do
+var DefaultRetention = Retention{
+	Every: 24 * time.Hour,
+}
+
+// DefaultRollupInterval is the interval of the downsampled points when the
+// retention has none
+const DefaultRollupInterval = time.Minute
+
+// interval returns the interval of the downsampled points
+func (r Retention) interval() time.Duration {
+	if r.Interval > 0 {
+		return r.Interval
+	}
+	return DefaultRollupInterval
+}
+
+// MarshalJSON writes the durations as strings, "720h0m0s"
+func (r Retention) MarshalJSON() ([]byte, error) {
+	return json.Marshal(map[string]string{
+		"raw":      r.Raw.String(),
+		"rollup":   r.Rollup.String(),
+		"interval": r.Interval.String(),
+		"every":    r.Every.String(),
+	})
+}
+
+// UnmarshalJSON reads the durations written by MarshalJSON
+func (r *Retention) UnmarshalJSON(data []byte) error {
+	var ds map[string]string
+	if err := json.Unmarshal(data, &ds); err != nil {
+		return err
+	}
+	for k, d := range map[string]*time.Duration{
+		"raw":      &r.Raw,
+		"rollup":   &r.Rollup,
+		"interval": &r.Interval,
+		"every":    &r.Every,
+	} {
+		if ds[k] == "" {
+			continue
+		}
+		v, err := time.ParseDuration(ds[k])
+		if err != nil {
+			return err
+		}
+		*d = v
+	}
+	return nil
+}
+
+// MaintenanceReport is the outcome of a maintenance run
+type MaintenanceReport struct {
+	Start       time.Time `json:"start"`
+	Duration    float64   `json:"duration"`    // seconds
+	Downsampled int       `json:"downsampled"` // raw points merged into rollups
+	Deleted     int       `json:"deleted"`     // points past the rollup retention
+	Vacuumed    bool      `json:"vacuumed"`
+	SizeBefore  int64     `json:"sizeBefore"` // bytes
+	SizeAfter   int64     `json:"sizeAfter"`  // bytes
+	Error       string    `json:"error,omitempty"`
+}
+
+// maintenance serializes the maintenance runs and keeps the last report
+type maintenance struct {
+	run  sync.Mutex // held during a run
+	mu   sync.Mutex // protects last
+	last *MaintenanceReport
+}
+
+// LastMaintenance returns the report of the last maintenance run, nil if
+// none ran since the master started
+func (m *Master) LastMaintenance() *MaintenanceReport {
+	m.maint.mu.Lock()
+	defer m.maint.mu.Unlock()
+
+	return m.maint.last
+}
+
+// Maintain applies the retention policy then vacuums the database when points
+// were removed. Only the
+// points of the finished, canceled or error applications are downsampled.
+// The report is returned with the first error.
+func (m *Master) Maintain(ctx context.Context) (*MaintenanceReport, error) {
+	m.maint.run.Lock()
+	defer m.maint.run.Unlock()
+
+	r := &MaintenanceReport{Start: time.Now()}
+	err := m.maintain(ctx, r)
+	if err != nil {
+		r.Error = err.Error()
+	}
+	r.Duration = time.Since(r.Start).Seconds()
+
+	m.maint.mu.Lock()
+	m.maint.last = r
+	m.maint.mu.Unlock()
+
+	m.logger.Infow("database maintenance",
+		"downsampled", r.Downsampled,
+		"deleted", r.Deleted,
+		"size before", r.SizeBefore,
+		"size after", r.SizeAfter,
+		"err", err,
+	)
+
+	return r, err
+}
+
+func (m *Master) maintain(ctx context.Context, r *MaintenanceReport) (err error) {
+	now := time.Now()
+
+	if r.SizeBefore, err = m.DbSize(); err != nil {
+		return
+	}
+
+	// the projects with a retention of their own are left to maintainProjects
+	if m.retention.Raw > 0 {
+		cutoff := now.Add(-m.retention.Raw).UnixNano() / int64(time.Millisecond)
+		if r.Downsampled, err = m.downsample(ctx, cutoff, m.retention.interval(),
+			entApp.Not(entApp.HasProjectWith(entProject.RawRetentionGT(0)))); err != nil {
+			return
+		}
+	}
+
+	if m.retention.Rollup > 0 {
+		cutoff := now.Add(-m.retention.Rollup).UnixNano() / int64(time.Millisecond)
+		if r.Deleted, err = m.deleteBefore(ctx, cutoff,
+			entApp.Not(entApp.HasProjectWith(entProject.RollupRetentionGT(0)))); err != nil {
+			return
+		}
+	}
+
+	if err = m.maintainProjects(ctx, now, r); err != nil {
+		return
+	}
+
+	// the vacuum locks or rewrites the tables, only run it for a reason
+	if r.Downsampled+r.Deleted > 0 {
+		if err = m.vacuum(ctx); err != nil {
+			return fmt.Errorf("vacuum: %v", err)
+		}
+		r.Vacuumed = true
+	}
+
+	r.SizeAfter, err = m.DbSize()
+	return
+}
+
+// maintainProjects applies the retention of the projects that replace the
+// retention of the server
+func (m *Master) maintainProjects(ctx context.Context, now time.Time, r *MaintenanceReport) error {
+	ps, err := m.db.Project.
+		Query().
+		Where(entProject.Or(entProject.RawRetentionGT(0), entProject.RollupRetentionGT(0))).
+		All(ctx)
+	if err != nil {
+		return err
+	}
+
+	for _, p := range ps {
+		inProject := entApp.HasProjectWith(entProject.ID(p.ID))
+		if p.RawRetention > 0 {
+			cutoff := now.Add(-time.Duration(p.RawRetention)*time.Second).UnixNano() / int64(time.Millisecond)
+			n, err := m.downsample(ctx, cutoff, m.retention.interval(), inProject)
+			r.Downsampled += n
+			if err != nil {
+				return err
+			}
+		}
+		if p.RollupRetention > 0 {
+			cutoff := now.Add(-time.Duration(p.RollupRetention)*time.Second).UnixNano() / int64(time.Millisecond)
+			n, err := m.deleteBefore(ctx, cutoff, inProject)
+			r.Deleted += n
+			if err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+// runMaintenance runs the maintenance every period, the errors are logged
+// and kept in the report
+func (m *Master) runMaintenance(every time.Duration) {
+	t := time.NewTicker(every)
+	defer t.Stop()
+
+	for range t.C {
+		_, _ = m.Maintain(context.Background())
+	}
+}
+
+// downsample keeps the last point of each executor in every interval for
+// the points older than the cutoff (ms). The counters and the histograms are
+// cumulative, so the last point of an interval carries it whole; a kept
+// gauge gets the mean of its interval. Only the applications of the
+// predicates are downsampled. A metric remembers the time it is downsampled
+// until, so that a later run only reads the newer points. It returns the
+// number of points deleted.
+func (m *Master) downsample(ctx context.Context, cutoff int64, interval time.Duration,
+	apps ...predicate.Application,
+) (int, error) {
+	// only whole intervals, so that a later run never merges an interval
+	// again
+	step := interval.Milliseconds()
+	cutoff = cutoff / step * step
+
+	apps = append(apps, entApp.StatusIn(string(jobFinished), string(jobCancel), string(jobError)))
+	ms, err := m.db.Metric.
+		Query().
+		Where(
+			entMetric.HasGraphWith(entGraph.HasGroupWith(entGroup.HasApplicationWith(apps...))),
+			entMetric.Or(entMetric.DownsampledUntilIsNil(), entMetric.DownsampledUntilLT(cutoff)),
+		).
+		All(ctx)
+	if err != nil {
+		return 0, err
+	}
+
+	deleted := 0
+	for _, em := range ms {
+		n, err := m.downsampleMetric(ctx, em, cutoff, step)
+		deleted += n
+		if err != nil {
+			return deleted, err
+		}
+		if err = em.Update().SetDownsampledUntil(cutoff).Exec(ctx); err != nil {
+			return deleted, err
+		}
+	}
+
+	return deleted, nil
+}
+
+// bucket identifies the points of an executor in an interval
+type bucket struct {
+	wID  string
+	slot int64
+}
+
+func (m *Master) downsampleMetric(ctx context.Context, em *ent.Metric, cutoff, step int64) (int, error) {
+	switch metrics.MetricType(em.Type) {
+	case metrics.Counter:
+		cs, err := em.QueryCounters().
+			WithExecutor().
+			Where(entCounter.TimeGTE(em.DownsampledUntil), entCounter.TimeLT(cutoff)).
+			Order(ent.Asc(entCounter.FieldTime)).
+			All(ctx)
+		if err != nil {
+			return 0, err
+		}
+		last := map[bucket]int{}
+		for _, c := range cs {
+			last[bucket{ExecutorEID(c.Edges.Executor), c.Time / step}] = c.ID
+		}
+		ids := []int{}
+		for _, c := range cs {
+			if last[bucket{ExecutorEID(c.Edges.Executor), c.Time / step}] != c.ID {
+				ids = append(ids, c.ID)
+			}
+		}
+		return len(ids), m.deleteIDs(ctx, ids, func(ids []int) (int, error) {
+			return m.db.Counter.Delete().Where(entCounter.IDIn(ids...)).Exec(ctx)
+		})
+	case metrics.Histogram:
+		hs, err := em.QueryHistograms().
+			WithExecutor().
+			Where(entHistogram.TimeGTE(em.DownsampledUntil), entHistogram.TimeLT(cutoff)).
+			Order(ent.Asc(entHistogram.FieldTime)).
+			All(ctx)
+		if err != nil {
+			return 0, err
+		}
+		last := map[bucket]int{}
+		for _, h := range hs {
+			last[bucket{ExecutorEID(h.Edges.Executor), h.Time / step}] = h.ID
+		}
+		ids := []int{}
+		for _, h := range hs {
+			if last[bucket{ExecutorEID(h.Edges.Executor), h.Time / step}] != h.ID {
+				ids = append(ids, h.ID)
+			}
+		}
+		return len(ids), m.deleteIDs(ctx, ids, func(ids []int) (int, error) {
+			return m.db.Histogram.Delete().Where(entHistogram.IDIn(ids...)).Exec(ctx)
+		})
+	case metrics.Gauge:
+		gs, err := em.QueryGauges().
+			WithExecutor().
+			Where(entGauge.TimeGTE(em.DownsampledUntil), entGauge.TimeLT(cutoff)).
+			Order(ent.Asc(entGauge.FieldTime)).
+			All(ctx)
+		if err != nil {
+			return 0, err
+		}
+		last := map[bucket]*ent.Gauge{}
+		sum := map[bucket]int64{}
+		count := map[bucket]int64{}
+		for _, g := range gs {
+			b := bucket{ExecutorEID(g.Edges.Executor), g.Time / step}
+			last[b] = g
+			sum[b] += g.Value
+			count[b]++
+		}
+		ids := []int{}
+		for _, g := range gs {
+			if last[bucket{ExecutorEID(g.Edges.Executor), g.Time / step}].ID != g.ID {
+				ids = append(ids, g.ID)
+			}
+		}
+		for b, g := range last {
+			if count[b] == 1 {
+				continue
+			}
+			if _, err = g.Update().SetValue(sum[b] / count[b]).Save(ctx); err != nil {
+				return 0, err
+			}
+		}
+		return len(ids), m.deleteIDs(ctx, ids, func(ids []int) (int, error) {
+			return m.db.Gauge.Delete().Where(entGauge.IDIn(ids...)).Exec(ctx)
+		})
+	}
+
+	return 0, nil
+}
+
+// deleteIDs deletes the points by chunks
+func (m *Master) deleteIDs(ctx context.Context, ids []int, del func([]int) (int, error)) error {
+	for i := 0; i < len(ids); i += deleteChunk {
+		j := i + deleteChunk
+		if j > len(ids) {
+			j = len(ids)
+		}
+		if _, err := del(ids[i:j]); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// deleteBefore deletes the points older than the cutoff (ms) of the
+// applications of the predicates
+func (m *Master) deleteBefore(ctx context.Context, cutoff int64, apps ...predicate.Application) (int, error) {
+	inApps := entMetric.HasGraphWith(entGraph.HasGroupWith(entGroup.HasApplicationWith(apps...)))
+	c, err := m.db.Counter.Delete().
+		Where(entCounter.TimeLT(cutoff), entCounter.HasMetricWith(inApps)).
+		Exec(ctx)
+	if err != nil {
+		return 0, err
+	}
+	h, err := m.db.Histogram.Delete().
+		Where(entHistogram.TimeLT(cutoff), entHistogram.HasMetricWith(inApps)).
+		Exec(ctx)
+	if err != nil {
+		return c, err
+	}
+	g, err := m.db.Gauge.Delete().
+		Where(entGauge.TimeLT(cutoff), entGauge.HasMetricWith(inApps)).
+		Exec(ctx)
+	if err != nil {
+		return c + h, err
+	}
+	return c + h + g, nil
+}
+
+// AppDbStats is the storage of an application
+type AppDbStats struct {
+	ID         int    `json:"id"`
+	Name       string `json:"name"`
+	Status     string `json:"status"`
+	Counters   int    `json:"counters"`
+	Histograms int    `json:"histograms"`
+	Gauges     int    `json:"gauges"`
+	Points     int    `json:"points"`
+	Size       int64  `json:"size"` // bytes, estimated from the share of points
+}
+
+// DbStats is the storage of the master database
+type DbStats struct {
+	Size            int64              `json:"size"` // bytes
+	Points          int                `json:"points"`
+	Retention       Retention          `json:"retention"`
+	Applications    []*AppDbStats      `json:"applications"`
+	LastMaintenance *MaintenanceReport `json:"lastMaintenance"`
+}
+
+// pointsQuery counts the points of a point table by application
+func pointsQuery(driver, table, metricColumn string) (string, []interface{}) {
+	b := sql.Dialect(driver)
+	points := b.Table(table).As("p")
+	ms := b.Table(entMetric.Table).As("m")
+	graphs := b.Table(entGraph.Table).As("gr")
+	groups := b.Table(entGroup.Table).As("g")
+
+	return b.Select(groups.C(entGroup.ApplicationColumn), sql.Count("*")).
+		From(points).
+		Join(ms).On(points.C(metricColumn), ms.C(entMetric.FieldID)).
+		Join(graphs).On(ms.C(entMetric.GraphColumn), graphs.C(entGraph.FieldID)).
+		Join(groups).On(graphs.C(entGraph.GroupColumn), groups.C(entGroup.FieldID)).
+		Where(sql.NotNull(groups.C(entGroup.ApplicationColumn))).
+		GroupBy(groups.C(entGroup.ApplicationColumn)).
+		Query()
+}
+
+// countPoints returns the number of points of a point table by application
+func (m *Master) countPoints(ctx context.Context, table, metricColumn string) (map[int]int, error) {
+	query, args := pointsQuery(m.dbDriver, table, metricColumn)
+	rows, err := m.dbDrv.DB().QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	counts := map[int]int{}
+	for rows.Next() {
+		var appID, count int
+		if err = rows.Scan(&appID, &count); err != nil {
+			return nil, err
+		}
+		counts[appID] = count
+	}
+	return counts, rows.Err()
+}
+
+// DbStats returns the size of the database with the number of points and
+// the estimated size of every application
+func (m *Master) DbStats(ctx context.Context) (*DbStats, error) {
+	size, err := m.DbSize()
+	if err != nil {
+		return nil, err
+	}
+	counters, err := m.countPoints(ctx, entCounter.Table, entCounter.MetricColumn)
+	if err != nil {
+		return nil, err
+	}
+	histograms, err := m.countPoints(ctx, entHistogram.Table, entHistogram.MetricColumn)
+	if err != nil {
+		return nil, err
+	}
+	gauges, err := m.countPoints(ctx, entGauge.Table, entGauge.MetricColumn)
+	if err != nil {
+		return nil, err
+	}
+
+	apps, err := m.db.Application.
+		Query().
+		Order(ent.Asc(entApp.FieldID)).
+		All(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	s := &DbStats{
+		Size:            size,
+		Retention:       m.retention,
+		Applications:    make([]*AppDbStats, 0, len(apps)),
+		LastMaintenance: m.LastMaintenance(),
+	}
+	for _, app := range apps {
+		as := &AppDbStats{
+			ID:         app.ID,
+			Name:       app.Name,
+			Status:     app.Status,
+			Counters:   counters[app.ID],
+			Histograms: histograms[app.ID],
+			Gauges:     gauges[app.ID],
+		}
+		as.Points = as.Counters + as.Histograms + as.Gauges
+		s.Points += as.Points
+		s.Applications = append(s.Applications, as)
+	}
+	if s.Points > 0 {
+		for _, as := range s.Applications {
+			as.Size = size * int64(as.Points) / int64(s.Points)
+		}
+	}
+
+	return s, nil
+}
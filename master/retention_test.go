@@ -0,0 +1,160 @@
+package master
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/gobench-io/gobench/ent"
+	"github.com/gobench-io/gobench/logger"
+	"github.com/stretchr/testify/assert"
+
+	entCounter "github.com/gobench-io/gobench/ent/counter"
+	entGauge "github.com/gobench-io/gobench/ent/gauge"
+	entMetric "github.com/gobench-io/gobench/ent/metric"
+)
+
+// seedRetentionMaster opens a master on its own database, the maintenance
+// touches every application
+func seedRetentionMaster(t *testing.T, r Retention) *Master {
+	m, err := NewMaster(&Options{
+		HomeDir:   t.TempDir(),
+		Program:   "gobench",
+		Retention: r,
+	}, logger.NewNopLogger())
+	assert.Nil(t, err)
+	m.isScheduled = false
+	assert.Nil(t, m.OpenDb())
+	t.Cleanup(func() { m.db.Close() })
+	return m
+}
+
+func TestMaintain(t *testing.T) {
+	ctx := context.Background()
+	m := seedRetentionMaster(t, Retention{Raw: time.Hour, Interval: time.Minute})
+
+	// 12 points every 10 seconds from a minute boundary, in 3 minutes
+	t0 := int64(1000 * 60000)
+	finished := m.seedApplication(ctx, t)
+	cID, _, gID := m.seedMetrics(ctx, t, finished, "e1", t0, 12)
+	_, err := finished.Update().SetStatus(string(jobFinished)).Save(ctx)
+	assert.Nil(t, err)
+
+	running := m.seedApplication(ctx, t)
+	m.seedMetrics(ctx, t, running, "e1", t0, 12)
+	_, err = running.Update().SetStatus(string(jobRunning)).Save(ctx)
+	assert.Nil(t, err)
+
+	assert.Nil(t, m.LastMaintenance())
+
+	r, err := m.Maintain(ctx)
+	assert.Nil(t, err)
+	assert.Equal(t, 3*9, r.Downsampled)
+	assert.Equal(t, 0, r.Deleted)
+	assert.True(t, r.Vacuumed)
+	assert.True(t, r.SizeAfter > 0)
+	assert.Equal(t, r, m.LastMaintenance())
+
+	t.Run("counters keep the last point of every interval", func(t *testing.T) {
+		cs, err := m.db.Counter.Query().
+			Where(entCounter.HasMetricWith(entMetric.ID(int(cID)))).
+			Order(ent.Asc(entCounter.FieldTime)).
+			All(ctx)
+		assert.Nil(t, err)
+		counts := []int64{}
+		for _, c := range cs {
+			counts = append(counts, c.Count)
+		}
+		assert.Equal(t, []int64{500, 1100, 1200}, counts)
+	})
+
+	t.Run("gauges keep the mean of every interval", func(t *testing.T) {
+		gs, err := m.db.Gauge.Query().
+			Where(entGauge.HasMetricWith(entMetric.ID(int(gID)))).
+			Order(ent.Asc(entGauge.FieldTime)).
+			All(ctx)
+		assert.Nil(t, err)
+		values := []int64{}
+		for _, g := range gs {
+			values = append(values, g.Value)
+		}
+		assert.Equal(t, []int64{3, 8, 12}, values)
+	})
+
+	t.Run("db stats", func(t *testing.T) {
+		s, err := m.DbStats(ctx)
+		assert.Nil(t, err)
+		assert.Equal(t, 3*3+3*12, s.Points)
+		assert.Len(t, s.Applications, 2)
+		assert.Equal(t, finished.ID, s.Applications[0].ID)
+		assert.Equal(t, 3, s.Applications[0].Counters)
+		assert.Equal(t, 9, s.Applications[0].Points)
+		assert.Equal(t, 36, s.Applications[1].Points)
+		assert.Equal(t, s.Size*9/45, s.Applications[0].Size)
+		assert.Equal(t, r, s.LastMaintenance)
+	})
+
+	t.Run("the downsampled points are not read again", func(t *testing.T) {
+		em, err := m.db.Metric.Get(ctx, int(cID))
+		assert.Nil(t, err)
+		assert.True(t, em.DownsampledUntil > t0)
+		assert.Equal(t, int64(0), em.DownsampledUntil%60000)
+
+		// a late point of a downsampled interval is left alone
+		e, err := m.db.Counter.Query().
+			Where(entCounter.HasMetricWith(entMetric.ID(int(cID)))).
+			QueryExecutor().
+			Only(ctx)
+		assert.Nil(t, err)
+		c, err := m.db.Counter.Create().
+			SetMetricID(int(cID)).
+			SetExecutor(e).
+			SetTime(t0 + 20000).
+			SetCount(200).
+			Save(ctx)
+		assert.Nil(t, err)
+
+		r, err := m.Maintain(ctx)
+		assert.Nil(t, err)
+		assert.Equal(t, 0, r.Downsampled)
+		assert.Nil(t, m.db.Counter.DeleteOne(c).Exec(ctx))
+	})
+
+	t.Run("downsampling twice is a no-op", func(t *testing.T) {
+		r, err := m.Maintain(ctx)
+		assert.Nil(t, err)
+		assert.Equal(t, 0, r.Downsampled)
+		assert.False(t, r.Vacuumed)
+	})
+
+	t.Run("rollup retention", func(t *testing.T) {
+		m.retention.Rollup = 2 * time.Hour
+		r, err := m.Maintain(ctx)
+		assert.Nil(t, err)
+		assert.Equal(t, 3*3+3*12, r.Deleted)
+
+		s, err := m.DbStats(ctx)
+		assert.Nil(t, err)
+		assert.Equal(t, 0, s.Points)
+	})
+}
+
+func TestMaintainKeepForever(t *testing.T) {
+	ctx := context.Background()
+	m := seedRetentionMaster(t, Retention{})
+
+	app := m.seedApplication(ctx, t)
+	m.seedMetrics(ctx, t, app, "e1", 1000, 12)
+	_, err := app.Update().SetStatus(string(jobFinished)).Save(ctx)
+	assert.Nil(t, err)
+
+	r, err := m.Maintain(ctx)
+	assert.Nil(t, err)
+	assert.Equal(t, 0, r.Downsampled)
+	assert.Equal(t, 0, r.Deleted)
+	assert.False(t, r.Vacuumed)
+
+	s, err := m.DbStats(ctx)
+	assert.Nil(t, err)
+	assert.Equal(t, 36, s.Points)
+}
@@ -0,0 +1,187 @@
+package master
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"path/filepath"
+	"sync/atomic"
+	"time"
+
+	"github.com/gobench-io/gobench/ent"
+	"github.com/gobench-io/gobench/executor/metrics"
+	"github.com/gobench-io/gobench/pb"
+	"github.com/gobench-io/gobench/sink"
+
+	entMetric "github.com/gobench-io/gobench/ent/metric"
+)
+
+// sinkQueueSize is the number of points a sink can lag behind before the
+// next points are dropped
+const sinkQueueSize = 4096
+
+// sinkFlushTimeout bounds the write of the queued points when the sinks are
+// closed
+const sinkFlushTimeout = 10 * time.Second
+
+// addSinkDropped increases the number of metric points dropped by the sinks
+func (m *Master) addSinkDropped(n uint64) {
+	atomic.AddUint64(&m.sinkDropped, n)
+}
+
+// SinkDropped returns the number of metric points the sinks dropped since the
+// master started
+func (m *Master) SinkDropped() uint64 {
+	return atomic.LoadUint64(&m.sinkDropped)
+}
+
+// metricMeta is the position of a metric in the group/graph hierarchy
+type metricMeta struct {
+	group string
+	graph string
+	unit  string
+	title string
+	typ   metrics.MetricType
+}
+
+// SinkDir returns the directory of the jsonl sink files, the sink paths are
+// relative to it
+func (m *Master) SinkDir() string {
+	return filepath.Join(m.homeDir, "sinks")
+}
+
+// openSinks creates the metric sinks configured for the job application, the
+// jsonl files in dir
+func (j *job) openSinks(dir string) (err error) {
+	j.metaMu.Lock()
+	j.metas = make(map[int64]*metricMeta)
+	j.metaMu.Unlock()
+
+	cs, err := sinkConfigs(j.app)
+	if err != nil {
+		return err
+	}
+	f, err := sink.NewFanout(cs, dir)
+	if err != nil {
+		return err
+	}
+
+	// the sinks are written in the background, never stalling the metric
+	// logging of the executor
+	for i, s := range f {
+		f[i] = sink.NewQueue(s, sinkQueueSize, sinkFlushTimeout, func(err error) {
+			j.logger.Errorw("failed write metric to sink", "err", err)
+		})
+	}
+	j.sinks = f
+
+	return nil
+}
+
+// sinkConfigs returns the sink configs of an application with their tokens
+func sinkConfigs(app *ent.Application) ([]sink.Config, error) {
+	cs := append([]sink.Config{}, app.Sinks...)
+	if app.SinkTokens == "" {
+		return cs, nil
+	}
+
+	var tokens []string
+	if err := json.Unmarshal([]byte(app.SinkTokens), &tokens); err != nil {
+		return nil, err
+	}
+	for i := range cs {
+		if i < len(tokens) {
+			cs[i].Token = tokens[i]
+		}
+	}
+	return cs, nil
+}
+
+// closeSinks flushes then closes the metric sinks of the job
+func (j *job) closeSinks() error {
+	if j.sinks == nil {
+		return nil
+	}
+	return j.sinks.Close()
+}
+
+// metricMeta returns the group/graph/title of a metric, the result is cached
+// since the executor keeps reporting the same metrics
+func (j *job) metricMeta(ctx context.Context, mID int64) (*metricMeta, error) {
+	j.metaMu.Lock()
+	defer j.metaMu.Unlock()
+
+	if mm, ok := j.metas[mID]; ok {
+		return mm, nil
+	}
+
+	em, err := j.app.QueryGroups().
+		QueryGraphs().
+		QueryMetrics().
+		Where(entMetric.ID(int(mID))).
+		WithGraph(func(q *ent.GraphQuery) {
+			q.WithGroup()
+		}).
+		Only(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	mm := &metricMeta{
+		title: em.Title,
+		typ:   metrics.MetricType(em.Type),
+	}
+	if g := em.Edges.Graph; g != nil {
+		mm.graph = g.Title
+		mm.unit = g.Unit
+		if gr := g.Edges.Group; gr != nil {
+			mm.group = gr.Name
+		}
+	}
+
+	if j.metas == nil {
+		j.metas = make(map[int64]*metricMeta)
+	}
+	j.metas[mID] = mm
+
+	return mm, nil
+}
+
+// toSinks queues a saved metric point to the sinks of the running job
+// failing sinks are logged but never fail the metric logging, the points of
+// a full queue are dropped and counted
+func (m *Master) toSinks(ctx context.Context, base *pb.BasedReqMetric, fill func(*sink.Point)) {
+	j := m.job
+	if j == nil || len(j.sinks) == 0 {
+		return
+	}
+
+	mm, err := j.metricMeta(ctx, base.MID)
+	if err != nil {
+		j.logger.Errorw("failed get metric for sinks", "metric id", base.MID, "err", err)
+		return
+	}
+
+	p := &sink.Point{
+		AppID:   j.app.ID,
+		AppName: j.app.Name,
+		EID:     base.EID,
+		Group:   mm.group,
+		Graph:   mm.graph,
+		Title:   mm.title,
+		Unit:    mm.unit,
+		Type:    mm.typ,
+		Time:    base.Time,
+	}
+	fill(p)
+
+	for _, s := range j.sinks {
+		if err := s.Write(ctx, p); err != nil {
+			if errors.Is(err, sink.ErrQueueFull) {
+				m.addSinkDropped(1)
+				continue
+			}
+			j.logger.Errorw("failed write metric to sinks", "metric id", base.MID, "err", err)
+		}
+	}
+}
@@ -0,0 +1,79 @@
+package master
+
+import (
+	"context"
+	"encoding/json"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/gobench-io/gobench/logger"
+	"github.com/gobench-io/gobench/sink"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestMetricToSinks(t *testing.T) {
+	ctx := context.Background()
+	m := seedMaster(t)
+
+	dir, err := ioutil.TempDir("", "sink-*")
+	assert.Nil(t, err)
+	defer os.RemoveAll(dir)
+	m.homeDir = dir
+	path := filepath.Join(m.SinkDir(), "points.jsonl")
+
+	app, err := m.NewApplication(ctx, "sinks", "scenario", "", "",
+		WithSinks([]sink.Config{{Type: sink.JSONL, Path: "points.jsonl"}}))
+	assert.Nil(t, err)
+	assert.Len(t, app.Sinks, 1)
+
+	m.job = &job{app: app, logger: logger.NewNopLogger()}
+	assert.Nil(t, m.job.openSinks(m.SinkDir()))
+
+	m.seedMetrics(ctx, t, app, "e1", 2000, 1)
+	assert.Nil(t, m.job.closeSinks())
+
+	b, err := ioutil.ReadFile(path)
+	assert.Nil(t, err)
+	lines := strings.Split(strings.TrimSpace(string(b)), "\n")
+	assert.Len(t, lines, 3)
+
+	var p sink.Point
+	assert.Nil(t, json.Unmarshal([]byte(lines[0]), &p))
+	assert.Equal(t, app.ID, p.AppID)
+	assert.Equal(t, "sinks", p.AppName)
+	assert.Equal(t, "e1", p.EID)
+	assert.Equal(t, "HTTP", p.Group)
+	assert.Equal(t, "Response", p.Graph)
+	assert.Equal(t, "home.http_ok", p.Title)
+	assert.EqualValues(t, 100, p.Count)
+	assert.EqualValues(t, 12000, p.Time)
+}
+
+func TestSinkTokens(t *testing.T) {
+	ctx := context.Background()
+	m := seedMaster(t)
+
+	app, err := m.NewApplication(ctx, "sinks", "scenario", "", "",
+		WithSinks([]sink.Config{
+			{Type: sink.StatsD, Addr: "localhost:8125"},
+			{Type: sink.InfluxDB, URL: "http://localhost:8086/write", Token: "s3cret"},
+		}))
+	assert.Nil(t, err)
+
+	app, err = m.db.Application.Get(ctx, app.ID)
+	assert.Nil(t, err)
+	assert.Equal(t, "", app.Sinks[1].Token)
+
+	// the token is out of the rendered application
+	b, err := json.Marshal(app)
+	assert.Nil(t, err)
+	assert.NotContains(t, string(b), "s3cret")
+
+	cs, err := sinkConfigs(app)
+	assert.Nil(t, err)
+	assert.Equal(t, "", cs[0].Token)
+	assert.Equal(t, "s3cret", cs[1].Token)
+}
@@ -0,0 +1,219 @@
+package master
+
+import (
+	"context"
+	"math"
+	"strings"
+
+	"github.com/gobench-io/gobench/ent"
+	"github.com/gobench-io/gobench/executor/metrics"
+
+	entCounter "github.com/gobench-io/gobench/ent/counter"
+	entGauge "github.com/gobench-io/gobench/ent/gauge"
+	entHistogram "github.com/gobench-io/gobench/ent/histogram"
+)
+
+// reportInterval is how often an executor reports its metrics, in ms
+const reportInterval = 10 * 1000
+
+// MetricStats summarizes the points of a metric over a run.
+// Counters and histograms are cumulative per executor, so the totals come
+// from the last point of every executor.
+type MetricStats struct {
+	Group string `json:"group"`
+	Graph string `json:"graph"`
+	Unit  string `json:"unit"`
+	Title string `json:"title"`
+	Type  string `json:"type"`
+
+	Duration float64 `json:"duration"` // seconds from the start of the run to the last point
+	Count    int64   `json:"count"`    // counter total or histogram samples
+	Rate     float64 `json:"rate"`     // count per second
+
+	// histograms: count weighted over the executors
+	// gauges: over all points
+	Mean   float64 `json:"mean"`
+	Median float64 `json:"median"`
+	P95    float64 `json:"p95"`
+	P99    float64 `json:"p99"`
+	Min    float64 `json:"min"`
+	Max    float64 `json:"max"`
+
+	// Last is the sum of the last gauge of every executor
+	Last float64 `json:"last"`
+
+	metric *ent.Metric
+}
+
+// IsErrorMetric reports whether a counter counts failures, as the clients
+// name them: "*_fail", "*.error" or "*.errors"
+func IsErrorMetric(title string) bool {
+	t := strings.ToLower(title)
+	return strings.HasSuffix(t, "fail") ||
+		strings.HasSuffix(t, "error") ||
+		strings.HasSuffix(t, "errors")
+}
+
+// runStart returns the start of the run in ms. Applications without a start
+// time begin one report interval before their first point.
+func runStart(app *ent.Application, first int64) int64 {
+	if app.StartedAt.IsZero() {
+		return first - reportInterval
+	}
+	return app.StartedAt.UnixNano() / 1e6
+}
+
+// runWindow returns the running window of an application in ms, from the
+// start to the finish of the run. A zero bound is open. The applications
+// finished before finished_at existed end at their last update.
+func runWindow(app *ent.Application) (from, to int64) {
+	if !app.StartedAt.IsZero() {
+		from = app.StartedAt.UnixNano() / 1e6
+	}
+	if !IsTerminal(app.Status) {
+		return
+	}
+	end := app.FinishedAt
+	if end.IsZero() {
+		end = app.UpdatedAt
+	}
+	if !end.Before(app.StartedAt) {
+		to = end.UnixNano() / 1e6
+	}
+	return
+}
+
+func runDuration(app *ent.Application, first, last int64) float64 {
+	d := float64(last-runStart(app, first)) / 1000
+	if d <= 0 {
+		return 0
+	}
+	return d
+}
+
+// ApplicationStats returns the stats of every metric of an application over
+// its running window
+func (m *Master) ApplicationStats(ctx context.Context, app *ent.Application) ([]*MetricStats, error) {
+	gs, err := app.QueryGroups().
+		WithGraphs(func(q *ent.GraphQuery) {
+			q.WithMetrics()
+		}).
+		All(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	res := []*MetricStats{}
+	for _, g := range gs {
+		for _, gr := range g.Edges.Graphs {
+			for _, em := range gr.Edges.Metrics {
+				s := &MetricStats{
+					Group: g.Name,
+					Graph: gr.Title,
+					Unit:  gr.Unit,
+					Title: em.Title,
+					Type:  em.Type,
+
+					metric: em,
+				}
+				if err := metricStats(ctx, app, em, s); err != nil {
+					return nil, err
+				}
+				res = append(res, s)
+			}
+		}
+	}
+
+	return res, nil
+}
+
+func metricStats(ctx context.Context, app *ent.Application, em *ent.Metric, s *MetricStats) error {
+	from, to := runWindow(app)
+	if to == 0 {
+		to = math.MaxInt64
+	}
+
+	switch metrics.MetricType(em.Type) {
+	case metrics.Counter:
+		cs, err := em.QueryCounters().
+			WithExecutor().
+			Where(entCounter.TimeGTE(from), entCounter.TimeLTE(to)).
+			Order(ent.Asc(entCounter.FieldTime)).
+			All(ctx)
+		if err != nil || len(cs) == 0 {
+			return err
+		}
+		last := map[string]int64{}
+		for _, c := range cs {
+			last[ExecutorEID(c.Edges.Executor)] = c.Count
+		}
+		for _, c := range last {
+			s.Count += c
+		}
+		s.Duration = runDuration(app, cs[0].Time, cs[len(cs)-1].Time)
+	case metrics.Histogram:
+		hs, err := em.QueryHistograms().
+			WithExecutor().
+			Where(entHistogram.TimeGTE(from), entHistogram.TimeLTE(to)).
+			Order(ent.Asc(entHistogram.FieldTime)).
+			All(ctx)
+		if err != nil || len(hs) == 0 {
+			return err
+		}
+		last := map[string]*ent.Histogram{}
+		for _, h := range hs {
+			last[ExecutorEID(h.Edges.Executor)] = h
+		}
+		s.Min = math.Inf(1)
+		for _, h := range last {
+			s.Count += h.Count
+			w := float64(h.Count)
+			s.Mean += w * h.Mean
+			s.Median += w * h.Median
+			s.P95 += w * h.P95
+			s.P99 += w * h.P99
+			s.Min = math.Min(s.Min, float64(h.Min))
+			s.Max = math.Max(s.Max, float64(h.Max))
+		}
+		if s.Count > 0 {
+			w := float64(s.Count)
+			s.Mean /= w
+			s.Median /= w
+			s.P95 /= w
+			s.P99 /= w
+		}
+		if math.IsInf(s.Min, 1) {
+			s.Min = 0
+		}
+		s.Duration = runDuration(app, hs[0].Time, hs[len(hs)-1].Time)
+	case metrics.Gauge:
+		gs, err := em.QueryGauges().
+			WithExecutor().
+			Where(entGauge.TimeGTE(from), entGauge.TimeLTE(to)).
+			Order(ent.Asc(entGauge.FieldTime)).
+			All(ctx)
+		if err != nil || len(gs) == 0 {
+			return err
+		}
+		last := map[string]int64{}
+		s.Min, s.Max = math.Inf(1), math.Inf(-1)
+		for _, g := range gs {
+			v := float64(g.Value)
+			s.Mean += v
+			s.Min = math.Min(s.Min, v)
+			s.Max = math.Max(s.Max, v)
+			last[ExecutorEID(g.Edges.Executor)] = g.Value
+		}
+		s.Mean /= float64(len(gs))
+		for _, v := range last {
+			s.Last += float64(v)
+		}
+		s.Duration = runDuration(app, gs[0].Time, gs[len(gs)-1].Time)
+	}
+
+	if s.Duration > 0 {
+		s.Rate = float64(s.Count) / s.Duration
+	}
+
+	return nil
+}
@@ -0,0 +1,135 @@
+package master
+
+import (
+	"sync"
+
+	"github.com/gobench-io/gobench/ent"
+)
+
+// event types of the live stream of an application
+const (
+	EventStatus    = "status"
+	EventCounter   = "counter"
+	EventHistogram = "histogram"
+	EventGauge     = "gauge"
+	EventTimeline  = "event"
+)
+
+// eventBuffer is the number of events a subscriber can lag behind before
+// it is closed
+const eventBuffer = 256
+
+// Event is a message of the live stream of an application. Data is a
+// StatusEvent for the status events, a PointEvent for the metric events and
+// the saved *ent.Event for the timeline events.
+type Event struct {
+	Type  string      `json:"type"`
+	AppID int         `json:"appId"`
+	Data  interface{} `json:"data"`
+}
+
+// StatusEvent is a job status transition
+type StatusEvent struct {
+	Status string `json:"status"`
+}
+
+// PointEvent is a metric point just persisted. Point is the saved counter,
+// histogram or gauge, WID the executor that reported it.
+type PointEvent struct {
+	MetricID int64       `json:"metricId"`
+	WID      string      `json:"wId"`
+	Point    interface{} `json:"point"`
+}
+
+// hub fans the events out to the subscribers of an application
+type hub struct {
+	mu   sync.Mutex
+	subs map[int]map[chan *Event]struct{} // app ID - subscribers
+}
+
+func newHub() *hub {
+	return &hub{
+		subs: make(map[int]map[chan *Event]struct{}),
+	}
+}
+
+func (h *hub) subscribe(appID int) chan *Event {
+	ch := make(chan *Event, eventBuffer)
+
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	if h.subs[appID] == nil {
+		h.subs[appID] = make(map[chan *Event]struct{})
+	}
+	h.subs[appID][ch] = struct{}{}
+
+	return ch
+}
+
+func (h *hub) unsubscribe(appID int, ch chan *Event) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	if _, ok := h.subs[appID][ch]; !ok {
+		return
+	}
+	delete(h.subs[appID], ch)
+	if len(h.subs[appID]) == 0 {
+		delete(h.subs, appID)
+	}
+	close(ch)
+}
+
+// publish never blocks. A subscriber whose buffer is full is closed rather
+// than missing an event, so that it resyncs from the current status.
+func (h *hub) publish(e *Event) {
+	if h == nil {
+		return
+	}
+
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	for ch := range h.subs[e.AppID] {
+		select {
+		case ch <- e:
+		default:
+			delete(h.subs[e.AppID], ch)
+			close(ch)
+		}
+	}
+	if len(h.subs[e.AppID]) == 0 {
+		delete(h.subs, e.AppID)
+	}
+}
+
+// Subscribe follows the status transitions and the new metric points of an
+// application. The returned function ends the subscription and closes the
+// channel. The channel is also closed when the subscriber lags behind.
+func (m *Master) Subscribe(appID int) (<-chan *Event, func()) {
+	ch := m.hub.subscribe(appID)
+	return ch, func() {
+		m.hub.unsubscribe(appID, ch)
+	}
+}
+
+func (h *hub) publishStatus(app *ent.Application) {
+	h.publish(&Event{
+		Type:  EventStatus,
+		AppID: app.ID,
+		Data:  &StatusEvent{Status: app.Status},
+	})
+}
+
+func (h *hub) publishPoint(typ string, appID, metricID int64, wID string, point interface{}) {
+	h.publish(&Event{
+		Type:  typ,
+		AppID: int(appID),
+		Data: &PointEvent{
+			MetricID: metricID,
+			WID:      wID,
+			Point:    point,
+		},
+	})
+}
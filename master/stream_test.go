@@ -0,0 +1,81 @@
+package master
+
+import (
+	"context"
+	"testing"
+
+	"github.com/gobench-io/gobench/ent"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestHub(t *testing.T) {
+	h := newHub()
+
+	ch1 := h.subscribe(1)
+	ch2 := h.subscribe(2)
+
+	h.publish(&Event{Type: EventStatus, AppID: 1})
+	assert.Len(t, ch1, 1)
+	assert.Len(t, ch2, 0)
+
+	t.Run("slow subscriber is closed", func(t *testing.T) {
+		for i := 0; i < eventBuffer+10; i++ {
+			h.publish(&Event{Type: EventStatus, AppID: 2})
+		}
+		assert.Len(t, ch2, eventBuffer)
+		assert.Len(t, h.subs, 1)
+
+		for i := 0; i < eventBuffer; i++ {
+			<-ch2
+		}
+		_, ok := <-ch2
+		assert.False(t, ok)
+
+		// unsubscribing a closed subscriber is a no-op
+		h.unsubscribe(2, ch2)
+	})
+
+	t.Run("unsubscribe", func(t *testing.T) {
+		h.unsubscribe(1, ch1)
+		h.unsubscribe(1, ch1)
+		assert.Len(t, h.subs, 0)
+
+		<-ch1
+		_, ok := <-ch1
+		assert.False(t, ok)
+	})
+
+	t.Run("nil hub", func(t *testing.T) {
+		var nh *hub
+		nh.publish(&Event{Type: EventStatus, AppID: 1})
+	})
+}
+
+func TestSubscribe(t *testing.T) {
+	ctx := context.Background()
+	m := seedMaster(t)
+	app := m.seedApplication(ctx, t)
+
+	events, unsubscribe := m.Subscribe(app.ID)
+	defer unsubscribe()
+
+	counterID, _, _ := m.seedMetrics(ctx, t, app, "e1", 1000, 1)
+
+	// a counter, a histogram and a gauge point
+	e := <-events
+	assert.Equal(t, EventCounter, e.Type)
+	assert.Equal(t, app.ID, e.AppID)
+	pe := e.Data.(*PointEvent)
+	assert.Equal(t, counterID, pe.MetricID)
+	assert.Equal(t, int64(100), pe.Point.(*ent.Counter).Count)
+	assert.Equal(t, EventHistogram, (<-events).Type)
+	assert.Equal(t, EventGauge, (<-events).Type)
+
+	// the application is not running
+	m.job = nil
+	_, err := m.CancelApplication(ctx, app.ID)
+	assert.Nil(t, err)
+	e = <-events
+	assert.Equal(t, EventStatus, e.Type)
+	assert.Equal(t, string(jobCancel), e.Data.(*StatusEvent).Status)
+}
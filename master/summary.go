@@ -0,0 +1,95 @@
+package master
+
+import (
+	"context"
+	"math"
+
+	"github.com/gobench-io/gobench/ent"
+	"github.com/gobench-io/gobench/executor/metrics"
+)
+
+// Summary is the whole run summary of an application.
+// Requests is the total of all counters, the error counters included, and
+// the latency percentiles are weighted by the samples of every histogram.
+type Summary struct {
+	ApplicationID int     `json:"applicationId"`
+	From          int64   `json:"from"`     // ms, start of the running window
+	To            int64   `json:"to"`       // ms, end of the running window
+	Duration      float64 `json:"duration"` // seconds
+
+	Requests  int64   `json:"requests"`
+	Errors    int64   `json:"errors"`
+	ErrorRate float64 `json:"errorRate"` // percent of the requests
+	RPS       float64 `json:"rps"`       // requests per second
+
+	P50      float64 `json:"p50"`
+	P95      float64 `json:"p95"`
+	P99      float64 `json:"p99"`
+	MaxGauge float64 `json:"maxGauge"`
+
+	Metrics []*MetricStats `json:"metrics"`
+}
+
+// ApplicationSummary aggregates every metric of an application over its
+// running window, from started_at to the finish of the run
+func (m *Master) ApplicationSummary(ctx context.Context, app *ent.Application) (*Summary, error) {
+	stats, err := m.ApplicationStats(ctx, app)
+	if err != nil {
+		return nil, err
+	}
+	return summarize(app, stats), nil
+}
+
+func summarize(app *ent.Application, stats []*MetricStats) *Summary {
+	s := &Summary{
+		ApplicationID: app.ID,
+		Metrics:       stats,
+	}
+	s.From, s.To = runWindow(app)
+	if s.From != 0 && s.To != 0 {
+		s.Duration = float64(s.To-s.From) / 1000
+	}
+
+	var samples int64
+	maxGauge := math.Inf(-1)
+
+	for _, ms := range stats {
+		// runs without a window last until their last point
+		if s.From == 0 || s.To == 0 {
+			s.Duration = math.Max(s.Duration, ms.Duration)
+		}
+
+		switch metrics.MetricType(ms.Type) {
+		case metrics.Counter:
+			s.Requests += ms.Count
+			if IsErrorMetric(ms.Title) {
+				s.Errors += ms.Count
+			}
+		case metrics.Histogram:
+			w := float64(ms.Count)
+			samples += ms.Count
+			s.P50 += w * ms.Median
+			s.P95 += w * ms.P95
+			s.P99 += w * ms.P99
+		case metrics.Gauge:
+			maxGauge = math.Max(maxGauge, ms.Max)
+		}
+	}
+
+	if samples > 0 {
+		s.P50 /= float64(samples)
+		s.P95 /= float64(samples)
+		s.P99 /= float64(samples)
+	}
+	if !math.IsInf(maxGauge, -1) {
+		s.MaxGauge = maxGauge
+	}
+	if s.Requests > 0 {
+		s.ErrorRate = float64(s.Errors) / float64(s.Requests) * 100
+	}
+	if s.Duration > 0 {
+		s.RPS = float64(s.Requests) / s.Duration
+	}
+
+	return s
+}
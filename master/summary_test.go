@@ -0,0 +1,65 @@
+package master
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/gobench-io/gobench/executor/metrics"
+	"github.com/gobench-io/gobench/pb"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestApplicationSummary(t *testing.T) {
+	ctx := context.Background()
+	m := seedMaster(t)
+
+	app := m.seedApplication(ctx, t)
+	m.seedMetrics(ctx, t, app, "e1", 1000, 2)
+	cID, _, _ := m.seedMetrics(ctx, t, app, "e2", 1000, 3)
+
+	// error counter in the same graph as home.http_ok
+	ok, err := m.db.Metric.Get(ctx, int(cID))
+	assert.Nil(t, err)
+	graph, err := ok.QueryGraph().Only(ctx)
+	assert.Nil(t, err)
+	fail, err := m.FindCreateMetric(ctx, &pb.FCMetricReq{
+		AppID: int64(app.ID), Title: "home.http_fail", Type: string(metrics.Counter),
+		GraphID: int64(graph.ID),
+	})
+	assert.Nil(t, err)
+	_, err = m.Counter(ctx, &pb.CounterReq{
+		Base:  &pb.BasedReqMetric{AppID: int64(app.ID), EID: "e1", MID: fail.Id, Time: 21000},
+		Count: 50,
+	})
+	assert.Nil(t, err)
+
+	// running window from 15s to 35s, the points at 11s are left out. A
+	// later update does not move the end of the run.
+	app, err = app.Update().
+		SetStatus(string(jobFinished)).
+		SetStartedAt(time.Unix(15, 0)).
+		SetFinishedAt(time.Unix(35, 0)).
+		SetUpdatedAt(time.Unix(90, 0)).
+		Save(ctx)
+	assert.Nil(t, err)
+
+	s, err := m.ApplicationSummary(ctx, app)
+	assert.Nil(t, err)
+	assert.Equal(t, app.ID, s.ApplicationID)
+	assert.EqualValues(t, 15000, s.From)
+	assert.EqualValues(t, 35000, s.To)
+	assert.InDelta(t, 20, s.Duration, 0.001)
+
+	// last count of e1 and e2 summed, with the errors
+	assert.EqualValues(t, 550, s.Requests)
+	assert.EqualValues(t, 50, s.Errors)
+	assert.InDelta(t, 50.0/550*100, s.ErrorRate, 0.001)
+	assert.InDelta(t, 27.5, s.RPS, 0.001)
+
+	assert.InDelta(t, 10, s.P50, 0.001)
+	assert.InDelta(t, 30, s.P95, 0.001)
+	assert.InDelta(t, 40, s.P99, 0.001)
+	assert.InDelta(t, 3, s.MaxGauge, 0.001)
+	assert.Len(t, s.Metrics, 4)
+}
@@ -0,0 +1,131 @@
+package master
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"strings"
+	"time"
+
+	"github.com/gobench-io/gobench/ent"
+
+	entToken "github.com/gobench-io/gobench/ent/apitoken"
+	entUser "github.com/gobench-io/gobench/ent/user"
+)
+
+// APITokenPrefix starts every API token, to tell them from the JWTs
+const APITokenPrefix = "gbt_"
+
+var (
+	// ErrInvalidToken is returned when an API token is unknown or expired
+	ErrInvalidToken = errors.New("invalid API token")
+	// ErrTokenScope is returned when the scope of a token exceeds the role
+	// of its user
+	ErrTokenScope = errors.New("the scope exceeds the role of the user")
+)
+
+// scopeRoles are the roles allowed by the scopes
+var scopeRoles = map[entToken.Scope]entUser.Role{
+	entToken.ScopeRead:  entUser.RoleViewer,
+	entToken.ScopeWrite: entUser.RoleRunner,
+	entToken.ScopeAdmin: entUser.RoleAdmin,
+}
+
+// TokenRole returns the role of a user with a token of a scope, the lower of
+// the role of the user and the role of the scope
+func TokenRole(role entUser.Role, scope entToken.Scope) entUser.Role {
+	if RoleAllows(role, scopeRoles[scope]) {
+		return scopeRoles[scope]
+	}
+	return role
+}
+
+func hashToken(token string) string {
+	sum := sha256.Sum256([]byte(token))
+	return hex.EncodeToString(sum[:])
+}
+
+// CreateAPIToken creates an API token of a user. The token is returned once,
+// only its hash is saved. A nil expiresAt never expires.
+func (m *Master) CreateAPIToken(ctx context.Context, userID int, name string,
+	scope entToken.Scope, expiresAt *time.Time,
+) (*ent.APIToken, string, error) {
+	u, err := m.GetUser(ctx, userID)
+	if err != nil {
+		return nil, "", err
+	}
+	if !RoleAllows(u.Role, scopeRoles[scope]) {
+		return nil, "", ErrTokenScope
+	}
+
+	b := make([]byte, 24)
+	if _, err := rand.Read(b); err != nil {
+		return nil, "", err
+	}
+	token := APITokenPrefix + hex.EncodeToString(b)
+
+	t, err := m.db.APIToken.
+		Create().
+		SetName(name).
+		SetTokenHash(hashToken(token)).
+		SetPrefix(token[:len(APITokenPrefix)+6]).
+		SetScope(scope).
+		SetNillableExpiresAt(expiresAt).
+		SetUserID(userID).
+		Save(ctx)
+	if err != nil {
+		return nil, "", err
+	}
+	return t, token, nil
+}
+
+// ListAPITokens returns the API tokens of a user, the latest first
+func (m *Master) ListAPITokens(ctx context.Context, userID int) ([]*ent.APIToken, error) {
+	return m.db.APIToken.
+		Query().
+		Where(entToken.HasUserWith(entUser.ID(userID))).
+		Order(ent.Desc(entToken.FieldCreatedAt), ent.Desc(entToken.FieldID)).
+		All(ctx)
+}
+
+// DeleteAPIToken revokes an API token of a user
+func (m *Master) DeleteAPIToken(ctx context.Context, userID, id int) error {
+	t, err := m.db.APIToken.
+		Query().
+		Where(entToken.ID(id), entToken.HasUserWith(entUser.ID(userID))).
+		Only(ctx)
+	if err != nil {
+		return err
+	}
+	return m.db.APIToken.DeleteOne(t).Exec(ctx)
+}
+
+// AuthenticateToken returns the API token with its user, and marks it used
+func (m *Master) AuthenticateToken(ctx context.Context, token string) (*ent.APIToken, error) {
+	if !strings.HasPrefix(token, APITokenPrefix) {
+		return nil, ErrInvalidToken
+	}
+	t, err := m.db.APIToken.
+		Query().
+		Where(entToken.TokenHash(hashToken(token))).
+		WithUser().
+		Only(ctx)
+	if ent.IsNotFound(err) {
+		return nil, ErrInvalidToken
+	}
+	if err != nil {
+		return nil, err
+	}
+	now := time.Now()
+	if t.ExpiresAt != nil && now.After(*t.ExpiresAt) {
+		return nil, ErrInvalidToken
+	}
+
+	if err = t.Update().SetLastUsedAt(now).Exec(ctx); err != nil {
+		return nil, err
+	}
+	t.LastUsedAt = &now
+	return t, nil
+}
@@ -0,0 +1,83 @@
+package master
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/gobench-io/gobench/ent"
+	"github.com/stretchr/testify/assert"
+
+	entToken "github.com/gobench-io/gobench/ent/apitoken"
+	entUser "github.com/gobench-io/gobench/ent/user"
+)
+
+func TestTokenRole(t *testing.T) {
+	assert.Equal(t, entUser.RoleViewer, TokenRole(entUser.RoleAdmin, entToken.ScopeRead))
+	assert.Equal(t, entUser.RoleRunner, TokenRole(entUser.RoleRunner, entToken.ScopeAdmin))
+	assert.Equal(t, entUser.RoleRunner, TokenRole(entUser.RoleAdmin, entToken.ScopeWrite))
+}
+
+func TestAPITokens(t *testing.T) {
+	ctx := context.Background()
+	m := seedRetentionMaster(t, Retention{})
+
+	runner, err := m.CreateUser(ctx, "ci", "cipw", entUser.RoleRunner)
+	assert.Nil(t, err)
+	other, err := m.CreateUser(ctx, "other", "otherpw", entUser.RoleViewer)
+	assert.Nil(t, err)
+
+	_, _, err = m.CreateAPIToken(ctx, runner.ID, "root", entToken.ScopeAdmin, nil)
+	assert.True(t, errors.Is(err, ErrTokenScope))
+
+	tok, token, err := m.CreateAPIToken(ctx, runner.ID, "nightly", entToken.ScopeWrite, nil)
+	assert.Nil(t, err)
+	assert.True(t, strings.HasPrefix(token, APITokenPrefix))
+	assert.True(t, strings.HasPrefix(token, tok.Prefix))
+	assert.NotContains(t, tok.TokenHash, token)
+	assert.Nil(t, tok.LastUsedAt)
+
+	t.Run("authenticate", func(t *testing.T) {
+		got, err := m.AuthenticateToken(ctx, token)
+		assert.Nil(t, err)
+		assert.Equal(t, runner.ID, got.Edges.User.ID)
+		assert.NotNil(t, got.LastUsedAt)
+
+		ts, err := m.ListAPITokens(ctx, runner.ID)
+		assert.Nil(t, err)
+		assert.Len(t, ts, 1)
+		assert.NotNil(t, ts[0].LastUsedAt)
+
+		for _, bad := range []string{"", "nope", APITokenPrefix + "nope", token + "x"} {
+			_, err = m.AuthenticateToken(ctx, bad)
+			assert.True(t, errors.Is(err, ErrInvalidToken), bad)
+		}
+	})
+
+	t.Run("expired", func(t *testing.T) {
+		past := time.Now().Add(-time.Minute)
+		_, expired, err := m.CreateAPIToken(ctx, runner.ID, "old", entToken.ScopeRead, &past)
+		assert.Nil(t, err)
+		_, err = m.AuthenticateToken(ctx, expired)
+		assert.True(t, errors.Is(err, ErrInvalidToken))
+	})
+
+	t.Run("revoke", func(t *testing.T) {
+		err := m.DeleteAPIToken(ctx, other.ID, tok.ID)
+		assert.True(t, ent.IsNotFound(err))
+
+		assert.Nil(t, m.DeleteAPIToken(ctx, runner.ID, tok.ID))
+		_, err = m.AuthenticateToken(ctx, token)
+		assert.True(t, errors.Is(err, ErrInvalidToken))
+	})
+
+	t.Run("deleted with the user", func(t *testing.T) {
+		_, token, err := m.CreateAPIToken(ctx, other.ID, "mine", entToken.ScopeRead, nil)
+		assert.Nil(t, err)
+		assert.Nil(t, m.DeleteUser(ctx, other.ID))
+		_, err = m.AuthenticateToken(ctx, token)
+		assert.True(t, errors.Is(err, ErrInvalidToken))
+	})
+}
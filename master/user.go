@@ -0,0 +1,213 @@
+package master
+
+import (
+	"context"
+	"errors"
+	"net/mail"
+
+	"golang.org/x/crypto/bcrypt"
+
+	"github.com/gobench-io/gobench/ent"
+
+	entToken "github.com/gobench-io/gobench/ent/apitoken"
+	entSub "github.com/gobench-io/gobench/ent/subscription"
+	entUser "github.com/gobench-io/gobench/ent/user"
+)
+
+// AdminUsername is the user bootstrapped with the admin password
+const AdminUsername = "admin"
+
+var (
+	// ErrInvalidCredentials is returned when a username or a password is
+	// wrong
+	ErrInvalidCredentials = errors.New("invalid credentials")
+	// ErrEmptyPassword is returned when a user is given an empty password
+	ErrEmptyPassword = errors.New("password is required")
+	// ErrLastAdmin is returned when the last admin would be deleted or
+	// demoted
+	ErrLastAdmin = errors.New("at least one admin is required")
+	// ErrInvalidEmail is returned for an email that is not an address
+	ErrInvalidEmail = errors.New("invalid email")
+)
+
+// roleRanks orders the roles, a role may do what a lower role does
+var roleRanks = map[entUser.Role]int{
+	entUser.RoleViewer: 1,
+	entUser.RoleRunner: 2,
+	entUser.RoleAdmin:  3,
+}
+
+// RoleAllows tells if a role may do what the want role does
+func RoleAllows(role, want entUser.Role) bool {
+	return roleRanks[role] >= roleRanks[want]
+}
+
+func hashPassword(password string) (string, error) {
+	if password == "" {
+		return "", ErrEmptyPassword
+	}
+	hash, err := bcrypt.GenerateFromPassword([]byte(password), bcrypt.DefaultCost)
+	return string(hash), err
+}
+
+// CreateUser creates a user with a password and a role, a member of the
+// default project
+func (m *Master) CreateUser(ctx context.Context, username, password string, role entUser.Role) (*ent.User, error) {
+	hash, err := hashPassword(password)
+	if err != nil {
+		return nil, err
+	}
+	p, err := m.DefaultProject(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return m.db.User.
+		Create().
+		SetUsername(username).
+		SetPasswordHash(hash).
+		SetRole(role).
+		AddProjectIDs(p.ID).
+		Save(ctx)
+}
+
+// GetUser returns a user by ID
+func (m *Master) GetUser(ctx context.Context, id int) (*ent.User, error) {
+	return m.db.User.
+		Query().
+		Where(entUser.ID(id)).
+		Only(ctx)
+}
+
+// UserByName returns a user by username
+func (m *Master) UserByName(ctx context.Context, username string) (*ent.User, error) {
+	return m.db.User.
+		Query().
+		Where(entUser.Username(username)).
+		Only(ctx)
+}
+
+// ListUsers returns all users by username
+func (m *Master) ListUsers(ctx context.Context) ([]*ent.User, error) {
+	return m.db.User.
+		Query().
+		Order(ent.Asc(entUser.FieldUsername)).
+		All(ctx)
+}
+
+// otherAdmins counts the admins other than a user
+func (m *Master) otherAdmins(ctx context.Context, id int) (int, error) {
+	return m.db.User.
+		Query().
+		Where(entUser.RoleEQ(entUser.RoleAdmin), entUser.IDNEQ(id)).
+		Count(ctx)
+}
+
+// UpdateUser changes the password of a user when it is not empty, and the
+// role when it is not empty
+func (m *Master) UpdateUser(ctx context.Context, id int, password string, role entUser.Role) (*ent.User, error) {
+	u, err := m.GetUser(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	up := u.Update()
+	if password != "" {
+		hash, err := hashPassword(password)
+		if err != nil {
+			return nil, err
+		}
+		up.SetPasswordHash(hash)
+	}
+	if role != "" {
+		if u.Role == entUser.RoleAdmin && role != entUser.RoleAdmin {
+			n, err := m.otherAdmins(ctx, id)
+			if err != nil {
+				return nil, err
+			}
+			if n == 0 {
+				return nil, ErrLastAdmin
+			}
+		}
+		up.SetRole(role)
+	}
+	return up.Save(ctx)
+}
+
+// SetUserEmail changes the email of the notifications of a user, an empty
+// email receives none
+func (m *Master) SetUserEmail(ctx context.Context, id int, email string) (*ent.User, error) {
+	if email == "" {
+		return m.db.User.UpdateOneID(id).ClearEmail().Save(ctx)
+	}
+	a, err := mail.ParseAddress(email)
+	if err != nil {
+		return nil, ErrInvalidEmail
+	}
+	return m.db.User.UpdateOneID(id).SetEmail(a.Address).Save(ctx)
+}
+
+// DeleteUser deletes a user with its API tokens and subscriptions, but not
+// the last admin
+func (m *Master) DeleteUser(ctx context.Context, id int) error {
+	u, err := m.GetUser(ctx, id)
+	if err != nil {
+		return err
+	}
+	if u.Role == entUser.RoleAdmin {
+		n, err := m.otherAdmins(ctx, id)
+		if err != nil {
+			return err
+		}
+		if n == 0 {
+			return ErrLastAdmin
+		}
+	}
+	_, err = m.db.APIToken.
+		Delete().
+		Where(entToken.HasUserWith(entUser.ID(id))).
+		Exec(ctx)
+	if err != nil {
+		return err
+	}
+	_, err = m.db.Subscription.
+		Delete().
+		Where(entSub.HasUserWith(entUser.ID(id))).
+		Exec(ctx)
+	if err != nil {
+		return err
+	}
+	return m.db.User.DeleteOneID(id).Exec(ctx)
+}
+
+// Authenticate returns the user of a username and a password
+func (m *Master) Authenticate(ctx context.Context, username, password string) (*ent.User, error) {
+	u, err := m.UserByName(ctx, username)
+	if ent.IsNotFound(err) {
+		return nil, ErrInvalidCredentials
+	}
+	if err != nil {
+		return nil, err
+	}
+	err = bcrypt.CompareHashAndPassword([]byte(u.PasswordHash), []byte(password))
+	if err != nil {
+		return nil, ErrInvalidCredentials
+	}
+	return u, nil
+}
+
+// EnsureAdmin makes the admin user an admin with the password, creating it
+// when needed, so the admin password always logs in
+func (m *Master) EnsureAdmin(ctx context.Context, password string) (*ent.User, error) {
+	u, err := m.UserByName(ctx, AdminUsername)
+	if ent.IsNotFound(err) {
+		return m.CreateUser(ctx, AdminUsername, password, entUser.RoleAdmin)
+	}
+	if err != nil {
+		return nil, err
+	}
+	if u.Role == entUser.RoleAdmin &&
+		bcrypt.CompareHashAndPassword([]byte(u.PasswordHash), []byte(password)) == nil {
+		return u, nil
+	}
+	return m.UpdateUser(ctx, u.ID, password, entUser.RoleAdmin)
+}
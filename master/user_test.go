@@ -0,0 +1,90 @@
+package master
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	entUser "github.com/gobench-io/gobench/ent/user"
+)
+
+func TestRoleAllows(t *testing.T) {
+	assert.True(t, RoleAllows(entUser.RoleAdmin, entUser.RoleRunner))
+	assert.True(t, RoleAllows(entUser.RoleRunner, entUser.RoleRunner))
+	assert.True(t, RoleAllows(entUser.RoleRunner, entUser.RoleViewer))
+	assert.False(t, RoleAllows(entUser.RoleViewer, entUser.RoleRunner))
+	assert.False(t, RoleAllows(entUser.RoleRunner, entUser.RoleAdmin))
+	assert.False(t, RoleAllows("", entUser.RoleViewer))
+}
+
+func TestUsers(t *testing.T) {
+	ctx := context.Background()
+	m := seedRetentionMaster(t, Retention{})
+
+	admin, err := m.EnsureAdmin(ctx, "secret")
+	assert.Nil(t, err)
+	assert.Equal(t, entUser.RoleAdmin, admin.Role)
+	assert.NotEqual(t, "secret", admin.PasswordHash)
+
+	t.Run("authenticate", func(t *testing.T) {
+		u, err := m.Authenticate(ctx, AdminUsername, "secret")
+		assert.Nil(t, err)
+		assert.Equal(t, admin.ID, u.ID)
+
+		_, err = m.Authenticate(ctx, AdminUsername, "wrong")
+		assert.True(t, errors.Is(err, ErrInvalidCredentials))
+		_, err = m.Authenticate(ctx, "nobody", "secret")
+		assert.True(t, errors.Is(err, ErrInvalidCredentials))
+	})
+
+	t.Run("a new admin password replaces the old one", func(t *testing.T) {
+		_, err := m.EnsureAdmin(ctx, "secret2")
+		assert.Nil(t, err)
+		_, err = m.Authenticate(ctx, AdminUsername, "secret")
+		assert.True(t, errors.Is(err, ErrInvalidCredentials))
+		_, err = m.EnsureAdmin(ctx, "secret")
+		assert.Nil(t, err)
+	})
+
+	viewer, err := m.CreateUser(ctx, "alice", "alicepw", entUser.RoleViewer)
+	assert.Nil(t, err)
+
+	t.Run("empty password", func(t *testing.T) {
+		_, err := m.CreateUser(ctx, "bob", "", entUser.RoleViewer)
+		assert.True(t, errors.Is(err, ErrEmptyPassword))
+	})
+
+	t.Run("duplicate username", func(t *testing.T) {
+		_, err := m.CreateUser(ctx, "alice", "pw", entUser.RoleViewer)
+		assert.NotNil(t, err)
+	})
+
+	t.Run("update", func(t *testing.T) {
+		u, err := m.UpdateUser(ctx, viewer.ID, "", entUser.RoleRunner)
+		assert.Nil(t, err)
+		assert.Equal(t, entUser.RoleRunner, u.Role)
+		_, err = m.Authenticate(ctx, "alice", "alicepw")
+		assert.Nil(t, err)
+
+		_, err = m.UpdateUser(ctx, viewer.ID, "newpw", "")
+		assert.Nil(t, err)
+		_, err = m.Authenticate(ctx, "alice", "newpw")
+		assert.Nil(t, err)
+	})
+
+	t.Run("the last admin stays", func(t *testing.T) {
+		_, err := m.UpdateUser(ctx, admin.ID, "", entUser.RoleViewer)
+		assert.True(t, errors.Is(err, ErrLastAdmin))
+		assert.True(t, errors.Is(m.DeleteUser(ctx, admin.ID), ErrLastAdmin))
+	})
+
+	users, err := m.ListUsers(ctx)
+	assert.Nil(t, err)
+	assert.Equal(t, []string{"admin", "alice"}, []string{users[0].Username, users[1].Username})
+
+	assert.Nil(t, m.DeleteUser(ctx, viewer.ID))
+	_, err = m.GetUser(ctx, viewer.ID)
+	assert.NotNil(t, err)
+}
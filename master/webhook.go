@@ -0,0 +1,429 @@
+package master
+
+import (
+	"bytes"
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"net/url"
+	"sync"
+	"text/template"
+	"time"
+
+	"github.com/gobench-io/gobench/ent"
+	"github.com/gobench-io/gobench/verdict"
+
+	entApp "github.com/gobench-io/gobench/ent/application"
+	entTag "github.com/gobench-io/gobench/ent/tag"
+	entWebhook "github.com/gobench-io/gobench/ent/webhook"
+	entDelivery "github.com/gobench-io/gobench/ent/webhookdelivery"
+)
+
+// webhook events
+const (
+	WebhookStart  = "start"
+	WebhookFinish = "finish"
+	WebhookError  = "error"
+	WebhookCancel = "cancel"
+	// WebhookBreach follows the finish of an application that regressed
+	// against its baseline
+	WebhookBreach = "breach"
+)
+
+// headers of the webhook posts
+const (
+	WebhookEventHeader     = "X-Gobench-Event"
+	WebhookDeliveryHeader  = "X-Gobench-Delivery"
+	WebhookSignatureHeader = "X-Gobench-Signature" // sha256=<hex HMAC of the body>
+)
+
+// statusEvents are the events of the status transitions
+var statusEvents = map[string]string{
+	string(jobRunning):  WebhookStart,
+	string(jobFinished): WebhookFinish,
+	string(jobError):    WebhookError,
+	string(jobCancel):   WebhookCancel,
+}
+
+// ErrWebhookInvalid is returned for a webhook with a bad URL, event or
+// template
+var ErrWebhookInvalid = errors.New("invalid webhook")
+
+// maxDeliveries is the number of deliveries listed of a webhook
+const maxDeliveries = 100
+
+// WebhookConfig is the configuration of a webhook
+type WebhookConfig struct {
+	Name string
+	URL  string
+	// Tag limits the webhook to the applications with the tag
+	Tag string
+	// Events are the events posted, all of them when empty
+	Events []string
+	// Template is a text/template of the JSON body over a WebhookPayload.
+	// The json function quotes a value. Empty posts the payload as JSON.
+	Template string
+	// Secret signs the body in the X-Gobench-Signature header
+	Secret string
+}
+
+// WebhookApplication is the application of a webhook payload
+type WebhookApplication struct {
+	ID      int      `json:"id"`
+	Name    string   `json:"name"`
+	Status  string   `json:"status"`
+	Verdict string   `json:"verdict,omitempty"`
+	Tags    []string `json:"tags"`
+}
+
+// WebhookPayload is the data of a webhook post
+type WebhookPayload struct {
+	Event       string             `json:"event"`
+	Time        time.Time          `json:"time"`
+	Application WebhookApplication `json:"application"`
+	// Checks are the regressed checks of a breach
+	Checks []verdict.Check `json:"checks,omitempty"`
+}
+
+// Validate checks the URL, the events and the template of a webhook
+func (c WebhookConfig) Validate() error {
+	u, err := url.Parse(c.URL)
+	if err != nil || (u.Scheme != "http" && u.Scheme != "https") || u.Host == "" {
+		return fmt.Errorf("%w: the URL must be http or https", ErrWebhookInvalid)
+	}
+	for _, e := range c.Events {
+		if !isWebhookEvent(e) {
+			return fmt.Errorf("%w: unknown event %s", ErrWebhookInvalid, e)
+		}
+	}
+	// the template renders a JSON of a sample payload
+	_, err = renderPayload(c.Template, &WebhookPayload{
+		Event:       WebhookFinish,
+		Time:        time.Now(),
+		Application: WebhookApplication{ID: 1, Name: "sample", Status: string(jobFinished)},
+	})
+	return err
+}
+
+func isWebhookEvent(e string) bool {
+	if e == WebhookBreach {
+		return true
+	}
+	for _, se := range statusEvents {
+		if se == e {
+			return true
+		}
+	}
+	return false
+}
+
+var payloadFuncs = template.FuncMap{
+	"json": func(v interface{}) (string, error) {
+		b, err := json.Marshal(v)
+		return string(b), err
+	},
+}
+
+// renderPayload renders the body of a payload, the JSON of the payload
+// without a template
+func renderPayload(tmpl string, p *WebhookPayload) ([]byte, error) {
+	if tmpl == "" {
+		return json.Marshal(p)
+	}
+
+	t, err := template.New("webhook").Funcs(payloadFuncs).Parse(tmpl)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrWebhookInvalid, err)
+	}
+	var buf bytes.Buffer
+	if err := t.Execute(&buf, p); err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrWebhookInvalid, err)
+	}
+	if !json.Valid(buf.Bytes()) {
+		return nil, fmt.Errorf("%w: the template does not render a JSON", ErrWebhookInvalid)
+	}
+	return buf.Bytes(), nil
+}
+
+// signPayload returns the signature header of a body
+func signPayload(secret string, body []byte) string {
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write(body)
+	return "sha256=" + hex.EncodeToString(mac.Sum(nil))
+}
+
+// CreateWebhook creates a webhook
+func (m *Master) CreateWebhook(ctx context.Context, c WebhookConfig) (*ent.Webhook, error) {
+	if err := c.Validate(); err != nil {
+		return nil, err
+	}
+	return m.db.Webhook.
+		Create().
+		SetName(c.Name).
+		SetURL(c.URL).
+		SetTag(c.Tag).
+		SetEvents(c.Events).
+		SetTemplate(c.Template).
+		SetSecret(c.Secret).
+		Save(ctx)
+}
+
+// GetWebhook returns a webhook by ID
+func (m *Master) GetWebhook(ctx context.Context, id int) (*ent.Webhook, error) {
+	return m.db.Webhook.
+		Query().
+		Where(entWebhook.ID(id)).
+		Only(ctx)
+}
+
+// ListWebhooks returns the webhooks by name
+func (m *Master) ListWebhooks(ctx context.Context) ([]*ent.Webhook, error) {
+	return m.db.Webhook.
+		Query().
+		Order(ent.Asc(entWebhook.FieldName), ent.Asc(entWebhook.FieldID)).
+		All(ctx)
+}
+
+// UpdateWebhook replaces the configuration of a webhook, an empty secret
+// keeps the secret
+func (m *Master) UpdateWebhook(ctx context.Context, id int, c WebhookConfig) (*ent.Webhook, error) {
+	if err := c.Validate(); err != nil {
+		return nil, err
+	}
+	wu := m.db.Webhook.
+		UpdateOneID(id).
+		SetName(c.Name).
+		SetURL(c.URL).
+		SetTag(c.Tag).
+		SetEvents(c.Events).
+		SetTemplate(c.Template)
+	if c.Secret != "" {
+		wu.SetSecret(c.Secret)
+	}
+	return wu.Save(ctx)
+}
+
+// DeleteWebhook deletes a webhook with its deliveries
+func (m *Master) DeleteWebhook(ctx context.Context, id int) error {
+	if _, err := m.db.WebhookDelivery.
+		Delete().
+		Where(entDelivery.HasWebhookWith(entWebhook.ID(id))).
+		Exec(ctx); err != nil {
+		return err
+	}
+	return m.db.Webhook.DeleteOneID(id).Exec(ctx)
+}
+
+// ListWebhookDeliveries returns the last deliveries of a webhook, the latest
+// first
+func (m *Master) ListWebhookDeliveries(ctx context.Context, id int) ([]*ent.WebhookDelivery, error) {
+	return m.db.WebhookDelivery.
+		Query().
+		Where(entDelivery.HasWebhookWith(entWebhook.ID(id))).
+		Order(ent.Desc(entDelivery.FieldID)).
+		Limit(maxDeliveries).
+		All(ctx)
+}
+
+// webhookSender posts the deliveries, retrying the failed posts with an
+// exponential backoff
+type webhookSender struct {
+	client   *http.Client
+	attempts int
+	backoff  time.Duration // before the second attempt, doubled after
+	wg       sync.WaitGroup
+}
+
+func newWebhookSender() *webhookSender {
+	return &webhookSender{
+		client:   &http.Client{Timeout: 10 * time.Second},
+		attempts: 5,
+		backoff:  time.Second,
+	}
+}
+
+// wants tells if a webhook posts an event of an application with tags
+func wants(w *ent.Webhook, event string, tags []string) bool {
+	if len(w.Events) > 0 {
+		found := false
+		for _, e := range w.Events {
+			found = found || e == event
+		}
+		if !found {
+			return false
+		}
+	}
+	if w.Tag == "" {
+		return true
+	}
+	for _, t := range tags {
+		if t == w.Tag {
+			return true
+		}
+	}
+	return false
+}
+
+// notifyWebhooks posts the events of the status of an application to the
+// webhooks that want them
+func (m *Master) notifyWebhooks(ctx context.Context, app *ent.Application) {
+	event, ok := statusEvents[app.Status]
+	if !ok {
+		return
+	}
+	hooks, err := m.db.Webhook.Query().All(ctx)
+	if err != nil || len(hooks) == 0 {
+		if err != nil {
+			m.logger.Errorw("failed list the webhooks", "err", err)
+		}
+		return
+	}
+
+	tags, err := m.db.Tag.
+		Query().
+		Where(entTag.HasApplicationWith(entApp.ID(app.ID))).
+		Order(ent.Asc(entTag.FieldName)).
+		All(ctx)
+	if err != nil {
+		m.logger.Errorw("failed list the tags of the application", "err", err)
+		return
+	}
+
+	p := &WebhookPayload{
+		Time: time.Now(),
+		Application: WebhookApplication{
+			ID:      app.ID,
+			Name:    app.Name,
+			Status:  app.Status,
+			Verdict: app.Verdict,
+			Tags:    []string{},
+		},
+	}
+	for _, t := range tags {
+		p.Application.Tags = append(p.Application.Tags, t.Name)
+	}
+
+	events := []string{event}
+	if event == WebhookFinish && app.Verdict == verdict.Regressed {
+		events = append(events, WebhookBreach)
+	}
+
+	for _, e := range events {
+		p.Event = e
+		p.Checks = nil
+		if e == WebhookBreach {
+			for _, c := range app.Checks {
+				if c.Verdict == verdict.Regressed {
+					p.Checks = append(p.Checks, c)
+				}
+			}
+		}
+
+		for _, w := range hooks {
+			if !wants(w, e, p.Application.Tags) {
+				continue
+			}
+			if err := m.sendWebhook(ctx, w, app.ID, p); err != nil {
+				m.logger.Errorw("failed send the webhook",
+					"webhook", w.Name,
+					"event", e,
+					"application id", app.ID,
+					"err", err,
+				)
+			}
+		}
+	}
+}
+
+// sendWebhook saves the delivery of a payload, then posts it in the
+// background
+func (m *Master) sendWebhook(ctx context.Context, w *ent.Webhook, appID int, p *WebhookPayload) error {
+	body, err := renderPayload(w.Template, p)
+	if err != nil {
+		return err
+	}
+	d, err := m.db.WebhookDelivery.
+		Create().
+		SetEvent(p.Event).
+		SetApplicationID(appID).
+		SetPayload(string(body)).
+		SetWebhook(w).
+		Save(ctx)
+	if err != nil {
+		return err
+	}
+
+	m.webhooks.wg.Add(1)
+	go func() {
+		defer m.webhooks.wg.Done()
+		m.deliver(w, d)
+	}()
+	return nil
+}
+
+// deliver posts a delivery until it succeeds or runs out of attempts, and
+// saves every attempt
+func (m *Master) deliver(w *ent.Webhook, d *ent.WebhookDelivery) {
+	ctx := context.Background()
+	s := m.webhooks
+	backoff := s.backoff
+
+	for attempt := 1; ; attempt++ {
+		code, err := s.post(ctx, w, d)
+
+		du := d.Update().
+			SetAttempts(attempt).
+			SetStatusCode(code)
+		if err == nil {
+			du.SetSuccess(true).SetDeliveredAt(time.Now()).ClearError()
+		} else {
+			du.SetError(err.Error())
+		}
+		saved, err := du.Save(ctx)
+		if err != nil {
+			m.logger.Errorw("failed save the webhook delivery", "delivery id", d.ID, "err", err)
+			return
+		}
+		d = saved
+
+		if d.Success || attempt >= s.attempts {
+			return
+		}
+		time.Sleep(backoff)
+		backoff *= 2
+	}
+}
+
+// post sends a delivery once, a status other than 2xx fails
+func (s *webhookSender) post(ctx context.Context, w *ent.Webhook, d *ent.WebhookDelivery) (int, error) {
+	body := []byte(d.Payload)
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, w.URL, bytes.NewReader(body))
+	if err != nil {
+		return 0, err
+	}
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set(WebhookEventHeader, d.Event)
+	req.Header.Set(WebhookDeliveryHeader, fmt.Sprint(d.ID))
+	if w.Secret != "" {
+		req.Header.Set(WebhookSignatureHeader, signPayload(w.Secret, body))
+	}
+
+	res, err := s.client.Do(req)
+	if err != nil {
+		return 0, err
+	}
+	defer res.Body.Close()
+	_, _ = io.Copy(ioutil.Discard, res.Body)
+
+	if res.StatusCode < 200 || res.StatusCode > 299 {
+		return res.StatusCode, fmt.Errorf("the receiver answered %s", res.Status)
+	}
+	return res.StatusCode, nil
+}
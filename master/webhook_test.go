@@ -0,0 +1,167 @@
+package master
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/gobench-io/gobench/logger"
+	"github.com/gobench-io/gobench/verdict"
+)
+
+// webhookReceiver records the posts, and fails the first ones
+type webhookReceiver struct {
+	mu     sync.Mutex
+	fail   int
+	posts  []http.Header
+	bodies [][]byte
+}
+
+func (wr *webhookReceiver) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	wr.mu.Lock()
+	defer wr.mu.Unlock()
+	if wr.fail > 0 {
+		wr.fail--
+		w.WriteHeader(http.StatusBadGateway)
+		return
+	}
+	body, _ := ioutil.ReadAll(r.Body)
+	wr.posts = append(wr.posts, r.Header)
+	wr.bodies = append(wr.bodies, body)
+}
+
+func TestWebhooks(t *testing.T) {
+	ctx := context.Background()
+	m := seedRetentionMaster(t, Retention{})
+	m.webhooks.backoff = time.Millisecond
+
+	wr := &webhookReceiver{fail: 2}
+	srv := httptest.NewServer(wr)
+	defer srv.Close()
+
+	all, err := m.CreateWebhook(ctx, WebhookConfig{
+		Name:   "all",
+		URL:    srv.URL,
+		Secret: "s3cret",
+	})
+	assert.Nil(t, err)
+	breach, err := m.CreateWebhook(ctx, WebhookConfig{
+		Name:     "nightly breach",
+		URL:      srv.URL,
+		Tag:      "nightly",
+		Events:   []string{WebhookBreach},
+		Template: `{"text": {{json (printf "%s regressed" .Application.Name)}}}`,
+	})
+	assert.Nil(t, err)
+
+	app := m.seedApplication(ctx, t)
+	_, err = m.SetApplicationTag(ctx, app.ID, "nightly")
+	assert.Nil(t, err)
+	j := &job{app: app, logger: logger.NewNopLogger(), onStatus: m.statusChanged}
+
+	assert.Nil(t, j.setStatus(ctx, jobRunning))
+	j.app, err = j.app.Update().
+		SetVerdict(verdict.Regressed).
+		SetChecks([]verdict.Check{
+			{Metric: "latency", Stat: "p95", Verdict: verdict.Regressed},
+			{Metric: "rate", Stat: "rate", Verdict: verdict.Pass},
+		}).
+		Save(ctx)
+	assert.Nil(t, err)
+	assert.Nil(t, j.setStatus(ctx, jobFinished))
+	m.webhooks.wg.Wait()
+
+	t.Run("deliveries", func(t *testing.T) {
+		global, err := m.ListWebhookDeliveries(ctx, all.ID)
+		assert.Nil(t, err)
+		events := []string{}
+		for _, d := range global {
+			events = append(events, d.Event)
+		}
+		assert.Equal(t, []string{WebhookBreach, WebhookFinish, WebhookStart}, events)
+
+		tagged, err := m.ListWebhookDeliveries(ctx, breach.ID)
+		assert.Nil(t, err)
+		assert.Len(t, tagged, 1)
+		assert.Equal(t, WebhookBreach, tagged[0].Event)
+
+		attempts := 0
+		for _, d := range append(global, tagged...) {
+			assert.True(t, d.Success)
+			assert.Equal(t, 200, d.StatusCode)
+			assert.NotNil(t, d.DeliveredAt)
+			attempts += d.Attempts
+		}
+		// the two failed posts were retried
+		assert.Equal(t, 6, attempts)
+	})
+
+	t.Run("payloads", func(t *testing.T) {
+		assert.Len(t, wr.bodies, 4)
+		signed := 0
+		for i, h := range wr.posts {
+			// the tagged webhook has a template and no secret
+			if h.Get(WebhookSignatureHeader) == "" {
+				var text struct {
+					Text string `json:"text"`
+				}
+				assert.Nil(t, json.Unmarshal(wr.bodies[i], &text))
+				assert.Equal(t, "foo regressed", text.Text)
+				continue
+			}
+			assert.Equal(t, signPayload("s3cret", wr.bodies[i]), h.Get(WebhookSignatureHeader))
+			signed++
+
+			var p WebhookPayload
+			assert.Nil(t, json.Unmarshal(wr.bodies[i], &p))
+			assert.Equal(t, h.Get(WebhookEventHeader), p.Event)
+			assert.Equal(t, app.ID, p.Application.ID)
+			assert.Equal(t, []string{"nightly"}, p.Application.Tags)
+			if p.Event == WebhookBreach {
+				assert.Len(t, p.Checks, 1)
+			}
+		}
+		assert.Equal(t, 3, signed)
+	})
+
+	t.Run("a receiver that fails runs out of attempts", func(t *testing.T) {
+		wr.mu.Lock()
+		wr.fail = 100
+		wr.mu.Unlock()
+		assert.Nil(t, j.setStatus(ctx, jobError))
+		m.webhooks.wg.Wait()
+
+		ds, err := m.ListWebhookDeliveries(ctx, all.ID)
+		assert.Nil(t, err)
+		assert.Equal(t, WebhookError, ds[0].Event)
+		assert.False(t, ds[0].Success)
+		assert.Equal(t, m.webhooks.attempts, ds[0].Attempts)
+		assert.Equal(t, http.StatusBadGateway, ds[0].StatusCode)
+		assert.NotEmpty(t, ds[0].Error)
+	})
+
+	t.Run("invalid webhooks", func(t *testing.T) {
+		for _, c := range []WebhookConfig{
+			{Name: "url", URL: "ftp://example.com"},
+			{Name: "event", URL: srv.URL, Events: []string{"launch"}},
+			{Name: "template", URL: srv.URL, Template: `{"text": {{.Nope}}}`},
+			{Name: "json", URL: srv.URL, Template: `text {{.Event}}`},
+		} {
+			_, err := m.CreateWebhook(ctx, c)
+			assert.True(t, errors.Is(err, ErrWebhookInvalid), c.Name)
+		}
+	})
+
+	assert.Nil(t, m.DeleteWebhook(ctx, all.ID))
+	ds, err := m.ListWebhookDeliveries(ctx, all.ID)
+	assert.Nil(t, err)
+	assert.Len(t, ds, 0)
+}
@@ -3,9 +3,14 @@ package main
 import (
 	"errors"
 	"flag"
+	"fmt"
 	"os"
 	"os/user"
 	"path/filepath"
+	"strings"
+	"time"
+
+	"github.com/gobench-io/gobench/master"
 )
 
 type mode string
@@ -14,6 +19,8 @@ type mode string
 const (
 	Master mode = "master"
 	Agent  mode = "agent"
+	Report mode = "report"
+	Run    mode = "run"
 )
 
 // Err messages
@@ -29,12 +36,42 @@ type Options struct {
 	ClusterPort   int
 	AdminPassword string
 
+	// master, report, run mode
+	DbDriver string
+	DbDSN    string
+
 	// master mode
 	Port int
 	Dir  string
 
+	RawRetention        time.Duration
+	RollupRetention     time.Duration
+	RollupInterval      time.Duration
+	MaintenanceInterval time.Duration
+
+	// email notifications, none without a host
+	SMTPHost     string
+	SMTPPort     int
+	SMTPUsername string
+	SMTPPassword string
+	SMTPFrom     string
+	DashboardURL string
+
 	// agent mode
 	Route string
+
+	// report mode
+	AppID  int
+	Output string
+
+	// run mode
+	Scenario string
+	Gomod    string
+	Gosum    string
+	Name     string
+	Tags     []string
+	JUnit    string
+	Result   string
 }
 
 // func (o Options) String() string {
@@ -57,10 +94,37 @@ func ConfigureOptions(fs *flag.FlagSet, args []string, printVersion, printHelp f
 		dbPath        string
 		adminPassword string
 		dir           string
+		dbDriver      string
+		dbDSN         string
+
+		rawRetention        time.Duration
+		rollupRetention     time.Duration
+		rollupInterval      time.Duration
+		maintenanceInterval time.Duration
+
+		smtpHost     string
+		smtpPort     int
+		smtpUsername string
+		smtpPassword string
+		smtpFrom     string
+		dashboardURL string
 
 		// agent mode
 		route       string
 		clusterPort int
+
+		// report mode
+		appID  int
+		output string
+
+		// run mode
+		scenario string
+		gomod    string
+		gosum    string
+		name     string
+		tags     string
+		junit    string
+		result   string
 	)
 	// gen default working dir
 	u, err := user.Current()
@@ -74,7 +138,7 @@ func ConfigureOptions(fs *flag.FlagSet, args []string, printVersion, printHelp f
 	fs.BoolVar(&showHelp, "h", false, "Show this message")
 	fs.BoolVar(&showHelp, "help", false, "Show this message")
 
-	fs.StringVar(&modeS, "mode", "master", "Operation mode of the program, either master, agent, or executor")
+	fs.StringVar(&modeS, "mode", "master", "Operation mode of the program, either master, agent, executor, report, or run")
 
 	// master
 	fs.IntVar(&port, "p", DEFAULT_PORT, "Port of the master server.")
@@ -82,6 +146,18 @@ func ConfigureOptions(fs *flag.FlagSet, args []string, printVersion, printHelp f
 	fs.StringVar(&dbPath, "db", "", "Name of the database.")
 	fs.StringVar(&adminPassword, "admin-password", "", "Admin password to login to web dashboard")
 	fs.StringVar(&dir, "dir", defDir, "Working directory (default: ${HOME}). The result database and logs will be stored on this folder.")
+	fs.StringVar(&dbDriver, "db-driver", master.DbDrivers[0], "Database driver, one of "+strings.Join(master.DbDrivers, ", ")+".")
+	fs.StringVar(&dbDSN, "db-dsn", "", "Database source (default: the sqlite3 file in the working directory).")
+	fs.DurationVar(&rawRetention, "raw-retention", master.DefaultRetention.Raw, "Age after which the metric points are downsampled, 0 keeps them.")
+	fs.DurationVar(&rollupRetention, "rollup-retention", master.DefaultRetention.Rollup, "Age after which the metric points are deleted, 0 keeps them.")
+	fs.DurationVar(&rollupInterval, "rollup-interval", master.DefaultRetention.Interval, "Interval of the downsampled metric points, 0 is 1 minute.")
+	fs.DurationVar(&maintenanceInterval, "maintenance-interval", master.DefaultRetention.Every, "Period of the database maintenance, 0 disables it.")
+	fs.StringVar(&smtpHost, "smtp-host", "", "SMTP server of the email notifications, none without it.")
+	fs.IntVar(&smtpPort, "smtp-port", DEFAULT_SMTP_PORT, "Port of the SMTP server.")
+	fs.StringVar(&smtpUsername, "smtp-username", "", "SMTP username, no authentication without it.")
+	fs.StringVar(&smtpPassword, "smtp-password", "", "SMTP password.")
+	fs.StringVar(&smtpFrom, "smtp-from", "", "Sender address of the email notifications.")
+	fs.StringVar(&dashboardURL, "dashboard-url", "", "Base URL of the dashboard links in the emails (default: http://<hostname>:<port>).")
 
 	// agent
 	fs.IntVar(&clusterPort, "clusterPort", DEFAULT_CLUSTER_PORT, "Cluster port to solicit and connect.")
@@ -89,6 +165,19 @@ func ConfigureOptions(fs *flag.FlagSet, args []string, printVersion, printHelp f
 	// master + agent
 	fs.StringVar(&route, "route", "", "Master address to solicit routes.")
 
+	// report
+	fs.IntVar(&appID, "app-id", 0, "ID of the application to report.")
+	fs.StringVar(&output, "output", "", "Report file (default: application-<app-id>.html).")
+
+	// run
+	fs.StringVar(&scenario, "scenario", "", "Scenario file to run.")
+	fs.StringVar(&gomod, "gomod", "", "go.mod file of the scenario.")
+	fs.StringVar(&gosum, "gosum", "", "go.sum file of the scenario.")
+	fs.StringVar(&name, "name", "", "Name of the application (default: the scenario file name).")
+	fs.StringVar(&tags, "tags", "", "Comma separated tags of the application.")
+	fs.StringVar(&junit, "junit", "", "JUnit XML result file.")
+	fs.StringVar(&result, "result", "", "JSON result file.")
+
 	program := args[0]
 	if err = fs.Parse(args[1:]); err != nil {
 		return nil, err
@@ -109,6 +198,14 @@ func ConfigureOptions(fs *flag.FlagSet, args []string, printVersion, printHelp f
 		Program: program,
 	}
 
+	if opts.Mode == Master || opts.Mode == Report || opts.Mode == Run {
+		if err = master.ValidateDb(dbDriver, dbDSN); err != nil {
+			return nil, err
+		}
+		opts.DbDriver = dbDriver
+		opts.DbDSN = dbDSN
+	}
+
 	if opts.Mode == Master {
 		if dbPath == "" {
 			home, err := os.UserHomeDir()
@@ -121,6 +218,32 @@ func ConfigureOptions(fs *flag.FlagSet, args []string, printVersion, printHelp f
 		opts.ClusterPort = clusterPort
 		opts.AdminPassword = adminPassword
 		opts.Dir = dir
+
+		if rawRetention < 0 || rollupRetention < 0 || rollupInterval < 0 || maintenanceInterval < 0 {
+			return nil, errors.New("retentions and intervals must not be negative")
+		}
+		if rawRetention > 0 && rollupRetention > 0 && rollupRetention <= rawRetention {
+			return nil, errors.New("rollup retention must be longer than the raw retention")
+		}
+		opts.RawRetention = rawRetention
+		opts.RollupRetention = rollupRetention
+		opts.RollupInterval = rollupInterval
+		opts.MaintenanceInterval = maintenanceInterval
+
+		if smtpHost != "" {
+			if smtpFrom == "" {
+				return nil, errors.New("smtp host must have a smtp from address")
+			}
+			if smtpPort <= 0 || smtpPort > 65535 {
+				return nil, errors.New("smtp port must be between 1 and 65535")
+			}
+		}
+		opts.SMTPHost = smtpHost
+		opts.SMTPPort = smtpPort
+		opts.SMTPUsername = smtpUsername
+		opts.SMTPPassword = smtpPassword
+		opts.SMTPFrom = smtpFrom
+		opts.DashboardURL = dashboardURL
 		return opts, nil
 	}
 
@@ -133,7 +256,55 @@ func ConfigureOptions(fs *flag.FlagSet, args []string, printVersion, printHelp f
 		return opts, nil
 	}
 
-	err = errors.New("mode must be either master, agent, or executor")
+	if opts.Mode == Report {
+		if appID <= 0 {
+			return nil, errors.New("report must have an application id")
+		}
+		if output == "" {
+			output = fmt.Sprintf("application-%d.html", appID)
+		}
+		opts.Dir = dir
+		opts.AppID = appID
+		opts.Output = output
+		return opts, nil
+	}
+
+	if opts.Mode == Run {
+		if scenario == "" {
+			return nil, errors.New("run must have a scenario")
+		}
+		if name == "" {
+			name = strings.TrimSuffix(filepath.Base(scenario), filepath.Ext(scenario))
+		}
+		// the run is isolated in a temporary directory, unless it shares
+		// the database of a master for its baselines
+		if isSet(fs, "dir") {
+			opts.Dir = dir
+		}
+		opts.Scenario = scenario
+		opts.Gomod = gomod
+		opts.Gosum = gosum
+		opts.Name = name
+		for _, t := range strings.Split(tags, ",") {
+			if t = strings.TrimSpace(t); t != "" {
+				opts.Tags = append(opts.Tags, t)
+			}
+		}
+		opts.JUnit = junit
+		opts.Result = result
+		return opts, nil
+	}
+
+	err = errors.New("mode must be either master, agent, executor, report, or run")
 
 	return nil, err
 }
+
+// isSet tells if a flag is on the command line
+func isSet(fs *flag.FlagSet, name string) bool {
+	set := false
+	fs.Visit(func(f *flag.Flag) {
+		set = set || f.Name == name
+	})
+	return set
+}
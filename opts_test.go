@@ -77,7 +77,7 @@ func TestOptions(t *testing.T) {
 	t.Run("master options", func(t *testing.T) {
 		// check mode
 		mustFail([]string{"me", "--mode", "not existed"},
-			"mode must be either master, agent, or executor")
+			"mode must be either master, agent, executor, report, or run")
 
 		// check default master
 		opts := mustNotFail([]string{"me"})
@@ -98,6 +98,65 @@ func TestOptions(t *testing.T) {
 		assert.Equal(t, opts.AdminPassword, "apassword")
 	})
 
+	t.Run("db options", func(t *testing.T) {
+		opts := mustNotFail([]string{"me"})
+		assert.Equal(t, "sqlite3", opts.DbDriver)
+		assert.Equal(t, "", opts.DbDSN)
+
+		opts = mustNotFail([]string{"me", "--db-driver", "postgres",
+			"--db-dsn", "postgres://localhost/gobench"})
+		assert.Equal(t, "postgres", opts.DbDriver)
+		assert.Equal(t, "postgres://localhost/gobench", opts.DbDSN)
+
+		opts = mustNotFail([]string{"me", "--mode", "report", "--app-id", "1",
+			"--db-driver", "mysql", "--db-dsn", "root@tcp(localhost:3306)/gobench"})
+		assert.Equal(t, "mysql", opts.DbDriver)
+
+		mustFail([]string{"me", "--db-driver", "oracle"}, "db driver must be one of")
+		mustFail([]string{"me", "--db-driver", "postgres"}, "postgres must have a db dsn")
+	})
+
+	t.Run("retention options", func(t *testing.T) {
+		opts := mustNotFail([]string{"me"})
+		assert.Equal(t, time.Duration(0), opts.RawRetention)
+		assert.Equal(t, time.Duration(0), opts.RollupRetention)
+		assert.Equal(t, time.Duration(0), opts.RollupInterval)
+		assert.Equal(t, 24*time.Hour, opts.MaintenanceInterval)
+
+		opts = mustNotFail([]string{"me", "--raw-retention", "168h",
+			"--rollup-retention", "2160h", "--rollup-interval", "5m",
+			"--maintenance-interval", "0"})
+		assert.Equal(t, 168*time.Hour, opts.RawRetention)
+		assert.Equal(t, 2160*time.Hour, opts.RollupRetention)
+		assert.Equal(t, 5*time.Minute, opts.RollupInterval)
+		assert.Equal(t, time.Duration(0), opts.MaintenanceInterval)
+
+		mustFail([]string{"me", "--raw-retention", "-1h"}, "must not be negative")
+		mustFail([]string{"me", "--rollup-interval", "-1m"}, "must not be negative")
+		mustFail([]string{"me", "--raw-retention", "48h", "--rollup-retention", "24h"},
+			"rollup retention must be longer than the raw retention")
+	})
+
+	t.Run("smtp options", func(t *testing.T) {
+		opts := mustNotFail([]string{"me"})
+		assert.Equal(t, "", opts.SMTPHost)
+		assert.Equal(t, DEFAULT_SMTP_PORT, opts.SMTPPort)
+
+		opts = mustNotFail([]string{"me", "--smtp-host", "smtp.example.com",
+			"--smtp-port", "25", "--smtp-username", "gobench", "--smtp-password", "pw",
+			"--smtp-from", "gobench@example.com", "--dashboard-url", "https://gobench.example.com"})
+		assert.Equal(t, "smtp.example.com", opts.SMTPHost)
+		assert.Equal(t, 25, opts.SMTPPort)
+		assert.Equal(t, "gobench", opts.SMTPUsername)
+		assert.Equal(t, "pw", opts.SMTPPassword)
+		assert.Equal(t, "gobench@example.com", opts.SMTPFrom)
+		assert.Equal(t, "https://gobench.example.com", opts.DashboardURL)
+
+		mustFail([]string{"me", "--smtp-host", "smtp.example.com"}, "must have a smtp from address")
+		mustFail([]string{"me", "--smtp-host", "smtp.example.com", "--smtp-from", "a@b.c",
+			"--smtp-port", "0"}, "smtp port must be between")
+	})
+
 	t.Run("agent options", func(t *testing.T) {
 		mustFail([]string{"me", "--mode", "agent"}, "must have route to a master")
 
@@ -110,4 +169,38 @@ func TestOptions(t *testing.T) {
 			"--route", "abc.xyz:1234", "--clusterPort", "4567"})
 		assert.Equal(t, 4567, opts.ClusterPort)
 	})
+
+	t.Run("report options", func(t *testing.T) {
+		mustFail([]string{"me", "--mode", "report"}, "must have an application id")
+
+		opts := mustNotFail([]string{"me", "--mode", "report", "--app-id", "12"})
+		assert.Equal(t, 12, opts.AppID)
+		assert.Equal(t, "application-12.html", opts.Output)
+
+		opts = mustNotFail([]string{"me", "--mode", "report", "--app-id", "12",
+			"--output", "/tmp/r.html", "--dir", "/foo"})
+		assert.Equal(t, "/tmp/r.html", opts.Output)
+		assert.Equal(t, "/foo", opts.Dir)
+	})
+
+	t.Run("run options", func(t *testing.T) {
+		mustFail([]string{"me", "--mode", "run"}, "must have a scenario")
+
+		opts := mustNotFail([]string{"me", "--mode", "run", "--scenario", "/tmp/http.go"})
+		assert.Equal(t, "/tmp/http.go", opts.Scenario)
+		assert.Equal(t, "http", opts.Name)
+		assert.Nil(t, opts.Tags)
+		assert.Equal(t, "", opts.JUnit)
+		assert.Equal(t, "", opts.Dir)
+
+		opts = mustNotFail([]string{"me", "--mode", "run", "--scenario", "/tmp/http.go",
+			"--name", "nightly", "--tags", "ci, http,,", "--gomod", "go.mod",
+			"--junit", "junit.xml", "--result", "result.json", "--dir", "/tmp/gobench"})
+		assert.Equal(t, "/tmp/gobench", opts.Dir)
+		assert.Equal(t, "nightly", opts.Name)
+		assert.Equal(t, []string{"ci", "http"}, opts.Tags)
+		assert.Equal(t, "go.mod", opts.Gomod)
+		assert.Equal(t, "junit.xml", opts.JUnit)
+		assert.Equal(t, "result.json", opts.Result)
+	})
 }
@@ -836,6 +836,124 @@ func (*GaugeRes) Descriptor() ([]byte, []int) {
 	return file_pb_agent_proto_rawDescGZIP(), []int{13}
 }
 
+// timeline event of a scenario
+type EventReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	AppID int64             `protobuf:"varint,1,opt,name=appID,proto3" json:"appID,omitempty"` // app ID
+	EID   string            `protobuf:"bytes,2,opt,name=eID,proto3" json:"eID,omitempty"`      // executor ID
+	Time  int64             `protobuf:"varint,3,opt,name=time,proto3" json:"time,omitempty"`
+	Name  string            `protobuf:"bytes,4,opt,name=name,proto3" json:"name,omitempty"`
+	Attrs map[string]string `protobuf:"bytes,5,rep,name=attrs,proto3" json:"attrs,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+}
+
+func (x *EventReq) Reset() {
+	*x = EventReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pb_agent_proto_msgTypes[14]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *EventReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EventReq) ProtoMessage() {}
+
+func (x *EventReq) ProtoReflect() protoreflect.Message {
+	mi := &file_pb_agent_proto_msgTypes[14]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use EventReq.ProtoReflect.Descriptor instead.
+func (*EventReq) Descriptor() ([]byte, []int) {
+	return file_pb_agent_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *EventReq) GetAppID() int64 {
+	if x != nil {
+		return x.AppID
+	}
+	return 0
+}
+
+func (x *EventReq) GetEID() string {
+	if x != nil {
+		return x.EID
+	}
+	return ""
+}
+
+func (x *EventReq) GetTime() int64 {
+	if x != nil {
+		return x.Time
+	}
+	return 0
+}
+
+func (x *EventReq) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *EventReq) GetAttrs() map[string]string {
+	if x != nil {
+		return x.Attrs
+	}
+	return nil
+}
+
+type EventRes struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *EventRes) Reset() {
+	*x = EventRes{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pb_agent_proto_msgTypes[15]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *EventRes) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EventRes) ProtoMessage() {}
+
+func (x *EventRes) ProtoReflect() protoreflect.Message {
+	mi := &file_pb_agent_proto_msgTypes[15]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use EventRes.ProtoReflect.Descriptor instead.
+func (*EventRes) Descriptor() ([]byte, []int) {
+	return file_pb_agent_proto_rawDescGZIP(), []int{15}
+}
+
 var File_pb_agent_proto protoreflect.FileDescriptor
 
 var file_pb_agent_proto_rawDesc = []byte{
@@ -900,27 +1018,42 @@ var file_pb_agent_proto_rawDesc = []byte{
 	0x01, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x70, 0x62, 0x2e, 0x42, 0x61, 0x73, 0x65, 0x64, 0x52, 0x65,
 	0x71, 0x4d, 0x65, 0x74, 0x72, 0x69, 0x63, 0x52, 0x04, 0x62, 0x61, 0x73, 0x65, 0x12, 0x14, 0x0a,
 	0x05, 0x67, 0x61, 0x75, 0x67, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x05, 0x67, 0x61,
-	0x75, 0x67, 0x65, 0x22, 0x0a, 0x0a, 0x08, 0x47, 0x61, 0x75, 0x67, 0x65, 0x52, 0x65, 0x73, 0x32,
-	0xa4, 0x02, 0x0a, 0x05, 0x41, 0x67, 0x65, 0x6e, 0x74, 0x12, 0x31, 0x0a, 0x0f, 0x46, 0x69, 0x6e,
-	0x64, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x12, 0x0e, 0x2e, 0x70,
-	0x62, 0x2e, 0x46, 0x43, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x52, 0x65, 0x71, 0x1a, 0x0e, 0x2e, 0x70,
-	0x62, 0x2e, 0x46, 0x43, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x52, 0x65, 0x73, 0x12, 0x31, 0x0a, 0x0f,
-	0x46, 0x69, 0x6e, 0x64, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x47, 0x72, 0x61, 0x70, 0x68, 0x12,
-	0x0e, 0x2e, 0x70, 0x62, 0x2e, 0x46, 0x43, 0x47, 0x72, 0x61, 0x70, 0x68, 0x52, 0x65, 0x71, 0x1a,
-	0x0e, 0x2e, 0x70, 0x62, 0x2e, 0x46, 0x43, 0x47, 0x72, 0x61, 0x70, 0x68, 0x52, 0x65, 0x73, 0x12,
-	0x34, 0x0a, 0x10, 0x46, 0x69, 0x6e, 0x64, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x4d, 0x65, 0x74,
-	0x72, 0x69, 0x63, 0x12, 0x0f, 0x2e, 0x70, 0x62, 0x2e, 0x46, 0x43, 0x4d, 0x65, 0x74, 0x72, 0x69,
-	0x63, 0x52, 0x65, 0x71, 0x1a, 0x0f, 0x2e, 0x70, 0x62, 0x2e, 0x46, 0x43, 0x4d, 0x65, 0x74, 0x72,
-	0x69, 0x63, 0x52, 0x65, 0x73, 0x12, 0x2f, 0x0a, 0x09, 0x48, 0x69, 0x73, 0x74, 0x6f, 0x67, 0x72,
-	0x61, 0x6d, 0x12, 0x10, 0x2e, 0x70, 0x62, 0x2e, 0x48, 0x69, 0x73, 0x74, 0x6f, 0x67, 0x72, 0x61,
-	0x6d, 0x52, 0x65, 0x71, 0x1a, 0x10, 0x2e, 0x70, 0x62, 0x2e, 0x48, 0x69, 0x73, 0x74, 0x6f, 0x67,
-	0x72, 0x61, 0x6d, 0x52, 0x65, 0x73, 0x12, 0x29, 0x0a, 0x07, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x65,
-	0x72, 0x12, 0x0e, 0x2e, 0x70, 0x62, 0x2e, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x65, 0x72, 0x52, 0x65,
-	0x71, 0x1a, 0x0e, 0x2e, 0x70, 0x62, 0x2e, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x65, 0x72, 0x52, 0x65,
-	0x73, 0x12, 0x23, 0x0a, 0x05, 0x47, 0x61, 0x75, 0x67, 0x65, 0x12, 0x0c, 0x2e, 0x70, 0x62, 0x2e,
-	0x47, 0x61, 0x75, 0x67, 0x65, 0x52, 0x65, 0x71, 0x1a, 0x0c, 0x2e, 0x70, 0x62, 0x2e, 0x47, 0x61,
-	0x75, 0x67, 0x65, 0x52, 0x65, 0x73, 0x42, 0x06, 0x5a, 0x04, 0x2e, 0x3b, 0x70, 0x62, 0x62, 0x06,
-	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+	0x75, 0x67, 0x65, 0x22, 0x0a, 0x0a, 0x08, 0x47, 0x61, 0x75, 0x67, 0x65, 0x52, 0x65, 0x73, 0x22,
+	0xc3, 0x01, 0x0a, 0x08, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x12, 0x14, 0x0a, 0x05,
+	0x61, 0x70, 0x70, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x05, 0x61, 0x70, 0x70,
+	0x49, 0x44, 0x12, 0x10, 0x0a, 0x03, 0x65, 0x49, 0x44, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x03, 0x65, 0x49, 0x44, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x03, 0x52, 0x04, 0x74, 0x69, 0x6d, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65,
+	0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x2d, 0x0a, 0x05,
+	0x61, 0x74, 0x74, 0x72, 0x73, 0x18, 0x05, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x70, 0x62,
+	0x2e, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x2e, 0x41, 0x74, 0x74, 0x72, 0x73, 0x45,
+	0x6e, 0x74, 0x72, 0x79, 0x52, 0x05, 0x61, 0x74, 0x74, 0x72, 0x73, 0x1a, 0x38, 0x0a, 0x0a, 0x41,
+	0x74, 0x74, 0x72, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76,
+	0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75,
+	0x65, 0x3a, 0x02, 0x38, 0x01, 0x22, 0x0a, 0x0a, 0x08, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x52, 0x65,
+	0x73, 0x32, 0xc9, 0x02, 0x0a, 0x05, 0x41, 0x67, 0x65, 0x6e, 0x74, 0x12, 0x31, 0x0a, 0x0f, 0x46,
+	0x69, 0x6e, 0x64, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x12, 0x0e,
+	0x2e, 0x70, 0x62, 0x2e, 0x46, 0x43, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x52, 0x65, 0x71, 0x1a, 0x0e,
+	0x2e, 0x70, 0x62, 0x2e, 0x46, 0x43, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x52, 0x65, 0x73, 0x12, 0x31,
+	0x0a, 0x0f, 0x46, 0x69, 0x6e, 0x64, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x47, 0x72, 0x61, 0x70,
+	0x68, 0x12, 0x0e, 0x2e, 0x70, 0x62, 0x2e, 0x46, 0x43, 0x47, 0x72, 0x61, 0x70, 0x68, 0x52, 0x65,
+	0x71, 0x1a, 0x0e, 0x2e, 0x70, 0x62, 0x2e, 0x46, 0x43, 0x47, 0x72, 0x61, 0x70, 0x68, 0x52, 0x65,
+	0x73, 0x12, 0x34, 0x0a, 0x10, 0x46, 0x69, 0x6e, 0x64, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x4d,
+	0x65, 0x74, 0x72, 0x69, 0x63, 0x12, 0x0f, 0x2e, 0x70, 0x62, 0x2e, 0x46, 0x43, 0x4d, 0x65, 0x74,
+	0x72, 0x69, 0x63, 0x52, 0x65, 0x71, 0x1a, 0x0f, 0x2e, 0x70, 0x62, 0x2e, 0x46, 0x43, 0x4d, 0x65,
+	0x74, 0x72, 0x69, 0x63, 0x52, 0x65, 0x73, 0x12, 0x2f, 0x0a, 0x09, 0x48, 0x69, 0x73, 0x74, 0x6f,
+	0x67, 0x72, 0x61, 0x6d, 0x12, 0x10, 0x2e, 0x70, 0x62, 0x2e, 0x48, 0x69, 0x73, 0x74, 0x6f, 0x67,
+	0x72, 0x61, 0x6d, 0x52, 0x65, 0x71, 0x1a, 0x10, 0x2e, 0x70, 0x62, 0x2e, 0x48, 0x69, 0x73, 0x74,
+	0x6f, 0x67, 0x72, 0x61, 0x6d, 0x52, 0x65, 0x73, 0x12, 0x29, 0x0a, 0x07, 0x43, 0x6f, 0x75, 0x6e,
+	0x74, 0x65, 0x72, 0x12, 0x0e, 0x2e, 0x70, 0x62, 0x2e, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x65, 0x72,
+	0x52, 0x65, 0x71, 0x1a, 0x0e, 0x2e, 0x70, 0x62, 0x2e, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x65, 0x72,
+	0x52, 0x65, 0x73, 0x12, 0x23, 0x0a, 0x05, 0x47, 0x61, 0x75, 0x67, 0x65, 0x12, 0x0c, 0x2e, 0x70,
+	0x62, 0x2e, 0x47, 0x61, 0x75, 0x67, 0x65, 0x52, 0x65, 0x71, 0x1a, 0x0c, 0x2e, 0x70, 0x62, 0x2e,
+	0x47, 0x61, 0x75, 0x67, 0x65, 0x52, 0x65, 0x73, 0x12, 0x23, 0x0a, 0x05, 0x45, 0x76, 0x65, 0x6e,
+	0x74, 0x12, 0x0c, 0x2e, 0x70, 0x62, 0x2e, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x1a,
+	0x0c, 0x2e, 0x70, 0x62, 0x2e, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x73, 0x42, 0x06, 0x5a,
+	0x04, 0x2e, 0x3b, 0x70, 0x62, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
 }
 
 var (
@@ -935,7 +1068,7 @@ func file_pb_agent_proto_rawDescGZIP() []byte {
 	return file_pb_agent_proto_rawDescData
 }
 
-var file_pb_agent_proto_msgTypes = make([]protoimpl.MessageInfo, 14)
+var file_pb_agent_proto_msgTypes = make([]protoimpl.MessageInfo, 17)
 var file_pb_agent_proto_goTypes = []interface{}{
 	(*FCGroupReq)(nil),      // 0: pb.FCGroupReq
 	(*FCGroupRes)(nil),      // 1: pb.FCGroupRes
@@ -951,29 +1084,35 @@ var file_pb_agent_proto_goTypes = []interface{}{
 	(*CounterRes)(nil),      // 11: pb.CounterRes
 	(*GaugeReq)(nil),        // 12: pb.GaugeReq
 	(*GaugeRes)(nil),        // 13: pb.GaugeRes
+	(*EventReq)(nil),        // 14: pb.EventReq
+	(*EventRes)(nil),        // 15: pb.EventRes
+	nil,                     // 16: pb.EventReq.AttrsEntry
 }
 var file_pb_agent_proto_depIdxs = []int32{
 	6,  // 0: pb.HistogramReq.base:type_name -> pb.BasedReqMetric
 	7,  // 1: pb.HistogramReq.histogram:type_name -> pb.HistogramValues
 	6,  // 2: pb.CounterReq.base:type_name -> pb.BasedReqMetric
 	6,  // 3: pb.GaugeReq.base:type_name -> pb.BasedReqMetric
-	0,  // 4: pb.Agent.FindCreateGroup:input_type -> pb.FCGroupReq
-	2,  // 5: pb.Agent.FindCreateGraph:input_type -> pb.FCGraphReq
-	4,  // 6: pb.Agent.FindCreateMetric:input_type -> pb.FCMetricReq
-	8,  // 7: pb.Agent.Histogram:input_type -> pb.HistogramReq
-	10, // 8: pb.Agent.Counter:input_type -> pb.CounterReq
-	12, // 9: pb.Agent.Gauge:input_type -> pb.GaugeReq
-	1,  // 10: pb.Agent.FindCreateGroup:output_type -> pb.FCGroupRes
-	3,  // 11: pb.Agent.FindCreateGraph:output_type -> pb.FCGraphRes
-	5,  // 12: pb.Agent.FindCreateMetric:output_type -> pb.FCMetricRes
-	9,  // 13: pb.Agent.Histogram:output_type -> pb.HistogramRes
-	11, // 14: pb.Agent.Counter:output_type -> pb.CounterRes
-	13, // 15: pb.Agent.Gauge:output_type -> pb.GaugeRes
-	10, // [10:16] is the sub-list for method output_type
-	4,  // [4:10] is the sub-list for method input_type
-	4,  // [4:4] is the sub-list for extension type_name
-	4,  // [4:4] is the sub-list for extension extendee
-	0,  // [0:4] is the sub-list for field type_name
+	16, // 4: pb.EventReq.attrs:type_name -> pb.EventReq.AttrsEntry
+	0,  // 5: pb.Agent.FindCreateGroup:input_type -> pb.FCGroupReq
+	2,  // 6: pb.Agent.FindCreateGraph:input_type -> pb.FCGraphReq
+	4,  // 7: pb.Agent.FindCreateMetric:input_type -> pb.FCMetricReq
+	8,  // 8: pb.Agent.Histogram:input_type -> pb.HistogramReq
+	10, // 9: pb.Agent.Counter:input_type -> pb.CounterReq
+	12, // 10: pb.Agent.Gauge:input_type -> pb.GaugeReq
+	14, // 11: pb.Agent.Event:input_type -> pb.EventReq
+	1,  // 12: pb.Agent.FindCreateGroup:output_type -> pb.FCGroupRes
+	3,  // 13: pb.Agent.FindCreateGraph:output_type -> pb.FCGraphRes
+	5,  // 14: pb.Agent.FindCreateMetric:output_type -> pb.FCMetricRes
+	9,  // 15: pb.Agent.Histogram:output_type -> pb.HistogramRes
+	11, // 16: pb.Agent.Counter:output_type -> pb.CounterRes
+	13, // 17: pb.Agent.Gauge:output_type -> pb.GaugeRes
+	15, // 18: pb.Agent.Event:output_type -> pb.EventRes
+	12, // [12:19] is the sub-list for method output_type
+	5,  // [5:12] is the sub-list for method input_type
+	5,  // [5:5] is the sub-list for extension type_name
+	5,  // [5:5] is the sub-list for extension extendee
+	0,  // [0:5] is the sub-list for field type_name
 }
 
 func init() { file_pb_agent_proto_init() }
@@ -1150,6 +1289,30 @@ func file_pb_agent_proto_init() {
 				return nil
 			}
 		}
+		file_pb_agent_proto_msgTypes[14].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*EventReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pb_agent_proto_msgTypes[15].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*EventRes); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
 	}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
@@ -1157,7 +1320,7 @@ func file_pb_agent_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_pb_agent_proto_rawDesc,
 			NumEnums:      0,
-			NumMessages:   14,
+			NumMessages:   17,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
@@ -24,6 +24,7 @@ type AgentClient interface {
 	Histogram(ctx context.Context, in *HistogramReq, opts ...grpc.CallOption) (*HistogramRes, error)
 	Counter(ctx context.Context, in *CounterReq, opts ...grpc.CallOption) (*CounterRes, error)
 	Gauge(ctx context.Context, in *GaugeReq, opts ...grpc.CallOption) (*GaugeRes, error)
+	Event(ctx context.Context, in *EventReq, opts ...grpc.CallOption) (*EventRes, error)
 }
 
 type agentClient struct {
@@ -88,6 +89,15 @@ func (c *agentClient) Gauge(ctx context.Context, in *GaugeReq, opts ...grpc.Call
 	return out, nil
 }
 
+func (c *agentClient) Event(ctx context.Context, in *EventReq, opts ...grpc.CallOption) (*EventRes, error) {
+	out := new(EventRes)
+	err := c.cc.Invoke(ctx, "/pb.Agent/Event", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // AgentServer is the server API for Agent service.
 // All implementations should embed UnimplementedAgentServer
 // for forward compatibility
@@ -98,6 +108,7 @@ type AgentServer interface {
 	Histogram(context.Context, *HistogramReq) (*HistogramRes, error)
 	Counter(context.Context, *CounterReq) (*CounterRes, error)
 	Gauge(context.Context, *GaugeReq) (*GaugeRes, error)
+	Event(context.Context, *EventReq) (*EventRes, error)
 }
 
 // UnimplementedAgentServer should be embedded to have forward compatible implementations.
@@ -122,6 +133,9 @@ func (UnimplementedAgentServer) Counter(context.Context, *CounterReq) (*CounterR
 func (UnimplementedAgentServer) Gauge(context.Context, *GaugeReq) (*GaugeRes, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Gauge not implemented")
 }
+func (UnimplementedAgentServer) Event(context.Context, *EventReq) (*EventRes, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Event not implemented")
+}
 
 // UnsafeAgentServer may be embedded to opt out of forward compatibility for this service.
 // Use of this interface is not recommended, as added methods to AgentServer will
@@ -242,6 +256,24 @@ func _Agent_Gauge_Handler(srv interface{}, ctx context.Context, dec func(interfa
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Agent_Event_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(EventReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AgentServer).Event(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pb.Agent/Event",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AgentServer).Event(ctx, req.(*EventReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // Agent_ServiceDesc is the grpc.ServiceDesc for Agent service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -273,6 +305,10 @@ var Agent_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "Gauge",
 			Handler:    _Agent_Gauge_Handler,
 		},
+		{
+			MethodName: "Event",
+			Handler:    _Agent_Event_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "pb/agent.proto",
@@ -14,6 +14,8 @@ go test -v -failfast -covermode=atomic -coverprofile=./cov/clients.gbgrpc.out ./
 go test -v -failfast -covermode=atomic -coverprofile=./cov/clients.http.out ./clients/http
 go test -v -failfast -covermode=atomic -coverprofile=./cov/clients.mqtt.out ./clients/mqtt
 go test -v -failfast -covermode=atomic -coverprofile=./cov/clients.nats.out ./clients/nats
+go test -v -failfast -covermode=atomic -coverprofile=./cov/sink.out ./sink
+go test -v -failfast -covermode=atomic -coverprofile=./cov/verdict.out ./verdict
 go test -v -failfast -covermode=atomic -coverprofile=./cov/web.out ./web
 
 gocovmerge ./cov/*.out > acc.out
@@ -0,0 +1,171 @@
+package sink
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/gobench-io/gobench/executor/metrics"
+)
+
+// influx writes points to InfluxDB with the line protocol over HTTP
+// like otlp, the lines are batched by executor and sent in one request when
+// the executor starts a new reporting interval, or when the sink is closed
+type influx struct {
+	mu      sync.Mutex
+	url     string
+	token   string
+	client  *http.Client
+	batches map[string]*influxBatch // executor ID - lines of the current interval
+}
+
+// influxBatch is the lines of a reporting interval of an executor
+type influxBatch struct {
+	time  int64
+	lines bytes.Buffer
+}
+
+func newInflux(c Config) *influx {
+	u := c.URL
+	// the point time is in ms
+	if pu, err := url.Parse(u); err == nil {
+		q := pu.Query()
+		if q.Get("precision") == "" {
+			q.Set("precision", "ms")
+			pu.RawQuery = q.Encode()
+			u = pu.String()
+		}
+	}
+
+	return &influx{
+		url:   u,
+		token: c.Token,
+		client: &http.Client{
+			Timeout: 5 * time.Second,
+		},
+		batches: make(map[string]*influxBatch),
+	}
+}
+
+var influxTagEscaper = strings.NewReplacer(`,`, `\,`, `=`, `\=`, ` `, `\ `)
+
+// Line returns the point in line protocol
+// measurement is gobench_<type>, with application, group, graph, metric and
+// executor as tags
+func Line(p *Point) string {
+	var b strings.Builder
+
+	fmt.Fprintf(&b, "gobench_%s,application_id=%d", p.Type, p.AppID)
+	for _, t := range []struct{ k, v string }{
+		{"application", p.AppName},
+		{"group", p.Group},
+		{"graph", p.Graph},
+		{"metric", p.Title},
+		{"executor", p.EID},
+	} {
+		if t.v == "" {
+			continue
+		}
+		fmt.Fprintf(&b, ",%s=%s", t.k, influxTagEscaper.Replace(t.v))
+	}
+	b.WriteByte(' ')
+
+	switch p.Type {
+	case metrics.Counter:
+		fmt.Fprintf(&b, "count=%di", p.Count)
+	case metrics.Gauge:
+		fmt.Fprintf(&b, "value=%di", p.Value)
+	case metrics.Histogram:
+		h := p.Histogram
+		fmt.Fprintf(&b, "count=%di,min=%di,max=%di,mean=%s,stddev=%s,median=%s,p75=%s,p95=%s,p99=%s,p999=%s",
+			h.Count, h.Min, h.Max,
+			formatFloat(h.Mean), formatFloat(h.Stddev), formatFloat(h.Median),
+			formatFloat(h.P75), formatFloat(h.P95), formatFloat(h.P99), formatFloat(h.P999))
+	}
+
+	fmt.Fprintf(&b, " %d", p.Time)
+
+	return b.String()
+}
+
+func formatFloat(f float64) string {
+	return strconv.FormatFloat(f, 'f', -1, 64)
+}
+
+func (s *influx) Write(ctx context.Context, p *Point) error {
+	s.mu.Lock()
+
+	// a new interval of the executor, send the previous one
+	var body []byte
+	b := s.batches[p.EID]
+	if b != nil && b.time != p.Time {
+		body = b.lines.Bytes()
+		b = nil
+	}
+	if b == nil {
+		b = &influxBatch{time: p.Time}
+		s.batches[p.EID] = b
+	}
+	b.lines.WriteString(Line(p))
+	b.lines.WriteByte('\n')
+
+	s.mu.Unlock()
+
+	if body == nil {
+		return nil
+	}
+	return s.send(ctx, body)
+}
+
+func (s *influx) send(ctx context.Context, body []byte) error {
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, s.url, bytes.NewReader(body))
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Content-Type", "text/plain; charset=utf-8")
+	if s.token != "" {
+		req.Header.Set("Authorization", "Token "+s.token)
+	}
+
+	res, err := s.client.Do(req)
+	if err != nil {
+		return fmt.Errorf("influxdb write: %v", err)
+	}
+	defer res.Body.Close()
+	_, _ = io.Copy(ioutil.Discard, res.Body)
+
+	if res.StatusCode >= 300 {
+		return fmt.Errorf("influxdb write: status %d", res.StatusCode)
+	}
+	return nil
+}
+
+// Close sends the pending intervals
+func (s *influx) Close() error {
+	s.mu.Lock()
+	bodies := [][]byte{}
+	for eID, b := range s.batches {
+		bodies = append(bodies, b.lines.Bytes())
+		delete(s.batches, eID)
+	}
+	s.mu.Unlock()
+
+	var err error
+	for _, body := range bodies {
+		if e := s.send(context.Background(), body); e != nil {
+			err = e
+		}
+	}
+
+	s.client.CloseIdleConnections()
+
+	return err
+}
@@ -0,0 +1,52 @@
+package sink
+
+import (
+	"context"
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"sync"
+)
+
+// jsonl appends every point as a JSON line to a local file
+type jsonl struct {
+	mu  sync.Mutex
+	f   *os.File
+	enc *json.Encoder
+}
+
+// newJSONL opens the file of the config path in dir, the path is checked
+// again since the file is written by the master
+func newJSONL(c Config, dir string) (*jsonl, error) {
+	if !isLocal(c.Path) {
+		return nil, ErrPath
+	}
+	path := filepath.Join(dir, c.Path)
+	if err := os.MkdirAll(filepath.Dir(path), os.ModePerm); err != nil {
+		return nil, err
+	}
+
+	f, err := os.OpenFile(path, os.O_APPEND|os.O_CREATE|os.O_WRONLY, 0644)
+	if err != nil {
+		return nil, err
+	}
+
+	return &jsonl{
+		f:   f,
+		enc: json.NewEncoder(f),
+	}, nil
+}
+
+func (s *jsonl) Write(ctx context.Context, p *Point) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	return s.enc.Encode(p)
+}
+
+func (s *jsonl) Close() error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	return s.f.Close()
+}
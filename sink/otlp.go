@@ -0,0 +1,295 @@
+package sink
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/gobench-io/gobench/executor/metrics"
+)
+
+// otlp pushes points to an OpenTelemetry collector with OTLP/HTTP JSON
+// every executor reports all its metrics at the same time, so points are
+// batched by executor and sent when the executor starts a new reporting
+// interval, or when the sink is closed
+type otlp struct {
+	mu      sync.Mutex
+	url     string
+	token   string
+	client  *http.Client
+	batches map[string][]*Point  // executor ID - points of the current interval
+	starts  map[otlpSeries]int64 // first report time
+}
+
+// otlpSeries identifies the points of a metric of an executor, the titles
+// are unique in a group only
+type otlpSeries struct {
+	eID   string
+	group string
+	title string
+}
+
+func newOTLP(c Config) *otlp {
+	return &otlp{
+		url:   c.URL,
+		token: c.Token,
+		client: &http.Client{
+			Timeout: 5 * time.Second,
+		},
+		batches: make(map[string][]*Point),
+		starts:  make(map[otlpSeries]int64),
+	}
+}
+
+// OTLP JSON encoding, see
+// https://github.com/open-telemetry/opentelemetry-proto/blob/main/opentelemetry/proto/metrics/v1/metrics.proto
+// 64 bit integers are encoded as strings
+
+type otlpRequest struct {
+	ResourceMetrics []otlpResourceMetrics `json:"resourceMetrics"`
+}
+
+type otlpResourceMetrics struct {
+	Resource     otlpResource       `json:"resource"`
+	ScopeMetrics []otlpScopeMetrics `json:"scopeMetrics"`
+}
+
+type otlpResource struct {
+	Attributes []otlpKeyValue `json:"attributes"`
+}
+
+type otlpScopeMetrics struct {
+	Scope   otlpScope    `json:"scope"`
+	Metrics []otlpMetric `json:"metrics"`
+}
+
+type otlpScope struct {
+	Name string `json:"name"`
+}
+
+type otlpKeyValue struct {
+	Key   string    `json:"key"`
+	Value otlpValue `json:"value"`
+}
+
+type otlpValue struct {
+	StringValue string `json:"stringValue"`
+}
+
+type otlpMetric struct {
+	Name    string       `json:"name"`
+	Unit    string       `json:"unit,omitempty"`
+	Sum     *otlpSum     `json:"sum,omitempty"`
+	Gauge   *otlpGauge   `json:"gauge,omitempty"`
+	Summary *otlpSummary `json:"summary,omitempty"`
+}
+
+type otlpNumberDataPoint struct {
+	Attributes        []otlpKeyValue `json:"attributes"`
+	StartTimeUnixNano string         `json:"startTimeUnixNano,omitempty"`
+	TimeUnixNano      string         `json:"timeUnixNano"`
+	AsInt             string         `json:"asInt"`
+}
+
+type otlpSum struct {
+	DataPoints             []otlpNumberDataPoint `json:"dataPoints"`
+	AggregationTemporality int                   `json:"aggregationTemporality"`
+	IsMonotonic            bool                  `json:"isMonotonic"`
+}
+
+type otlpGauge struct {
+	DataPoints []otlpNumberDataPoint `json:"dataPoints"`
+}
+
+type otlpQuantile struct {
+	Quantile float64 `json:"quantile"`
+	Value    float64 `json:"value"`
+}
+
+type otlpSummaryDataPoint struct {
+	Attributes        []otlpKeyValue `json:"attributes"`
+	StartTimeUnixNano string         `json:"startTimeUnixNano,omitempty"`
+	TimeUnixNano      string         `json:"timeUnixNano"`
+	Count             string         `json:"count"`
+	Sum               float64        `json:"sum"`
+	QuantileValues    []otlpQuantile `json:"quantileValues"`
+}
+
+type otlpSummary struct {
+	DataPoints []otlpSummaryDataPoint `json:"dataPoints"`
+}
+
+// aggregationTemporalityCumulative is AGGREGATION_TEMPORALITY_CUMULATIVE
+const aggregationTemporalityCumulative = 2
+
+func kv(k, v string) otlpKeyValue {
+	return otlpKeyValue{Key: k, Value: otlpValue{StringValue: v}}
+}
+
+func nanos(ms int64) string {
+	return strconv.FormatInt(ms*int64(time.Millisecond), 10)
+}
+
+// request builds the export request of the points of one executor interval
+func (s *otlp) request(ps []*Point) *otlpRequest {
+	p0 := ps[0]
+	rm := otlpResourceMetrics{
+		Resource: otlpResource{
+			Attributes: []otlpKeyValue{
+				kv("service.name", "gobench"),
+				kv("gobench.application.id", strconv.Itoa(p0.AppID)),
+				kv("gobench.application.name", p0.AppName),
+				kv("gobench.executor.id", p0.EID),
+			},
+		},
+	}
+
+	ms := []otlpMetric{}
+	for _, p := range ps {
+		attrs := []otlpKeyValue{
+			kv("gobench.group", p.Group),
+			kv("gobench.graph", p.Graph),
+		}
+		start := nanos(s.starts[otlpSeries{p.EID, p.Group, p.Title}])
+		now := nanos(p.Time)
+
+		om := otlpMetric{
+			Name: p.Title,
+			Unit: p.Unit,
+		}
+		switch p.Type {
+		case metrics.Counter:
+			om.Sum = &otlpSum{
+				DataPoints: []otlpNumberDataPoint{{
+					Attributes:        attrs,
+					StartTimeUnixNano: start,
+					TimeUnixNano:      now,
+					AsInt:             strconv.FormatInt(p.Count, 10),
+				}},
+				AggregationTemporality: aggregationTemporalityCumulative,
+				IsMonotonic:            true,
+			}
+		case metrics.Gauge:
+			om.Gauge = &otlpGauge{
+				DataPoints: []otlpNumberDataPoint{{
+					Attributes:   attrs,
+					TimeUnixNano: now,
+					AsInt:        strconv.FormatInt(p.Value, 10),
+				}},
+			}
+		case metrics.Histogram:
+			h := p.Histogram
+			om.Summary = &otlpSummary{
+				DataPoints: []otlpSummaryDataPoint{{
+					Attributes:        attrs,
+					StartTimeUnixNano: start,
+					TimeUnixNano:      now,
+					Count:             strconv.FormatInt(h.Count, 10),
+					Sum:               h.Mean * float64(h.Count),
+					QuantileValues: []otlpQuantile{
+						{0, float64(h.Min)},
+						{0.5, h.Median},
+						{0.75, h.P75},
+						{0.95, h.P95},
+						{0.99, h.P99},
+						{0.999, h.P999},
+						{1, float64(h.Max)},
+					},
+				}},
+			}
+		}
+		ms = append(ms, om)
+	}
+
+	rm.ScopeMetrics = []otlpScopeMetrics{{
+		Scope:   otlpScope{Name: "gobench"},
+		Metrics: ms,
+	}}
+
+	return &otlpRequest{
+		ResourceMetrics: []otlpResourceMetrics{rm},
+	}
+}
+
+func (s *otlp) Write(ctx context.Context, p *Point) error {
+	s.mu.Lock()
+
+	key := otlpSeries{p.EID, p.Group, p.Title}
+	if _, ok := s.starts[key]; !ok {
+		s.starts[key] = p.Time
+	}
+
+	// a new interval of the executor, push the previous one
+	var req *otlpRequest
+	if b := s.batches[p.EID]; len(b) > 0 && b[0].Time != p.Time {
+		req = s.request(b)
+		s.batches[p.EID] = nil
+	}
+	s.batches[p.EID] = append(s.batches[p.EID], p)
+
+	s.mu.Unlock()
+
+	if req == nil {
+		return nil
+	}
+	return s.send(ctx, req)
+}
+
+func (s *otlp) send(ctx context.Context, or *otlpRequest) error {
+	body, err := json.Marshal(or)
+	if err != nil {
+		return err
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, s.url, bytes.NewBuffer(body))
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Content-Type", "application/json")
+	if s.token != "" {
+		req.Header.Set("Authorization", "Bearer "+s.token)
+	}
+
+	res, err := s.client.Do(req)
+	if err != nil {
+		return fmt.Errorf("otlp export: %v", err)
+	}
+	defer res.Body.Close()
+	_, _ = io.Copy(ioutil.Discard, res.Body)
+
+	if res.StatusCode >= 300 {
+		return fmt.Errorf("otlp export: status %d", res.StatusCode)
+	}
+	return nil
+}
+
+// Close pushes the pending intervals
+func (s *otlp) Close() error {
+	s.mu.Lock()
+	reqs := []*otlpRequest{}
+	for eID, b := range s.batches {
+		if len(b) > 0 {
+			reqs = append(reqs, s.request(b))
+		}
+		delete(s.batches, eID)
+	}
+	s.mu.Unlock()
+
+	var err error
+	for _, req := range reqs {
+		if e := s.send(context.Background(), req); e != nil {
+			err = e
+		}
+	}
+
+	s.client.CloseIdleConnections()
+
+	return err
+}
@@ -0,0 +1,94 @@
+package sink
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/gobench-io/gobench/executor/metrics"
+	"github.com/gobench-io/gobench/pb"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestOTLP(t *testing.T) {
+	reqs := make(chan otlpRequest, 2)
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/v1/metrics", r.URL.Path)
+		assert.Equal(t, "application/json", r.Header.Get("Content-Type"))
+
+		var req otlpRequest
+		assert.Nil(t, json.NewDecoder(r.Body).Decode(&req))
+		reqs <- req
+	}))
+	defer ts.Close()
+
+	s, err := New(Config{Type: OTLP, URL: ts.URL + "/v1/metrics"}, "")
+	assert.Nil(t, err)
+
+	ctx := context.Background()
+
+	c := counterPoint(10)
+	c.Unit = "N"
+	g := counterPoint(0)
+	g.Type = metrics.Gauge
+	g.Title = "home.conns"
+	g.Value = 7
+	h := counterPoint(0)
+	h.Type = metrics.Histogram
+	h.Title = "home.latency"
+	h.Histogram = &pb.HistogramValues{
+		Count: 4, Min: 1, Max: 9, Mean: 2.5, Median: 2, P75: 3, P95: 8, P99: 9, P999: 9,
+	}
+
+	// the first interval is pushed when the next one starts
+	for _, p := range []*Point{c, g, h} {
+		assert.Nil(t, s.Write(ctx, p))
+	}
+	assert.Len(t, reqs, 0)
+
+	c2 := counterPoint(30)
+	c2.Time += 10000
+	assert.Nil(t, s.Write(ctx, c2))
+	// the same title in another group starts later
+	o := counterPoint(5)
+	o.Group = "HTTP (about)"
+	o.Time = c2.Time
+	assert.Nil(t, s.Write(ctx, o))
+
+	req := <-reqs
+	assert.Len(t, req.ResourceMetrics, 1)
+	rm := req.ResourceMetrics[0]
+	assert.Contains(t, rm.Resource.Attributes, kv("gobench.application.id", "1"))
+	assert.Contains(t, rm.Resource.Attributes, kv("gobench.application.name", "my app"))
+	assert.Contains(t, rm.Resource.Attributes, kv("gobench.executor.id", "host-1"))
+
+	ms := rm.ScopeMetrics[0].Metrics
+	assert.Len(t, ms, 3)
+
+	assert.Equal(t, "home.http_ok", ms[0].Name)
+	assert.Equal(t, "N", ms[0].Unit)
+	assert.True(t, ms[0].Sum.IsMonotonic)
+	assert.Equal(t, aggregationTemporalityCumulative, ms[0].Sum.AggregationTemporality)
+	assert.Equal(t, "10", ms[0].Sum.DataPoints[0].AsInt)
+	assert.Equal(t, "1600000000000000000", ms[0].Sum.DataPoints[0].TimeUnixNano)
+	assert.Contains(t, ms[0].Sum.DataPoints[0].Attributes, kv("gobench.group", "HTTP (home)"))
+
+	assert.Equal(t, "7", ms[1].Gauge.DataPoints[0].AsInt)
+
+	sdp := ms[2].Summary.DataPoints[0]
+	assert.Equal(t, "4", sdp.Count)
+	assert.Equal(t, 10.0, sdp.Sum)
+	assert.Contains(t, sdp.QuantileValues, otlpQuantile{0.95, 8})
+
+	// closing pushes the pending interval
+	assert.Nil(t, s.Close())
+	req = <-reqs
+	ms = req.ResourceMetrics[0].ScopeMetrics[0].Metrics
+	assert.Len(t, ms, 2)
+	assert.Equal(t, "30", ms[0].Sum.DataPoints[0].AsInt)
+	// the cumulative counter keeps its start time
+	assert.Equal(t, "1600000000000000000", ms[0].Sum.DataPoints[0].StartTimeUnixNano)
+	assert.Equal(t, "1600000010000000000", ms[1].Sum.DataPoints[0].StartTimeUnixNano)
+}
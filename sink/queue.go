@@ -0,0 +1,113 @@
+package sink
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"sync/atomic"
+	"time"
+)
+
+// ErrQueueFull is returned when a point is dropped since the queue of a sink
+// is full
+var ErrQueueFull = errors.New("sink queue is full")
+
+// Queue writes the points to a sink in the background through a bounded
+// queue, so that a slow or down sink never blocks the writer. The points that
+// do not fit in the queue are dropped.
+type Queue struct {
+	s       Sink
+	onError func(error)
+	timeout time.Duration // of the flush on close
+
+	mu     sync.RWMutex
+	closed bool
+	points chan *Point
+
+	ctx     context.Context
+	cancel  context.CancelFunc
+	done    chan struct{}
+	dropped uint64 // access atomically
+}
+
+// NewQueue starts writing the points queued, at most size of them, to a sink.
+// onError receives the write errors of the sink. Close flushes the queue for
+// at most timeout.
+func NewQueue(s Sink, size int, timeout time.Duration, onError func(error)) *Queue {
+	ctx, cancel := context.WithCancel(context.Background())
+	q := &Queue{
+		s:       s,
+		onError: onError,
+		timeout: timeout,
+		points:  make(chan *Point, size),
+		ctx:     ctx,
+		cancel:  cancel,
+		done:    make(chan struct{}),
+	}
+	go q.run()
+	return q
+}
+
+func (q *Queue) run() {
+	defer close(q.done)
+
+	for p := range q.points {
+		// the flush timed out, the rest is dropped
+		if q.ctx.Err() != nil {
+			atomic.AddUint64(&q.dropped, 1)
+			continue
+		}
+		if err := q.s.Write(q.ctx, p); err != nil && q.onError != nil {
+			q.onError(err)
+		}
+	}
+}
+
+// Write queues a point, without blocking. ErrQueueFull is returned when the
+// point is dropped.
+func (q *Queue) Write(ctx context.Context, p *Point) error {
+	q.mu.RLock()
+	defer q.mu.RUnlock()
+
+	if q.closed {
+		return ErrQueueFull
+	}
+	select {
+	case q.points <- p:
+		return nil
+	default:
+		atomic.AddUint64(&q.dropped, 1)
+		return ErrQueueFull
+	}
+}
+
+// Dropped returns the number of points dropped
+func (q *Queue) Dropped() uint64 {
+	return atomic.LoadUint64(&q.dropped)
+}
+
+// Close writes the queued points then closes the sink. The points still
+// queued after the flush timeout are dropped.
+func (q *Queue) Close() error {
+	q.mu.Lock()
+	if q.closed {
+		q.mu.Unlock()
+		return nil
+	}
+	q.closed = true
+	close(q.points)
+	q.mu.Unlock()
+
+	t := time.NewTimer(q.timeout)
+	defer t.Stop()
+
+	select {
+	case <-q.done:
+	case <-t.C:
+		q.cancel()
+		<-q.done
+	}
+	q.cancel()
+
+	return q.s.Close()
+}
@@ -0,0 +1,90 @@
+package sink
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// blockingSink records the points, blocking each write until release is
+// closed or the write is canceled
+type blockingSink struct {
+	mu      sync.Mutex
+	points  []*Point
+	started chan struct{}
+	release chan struct{}
+	closed  bool
+}
+
+func (s *blockingSink) Write(ctx context.Context, p *Point) error {
+	select {
+	case s.started <- struct{}{}:
+	default:
+	}
+	select {
+	case <-s.release:
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.points = append(s.points, p)
+	return nil
+}
+
+func (s *blockingSink) Close() error {
+	s.closed = true
+	return nil
+}
+
+func newBlockingSink() *blockingSink {
+	return &blockingSink{
+		started: make(chan struct{}, 1),
+		release: make(chan struct{}),
+	}
+}
+
+func TestQueueFlush(t *testing.T) {
+	s := newBlockingSink()
+	close(s.release)
+	q := NewQueue(s, 10, time.Second, nil)
+
+	ctx := context.Background()
+	for i := int64(1); i <= 3; i++ {
+		assert.Nil(t, q.Write(ctx, counterPoint(i)))
+	}
+	assert.Nil(t, q.Close())
+
+	assert.True(t, s.closed)
+	assert.Len(t, s.points, 3)
+	assert.Equal(t, int64(3), s.points[2].Count)
+	assert.Equal(t, uint64(0), q.Dropped())
+	assert.True(t, errors.Is(q.Write(ctx, counterPoint(4)), ErrQueueFull))
+}
+
+func TestQueueSlowSink(t *testing.T) {
+	s := newBlockingSink()
+	var errs []error
+	q := NewQueue(s, 2, 50*time.Millisecond, func(err error) { errs = append(errs, err) })
+
+	ctx := context.Background()
+	assert.Nil(t, q.Write(ctx, counterPoint(1)))
+	<-s.started
+
+	// the writes never wait for the sink
+	assert.Nil(t, q.Write(ctx, counterPoint(2)))
+	assert.Nil(t, q.Write(ctx, counterPoint(3)))
+	assert.True(t, errors.Is(q.Write(ctx, counterPoint(4)), ErrQueueFull))
+	assert.Equal(t, uint64(1), q.Dropped())
+
+	// the flush times out, the blocked write is canceled and the rest dropped
+	assert.Nil(t, q.Close())
+	assert.True(t, s.closed)
+	assert.Len(t, s.points, 0)
+	assert.Len(t, errs, 1)
+	assert.Equal(t, uint64(3), q.Dropped())
+}
@@ -0,0 +1,170 @@
+// Package sink forwards the metrics of an application to external systems:
+// InfluxDB, StatsD, a JSON lines file, or an OpenTelemetry collector.
+package sink
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"path/filepath"
+	"strings"
+
+	"github.com/gobench-io/gobench/executor/metrics"
+	"github.com/gobench-io/gobench/pb"
+)
+
+// Sink types
+const (
+	InfluxDB = "influxdb"
+	StatsD   = "statsd"
+	JSONL    = "jsonl"
+	OTLP     = "otlp"
+)
+
+// Error
+var (
+	ErrUnknownType = errors.New("unknown sink type")
+	ErrPath        = errors.New("jsonl sink path must be relative to the sink directory, without ..")
+)
+
+// Point is a metric value reported by an executor
+type Point struct {
+	AppID   int                `json:"appId"`
+	AppName string             `json:"appName"`
+	EID     string             `json:"eId"` // executor ID
+	Group   string             `json:"group"`
+	Graph   string             `json:"graph"`
+	Title   string             `json:"title"`
+	Unit    string             `json:"unit,omitempty"` // graph unit
+	Type    metrics.MetricType `json:"type"`
+	Time    int64              `json:"time"` // ms
+
+	Count     int64               `json:"count,omitempty"`     // counter
+	Value     int64               `json:"value,omitempty"`     // gauge
+	Histogram *pb.HistogramValues `json:"histogram,omitempty"` // histogram
+}
+
+// Sink receives the metric points of an application
+type Sink interface {
+	Write(ctx context.Context, p *Point) error
+	Close() error
+}
+
+// Config describes a sink of an application
+type Config struct {
+	Type string `json:"type"`
+
+	// influxdb: write endpoint, e.g. http://localhost:8086/write?db=gobench
+	// otlp: metrics endpoint, e.g. http://localhost:4318/v1/metrics
+	URL string `json:"url,omitempty"`
+	// influxdb, otlp: optional token sent in the Authorization header. It is
+	// never serialized: the master stores the tokens apart from the configs.
+	Token string `json:"-"`
+	// statsd: host:port of the UDP listener
+	Addr string `json:"addr,omitempty"`
+	// statsd: prefix for every metric name
+	Prefix string `json:"prefix,omitempty"`
+	// jsonl: file to append the points to, relative to the sink directory
+	Path string `json:"path,omitempty"`
+}
+
+// Validate checks the config has all required properties of its type
+func (c Config) Validate() error {
+	switch c.Type {
+	case InfluxDB:
+		if c.URL == "" {
+			return errors.New("influxdb sink requires url")
+		}
+	case StatsD:
+		if c.Addr == "" {
+			return errors.New("statsd sink requires addr")
+		}
+	case JSONL:
+		if c.Path == "" {
+			return errors.New("jsonl sink requires path")
+		}
+		if !isLocal(c.Path) {
+			return ErrPath
+		}
+	case OTLP:
+		if c.URL == "" {
+			return errors.New("otlp sink requires url")
+		}
+	default:
+		return fmt.Errorf("%w: %q", ErrUnknownType, c.Type)
+	}
+	return nil
+}
+
+// isLocal tells if a path stays in the directory it is relative to
+func isLocal(path string) bool {
+	p := filepath.Clean(path)
+	return !filepath.IsAbs(p) && p != "." && p != ".." &&
+		!strings.HasPrefix(p, ".."+string(filepath.Separator))
+}
+
+// New creates a sink given its config, the jsonl files are created in dir
+func New(c Config, dir string) (Sink, error) {
+	if err := c.Validate(); err != nil {
+		return nil, err
+	}
+
+	switch c.Type {
+	case InfluxDB:
+		return newInflux(c), nil
+	case StatsD:
+		return newStatsd(c)
+	case JSONL:
+		return newJSONL(c, dir)
+	case OTLP:
+		return newOTLP(c), nil
+	}
+
+	return nil, ErrUnknownType
+}
+
+// Fanout writes a point to many sinks
+type Fanout []Sink
+
+// NewFanout creates the sinks of all configs, the jsonl files in dir. The
+// created sinks are closed if one of them cannot be created.
+func NewFanout(cs []Config, dir string) (Fanout, error) {
+	f := Fanout{}
+	for _, c := range cs {
+		s, err := New(c, dir)
+		if err != nil {
+			_ = f.Close()
+			return nil, err
+		}
+		f = append(f, s)
+	}
+	return f, nil
+}
+
+// Write writes the point to every sink, and returns all the errors joined
+func (f Fanout) Write(ctx context.Context, p *Point) error {
+	var errs []string
+	for _, s := range f {
+		if err := s.Write(ctx, p); err != nil {
+			errs = append(errs, err.Error())
+		}
+	}
+	if len(errs) > 0 {
+		return errors.New(strings.Join(errs, "; "))
+	}
+	return nil
+}
+
+// Close closes every sink, and returns all the errors joined
+func (f Fanout) Close() error {
+	var errs []string
+	for _, s := range f {
+		if err := s.Close(); err != nil {
+			errs = append(errs, err.Error())
+		}
+	}
+	if len(errs) > 0 {
+		return errors.New(strings.Join(errs, "; "))
+	}
+	return nil
+}
@@ -0,0 +1,193 @@
+package sink
+
+import (
+	"bufio"
+	"context"
+	"encoding/json"
+	"errors"
+	"io/ioutil"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/gobench-io/gobench/executor/metrics"
+	"github.com/gobench-io/gobench/pb"
+	"github.com/stretchr/testify/assert"
+)
+
+func counterPoint(count int64) *Point {
+	return &Point{
+		AppID:   1,
+		AppName: "my app",
+		EID:     "host-1",
+		Group:   "HTTP (home)",
+		Graph:   "HTTP Response",
+		Title:   "home.http_ok",
+		Type:    metrics.Counter,
+		Time:    1600000000000,
+		Count:   count,
+	}
+}
+
+func TestValidate(t *testing.T) {
+	assert.Nil(t, Config{Type: InfluxDB, URL: "http://localhost:8086/write"}.Validate())
+	assert.Nil(t, Config{Type: StatsD, Addr: "localhost:8125"}.Validate())
+	assert.Nil(t, Config{Type: JSONL, Path: "a.jsonl"}.Validate())
+	assert.Nil(t, Config{Type: JSONL, Path: "runs/../a.jsonl"}.Validate())
+
+	assert.Error(t, Config{Type: InfluxDB}.Validate())
+	assert.Error(t, Config{Type: StatsD}.Validate())
+	assert.Error(t, Config{Type: JSONL}.Validate())
+	for _, path := range []string{"/tmp/a.jsonl", "../a.jsonl", "runs/../../a.jsonl", "."} {
+		assert.True(t, errors.Is(Config{Type: JSONL, Path: path}.Validate(), ErrPath), path)
+	}
+	assert.True(t, errors.Is(Config{Type: "kafka"}.Validate(), ErrUnknownType))
+}
+
+func TestLine(t *testing.T) {
+	assert.Equal(t,
+		`gobench_counter,application_id=1,application=my\ app,group=HTTP\ (home),graph=HTTP\ Response,metric=home.http_ok,executor=host-1 count=10i 1600000000000`,
+		Line(counterPoint(10)))
+
+	p := counterPoint(0)
+	p.Type = metrics.Histogram
+	p.Histogram = &pb.HistogramValues{
+		Count: 3, Min: 1, Max: 5, Mean: 2.5, Stddev: 0.5,
+		Median: 2, P75: 3, P95: 4, P99: 5, P999: 5,
+	}
+	assert.Contains(t, Line(p),
+		" count=3i,min=1i,max=5i,mean=2.5,stddev=0.5,median=2,p75=3,p95=4,p99=5,p999=5 1600000000000")
+}
+
+func TestInflux(t *testing.T) {
+	bodies := make(chan string, 2)
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "ms", r.URL.Query().Get("precision"))
+		assert.Equal(t, "gobench", r.URL.Query().Get("db"))
+		assert.Equal(t, "Token secret", r.Header.Get("Authorization"))
+		b, _ := ioutil.ReadAll(r.Body)
+		bodies <- string(b)
+		w.WriteHeader(http.StatusNoContent)
+	}))
+	defer ts.Close()
+
+	s, err := New(Config{Type: InfluxDB, URL: ts.URL + "/write?db=gobench", Token: "secret"}, "")
+	assert.Nil(t, err)
+
+	ctx := context.Background()
+	g := counterPoint(0)
+	g.Type = metrics.Gauge
+	g.Title = "home.conns"
+	g.Value = 7
+
+	// the points of an interval are sent together
+	assert.Nil(t, s.Write(ctx, counterPoint(10)))
+	assert.Nil(t, s.Write(ctx, g))
+	assert.Len(t, bodies, 0)
+
+	next := counterPoint(20)
+	next.Time += 10000
+	assert.Nil(t, s.Write(ctx, next))
+	assert.Equal(t, Line(counterPoint(10))+"\n"+Line(g)+"\n", <-bodies)
+
+	// the last interval is sent on close
+	assert.Nil(t, s.Close())
+	assert.Equal(t, Line(next)+"\n", <-bodies)
+}
+
+func TestInfluxError(t *testing.T) {
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusBadRequest)
+	}))
+	defer ts.Close()
+
+	s, err := New(Config{Type: InfluxDB, URL: ts.URL}, "")
+	assert.Nil(t, err)
+
+	assert.Nil(t, s.Write(context.Background(), counterPoint(10)))
+	assert.EqualError(t, s.Close(), "influxdb write: status 400")
+}
+
+func TestStatsd(t *testing.T) {
+	pc, err := net.ListenPacket("udp", "127.0.0.1:0")
+	assert.Nil(t, err)
+	defer pc.Close()
+
+	s, err := New(Config{Type: StatsD, Addr: pc.LocalAddr().String(), Prefix: "gobench"}, "")
+	assert.Nil(t, err)
+	defer s.Close()
+
+	read := func() string {
+		buf := make([]byte, 1024)
+		_ = pc.SetReadDeadline(time.Now().Add(time.Second))
+		n, _, err := pc.ReadFrom(buf)
+		assert.Nil(t, err)
+		return string(buf[:n])
+	}
+
+	ctx := context.Background()
+
+	// counters are sent as the difference to the previous report
+	assert.Nil(t, s.Write(ctx, counterPoint(10)))
+	assert.Equal(t, "gobench.HTTP_(home).home.http_ok:10|c", read())
+	assert.Nil(t, s.Write(ctx, counterPoint(25)))
+	assert.Equal(t, "gobench.HTTP_(home).home.http_ok:15|c", read())
+
+	g := counterPoint(0)
+	g.Type = metrics.Gauge
+	g.Title = "home.conns"
+	g.Value = 7
+	assert.Nil(t, s.Write(ctx, g))
+	assert.Equal(t, "gobench.HTTP_(home).home.conns.host-1:7|g", read())
+
+	// the gauges of the executors do not overwrite each other
+	g.EID = "host.2"
+	g.Value = 3
+	assert.Nil(t, s.Write(ctx, g))
+	assert.Equal(t, "gobench.HTTP_(home).home.conns.host_2:3|g", read())
+
+	// the same title in another group is another counter
+	o := counterPoint(4)
+	o.Group = "HTTP (about)"
+	assert.Nil(t, s.Write(ctx, o))
+	assert.Equal(t, "gobench.HTTP_(about).home.http_ok:4|c", read())
+}
+
+func TestJSONL(t *testing.T) {
+	dir, err := ioutil.TempDir("", "sink-*")
+	assert.Nil(t, err)
+	defer os.RemoveAll(dir)
+
+	path := filepath.Join(dir, "runs", "points.jsonl")
+
+	f, err := NewFanout([]Config{{Type: JSONL, Path: "runs/points.jsonl"}}, dir)
+	assert.Nil(t, err)
+
+	ctx := context.Background()
+	assert.Nil(t, f.Write(ctx, counterPoint(1)))
+	assert.Nil(t, f.Write(ctx, counterPoint(2)))
+	assert.Nil(t, f.Close())
+
+	file, err := os.Open(path)
+	assert.Nil(t, err)
+	defer file.Close()
+
+	var ps []Point
+	scanner := bufio.NewScanner(file)
+	for scanner.Scan() {
+		var p Point
+		assert.Nil(t, json.Unmarshal(scanner.Bytes(), &p))
+		ps = append(ps, p)
+	}
+	assert.Len(t, ps, 2)
+	assert.Equal(t, *counterPoint(2), ps[1])
+}
+
+func TestNewFanoutInvalid(t *testing.T) {
+	_, err := NewFanout([]Config{{Type: JSONL, Path: "a.jsonl"}, {Type: "kafka"}}, os.TempDir())
+	assert.True(t, errors.Is(err, ErrUnknownType))
+}
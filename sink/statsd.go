@@ -0,0 +1,101 @@
+package sink
+
+import (
+	"context"
+	"fmt"
+	"net"
+	"strings"
+	"sync"
+
+	"github.com/gobench-io/gobench/executor/metrics"
+)
+
+// statsd sends points to a StatsD server over UDP
+// the executor reports cumulative counters, so the sink sends the difference
+// to the previous report of the same executor
+type statsd struct {
+	mu     sync.Mutex
+	conn   net.Conn
+	prefix string
+	last   map[string]int64 // last counter value by executor and metric
+}
+
+func newStatsd(c Config) (*statsd, error) {
+	conn, err := net.Dial("udp", c.Addr)
+	if err != nil {
+		return nil, fmt.Errorf("statsd dial: %v", err)
+	}
+
+	prefix := c.Prefix
+	if prefix != "" && !strings.HasSuffix(prefix, ".") {
+		prefix += "."
+	}
+
+	return &statsd{
+		conn:   conn,
+		prefix: prefix,
+		last:   make(map[string]int64),
+	}, nil
+}
+
+var statsdEscaper = strings.NewReplacer(":", "_", "|", "_", "@", "_", " ", "_", "\n", "_")
+
+// statsdNodeEscaper also escapes the '.' of a name node
+var statsdNodeEscaper = strings.NewReplacer(":", "_", "|", "_", "@", "_", " ", "_", "\n", "_", ".", "_")
+
+// name is the prefix, the group then the title, the titles are unique in a
+// group only
+func (s *statsd) name(p *Point) string {
+	return s.prefix + statsdEscaper.Replace(p.Group) + "." + statsdEscaper.Replace(p.Title)
+}
+
+// executorName is the name followed by the executor. The server sums the
+// counters of the executors, but keeps the last gauge only.
+func (s *statsd) executorName(p *Point) string {
+	return s.name(p) + "." + statsdNodeEscaper.Replace(p.EID)
+}
+
+func (s *statsd) Write(ctx context.Context, p *Point) error {
+	var lines []string
+	name := s.name(p)
+
+	switch p.Type {
+	case metrics.Counter:
+		key := p.EID + "/" + name
+		s.mu.Lock()
+		delta := p.Count - s.last[key]
+		s.last[key] = p.Count
+		s.mu.Unlock()
+
+		lines = append(lines, fmt.Sprintf("%s:%d|c", name, delta))
+	case metrics.Gauge:
+		lines = append(lines, fmt.Sprintf("%s:%d|g", s.executorName(p), p.Value))
+	case metrics.Histogram:
+		// the raw samples are not available, send the summary as gauges
+		h := p.Histogram
+		name := s.executorName(p)
+		for _, v := range []struct {
+			k string
+			v string
+		}{
+			{"count", fmt.Sprint(h.Count)},
+			{"min", fmt.Sprint(h.Min)},
+			{"max", fmt.Sprint(h.Max)},
+			{"mean", formatFloat(h.Mean)},
+			{"median", formatFloat(h.Median)},
+			{"p75", formatFloat(h.P75)},
+			{"p95", formatFloat(h.P95)},
+			{"p99", formatFloat(h.P99)},
+			{"p999", formatFloat(h.P999)},
+		} {
+			lines = append(lines, fmt.Sprintf("%s.%s:%s|g", name, v.k, v.v))
+		}
+	}
+
+	_, err := s.conn.Write([]byte(strings.Join(lines, "\n")))
+	return err
+}
+
+func (s *statsd) Close() error {
+	return s.conn.Close()
+}
@@ -0,0 +1,75 @@
+// Package verdict judges the stats of a run against the stats of a baseline
+// run.
+package verdict
+
+// Verdicts
+const (
+	Pass      = "pass"
+	Regressed = "regressed"
+	Improved  = "improved"
+)
+
+// Check is the comparison of one stat of a metric with the baseline
+type Check struct {
+	Group     string   `json:"group"`
+	Graph     string   `json:"graph"`
+	Metric    string   `json:"metric"`
+	Stat      string   `json:"stat"` // p95, p99 or rate
+	Baseline  float64  `json:"baseline"`
+	Value     float64  `json:"value"`
+	Change    *float64 `json:"change"`    // percent, nil when the baseline is zero
+	Tolerance float64  `json:"tolerance"` // percent
+	Verdict   string   `json:"verdict"`
+}
+
+// Judge sets the change and the verdict of a check. A stat that is better
+// lower (latency, errors) regresses when it grows more than the tolerance, a
+// stat that is better higher (throughput) when it drops more than the
+// tolerance.
+func (c *Check) Judge(lowerIsBetter bool) {
+	c.Change = nil
+
+	if c.Baseline == 0 {
+		switch {
+		case c.Value == 0:
+			c.Verdict = Pass
+		case lowerIsBetter:
+			c.Verdict = Regressed
+		default:
+			c.Verdict = Improved
+		}
+		return
+	}
+
+	change := (c.Value - c.Baseline) / c.Baseline * 100
+	c.Change = &change
+
+	worse := change
+	if !lowerIsBetter {
+		worse = -change
+	}
+
+	switch {
+	case worse > c.Tolerance:
+		c.Verdict = Regressed
+	case worse < -c.Tolerance:
+		c.Verdict = Improved
+	default:
+		c.Verdict = Pass
+	}
+}
+
+// Of returns the verdict of a run: regressed when any check regressed,
+// improved when any check improved and none regressed, pass otherwise
+func Of(checks []Check) string {
+	v := Pass
+	for _, c := range checks {
+		if c.Verdict == Regressed {
+			return Regressed
+		}
+		if c.Verdict == Improved {
+			v = Improved
+		}
+	}
+	return v
+}
@@ -0,0 +1,43 @@
+package verdict
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestJudge(t *testing.T) {
+	for _, tc := range []struct {
+		name          string
+		baseline      float64
+		value         float64
+		lowerIsBetter bool
+		verdict       string
+	}{
+		{"latency within tolerance", 100, 109, true, Pass},
+		{"latency up", 100, 111, true, Regressed},
+		{"latency down", 100, 80, true, Improved},
+		{"throughput within tolerance", 100, 91, false, Pass},
+		{"throughput down", 100, 80, false, Regressed},
+		{"throughput up", 100, 120, false, Improved},
+		{"new errors", 0, 5, true, Regressed},
+		{"no errors", 0, 0, true, Pass},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			c := Check{Baseline: tc.baseline, Value: tc.value, Tolerance: 10}
+			c.Judge(tc.lowerIsBetter)
+			assert.Equal(t, tc.verdict, c.Verdict)
+		})
+	}
+
+	c := Check{Baseline: 200, Value: 250, Tolerance: 10}
+	c.Judge(true)
+	assert.InDelta(t, 25, *c.Change, 0.001)
+}
+
+func TestOf(t *testing.T) {
+	assert.Equal(t, Pass, Of(nil))
+	assert.Equal(t, Pass, Of([]Check{{Verdict: Pass}}))
+	assert.Equal(t, Improved, Of([]Check{{Verdict: Pass}, {Verdict: Improved}}))
+	assert.Equal(t, Regressed, Of([]Check{{Verdict: Improved}, {Verdict: Regressed}}))
+}
@@ -0,0 +1,55 @@
+package web
+
+import (
+	"net/http"
+
+	"github.com/go-chi/render"
+	"github.com/gobench-io/gobench/master"
+)
+
+type dbStatsResponse struct {
+	*master.DbStats
+}
+
+func (dr *dbStatsResponse) Render(w http.ResponseWriter, r *http.Request) error {
+	return nil
+}
+
+type maintenanceResponse struct {
+	*master.MaintenanceReport
+}
+
+func (mr *maintenanceResponse) Render(w http.ResponseWriter, r *http.Request) error {
+	return nil
+}
+
+// getDbStats returns the database size, the retention policy, the points
+// and the estimated size of every application and the last maintenance
+// GET /api/admin/db
+func (h *handler) getDbStats(w http.ResponseWriter, r *http.Request) {
+	s, err := h.s.DbStats(r.Context())
+	if err != nil {
+		render.Render(w, r, ErrInternalServer(err))
+		return
+	}
+
+	if err := render.Render(w, r, &dbStatsResponse{s}); err != nil {
+		render.Render(w, r, ErrRender(err))
+		return
+	}
+}
+
+// maintainDb runs the database maintenance now and returns its report
+// POST /api/admin/db/maintenance
+func (h *handler) maintainDb(w http.ResponseWriter, r *http.Request) {
+	report, err := h.s.Maintain(r.Context())
+	if err != nil {
+		render.Render(w, r, ErrInternalServer(err))
+		return
+	}
+
+	if err := render.Render(w, r, &maintenanceResponse{report}); err != nil {
+		render.Render(w, r, ErrRender(err))
+		return
+	}
+}
@@ -0,0 +1,53 @@
+package web
+
+import (
+	"encoding/json"
+	"net/http"
+	"testing"
+
+	"github.com/gobench-io/gobench/master"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestGetDbStats(t *testing.T) {
+	app := newApp(t, "db stats", "scenario")
+	_, _, m := newAPITestMaster(t, "")
+	seedAppMetrics(t, m.DbClient(), app, "e1", 1000, 2)
+
+	r, w := newAPITest(t, "")
+	req, _ := http.NewRequest("GET", "/api/admin/db", nil)
+	r.ServeHTTP(w, req)
+
+	assert.Equal(t, 200, w.Code)
+
+	var s master.DbStats
+	assert.Nil(t, json.Unmarshal(w.Body.Bytes(), &s))
+	assert.True(t, s.Size > 0)
+
+	var as *master.AppDbStats
+	for _, a := range s.Applications {
+		if a.ID == app.ID {
+			as = a
+		}
+	}
+	if assert.NotNil(t, as) {
+		assert.True(t, as.Points > 0)
+		assert.Equal(t, as.Counters+as.Histograms+as.Gauges, as.Points)
+	}
+}
+
+func TestMaintainDb(t *testing.T) {
+	r, w := newAPITest(t, "")
+	req, _ := http.NewRequest("POST", "/api/admin/db/maintenance", nil)
+	r.ServeHTTP(w, req)
+
+	assert.Equal(t, 200, w.Code)
+
+	var report master.MaintenanceReport
+	assert.Nil(t, json.Unmarshal(w.Body.Bytes(), &report))
+	assert.Equal(t, "", report.Error)
+	// the test master keeps the points forever
+	assert.Equal(t, 0, report.Downsampled)
+	assert.Equal(t, 0, report.Deleted)
+	assert.True(t, report.SizeAfter > 0)
+}
@@ -12,6 +12,8 @@ import (
 	"github.com/gobench-io/gobench/ent"
 	"github.com/gobench-io/gobench/ent/application"
 	"github.com/gobench-io/gobench/master"
+
+	entAudit "github.com/gobench-io/gobench/ent/audit"
 )
 
 func (h *handler) applicationCtx(next http.Handler) http.Handler {
@@ -24,7 +26,8 @@ func (h *handler) applicationCtx(next http.Handler) http.Handler {
 
 		app, err := h.db().Application.
 			Query().
-			Where(application.ID(appID)).
+			Where(application.ID(appID), visible(r)).
+			WithProject().
 			Only(r.Context())
 
 		if err != nil {
@@ -39,8 +42,17 @@ func (h *handler) applicationCtx(next http.Handler) http.Handler {
 func (h *handler) countApplications(w http.ResponseWriter, r *http.Request) {
 	ctx := r.Context()
 	keyword := r.URL.Query().Get("keyword")
+	inProject, err := projectFilter(r)
+	if err != nil {
+		render.Render(w, r, ErrInvalidRequest(err))
+		return
+	}
 	q := h.db().Application.
-		Query()
+		Query().
+		Where(visible(r))
+	if inProject != nil {
+		q = q.Where(inProject)
+	}
 	if keyword != "" {
 		q = q.Where(application.NameContains(keyword))
 	}
@@ -71,9 +83,19 @@ func (h *handler) listApplications(w http.ResponseWriter, r *http.Request) {
 	if order == "" {
 		order = "created_at"
 	}
+	inProject, err := projectFilter(r)
+	if err != nil {
+		render.Render(w, r, ErrInvalidRequest(err))
+		return
+	}
 	q := h.db().Application.
 		Query().
-		WithTags()
+		Where(visible(r)).
+		WithTags().
+		WithProject()
+	if inProject != nil {
+		q = q.Where(inProject)
+	}
 	if keyword != "" {
 		q = q.Where(application.NameContains(keyword))
 	}
@@ -138,15 +160,42 @@ func (h *handler) createApplication(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	app, err := h.s.NewApplication(r.Context(), data.Name, scenario, gomod, gosum)
+	sinks := data.sinks()
+	for _, sc := range sinks {
+		if err := sc.Validate(); err != nil {
+			render.Render(w, r, ErrInvalidRequest(err))
+			return
+		}
+	}
 
+	projectID, err := h.requestProject(r, data.ProjectID)
 	if err != nil {
+		if errors.Is(err, errNotMember) {
+			render.Render(w, r, ErrForbidden(err))
+			return
+		}
 		render.Render(w, r, ErrInternalServer(err))
 		return
 	}
 
+	app, err := h.s.NewApplication(r.Context(), data.Name, scenario, gomod, gosum,
+		master.WithSinks(sinks), master.WithProject(projectID))
+
+	if err != nil {
+		if errors.Is(err, master.ErrProjectQueueFull) {
+			render.Render(w, r, ErrTooManyRequests(err))
+			return
+		}
+		render.Render(w, r, ErrInternalServer(err))
+		return
+	}
+
+	h.audit(r, master.AuditEntry{Action: entAudit.ActionCreate, ApplicationID: app.ID})
+
+	ar := newApplicationResponse(app)
+	ar.ProjectID = projectID
 	render.Status(r, http.StatusCreated)
-	render.Render(w, r, newApplicationResponse(app))
+	render.Render(w, r, ar)
 }
 
 func (h *handler) getApplication(w http.ResponseWriter, r *http.Request) {
@@ -224,6 +273,7 @@ func (h *handler) cancelApplication(w http.ResponseWriter, r *http.Request) {
 		render.Render(w, r, ErrInternalServer(err))
 		return
 	}
+	h.audit(r, master.AuditEntry{Action: entAudit.ActionCancel, ApplicationID: app.ID})
 
 	if err := render.Render(w, r, newApplicationResponse(na)); err != nil {
 		render.Render(w, r, ErrRender(err))
@@ -242,6 +292,7 @@ func (h *handler) deleteApplication(w http.ResponseWriter, r *http.Request) {
 		http.Error(w, http.StatusText(400), 400)
 		return
 	}
+	h.audit(r, master.AuditEntry{Action: entAudit.ActionDelete, ApplicationID: app.ID})
 
 	if err := render.Render(w, r, newApplicationResponse(nil)); err != nil {
 		render.Render(w, r, ErrRender(err))
@@ -257,6 +308,10 @@ func (h *handler) addApplicationTag(w http.ResponseWriter, r *http.Request) {
 		http.Error(w, http.StatusText(422), 422)
 		return
 	}
+	if app.Imported {
+		render.Render(w, r, ErrInvalidRequest(master.ErrAppIsReadOnly))
+		return
+	}
 
 	data := &tagRequest{}
 	if err := render.Bind(r, data); err != nil {
@@ -283,6 +338,7 @@ func (h *handler) addApplicationTag(w http.ResponseWriter, r *http.Request) {
 		render.Render(w, r, ErrInternalServer(err))
 		return
 	}
+	h.audit(r, master.AuditEntry{Action: entAudit.ActionTag, ApplicationID: app.ID, Tag: tag.Name})
 
 	if err := render.Render(w, r, newTagResponse(tag)); err != nil {
 		render.Render(w, r, ErrRender(err))
@@ -302,13 +358,17 @@ func (h *handler) removeApplicationTag(w http.ResponseWriter, r *http.Request) {
 		render.Render(w, r, ErrNotFoundRequest(errors.New("Application not found")))
 		return
 	}
+	if app.Imported {
+		render.Render(w, r, ErrInvalidRequest(master.ErrAppIsReadOnly))
+		return
+	}
 	tagID, err := strconv.Atoi(chi.URLParam(r, "tagID"))
 	if err != nil {
 		render.Render(w, r, ErrNotFoundRequest(err))
 		return
 	}
-	// get tags
-	tag, err := h.s.GetTagByID(ctx, tagID)
+	// only a tag of the application in the URL
+	tag, err := h.s.GetApplicationTagByID(ctx, app.ID, tagID)
 	if err != nil {
 		render.Render(w, r, ErrInternalServer(err))
 		return
@@ -322,6 +382,7 @@ func (h *handler) removeApplicationTag(w http.ResponseWriter, r *http.Request) {
 		render.Render(w, r, ErrInternalServer(err))
 		return
 	}
+	h.audit(r, master.AuditEntry{Action: entAudit.ActionUntag, ApplicationID: app.ID, Tag: tag.Name})
 	if err := render.Render(w, r, newTagResponse(tag)); err != nil {
 		render.Render(w, r, ErrRender(err))
 		return
@@ -338,7 +399,7 @@ func (h *handler) getApplicationSystemLog(w http.ResponseWriter, r *http.Request
 
 	_, sl, _ := h.s.Logpaths(app.ID)
 
-	http.ServeFile(w, r, sl)
+	h.serveLog(w, r, app, sl)
 }
 
 func (h *handler) getApplicationUserLog(w http.ResponseWriter, r *http.Request) {
@@ -351,5 +412,5 @@ func (h *handler) getApplicationUserLog(w http.ResponseWriter, r *http.Request)
 
 	_, _, ul := h.s.Logpaths(app.ID)
 
-	http.ServeFile(w, r, ul)
+	h.serveLog(w, r, app, ul)
 }
@@ -0,0 +1,102 @@
+package web
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"strconv"
+	"strings"
+
+	"github.com/go-chi/render"
+	"github.com/gobench-io/gobench/ent"
+	"github.com/gobench-io/gobench/master"
+
+	entAudit "github.com/gobench-io/gobench/ent/audit"
+)
+
+// archiveFormField is the multipart field of an uploaded archive
+const archiveFormField = "archive"
+
+// maxArchiveSize bounds the bytes of an uploaded archive
+var maxArchiveSize int64 = 1 << 30
+
+// getApplicationArchive downloads an application as a tar.gz archive
+// GET /api/applications/{id}/archive
+func (h *handler) getApplicationArchive(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	app, ok := ctx.Value(webKey("application")).(*ent.Application)
+	if !ok {
+		http.Error(w, http.StatusText(422), 422)
+		return
+	}
+
+	if !master.IsTerminal(app.Status) {
+		render.Render(w, r, ErrInvalidRequest(
+			fmt.Errorf("%w: %s", master.ErrArchiveUnfinished, app.Status)))
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/gzip")
+	w.Header().Set("Content-Disposition",
+		fmt.Sprintf(`attachment; filename="application-%d.tar.gz"`, app.ID))
+
+	// the response is already streaming, the error can only be logged
+	if err := h.s.ExportArchive(ctx, app.ID, w); err != nil {
+		h.logger.Errorw("failed archive application", "application id", app.ID, "err", err)
+	}
+}
+
+// importApplication creates a read-only application from an archive. The
+// archive is the request body or the "archive" field of a multipart form.
+// The application goes to the "project" query parameter, or the default
+// project.
+// POST /api/applications/import?project=
+func (h *handler) importApplication(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	projectID := 0
+	if s := r.URL.Query().Get("project"); s != "" {
+		id, err := strconv.Atoi(s)
+		if err != nil {
+			render.Render(w, r, ErrInvalidRequest(errors.New("invalid project")))
+			return
+		}
+		projectID = id
+	}
+	projectID, err := h.requestProject(r, projectID)
+	if err != nil {
+		if errors.Is(err, errNotMember) {
+			render.Render(w, r, ErrForbidden(err))
+			return
+		}
+		render.Render(w, r, ErrInternalServer(err))
+		return
+	}
+
+	r.Body = http.MaxBytesReader(w, r.Body, maxArchiveSize)
+	var body io.Reader = r.Body
+	if strings.HasPrefix(r.Header.Get("Content-Type"), "multipart/form-data") {
+		f, _, err := r.FormFile(archiveFormField)
+		if err != nil {
+			render.Render(w, r, ErrInvalidRequest(err))
+			return
+		}
+		defer f.Close()
+		body = f
+	}
+
+	app, err := h.s.ImportArchive(ctx, body, master.WithProject(projectID))
+	if err != nil {
+		if errors.Is(err, master.ErrArchiveInvalid) {
+			render.Render(w, r, ErrInvalidRequest(err))
+			return
+		}
+		render.Render(w, r, ErrInternalServer(err))
+		return
+	}
+	h.audit(r, master.AuditEntry{Action: entAudit.ActionImport, ApplicationID: app.ID})
+
+	render.Status(r, http.StatusCreated)
+	render.Render(w, r, newApplicationResponse(app))
+}
@@ -0,0 +1,107 @@
+package web
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/gobench-io/gobench/ent"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestApplicationArchive(t *testing.T) {
+	app := newApp(t, "archive", "scenario")
+	_, _, m := newAPITestMaster(t, "")
+	seedAppMetrics(t, m.DbClient(), app, "e1", 1000, 2)
+
+	t.Run("unfinished application", func(t *testing.T) {
+		r, w := newAPITest(t, "")
+		req, _ := http.NewRequest("GET", fmt.Sprintf("/api/applications/%d/archive", app.ID), nil)
+		r.ServeHTTP(w, req)
+
+		assert.Equal(t, 400, w.Code)
+	})
+
+	_, err := m.DbClient().Application.UpdateOneID(app.ID).
+		SetStatus("finished").Save(context.Background())
+	assert.Nil(t, err)
+
+	r, w := newAPITest(t, "")
+	req, _ := http.NewRequest("GET", fmt.Sprintf("/api/applications/%d/archive", app.ID), nil)
+	r.ServeHTTP(w, req)
+
+	assert.Equal(t, 200, w.Code)
+	assert.Equal(t, "application/gzip", w.Header().Get("Content-Type"))
+	assert.Contains(t, w.Header().Get("Content-Disposition"),
+		fmt.Sprintf("application-%d.tar.gz", app.ID))
+	archive := w.Body.Bytes()
+
+	imported := &ent.Application{}
+	t.Run("import", func(t *testing.T) {
+		r, w := newAPITest(t, "")
+		req, _ := http.NewRequest("POST", "/api/applications/import", bytes.NewReader(archive))
+		req.Header.Set("Content-Type", "application/gzip")
+		r.ServeHTTP(w, req)
+
+		assert.Equal(t, 201, w.Code)
+		assert.Nil(t, json.Unmarshal(w.Body.Bytes(), imported))
+		assert.NotEqual(t, app.ID, imported.ID)
+		assert.Equal(t, "archive", imported.Name)
+		assert.Equal(t, "finished", imported.Status)
+		assert.True(t, imported.Imported)
+	})
+
+	t.Run("outlives the request timeout", func(t *testing.T) {
+		defer func(d time.Duration) { requestTimeout = d }(requestTimeout)
+		requestTimeout = time.Nanosecond
+
+		r, w := newAPITest(t, "")
+		req, _ := http.NewRequest("GET", fmt.Sprintf("/api/applications/%d/archive", app.ID), nil)
+		r.ServeHTTP(w, req)
+		assert.Equal(t, 200, w.Code)
+
+		// the archive downloaded is whole
+		body := w.Body.Bytes()
+		w = httptest.NewRecorder()
+		req, _ = http.NewRequest("POST", "/api/applications/import", bytes.NewReader(body))
+		r.ServeHTTP(w, req)
+		assert.Equal(t, 201, w.Code)
+	})
+
+	t.Run("imported application is read-only", func(t *testing.T) {
+		r, w := newAPITest(t, "")
+		reqBody, _ := json.Marshal(map[string]string{"name": "foo"})
+		req, _ := http.NewRequest("PUT",
+			fmt.Sprintf("/api/applications/%d/tags", imported.ID),
+			bytes.NewBuffer(reqBody))
+		req.Header.Set("Content-Type", "application/json")
+		r.ServeHTTP(w, req)
+
+		assert.Equal(t, 400, w.Code)
+	})
+
+	t.Run("archive too large", func(t *testing.T) {
+		defer func(n int64) { maxArchiveSize = n }(maxArchiveSize)
+		maxArchiveSize = int64(len(archive) - 1)
+
+		r, w := newAPITest(t, "")
+		req, _ := http.NewRequest("POST", "/api/applications/import", bytes.NewReader(archive))
+		r.ServeHTTP(w, req)
+
+		assert.Equal(t, 400, w.Code)
+	})
+
+	t.Run("invalid archive", func(t *testing.T) {
+		r, w := newAPITest(t, "")
+		req, _ := http.NewRequest("POST", "/api/applications/import",
+			bytes.NewBufferString("not an archive"))
+		r.ServeHTTP(w, req)
+
+		assert.Equal(t, 400, w.Code)
+	})
+}
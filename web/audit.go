@@ -0,0 +1,142 @@
+package web
+
+import (
+	"context"
+	"errors"
+	"net"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/go-chi/render"
+
+	"github.com/gobench-io/gobench/ent"
+	"github.com/gobench-io/gobench/master"
+
+	entAudit "github.com/gobench-io/gobench/ent/audit"
+)
+
+// peerAddr keeps the address of the peer of a request before RealIP replaces
+// it with the proxy headers, which any client can forge
+func peerAddr(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		ctx := context.WithValue(r.Context(), webKey("peerAddr"), r.RemoteAddr)
+		next.ServeHTTP(w, r.WithContext(ctx))
+	})
+}
+
+// requestIP returns the address of the peer of a request
+func requestIP(r *http.Request) string {
+	addr, ok := r.Context().Value(webKey("peerAddr")).(string)
+	if !ok {
+		addr = r.RemoteAddr
+	}
+	host, _, err := net.SplitHostPort(addr)
+	if err != nil {
+		return addr
+	}
+	return host
+}
+
+// audit records an action of the user of a request. A failed record is
+// logged, the action is done already.
+func (h *handler) audit(r *http.Request, e master.AuditEntry) {
+	if e.Username == "" {
+		e.Username = currentUser(r).Username
+	}
+	e.IP = requestIP(r)
+	if _, err := h.s.Audit(r.Context(), e); err != nil {
+		h.logger.Errorw("failed record the audit",
+			"action", e.Action,
+			"username", e.Username,
+			"err", err,
+		)
+	}
+}
+
+// auditFilter reads the filter of the audits: user, action, app, tag, from and
+// end (ms), before and limit
+func auditFilter(r *http.Request) (f master.AuditFilter, err error) {
+	v := r.URL.Query()
+
+	f.Username = v.Get("user")
+	f.Action = entAudit.Action(v.Get("action"))
+	f.Tag = v.Get("tag")
+
+	ints := []struct {
+		name string
+		to   *int
+	}{
+		{"app", &f.ApplicationID},
+		{"before", &f.Before},
+		{"limit", &f.Limit},
+	}
+	for _, p := range ints {
+		if s := v.Get(p.name); s != "" {
+			if *p.to, err = strconv.Atoi(s); err != nil || *p.to <= 0 {
+				return f, errors.New("invalid " + p.name)
+			}
+		}
+	}
+
+	times := []struct {
+		name string
+		to   *time.Time
+	}{
+		{"from", &f.From},
+		{"end", &f.End},
+	}
+	for _, p := range times {
+		if s := v.Get(p.name); s != "" {
+			ms, err := strconv.ParseInt(s, 10, 64)
+			if err != nil {
+				return f, errors.New("invalid " + p.name)
+			}
+			*p.to = time.Unix(0, ms*int64(time.Millisecond))
+		}
+	}
+
+	return f, f.Validate()
+}
+
+// audit response
+type auditResponse struct {
+	*ent.Audit
+}
+
+func (ar *auditResponse) Render(w http.ResponseWriter, r *http.Request) error {
+	return nil
+}
+
+// listAudits returns a page of the audits, the latest first, with the ID to
+// pass as ?before= for the next page in the X-Next-Cursor header
+// GET /api/audit
+func (h *handler) listAudits(w http.ResponseWriter, r *http.Request) {
+	f, err := auditFilter(r)
+	if err != nil {
+		render.Render(w, r, ErrInvalidRequest(err))
+		return
+	}
+	as, err := h.s.ListAudits(r.Context(), f)
+	if err != nil {
+		render.Render(w, r, ErrInternalServer(err))
+		return
+	}
+
+	limit := f.Limit
+	if limit == 0 {
+		limit = master.DefaultAuditLimit
+	}
+	if len(as) == limit {
+		w.Header().Set(nextCursorHeader, strconv.Itoa(as[len(as)-1].ID))
+	}
+
+	list := []render.Renderer{}
+	for _, a := range as {
+		list = append(list, &auditResponse{a})
+	}
+	if err := render.RenderList(w, r, list); err != nil {
+		render.Render(w, r, ErrRender(err))
+		return
+	}
+}
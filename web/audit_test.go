@@ -0,0 +1,99 @@
+package web
+
+import (
+	"bytes"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/gobench-io/gobench/ent"
+	"github.com/gobench-io/gobench/master"
+	"github.com/stretchr/testify/assert"
+
+	entAudit "github.com/gobench-io/gobench/ent/audit"
+	entUser "github.com/gobench-io/gobench/ent/user"
+)
+
+func TestAudit(t *testing.T) {
+	r, _, m := newAPITestMaster(t, "adminPassword")
+	admin := login(t, r, master.AdminUsername, "adminPassword")
+
+	u := seedUser(t, m, entUser.RoleRunner)
+
+	// the proxy headers of the login are forged
+	w := httptest.NewRecorder()
+	reqBody, _ := json.Marshal(map[string]string{"username": u.Username, "password": "password"})
+	req, _ := http.NewRequest("POST", "/api/users/login", bytes.NewBuffer(reqBody))
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set("X-Real-IP", "203.0.113.9")
+	req.Header.Set("X-Forwarded-For", "203.0.113.9")
+	req.RemoteAddr = "192.0.2.7:41000"
+	r.ServeHTTP(w, req)
+	assert.Equal(t, 200, w.Code)
+	act := new(accesstokenResponse)
+	_ = json.Unmarshal(w.Body.Bytes(), act)
+	token := act.ID
+
+	w = serveAs(r, token, "POST", "/api/applications", map[string]interface{}{
+		"name":     "audited",
+		"scenario": base64.StdEncoding.EncodeToString([]byte("scenario")),
+	})
+	assert.Equal(t, 201, w.Code)
+	var app applicationResponse
+	_ = json.Unmarshal(w.Body.Bytes(), &app)
+	appURL := fmt.Sprintf("/api/applications/%d", app.ID)
+
+	assert.Equal(t, 200, serveAs(r, token, "PUT", appURL+"/tags",
+		map[string]string{"name": "nightly"}).Code)
+	assert.Equal(t, 200, serveAs(r, token, "PUT", appURL+"/cancel", nil).Code)
+	assert.Equal(t, 200, serveAs(r, token, "DELETE", appURL, nil).Code)
+
+	audits := func(query string) []*ent.Audit {
+		w := serveAs(r, admin, "GET", "/api/audit?"+query, nil)
+		assert.Equal(t, 200, w.Code)
+		var as []*ent.Audit
+		_ = json.Unmarshal(w.Body.Bytes(), &as)
+		return as
+	}
+
+	t.Run("actions of a user", func(t *testing.T) {
+		as := audits("user=" + u.Username)
+		actions := []entAudit.Action{}
+		for _, a := range as {
+			actions = append(actions, a.Action)
+		}
+		assert.Equal(t, []entAudit.Action{entAudit.ActionDelete, entAudit.ActionCancel,
+			entAudit.ActionTag, entAudit.ActionCreate, entAudit.ActionLogin}, actions)
+		assert.Equal(t, "192.0.2.7", as[len(as)-1].IP)
+		assert.Equal(t, "nightly", as[2].Tag)
+	})
+
+	t.Run("filters", func(t *testing.T) {
+		as := audits(fmt.Sprintf("app=%d&action=cancel", app.ID))
+		assert.Len(t, as, 1)
+		assert.Equal(t, u.Username, as[0].Username)
+
+		w := serveAs(r, admin, "GET", "/api/audit?user="+u.Username+"&limit=2", nil)
+		assert.Equal(t, 200, w.Code)
+		before := w.Header().Get(nextCursorHeader)
+		assert.NotEmpty(t, before)
+		as = audits("user=" + u.Username + "&before=" + before)
+		assert.Len(t, as, 3)
+
+		assert.Equal(t, 400, serveAs(r, admin, "GET", "/api/audit?action=launch", nil).Code)
+	})
+
+	t.Run("failed login", func(t *testing.T) {
+		assert.Equal(t, 401, serveAs(r, "", "POST", "/api/users/login",
+			map[string]string{"username": u.Username, "password": "wrong"}).Code)
+		as := audits("user=" + u.Username + "&action=login_failed")
+		assert.Len(t, as, 1)
+	})
+
+	t.Run("admin only", func(t *testing.T) {
+		assert.Equal(t, 403, serveAs(r, token, "GET", "/api/audit", nil).Code)
+	})
+}
@@ -0,0 +1,210 @@
+package web
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"strconv"
+
+	"github.com/go-chi/chi"
+	"github.com/go-chi/render"
+	"github.com/gobench-io/gobench/ent"
+	"github.com/gobench-io/gobench/ent/application"
+	"github.com/gobench-io/gobench/master"
+)
+
+// baseline request
+type baselineRequest struct {
+	ApplicationID int                `json:"applicationId"`
+	Tag           string             `json:"tag"`
+	Tolerance     *float64           `json:"tolerance"`
+	Tolerances    map[string]float64 `json:"tolerances"`
+}
+
+func (br *baselineRequest) Bind(r *http.Request) error {
+	if br.ApplicationID == 0 {
+		return errors.New("applicationId required")
+	}
+	if br.Tag == "" {
+		return errors.New("tag required")
+	}
+	if br.Tolerance == nil {
+		t := master.DefaultTolerance
+		br.Tolerance = &t
+	}
+	if *br.Tolerance < 0 {
+		return errors.New("tolerance must not be negative")
+	}
+	for _, t := range br.Tolerances {
+		if t < 0 {
+			return errors.New("tolerance must not be negative")
+		}
+	}
+	return nil
+}
+
+// baseline response
+type baselineResponse struct {
+	*ent.Baseline
+	ApplicationID int       `json:"applicationId"`
+	Edges         *struct{} `json:"edges,omitempty"`
+}
+
+func (br *baselineResponse) Render(w http.ResponseWriter, r *http.Request) error {
+	return nil
+}
+
+func newBaselineResponse(b *ent.Baseline) *baselineResponse {
+	br := &baselineResponse{Baseline: b}
+	if b.Edges.Application != nil {
+		br.ApplicationID = b.Edges.Application.ID
+	}
+	return br
+}
+
+func newBaselineListResponse(bs []*ent.Baseline) []render.Renderer {
+	list := []render.Renderer{}
+	for _, b := range bs {
+		list = append(list, newBaselineResponse(b))
+	}
+	return list
+}
+
+// baselineCtx loads a baseline of an application the user of the request
+// sees
+func (h *handler) baselineCtx(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		id, err := strconv.Atoi(chi.URLParam(r, "baselineID"))
+		if err != nil {
+			render.Render(w, r, ErrNotFoundRequest(err))
+			return
+		}
+		b, err := h.s.GetBaseline(r.Context(), id)
+		if err != nil {
+			if ent.IsNotFound(err) {
+				render.Render(w, r, ErrNotFoundRequest(err))
+				return
+			}
+			render.Render(w, r, ErrInternalServer(err))
+			return
+		}
+		ok := b.Edges.Application != nil
+		if ok {
+			ok, err = h.appVisible(r, b.Edges.Application.ID)
+		}
+		if err != nil {
+			render.Render(w, r, ErrInternalServer(err))
+			return
+		}
+		if !ok {
+			render.Render(w, r, ErrNotFoundRequest(errNotMember))
+			return
+		}
+
+		ctx := context.WithValue(r.Context(), webKey("baseline"), b)
+		next.ServeHTTP(w, r.WithContext(ctx))
+	})
+}
+
+func (h *handler) listBaselines(w http.ResponseWriter, r *http.Request) {
+	all, err := h.s.ListBaselines(r.Context())
+	if err != nil {
+		render.Render(w, r, ErrInternalServer(err))
+		return
+	}
+	ids := []int{}
+	for _, b := range all {
+		if b.Edges.Application != nil {
+			ids = append(ids, b.Edges.Application.ID)
+		}
+	}
+	visibleIDs, err := h.db().Application.
+		Query().
+		Where(application.IDIn(ids...), visible(r)).
+		IDs(r.Context())
+	if err != nil {
+		render.Render(w, r, ErrInternalServer(err))
+		return
+	}
+	seen := map[int]bool{}
+	for _, id := range visibleIDs {
+		seen[id] = true
+	}
+	bs := []*ent.Baseline{}
+	for _, b := range all {
+		if b.Edges.Application != nil && seen[b.Edges.Application.ID] {
+			bs = append(bs, b)
+		}
+	}
+
+	if err := render.RenderList(w, r, newBaselineListResponse(bs)); err != nil {
+		render.Render(w, r, ErrRender(err))
+		return
+	}
+}
+
+// setBaseline makes an application the baseline of a tag
+// POST /api/baselines
+func (h *handler) setBaseline(w http.ResponseWriter, r *http.Request) {
+	data := &baselineRequest{}
+	if err := render.Bind(r, data); err != nil {
+		render.Render(w, r, ErrInvalidRequest(err))
+		return
+	}
+	ok, err := h.appVisible(r, data.ApplicationID)
+	if err != nil {
+		render.Render(w, r, ErrInternalServer(err))
+		return
+	}
+	if !ok {
+		render.Render(w, r, ErrNotFoundRequest(errNotMember))
+		return
+	}
+
+	b, err := h.s.SetBaseline(r.Context(), data.ApplicationID, data.Tag,
+		*data.Tolerance, data.Tolerances)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			render.Render(w, r, ErrNotFoundRequest(err))
+			return
+		}
+		if errors.Is(err, master.ErrBaselineUnfinished) {
+			render.Render(w, r, ErrInvalidRequest(err))
+			return
+		}
+		render.Render(w, r, ErrInternalServer(err))
+		return
+	}
+
+	render.Status(r, http.StatusCreated)
+	render.Render(w, r, newBaselineResponse(b))
+}
+
+func (h *handler) getBaseline(w http.ResponseWriter, r *http.Request) {
+	b, ok := r.Context().Value(webKey("baseline")).(*ent.Baseline)
+	if !ok {
+		http.Error(w, http.StatusText(422), 422)
+		return
+	}
+	if err := render.Render(w, r, newBaselineResponse(b)); err != nil {
+		render.Render(w, r, ErrRender(err))
+		return
+	}
+}
+
+func (h *handler) deleteBaseline(w http.ResponseWriter, r *http.Request) {
+	b, ok := r.Context().Value(webKey("baseline")).(*ent.Baseline)
+	if !ok {
+		http.Error(w, http.StatusText(422), 422)
+		return
+	}
+	if err := h.s.DeleteBaseline(r.Context(), b.ID); err != nil {
+		if ent.IsNotFound(err) {
+			render.Render(w, r, ErrNotFoundRequest(err))
+			return
+		}
+		render.Render(w, r, ErrInternalServer(err))
+		return
+	}
+	w.WriteHeader(http.StatusNoContent)
+}
@@ -0,0 +1,94 @@
+package web
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestBaselines(t *testing.T) {
+	app := newApp(t, "baseline", "scenario")
+	pending := newApp(t, "baseline pending", "scenario")
+	_, _, m := newAPITestMaster(t, "")
+	_, err := m.DbClient().Application.UpdateOneID(app.ID).
+		SetStatus("finished").Save(context.Background())
+	assert.Nil(t, err)
+
+	post := func(body map[string]interface{}) (int, *baselineResponse) {
+		r, w := newAPITest(t, "")
+		reqBody, _ := json.Marshal(body)
+		req, _ := http.NewRequest("POST", "/api/baselines", bytes.NewBuffer(reqBody))
+		req.Header.Set("Content-Type", "application/json")
+		r.ServeHTTP(w, req)
+
+		br := &baselineResponse{}
+		_ = json.Unmarshal(w.Body.Bytes(), br)
+		return w.Code, br
+	}
+
+	code, b := post(map[string]interface{}{
+		"applicationId": app.ID,
+		"tag":           "web-baseline",
+		"tolerances":    map[string]float64{"home.latency": 20},
+	})
+	assert.Equal(t, 201, code)
+	assert.Equal(t, app.ID, b.ApplicationID)
+	assert.Equal(t, "web-baseline", b.Tag)
+	assert.Equal(t, 10.0, b.Tolerance)
+	assert.Equal(t, 20.0, b.Tolerances["home.latency"])
+
+	t.Run("invalid requests", func(t *testing.T) {
+		code, _ := post(map[string]interface{}{"applicationId": app.ID})
+		assert.Equal(t, 400, code)
+		code, _ = post(map[string]interface{}{
+			"applicationId": app.ID, "tag": "web-baseline", "tolerance": -1,
+		})
+		assert.Equal(t, 400, code)
+		code, _ = post(map[string]interface{}{"applicationId": pending.ID, "tag": "web-baseline"})
+		assert.Equal(t, 400, code)
+		code, _ = post(map[string]interface{}{"applicationId": app.ID + 1000000, "tag": "web-baseline"})
+		assert.Equal(t, 404, code)
+	})
+
+	t.Run("list", func(t *testing.T) {
+		r, w := newAPITest(t, "")
+		req, _ := http.NewRequest("GET", "/api/baselines", nil)
+		r.ServeHTTP(w, req)
+
+		assert.Equal(t, 200, w.Code)
+		var bs []baselineResponse
+		assert.Nil(t, json.Unmarshal(w.Body.Bytes(), &bs))
+		found := false
+		for _, lb := range bs {
+			if lb.ID == b.ID {
+				found = true
+			}
+		}
+		assert.True(t, found)
+	})
+
+	t.Run("get", func(t *testing.T) {
+		r, w := newAPITest(t, "")
+		req, _ := http.NewRequest("GET", fmt.Sprintf("/api/baselines/%d", b.ID), nil)
+		r.ServeHTTP(w, req)
+
+		assert.Equal(t, 200, w.Code)
+	})
+
+	t.Run("delete", func(t *testing.T) {
+		r, w := newAPITest(t, "")
+		req, _ := http.NewRequest("DELETE", fmt.Sprintf("/api/baselines/%d", b.ID), nil)
+		r.ServeHTTP(w, req)
+		assert.Equal(t, 204, w.Code)
+
+		r, w = newAPITest(t, "")
+		req, _ = http.NewRequest("GET", fmt.Sprintf("/api/baselines/%d", b.ID), nil)
+		r.ServeHTTP(w, req)
+		assert.Equal(t, 404, w.Code)
+	})
+}
@@ -0,0 +1,71 @@
+package web
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"strconv"
+	"strings"
+
+	"github.com/go-chi/render"
+	"github.com/gobench-io/gobench/ent"
+	"github.com/gobench-io/gobench/master"
+)
+
+type compareResponse struct {
+	*master.Comparison
+}
+
+func (cr *compareResponse) Render(w http.ResponseWriter, r *http.Request) error {
+	return nil
+}
+
+// compareApplications diffs two or more applications metric by metric
+// GET /api/compare?apps=1,2,...
+func (h *handler) compareApplications(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var ids []int
+	for _, s := range strings.Split(r.URL.Query().Get("apps"), ",") {
+		s = strings.TrimSpace(s)
+		if s == "" {
+			continue
+		}
+		id, err := strconv.Atoi(s)
+		if err != nil {
+			render.Render(w, r, ErrInvalidRequest(fmt.Errorf("invalid application id %q", s)))
+			return
+		}
+		ids = append(ids, id)
+	}
+	for _, id := range ids {
+		ok, err := h.appVisible(r, id)
+		if err != nil {
+			render.Render(w, r, ErrInternalServer(err))
+			return
+		}
+		if !ok {
+			render.Render(w, r, ErrNotFoundRequest(errNotMember))
+			return
+		}
+	}
+
+	c, err := h.s.Compare(ctx, ids)
+	if err != nil {
+		if errors.Is(err, master.ErrCompareApps) {
+			render.Render(w, r, ErrInvalidRequest(err))
+			return
+		}
+		if ent.IsNotFound(err) {
+			render.Render(w, r, ErrNotFoundRequest(err))
+			return
+		}
+		render.Render(w, r, ErrInternalServer(err))
+		return
+	}
+
+	if err := render.Render(w, r, &compareResponse{c}); err != nil {
+		render.Render(w, r, ErrRender(err))
+		return
+	}
+}
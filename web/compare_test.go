@@ -0,0 +1,60 @@
+package web
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/gobench-io/gobench/master"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCompareApplications(t *testing.T) {
+	app1 := newApp(t, "compare 1", "scenario")
+	app2 := newApp(t, "compare 2", "scenario")
+	_, _, m := newAPITestMaster(t, "")
+	seedAppMetrics(t, m.DbClient(), app1, "e1", 1000, 2)
+	seedAppMetrics(t, m.DbClient(), app2, "e1", 1000, 3)
+
+	t.Run("successful request", func(t *testing.T) {
+		r, w := newAPITest(t, "")
+		req, _ := http.NewRequest("GET",
+			fmt.Sprintf("/api/compare?apps=%d,%d", app1.ID, app2.ID), nil)
+		r.ServeHTTP(w, req)
+
+		assert.Equal(t, 200, w.Code)
+
+		var c master.Comparison
+		assert.Nil(t, json.Unmarshal(w.Body.Bytes(), &c))
+		assert.Len(t, c.Applications, 2)
+		assert.Equal(t, app1.ID, c.Applications[0].ID)
+		assert.Len(t, c.Metrics, 3)
+		assert.InDelta(t, 50, *c.Applications[1].Delta.Count, 0.001)
+	})
+
+	t.Run("one application", func(t *testing.T) {
+		r, w := newAPITest(t, "")
+		req, _ := http.NewRequest("GET", fmt.Sprintf("/api/compare?apps=%d", app1.ID), nil)
+		r.ServeHTTP(w, req)
+
+		assert.Equal(t, 400, w.Code)
+	})
+
+	t.Run("invalid application id", func(t *testing.T) {
+		r, w := newAPITest(t, "")
+		req, _ := http.NewRequest("GET", "/api/compare?apps=1,foo", nil)
+		r.ServeHTTP(w, req)
+
+		assert.Equal(t, 400, w.Code)
+	})
+
+	t.Run("application not found", func(t *testing.T) {
+		r, w := newAPITest(t, "")
+		req, _ := http.NewRequest("GET",
+			fmt.Sprintf("/api/compare?apps=%d,%d", app1.ID, app2.ID+1000000), nil)
+		r.ServeHTTP(w, req)
+
+		assert.Equal(t, 404, w.Code)
+	})
+}
@@ -0,0 +1,64 @@
+package web
+
+import (
+	"errors"
+	"net/http"
+	"strconv"
+
+	"github.com/go-chi/render"
+	"github.com/gobench-io/gobench/ent"
+)
+
+type eventResponse struct {
+	*ent.Event
+	Edges *struct{} `json:"edges,omitempty"`
+}
+
+func newEventListResponse(es []*ent.Event) []render.Renderer {
+	list := []render.Renderer{}
+	for _, e := range es {
+		list = append(list, &eventResponse{e, nil})
+	}
+	return list
+}
+
+func (er *eventResponse) Render(w http.ResponseWriter, r *http.Request) error {
+	return nil
+}
+
+// getApplicationEvents returns the timeline events of an application in
+// time order, optionally between ?from= and ?end= (ms)
+// GET /api/applications/{id}/events
+func (h *handler) getApplicationEvents(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	app, ok := ctx.Value(webKey("application")).(*ent.Application)
+	if !ok {
+		http.Error(w, http.StatusText(422), 422)
+		return
+	}
+
+	var bounds [2]int64
+	for i, k := range []string{"from", "end"} {
+		s := r.URL.Query().Get(k)
+		if s == "" {
+			continue
+		}
+		v, err := strconv.ParseInt(s, 10, 64)
+		if err != nil {
+			render.Render(w, r, ErrInvalidRequest(errors.New(k+" must be a time in ms")))
+			return
+		}
+		bounds[i] = v
+	}
+
+	es, err := h.s.ListEvents(ctx, app.ID, bounds[0], bounds[1])
+	if err != nil {
+		render.Render(w, r, ErrInternalServer(err))
+		return
+	}
+
+	if err := render.RenderList(w, r, newEventListResponse(es)); err != nil {
+		render.Render(w, r, ErrRender(err))
+		return
+	}
+}
@@ -0,0 +1,52 @@
+package web
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/gobench-io/gobench/ent"
+	"github.com/gobench-io/gobench/pb"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestGetApplicationEvents(t *testing.T) {
+	app := newApp(t, "events", "scenario")
+	r, _, m := newAPITestMaster(t, "")
+
+	ctx := context.Background()
+	for i, name := range []string{"phase 1", "phase 2", "failover"} {
+		_, err := m.Event(ctx, &pb.EventReq{
+			AppID: int64(app.ID), EID: "e1", Time: int64(i+1) * 1000, Name: name,
+		})
+		assert.Nil(t, err)
+	}
+
+	get := func(query string) *httptest.ResponseRecorder {
+		w := httptest.NewRecorder()
+		req, _ := http.NewRequest("GET",
+			fmt.Sprintf("/api/applications/%d/events%s", app.ID, query), nil)
+		r.ServeHTTP(w, req)
+		return w
+	}
+
+	w := get("")
+	assert.Equal(t, 200, w.Code)
+	var es []ent.Event
+	assert.Nil(t, json.Unmarshal(w.Body.Bytes(), &es))
+	assert.Len(t, es, 3)
+	assert.Equal(t, "phase 1", es[0].Name)
+	assert.Equal(t, int64(1000), es[0].Time)
+	assert.Equal(t, "scenario", es[0].Source)
+
+	w = get("?from=1500&end=2500")
+	assert.Equal(t, 200, w.Code)
+	assert.Nil(t, json.Unmarshal(w.Body.Bytes(), &es))
+	assert.Len(t, es, 1)
+	assert.Equal(t, "phase 2", es[0].Name)
+
+	assert.Equal(t, 400, get("?from=x").Code)
+}
@@ -0,0 +1,271 @@
+package web
+
+import (
+	"context"
+	"encoding/csv"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"strconv"
+
+	"github.com/go-chi/render"
+	"github.com/gobench-io/gobench/ent"
+	"github.com/gobench-io/gobench/ent/counter"
+	"github.com/gobench-io/gobench/ent/gauge"
+	"github.com/gobench-io/gobench/ent/histogram"
+	"github.com/gobench-io/gobench/executor/metrics"
+	"github.com/gobench-io/gobench/master"
+)
+
+// export formats
+const (
+	exportCSV  = "csv"
+	exportJSON = "json"
+)
+
+var exportCSVHeader = []string{
+	"group", "graph", "unit", "metric", "type", "executor", "time",
+	"count", "value", "min", "max", "mean", "stddev",
+	"median", "p75", "p95", "p99", "p999",
+}
+
+// exportMetric is a metric with its position in the group/graph hierarchy
+type exportMetric struct {
+	group  *ent.Group
+	graph  *ent.Graph
+	metric *ent.Metric
+}
+
+// exportApplication streams all groups, graphs and metrics of an application
+// with their time series in one download
+// GET /api/applications/{id}/export?format=csv|json
+func (h *handler) exportApplication(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	app, ok := ctx.Value(webKey("application")).(*ent.Application)
+	if !ok {
+		http.Error(w, http.StatusText(422), 422)
+		return
+	}
+
+	format := r.URL.Query().Get("format")
+	if format == "" {
+		format = exportCSV
+	}
+	if format != exportCSV && format != exportJSON {
+		render.Render(w, r, ErrInvalidRequest(fmt.Errorf("unsupported format %q", format)))
+		return
+	}
+
+	gs, err := app.QueryGroups().
+		WithGraphs(func(q *ent.GraphQuery) {
+			q.WithMetrics()
+		}).
+		All(ctx)
+	if err != nil {
+		render.Render(w, r, ErrInternalServer(err))
+		return
+	}
+
+	var ems []exportMetric
+	for _, g := range gs {
+		for _, gr := range g.Edges.Graphs {
+			for _, m := range gr.Edges.Metrics {
+				ems = append(ems, exportMetric{g, gr, m})
+			}
+		}
+	}
+
+	w.Header().Set("Content-Disposition",
+		fmt.Sprintf(`attachment; filename="application-%d.%s"`, app.ID, format))
+
+	if format == exportCSV {
+		w.Header().Set("Content-Type", "text/csv; charset=utf-8")
+		err = exportCSVRows(ctx, w, ems)
+	} else {
+		w.Header().Set("Content-Type", "application/json; charset=utf-8")
+		err = exportJSONTree(ctx, w, app, gs)
+	}
+
+	// the response is already streaming, the error can only be logged
+	if err != nil {
+		h.logger.Errorw("failed export application", "application id", app.ID, "err", err)
+	}
+}
+
+func i64(v int64) string {
+	return strconv.FormatInt(v, 10)
+}
+
+func f64(v float64) string {
+	return strconv.FormatFloat(v, 'f', -1, 64)
+}
+
+// exportCSVRows writes one csv row per metric point
+func exportCSVRows(ctx context.Context, w io.Writer, ems []exportMetric) error {
+	cw := csv.NewWriter(w)
+
+	if err := cw.Write(exportCSVHeader); err != nil {
+		return err
+	}
+
+	for _, em := range ems {
+		prefix := []string{
+			em.group.Name, em.graph.Title, em.graph.Unit, em.metric.Title, em.metric.Type,
+		}
+
+		switch metrics.MetricType(em.metric.Type) {
+		case metrics.Counter:
+			cs, err := em.metric.QueryCounters().
+				WithExecutor().
+				Order(ent.Asc(counter.FieldTime)).
+				All(ctx)
+			if err != nil {
+				return err
+			}
+			for _, c := range cs {
+				row := append(append([]string{}, prefix...),
+					master.ExecutorEID(c.Edges.Executor), i64(c.Time), i64(c.Count), "",
+					"", "", "", "", "", "", "", "", "")
+				if err := cw.Write(row); err != nil {
+					return err
+				}
+			}
+		case metrics.Histogram:
+			hs, err := em.metric.QueryHistograms().
+				WithExecutor().
+				Order(ent.Asc(histogram.FieldTime)).
+				All(ctx)
+			if err != nil {
+				return err
+			}
+			for _, h := range hs {
+				row := append(append([]string{}, prefix...),
+					master.ExecutorEID(h.Edges.Executor), i64(h.Time), i64(h.Count), "",
+					i64(h.Min), i64(h.Max), f64(h.Mean), f64(h.Stddev),
+					f64(h.Median), f64(h.P75), f64(h.P95), f64(h.P99), f64(h.P999))
+				if err := cw.Write(row); err != nil {
+					return err
+				}
+			}
+		case metrics.Gauge:
+			gs, err := em.metric.QueryGauges().
+				WithExecutor().
+				Order(ent.Asc(gauge.FieldTime)).
+				All(ctx)
+			if err != nil {
+				return err
+			}
+			for _, g := range gs {
+				row := append(append([]string{}, prefix...),
+					master.ExecutorEID(g.Edges.Executor), i64(g.Time), "", i64(g.Value),
+					"", "", "", "", "", "", "", "", "")
+				if err := cw.Write(row); err != nil {
+					return err
+				}
+			}
+		}
+
+		// flush every metric so that the download keeps streaming
+		cw.Flush()
+		if err := cw.Error(); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// exportJSONTree writes the application as a json document of nested
+// groups/graphs/metrics, the points are streamed metric by metric
+func exportJSONTree(ctx context.Context, w io.Writer, app *ent.Application, gs []*ent.Group) error {
+	ew := &errWriter{w: w}
+
+	appJSON, err := json.Marshal(newApplicationResponse(app))
+	if err != nil {
+		return err
+	}
+	ew.printf(`{"application":%s,"groups":[`, appJSON)
+
+	for i, g := range gs {
+		if i > 0 {
+			ew.printf(",")
+		}
+		name, _ := json.Marshal(g.Name)
+		ew.printf(`{"id":%d,"name":%s,"graphs":[`, g.ID, name)
+
+		for j, gr := range g.Edges.Graphs {
+			if j > 0 {
+				ew.printf(",")
+			}
+			title, _ := json.Marshal(gr.Title)
+			unit, _ := json.Marshal(gr.Unit)
+			ew.printf(`{"id":%d,"title":%s,"unit":%s,"metrics":[`, gr.ID, title, unit)
+
+			for k, m := range gr.Edges.Metrics {
+				if k > 0 {
+					ew.printf(",")
+				}
+				if err := exportJSONMetric(ctx, ew, m); err != nil {
+					return err
+				}
+			}
+			ew.printf("]}")
+		}
+		ew.printf("]}")
+	}
+	ew.printf("]}\n")
+
+	return ew.err
+}
+
+func exportJSONMetric(ctx context.Context, ew *errWriter, m *ent.Metric) error {
+	title, _ := json.Marshal(m.Title)
+	typ, _ := json.Marshal(m.Type)
+
+	var points interface{}
+	var err error
+
+	switch metrics.MetricType(m.Type) {
+	case metrics.Counter:
+		var cs []*ent.Counter
+		cs, err = m.QueryCounters().WithExecutor().Order(ent.Asc(counter.FieldTime)).All(ctx)
+		points = newCounterListResponse(cs)
+	case metrics.Histogram:
+		var hs []*ent.Histogram
+		hs, err = m.QueryHistograms().WithExecutor().Order(ent.Asc(histogram.FieldTime)).All(ctx)
+		points = newHistogramListResponse(hs)
+	case metrics.Gauge:
+		var gs []*ent.Gauge
+		gs, err = m.QueryGauges().WithExecutor().Order(ent.Asc(gauge.FieldTime)).All(ctx)
+		points = newGaugeListResponse(gs)
+	default:
+		points = []struct{}{}
+	}
+	if err != nil {
+		return err
+	}
+
+	pointsJSON, err := json.Marshal(points)
+	if err != nil {
+		return err
+	}
+
+	ew.printf(`{"id":%d,"title":%s,"type":%s,"points":%s}`, m.ID, title, typ, pointsJSON)
+
+	return ew.err
+}
+
+// errWriter keeps the first write error sothat a sequence of writes can be
+// checked once
+type errWriter struct {
+	w   io.Writer
+	err error
+}
+
+func (ew *errWriter) printf(format string, args ...interface{}) {
+	if ew.err != nil {
+		return
+	}
+	_, ew.err = fmt.Fprintf(ew.w, format, args...)
+}
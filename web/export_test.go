@@ -0,0 +1,168 @@
+package web
+
+import (
+	"context"
+	"encoding/csv"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/go-chi/chi"
+	"github.com/gobench-io/gobench/ent"
+	"github.com/gobench-io/gobench/executor/metrics"
+	"github.com/gobench-io/gobench/logger"
+	"github.com/gobench-io/gobench/master"
+	"github.com/stretchr/testify/assert"
+)
+
+// newAPITestMaster is like newAPITest but also returns the master for seeding
+func newAPITestMaster(t *testing.T, adminPassword string) (*chi.Mux, *httptest.ResponseRecorder, *master.Master) {
+	logger := logger.NewNopLogger()
+	m, _ := master.NewMaster(&master.Options{
+		Addr:    "0.0.0.0",
+		Port:    8080,
+		HomeDir: "/tmp",
+	}, logger)
+
+	m.SetIsScheduled(false)
+
+	err := m.Start()
+	assert.Nil(t, err)
+	h := newHandler(m, adminPassword, logger)
+
+	return h.r, httptest.NewRecorder(), m
+}
+
+// seedAppMetrics creates a group with a counter, a histogram and a gauge for
+// an application, with points of executor eID every 10 seconds from t0 (ms)
+func seedAppMetrics(t *testing.T, db *ent.Client, app *ent.Application, eID string, t0 int64, points int) {
+	ctx := context.Background()
+
+	group, err := db.Group.Create().SetName("HTTP").SetApplicationID(app.ID).Save(ctx)
+	assert.Nil(t, err)
+	graph, err := db.Graph.Create().SetTitle("Response").SetUnit("N").SetGroupID(group.ID).Save(ctx)
+	assert.Nil(t, err)
+
+	c, err := db.Metric.Create().SetTitle("home.http_ok").
+		SetType(string(metrics.Counter)).SetGraphID(graph.ID).Save(ctx)
+	assert.Nil(t, err)
+	h, err := db.Metric.Create().SetTitle("home.latency").
+		SetType(string(metrics.Histogram)).SetGraphID(graph.ID).Save(ctx)
+	assert.Nil(t, err)
+	g, err := db.Metric.Create().SetTitle("home.conns").
+		SetType(string(metrics.Gauge)).SetGraphID(graph.ID).Save(ctx)
+	assert.Nil(t, err)
+
+	ex, err := db.Executor.Create().SetEID(eID).SetApplicationID(app.ID).Save(ctx)
+	assert.Nil(t, err)
+
+	for i := 1; i <= points; i++ {
+		ts := t0 + int64(i)*10000
+		_, err = db.Counter.Create().SetMetricID(c.ID).SetExecutorID(ex.ID).
+			SetTime(ts).SetCount(int64(i * 100)).Save(ctx)
+		assert.Nil(t, err)
+		_, err = db.Histogram.Create().SetMetricID(h.ID).SetExecutorID(ex.ID).
+			SetTime(ts).SetCount(int64(i * 100)).SetMin(1).SetMax(100).
+			SetMean(10).SetStddev(1).SetMedian(10).SetP75(20).
+			SetP95(30).SetP99(40).SetP999(50).Save(ctx)
+		assert.Nil(t, err)
+		_, err = db.Gauge.Create().SetMetricID(g.ID).SetExecutorID(ex.ID).
+			SetTime(ts).SetValue(int64(i)).Save(ctx)
+		assert.Nil(t, err)
+	}
+}
+
+func TestExportApplication(t *testing.T) {
+	app := newApp(t, "export", "scenario")
+	_, _, m := newAPITestMaster(t, "")
+	seedAppMetrics(t, m.DbClient(), app, "e1", 1000, 2)
+
+	t.Run("csv", func(t *testing.T) {
+		r, w := newAPITest(t, "")
+		req, _ := http.NewRequest("GET", fmt.Sprintf("/api/applications/%d/export", app.ID), nil)
+		r.ServeHTTP(w, req)
+
+		assert.Equal(t, 200, w.Code)
+		assert.Equal(t, "text/csv; charset=utf-8", w.Header().Get("Content-Type"))
+		assert.Contains(t, w.Header().Get("Content-Disposition"),
+			fmt.Sprintf("application-%d.csv", app.ID))
+
+		rows, err := csv.NewReader(w.Body).ReadAll()
+		assert.Nil(t, err)
+		assert.Len(t, rows, 7) // header + 2 points of 3 metrics
+		assert.Equal(t, exportCSVHeader, rows[0])
+		assert.Equal(t, []string{
+			"HTTP", "Response", "N", "home.http_ok", "counter", "e1", "11000",
+			"100", "", "", "", "", "", "", "", "", "", "",
+		}, rows[1])
+		assert.Equal(t, []string{
+			"HTTP", "Response", "N", "home.latency", "histogram", "e1", "21000",
+			"200", "", "1", "100", "10", "1", "10", "20", "30", "40", "50",
+		}, rows[4])
+		assert.Equal(t, []string{
+			"HTTP", "Response", "N", "home.conns", "gauge", "e1", "21000",
+			"", "2", "", "", "", "", "", "", "", "", "",
+		}, rows[6])
+	})
+
+	t.Run("outlives the request timeout", func(t *testing.T) {
+		defer func(d time.Duration) { requestTimeout = d }(requestTimeout)
+		requestTimeout = time.Nanosecond
+
+		r, w := newAPITest(t, "")
+		req, _ := http.NewRequest("GET", fmt.Sprintf("/api/applications/%d/export", app.ID), nil)
+		r.ServeHTTP(w, req)
+
+		assert.Equal(t, 200, w.Code)
+		rows, err := csv.NewReader(w.Body).ReadAll()
+		assert.Nil(t, err)
+		assert.Len(t, rows, 7)
+	})
+
+	t.Run("json", func(t *testing.T) {
+		r, w := newAPITest(t, "")
+		req, _ := http.NewRequest("GET",
+			fmt.Sprintf("/api/applications/%d/export?format=json", app.ID), nil)
+		r.ServeHTTP(w, req)
+
+		assert.Equal(t, 200, w.Code)
+
+		var doc struct {
+			Application ent.Application
+			Groups      []struct {
+				Name   string
+				Graphs []struct {
+					Title   string
+					Unit    string
+					Metrics []struct {
+						Title  string
+						Type   string
+						Points []map[string]interface{}
+					}
+				}
+			}
+		}
+		assert.Nil(t, json.Unmarshal(w.Body.Bytes(), &doc))
+		assert.Equal(t, app.ID, doc.Application.ID)
+		assert.Len(t, doc.Groups, 1)
+		assert.Equal(t, "HTTP", doc.Groups[0].Name)
+		ms := doc.Groups[0].Graphs[0].Metrics
+		assert.Len(t, ms, 3)
+		assert.Equal(t, "home.http_ok", ms[0].Title)
+		assert.Len(t, ms[0].Points, 2)
+		assert.EqualValues(t, 200, ms[0].Points[1]["count"])
+		assert.EqualValues(t, 40, ms[1].Points[0]["p99"])
+	})
+
+	t.Run("unsupported format", func(t *testing.T) {
+		r, w := newAPITest(t, "")
+		req, _ := http.NewRequest("GET",
+			fmt.Sprintf("/api/applications/%d/export?format=parquet", app.ID), nil)
+		r.ServeHTTP(w, req)
+
+		assert.Equal(t, 400, w.Code)
+	})
+}
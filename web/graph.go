@@ -9,6 +9,7 @@ import (
 	"github.com/go-chi/render"
 	"github.com/gobench-io/gobench/ent"
 	"github.com/gobench-io/gobench/ent/graph"
+	"github.com/gobench-io/gobench/ent/group"
 )
 
 func (h *handler) graphCtx(next http.Handler) http.Handler {
@@ -21,7 +22,7 @@ func (h *handler) graphCtx(next http.Handler) http.Handler {
 
 		g, err := h.db().Graph.
 			Query().
-			Where(graph.ID(graphID)).
+			Where(graph.ID(graphID), graph.HasGroupWith(group.HasApplicationWith(visible(r)))).
 			Only(r.Context())
 
 		if err != nil {
@@ -36,6 +37,7 @@ func (h *handler) graphCtx(next http.Handler) http.Handler {
 func (h *handler) listGraphs(w http.ResponseWriter, r *http.Request) {
 	graphs, err := h.db().Graph.
 		Query().
+		Where(graph.HasGroupWith(group.HasApplicationWith(visible(r)))).
 		All(r.Context())
 	if err != nil {
 		render.Render(w, r, ErrInternalServer(err))
@@ -21,7 +21,7 @@ func (h *handler) groupCtx(next http.Handler) http.Handler {
 
 		group, err := h.db().Group.
 			Query().
-			Where(group.ID(groupID)).
+			Where(group.ID(groupID), group.HasApplicationWith(visible(r))).
 			Only(r.Context())
 
 		if err != nil {
@@ -36,6 +36,7 @@ func (h *handler) groupCtx(next http.Handler) http.Handler {
 func (h *handler) listGroups(w http.ResponseWriter, r *http.Request) {
 	gs, err := h.db().Group.
 		Query().
+		Where(group.HasApplicationWith(visible(r))).
 		All(r.Context())
 	if err != nil {
 		render.Render(w, r, ErrInternalServer(err))
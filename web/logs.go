@@ -0,0 +1,197 @@
+package web
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"os"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/go-chi/render"
+	"github.com/gobench-io/gobench/ent"
+	"github.com/gobench-io/gobench/master"
+)
+
+// logFollowInterval is how often a followed log file is read for new lines
+const logFollowInterval = 500 * time.Millisecond
+
+// logOffsetHeader carries the offset to continue reading a log from
+const logOffsetHeader = "X-Log-Offset"
+
+// logQuery reads the log query parameters: offset, tail, level, q, the vu,
+// group and eid fields, and follow. ok is false when there is none of them.
+func logQuery(r *http.Request) (q *master.LogQuery, follow, ok bool, err error) {
+	v := r.URL.Query()
+	q = &master.LogQuery{
+		Level:    v.Get("level"),
+		Contains: v.Get("q"),
+	}
+
+	if s := v.Get("offset"); s != "" {
+		if q.Offset, err = strconv.ParseInt(s, 10, 64); err != nil {
+			return nil, false, false, errors.New("offset must be a number")
+		}
+	}
+	if s := v.Get("tail"); s != "" {
+		if q.Tail, err = strconv.Atoi(s); err != nil {
+			return nil, false, false, errors.New("tail must be a number")
+		}
+	}
+	follow = v.Get("follow") == "true"
+
+	// fields of the scenario logger
+	for _, k := range []string{"vu", "group", "eid"} {
+		if f := v.Get(k); f != "" {
+			if q.Fields == nil {
+				q.Fields = map[string]string{}
+			}
+			q.Fields[k] = f
+		}
+	}
+
+	for _, k := range []string{"offset", "tail", "level", "q", "follow", "vu", "group", "eid"} {
+		if _, has := v[k]; has {
+			ok = true
+		}
+	}
+
+	return q, follow, ok, q.Validate()
+}
+
+func writeLogLines(w http.ResponseWriter, lines []string) error {
+	if len(lines) == 0 {
+		return nil
+	}
+	_, err := fmt.Fprint(w, strings.Join(lines, "\n")+"\n")
+	return err
+}
+
+// serveLog writes a log file of an application. Without query parameters,
+// the whole file is served. Otherwise, the lines after ?offset= that match
+// ?level=, ?q=, ?vu=, ?group= and ?eid= are written, only the last ?tail= of them if set, with
+// the offset to continue from in the X-Log-Offset header. With
+// ?follow=true, the new lines are pushed as they are written until the
+// application reaches a final status, and the offset is the X-Log-Offset
+// trailer.
+func (h *handler) serveLog(w http.ResponseWriter, r *http.Request, app *ent.Application, filename string) {
+	q, follow, ok, err := logQuery(r)
+	if err != nil {
+		render.Render(w, r, ErrInvalidRequest(err))
+		return
+	}
+	if !ok {
+		http.ServeFile(w, r, filename)
+		return
+	}
+
+	if !follow {
+		lines, offset, err := master.ReadLog(filename, q)
+		if err != nil {
+			if os.IsNotExist(err) {
+				render.Render(w, r, ErrNotFoundRequest(err))
+				return
+			}
+			render.Render(w, r, ErrInternalServer(err))
+			return
+		}
+		w.Header().Set("Content-Type", "text/plain; charset=utf-8")
+		w.Header().Set(logOffsetHeader, strconv.FormatInt(offset, 10))
+		_ = writeLogLines(w, lines)
+		return
+	}
+
+	h.followLog(w, r, app, filename, q)
+}
+
+func (h *handler) followLog(w http.ResponseWriter, r *http.Request, app *ent.Application,
+	filename string, q *master.LogQuery,
+) {
+	ctx := r.Context()
+	flusher, ok := w.(http.Flusher)
+	if !ok {
+		http.Error(w, "streaming unsupported", http.StatusInternalServerError)
+		return
+	}
+
+	// the status events tell when the last lines are written
+	events, unsubscribe := h.s.Subscribe(app.ID)
+	defer unsubscribe()
+
+	app, err := h.db().Application.Get(ctx, app.ID)
+	if err != nil {
+		render.Render(w, r, ErrInternalServer(err))
+		return
+	}
+	done := master.IsTerminal(app.Status)
+
+	w.Header().Set("Content-Type", "text/plain; charset=utf-8")
+	w.Header().Set("Cache-Control", "no-cache")
+	w.Header().Set("X-Content-Type-Options", "nosniff")
+	w.Header().Set("X-Accel-Buffering", "no")
+	// a client cut off resumes from the trailer
+	w.Header().Set("Trailer", logOffsetHeader)
+	w.WriteHeader(http.StatusOK)
+	flusher.Flush()
+
+	ticker := time.NewTicker(logFollowInterval)
+	defer ticker.Stop()
+
+	for {
+		// the log file is created when the application is scheduled
+		lines, offset, err := master.ReadLog(filename, q)
+		if err != nil && !os.IsNotExist(err) {
+			return
+		}
+		if err = writeLogLines(w, lines); err != nil {
+			return
+		}
+		flusher.Flush()
+		// the tail applies to the lines already written only
+		q.Offset, q.Tail = offset, 0
+		w.Header().Set(logOffsetHeader, strconv.FormatInt(offset, 10))
+
+		if done {
+			return
+		}
+
+		if !waitLog(ctx, ticker.C, &events, &done) {
+			return
+		}
+		if events == nil {
+			// the subscription lagged behind, the status is read instead
+			app, err := h.db().Application.Get(ctx, app.ID)
+			if err != nil {
+				return
+			}
+			done = master.IsTerminal(app.Status)
+		}
+	}
+}
+
+// waitLog waits for the next read of a followed log: a tick, or a status
+// transition. The metric events are skipped, they do not write the logs.
+// The events are set to nil when the subscription lagged behind and was
+// closed, the status is then unknown. It returns false when the follow must
+// end.
+func waitLog(ctx context.Context, tick <-chan time.Time, events *<-chan *master.Event, done *bool) bool {
+	for {
+		select {
+		case <-ctx.Done():
+			return false
+		case <-tick:
+			return true
+		case e, ok := <-*events:
+			if !ok {
+				*events = nil
+				return true
+			}
+			if s, ok := e.Data.(*master.StatusEvent); ok {
+				*done = master.IsTerminal(s.Status)
+				return true
+			}
+		}
+	}
+}
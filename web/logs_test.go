@@ -0,0 +1,147 @@
+package web
+
+import (
+	"bufio"
+	"context"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"strconv"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/gobench-io/gobench/master"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestGetApplicationLogQuery(t *testing.T) {
+	app := newApp(t, "logs", "scenario")
+	r, _, m := newAPITestMaster(t, "")
+
+	fd, sl, _ := m.Logpaths(app.ID)
+	assert.Nil(t, os.MkdirAll(fd, os.ModePerm))
+	content := `{"level":"info","msg":"one"}
+{"level":"error","msg":"two"}
+{"level":"info","msg":"three"}
+{"level":"info","msg":"four","eid":"host-1","vu":2,"group":1}
+`
+	assert.Nil(t, ioutil.WriteFile(sl, []byte(content), 0644))
+
+	get := func(query string) *httptest.ResponseRecorder {
+		w := httptest.NewRecorder()
+		req, _ := http.NewRequest("GET",
+			fmt.Sprintf("/api/applications/%d/logs/system?%s", app.ID, query), nil)
+		r.ServeHTTP(w, req)
+		return w
+	}
+
+	t.Run("tail", func(t *testing.T) {
+		w := get("tail=2&level=error")
+		assert.Equal(t, 200, w.Code)
+		assert.Equal(t, "{\"level\":\"error\",\"msg\":\"two\"}\n", w.Body.String())
+		assert.Equal(t, fmt.Sprint(len(content)), w.Header().Get(logOffsetHeader))
+	})
+
+	t.Run("offset", func(t *testing.T) {
+		w := get(fmt.Sprintf("offset=%d", len(content)))
+		assert.Equal(t, 200, w.Code)
+		assert.Equal(t, "", w.Body.String())
+	})
+
+	t.Run("filters", func(t *testing.T) {
+		w := get("level=error")
+		assert.Equal(t, "{\"level\":\"error\",\"msg\":\"two\"}\n", w.Body.String())
+
+		w = get("q=three")
+		assert.Equal(t, "{\"level\":\"info\",\"msg\":\"three\"}\n", w.Body.String())
+	})
+
+	t.Run("scenario logger fields", func(t *testing.T) {
+		w := get("vu=2&group=1&eid=host-1")
+		assert.Contains(t, w.Body.String(), `"msg":"four"`)
+		assert.Equal(t, 1, strings.Count(w.Body.String(), "\n"))
+
+		w = get("vu=1")
+		assert.Equal(t, "", w.Body.String())
+	})
+
+	t.Run("invalid", func(t *testing.T) {
+		assert.Equal(t, 400, get("level=loud").Code)
+		assert.Equal(t, 400, get("tail=x").Code)
+		assert.Equal(t, 400, get("offset=-1").Code)
+	})
+
+	t.Run("not found", func(t *testing.T) {
+		w := httptest.NewRecorder()
+		req, _ := http.NewRequest("GET",
+			fmt.Sprintf("/api/applications/%d/logs/user?tail=10", app.ID), nil)
+		r.ServeHTTP(w, req)
+		assert.Equal(t, 404, w.Code)
+	})
+}
+
+func TestFollowApplicationLog(t *testing.T) {
+	defer func(d time.Duration) { requestTimeout = d }(requestTimeout)
+	requestTimeout = 50 * time.Millisecond
+
+	app := newApp(t, "logs", "scenario")
+	r, _, m := newAPITestMaster(t, "")
+	srv := httptest.NewServer(r)
+	defer srv.Close()
+
+	fd, _, ul := m.Logpaths(app.ID)
+	assert.Nil(t, os.MkdirAll(fd, os.ModePerm))
+	assert.Nil(t, ioutil.WriteFile(ul, []byte("first\n"), 0644))
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	req, _ := http.NewRequestWithContext(ctx, "GET",
+		fmt.Sprintf("%s/api/applications/%d/logs/user?follow=true&q=line", srv.URL, app.ID), nil)
+	res, err := http.DefaultClient.Do(req)
+	assert.Nil(t, err)
+	defer res.Body.Close()
+	assert.Equal(t, 200, res.StatusCode)
+
+	// the follow outlives the request timeout
+	time.Sleep(4 * requestTimeout)
+	f, err := os.OpenFile(ul, os.O_APPEND|os.O_WRONLY, 0644)
+	assert.Nil(t, err)
+	_, err = f.WriteString("skipped\nnew line\n")
+	assert.Nil(t, err)
+	assert.Nil(t, f.Close())
+
+	br := bufio.NewReader(res.Body)
+	line, err := br.ReadString('\n')
+	assert.Nil(t, err)
+	assert.Equal(t, "new line\n", line)
+
+	// the follow ends with the application
+	_, err = m.CancelApplication(ctx, app.ID)
+	assert.Nil(t, err)
+	_, err = br.ReadString('\n')
+	assert.Equal(t, io.EOF, err)
+	assert.Equal(t, strconv.Itoa(len("first\nskipped\nnew line\n")),
+		res.Trailer.Get(logOffsetHeader))
+}
+
+func TestWaitLogLagged(t *testing.T) {
+	// a closed subscription is not the end of the application
+	events := make(chan *master.Event)
+	close(events)
+
+	var ch <-chan *master.Event = events
+	done := false
+	assert.True(t, waitLog(context.Background(), nil, &ch, &done))
+	assert.Nil(t, ch)
+	assert.False(t, done)
+
+	// then only the ticks wake the follow
+	tick := make(chan time.Time, 1)
+	tick <- time.Now()
+	assert.True(t, waitLog(context.Background(), tick, &ch, &done))
+}
@@ -2,6 +2,8 @@ package web
 
 import (
 	"context"
+	"errors"
+	"fmt"
 	"net/http"
 	"strconv"
 	"time"
@@ -9,10 +11,12 @@ import (
 	"github.com/go-chi/chi"
 	"github.com/go-chi/render"
 	"github.com/gobench-io/gobench/ent"
-	"github.com/gobench-io/gobench/ent/counter"
-	"github.com/gobench-io/gobench/ent/gauge"
-	"github.com/gobench-io/gobench/ent/histogram"
+	"github.com/gobench-io/gobench/ent/graph"
+	"github.com/gobench-io/gobench/ent/group"
 	"github.com/gobench-io/gobench/ent/metric"
+	"github.com/gobench-io/gobench/ent/predicate"
+	"github.com/gobench-io/gobench/executor/metrics"
+	"github.com/gobench-io/gobench/master"
 )
 
 // middleware to get metric with metricID in the url param
@@ -27,7 +31,7 @@ func (h *handler) metricCtx(next http.Handler) http.Handler {
 
 		metric, err := h.db().Metric.
 			Query().
-			Where(metric.ID(metricID)).
+			Where(metric.ID(metricID), visibleMetric(r)).
 			Only(r.Context())
 
 		if err != nil {
@@ -40,6 +44,11 @@ func (h *handler) metricCtx(next http.Handler) http.Handler {
 	})
 }
 
+// visibleMetric is the predicate of the metrics the user of a request sees
+func visibleMetric(r *http.Request) predicate.Metric {
+	return metric.HasGraphWith(graph.HasGroupWith(group.HasApplicationWith(visible(r))))
+}
+
 func (h *handler) msToTime(ms string) (time.Time, error) {
 	msInt, err := strconv.ParseInt(ms, 10, 64)
 	if err != nil {
@@ -52,12 +61,12 @@ func (h *handler) msToTime(ms string) (time.Time, error) {
 // middleware to get `from` from query
 func (h *handler) timeCtx(next http.Handler) http.Handler {
 	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		var ctx context.Context
+		ctx := r.Context()
 
 		fromS := r.URL.Query().Get("from")
 		from, err := strconv.ParseInt(fromS, 10, 64)
 		if err == nil {
-			ctx = context.WithValue(r.Context(), webKey("from"), from)
+			ctx = context.WithValue(ctx, webKey("from"), from)
 		}
 
 		endS := r.URL.Query().Get("end")
@@ -66,11 +75,6 @@ func (h *handler) timeCtx(next http.Handler) http.Handler {
 			ctx = context.WithValue(ctx, webKey("end"), end)
 		}
 
-		if ctx == nil {
-			next.ServeHTTP(w, r)
-			return
-		}
-
 		next.ServeHTTP(w, r.WithContext(ctx))
 	})
 }
@@ -78,6 +82,7 @@ func (h *handler) timeCtx(next http.Handler) http.Handler {
 func (h *handler) listMetrics(w http.ResponseWriter, r *http.Request) {
 	ms, err := h.db().Metric.
 		Query().
+		Where(visibleMetric(r)).
 		All(r.Context())
 	if err != nil {
 		render.Render(w, r, ErrInternalServer(err))
@@ -102,70 +107,108 @@ func (h *handler) getMetric(w http.ResponseWriter, r *http.Request) {
 	}
 }
 
-func (h *handler) getMetricCounters(w http.ResponseWriter, r *http.Request) {
+// nextCursorHeader carries the cursor of the next page of points
+const nextCursorHeader = "X-Next-Cursor"
+
+// pointsQuery reads the points query of a metric: from and end from timeCtx,
+// limit, cursor, step (ms or a duration like 1m) and agg
+func pointsQuery(r *http.Request, m *ent.Metric) (q master.PointsQuery, err error) {
 	ctx := r.Context()
-	m, ok := ctx.Value(webKey("metric")).(*ent.Metric)
-	if !ok {
-		http.Error(w, http.StatusText(422), 422)
-		return
-	}
+	v := r.URL.Query()
 
-	q := m.QueryCounters()
+	q.From, _ = ctx.Value(webKey("from")).(int64)
+	q.End, _ = ctx.Value(webKey("end")).(int64)
 
-	from, ok := ctx.Value(webKey("from")).(int64)
-	if ok {
-		q.Where(counter.TimeGT(from))
+	if s := v.Get("limit"); s != "" {
+		if q.Limit, err = strconv.Atoi(s); err != nil || q.Limit == 0 {
+			return q, fmt.Errorf("limit must be between 1 and %d", master.MaxPointsLimit)
+		}
+	}
+	if s := v.Get("cursor"); s != "" {
+		if q.Cursor, err = master.ParseCursor(s); err != nil {
+			return q, err
+		}
 	}
-	end, ok := ctx.Value(webKey("end")).(int64)
-	if ok {
-		q.Where(counter.TimeLTE(end))
+	if s := v.Get("step"); s != "" {
+		if q.Step, err = strconv.ParseInt(s, 10, 64); err != nil {
+			d, derr := time.ParseDuration(s)
+			if derr != nil || d < time.Millisecond {
+				return q, errors.New("step must be in ms or a duration")
+			}
+			q.Step = d.Milliseconds()
+		}
 	}
+	q.Agg = master.Agg(v.Get("agg"))
 
-	cs, err := q.All(ctx)
+	return q, q.Validate(metrics.MetricType(m.Type))
+}
 
+// renderPoints renders a page of points, or of buckets, with the cursor of
+// the next page in the X-Next-Cursor header
+func renderPoints(w http.ResponseWriter, r *http.Request, list []render.Renderer, next *master.Cursor, err error) {
 	if err != nil {
 		render.Render(w, r, ErrInternalServer(err))
 		return
 	}
-
-	if err := render.RenderList(w, r, newCounterListResponse(cs)); err != nil {
+	if next != nil {
+		w.Header().Set(nextCursorHeader, next.String())
+	}
+	if err := render.RenderList(w, r, list); err != nil {
 		render.Render(w, r, ErrRender(err))
 		return
 	}
 }
 
-func (h *handler) getMetricHistograms(w http.ResponseWriter, r *http.Request) {
+// getMetricCounters returns the points of a counter in time order, every
+// point or a page of ?limit= points after ?cursor=. With ?step=, the points
+// are bucketed by steps, ?agg= being sum, avg or max over the executors.
+func (h *handler) getMetricCounters(w http.ResponseWriter, r *http.Request) {
 	ctx := r.Context()
 	m, ok := ctx.Value(webKey("metric")).(*ent.Metric)
 	if !ok {
 		http.Error(w, http.StatusText(422), 422)
 		return
 	}
-
-	q := m.QueryHistograms()
-
-	from, ok := ctx.Value(webKey("from")).(int64)
-	if ok {
-		q.Where(histogram.TimeGT(from))
-	}
-	end, ok := ctx.Value(webKey("end")).(int64)
-	if ok {
-		q.Where(histogram.TimeLTE(end))
+	q, err := pointsQuery(r, m)
+	if err != nil {
+		render.Render(w, r, ErrInvalidRequest(err))
+		return
 	}
 
-	hs, err := q.All(ctx)
+	if q.Step > 0 {
+		bs, next, err := master.CounterBuckets(ctx, m, q)
+		renderPoints(w, r, newCounterBucketListResponse(bs), next, err)
+		return
+	}
+	cs, next, err := master.CounterPoints(ctx, m, q)
+	renderPoints(w, r, newCounterListResponse(cs), next, err)
+}
 
+// getMetricHistograms returns the points of a histogram as the counters do,
+// ?agg= being merge or max
+func (h *handler) getMetricHistograms(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	m, ok := ctx.Value(webKey("metric")).(*ent.Metric)
+	if !ok {
+		http.Error(w, http.StatusText(422), 422)
+		return
+	}
+	q, err := pointsQuery(r, m)
 	if err != nil {
-		render.Render(w, r, ErrInternalServer(err))
+		render.Render(w, r, ErrInvalidRequest(err))
 		return
 	}
 
-	if err := render.RenderList(w, r, newHistogramListResponse(hs)); err != nil {
-		render.Render(w, r, ErrRender(err))
+	if q.Step > 0 {
+		bs, next, err := master.HistogramBuckets(ctx, m, q)
+		renderPoints(w, r, newHistogramBucketListResponse(bs), next, err)
 		return
 	}
+	hs, next, err := master.HistogramPoints(ctx, m, q)
+	renderPoints(w, r, newHistogramListResponse(hs), next, err)
 }
 
+// getMetricGauges returns the points of a gauge as the counters do
 func (h *handler) getMetricGauges(w http.ResponseWriter, r *http.Request) {
 	ctx := r.Context()
 	m, ok := ctx.Value(webKey("metric")).(*ent.Metric)
@@ -173,27 +216,17 @@ func (h *handler) getMetricGauges(w http.ResponseWriter, r *http.Request) {
 		http.Error(w, http.StatusText(422), 422)
 		return
 	}
-
-	q := m.QueryGauges()
-
-	from, ok := ctx.Value(webKey("from")).(int64)
-	if ok {
-		q.Where(gauge.TimeGT(from))
-	}
-	end, ok := ctx.Value(webKey("end")).(int64)
-	if ok {
-		q.Where(gauge.TimeLTE(end))
-	}
-
-	gs, err := q.All(ctx)
-
+	q, err := pointsQuery(r, m)
 	if err != nil {
-		render.Render(w, r, ErrInternalServer(err))
+		render.Render(w, r, ErrInvalidRequest(err))
 		return
 	}
 
-	if err := render.RenderList(w, r, newGaugeListResponse(gs)); err != nil {
-		render.Render(w, r, ErrRender(err))
+	if q.Step > 0 {
+		bs, next, err := master.GaugeBuckets(ctx, m, q)
+		renderPoints(w, r, newGaugeBucketListResponse(bs), next, err)
 		return
 	}
+	gs, next, err := master.GaugePoints(ctx, m, q)
+	renderPoints(w, r, newGaugeListResponse(gs), next, err)
 }
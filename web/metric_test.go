@@ -0,0 +1,98 @@
+package web
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/gobench-io/gobench/ent/application"
+	"github.com/gobench-io/gobench/ent/metric"
+	"github.com/gobench-io/gobench/master"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestGetMetricPoints(t *testing.T) {
+	app := newApp(t, "metric points", "scenario")
+	_, _, m := newAPITestMaster(t, "")
+	t0 := int64(1000 * 60000)
+	seedAppMetrics(t, m.DbClient(), app, "e1", t0, 12)
+
+	metricID := func(title string) int {
+		id, err := m.DbClient().Application.Query().
+			Where(application.ID(app.ID)).
+			QueryGroups().QueryGraphs().QueryMetrics().
+			Where(metric.Title(title)).
+			OnlyID(context.Background())
+		assert.Nil(t, err)
+		return id
+	}
+	counterID := metricID("home.http_ok")
+	histogramID := metricID("home.latency")
+
+	get := func(url string) *http.Response {
+		r, w := newAPITest(t, "")
+		req, _ := http.NewRequest("GET", url, nil)
+		r.ServeHTTP(w, req)
+		return w.Result()
+	}
+
+	t.Run("every point", func(t *testing.T) {
+		res := get(fmt.Sprintf("/api/metrics/%d/counters", counterID))
+		assert.Equal(t, 200, res.StatusCode)
+		assert.Equal(t, "", res.Header.Get(nextCursorHeader))
+
+		var ps []map[string]interface{}
+		assert.Nil(t, json.NewDecoder(res.Body).Decode(&ps))
+		assert.Len(t, ps, 12)
+		assert.Equal(t, "e1", ps[0]["wId"])
+	})
+
+	t.Run("pages", func(t *testing.T) {
+		url := fmt.Sprintf("/api/metrics/%d/counters?limit=5", counterID)
+		times := []float64{}
+		for {
+			res := get(url)
+			assert.Equal(t, 200, res.StatusCode)
+
+			var ps []map[string]interface{}
+			assert.Nil(t, json.NewDecoder(res.Body).Decode(&ps))
+			for _, p := range ps {
+				times = append(times, p["time"].(float64))
+			}
+
+			cursor := res.Header.Get(nextCursorHeader)
+			if cursor == "" {
+				break
+			}
+			url = fmt.Sprintf("/api/metrics/%d/counters?limit=5&cursor=%s", counterID, cursor)
+		}
+		assert.Len(t, times, 12)
+		assert.EqualValues(t, t0+120000, times[11])
+	})
+
+	t.Run("steps", func(t *testing.T) {
+		res := get(fmt.Sprintf("/api/metrics/%d/histograms?step=1m&agg=max&limit=2&from=%d",
+			histogramID, t0))
+		assert.Equal(t, 200, res.StatusCode)
+		assert.Equal(t, fmt.Sprint(t0+120000), res.Header.Get(nextCursorHeader))
+
+		var bs []master.HistogramBucket
+		assert.Nil(t, json.NewDecoder(res.Body).Decode(&bs))
+		if assert.Len(t, bs, 2) {
+			assert.Equal(t, t0+60000, bs[1].Time)
+			assert.EqualValues(t, 1100, bs[1].Count)
+			assert.Equal(t, 30.0, bs[1].P95)
+		}
+	})
+
+	t.Run("invalid", func(t *testing.T) {
+		for _, q := range []string{
+			"limit=0", "limit=x", "limit=100000", "cursor=x", "step=x", "step=1m&agg=merge",
+		} {
+			res := get(fmt.Sprintf("/api/metrics/%d/counters?%s", counterID, q))
+			assert.Equal(t, 400, res.StatusCode, q)
+		}
+	})
+}
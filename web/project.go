@@ -0,0 +1,287 @@
+package web
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/go-chi/chi"
+	"github.com/go-chi/render"
+
+	"github.com/gobench-io/gobench/ent"
+	"github.com/gobench-io/gobench/ent/predicate"
+	"github.com/gobench-io/gobench/master"
+
+	entApp "github.com/gobench-io/gobench/ent/application"
+	entProject "github.com/gobench-io/gobench/ent/project"
+)
+
+// errNotMember is returned when a user writes to a project of which it is
+// not a member
+var errNotMember = errors.New("not a member of the project")
+
+// visible is the predicate of the applications the user of a request sees
+func visible(r *http.Request) predicate.Application {
+	return master.VisibleApplications(currentUser(r))
+}
+
+// appVisible tells if the user of a request sees an application
+func (h *handler) appVisible(r *http.Request, appID int) (bool, error) {
+	return h.db().Application.
+		Query().
+		Where(entApp.ID(appID), visible(r)).
+		Exist(r.Context())
+}
+
+// requestProject returns the project of a new application, the default
+// project when projectID is 0, or errNotMember
+func (h *handler) requestProject(r *http.Request, projectID int) (int, error) {
+	if projectID == 0 {
+		p, err := h.s.DefaultProject(r.Context())
+		if err != nil {
+			return 0, err
+		}
+		projectID = p.ID
+	}
+	ok, err := h.s.IsProjectMember(r.Context(), projectID, currentUser(r))
+	if err != nil {
+		return 0, err
+	}
+	if !ok {
+		return 0, errNotMember
+	}
+	return projectID, nil
+}
+
+// projectFilter is the predicate of the "project" query parameter, nil
+// without it
+func projectFilter(r *http.Request) (predicate.Application, error) {
+	s := r.URL.Query().Get("project")
+	if s == "" {
+		return nil, nil
+	}
+	id, err := strconv.Atoi(s)
+	if err != nil {
+		return nil, errors.New("invalid project")
+	}
+	return entApp.HasProjectWith(entProject.ID(id)), nil
+}
+
+// project request, the retentions are in seconds
+type projectRequest struct {
+	Name            string `json:"name"`
+	MaxQueued       int    `json:"maxQueued"`
+	RawRetention    int64  `json:"rawRetention"`
+	RollupRetention int64  `json:"rollupRetention"`
+}
+
+func (pr *projectRequest) Bind(r *http.Request) error {
+	if pr.MaxQueued < 0 || pr.RawRetention < 0 || pr.RollupRetention < 0 {
+		return errors.New("limits must not be negative")
+	}
+	return nil
+}
+
+func (pr *projectRequest) limits() master.ProjectLimits {
+	return master.ProjectLimits{
+		MaxQueued:       pr.MaxQueued,
+		RawRetention:    time.Duration(pr.RawRetention) * time.Second,
+		RollupRetention: time.Duration(pr.RollupRetention) * time.Second,
+	}
+}
+
+// project response
+type projectResponse struct {
+	*ent.Project
+	Edges *struct{} `json:"edges,omitempty"`
+}
+
+func (pr *projectResponse) Render(w http.ResponseWriter, r *http.Request) error {
+	return nil
+}
+
+func newProjectListResponse(ps []*ent.Project) []render.Renderer {
+	list := []render.Renderer{}
+	for _, p := range ps {
+		list = append(list, &projectResponse{Project: p})
+	}
+	return list
+}
+
+// projectCtx loads a project the user of the request sees
+func (h *handler) projectCtx(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		id, err := strconv.Atoi(chi.URLParam(r, "projectID"))
+		if err != nil {
+			render.Render(w, r, ErrNotFoundRequest(err))
+			return
+		}
+		ok, err := h.s.IsProjectMember(r.Context(), id, currentUser(r))
+		if err != nil {
+			render.Render(w, r, ErrInternalServer(err))
+			return
+		}
+		if !ok {
+			render.Render(w, r, ErrNotFoundRequest(errNotMember))
+			return
+		}
+		p, err := h.s.GetProject(r.Context(), id)
+		if err != nil {
+			render.Render(w, r, ErrNotFoundRequest(err))
+			return
+		}
+
+		ctx := context.WithValue(r.Context(), webKey("project"), p)
+		next.ServeHTTP(w, r.WithContext(ctx))
+	})
+}
+
+func (h *handler) listProjects(w http.ResponseWriter, r *http.Request) {
+	ps, err := h.s.ListProjects(r.Context(), currentUser(r))
+	if err != nil {
+		render.Render(w, r, ErrInternalServer(err))
+		return
+	}
+	if err := render.RenderList(w, r, newProjectListResponse(ps)); err != nil {
+		render.Render(w, r, ErrRender(err))
+		return
+	}
+}
+
+// createProject creates a project without members
+// POST /api/projects
+func (h *handler) createProject(w http.ResponseWriter, r *http.Request) {
+	data := &projectRequest{}
+	if err := render.Bind(r, data); err != nil {
+		render.Render(w, r, ErrInvalidRequest(err))
+		return
+	}
+
+	p, err := h.s.CreateProject(r.Context(), data.Name, data.limits())
+	if err != nil {
+		if ent.IsValidationError(err) || ent.IsConstraintError(err) {
+			render.Render(w, r, ErrInvalidRequest(err))
+			return
+		}
+		render.Render(w, r, ErrInternalServer(err))
+		return
+	}
+
+	render.Status(r, http.StatusCreated)
+	render.Render(w, r, &projectResponse{Project: p})
+}
+
+func (h *handler) getProject(w http.ResponseWriter, r *http.Request) {
+	p, ok := r.Context().Value(webKey("project")).(*ent.Project)
+	if !ok {
+		http.Error(w, http.StatusText(422), 422)
+		return
+	}
+	if err := render.Render(w, r, &projectResponse{Project: p}); err != nil {
+		render.Render(w, r, ErrRender(err))
+		return
+	}
+}
+
+// updateProject changes the limits of a project
+// PUT /api/projects/{projectID}
+func (h *handler) updateProject(w http.ResponseWriter, r *http.Request) {
+	p, ok := r.Context().Value(webKey("project")).(*ent.Project)
+	if !ok {
+		http.Error(w, http.StatusText(422), 422)
+		return
+	}
+	data := &projectRequest{}
+	if err := render.Bind(r, data); err != nil {
+		render.Render(w, r, ErrInvalidRequest(err))
+		return
+	}
+
+	p, err := h.s.UpdateProject(r.Context(), p.ID, data.limits())
+	if err != nil {
+		render.Render(w, r, ErrInternalServer(err))
+		return
+	}
+	if err := render.Render(w, r, &projectResponse{Project: p}); err != nil {
+		render.Render(w, r, ErrRender(err))
+		return
+	}
+}
+
+func (h *handler) deleteProject(w http.ResponseWriter, r *http.Request) {
+	p, ok := r.Context().Value(webKey("project")).(*ent.Project)
+	if !ok {
+		http.Error(w, http.StatusText(422), 422)
+		return
+	}
+	if err := h.s.DeleteProject(r.Context(), p.ID); err != nil {
+		if errors.Is(err, master.ErrProjectNotEmpty) {
+			render.Render(w, r, ErrInvalidRequest(err))
+			return
+		}
+		render.Render(w, r, ErrInternalServer(err))
+		return
+	}
+	w.WriteHeader(http.StatusNoContent)
+}
+
+func (h *handler) listProjectMembers(w http.ResponseWriter, r *http.Request) {
+	p, ok := r.Context().Value(webKey("project")).(*ent.Project)
+	if !ok {
+		http.Error(w, http.StatusText(422), 422)
+		return
+	}
+	us, err := h.s.ProjectMembers(r.Context(), p.ID)
+	if err != nil {
+		render.Render(w, r, ErrInternalServer(err))
+		return
+	}
+	if err := render.RenderList(w, r, newUserListResponse(us)); err != nil {
+		render.Render(w, r, ErrRender(err))
+		return
+	}
+}
+
+// addProjectMember makes a user a member of a project
+// PUT /api/projects/{projectID}/members/{userID}
+func (h *handler) addProjectMember(w http.ResponseWriter, r *http.Request) {
+	p, ok := r.Context().Value(webKey("project")).(*ent.Project)
+	if !ok {
+		http.Error(w, http.StatusText(422), 422)
+		return
+	}
+	userID, err := strconv.Atoi(chi.URLParam(r, "userID"))
+	if err != nil {
+		render.Render(w, r, ErrNotFoundRequest(err))
+		return
+	}
+	if err := h.s.AddProjectMember(r.Context(), p.ID, userID); err != nil {
+		if ent.IsNotFound(err) {
+			render.Render(w, r, ErrNotFoundRequest(err))
+			return
+		}
+		render.Render(w, r, ErrInternalServer(err))
+		return
+	}
+	w.WriteHeader(http.StatusNoContent)
+}
+
+func (h *handler) removeProjectMember(w http.ResponseWriter, r *http.Request) {
+	p, ok := r.Context().Value(webKey("project")).(*ent.Project)
+	if !ok {
+		http.Error(w, http.StatusText(422), 422)
+		return
+	}
+	userID, err := strconv.Atoi(chi.URLParam(r, "userID"))
+	if err != nil {
+		render.Render(w, r, ErrNotFoundRequest(err))
+		return
+	}
+	if err := h.s.RemoveProjectMember(r.Context(), p.ID, userID); err != nil {
+		render.Render(w, r, ErrInternalServer(err))
+		return
+	}
+	w.WriteHeader(http.StatusNoContent)
+}
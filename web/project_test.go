@@ -0,0 +1,107 @@
+package web
+
+import (
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/gobench-io/gobench/ent"
+	"github.com/gobench-io/gobench/master"
+	"github.com/stretchr/testify/assert"
+
+	entUser "github.com/gobench-io/gobench/ent/user"
+)
+
+func TestProjects(t *testing.T) {
+	ctx := context.Background()
+	r, _, m := newAPITestMaster(t, "adminPassword")
+	admin := login(t, r, master.AdminUsername, "adminPassword")
+
+	w := serveAs(r, admin, "POST", "/api/projects", map[string]interface{}{
+		"name":      fmt.Sprintf("team-%d", time.Now().UnixNano()),
+		"maxQueued": 1,
+	})
+	assert.Equal(t, 201, w.Code)
+	var p ent.Project
+	_ = json.Unmarshal(w.Body.Bytes(), &p)
+	assert.Equal(t, 1, p.MaxQueued)
+	t.Cleanup(func() {
+		project, err := m.GetProject(ctx, p.ID)
+		if err != nil {
+			return
+		}
+		apps, _ := project.QueryApplications().All(ctx)
+		for _, app := range apps {
+			_ = m.DeleteApplication(ctx, app.ID)
+		}
+		_ = m.DeleteProject(ctx, p.ID)
+	})
+	projectURL := fmt.Sprintf("/api/projects/%d", p.ID)
+
+	member := seedUser(t, m, entUser.RoleRunner)
+	outsider := seedUser(t, m, entUser.RoleRunner)
+	memberToken := login(t, r, member.Username, "password")
+	outsiderToken := login(t, r, outsider.Username, "password")
+
+	newApp := map[string]interface{}{
+		"name":      "team app",
+		"scenario":  base64.StdEncoding.EncodeToString([]byte("scenario")),
+		"projectId": p.ID,
+	}
+
+	t.Run("only the members create applications", func(t *testing.T) {
+		assert.Equal(t, 403, serveAs(r, memberToken, "POST", "/api/applications", newApp).Code)
+
+		url := fmt.Sprintf("%s/members/%d", projectURL, member.ID)
+		// the project is hidden from who is not a member yet
+		assert.Equal(t, 404, serveAs(r, memberToken, "PUT", url, nil).Code)
+		assert.Equal(t, 204, serveAs(r, admin, "PUT", url, nil).Code)
+
+		w := serveAs(r, memberToken, "GET", projectURL+"/members", nil)
+		assert.Equal(t, 200, w.Code)
+		assert.Contains(t, w.Body.String(), member.Username)
+	})
+
+	var app applicationResponse
+	w = serveAs(r, memberToken, "POST", "/api/applications", newApp)
+	assert.Equal(t, 201, w.Code)
+	_ = json.Unmarshal(w.Body.Bytes(), &app)
+	assert.Equal(t, p.ID, app.ProjectID)
+	appURL := fmt.Sprintf("/api/applications/%d", app.ID)
+
+	t.Run("queue limit", func(t *testing.T) {
+		assert.Equal(t, 429, serveAs(r, memberToken, "POST", "/api/applications", newApp).Code)
+	})
+
+	t.Run("the applications are scoped by project", func(t *testing.T) {
+		assert.Equal(t, 200, serveAs(r, memberToken, "GET", appURL, nil).Code)
+		assert.Equal(t, 404, serveAs(r, outsiderToken, "GET", appURL, nil).Code)
+		assert.Equal(t, 404, serveAs(r, outsiderToken, "PUT", appURL+"/cancel", nil).Code)
+		assert.Equal(t, 404, serveAs(r, outsiderToken, "GET", projectURL, nil).Code)
+		assert.Equal(t, 404, serveAs(r, outsiderToken, "POST", "/api/query",
+			map[string]interface{}{"appId": app.ID, "expr": "1"}).Code)
+
+		list := fmt.Sprintf("/api/applications?project=%d", p.ID)
+		var apps []applicationResponse
+		_ = json.Unmarshal(serveAs(r, memberToken, "GET", list, nil).Body.Bytes(), &apps)
+		assert.Len(t, apps, 1)
+		_ = json.Unmarshal(serveAs(r, outsiderToken, "GET", list, nil).Body.Bytes(), &apps)
+		assert.Len(t, apps, 0)
+	})
+
+	t.Run("limits", func(t *testing.T) {
+		w := serveAs(r, admin, "PUT", projectURL, map[string]interface{}{
+			"maxQueued":       0,
+			"rollupRetention": 3600,
+		})
+		assert.Equal(t, 200, w.Code)
+		_ = json.Unmarshal(w.Body.Bytes(), &p)
+		assert.Equal(t, int64(3600), p.RollupRetention)
+		assert.Equal(t, 201, serveAs(r, memberToken, "POST", "/api/applications", newApp).Code)
+	})
+
+	assert.Equal(t, 400, serveAs(r, admin, "DELETE", projectURL, nil).Code)
+}
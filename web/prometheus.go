@@ -0,0 +1,23 @@
+package web
+
+import (
+	"bytes"
+	"net/http"
+
+	"github.com/gobench-io/gobench/master"
+)
+
+// prometheus exposes the master health and the latest metrics of the running
+// applications the user sees in Prometheus text format
+func (h *handler) prometheus(w http.ResponseWriter, r *http.Request) {
+	var buf bytes.Buffer
+
+	if err := h.s.WritePrometheus(r.Context(), &buf, visible(r)); err != nil {
+		h.logger.Errorw("failed write prometheus metrics", "err", err)
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	w.Header().Set("Content-Type", master.PrometheusContentType)
+	_, _ = buf.WriteTo(w)
+}
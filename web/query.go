@@ -0,0 +1,76 @@
+package web
+
+import (
+	"errors"
+	"net/http"
+
+	"github.com/go-chi/render"
+	"github.com/gobench-io/gobench/ent"
+	"github.com/gobench-io/gobench/expr"
+	"github.com/gobench-io/gobench/master"
+)
+
+// query request
+type queryRequest struct {
+	master.Query
+}
+
+func (qr *queryRequest) Bind(r *http.Request) error {
+	if qr.AppID == 0 {
+		return errors.New("appId required")
+	}
+	if qr.Expr == "" {
+		return errors.New("expr required")
+	}
+	if qr.Step < 0 {
+		return errors.New("step must be positive")
+	}
+	return nil
+}
+
+type queryResponse struct {
+	*master.QueryResult
+}
+
+func (qr *queryResponse) Render(w http.ResponseWriter, r *http.Request) error {
+	return nil
+}
+
+// query evaluates an expression over the metrics of an application
+// POST /api/query
+func (h *handler) query(w http.ResponseWriter, r *http.Request) {
+	data := &queryRequest{}
+	if err := render.Bind(r, data); err != nil {
+		render.Render(w, r, ErrInvalidRequest(err))
+		return
+	}
+	ok, err := h.appVisible(r, data.AppID)
+	if err != nil {
+		render.Render(w, r, ErrInternalServer(err))
+		return
+	}
+	if !ok {
+		render.Render(w, r, ErrNotFoundRequest(errNotMember))
+		return
+	}
+
+	res, err := h.s.Query(r.Context(), data.Query)
+	if err != nil {
+		var exprErr *expr.Error
+		if errors.As(err, &exprErr) {
+			render.Render(w, r, ErrInvalidRequest(err))
+			return
+		}
+		if ent.IsNotFound(err) {
+			render.Render(w, r, ErrNotFoundRequest(err))
+			return
+		}
+		render.Render(w, r, ErrInternalServer(err))
+		return
+	}
+
+	if err := render.Render(w, r, &queryResponse{res}); err != nil {
+		render.Render(w, r, ErrRender(err))
+		return
+	}
+}
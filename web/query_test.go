@@ -0,0 +1,63 @@
+package web
+
+import (
+	"bytes"
+	"encoding/json"
+	"net/http"
+	"testing"
+
+	"github.com/gobench-io/gobench/master"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestQuery(t *testing.T) {
+	app := newApp(t, "query", "scenario")
+	_, _, m := newAPITestMaster(t, "")
+	t0 := int64(1000 * 60000)
+	seedAppMetrics(t, m.DbClient(), app, "e1", t0, 6)
+
+	post := func(body interface{}) (int, []byte) {
+		r, w := newAPITest(t, "")
+		b, _ := json.Marshal(body)
+		req, _ := http.NewRequest("POST", "/api/query", bytes.NewBuffer(b))
+		req.Header.Set("Content-Type", "application/json")
+		r.ServeHTTP(w, req)
+		return w.Code, w.Body.Bytes()
+	}
+
+	code, body := post(map[string]interface{}{
+		"appId": app.ID,
+		"expr":  "home.conns * 2",
+		"from":  t0,
+		"end":   t0 + 60000,
+		"step":  20000,
+	})
+	assert.Equal(t, 200, code)
+
+	var res master.QueryResult
+	assert.Nil(t, json.Unmarshal(body, &res))
+	assert.Equal(t, "home.conns * 2", res.Expr)
+	if assert.Len(t, res.Series, 1) {
+		assert.Equal(t, "e1", res.Series[0].EID)
+		values := []float64{}
+		for _, p := range res.Series[0].Points {
+			values = append(values, p.Value)
+		}
+		assert.Equal(t, []float64{4, 8, 12}, values)
+	}
+
+	for _, c := range []struct {
+		body map[string]interface{}
+		code int
+	}{
+		{map[string]interface{}{"expr": "home.conns"}, 400},
+		{map[string]interface{}{"appId": app.ID}, 400},
+		{map[string]interface{}{"appId": app.ID, "expr": "home.conns +"}, 400},
+		{map[string]interface{}{"appId": app.ID, "expr": "home.foo"}, 400},
+		{map[string]interface{}{"appId": app.ID, "expr": "home.conns", "step": -1}, 400},
+		{map[string]interface{}{"appId": app.ID + 1000, "expr": "home.conns"}, 404},
+	} {
+		code, body := post(c.body)
+		assert.Equal(t, c.code, code, string(body))
+	}
+}
@@ -7,6 +7,7 @@ import (
 
 	"github.com/gobench-io/gobench/ent"
 	"github.com/gobench-io/gobench/master"
+	"github.com/gobench-io/gobench/sink"
 )
 
 // Err is the error struct that compatible to Google API recommendation
@@ -48,6 +49,26 @@ func ErrUnauthenticated(err error) render.Renderer {
 	}
 }
 
+func ErrForbidden(err error) render.Renderer {
+	return &ErrResponse{
+		Error: Err{
+			Code:    403,
+			Message: err.Error(),
+			Status:  "Permission Denied",
+		},
+	}
+}
+
+func ErrTooManyRequests(err error) render.Renderer {
+	return &ErrResponse{
+		Error: Err{
+			Code:    429,
+			Message: err.Error(),
+			Status:  "Too Many Requests",
+		},
+	}
+}
+
 func ErrAppIsFinished(err error) render.Renderer {
 	return &ErrResponse{
 		Error: Err{
@@ -91,7 +112,26 @@ func ErrRender(err error) render.Renderer {
 // application response
 type applicationRequest struct {
 	*ent.Application
-	ProtectedID int `json:"id"`
+	ProtectedID int           `json:"id"`
+	ProjectID   int           `json:"projectId"` // the default project when 0
+	Sinks       []sinkRequest `json:"sinks"`
+}
+
+// sink request, the token is accepted but never rendered back
+type sinkRequest struct {
+	sink.Config
+	Token string `json:"token"`
+}
+
+// sinks returns the sink configs of the request with their tokens
+func (a *applicationRequest) sinks() []sink.Config {
+	cs := make([]sink.Config, 0, len(a.Sinks))
+	for _, s := range a.Sinks {
+		c := s.Config
+		c.Token = s.Token
+		cs = append(cs, c)
+	}
+	return cs
 }
 
 func (a *applicationRequest) Bind(r *http.Request) (err error) {
@@ -100,7 +140,8 @@ func (a *applicationRequest) Bind(r *http.Request) (err error) {
 
 type applicationResponse struct {
 	*ent.Application
-	Edges *struct{} `json:"edges,omitempty"`
+	ProjectID int       `json:"projectId,omitempty"`
+	Edges     *struct{} `json:"edges,omitempty"`
 }
 
 type countApplicationResponse struct {
@@ -122,10 +163,11 @@ func newCountApplicationResponse(count int) *countApplicationResponse {
 }
 
 func newApplicationResponse(a *ent.Application) *applicationResponse {
-	return &applicationResponse{
-		a,
-		nil,
+	ar := &applicationResponse{Application: a}
+	if a != nil && a.Edges.Project != nil {
+		ar.ProjectID = a.Edges.Project.ID
 	}
+	return ar
 }
 
 func newApplicationListResponse(aps []*ent.Application) []render.Renderer {
@@ -215,6 +257,7 @@ func newMetricListResponse(ms []*ent.Metric) []render.Renderer {
 type counterResponse struct {
 	*ent.Counter
 	Count int64     `json:"count"`
+	WID   string    `json:"wId"`
 	Edges *struct{} `json:"edges,omitempty"`
 }
 
@@ -226,6 +269,7 @@ func newCounterResponse(e *ent.Counter) *counterResponse {
 	return &counterResponse{
 		e,
 		e.Count,
+		master.ExecutorEID(e.Edges.Executor),
 		nil,
 	}
 }
@@ -241,6 +285,7 @@ func newCounterListResponse(es []*ent.Counter) []render.Renderer {
 // histogram response
 type histogramResponse struct {
 	*ent.Histogram
+	WID   string    `json:"wId"`
 	Edges *struct{} `json:"edges,omitempty"`
 }
 
@@ -251,6 +296,7 @@ func (gr *histogramResponse) Render(w http.ResponseWriter, r *http.Request) erro
 func newHistogramResponse(e *ent.Histogram) *histogramResponse {
 	return &histogramResponse{
 		e,
+		master.ExecutorEID(e.Edges.Executor),
 		nil,
 	}
 }
@@ -266,6 +312,7 @@ func newHistogramListResponse(es []*ent.Histogram) []render.Renderer {
 // gauge response
 type gaugeResponse struct {
 	*ent.Gauge
+	WID   string    `json:"wId"`
 	Edges *struct{} `json:"edges,omitempty"`
 }
 
@@ -276,6 +323,7 @@ func (gr *gaugeResponse) Render(w http.ResponseWriter, r *http.Request) error {
 func newGaugeResponse(e *ent.Gauge) *gaugeResponse {
 	return &gaugeResponse{
 		e,
+		master.ExecutorEID(e.Edges.Executor),
 		nil,
 	}
 }
@@ -288,6 +336,55 @@ func newGaugeListResponse(es []*ent.Gauge) []render.Renderer {
 	return list
 }
 
+// bucket responses of the points bucketed by steps
+type counterBucketResponse struct {
+	*master.CounterBucket
+}
+
+func (br *counterBucketResponse) Render(w http.ResponseWriter, r *http.Request) error {
+	return nil
+}
+
+func newCounterBucketListResponse(bs []*master.CounterBucket) []render.Renderer {
+	list := []render.Renderer{}
+	for _, b := range bs {
+		list = append(list, &counterBucketResponse{b})
+	}
+	return list
+}
+
+type histogramBucketResponse struct {
+	*master.HistogramBucket
+}
+
+func (br *histogramBucketResponse) Render(w http.ResponseWriter, r *http.Request) error {
+	return nil
+}
+
+func newHistogramBucketListResponse(bs []*master.HistogramBucket) []render.Renderer {
+	list := []render.Renderer{}
+	for _, b := range bs {
+		list = append(list, &histogramBucketResponse{b})
+	}
+	return list
+}
+
+type gaugeBucketResponse struct {
+	*master.GaugeBucket
+}
+
+func (br *gaugeBucketResponse) Render(w http.ResponseWriter, r *http.Request) error {
+	return nil
+}
+
+func newGaugeBucketListResponse(bs []*master.GaugeBucket) []render.Renderer {
+	list := []render.Renderer{}
+	for _, b := range bs {
+		list = append(list, &gaugeBucketResponse{b})
+	}
+	return list
+}
+
 type accesstokenRequest struct {
 	Username string
 	Password string
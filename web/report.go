@@ -0,0 +1,50 @@
+package web
+
+import (
+	"fmt"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"os"
+
+	"github.com/go-chi/render"
+	"github.com/gobench-io/gobench/ent"
+	"github.com/gobench-io/gobench/master"
+)
+
+// getApplicationReport renders the self-contained HTML report of an
+// application
+// GET /api/applications/{id}/report.html
+func (h *handler) getApplicationReport(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	app, ok := ctx.Value(webKey("application")).(*ent.Application)
+	if !ok {
+		http.Error(w, http.StatusText(422), 422)
+		return
+	}
+
+	// render to a file first, so that an error can still be returned
+	f, err := ioutil.TempFile("", "gobench-report-*")
+	if err != nil {
+		render.Render(w, r, ErrInternalServer(err))
+		return
+	}
+	defer os.Remove(f.Name())
+	defer f.Close()
+
+	if err = h.s.WriteReport(ctx, app.ID, f); err != nil {
+		render.Render(w, r, ErrInternalServer(err))
+		return
+	}
+	if _, err = f.Seek(0, io.SeekStart); err != nil {
+		render.Render(w, r, ErrInternalServer(err))
+		return
+	}
+
+	w.Header().Set("Content-Type", master.ReportContentType)
+	if r.URL.Query().Get("download") == "true" {
+		w.Header().Set("Content-Disposition",
+			fmt.Sprintf(`attachment; filename="application-%d.html"`, app.ID))
+	}
+	_, _ = io.Copy(w, f)
+}
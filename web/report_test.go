@@ -0,0 +1,50 @@
+package web
+
+import (
+	"fmt"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestGetApplicationReport(t *testing.T) {
+	app := newApp(t, "report", "scenario")
+	_, _, m := newAPITestMaster(t, "")
+	seedAppMetrics(t, m.DbClient(), app, "e1", 1000, 2)
+
+	t.Run("inline", func(t *testing.T) {
+		r, w := newAPITest(t, "")
+		req, _ := http.NewRequest("GET", fmt.Sprintf("/api/applications/%d/report.html", app.ID), nil)
+		r.ServeHTTP(w, req)
+
+		assert.Equal(t, 200, w.Code)
+		assert.Equal(t, "text/html; charset=utf-8", w.Header().Get("Content-Type"))
+		assert.Equal(t, "", w.Header().Get("Content-Disposition"))
+		assert.Contains(t, w.Body.String(), "<svg")
+	})
+
+	t.Run("outlives the request timeout", func(t *testing.T) {
+		defer func(d time.Duration) { requestTimeout = d }(requestTimeout)
+		requestTimeout = time.Nanosecond
+
+		r, w := newAPITest(t, "")
+		req, _ := http.NewRequest("GET", fmt.Sprintf("/api/applications/%d/report.html", app.ID), nil)
+		r.ServeHTTP(w, req)
+
+		assert.Equal(t, 200, w.Code)
+		assert.Contains(t, w.Body.String(), "<svg")
+	})
+
+	t.Run("download", func(t *testing.T) {
+		r, w := newAPITest(t, "")
+		req, _ := http.NewRequest("GET",
+			fmt.Sprintf("/api/applications/%d/report.html?download=true", app.ID), nil)
+		r.ServeHTTP(w, req)
+
+		assert.Equal(t, 200, w.Code)
+		assert.Contains(t, w.Header().Get("Content-Disposition"),
+			fmt.Sprintf("application-%d.html", app.ID))
+	})
+}
@@ -0,0 +1,66 @@
+package web
+
+import (
+	"bytes"
+	"net/http"
+
+	"github.com/go-chi/render"
+	"github.com/gobench-io/gobench/ent"
+	"github.com/gobench-io/gobench/master"
+)
+
+type resultResponse struct {
+	*master.Result
+}
+
+func (rr *resultResponse) Render(w http.ResponseWriter, r *http.Request) error {
+	return nil
+}
+
+// getApplicationResult returns the compact JSON result of an application
+// GET /api/applications/{id}/result.json
+func (h *handler) getApplicationResult(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	app, ok := ctx.Value(webKey("application")).(*ent.Application)
+	if !ok {
+		http.Error(w, http.StatusText(422), 422)
+		return
+	}
+
+	res, err := h.s.ApplicationResult(ctx, app.ID)
+	if err != nil {
+		render.Render(w, r, ErrInternalServer(err))
+		return
+	}
+
+	if err := render.Render(w, r, &resultResponse{res}); err != nil {
+		render.Render(w, r, ErrRender(err))
+		return
+	}
+}
+
+// getApplicationJUnit returns the result of an application as JUnit XML
+// GET /api/applications/{id}/junit.xml
+func (h *handler) getApplicationJUnit(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	app, ok := ctx.Value(webKey("application")).(*ent.Application)
+	if !ok {
+		http.Error(w, http.StatusText(422), 422)
+		return
+	}
+
+	res, err := h.s.ApplicationResult(ctx, app.ID)
+	if err != nil {
+		render.Render(w, r, ErrInternalServer(err))
+		return
+	}
+
+	var buf bytes.Buffer
+	if err := res.WriteJUnit(&buf); err != nil {
+		render.Render(w, r, ErrInternalServer(err))
+		return
+	}
+
+	w.Header().Set("Content-Type", master.JUnitContentType)
+	_, _ = w.Write(buf.Bytes())
+}
@@ -0,0 +1,50 @@
+package web
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/gobench-io/gobench/master"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestGetApplicationResult(t *testing.T) {
+	app := newApp(t, "result", "scenario")
+	_, _, m := newAPITestMaster(t, "")
+	seedAppMetrics(t, m.DbClient(), app, "e1", 1000, 2)
+
+	t.Run("json", func(t *testing.T) {
+		r, w := newAPITest(t, "")
+		req, _ := http.NewRequest("GET", fmt.Sprintf("/api/applications/%d/result.json", app.ID), nil)
+		r.ServeHTTP(w, req)
+
+		assert.Equal(t, 200, w.Code)
+		res := new(master.Result)
+		assert.Nil(t, json.Unmarshal(w.Body.Bytes(), res))
+		assert.Equal(t, app.ID, res.ID)
+		assert.Equal(t, "pending", res.Status)
+		assert.False(t, res.Passed)
+		assert.True(t, res.Requests > 0)
+	})
+
+	t.Run("junit", func(t *testing.T) {
+		r, w := newAPITest(t, "")
+		req, _ := http.NewRequest("GET", fmt.Sprintf("/api/applications/%d/junit.xml", app.ID), nil)
+		r.ServeHTTP(w, req)
+
+		assert.Equal(t, 200, w.Code)
+		assert.Equal(t, master.JUnitContentType, w.Header().Get("Content-Type"))
+		assert.Contains(t, w.Body.String(), `<testsuites name="gobench" tests="1" failures="1"`)
+		assert.Contains(t, w.Body.String(), `<testcase name="run" classname="gobench.result"`)
+	})
+
+	t.Run("not found", func(t *testing.T) {
+		r, w := newAPITest(t, "")
+		req, _ := http.NewRequest("GET", "/api/applications/0/junit.xml", nil)
+		r.ServeHTTP(w, req)
+
+		assert.Equal(t, 404, w.Code)
+	})
+}
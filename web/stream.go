@@ -0,0 +1,97 @@
+package web
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/gobench-io/gobench/ent"
+	"github.com/gobench-io/gobench/master"
+)
+
+// streamKeepAlive is the interval of the comments that keep an idle stream
+// open through proxies
+const streamKeepAlive = 15 * time.Second
+
+// writeEvent writes an event in the server-sent events format
+func writeEvent(w http.ResponseWriter, e *master.Event) error {
+	data, err := json.Marshal(e)
+	if err != nil {
+		return err
+	}
+	_, err = fmt.Fprintf(w, "event: %s\ndata: %s\n\n", e.Type, data)
+	return err
+}
+
+// streamApplication pushes the job status transitions and the new metric
+// points of an application as server-sent events. The current status is
+// sent first, the stream ends after the application reaches a final status.
+// GET /api/applications/{id}/stream
+func (h *handler) streamApplication(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	app, ok := ctx.Value(webKey("application")).(*ent.Application)
+	if !ok {
+		http.Error(w, http.StatusText(422), 422)
+		return
+	}
+	flusher, ok := w.(http.Flusher)
+	if !ok {
+		http.Error(w, "streaming unsupported", http.StatusInternalServerError)
+		return
+	}
+
+	// subscribe before reading the status, so that no transition is missed
+	events, unsubscribe := h.s.Subscribe(app.ID)
+	defer unsubscribe()
+
+	app, err := h.db().Application.Get(ctx, app.ID)
+	if err != nil {
+		http.Error(w, http.StatusText(500), 500)
+		return
+	}
+
+	w.Header().Set("Content-Type", "text/event-stream")
+	w.Header().Set("Cache-Control", "no-cache")
+	w.Header().Set("Connection", "keep-alive")
+	w.Header().Set("X-Accel-Buffering", "no")
+	w.WriteHeader(http.StatusOK)
+
+	send := func(e *master.Event) bool {
+		if err := writeEvent(w, e); err != nil {
+			return false
+		}
+		flusher.Flush()
+		return true
+	}
+
+	if !send(&master.Event{
+		Type:  master.EventStatus,
+		AppID: app.ID,
+		Data:  &master.StatusEvent{Status: app.Status},
+	}) || master.IsTerminal(app.Status) {
+		return
+	}
+
+	keepAlive := time.NewTicker(streamKeepAlive)
+	defer keepAlive.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-keepAlive.C:
+			if _, err := fmt.Fprint(w, ": keep-alive\n\n"); err != nil {
+				return
+			}
+			flusher.Flush()
+		case e, ok := <-events:
+			if !ok || !send(e) {
+				return
+			}
+			if s, ok := e.Data.(*master.StatusEvent); ok && master.IsTerminal(s.Status) {
+				return
+			}
+		}
+	}
+}
@@ -0,0 +1,115 @@
+package web
+
+import (
+	"bufio"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/gobench-io/gobench/master"
+	"github.com/stretchr/testify/assert"
+)
+
+// readEvent reads the next server-sent event, skipping the comments
+func readEvent(t *testing.T, br *bufio.Reader) (string, *master.Event) {
+	var typ string
+	e := new(master.Event)
+	for {
+		line, err := br.ReadString('\n')
+		if !assert.Nil(t, err) {
+			t.FailNow()
+		}
+		line = strings.TrimSuffix(line, "\n")
+		switch {
+		case strings.HasPrefix(line, "event: "):
+			typ = strings.TrimPrefix(line, "event: ")
+		case strings.HasPrefix(line, "data: "):
+			assert.Nil(t, json.Unmarshal([]byte(strings.TrimPrefix(line, "data: ")), e))
+		case line == "" && typ != "":
+			return typ, e
+		}
+	}
+}
+
+func TestStreamApplication(t *testing.T) {
+	app := newApp(t, "stream", "scenario")
+	r, _, m := newAPITestMaster(t, "")
+	srv := httptest.NewServer(r)
+	defer srv.Close()
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	req, _ := http.NewRequestWithContext(ctx, "GET",
+		fmt.Sprintf("%s/api/applications/%d/stream", srv.URL, app.ID), nil)
+	res, err := http.DefaultClient.Do(req)
+	assert.Nil(t, err)
+	defer res.Body.Close()
+
+	assert.Equal(t, 200, res.StatusCode)
+	assert.Equal(t, "text/event-stream", res.Header.Get("Content-Type"))
+
+	br := bufio.NewReader(res.Body)
+
+	// the current status first
+	typ, e := readEvent(t, br)
+	assert.Equal(t, master.EventStatus, typ)
+	assert.Equal(t, app.ID, e.AppID)
+	assert.Equal(t, "pending", e.Data.(map[string]interface{})["status"])
+
+	// a transition ends the stream once the application is canceled
+	_, err = m.CancelApplication(ctx, app.ID)
+	assert.Nil(t, err)
+
+	typ, e = readEvent(t, br)
+	assert.Equal(t, master.EventStatus, typ)
+	assert.Equal(t, "cancel", e.Data.(map[string]interface{})["status"])
+
+	_, err = br.ReadString('\n')
+	assert.NotNil(t, err)
+}
+
+func TestStreamTerminalApplication(t *testing.T) {
+	app := newApp(t, "stream", "scenario")
+	r, w, m := newAPITestMaster(t, "")
+	_, err := m.CancelApplication(context.Background(), app.ID)
+	assert.Nil(t, err)
+
+	req, _ := http.NewRequest("GET", fmt.Sprintf("/api/applications/%d/stream", app.ID), nil)
+	r.ServeHTTP(w, req)
+
+	assert.Equal(t, 200, w.Code)
+	assert.Equal(t, "event: status\ndata: "+
+		fmt.Sprintf(`{"type":"status","appId":%d,"data":{"status":"cancel"}}`, app.ID)+"\n\n",
+		w.Body.String())
+}
+
+func TestStreamOutlivesRequestTimeout(t *testing.T) {
+	defer func(d time.Duration) { requestTimeout = d }(requestTimeout)
+	requestTimeout = 50 * time.Millisecond
+
+	app := newApp(t, "stream", "scenario")
+	r, _, m := newAPITestMaster(t, "")
+	srv := httptest.NewServer(r)
+	defer srv.Close()
+
+	res, err := http.Get(fmt.Sprintf("%s/api/applications/%d/stream", srv.URL, app.ID))
+	assert.Nil(t, err)
+	defer res.Body.Close()
+	br := bufio.NewReader(res.Body)
+	typ, _ := readEvent(t, br)
+	assert.Equal(t, master.EventStatus, typ)
+
+	time.Sleep(4 * requestTimeout)
+	_, err = m.CancelApplication(context.Background(), app.ID)
+	assert.Nil(t, err)
+
+	typ, e := readEvent(t, br)
+	assert.Equal(t, master.EventStatus, typ)
+	assert.Equal(t, "cancel", e.Data.(map[string]interface{})["status"])
+}
@@ -0,0 +1,152 @@
+package web
+
+import (
+	"errors"
+	"net/http"
+	"strconv"
+
+	"github.com/go-chi/chi"
+	"github.com/go-chi/render"
+
+	"github.com/gobench-io/gobench/ent"
+	"github.com/gobench-io/gobench/master"
+)
+
+// errOpenSubscriptions is returned for the email notifications when the API
+// is open, there is no user to email
+var errOpenSubscriptions = errors.New("email notifications need the --admin-password")
+
+// me request, the settings a user changes itself
+type meRequest struct {
+	Email string `json:"email"`
+}
+
+func (mr *meRequest) Bind(r *http.Request) error {
+	return nil
+}
+
+// subscription request, either to an application or to a tag
+type subscriptionRequest struct {
+	AppID int    `json:"appId"`
+	Tag   string `json:"tag"`
+}
+
+func (sr *subscriptionRequest) Bind(r *http.Request) error {
+	return nil
+}
+
+// subscription response
+type subscriptionResponse struct {
+	*ent.Subscription
+	Edges *struct{} `json:"edges,omitempty"`
+}
+
+func (sr *subscriptionResponse) Render(w http.ResponseWriter, r *http.Request) error {
+	return nil
+}
+
+// updateMe sets the email of the current user, an empty email receives no
+// notification
+// PUT /api/users/me
+func (h *handler) updateMe(w http.ResponseWriter, r *http.Request) {
+	if !h.auth {
+		render.Render(w, r, ErrInvalidRequest(errOpenSubscriptions))
+		return
+	}
+	data := &meRequest{}
+	if err := render.Bind(r, data); err != nil {
+		render.Render(w, r, ErrInvalidRequest(err))
+		return
+	}
+	u, err := h.s.SetUserEmail(r.Context(), currentUser(r).ID, data.Email)
+	if err != nil {
+		if errors.Is(err, master.ErrInvalidEmail) || ent.IsValidationError(err) {
+			render.Render(w, r, ErrInvalidRequest(err))
+			return
+		}
+		render.Render(w, r, ErrInternalServer(err))
+		return
+	}
+	if err := render.Render(w, r, &userResponse{u}); err != nil {
+		render.Render(w, r, ErrRender(err))
+		return
+	}
+}
+
+func (h *handler) listSubscriptions(w http.ResponseWriter, r *http.Request) {
+	if !h.auth {
+		render.Render(w, r, ErrInvalidRequest(errOpenSubscriptions))
+		return
+	}
+	ss, err := h.s.ListSubscriptions(r.Context(), currentUser(r).ID)
+	if err != nil {
+		render.Render(w, r, ErrInternalServer(err))
+		return
+	}
+	list := []render.Renderer{}
+	for _, s := range ss {
+		list = append(list, &subscriptionResponse{Subscription: s})
+	}
+	if err := render.RenderList(w, r, list); err != nil {
+		render.Render(w, r, ErrRender(err))
+		return
+	}
+}
+
+// createSubscription emails the current user when an application it sees
+// ends, or an application with a tag
+// POST /api/users/me/subscriptions
+func (h *handler) createSubscription(w http.ResponseWriter, r *http.Request) {
+	if !h.auth {
+		render.Render(w, r, ErrInvalidRequest(errOpenSubscriptions))
+		return
+	}
+	data := &subscriptionRequest{}
+	if err := render.Bind(r, data); err != nil {
+		render.Render(w, r, ErrInvalidRequest(err))
+		return
+	}
+	if data.AppID != 0 {
+		ok, err := h.appVisible(r, data.AppID)
+		if err != nil {
+			render.Render(w, r, ErrInternalServer(err))
+			return
+		}
+		if !ok {
+			render.Render(w, r, ErrNotFoundRequest(errors.New("Application not found")))
+			return
+		}
+	}
+
+	s, err := h.s.CreateSubscription(r.Context(), currentUser(r).ID, data.AppID, data.Tag)
+	if err != nil {
+		if errors.Is(err, master.ErrSubscription) {
+			render.Render(w, r, ErrInvalidRequest(err))
+			return
+		}
+		render.Render(w, r, ErrInternalServer(err))
+		return
+	}
+
+	render.Status(r, http.StatusCreated)
+	render.Render(w, r, &subscriptionResponse{Subscription: s})
+}
+
+// deleteSubscription deletes a subscription of the current user
+// DELETE /api/users/me/subscriptions/{subscriptionID}
+func (h *handler) deleteSubscription(w http.ResponseWriter, r *http.Request) {
+	id, err := strconv.Atoi(chi.URLParam(r, "subscriptionID"))
+	if err != nil {
+		render.Render(w, r, ErrNotFoundRequest(err))
+		return
+	}
+	if err := h.s.DeleteSubscription(r.Context(), currentUser(r).ID, id); err != nil {
+		if ent.IsNotFound(err) {
+			render.Render(w, r, ErrNotFoundRequest(err))
+			return
+		}
+		render.Render(w, r, ErrInternalServer(err))
+		return
+	}
+	w.WriteHeader(http.StatusNoContent)
+}
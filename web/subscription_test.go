@@ -0,0 +1,74 @@
+package web
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/gobench-io/gobench/ent"
+	"github.com/gobench-io/gobench/master"
+	"github.com/stretchr/testify/assert"
+
+	entUser "github.com/gobench-io/gobench/ent/user"
+)
+
+func TestSubscriptions(t *testing.T) {
+	ctx := context.Background()
+	r, _, m := newAPITestMaster(t, "adminPassword")
+
+	u := seedUser(t, m, entUser.RoleViewer)
+	token := login(t, r, u.Username, "password")
+
+	t.Run("email", func(t *testing.T) {
+		w := serveAs(r, token, "PUT", "/api/users/me", map[string]string{"email": "viewer@example.com"})
+		assert.Equal(t, 200, w.Code)
+		assert.Contains(t, w.Body.String(), `"email":"viewer@example.com"`)
+		assert.Equal(t, 400, serveAs(r, token, "PUT", "/api/users/me",
+			map[string]string{"email": "viewer"}).Code)
+	})
+
+	app, err := m.NewApplication(ctx, "subscribed", "scenario", "", "")
+	assert.Nil(t, err)
+	t.Cleanup(func() { _ = m.DeleteApplication(ctx, app.ID) })
+
+	var sub ent.Subscription
+	w := serveAs(r, token, "POST", "/api/users/me/subscriptions", map[string]interface{}{"appId": app.ID})
+	assert.Equal(t, 201, w.Code)
+	_ = json.Unmarshal(w.Body.Bytes(), &sub)
+	assert.Equal(t, app.ID, sub.ApplicationID)
+	assert.Equal(t, 201, serveAs(r, token, "POST", "/api/users/me/subscriptions",
+		map[string]interface{}{"tag": "nightly"}).Code)
+
+	t.Run("invalid", func(t *testing.T) {
+		assert.Equal(t, 400, serveAs(r, token, "POST", "/api/users/me/subscriptions",
+			map[string]interface{}{"appId": app.ID, "tag": "nightly"}).Code)
+		assert.Equal(t, 400, serveAs(r, token, "POST", "/api/users/me/subscriptions",
+			map[string]interface{}{}).Code)
+	})
+
+	t.Run("only to the visible applications", func(t *testing.T) {
+		p, err := m.CreateProject(ctx, fmt.Sprintf("private-%d", time.Now().UnixNano()), master.ProjectLimits{})
+		assert.Nil(t, err)
+		hidden, err := m.NewApplication(ctx, "hidden", "scenario", "", "", master.WithProject(p.ID))
+		assert.Nil(t, err)
+		t.Cleanup(func() {
+			_ = m.DeleteApplication(ctx, hidden.ID)
+			_ = m.DeleteProject(ctx, p.ID)
+		})
+		assert.Equal(t, 404, serveAs(r, token, "POST", "/api/users/me/subscriptions",
+			map[string]interface{}{"appId": hidden.ID}).Code)
+	})
+
+	w = serveAs(r, token, "GET", "/api/users/me/subscriptions", nil)
+	assert.Equal(t, 200, w.Code)
+	var subs []ent.Subscription
+	_ = json.Unmarshal(w.Body.Bytes(), &subs)
+	assert.Len(t, subs, 2)
+
+	url := fmt.Sprintf("/api/users/me/subscriptions/%d", sub.ID)
+	other := login(t, r, seedUser(t, m, entUser.RoleViewer).Username, "password")
+	assert.Equal(t, 404, serveAs(r, other, "DELETE", url, nil).Code)
+	assert.Equal(t, 204, serveAs(r, token, "DELETE", url, nil).Code)
+}
@@ -0,0 +1,39 @@
+package web
+
+import (
+	"net/http"
+
+	"github.com/go-chi/render"
+	"github.com/gobench-io/gobench/ent"
+	"github.com/gobench-io/gobench/master"
+)
+
+type summaryResponse struct {
+	*master.Summary
+}
+
+func (sr *summaryResponse) Render(w http.ResponseWriter, r *http.Request) error {
+	return nil
+}
+
+// getApplicationSummary returns the whole run statistics of an application
+// GET /api/applications/{id}/summary
+func (h *handler) getApplicationSummary(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	app, ok := ctx.Value(webKey("application")).(*ent.Application)
+	if !ok {
+		http.Error(w, http.StatusText(422), 422)
+		return
+	}
+
+	s, err := h.s.ApplicationSummary(ctx, app)
+	if err != nil {
+		render.Render(w, r, ErrInternalServer(err))
+		return
+	}
+
+	if err := render.Render(w, r, &summaryResponse{s}); err != nil {
+		render.Render(w, r, ErrRender(err))
+		return
+	}
+}
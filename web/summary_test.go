@@ -0,0 +1,32 @@
+package web
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/gobench-io/gobench/master"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestGetApplicationSummary(t *testing.T) {
+	app := newApp(t, "summary", "scenario")
+	_, _, m := newAPITestMaster(t, "")
+	seedAppMetrics(t, m.DbClient(), app, "e1", 1000, 2)
+	seedAppMetrics(t, m.DbClient(), app, "e2", 1000, 3)
+
+	r, w := newAPITest(t, "")
+	req, _ := http.NewRequest("GET", fmt.Sprintf("/api/applications/%d/summary", app.ID), nil)
+	r.ServeHTTP(w, req)
+
+	assert.Equal(t, 200, w.Code)
+
+	var s master.Summary
+	assert.Nil(t, json.Unmarshal(w.Body.Bytes(), &s))
+	assert.Equal(t, app.ID, s.ApplicationID)
+	// 200 from e1 and 300 from e2
+	assert.EqualValues(t, 500, s.Requests)
+	assert.EqualValues(t, 0, s.Errors)
+	assert.InDelta(t, 30, s.P95, 0.001)
+}
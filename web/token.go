@@ -0,0 +1,122 @@
+package web
+
+import (
+	"errors"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/go-chi/chi"
+	"github.com/go-chi/render"
+
+	"github.com/gobench-io/gobench/ent"
+	"github.com/gobench-io/gobench/master"
+
+	entToken "github.com/gobench-io/gobench/ent/apitoken"
+)
+
+// errOpenTokens is returned for the API tokens when the API is open
+var errOpenTokens = errors.New("API tokens need the --admin-password")
+
+// API token request, a token without expiresAt never expires
+type apiTokenRequest struct {
+	Name      string         `json:"name"`
+	Scope     entToken.Scope `json:"scope"`
+	ExpiresAt *time.Time     `json:"expiresAt"`
+}
+
+func (tr *apiTokenRequest) Bind(r *http.Request) error {
+	if tr.Name == "" {
+		return errors.New("name required")
+	}
+	if tr.Scope == "" {
+		tr.Scope = entToken.DefaultScope
+	}
+	if tr.ExpiresAt != nil && tr.ExpiresAt.Before(time.Now()) {
+		return errors.New("expiresAt must be in the future")
+	}
+	return entToken.ScopeValidator(tr.Scope)
+}
+
+// API token response, with the token only once created
+type apiTokenResponse struct {
+	*ent.APIToken
+	Token string    `json:"token,omitempty"`
+	Edges *struct{} `json:"edges,omitempty"`
+}
+
+func (tr *apiTokenResponse) Render(w http.ResponseWriter, r *http.Request) error {
+	return nil
+}
+
+func newAPITokenListResponse(ts []*ent.APIToken) []render.Renderer {
+	list := []render.Renderer{}
+	for _, t := range ts {
+		list = append(list, &apiTokenResponse{APIToken: t})
+	}
+	return list
+}
+
+func (h *handler) listAPITokens(w http.ResponseWriter, r *http.Request) {
+	if !h.auth {
+		render.Render(w, r, ErrInvalidRequest(errOpenTokens))
+		return
+	}
+	ts, err := h.s.ListAPITokens(r.Context(), currentUser(r).ID)
+	if err != nil {
+		render.Render(w, r, ErrInternalServer(err))
+		return
+	}
+	if err := render.RenderList(w, r, newAPITokenListResponse(ts)); err != nil {
+		render.Render(w, r, ErrRender(err))
+		return
+	}
+}
+
+// createAPIToken creates an API token of the current user, the response is
+// the only time the token is shown
+// POST /api/users/me/tokens
+func (h *handler) createAPIToken(w http.ResponseWriter, r *http.Request) {
+	if !h.auth {
+		render.Render(w, r, ErrInvalidRequest(errOpenTokens))
+		return
+	}
+	data := &apiTokenRequest{}
+	if err := render.Bind(r, data); err != nil {
+		render.Render(w, r, ErrInvalidRequest(err))
+		return
+	}
+
+	t, token, err := h.s.CreateAPIToken(r.Context(), currentUser(r).ID,
+		data.Name, data.Scope, data.ExpiresAt)
+	if err != nil {
+		if errors.Is(err, master.ErrTokenScope) || ent.IsValidationError(err) {
+			render.Render(w, r, ErrInvalidRequest(err))
+			return
+		}
+		render.Render(w, r, ErrInternalServer(err))
+		return
+	}
+
+	render.Status(r, http.StatusCreated)
+	render.Render(w, r, &apiTokenResponse{APIToken: t, Token: token})
+}
+
+// deleteAPIToken revokes an API token of the current user
+// DELETE /api/users/me/tokens/{tokenID}
+func (h *handler) deleteAPIToken(w http.ResponseWriter, r *http.Request) {
+	id, err := strconv.Atoi(chi.URLParam(r, "tokenID"))
+	if err != nil {
+		render.Render(w, r, ErrNotFoundRequest(err))
+		return
+	}
+	if err := h.s.DeleteAPIToken(r.Context(), currentUser(r).ID, id); err != nil {
+		if ent.IsNotFound(err) {
+			render.Render(w, r, ErrNotFoundRequest(err))
+			return
+		}
+		render.Render(w, r, ErrInternalServer(err))
+		return
+	}
+	w.WriteHeader(http.StatusNoContent)
+}
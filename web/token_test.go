@@ -0,0 +1,59 @@
+package web
+
+import (
+	"encoding/json"
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	entUser "github.com/gobench-io/gobench/ent/user"
+)
+
+func TestAPITokens(t *testing.T) {
+	r, _, m := newAPITestMaster(t, "adminPassword")
+	u := seedUser(t, m, entUser.RoleRunner)
+	jwt := login(t, r, u.Username, "password")
+
+	w := serveAs(r, jwt, "POST", "/api/users/me/tokens", map[string]string{
+		"name":  "nightly",
+		"scope": "admin",
+	})
+	assert.Equal(t, 400, w.Code)
+
+	w = serveAs(r, jwt, "POST", "/api/users/me/tokens", map[string]string{
+		"name":  "nightly",
+		"scope": "read",
+	})
+	assert.Equal(t, 201, w.Code)
+	var created apiTokenResponse
+	_ = json.Unmarshal(w.Body.Bytes(), &created)
+	assert.NotEmpty(t, created.Token)
+
+	t.Run("a token is a bearer with its scope", func(t *testing.T) {
+		assert.Equal(t, 200, serveAs(r, created.Token, "GET", "/api/applications", nil).Code)
+		// a runner, but a read token
+		assert.Equal(t, 403, serveAs(r, created.Token, "POST", "/api/baselines", nil).Code)
+		assert.Equal(t, 401, serveAs(r, created.Token+"x", "GET", "/api/applications", nil).Code)
+	})
+
+	t.Run("list with the last use, without the token", func(t *testing.T) {
+		w := serveAs(r, jwt, "GET", "/api/users/me/tokens", nil)
+		assert.Equal(t, 200, w.Code)
+		assert.NotContains(t, w.Body.String(), created.Token)
+		assert.NotContains(t, w.Body.String(), "token_hash")
+
+		var ts []apiTokenResponse
+		_ = json.Unmarshal(w.Body.Bytes(), &ts)
+		assert.Len(t, ts, 1)
+		assert.Equal(t, "nightly", ts[0].Name)
+		assert.NotNil(t, ts[0].LastUsedAt)
+	})
+
+	t.Run("revoke", func(t *testing.T) {
+		url := fmt.Sprintf("/api/users/me/tokens/%d", created.ID)
+		assert.Equal(t, 204, serveAs(r, jwt, "DELETE", url, nil).Code)
+		assert.Equal(t, 404, serveAs(r, jwt, "DELETE", url, nil).Code)
+		assert.Equal(t, 401, serveAs(r, created.Token, "GET", "/api/applications", nil).Code)
+	})
+}
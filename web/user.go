@@ -1,54 +1,286 @@
 package web
 
 import (
+	"context"
 	"errors"
 	"net/http"
+	"strconv"
+	"strings"
 	"time"
 
+	"github.com/go-chi/chi"
 	"github.com/go-chi/jwtauth"
 	"github.com/go-chi/render"
 	"github.com/golang-jwt/jwt"
+
+	"github.com/gobench-io/gobench/ent"
+	"github.com/gobench-io/gobench/master"
+
+	entAudit "github.com/gobench-io/gobench/ent/audit"
+	entUser "github.com/gobench-io/gobench/ent/user"
 )
 
 const fifteenDays = 15 * 24 * time.Hour
 
-func createToken(tokenAuth *jwtauth.JWTAuth) (token string, err error) {
-	if tokenAuth == nil {
-		token = "you-can-get-in"
-		return
-	}
+// openUser is the user of every request when the authentication is off
+var openUser = &ent.User{
+	Username: master.AdminUsername,
+	Role:     entUser.RoleAdmin,
+}
+
+func createToken(tokenAuth *jwtauth.JWTAuth, u *ent.User) (token string, err error) {
 	_, token, err = tokenAuth.Encode(jwt.MapClaims{
-		"username": "admin",
+		"username": u.Username,
+		"role":     u.Role,
 		"exp":      jwtauth.ExpireIn(fifteenDays),
 	})
 
 	return
 }
 
-func (h *handler) userLogin(tokenAuth *jwtauth.JWTAuth) http.HandlerFunc {
-	return func(w http.ResponseWriter, r *http.Request) {
-		d := new(accesstokenRequest)
-		if err := render.Bind(r, d); err != nil {
-			render.Render(w, r, ErrInvalidRequest(err))
+// user request, the password and the role are optional on an update
+type userRequest struct {
+	Username string       `json:"username"`
+	Password string       `json:"password"`
+	Role     entUser.Role `json:"role"`
+}
+
+func (ur *userRequest) Bind(r *http.Request) error {
+	if ur.Role != "" {
+		return entUser.RoleValidator(ur.Role)
+	}
+	return nil
+}
+
+// user response
+type userResponse struct {
+	*ent.User
+}
+
+func (ur *userResponse) Render(w http.ResponseWriter, r *http.Request) error {
+	return nil
+}
+
+func newUserListResponse(us []*ent.User) []render.Renderer {
+	list := []render.Renderer{}
+	for _, u := range us {
+		list = append(list, &userResponse{u})
+	}
+	return list
+}
+
+// authenticate finds the user of an API token, or of a JWT otherwise
+func (h *handler) authenticate(next http.Handler) http.Handler {
+	jwtNext := jwtauth.Verifier(h.tokenAuth)(jwtauth.Authenticator(h.userCtx(next)))
+
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		token := jwtauth.TokenFromHeader(r)
+		if !strings.HasPrefix(token, master.APITokenPrefix) {
+			jwtNext.ServeHTTP(w, r)
 			return
 		}
-		if d.Username != h.adminUsername || d.Password != h.adminPassword {
-			render.Render(w, r, ErrUnauthenticated(errors.New("invalid credentials")))
+
+		t, err := h.s.AuthenticateToken(r.Context(), token)
+		if err != nil {
+			if errors.Is(err, master.ErrInvalidToken) {
+				render.Render(w, r, ErrUnauthenticated(err))
+				return
+			}
+			render.Render(w, r, ErrInternalServer(err))
 			return
 		}
 
-		tokenString, err := createToken(tokenAuth)
+		u := t.Edges.User
+		ctx := context.WithValue(r.Context(), webKey("user"), u)
+		ctx = context.WithValue(ctx, webKey("role"), master.TokenRole(u.Role, t.Scope))
+		next.ServeHTTP(w, r.WithContext(ctx))
+	})
+}
 
+// userCtx loads the user of the token, so a deleted user or a changed role
+// applies at once
+func (h *handler) userCtx(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		_, claims, err := jwtauth.FromContext(r.Context())
+		if err != nil {
+			render.Render(w, r, ErrUnauthenticated(err))
+			return
+		}
+		username, _ := claims["username"].(string)
+		u, err := h.s.UserByName(r.Context(), username)
 		if err != nil {
+			if ent.IsNotFound(err) {
+				render.Render(w, r, ErrUnauthenticated(errors.New("unknown user")))
+				return
+			}
 			render.Render(w, r, ErrInternalServer(err))
 			return
 		}
-		err = render.Render(w, r, &accesstokenResponse{
-			ID: tokenString,
+
+		ctx := context.WithValue(r.Context(), webKey("user"), u)
+		next.ServeHTTP(w, r.WithContext(ctx))
+	})
+}
+
+// currentUser returns the user of a request
+func currentUser(r *http.Request) *ent.User {
+	if u, ok := r.Context().Value(webKey("user")).(*ent.User); ok {
+		return u
+	}
+	return openUser
+}
+
+// currentRole returns the role of a request, the role of its user limited
+// by the scope of its API token
+func currentRole(r *http.Request) entUser.Role {
+	if role, ok := r.Context().Value(webKey("role")).(entUser.Role); ok {
+		return role
+	}
+	return currentUser(r).Role
+}
+
+// requireRole allows the users with at least the role
+func (h *handler) requireRole(role entUser.Role) func(http.Handler) http.Handler {
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			if h.auth && !master.RoleAllows(currentRole(r), role) {
+				render.Render(w, r, ErrForbidden(
+					errors.New("the "+string(role)+" role is required")))
+				return
+			}
+			next.ServeHTTP(w, r)
 		})
+	}
+}
+
+func (h *handler) userLogin(w http.ResponseWriter, r *http.Request) {
+	d := new(accesstokenRequest)
+	if err := render.Bind(r, d); err != nil {
+		render.Render(w, r, ErrInvalidRequest(err))
+		return
+	}
+
+	u := openUser
+	// without authentication the admin logs in without a password
+	if h.auth || d.Username != u.Username || d.Password != "" {
+		var err error
+		u, err = h.s.Authenticate(r.Context(), d.Username, d.Password)
 		if err != nil {
-			render.Render(w, r, ErrRender(err))
+			if errors.Is(err, master.ErrInvalidCredentials) {
+				h.audit(r, master.AuditEntry{Username: d.Username, Action: entAudit.ActionLoginFailed})
+				render.Render(w, r, ErrUnauthenticated(err))
+				return
+			}
+			render.Render(w, r, ErrInternalServer(err))
 			return
 		}
 	}
+
+	tokenString, err := createToken(h.tokenAuth, u)
+	if err != nil {
+		render.Render(w, r, ErrInternalServer(err))
+		return
+	}
+	h.audit(r, master.AuditEntry{Username: u.Username, Action: entAudit.ActionLogin})
+	err = render.Render(w, r, &accesstokenResponse{
+		ID: tokenString,
+	})
+	if err != nil {
+		render.Render(w, r, ErrRender(err))
+		return
+	}
+}
+
+// getMe returns the user of the request
+// GET /api/users/me
+func (h *handler) getMe(w http.ResponseWriter, r *http.Request) {
+	if err := render.Render(w, r, &userResponse{currentUser(r)}); err != nil {
+		render.Render(w, r, ErrRender(err))
+		return
+	}
+}
+
+func (h *handler) listUsers(w http.ResponseWriter, r *http.Request) {
+	us, err := h.s.ListUsers(r.Context())
+	if err != nil {
+		render.Render(w, r, ErrInternalServer(err))
+		return
+	}
+	if err := render.RenderList(w, r, newUserListResponse(us)); err != nil {
+		render.Render(w, r, ErrRender(err))
+		return
+	}
+}
+
+// renderUserErr renders a fault of a user change
+func renderUserErr(w http.ResponseWriter, r *http.Request, err error) {
+	switch {
+	case ent.IsNotFound(err):
+		render.Render(w, r, ErrNotFoundRequest(err))
+	case ent.IsValidationError(err), ent.IsConstraintError(err),
+		errors.Is(err, master.ErrEmptyPassword), errors.Is(err, master.ErrLastAdmin):
+		render.Render(w, r, ErrInvalidRequest(err))
+	default:
+		render.Render(w, r, ErrInternalServer(err))
+	}
+}
+
+// createUser creates a user, a viewer by default
+// POST /api/users
+func (h *handler) createUser(w http.ResponseWriter, r *http.Request) {
+	data := &userRequest{}
+	if err := render.Bind(r, data); err != nil {
+		render.Render(w, r, ErrInvalidRequest(err))
+		return
+	}
+	if data.Role == "" {
+		data.Role = entUser.DefaultRole
+	}
+
+	u, err := h.s.CreateUser(r.Context(), data.Username, data.Password, data.Role)
+	if err != nil {
+		renderUserErr(w, r, err)
+		return
+	}
+
+	render.Status(r, http.StatusCreated)
+	render.Render(w, r, &userResponse{u})
+}
+
+// updateUser changes the password or the role of a user
+// PUT /api/users/{userID}
+func (h *handler) updateUser(w http.ResponseWriter, r *http.Request) {
+	id, err := strconv.Atoi(chi.URLParam(r, "userID"))
+	if err != nil {
+		render.Render(w, r, ErrNotFoundRequest(err))
+		return
+	}
+	data := &userRequest{}
+	if err := render.Bind(r, data); err != nil {
+		render.Render(w, r, ErrInvalidRequest(err))
+		return
+	}
+
+	u, err := h.s.UpdateUser(r.Context(), id, data.Password, data.Role)
+	if err != nil {
+		renderUserErr(w, r, err)
+		return
+	}
+	if err := render.Render(w, r, &userResponse{u}); err != nil {
+		render.Render(w, r, ErrRender(err))
+		return
+	}
+}
+
+func (h *handler) deleteUser(w http.ResponseWriter, r *http.Request) {
+	id, err := strconv.Atoi(chi.URLParam(r, "userID"))
+	if err != nil {
+		render.Render(w, r, ErrNotFoundRequest(err))
+		return
+	}
+	if err := h.s.DeleteUser(r.Context(), id); err != nil {
+		renderUserErr(w, r, err)
+		return
+	}
+	w.WriteHeader(http.StatusNoContent)
 }
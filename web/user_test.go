@@ -0,0 +1,146 @@
+package web
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/go-chi/chi"
+	"github.com/gobench-io/gobench/ent"
+	"github.com/gobench-io/gobench/master"
+	"github.com/stretchr/testify/assert"
+
+	entUser "github.com/gobench-io/gobench/ent/user"
+)
+
+// seedUser creates a user with a unique username, deleted after the test
+func seedUser(t *testing.T, m *master.Master, role entUser.Role) *ent.User {
+	username := fmt.Sprintf("%s-%d", role, time.Now().UnixNano())
+	u, err := m.CreateUser(context.Background(), username, "password", role)
+	assert.Nil(t, err)
+	t.Cleanup(func() { _ = m.DeleteUser(context.Background(), u.ID) })
+	return u
+}
+
+func login(t *testing.T, r *chi.Mux, username, password string) string {
+	w := httptest.NewRecorder()
+	reqBody, _ := json.Marshal(map[string]string{
+		"username": username,
+		"password": password,
+	})
+	req, _ := http.NewRequest("POST", "/api/users/login", bytes.NewBuffer(reqBody))
+	req.Header.Set("Content-Type", "application/json")
+	r.ServeHTTP(w, req)
+	assert.Equal(t, 200, w.Code)
+
+	act := new(accesstokenResponse)
+	_ = json.Unmarshal(w.Body.Bytes(), act)
+	return act.ID
+}
+
+func serveAs(r *chi.Mux, token, method, url string, body interface{}) *httptest.ResponseRecorder {
+	w := httptest.NewRecorder()
+	reqBody, _ := json.Marshal(body)
+	req, _ := http.NewRequest(method, url, bytes.NewBuffer(reqBody))
+	req.Header.Set("Content-Type", "application/json")
+	if token != "" {
+		req.Header.Set("Authorization", "Bearer "+token)
+	}
+	r.ServeHTTP(w, req)
+	return w
+}
+
+func TestUserRoles(t *testing.T) {
+	r, _, m := newAPITestMaster(t, "adminPassword")
+
+	t.Run("viewer reads only", func(t *testing.T) {
+		u := seedUser(t, m, entUser.RoleViewer)
+		token := login(t, r, u.Username, "password")
+
+		assert.Equal(t, 200, serveAs(r, token, "GET", "/api/applications", nil).Code)
+		assert.Equal(t, 403, serveAs(r, token, "POST", "/api/applications", nil).Code)
+		assert.Equal(t, 403, serveAs(r, token, "POST", "/api/baselines", nil).Code)
+		assert.Equal(t, 403, serveAs(r, token, "GET", "/api/users", nil).Code)
+		assert.Equal(t, 403, serveAs(r, token, "GET", "/api/admin/db", nil).Code)
+
+		w := serveAs(r, token, "GET", "/api/users/me", nil)
+		assert.Equal(t, 200, w.Code)
+		var me ent.User
+		_ = json.Unmarshal(w.Body.Bytes(), &me)
+		assert.Equal(t, u.Username, me.Username)
+		assert.Equal(t, entUser.RoleViewer, me.Role)
+	})
+
+	t.Run("runner writes", func(t *testing.T) {
+		u := seedUser(t, m, entUser.RoleRunner)
+		token := login(t, r, u.Username, "password")
+
+		// passes the role check, fails on the empty body
+		assert.Equal(t, 400, serveAs(r, token, "POST", "/api/baselines", nil).Code)
+		assert.Equal(t, 403, serveAs(r, token, "GET", "/api/users", nil).Code)
+	})
+
+	t.Run("deleted user", func(t *testing.T) {
+		u := seedUser(t, m, entUser.RoleRunner)
+		token := login(t, r, u.Username, "password")
+		assert.Nil(t, m.DeleteUser(context.Background(), u.ID))
+
+		assert.Equal(t, 401, serveAs(r, token, "GET", "/api/applications", nil).Code)
+	})
+
+	t.Run("admin manages users", func(t *testing.T) {
+		token := login(t, r, master.AdminUsername, "adminPassword")
+		username := fmt.Sprintf("bob-%d", time.Now().UnixNano())
+
+		w := serveAs(r, token, "POST", "/api/users", map[string]string{
+			"username": username,
+			"password": "bobpw",
+		})
+		assert.Equal(t, 201, w.Code)
+		assert.NotContains(t, w.Body.String(), "password")
+		var u ent.User
+		_ = json.Unmarshal(w.Body.Bytes(), &u)
+		assert.Equal(t, entUser.RoleViewer, u.Role)
+
+		w = serveAs(r, token, "POST", "/api/users", map[string]string{
+			"username": username,
+			"password": "bobpw",
+		})
+		assert.Equal(t, 400, w.Code)
+		w = serveAs(r, token, "POST", "/api/users", map[string]string{
+			"username": "carol",
+			"password": "carolpw",
+			"role":     "owner",
+		})
+		assert.Equal(t, 400, w.Code)
+
+		url := fmt.Sprintf("/api/users/%d", u.ID)
+		w = serveAs(r, token, "PUT", url, map[string]string{"role": "runner"})
+		assert.Equal(t, 200, w.Code)
+		_ = json.Unmarshal(w.Body.Bytes(), &u)
+		assert.Equal(t, entUser.RoleRunner, u.Role)
+
+		w = serveAs(r, token, "GET", "/api/users", nil)
+		assert.Equal(t, 200, w.Code)
+		assert.Contains(t, w.Body.String(), username)
+
+		assert.Equal(t, 204, serveAs(r, token, "DELETE", url, nil).Code)
+		assert.Equal(t, 404, serveAs(r, token, "DELETE", url, nil).Code)
+	})
+}
+
+func TestOpenLogin(t *testing.T) {
+	r, _ := newAPITest(t, "")
+
+	token := login(t, r, master.AdminUsername, "")
+	assert.NotEqual(t, "you-can-get-in", token)
+
+	w := serveAs(r, "", "GET", "/api/users/me", nil)
+	assert.Equal(t, 200, w.Code)
+	assert.Contains(t, w.Body.String(), `"role":"admin"`)
+}
@@ -1,6 +1,8 @@
 package web
 
 import (
+	"context"
+	"crypto/rand"
 	"embed"
 	"fmt"
 	"io/fs"
@@ -14,18 +16,21 @@ import (
 	"github.com/gobench-io/gobench/ent"
 	"github.com/gobench-io/gobench/logger"
 	"github.com/gobench-io/gobench/master"
-)
 
-const adminUsername = "admin"
+	entUser "github.com/gobench-io/gobench/ent/user"
+)
 
 type webKey string
 
+// requestTimeout bounds the requests but the streams
+var requestTimeout = 60 * time.Second
+
 type handler struct {
-	logger        logger.Logger
-	s             *master.Master
-	adminUsername string
-	adminPassword string
-	r             *chi.Mux
+	logger    logger.Logger
+	s         *master.Master
+	tokenAuth *jwtauth.JWTAuth
+	auth      bool // whether the API requires a token
+	r         *chi.Mux
 }
 
 //go:embed ui/gobench-ui/build
@@ -35,20 +40,18 @@ func (h *handler) db() *ent.Client {
 	return h.s.DbClient()
 }
 
-func setAuth(r chi.Router, tokenAuth *jwtauth.JWTAuth) {
-	if tokenAuth != nil {
-		r.Use(jwtauth.Verifier(tokenAuth))
-		r.Use(jwtauth.Authenticator)
+func (h *handler) setAuth(r chi.Router) {
+	if h.auth {
+		r.Use(h.authenticate)
 	}
 }
 
 // New return new router interface
 func newHandler(s *master.Master, adminPassword string, logger logger.Logger) *handler {
 	h := &handler{
-		s:             s,
-		adminUsername: adminUsername,
-		adminPassword: adminPassword,
-		logger:        logger,
+		s:      s,
+		auth:   adminPassword != "",
+		logger: logger,
 	}
 
 	// basic cors for more ideas, see:
@@ -62,30 +65,132 @@ func newHandler(s *master.Master, adminPassword string, logger logger.Logger) *h
 		MaxAge:           300, // Maximum value not ignored by any of major browsers
 	})
 
-	var tokenAuth *jwtauth.JWTAuth
-	if adminPassword != "" {
-		tokenAuth = jwtauth.New("HS256", []byte(adminPassword), nil)
+	if h.auth {
+		h.tokenAuth = jwtauth.New("HS256", []byte(adminPassword), nil)
+		if _, err := s.EnsureAdmin(context.Background(), adminPassword); err != nil {
+			logger.Errorw("failed creating the admin user", "err", err)
+		}
+	} else {
+		// the tokens are not checked, sign them with a key of the process
+		key := make([]byte, 32)
+		_, _ = rand.Read(key)
+		h.tokenAuth = jwtauth.New("HS256", key, nil)
 	}
 
 	// initialize the router
 	r := chi.NewRouter()
 	r.Use(cors.Handler)
 	r.Use(middleware.RequestID)
+	r.Use(peerAddr)
 	r.Use(middleware.RealIP)
 	r.Use(middleware.Recoverer)
 
-	r.Use(middleware.Timeout(60 * time.Second))
+	// the streams and the downloads outlive the request timeout
+	r.Group(func(r chi.Router) {
+		h.setAuth(r)
+		r.Use(h.applicationCtx)
+
+		r.Get("/api/applications/{applicationID}/stream", h.streamApplication)
+		r.Get("/api/applications/{applicationID}/logs/system", h.getApplicationSystemLog)
+		r.Get("/api/applications/{applicationID}/logs/user", h.getApplicationUserLog)
+		r.Get("/api/applications/{applicationID}/export", h.exportApplication)
+		r.Get("/api/applications/{applicationID}/archive", h.getApplicationArchive)
+		r.Get("/api/applications/{applicationID}/report.html", h.getApplicationReport)
+	})
+	r.Group(func(r chi.Router) {
+		h.setAuth(r)
+		r.Use(h.requireRole(entUser.RoleRunner))
+
+		r.Post("/api/applications/import", h.importApplication)
+	})
+
+	r.Group(func(r chi.Router) {
+		r.Use(middleware.Timeout(requestTimeout))
+		h.routes(r)
+	})
+
+	fsys, _ := fs.Sub(staticFs, "ui/gobench-ui/build")
+	r.Handle("/*", http.FileServer(http.FS(fsys)))
+
+	h.r = r
+
+	return h
+}
 
+// routes registers the requests bounded by the request timeout
+func (h *handler) routes(r chi.Router) {
 	r.Get("/healthz", h.healthz)
 
 	r.Get("/varz", h.varz)
 
+	// the metrics of the projects of the user, a read token is enough
+	r.Group(func(r chi.Router) {
+		h.setAuth(r)
+		r.Use(h.requireRole(entUser.RoleViewer))
+
+		r.Get("/metrics", h.prometheus)
+	})
+
+	// the writes need a runner, the reads a viewer
+	runner := h.requireRole(entUser.RoleRunner)
+
 	// rest for groups
 	r.Route("/api/", func(r chi.Router) {
-		r.Post("/users/login", h.userLogin(tokenAuth))
+		r.Route("/users", func(r chi.Router) {
+			r.Post("/login", h.userLogin) // POST /users/login
+
+			r.Group(func(r chi.Router) {
+				h.setAuth(r)
+
+				r.Get("/me", h.getMe)    // GET /users/me
+				r.Put("/me", h.updateMe) // PUT /users/me
+				r.Route("/me/tokens", func(r chi.Router) {
+					r.Get("/", h.listAPITokens)              // GET /users/me/tokens
+					r.Post("/", h.createAPIToken)            // POST /users/me/tokens
+					r.Delete("/{tokenID}", h.deleteAPIToken) // DELETE /users/me/tokens/{tokenID}
+				})
+				r.Route("/me/subscriptions", func(r chi.Router) {
+					r.Get("/", h.listSubscriptions)                     // GET /users/me/subscriptions
+					r.Post("/", h.createSubscription)                   // POST /users/me/subscriptions
+					r.Delete("/{subscriptionID}", h.deleteSubscription) // DELETE /users/me/subscriptions/{subscriptionID}
+				})
+
+				r.Group(func(r chi.Router) {
+					r.Use(h.requireRole(entUser.RoleAdmin))
+
+					r.Get("/", h.listUsers)             // GET /users
+					r.Post("/", h.createUser)           // POST /users
+					r.Put("/{userID}", h.updateUser)    // PUT /users/{userID}
+					r.Delete("/{userID}", h.deleteUser) // DELETE /users/{userID}
+				})
+			})
+		})
+
+		r.Route("/projects", func(r chi.Router) {
+			h.setAuth(r)
+
+			r.Get("/", h.listProjects)                                          // GET /projects
+			r.With(h.requireRole(entUser.RoleAdmin)).Post("/", h.createProject) // POST /projects
+
+			r.Route("/{projectID}", func(r chi.Router) {
+				r.Use(h.projectCtx)
+
+				r.Get("/", h.getProject)
+				r.Get("/members", h.listProjectMembers)
+
+				r.Group(func(r chi.Router) {
+					r.Use(h.requireRole(entUser.RoleAdmin))
+
+					r.Put("/", h.updateProject)
+					r.Delete("/", h.deleteProject)
+					r.Put("/members/{userID}", h.addProjectMember)
+					r.Delete("/members/{userID}", h.removeProjectMember)
+				})
+			})
+		})
 
 		r.Route("/groups", func(r chi.Router) {
-			setAuth(r, tokenAuth)
+			h.setAuth(r)
 
 			r.Get("/", h.listGroups) // GET /groups
 
@@ -98,7 +203,7 @@ func newHandler(s *master.Master, adminPassword string, logger logger.Logger) *h
 
 		// rest for graphs
 		r.Route("/graphs", func(r chi.Router) {
-			setAuth(r, tokenAuth)
+			h.setAuth(r)
 
 			r.Get("/", h.listGraphs) // GET /graphs
 
@@ -111,7 +216,7 @@ func newHandler(s *master.Master, adminPassword string, logger logger.Logger) *h
 
 		// rest for metrics
 		r.Route("/metrics", func(r chi.Router) {
-			setAuth(r, tokenAuth)
+			h.setAuth(r)
 
 			r.Get("/", h.listMetrics) // GET /metrics
 
@@ -127,36 +232,88 @@ func newHandler(s *master.Master, adminPassword string, logger logger.Logger) *h
 
 		// get the application
 		r.Route("/applications", func(r chi.Router) {
-			setAuth(r, tokenAuth)
+			h.setAuth(r)
 
-			r.Get("/", h.listApplications)       // GET /applications
-			r.Get("/count", h.countApplications) // GET /applications/count
-			r.Post("/", h.createApplication)     // POST /applications
+			r.Get("/", h.listApplications)                // GET /applications
+			r.Get("/count", h.countApplications)          // GET /applications/count
+			r.With(runner).Post("/", h.createApplication) // POST /applications
 
 			r.Route("/{applicationID}", func(r chi.Router) {
 				r.Use(h.applicationCtx)
 
 				r.Get("/", h.getApplication)
-				r.Delete("/", h.deleteApplication)
+				r.With(runner).Delete("/", h.deleteApplication)
 				r.Get("/groups", h.getApplicationGroups)
-				r.Put("/cancel", h.cancelApplication)
+				r.With(runner).Put("/cancel", h.cancelApplication)
 				r.Route("/tags", func(r chi.Router) {
 					r.Get("/", h.getApplicationTags)
-					r.Put("/", h.addApplicationTag)
-					r.Delete("/{tagID}", h.removeApplicationTag)
+					r.With(runner).Put("/", h.addApplicationTag)
+					r.With(runner).Delete("/{tagID}", h.removeApplicationTag)
 				})
-				r.Get("/logs/system", h.getApplicationSystemLog)
-				r.Get("/logs/user", h.getApplicationUserLog)
+				r.Get("/summary", h.getApplicationSummary)
+				r.Get("/result.json", h.getApplicationResult)
+				r.Get("/junit.xml", h.getApplicationJUnit)
+				r.Get("/events", h.getApplicationEvents)
 			})
 		})
-	})
 
-	fsys, _ := fs.Sub(staticFs, "ui/gobench-ui/build")
-	r.Handle("/*", http.FileServer(http.FS(fsys)))
+		r.Route("/baselines", func(r chi.Router) {
+			h.setAuth(r)
 
-	h.r = r
+			r.Get("/", h.listBaselines)             // GET /baselines
+			r.With(runner).Post("/", h.setBaseline) // POST /baselines
 
-	return h
+			r.Route("/{baselineID}", func(r chi.Router) {
+				r.Use(h.baselineCtx)
+				r.Get("/", h.getBaseline)
+				r.With(runner).Delete("/", h.deleteBaseline)
+			})
+		})
+
+		r.Route("/compare", func(r chi.Router) {
+			h.setAuth(r)
+
+			r.Get("/", h.compareApplications) // GET /compare?apps=1,2
+		})
+
+		r.Route("/query", func(r chi.Router) {
+			h.setAuth(r)
+
+			r.Post("/", h.query) // POST /query
+		})
+
+		r.Route("/webhooks", func(r chi.Router) {
+			h.setAuth(r)
+			r.Use(h.requireRole(entUser.RoleAdmin))
+
+			r.Get("/", h.listWebhooks)   // GET /webhooks
+			r.Post("/", h.createWebhook) // POST /webhooks
+
+			r.Route("/{webhookID}", func(r chi.Router) {
+				r.Use(h.webhookCtx)
+
+				r.Get("/", h.getWebhook)
+				r.Put("/", h.updateWebhook)
+				r.Delete("/", h.deleteWebhook)
+				r.Get("/deliveries", h.getWebhookDeliveries)
+			})
+		})
+
+		r.Route("/audit", func(r chi.Router) {
+			h.setAuth(r)
+			r.Use(h.requireRole(entUser.RoleAdmin))
+
+			r.Get("/", h.listAudits) // GET /audit?user=&action=&app=&tag=&from=&end=
+		})
+
+		r.Route("/admin", func(r chi.Router) {
+			h.setAuth(r)
+			r.Use(h.requireRole(entUser.RoleAdmin))
+
+			r.Get("/db", h.getDbStats)              // GET /admin/db
+			r.Post("/db/maintenance", h.maintainDb) // POST /admin/db/maintenance
+		})
+	})
 }
 
 // Serve start a web server with given gobench server
@@ -2,6 +2,7 @@ package web
 
 import (
 	"bytes"
+	"context"
 	"encoding/base64"
 	"encoding/json"
 	"fmt"
@@ -16,49 +17,45 @@ import (
 	"github.com/gobench-io/gobench/logger"
 	"github.com/gobench-io/gobench/master"
 	"github.com/stretchr/testify/assert"
+
+	entUser "github.com/gobench-io/gobench/ent/user"
 )
 
 func newAPITest(t *testing.T, adminPassword string) (*chi.Mux, *httptest.ResponseRecorder) {
-	logger := logger.NewNopLogger()
-	m, _ := master.NewMaster(&master.Options{
-		Addr:    "0.0.0.0",
-		Port:    8080,
-		HomeDir: "/tmp",
-	}, logger)
-
-	m.SetIsScheduled(false)
-
-	err := m.Start()
-	assert.Nil(t, err)
-	h := newHandler(m, adminPassword, logger)
-	r := h.r
-
-	w := httptest.NewRecorder()
-
+	r, w, _ := newAPITestMaster(t, adminPassword)
 	return r, w
 }
 
 func cleanApplication(t *testing.T) {
-	r, w := newAPITest(t, "")
-	req, _ := http.NewRequest("GET", fmt.Sprintf("/api/applications?limit=%d", ^uint(0)), nil)
-	req.Header.Set("Content-Type", "application/json")
-
-	r.ServeHTTP(w, req)
-	assert.Less(t, w.Code, 400)
-
-	var apps []ent.Application
-	err := json.Unmarshal(w.Body.Bytes(), &apps)
-	assert.Equal(t, err, nil)
-	for _, app := range apps {
+	// the list is paginated, delete page by page
+	for {
 		r, w := newAPITest(t, "")
-		req, _ := http.NewRequest(
-			"DELETE",
-			fmt.Sprintf("/api/applications/%d", app.ID),
-			nil,
-		)
+		req, _ := http.NewRequest("GET", fmt.Sprintf("/api/applications?limit=%d", ^uint(0)), nil)
+		req.Header.Set("Content-Type", "application/json")
+
 		r.ServeHTTP(w, req)
+		assert.Less(t, w.Code, 400)
 
-		assert.Equal(t, 200, w.Code)
+		var apps []ent.Application
+		err := json.Unmarshal(w.Body.Bytes(), &apps)
+		assert.Equal(t, err, nil)
+
+		if len(apps) == 0 {
+			return
+		}
+		for _, app := range apps {
+			r, w := newAPITest(t, "")
+			req, _ := http.NewRequest(
+				"DELETE",
+				fmt.Sprintf("/api/applications/%d", app.ID),
+				nil,
+			)
+			r.ServeHTTP(w, req)
+
+			if !assert.Equal(t, 200, w.Code) {
+				return
+			}
+		}
 	}
 }
 func newAppTag(t *testing.T, appID int, name string) *ent.Tag {
@@ -451,6 +448,77 @@ func TestCreateApplications(t *testing.T) {
 
 	})
 
+	t.Run("successful request with sinks", func(t *testing.T) {
+		r, w := newAPITest(t, "")
+
+		reqBody, _ := json.Marshal(map[string]interface{}{
+			"Name":     "name",
+			"Scenario": base64.StdEncoding.EncodeToString([]byte("this is the scenario")),
+			"sinks": []map[string]string{
+				{"type": "statsd", "addr": "localhost:8125"},
+				{"type": "influxdb", "url": "http://localhost:8086/write", "token": "s3cret"},
+			},
+		})
+		req, _ := http.NewRequest("POST", "/api/applications", bytes.NewBuffer(reqBody))
+		req.Header.Set("Content-Type", "application/json")
+
+		r.ServeHTTP(w, req)
+
+		assert.Equal(t, 201, w.Code)
+		assert.NotContains(t, w.Body.String(), "s3cret")
+
+		var app ent.Application
+		json.Unmarshal(w.Body.Bytes(), &app)
+		assert.Len(t, app.Sinks, 2)
+		assert.Equal(t, "statsd", app.Sinks[0].Type)
+		assert.Equal(t, "localhost:8125", app.Sinks[0].Addr)
+
+		// the token is stored, never rendered
+		w = httptest.NewRecorder()
+		req, _ = http.NewRequest("GET", fmt.Sprintf("/api/applications/%d", app.ID), nil)
+		r.ServeHTTP(w, req)
+		assert.Equal(t, 200, w.Code)
+		assert.NotContains(t, w.Body.String(), "s3cret")
+	})
+
+	t.Run("invalid request - unknown sink", func(t *testing.T) {
+		r, w := newAPITest(t, "")
+
+		reqBody, _ := json.Marshal(map[string]interface{}{
+			"Name":     "name",
+			"Scenario": base64.StdEncoding.EncodeToString([]byte("this is the scenario")),
+			"sinks": []map[string]string{
+				{"type": "kafka"},
+			},
+		})
+		req, _ := http.NewRequest("POST", "/api/applications", bytes.NewBuffer(reqBody))
+		req.Header.Set("Content-Type", "application/json")
+
+		r.ServeHTTP(w, req)
+
+		assert.Equal(t, 400, w.Code)
+		assert.Contains(t, w.Body.String(), "unknown sink type")
+	})
+
+	t.Run("invalid request - jsonl sink outside the sink directory", func(t *testing.T) {
+		r, w := newAPITest(t, "")
+
+		reqBody, _ := json.Marshal(map[string]interface{}{
+			"Name":     "name",
+			"Scenario": base64.StdEncoding.EncodeToString([]byte("this is the scenario")),
+			"sinks": []map[string]string{
+				{"type": "jsonl", "path": "../../etc/cron.d/gobench"},
+			},
+		})
+		req, _ := http.NewRequest("POST", "/api/applications", bytes.NewBuffer(reqBody))
+		req.Header.Set("Content-Type", "application/json")
+
+		r.ServeHTTP(w, req)
+
+		assert.Equal(t, 400, w.Code)
+		assert.Contains(t, w.Body.String(), "sink directory")
+	})
+
 	t.Run("invalid request - without Name", func(t *testing.T) {
 		r, w := newAPITest(t, "")
 		reqBody, _ := json.Marshal(map[string]string{
@@ -624,6 +692,39 @@ func TestDeleteApplicationTag(t *testing.T) {
 	assert.Equal(t, 200, w.Code)
 }
 
+func TestDeleteTagOfAnotherApplication(t *testing.T) {
+	app := newApp(t, "name 1", "scenario 1")
+	other := newApp(t, "name 2", "scenario 2")
+	otherTag := newAppTag(t, other.ID, "foo")
+
+	r, w, m := newAPITestMaster(t, "")
+
+	req, _ := http.NewRequest(
+		"DELETE",
+		fmt.Sprintf("/api/applications/%d/tags/%d", app.ID, otherTag.ID),
+		nil,
+	)
+	r.ServeHTTP(w, req)
+
+	assert.Equal(t, 404, w.Code)
+	tag, err := m.GetTagByID(context.Background(), otherTag.ID)
+	assert.Nil(t, err)
+	assert.NotNil(t, tag)
+}
+
+func TestDeleteTagWithoutApplication(t *testing.T) {
+	_, _, m := newAPITestMaster(t, "")
+	h := newHandler(m, "", logger.NewNopLogger())
+
+	req, _ := http.NewRequest("DELETE", "/api/applications/0/tags/1", nil)
+	req = req.WithContext(context.WithValue(req.Context(),
+		webKey("application"), (*ent.Application)(nil)))
+	w := httptest.NewRecorder()
+	h.removeApplicationTag(w, req)
+
+	assert.Equal(t, 404, w.Code)
+}
+
 func TestGetApplicationLogs(t *testing.T) {
 	app := newApp(t, "name 1", "scenario 1")
 
@@ -705,3 +806,35 @@ func TestGetVarz(t *testing.T) {
 		assert.Fail(t, "Expect gomaxprocs to be valid")
 	}
 }
+
+func TestGetPrometheusMetrics(t *testing.T) {
+	adminPassword := "adminPassword"
+	r, _, m := newAPITestMaster(t, adminPassword)
+	ctx := context.Background()
+
+	// a running application of a project of the admins only
+	p, err := m.CreateProject(ctx, fmt.Sprintf("prometheus-%d", time.Now().UnixNano()), master.ProjectLimits{})
+	assert.Nil(t, err)
+	app, err := m.NewApplication(ctx, "scraped", "scenario", "", "", master.WithProject(p.ID))
+	assert.Nil(t, err)
+	app, err = app.Update().SetStatus("running").Save(ctx)
+	assert.Nil(t, err)
+	defer app.Update().SetStatus("finished").Save(ctx)
+	seedAppMetrics(t, m.DbClient(), app, "e1", 1000, 1)
+
+	w := serveAs(r, "", "GET", "/metrics", nil)
+	assert.Equal(t, 401, w.Code)
+
+	// a viewer scrapes the metrics of its projects
+	viewer := login(t, r, seedUser(t, m, entUser.RoleViewer).Username, "password")
+	w = serveAs(r, viewer, "GET", "/metrics", nil)
+	assert.Equal(t, 200, w.Code)
+	assert.Contains(t, w.Body.String(), "# TYPE gobench_jobs gauge")
+	assert.NotContains(t, w.Body.String(), `application="scraped"`)
+
+	w = serveAs(r, login(t, r, "admin", adminPassword), "GET", "/metrics", nil)
+	assert.Equal(t, 200, w.Code)
+	assert.Equal(t, master.PrometheusContentType, w.Header().Get("Content-Type"))
+	assert.Contains(t, w.Body.String(), "# TYPE gobench_counter_total counter")
+	assert.Contains(t, w.Body.String(), `application="scraped"`)
+}
@@ -0,0 +1,201 @@
+package web
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"strconv"
+
+	"github.com/go-chi/chi"
+	"github.com/go-chi/render"
+
+	"github.com/gobench-io/gobench/ent"
+	"github.com/gobench-io/gobench/master"
+)
+
+// webhook request, an empty secret keeps the secret on update
+type webhookRequest struct {
+	Name     string   `json:"name"`
+	URL      string   `json:"url"`
+	Tag      string   `json:"tag"`
+	Events   []string `json:"events"`
+	Template string   `json:"template"`
+	Secret   string   `json:"secret"`
+}
+
+func (wr *webhookRequest) Bind(r *http.Request) error {
+	if wr.Name == "" {
+		return errors.New("name required")
+	}
+	return nil
+}
+
+func (wr *webhookRequest) config() master.WebhookConfig {
+	return master.WebhookConfig{
+		Name:     wr.Name,
+		URL:      wr.URL,
+		Tag:      wr.Tag,
+		Events:   wr.Events,
+		Template: wr.Template,
+		Secret:   wr.Secret,
+	}
+}
+
+// webhook response, the secret is never shown
+type webhookResponse struct {
+	*ent.Webhook
+	Signed bool      `json:"signed"`
+	Edges  *struct{} `json:"edges,omitempty"`
+}
+
+func (wr *webhookResponse) Render(w http.ResponseWriter, r *http.Request) error {
+	return nil
+}
+
+func newWebhookResponse(w *ent.Webhook) *webhookResponse {
+	return &webhookResponse{Webhook: w, Signed: w.Secret != ""}
+}
+
+// webhook delivery response
+type webhookDeliveryResponse struct {
+	*ent.WebhookDelivery
+	Edges *struct{} `json:"edges,omitempty"`
+}
+
+func (dr *webhookDeliveryResponse) Render(w http.ResponseWriter, r *http.Request) error {
+	return nil
+}
+
+func renderWebhookErr(w http.ResponseWriter, r *http.Request, err error) {
+	switch {
+	case errors.Is(err, master.ErrWebhookInvalid), ent.IsValidationError(err):
+		render.Render(w, r, ErrInvalidRequest(err))
+	case ent.IsNotFound(err):
+		render.Render(w, r, ErrNotFoundRequest(err))
+	default:
+		render.Render(w, r, ErrInternalServer(err))
+	}
+}
+
+func (h *handler) webhookCtx(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		id, err := strconv.Atoi(chi.URLParam(r, "webhookID"))
+		if err != nil {
+			render.Render(w, r, ErrNotFoundRequest(err))
+			return
+		}
+		wh, err := h.s.GetWebhook(r.Context(), id)
+		if err != nil {
+			renderWebhookErr(w, r, err)
+			return
+		}
+
+		ctx := context.WithValue(r.Context(), webKey("webhook"), wh)
+		next.ServeHTTP(w, r.WithContext(ctx))
+	})
+}
+
+func (h *handler) listWebhooks(w http.ResponseWriter, r *http.Request) {
+	whs, err := h.s.ListWebhooks(r.Context())
+	if err != nil {
+		render.Render(w, r, ErrInternalServer(err))
+		return
+	}
+	list := []render.Renderer{}
+	for _, wh := range whs {
+		list = append(list, newWebhookResponse(wh))
+	}
+	if err := render.RenderList(w, r, list); err != nil {
+		render.Render(w, r, ErrRender(err))
+		return
+	}
+}
+
+// createWebhook creates a webhook of every application, or of the
+// applications with a tag
+// POST /api/webhooks
+func (h *handler) createWebhook(w http.ResponseWriter, r *http.Request) {
+	data := &webhookRequest{}
+	if err := render.Bind(r, data); err != nil {
+		render.Render(w, r, ErrInvalidRequest(err))
+		return
+	}
+	wh, err := h.s.CreateWebhook(r.Context(), data.config())
+	if err != nil {
+		renderWebhookErr(w, r, err)
+		return
+	}
+	render.Status(r, http.StatusCreated)
+	render.Render(w, r, newWebhookResponse(wh))
+}
+
+func (h *handler) getWebhook(w http.ResponseWriter, r *http.Request) {
+	wh, ok := r.Context().Value(webKey("webhook")).(*ent.Webhook)
+	if !ok {
+		http.Error(w, http.StatusText(422), 422)
+		return
+	}
+	if err := render.Render(w, r, newWebhookResponse(wh)); err != nil {
+		render.Render(w, r, ErrRender(err))
+		return
+	}
+}
+
+func (h *handler) updateWebhook(w http.ResponseWriter, r *http.Request) {
+	wh, ok := r.Context().Value(webKey("webhook")).(*ent.Webhook)
+	if !ok {
+		http.Error(w, http.StatusText(422), 422)
+		return
+	}
+	data := &webhookRequest{}
+	if err := render.Bind(r, data); err != nil {
+		render.Render(w, r, ErrInvalidRequest(err))
+		return
+	}
+	wh, err := h.s.UpdateWebhook(r.Context(), wh.ID, data.config())
+	if err != nil {
+		renderWebhookErr(w, r, err)
+		return
+	}
+	if err := render.Render(w, r, newWebhookResponse(wh)); err != nil {
+		render.Render(w, r, ErrRender(err))
+		return
+	}
+}
+
+func (h *handler) deleteWebhook(w http.ResponseWriter, r *http.Request) {
+	wh, ok := r.Context().Value(webKey("webhook")).(*ent.Webhook)
+	if !ok {
+		http.Error(w, http.StatusText(422), 422)
+		return
+	}
+	if err := h.s.DeleteWebhook(r.Context(), wh.ID); err != nil {
+		renderWebhookErr(w, r, err)
+		return
+	}
+	w.WriteHeader(http.StatusNoContent)
+}
+
+// getWebhookDeliveries returns the last deliveries of a webhook with their
+// attempts, the latest first
+// GET /api/webhooks/{webhookID}/deliveries
+func (h *handler) getWebhookDeliveries(w http.ResponseWriter, r *http.Request) {
+	wh, ok := r.Context().Value(webKey("webhook")).(*ent.Webhook)
+	if !ok {
+		http.Error(w, http.StatusText(422), 422)
+		return
+	}
+	ds, err := h.s.ListWebhookDeliveries(r.Context(), wh.ID)
+	if err != nil {
+		render.Render(w, r, ErrInternalServer(err))
+		return
+	}
+	list := []render.Renderer{}
+	for _, d := range ds {
+		list = append(list, &webhookDeliveryResponse{WebhookDelivery: d})
+	}
+	if err := render.RenderList(w, r, list); err != nil {
+		render.Render(w, r, ErrRender(err))
+		return
+	}
+}
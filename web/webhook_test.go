@@ -0,0 +1,72 @@
+package web
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"testing"
+
+	"github.com/gobench-io/gobench/master"
+	"github.com/stretchr/testify/assert"
+
+	entUser "github.com/gobench-io/gobench/ent/user"
+)
+
+func TestWebhookAPI(t *testing.T) {
+	r, _, m := newAPITestMaster(t, "adminPassword")
+	admin := login(t, r, master.AdminUsername, "adminPassword")
+
+	w := serveAs(r, admin, "POST", "/api/webhooks", map[string]interface{}{
+		"name":   "chat",
+		"url":    "http://127.0.0.1:1/hook",
+		"tag":    "nightly",
+		"events": []string{"finish", "breach"},
+		"secret": "s3cret",
+	})
+	assert.Equal(t, 201, w.Code)
+	assert.NotContains(t, w.Body.String(), "s3cret")
+	var wh webhookResponse
+	_ = json.Unmarshal(w.Body.Bytes(), &wh)
+	assert.True(t, wh.Signed)
+	t.Cleanup(func() { _ = m.DeleteWebhook(context.Background(), wh.ID) })
+	url := fmt.Sprintf("/api/webhooks/%d", wh.ID)
+
+	t.Run("update keeps the secret", func(t *testing.T) {
+		w := serveAs(r, admin, "PUT", url, map[string]interface{}{
+			"name":     "chat",
+			"url":      "http://127.0.0.1:1/hook",
+			"template": `{"text": {{json .Event}}}`,
+		})
+		assert.Equal(t, 200, w.Code)
+		var got webhookResponse
+		_ = json.Unmarshal(w.Body.Bytes(), &got)
+		assert.True(t, got.Signed)
+		assert.Empty(t, got.Tag)
+		assert.Equal(t, `{"text": {{json .Event}}}`, got.Template)
+	})
+
+	t.Run("deliveries", func(t *testing.T) {
+		w := serveAs(r, admin, "GET", url+"/deliveries", nil)
+		assert.Equal(t, 200, w.Code)
+		assert.Equal(t, "[]\n", w.Body.String())
+	})
+
+	t.Run("invalid", func(t *testing.T) {
+		assert.Equal(t, 400, serveAs(r, admin, "POST", "/api/webhooks", map[string]interface{}{
+			"name": "bad", "url": "http://127.0.0.1:1/hook", "template": "not json",
+		}).Code)
+		assert.Equal(t, 400, serveAs(r, admin, "POST", "/api/webhooks", map[string]interface{}{
+			"name": "bad", "url": "127.0.0.1",
+		}).Code)
+		assert.Equal(t, 404, serveAs(r, admin, "GET", "/api/webhooks/0", nil).Code)
+	})
+
+	t.Run("admin only", func(t *testing.T) {
+		u := seedUser(t, m, entUser.RoleRunner)
+		token := login(t, r, u.Username, "password")
+		assert.Equal(t, 403, serveAs(r, token, "GET", "/api/webhooks", nil).Code)
+	})
+
+	assert.Equal(t, 204, serveAs(r, admin, "DELETE", url, nil).Code)
+	assert.Equal(t, 404, serveAs(r, admin, "GET", url, nil).Code)
+}